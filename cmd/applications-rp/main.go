@@ -29,6 +29,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	certificateservice "github.com/radius-project/radius/pkg/corerp/certificates/service"
 	metricsservice "github.com/radius-project/radius/pkg/metrics/service"
 	profilerservice "github.com/radius-project/radius/pkg/profiler/service"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
@@ -43,6 +44,7 @@ import (
 	corerp_setup "github.com/radius-project/radius/pkg/corerp/setup"
 	daprrp_setup "github.com/radius-project/radius/pkg/daprrp/setup"
 	dsrp_setup "github.com/radius-project/radius/pkg/datastoresrp/setup"
+	dynamicrp_setup "github.com/radius-project/radius/pkg/dynamicrp/setup"
 	msgrp_setup "github.com/radius-project/radius/pkg/messagingrp/setup"
 )
 
@@ -97,15 +99,24 @@ func main() {
 		hostingSvc = append(hostingSvc, data.NewEmbeddedETCDService(data.EmbeddedETCDServiceOptions{ClientConfigSink: client}))
 	}
 
-	builders, err := builders(options)
+	config, err := controllerconfig.New(options)
+	if err != nil {
+		log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
+	}
+
+	builders := builders(config)
+	dynamicRP := dynamicrp_setup.NewSetup(config)
+
+	certificateSvc, err := certificateservice.NewService(options)
 	if err != nil {
 		log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
 	}
 
 	hostingSvc = append(
 		hostingSvc,
-		server.NewAPIService(options, builders),
-		server.NewAsyncWorker(options, builders),
+		server.NewAPIService(options, builders, dynamicRP),
+		server.NewAsyncWorker(options, builders, dynamicRP),
+		certificateSvc,
 	)
 
 	tracerOpts := options.Config.TracerProvider
@@ -129,17 +140,12 @@ func main() {
 	}
 }
 
-func builders(options hostoptions.HostOptions) ([]builder.Builder, error) {
-	config, err := controllerconfig.New(options)
-	if err != nil {
-		return nil, err
-	}
-
+func builders(config *controllerconfig.RecipeControllerConfig) []builder.Builder {
 	return []builder.Builder{
 		corerp_setup.SetupNamespace(config).GenerateBuilder(),
 		daprrp_setup.SetupNamespace(config).GenerateBuilder(),
 		msgrp_setup.SetupNamespace(config).GenerateBuilder(),
 		dsrp_setup.SetupNamespace(config).GenerateBuilder(),
 		// Add resource provider builders...
-	}, nil
+	}
 }
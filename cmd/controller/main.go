@@ -43,7 +43,7 @@ func main() {
 	pflag.StringVar(&config, "config-file", config, "The service configuration file.")
 
 	tlsCertDir := ""
-	pflag.StringVar(&tlsCertDir, "cert-dir", "", "The directory containing the TLS certificates.")
+	pflag.StringVar(&tlsCertDir, "cert-dir", os.Getenv("TLS_CERT_DIR"), "The directory containing the TLS certificates. Defaults to the value of the TLS_CERT_DIR environment variable.")
 
 	pflag.Parse()
 	options, err := hostoptions.NewHostOptionsFromEnvironment(config)
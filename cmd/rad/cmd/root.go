@@ -33,9 +33,14 @@ import (
 	app_switch "github.com/radius-project/radius/pkg/cli/cmd/app/appswitch"
 	app_connections "github.com/radius-project/radius/pkg/cli/cmd/app/connections"
 	app_delete "github.com/radius-project/radius/pkg/cli/cmd/app/delete"
+	app_diff "github.com/radius-project/radius/pkg/cli/cmd/app/diff"
+	app_export "github.com/radius-project/radius/pkg/cli/cmd/app/export"
+	app_history "github.com/radius-project/radius/pkg/cli/cmd/app/history"
 	app_list "github.com/radius-project/radius/pkg/cli/cmd/app/list"
+	app_rollback "github.com/radius-project/radius/pkg/cli/cmd/app/rollback"
 	app_show "github.com/radius-project/radius/pkg/cli/cmd/app/show"
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
+	"github.com/radius-project/radius/pkg/cli/cmd/audit"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
 	credential "github.com/radius-project/radius/pkg/cli/cmd/credential"
 	cmd_deploy "github.com/radius-project/radius/pkg/cli/cmd/deploy"
@@ -49,6 +54,7 @@ import (
 	group "github.com/radius-project/radius/pkg/cli/cmd/group"
 	"github.com/radius-project/radius/pkg/cli/cmd/install"
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
+	lock "github.com/radius-project/radius/pkg/cli/cmd/lock"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
@@ -212,6 +218,9 @@ func initSubCommands() {
 	runCmd, _ := run.NewCommand(framework)
 	RootCmd.AddCommand(runCmd)
 
+	auditCmd, _ := audit.NewCommand(framework)
+	RootCmd.AddCommand(auditCmd)
+
 	showCmd, _ := resource_show.NewCommand(framework)
 	resourceCmd.AddCommand(showCmd)
 
@@ -239,6 +248,9 @@ func initSubCommands() {
 	groupCmd := group.NewCommand(framework)
 	RootCmd.AddCommand(groupCmd)
 
+	lockCmd := lock.NewCommand(framework)
+	RootCmd.AddCommand(lockCmd)
+
 	initCmd, _ := radinit.NewCommand(framework)
 	RootCmd.AddCommand(initCmd)
 
@@ -275,9 +287,21 @@ func initSubCommands() {
 	appDeleteCmd, _ := app_delete.NewCommand(framework)
 	applicationCmd.AddCommand(appDeleteCmd)
 
+	appDiffCmd, _ := app_diff.NewCommand(framework)
+	applicationCmd.AddCommand(appDiffCmd)
+
+	appExportCmd, _ := app_export.NewCommand(framework)
+	applicationCmd.AddCommand(appExportCmd)
+
+	appHistoryCmd, _ := app_history.NewCommand(framework)
+	applicationCmd.AddCommand(appHistoryCmd)
+
 	appListCmd, _ := app_list.NewCommand(framework)
 	applicationCmd.AddCommand(appListCmd)
 
+	appRollbackCmd, _ := app_rollback.NewCommand(framework)
+	applicationCmd.AddCommand(appRollbackCmd)
+
 	appShowCmd, _ := app_show.NewCommand(framework)
 	applicationCmd.AddCommand(appShowCmd)
 
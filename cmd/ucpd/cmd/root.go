@@ -25,6 +25,12 @@ import (
 	etcdclient "go.etcd.io/etcd/client/v3"
 	runtimelog "sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/radius-project/radius/pkg/armrpc/builder"
+	corerp_setup "github.com/radius-project/radius/pkg/corerp/setup"
+	daprrp_setup "github.com/radius-project/radius/pkg/daprrp/setup"
+	dsrp_setup "github.com/radius-project/radius/pkg/datastoresrp/setup"
+	msgrp_setup "github.com/radius-project/radius/pkg/messagingrp/setup"
+	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/hosting"
 	"github.com/radius-project/radius/pkg/ucp/server"
@@ -60,6 +66,8 @@ var rootCmd = &cobra.Command{
 			options.SecretProviderOptions.ETCD.Client = clientconfigSource
 		}
 
+		options.Builders = builders()
+
 		host, err := server.NewServer(&options)
 		if err != nil {
 			return err
@@ -71,6 +79,18 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+// builders returns the builders of the resource providers hosted by applications-rp. UCP only uses them to generate
+// its OpenAPI document, so their controllers are never created and the recipe controller configuration is empty.
+func builders() []builder.Builder {
+	config := &controllerconfig.RecipeControllerConfig{}
+	return []builder.Builder{
+		corerp_setup.SetupNamespace(config).GenerateBuilder(),
+		daprrp_setup.SetupNamespace(config).GenerateBuilder(),
+		msgrp_setup.SetupNamespace(config).GenerateBuilder(),
+		dsrp_setup.SetupNamespace(config).GenerateBuilder(),
+	}
+}
+
 func Execute() {
 	cobra.CheckErr(rootCmd.ExecuteContext(context.Background()))
 }
@@ -102,6 +102,9 @@ var (
 	// https://github.com/Azure/azure-resource-manager-rpc/blob/master/v1.0/common-api-contracts.md#properties
 	ARMResourceSystemDataHeader = "X-Ms-Arm-Resource-System-Data"
 
+	// WarningHeader is the standard http header Warning. It is used to warn clients about deprecated API versions.
+	WarningHeader = "Warning"
+
 	// TraceparentHeader is W3C trace parent header.
 	TraceparentHeader = "Traceparent"
 
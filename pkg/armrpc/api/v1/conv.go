@@ -38,6 +38,14 @@ type ResourceDataModel interface {
 	UpdateMetadata(ctx *ARMRequestContext, oldResource *BaseResource)
 }
 
+// WriteOnlyDataModel is implemented by datamodels with properties that are accepted in requests but never returned
+// in responses, such as secrets.
+type WriteOnlyDataModel interface {
+	// WriteOnlyProperties returns the write-only properties in the form of the "properties" object of the versioned
+	// model, or nil if there are none.
+	WriteOnlyProperties() map[string]any
+}
+
 // VersionedModelInterface is the interface for versioned models.
 type VersionedModelInterface interface {
 	// ConvertFrom converts version agnostic datamodel to versioned model.
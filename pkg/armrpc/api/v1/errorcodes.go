@@ -41,6 +41,18 @@ const (
 	// Used for CodeInvalidAuthenticationInfo.
 	CodeInvalidAuthenticationInfo = "InvalidAuthenticationInfo"
 
+	// Used for the cases when the caller is not authorized to perform an operation.
+	CodeAuthorizationFailed = "AuthorizationFailed"
+
+	// Used for the cases when an operation is prevented by a lock on the scope.
+	CodeScopeLocked = "ScopeLocked"
+
+	// Used for the cases when an operation exceeds a quota on the scope.
+	CodeQuotaExceeded = "QuotaExceeded"
+
+	// Used for the cases when the caller exceeds a request rate limit.
+	CodeTooManyRequests = "TooManyRequests"
+
 	// Used for the cases when the precondition of a request fails.
 	CodePreconditionFailed = "PreconditionFailed"
 
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apiversions provides the building blocks to serve multiple API versions of a resource type side by side:
+// ordering and deprecation of API versions and converters between the datamodel and the versioned models.
+package apiversions
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// PreviewSuffix is the suffix of preview API versions.
+	PreviewSuffix = "-preview"
+
+	// dateLength is the length of the date of an API version in the form YYYY-MM-DD.
+	dateLength = len("2006-01-02")
+)
+
+var versionPattern = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})(-[a-z0-9]+)?$`)
+
+// IsValid returns true if the API version is a date in the form YYYY-MM-DD with an optional suffix such as '-preview'.
+func IsValid(version string) bool {
+	return versionPattern.MatchString(strings.ToLower(version))
+}
+
+// IsPreview returns true if the API version is a preview version.
+func IsPreview(version string) bool {
+	return strings.HasSuffix(strings.ToLower(version), PreviewSuffix)
+}
+
+// Compare returns -1 if version a is older than version b, 1 if it is newer and 0 if the versions are equal. Versions
+// are ordered by date and a version with a suffix, such as a preview version, is older than the stable version of the
+// same date. The comparison is case-insensitive.
+func Compare(a, b string) int {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	if a == b {
+		return 0
+	}
+
+	dateA, suffixA := split(a)
+	dateB, suffixB := split(b)
+	switch {
+	case dateA != dateB:
+		return strings.Compare(dateA, dateB)
+	case suffixA == "":
+		return 1
+	case suffixB == "":
+		return -1
+	default:
+		return strings.Compare(suffixA, suffixB)
+	}
+}
+
+// SortNewestFirst sorts the API versions from the newest to the oldest.
+func SortNewestFirst(versions []string) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return Compare(versions[i], versions[j]) > 0
+	})
+}
+
+// Deprecated returns the newest of the supported API versions and true if the API version is deprecated. A preview
+// version is deprecated once a newer version is supported, so that preview features can keep evolving in new preview
+// versions. Stable versions are never deprecated by newer versions.
+func Deprecated(version string, supported []string) (string, bool) {
+	if !IsPreview(version) {
+		return "", false
+	}
+
+	newest := ""
+	for _, candidate := range supported {
+		if newest == "" || Compare(candidate, newest) > 0 {
+			newest = candidate
+		}
+	}
+
+	if newest == "" || Compare(newest, version) <= 0 {
+		return "", false
+	}
+	return newest, true
+}
+
+// PackageName returns the name of the Go package of the versioned models of the API version, for example
+// 'v20231001preview' for '2023-10-01-preview'.
+func PackageName(version string) string {
+	return "v" + strings.ReplaceAll(strings.ToLower(version), "-", "")
+}
+
+// split returns the date and the suffix of a lowercase API version.
+func split(version string) (string, string) {
+	if len(version) < dateLength {
+		return version, ""
+	}
+	return version[:dateLength], strings.TrimPrefix(version[dateLength:], "-")
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiversions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsValid(t *testing.T) {
+	require.True(t, IsValid("2023-10-01-preview"))
+	require.True(t, IsValid("2024-01-01"))
+	require.True(t, IsValid("2023-10-01-PREVIEW"))
+	require.False(t, IsValid(""))
+	require.False(t, IsValid("v1"))
+	require.False(t, IsValid("2023-10-01-"))
+}
+
+func Test_Compare(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"2023-10-01-preview", "2023-10-01-preview", 0},
+		{"2023-10-01-preview", "2023-10-01-Preview", 0},
+		{"2023-10-01-preview", "2024-01-01-preview", -1},
+		{"2024-01-01", "2023-10-01-preview", 1},
+		{"2023-10-01-preview", "2023-10-01", -1},
+		{"2023-10-01", "2023-10-01-preview", 1},
+		{"2023-10-01-alpha", "2023-10-01-beta", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			require.Equal(t, tt.expected, Compare(tt.a, tt.b))
+		})
+	}
+}
+
+func Test_SortNewestFirst(t *testing.T) {
+	versions := []string{"2023-10-01-preview", "2024-01-01", "2022-03-15-privatepreview", "2024-01-01-preview"}
+	SortNewestFirst(versions)
+	require.Equal(t, []string{"2024-01-01", "2024-01-01-preview", "2023-10-01-preview", "2022-03-15-privatepreview"}, versions)
+}
+
+func Test_Deprecated(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		supported  []string
+		newest     string
+		deprecated bool
+	}{
+		{
+			name:      "only version",
+			version:   "2023-10-01-preview",
+			supported: []string{"2023-10-01-preview"},
+		},
+		{
+			name:       "preview with newer stable version",
+			version:    "2023-10-01-preview",
+			supported:  []string{"2023-10-01-preview", "2024-01-01"},
+			newest:     "2024-01-01",
+			deprecated: true,
+		},
+		{
+			name:       "preview with newer preview version",
+			version:    "2023-10-01-preview",
+			supported:  []string{"2024-01-01-preview", "2023-10-01-preview"},
+			newest:     "2024-01-01-preview",
+			deprecated: true,
+		},
+		{
+			name:      "newest preview",
+			version:   "2024-06-01-preview",
+			supported: []string{"2024-01-01", "2024-06-01-preview"},
+		},
+		{
+			name:      "stable with newer version",
+			version:   "2024-01-01",
+			supported: []string{"2024-01-01", "2024-06-01-preview", "2025-01-01"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newest, deprecated := Deprecated(tt.version, tt.supported)
+			require.Equal(t, tt.deprecated, deprecated)
+			require.Equal(t, tt.newest, newest)
+		})
+	}
+}
+
+func Test_PackageName(t *testing.T) {
+	require.Equal(t, "v20231001preview", PackageName("2023-10-01-preview"))
+	require.Equal(t, "v20240101", PackageName("2024-01-01"))
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiversions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// Registration is the version-agnostic view of the converter of a resource type.
+type Registration interface {
+	// ResourceType returns the resource type of the converter.
+	ResourceType() string
+
+	// Versions returns the API versions registered with the converter, newest first.
+	Versions() []string
+
+	// Model returns a new versioned model of the API version, or nil if the API version is not registered.
+	Model(version string) v1.VersionedModelInterface
+
+	// RoundTrip converts the versioned model in the content to the datamodel and back twice and returns an error if
+	// the second conversion does not produce the same versioned model as the first.
+	RoundTrip(content []byte, version string) error
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[string]Registration{}
+)
+
+// Registered returns the converters of all resource types, ordered by resource type.
+func Registered() []Registration {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	result := []Registration{}
+	for _, registration := range registry {
+		result = append(result, registration)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return strings.ToLower(result[i].ResourceType()) < strings.ToLower(result[j].ResourceType())
+	})
+	return result
+}
+
+// Lookup returns the converter of the resource type. Resource types are matched case-insensitively.
+func Lookup(resourceType string) (Registration, bool) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registration, ok := registry[strings.ToLower(resourceType)]
+	return registration, ok
+}
+
+// Converter converts the datamodel of a resource type to and from the versioned models of the API versions of the
+// resource type. API versions are matched case-insensitively.
+type Converter[T any] struct {
+	resourceType string
+	versions     map[string]versionEntry
+}
+
+type versionEntry struct {
+	version      string
+	newVersioned func() v1.VersionedModelInterface
+}
+
+// NewConverter creates the converter of the resource type and registers it. It panics if a converter is already
+// registered for the resource type.
+func NewConverter[T any](resourceType string) *Converter[T] {
+	c := &Converter[T]{resourceType: resourceType, versions: map[string]versionEntry{}}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	key := strings.ToLower(resourceType)
+	if _, ok := registry[key]; ok {
+		panic(fmt.Sprintf("a converter is already registered for resource type %q", resourceType))
+	}
+	registry[key] = c
+	return c
+}
+
+// Register registers the versioned model of the API version. It panics if the API version is invalid or already
+// registered.
+func (c *Converter[T]) Register(version string, newVersioned func() v1.VersionedModelInterface) *Converter[T] {
+	if !IsValid(version) {
+		panic(fmt.Sprintf("invalid API version %q for resource type %q", version, c.resourceType))
+	}
+
+	key := strings.ToLower(version)
+	if _, ok := c.versions[key]; ok {
+		panic(fmt.Sprintf("API version %q is already registered for resource type %q", version, c.resourceType))
+	}
+	c.versions[key] = versionEntry{version: version, newVersioned: newVersioned}
+	return c
+}
+
+// ResourceType returns the resource type of the converter.
+func (c *Converter[T]) ResourceType() string {
+	return c.resourceType
+}
+
+// Versions returns the registered API versions, newest first.
+func (c *Converter[T]) Versions() []string {
+	versions := []string{}
+	for _, entry := range c.versions {
+		versions = append(versions, entry.version)
+	}
+	SortNewestFirst(versions)
+	return versions
+}
+
+// Model returns a new versioned model of the API version, or nil if the API version is not registered.
+func (c *Converter[T]) Model(version string) v1.VersionedModelInterface {
+	entry, ok := c.versions[strings.ToLower(version)]
+	if !ok {
+		return nil
+	}
+	return entry.newVersioned()
+}
+
+// ToVersioned converts the datamodel to the versioned model of the API version. It returns
+// v1.ErrUnsupportedAPIVersion if the API version is not registered.
+func (c *Converter[T]) ToVersioned(model *T, version string) (v1.VersionedModelInterface, error) {
+	entry, ok := c.versions[strings.ToLower(version)]
+	if !ok {
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+
+	src, ok := any(model).(v1.DataModelInterface)
+	if !ok {
+		return nil, v1.ErrInvalidModelConversion
+	}
+
+	versioned := entry.newVersioned()
+	if err := versioned.ConvertFrom(src); err != nil {
+		return nil, err
+	}
+	return versioned, nil
+}
+
+// FromVersioned converts the versioned model of the API version in the content to the datamodel. It returns
+// v1.ErrUnsupportedAPIVersion if the API version is not registered.
+func (c *Converter[T]) FromVersioned(content []byte, version string) (*T, error) {
+	entry, ok := c.versions[strings.ToLower(version)]
+	if !ok {
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+
+	versioned := entry.newVersioned()
+	if err := json.Unmarshal(content, versioned); err != nil {
+		return nil, err
+	}
+
+	dm, err := versioned.ConvertTo()
+	if err != nil {
+		return nil, err
+	}
+
+	model, ok := any(dm).(*T)
+	if !ok {
+		return nil, v1.ErrInvalidModelConversion
+	}
+	return model, nil
+}
+
+// RoundTrip converts the versioned model in the content to the datamodel and back twice. The first conversion may
+// drop write-only properties such as secrets and fill in defaults, so the versioned models produced by the first and
+// the second conversion are compared. A difference means that a property is lost or changed by the conversion.
+func (c *Converter[T]) RoundTrip(content []byte, version string) error {
+	first, err := c.roundTrip(content, version)
+	if err != nil {
+		return fmt.Errorf("failed to convert the content: %w", err)
+	}
+
+	second, err := c.roundTrip(first, version)
+	if err != nil {
+		return fmt.Errorf("failed to convert the converted content %s: %w", string(first), err)
+	}
+
+	if !bytes.Equal(first, second) {
+		return fmt.Errorf("the versioned model of %q in API version %q changed after a round trip through the datamodel:\nfirst:  %s\nsecond: %s", c.resourceType, version, string(first), string(second))
+	}
+	return nil
+}
+
+func (c *Converter[T]) roundTrip(content []byte, version string) ([]byte, error) {
+	model, err := c.FromVersioned(content, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versioned, err := c.ToVersioned(model, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(versioned)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiversions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	testPreviewVersion = "2023-10-01-preview"
+	testStableVersion  = "2024-01-01"
+)
+
+// testDataModel is the datamodel of the test resource type.
+type testDataModel struct {
+	ID       string
+	Replicas int32
+	Secret   string
+}
+
+func (m *testDataModel) ResourceTypeName() string {
+	return "Test.Resources/tests"
+}
+
+// testPreviewResource is the versioned model of the preview API version. The replicas are a string.
+type testPreviewResource struct {
+	ID       *string `json:"id,omitempty"`
+	Replicas *string `json:"replicas,omitempty"`
+	Secret   *string `json:"secret,omitempty"`
+}
+
+func (r *testPreviewResource) ConvertTo() (v1.DataModelInterface, error) {
+	dm := &testDataModel{ID: to.String(r.ID), Secret: to.String(r.Secret)}
+	if to.String(r.Replicas) == "two" {
+		dm.Replicas = 2
+	}
+	return dm, nil
+}
+
+func (r *testPreviewResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*testDataModel)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+	r.ID = to.Ptr(dm.ID)
+	if dm.Replicas == 2 {
+		r.Replicas = to.Ptr("two")
+	}
+	// The secret is write-only.
+	return nil
+}
+
+// testStableResource is the versioned model of the stable API version. The replicas are a number.
+type testStableResource struct {
+	ID       *string `json:"id,omitempty"`
+	Replicas *int32  `json:"replicas,omitempty"`
+}
+
+func (r *testStableResource) ConvertTo() (v1.DataModelInterface, error) {
+	return &testDataModel{ID: to.String(r.ID), Replicas: to.Int32(r.Replicas)}, nil
+}
+
+func (r *testStableResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*testDataModel)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+	r.ID = to.Ptr(dm.ID)
+	r.Replicas = to.Ptr(dm.Replicas)
+	return nil
+}
+
+func newTestConverter(resourceType string) *Converter[testDataModel] {
+	return NewConverter[testDataModel](resourceType).
+		Register(testPreviewVersion, func() v1.VersionedModelInterface { return &testPreviewResource{} }).
+		Register(testStableVersion, func() v1.VersionedModelInterface { return &testStableResource{} })
+}
+
+func Test_Converter(t *testing.T) {
+	c := newTestConverter("Test.Resources/converters")
+
+	require.Equal(t, "Test.Resources/converters", c.ResourceType())
+	require.Equal(t, []string{testStableVersion, testPreviewVersion}, c.Versions())
+
+	t.Run("from preview to stable", func(t *testing.T) {
+		dm, err := c.FromVersioned([]byte(`{"id":"test","replicas":"two"}`), testPreviewVersion)
+		require.NoError(t, err)
+		require.Equal(t, &testDataModel{ID: "test", Replicas: 2}, dm)
+
+		versioned, err := c.ToVersioned(dm, testStableVersion)
+		require.NoError(t, err)
+		require.Equal(t, &testStableResource{ID: to.Ptr("test"), Replicas: to.Ptr(int32(2))}, versioned)
+	})
+
+	t.Run("version is case-insensitive", func(t *testing.T) {
+		dm, err := c.FromVersioned([]byte(`{"id":"test","replicas":2}`), "2024-01-01")
+		require.NoError(t, err)
+
+		versioned, err := c.ToVersioned(dm, strings.ToUpper(testPreviewVersion))
+		require.NoError(t, err)
+		require.Equal(t, &testPreviewResource{ID: to.Ptr("test"), Replicas: to.Ptr("two")}, versioned)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, err := c.FromVersioned([]byte(`{}`), "2022-01-01")
+		require.ErrorIs(t, err, v1.ErrUnsupportedAPIVersion)
+
+		_, err = c.ToVersioned(&testDataModel{}, "2022-01-01")
+		require.ErrorIs(t, err, v1.ErrUnsupportedAPIVersion)
+	})
+
+	t.Run("invalid content", func(t *testing.T) {
+		_, err := c.FromVersioned([]byte(`{`), testStableVersion)
+		require.Error(t, err)
+	})
+}
+
+func Test_Converter_Register(t *testing.T) {
+	c := newTestConverter("Test.Resources/duplicates")
+
+	require.Panics(t, func() {
+		NewConverter[testDataModel]("test.resources/DUPLICATES")
+	})
+	require.Panics(t, func() {
+		c.Register("2024-01-01", func() v1.VersionedModelInterface { return &testStableResource{} })
+	})
+	require.Panics(t, func() {
+		c.Register("latest", func() v1.VersionedModelInterface { return &testStableResource{} })
+	})
+
+	found := false
+	for _, registration := range Registered() {
+		if registration.ResourceType() == "Test.Resources/duplicates" {
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	registration, ok := Lookup("TEST.RESOURCES/duplicates")
+	require.True(t, ok)
+	require.IsType(t, &testStableResource{}, registration.Model(testStableVersion))
+	require.Nil(t, registration.Model("2022-01-01"))
+
+	_, ok = Lookup("Test.Resources/unregistered")
+	require.False(t, ok)
+}
+
+func Test_Converter_RoundTrip(t *testing.T) {
+	c := newTestConverter("Test.Resources/roundtrips")
+
+	// The write-only secret is dropped by the first conversion.
+	require.NoError(t, c.RoundTrip([]byte(`{"id":"test","replicas":"two","secret":"s3cr3t"}`), testPreviewVersion))
+	require.NoError(t, c.RoundTrip([]byte(`{"id":"test","replicas":2}`), testStableVersion))
+
+	err := c.RoundTrip([]byte(`{`), testStableVersion)
+	require.ErrorContains(t, err, "failed to convert the content")
+
+	asymmetric := NewConverter[testDataModel]("Test.Resources/asymmetric").
+		Register(testPreviewVersion, func() v1.VersionedModelInterface { return &testAsymmetricResource{} })
+	err = asymmetric.RoundTrip([]byte(`{"id":"test","replicas":"two"}`), testPreviewVersion)
+	require.ErrorContains(t, err, "changed after a round trip through the datamodel")
+}
+
+// testAsymmetricResource writes the replicas in a form that it does not read back.
+type testAsymmetricResource struct {
+	testPreviewResource
+}
+
+func (r *testAsymmetricResource) ConvertFrom(src v1.DataModelInterface) error {
+	if err := r.testPreviewResource.ConvertFrom(src); err != nil {
+		return err
+	}
+	if r.Replicas != nil {
+		r.Replicas = to.Ptr("TWO")
+	}
+	return nil
+}
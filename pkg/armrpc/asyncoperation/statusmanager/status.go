@@ -29,6 +29,9 @@ type Status struct {
 	// LinkedResourceID is the resource id associated with operation status.
 	LinkedResourceID string `json:"resourceID"`
 
+	// OperationType is the type of the operation, e.g. APPLICATIONS.CORE/ENVIRONMENTS|PUT.
+	OperationType string `json:"operationType,omitempty"`
+
 	// Location represents the location of operationstatus.
 	Location string `json:"location"`
 
@@ -40,6 +40,7 @@ type statusManager struct {
 	storeProvider dataprovider.DataStorageProvider
 	queue         queue.Client
 	location      string
+	observers     []Observer
 }
 
 // QueueOperationOptions is the options type provided when queueing an async operation.
@@ -64,12 +65,19 @@ type StatusManager interface {
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 }
 
-// New creates statusManager instance.
-func New(dataProvider dataprovider.DataStorageProvider, q queue.Client, location string) StatusManager {
+// Observer is notified when the state of an async operation changes.
+type Observer interface {
+	// OperationUpdated is called after the status of an async operation is saved with a new state.
+	OperationUpdated(ctx context.Context, status *Status)
+}
+
+// New creates statusManager instance. The observers are notified of each state transition of an async operation.
+func New(dataProvider dataprovider.DataStorageProvider, q queue.Client, location string, observers ...Observer) StatusManager {
 	return &statusManager{
 		storeProvider: dataProvider,
 		queue:         q,
 		location:      location,
+		observers:     observers,
 	}
 }
 
@@ -105,6 +113,7 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 			StartTime: time.Now().UTC(),
 		},
 		LinkedResourceID: sCtx.ResourceID.String(),
+		OperationType:    sCtx.OperationType.String(),
 		Location:         aom.location,
 		RetryAfter:       options.RetryAfter,
 		HomeTenantID:     sCtx.HomeTenantID,
@@ -190,7 +199,15 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 
 	obj.Data = s
 
-	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+	if err := storeClient.Save(ctx, obj, store.WithETag(obj.ETag)); err != nil {
+		return err
+	}
+
+	for _, observer := range aom.observers {
+		observer.OperationUpdated(ctx, s)
+	}
+
+	return nil
 }
 
 // Delete deletes the operation status resource associated with the given ID and
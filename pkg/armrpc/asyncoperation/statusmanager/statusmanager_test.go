@@ -328,3 +328,47 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+type testObserver struct {
+	statuses []Status
+}
+
+func (o *testObserver) OperationUpdated(ctx context.Context, status *Status) {
+	o.statuses = append(o.statuses, *status)
+}
+
+func TestUpdateAsyncOperationStatus_Observer(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	dp := dataprovider.NewMockDataStorageProvider(mctrl)
+	sc := store.NewMockStorageClient(mctrl)
+	dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(sc, nil).AnyTimes()
+
+	observer := &testObserver{}
+	manager := New(dp, queue.NewMockClient(mctrl), "test-location", observer)
+
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+
+	obj := &store.Object{
+		Metadata: store.Metadata{ID: opID.String(), ETag: "etag"},
+		Data:     &Status{LinkedResourceID: azureEnvResourceID, OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|DELETE"},
+	}
+
+	// The observer is not notified when the status cannot be saved.
+	sc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(obj, nil)
+	sc.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf(saveErr))
+	err = manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateSucceeded, nil, nil)
+	require.Error(t, err)
+	require.Empty(t, observer.statuses)
+
+	sc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(obj, nil)
+	sc.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	err = manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateFailed, nil, &v1.ErrorDetails{Code: "Failed"})
+	require.NoError(t, err)
+
+	require.Len(t, observer.statuses, 1)
+	require.Equal(t, v1.ProvisioningStateFailed, observer.statuses[0].Status)
+	require.Equal(t, azureEnvResourceID, observer.statuses[0].LinkedResourceID)
+	require.Equal(t, "APPLICATIONS.CORE/ENVIRONMENTS|DELETE", observer.statuses[0].OperationType)
+	require.Equal(t, "Failed", observer.statuses[0].Error.Code)
+}
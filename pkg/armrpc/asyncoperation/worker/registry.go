@@ -29,9 +29,10 @@ type ControllerFactoryFunc func(opts ctrl.Options) (ctrl.Controller, error)
 
 // ControllerRegistry is an registry to register async controllers.
 type ControllerRegistry struct {
-	ctrlMap   map[string]ctrl.Controller
-	ctrlMapMu sync.RWMutex
-	sp        dataprovider.DataStorageProvider
+	ctrlMap     map[string]ctrl.Controller
+	ctrlMapMu   sync.RWMutex
+	defaultCtrl ctrl.Controller
+	sp          dataprovider.DataStorageProvider
 }
 
 // NewControllerRegistry creates an ControllerRegistry instance.
@@ -65,7 +66,31 @@ func (h *ControllerRegistry) Register(ctx context.Context, resourceType string,
 	return nil
 }
 
-// Get gets the registered async controller instance.
+// RegisterDefault registers the controller for the operations which have no registered controller. This is used for
+// the operations of resource types which are not known when the worker starts, such as user-defined resource types.
+// The storage client of the default controller is not filtered to a specific resource type.
+func (h *ControllerRegistry) RegisterDefault(ctx context.Context, factoryFn ControllerFactoryFunc, opts ctrl.Options) error {
+	h.ctrlMapMu.Lock()
+	defer h.ctrlMapMu.Unlock()
+
+	storageClient, err := h.sp.GetStorageClient(ctx, "")
+	if err != nil {
+		return err
+	}
+	opts.StorageClient = storageClient
+	opts.ResourceType = ""
+
+	ctrl, err := factoryFn(opts)
+	if err != nil {
+		return err
+	}
+
+	h.defaultCtrl = ctrl
+	return nil
+}
+
+// Get gets the registered async controller instance. It returns the default controller if no controller is registered
+// for the operation type, or nil if the default controller is not registered either.
 func (h *ControllerRegistry) Get(operationType v1.OperationType) ctrl.Controller {
 	h.ctrlMapMu.RLock()
 	defer h.ctrlMapMu.RUnlock()
@@ -74,5 +99,5 @@ func (h *ControllerRegistry) Get(operationType v1.OperationType) ctrl.Controller
 		return h
 	}
 
-	return nil
+	return h.defaultCtrl
 }
@@ -68,3 +68,39 @@ func TestRegister_Get(t *testing.T) {
 	ctrl = registry.Get(opPut)
 	require.NotNil(t, ctrl)
 }
+
+func TestRegisterDefault_Get(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	mockSP := dataprovider.NewMockDataStorageProvider(mctrl)
+	mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	registry := NewControllerRegistry(mockSP)
+
+	opPut := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationPut}
+	opUnknown := v1.OperationType{Type: "MyCompany.Data/kafkaTopics", Method: v1.OperationPut}
+
+	require.Nil(t, registry.Get(opUnknown))
+
+	ctrlOpts := ctrl.Options{
+		DataProvider:           mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor { return nil },
+	}
+
+	putCtrl := &testAsyncController{BaseController: ctrl.NewBaseAsyncController(ctrlOpts)}
+	err := registry.Register(context.TODO(), opPut.Type, opPut.Method, func(opts ctrl.Options) (ctrl.Controller, error) {
+		return putCtrl, nil
+	}, ctrlOpts)
+	require.NoError(t, err)
+
+	defaultCtrl := &testAsyncController{BaseController: ctrl.NewBaseAsyncController(ctrlOpts)}
+	err = registry.RegisterDefault(context.TODO(), func(opts ctrl.Options) (ctrl.Controller, error) {
+		require.Empty(t, opts.ResourceType)
+		return defaultCtrl, nil
+	}, ctrlOpts)
+	require.NoError(t, err)
+
+	require.Same(t, putCtrl, registry.Get(opPut))
+	require.Same(t, defaultCtrl, registry.Get(opUnknown))
+}
@@ -20,7 +20,9 @@ import (
 	"context"
 
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/notifications"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
@@ -41,10 +43,14 @@ type Service struct {
 	Controllers *ControllerRegistry
 	// RequestQueue is the queue client for async operation request message.
 	RequestQueue queue.Client
+	// AuditSink records the final state of operations.
+	AuditSink audit.Sink
+	// Publisher notifies event subscriptions of operation transitions.
+	Publisher *notifications.Publisher
 }
 
-// Init initializes worker service - it initializes the StorageProvider, RequestQueue, OperationStatusManager, Controllers, KubeClient and
-// returns an error if any of these operations fail.
+// Init initializes worker service - it initializes the StorageProvider, RequestQueue, Publisher, OperationStatusManager, Controllers,
+// AuditSink and returns an error if any of these operations fail.
 func (s *Service) Init(ctx context.Context) error {
 	s.StorageProvider = dataprovider.NewStorageProvider(s.Options.Config.StorageProvider)
 	qp := qprovider.New(s.Options.Config.QueueProvider)
@@ -53,8 +59,14 @@ func (s *Service) Init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	s.OperationStatusManager = manager.New(s.StorageProvider, s.RequestQueue, s.Options.Config.Env.RoleLocation)
+	s.Publisher = notifications.NewPublisher(s.StorageProvider, notifications.Options{})
+	s.Publisher.Start(ctx)
+	s.OperationStatusManager = manager.New(s.StorageProvider, s.RequestQueue, s.Options.Config.Env.RoleLocation, s.Publisher)
 	s.Controllers = NewControllerRegistry(s.StorageProvider)
+	s.AuditSink, err = audit.NewSink(ctx, s.Options.Config.Audit, s.StorageProvider)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -63,6 +75,10 @@ func (s *Service) Start(ctx context.Context, opt Options) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	ctx = hostoptions.WithContext(ctx, s.Options.Config)
 
+	if opt.AuditSink == nil {
+		opt.AuditSink = s.AuditSink
+	}
+
 	// Create and start worker.
 	worker := New(opt, s.OperationStatusManager, s.RequestQueue, s.Controllers)
 
@@ -29,6 +29,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/logging"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/trace"
@@ -80,6 +81,19 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// OperationObserver is notified when operations complete. Operations are not observed if nil.
+	OperationObserver OperationObserver
+
+	// AuditSink records the final state of operations. Operations are not audited if nil.
+	AuditSink audit.Sink
+}
+
+// OperationObserver is notified when the worker completes async operations.
+type OperationObserver interface {
+	// OperationCompleted is called after an operation completes and the provisioning state of its resource and its
+	// operation status are saved. It is not called for operations that are requeued.
+	OperationCompleted(ctx context.Context, request *ctrl.Request, state v1.ProvisioningState)
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -333,6 +347,14 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 		if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
 			logger.Error(err, "failed to finish the message")
 		}
+
+		if w.options.OperationObserver != nil {
+			w.options.OperationObserver.OperationCompleted(ctx, req, result.ProvisioningState())
+		}
+
+		if w.options.AuditSink != nil {
+			audit.RecordOperationCompleted(ctx, w.options.AuditSink, req, result.ProvisioningState())
+		}
 	}
 
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
@@ -457,6 +457,50 @@ func TestRunOperation_Successfully(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+type testObserver struct {
+	requests []*ctrl.Request
+	states   []v1.ProvisioningState
+}
+
+func (o *testObserver) OperationCompleted(ctx context.Context, request *ctrl.Request, state v1.ProvisioningState) {
+	o.requests = append(o.requests, request)
+	o.states = append(o.states, state)
+}
+
+func TestRunOperation_Observer(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	observer := &testObserver{}
+	worker := New(Options{OperationObserver: observer}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC, DataProvider: tCtx.mockSP}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			return ctrl.NewFailedResult(v1.ErrorDetails{Message: "failed"}), nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	require.Len(t, observer.requests, 1)
+	require.Equal(t, "APPLICATIONS.CORE/ENVIRONMENTS|PUT", observer.requests[0].OperationType)
+	require.Equal(t, []v1.ProvisioningState{v1.ProvisioningStateFailed}, observer.states)
+}
+
 func TestRunOperation_ExtendMessageLock(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	// KindRequest is the kind of event recorded for a mutating request handled by the frontend.
+	KindRequest = "Request"
+
+	// KindOperationCompleted is the kind of event recorded by the worker when an asynchronous operation completes.
+	KindOperationCompleted = "OperationCompleted"
+
+	// OutcomeSucceeded is the outcome of a request that completed successfully.
+	OutcomeSucceeded = "Succeeded"
+
+	// OutcomeAccepted is the outcome of a request that started an asynchronous operation.
+	OutcomeAccepted = "Accepted"
+
+	// OutcomeFailed is the outcome of a request that failed or was rejected.
+	OutcomeFailed = "Failed"
+)
+
+// Event is a structured audit record of a control-plane operation.
+type Event struct {
+	// ID is the unique identifier of the event.
+	ID string `json:"id"`
+
+	// Time is the time the event was recorded.
+	Time time.Time `json:"time"`
+
+	// Kind is the kind of event, either Request or OperationCompleted.
+	Kind string `json:"kind"`
+
+	// Principal is the name of the authenticated caller. Empty when authentication is not enabled.
+	Principal string `json:"principal,omitempty"`
+
+	// ResourceID is the ID of the resource the operation targets.
+	ResourceID string `json:"resourceId"`
+
+	// OperationType is the operation type that handled the request, e.g. APPLICATIONS.CORE/ENVIRONMENTS|PUT.
+	OperationType string `json:"operationType"`
+
+	// Action is the authorization action of the operation, e.g. applications.core/environments/write.
+	Action string `json:"action,omitempty"`
+
+	// Method is the HTTP method of the request.
+	Method string `json:"method,omitempty"`
+
+	// CorrelationID is the correlation ID of the request.
+	CorrelationID string `json:"correlationId,omitempty"`
+
+	// Traceparent is the W3C trace parent of the request.
+	Traceparent string `json:"traceparent,omitempty"`
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode,omitempty"`
+
+	// Outcome is the outcome of the request: Succeeded, Accepted, or Failed. For OperationCompleted events this is
+	// the final state of the asynchronous operation.
+	Outcome string `json:"outcome"`
+
+	// AsyncOperationID is the ID of the asynchronous operation started by the request.
+	AsyncOperationID string `json:"asyncOperationId,omitempty"`
+
+	// AsyncOperationState is the final state of the asynchronous operation reported by the worker.
+	AsyncOperationState string `json:"asyncOperationState,omitempty"`
+
+	// CompletedAt is the time the asynchronous operation completed.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+// IsAudited returns true if requests with the given HTTP method are recorded in the audit log.
+func IsAudited(method string) bool {
+	switch method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodPost:
+		return true
+	default:
+		return false
+	}
+}
+
+// Correlate merges OperationCompleted events into the Request events that started the same asynchronous operation,
+// and returns the events sorted by time. OperationCompleted events without a matching request are kept.
+func Correlate(events []Event) []Event {
+	completed := map[string]Event{}
+	for _, event := range events {
+		if event.Kind == KindOperationCompleted && event.AsyncOperationID != "" {
+			completed[event.AsyncOperationID] = event
+		}
+	}
+
+	results := []Event{}
+	matched := map[string]bool{}
+	for _, event := range events {
+		if event.Kind != KindRequest {
+			continue
+		}
+		if completion, ok := completed[event.AsyncOperationID]; ok && event.AsyncOperationID != "" {
+			completedAt := completion.Time
+			event.AsyncOperationState = completion.AsyncOperationState
+			event.CompletedAt = &completedAt
+			matched[event.AsyncOperationID] = true
+		}
+		results = append(results, event)
+	}
+
+	for _, event := range events {
+		if event.Kind == KindOperationCompleted && !matched[event.AsyncOperationID] {
+			results = append(results, event)
+		}
+	}
+
+	slices.SortStableFunc(results, func(a, b Event) int {
+		return a.Time.Compare(b.Time)
+	})
+	return results
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsAudited(t *testing.T) {
+	for _, method := range []string{http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodPost} {
+		require.True(t, IsAudited(method), method)
+	}
+	for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodOptions} {
+		require.False(t, IsAudited(method), method)
+	}
+}
+
+func Test_Correlate(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	events := []Event{
+		{ID: "completed", Time: start.Add(2 * time.Minute), Kind: KindOperationCompleted, AsyncOperationID: "op-1", AsyncOperationState: "Succeeded", Outcome: "Succeeded"},
+		{ID: "sync", Time: start.Add(time.Minute), Kind: KindRequest, Outcome: OutcomeSucceeded},
+		{ID: "async", Time: start, Kind: KindRequest, Outcome: OutcomeAccepted, AsyncOperationID: "op-1"},
+		{ID: "orphan", Time: start.Add(3 * time.Minute), Kind: KindOperationCompleted, AsyncOperationID: "op-2", AsyncOperationState: "Failed", Outcome: "Failed"},
+	}
+
+	results := Correlate(events)
+	require.Len(t, results, 3)
+
+	require.Equal(t, "async", results[0].ID)
+	require.Equal(t, "Succeeded", results[0].AsyncOperationState)
+	require.Equal(t, start.Add(2*time.Minute), *results[0].CompletedAt)
+
+	require.Equal(t, "sync", results[1].ID)
+	require.Empty(t, results[1].AsyncOperationState)
+	require.Nil(t, results[1].CompletedAt)
+
+	require.Equal(t, "orphan", results[2].ID)
+}
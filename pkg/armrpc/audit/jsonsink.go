@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONLinesSink writes each event as a single line of JSON.
+type JSONLinesSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+var _ Sink = (*JSONLinesSink)(nil)
+
+// NewJSONLinesSink creates a JSONLinesSink that writes to w.
+func NewJSONLinesSink(w io.Writer) *JSONLinesSink {
+	return &JSONLinesSink{w: w}
+}
+
+// Write implements Sink.
+func (s *JSONLinesSink) Write(ctx context.Context, event *Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(b, '\n'))
+	return err
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+)
+
+const (
+	// asyncOperationHeader is the response header that refers to the status of an asynchronous operation.
+	asyncOperationHeader = "Azure-AsyncOperation"
+)
+
+// ResponseRecorder wraps a http.ResponseWriter to capture the status code of the response.
+type ResponseRecorder struct {
+	http.ResponseWriter
+
+	// StatusCode is the status code written to the response.
+	StatusCode int
+}
+
+// NewResponseRecorder creates a ResponseRecorder.
+func NewResponseRecorder(w http.ResponseWriter) *ResponseRecorder {
+	return &ResponseRecorder{ResponseWriter: w}
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (r *ResponseRecorder) WriteHeader(statusCode int) {
+	if r.StatusCode == 0 {
+		r.StatusCode = statusCode
+	}
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write implements http.ResponseWriter.
+func (r *ResponseRecorder) Write(b []byte) (int, error) {
+	if r.StatusCode == 0 {
+		r.StatusCode = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the underlying http.ResponseWriter for http.ResponseController.
+func (r *ResponseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// RecordRequest writes a Request event for a request handled with the given operation type.
+func RecordRequest(ctx context.Context, sink Sink, req *http.Request, operationType v1.OperationType, recorder *ResponseRecorder) {
+	rpcCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The server responds with 200 OK if the handler didn't write a response.
+	if recorder.StatusCode == 0 {
+		recorder.StatusCode = http.StatusOK
+	}
+
+	event := &Event{
+		ID:            uuid.NewString(),
+		Time:          time.Now().UTC(),
+		Kind:          KindRequest,
+		OperationType: operationType.String(),
+		Method:        req.Method,
+		StatusCode:    recorder.StatusCode,
+		Outcome:       outcome(recorder.StatusCode),
+	}
+
+	if principal := authentication.PrincipalFromContext(ctx); principal != nil {
+		event.Principal = principal.Name
+	}
+
+	if rpcCtx != nil {
+		event.ResourceID = rpcCtx.ResourceID.String()
+		event.Action = authorization.ActionForOperation(operationType, req, rpcCtx.ResourceID)
+		event.CorrelationID = rpcCtx.CorrelationID
+		event.Traceparent = rpcCtx.Traceparent
+	}
+
+	if event.Outcome == OutcomeAccepted {
+		event.AsyncOperationID = asyncOperationID(recorder.Header().Get(asyncOperationHeader))
+	}
+
+	write(ctx, sink, event)
+}
+
+// RecordOperationCompleted writes an OperationCompleted event for an asynchronous operation that reached a final state.
+func RecordOperationCompleted(ctx context.Context, sink Sink, req *ctrl.Request, state v1.ProvisioningState) {
+	event := &Event{
+		ID:                  uuid.NewString(),
+		Time:                time.Now().UTC(),
+		Kind:                KindOperationCompleted,
+		ResourceID:          req.ResourceID,
+		OperationType:       req.OperationType,
+		CorrelationID:       req.CorrelationID,
+		Traceparent:         req.TraceparentID,
+		Outcome:             string(state),
+		AsyncOperationID:    req.OperationID.String(),
+		AsyncOperationState: string(state),
+	}
+
+	write(ctx, sink, event)
+}
+
+func outcome(statusCode int) string {
+	switch {
+	case statusCode == http.StatusAccepted:
+		return OutcomeAccepted
+	case statusCode >= 200 && statusCode < 300:
+		return OutcomeSucceeded
+	default:
+		return OutcomeFailed
+	}
+}
+
+// asyncOperationID returns the operation ID at the end of the operation status URL.
+func asyncOperationID(statusURL string) string {
+	if statusURL == "" {
+		return ""
+	}
+	u, err := url.Parse(statusURL)
+	if err != nil {
+		return ""
+	}
+	return path.Base(u.Path)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env0"
+
+func Test_ResponseRecorder(t *testing.T) {
+	w := httptest.NewRecorder()
+	recorder := NewResponseRecorder(w)
+	recorder.WriteHeader(http.StatusCreated)
+	_, err := recorder.Write([]byte("{}"))
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusCreated, recorder.StatusCode)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Same(t, w, recorder.Unwrap())
+
+	recorder = NewResponseRecorder(httptest.NewRecorder())
+	_, err = recorder.Write([]byte("{}"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, recorder.StatusCode)
+}
+
+func Test_RecordRequest(t *testing.T) {
+	id, err := resources.ParseResource(testResourceID)
+	require.NoError(t, err)
+	operationType := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationPut}
+
+	ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{
+		ResourceID:    id,
+		CorrelationID: "correlation",
+		Traceparent:   "traceparent",
+	})
+	ctx = authentication.WithPrincipal(ctx, &authentication.Principal{Name: "alice"})
+	req := httptest.NewRequest(http.MethodPut, testResourceID, nil).WithContext(ctx)
+
+	t.Run("accepted", func(t *testing.T) {
+		sink := &fakeSink{}
+		recorder := NewResponseRecorder(httptest.NewRecorder())
+		recorder.Header().Set("Azure-AsyncOperation", "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/op-1?api-version=2023-10-01-preview")
+		recorder.WriteHeader(http.StatusAccepted)
+
+		RecordRequest(ctx, sink, req, operationType, recorder)
+
+		require.Len(t, sink.events, 1)
+		event := sink.events[0]
+		_, err := uuid.Parse(event.ID)
+		require.NoError(t, err)
+		require.False(t, event.Time.IsZero())
+		require.Equal(t, KindRequest, event.Kind)
+		require.Equal(t, "alice", event.Principal)
+		require.Equal(t, testResourceID, event.ResourceID)
+		require.Equal(t, "APPLICATIONS.CORE/ENVIRONMENTS|PUT", event.OperationType)
+		require.Equal(t, "applications.core/environments/write", event.Action)
+		require.Equal(t, http.MethodPut, event.Method)
+		require.Equal(t, "correlation", event.CorrelationID)
+		require.Equal(t, "traceparent", event.Traceparent)
+		require.Equal(t, http.StatusAccepted, event.StatusCode)
+		require.Equal(t, OutcomeAccepted, event.Outcome)
+		require.Equal(t, "op-1", event.AsyncOperationID)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		sink := &fakeSink{}
+		recorder := NewResponseRecorder(httptest.NewRecorder())
+		recorder.WriteHeader(http.StatusBadRequest)
+
+		RecordRequest(ctx, sink, req, operationType, recorder)
+
+		require.Len(t, sink.events, 1)
+		require.Equal(t, OutcomeFailed, sink.events[0].Outcome)
+		require.Empty(t, sink.events[0].AsyncOperationID)
+	})
+
+	t.Run("no response written", func(t *testing.T) {
+		sink := &fakeSink{}
+		RecordRequest(ctx, sink, req, operationType, NewResponseRecorder(httptest.NewRecorder()))
+
+		require.Len(t, sink.events, 1)
+		require.Equal(t, http.StatusOK, sink.events[0].StatusCode)
+		require.Equal(t, OutcomeSucceeded, sink.events[0].Outcome)
+	})
+}
+
+func Test_RecordOperationCompleted(t *testing.T) {
+	sink := &fakeSink{}
+	operationID := uuid.New()
+
+	RecordOperationCompleted(context.Background(), sink, &ctrl.Request{
+		OperationID:   operationID,
+		OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+		ResourceID:    testResourceID,
+		CorrelationID: "correlation",
+		TraceparentID: "traceparent",
+	}, v1.ProvisioningStateFailed)
+
+	require.Len(t, sink.events, 1)
+	event := sink.events[0]
+	require.Equal(t, KindOperationCompleted, event.Kind)
+	require.Equal(t, testResourceID, event.ResourceID)
+	require.Equal(t, operationID.String(), event.AsyncOperationID)
+	require.Equal(t, string(v1.ProvisioningStateFailed), event.AsyncOperationState)
+	require.Equal(t, string(v1.ProvisioningStateFailed), event.Outcome)
+	require.Equal(t, "correlation", event.CorrelationID)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// SinkTypeStdout writes events to standard output as JSON lines.
+	SinkTypeStdout = "stdout"
+
+	// SinkTypeFile writes events to a file as JSON lines.
+	SinkTypeFile = "file"
+
+	// SinkTypeStore writes events to the data store where they can be queried.
+	SinkTypeStore = "store"
+)
+
+// Options represents the audit log configuration.
+type Options struct {
+	// Sinks is the list of destinations events are written to.
+	Sinks []SinkOptions `yaml:"sinks"`
+}
+
+// SinkOptions represents the configuration of an audit sink.
+type SinkOptions struct {
+	// Type is the type of sink: stdout, file, or store.
+	Type string `yaml:"type"`
+
+	// Path is the path of the file for the file sink. Events are appended to the file.
+	Path string `yaml:"path,omitempty"`
+}
+
+// Filter selects events from a queryable sink.
+type Filter struct {
+	// Scope is the plane or resource group scope to query, e.g. /planes/radius/local/resourceGroups/default.
+	Scope string
+
+	// ResourceID limits the results to events for the resource and its child resources.
+	ResourceID string
+
+	// Since limits the results to events recorded at or after this time.
+	Since time.Time
+
+	// Until limits the results to events recorded before this time.
+	Until time.Time
+}
+
+// Matches returns true if the event satisfies the resource and time filters.
+func (f Filter) Matches(event *Event) bool {
+	if f.ResourceID != "" {
+		id := strings.ToLower(event.ResourceID)
+		prefix := strings.TrimSuffix(strings.ToLower(f.ResourceID), "/")
+		if id != prefix && !strings.HasPrefix(id, prefix+"/") {
+			return false
+		}
+	}
+	if !f.Since.IsZero() && event.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !event.Time.Before(f.Until) {
+		return false
+	}
+	return true
+}
+
+// Sink is a destination for audit events.
+type Sink interface {
+	// Write records the event.
+	Write(ctx context.Context, event *Event) error
+}
+
+// Querier is implemented by sinks that can be queried.
+type Querier interface {
+	// Query returns the events matching the filter.
+	Query(ctx context.Context, filter Filter) ([]Event, error)
+}
+
+// NewSink creates the sink configured in options. It returns nil if auditing is not configured.
+func NewSink(ctx context.Context, options *Options, storageProvider dataprovider.DataStorageProvider) (Sink, error) {
+	if options == nil || len(options.Sinks) == 0 {
+		return nil, nil
+	}
+
+	sinks := multiSink{}
+	for _, sinkOptions := range options.Sinks {
+		switch sinkOptions.Type {
+		case SinkTypeStdout:
+			sinks = append(sinks, NewJSONLinesSink(os.Stdout))
+
+		case SinkTypeFile:
+			if sinkOptions.Path == "" {
+				return nil, errors.New("the file audit sink requires a path")
+			}
+			f, err := os.OpenFile(sinkOptions.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open audit log file: %w", err)
+			}
+			sinks = append(sinks, NewJSONLinesSink(f))
+
+		case SinkTypeStore:
+			client, err := storageProvider.GetStorageClient(ctx, ResourceType)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, NewStoreSink(client))
+
+		default:
+			return nil, fmt.Errorf("unsupported audit sink type %q", sinkOptions.Type)
+		}
+	}
+
+	if len(sinks) == 1 {
+		return sinks[0], nil
+	}
+	return sinks, nil
+}
+
+// multiSink writes events to several sinks.
+type multiSink []Sink
+
+// Write implements Sink. All sinks are written even if one of them fails.
+func (m multiSink) Write(ctx context.Context, event *Event) error {
+	errs := []error{}
+	for _, sink := range m {
+		if err := sink.Write(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type sinkKey struct{}
+
+// WithSink returns a middleware that stores the audit sink in the request context.
+func WithSink(sink Sink) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), sinkKey{}, sink)))
+		})
+	}
+}
+
+// FromContext returns the audit sink stored in the context, or nil if auditing is not enabled.
+func FromContext(ctx context.Context) Sink {
+	sink, ok := ctx.Value(sinkKey{}).(Sink)
+	if !ok {
+		return nil
+	}
+	return sink
+}
+
+// write records the event and logs failures. Audit failures never fail the operation being audited.
+func write(ctx context.Context, sink Sink, event *Event) {
+	if err := sink.Write(ctx, event); err != nil {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Error(err, "failed to write audit event", "resourceID", event.ResourceID, "operationType", event.OperationType)
+	}
+}
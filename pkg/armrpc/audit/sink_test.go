@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSink struct {
+	events []*Event
+	err    error
+}
+
+func (s *fakeSink) Write(ctx context.Context, event *Event) error {
+	s.events = append(s.events, event)
+	return s.err
+}
+
+func Test_Filter_Matches(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	event := &Event{
+		Time:       now,
+		ResourceID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/app",
+	}
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		expected bool
+	}{
+		{name: "empty", filter: Filter{}, expected: true},
+		{name: "same resource", filter: Filter{ResourceID: "/planes/radius/local/resourcegroups/RG/providers/Applications.Core/applications/app"}, expected: true},
+		{name: "parent resource", filter: Filter{ResourceID: "/planes/radius/local/resourceGroups/rg/"}, expected: true},
+		{name: "sibling resource", filter: Filter{ResourceID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/ap"}, expected: false},
+		{name: "since", filter: Filter{Since: now}, expected: true},
+		{name: "since later", filter: Filter{Since: now.Add(time.Second)}, expected: false},
+		{name: "until", filter: Filter{Until: now}, expected: false},
+		{name: "until later", filter: Filter{Until: now.Add(time.Second)}, expected: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.filter.Matches(event))
+		})
+	}
+}
+
+func Test_NewSink(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not configured", func(t *testing.T) {
+		sink, err := NewSink(ctx, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, sink)
+
+		sink, err = NewSink(ctx, &Options{}, nil)
+		require.NoError(t, err)
+		require.Nil(t, sink)
+	})
+
+	t.Run("stdout", func(t *testing.T) {
+		sink, err := NewSink(ctx, &Options{Sinks: []SinkOptions{{Type: SinkTypeStdout}}}, nil)
+		require.NoError(t, err)
+		require.IsType(t, &JSONLinesSink{}, sink)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		sink, err := NewSink(ctx, &Options{Sinks: []SinkOptions{{Type: SinkTypeFile, Path: path}}}, nil)
+		require.NoError(t, err)
+
+		err = sink.Write(ctx, &Event{ID: "1"})
+		require.NoError(t, err)
+
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Contains(t, string(b), `"id":"1"`)
+	})
+
+	t.Run("file without path", func(t *testing.T) {
+		_, err := NewSink(ctx, &Options{Sinks: []SinkOptions{{Type: SinkTypeFile}}}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("store and stdout", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		storageProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+		storageProvider.EXPECT().GetStorageClient(gomock.Any(), ResourceType).Return(store.NewMockStorageClient(mctrl), nil)
+
+		sink, err := NewSink(ctx, &Options{Sinks: []SinkOptions{{Type: SinkTypeStore}, {Type: SinkTypeStdout}}}, storageProvider)
+		require.NoError(t, err)
+		require.Len(t, sink, 2)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := NewSink(ctx, &Options{Sinks: []SinkOptions{{Type: "syslog"}}}, nil)
+		require.ErrorContains(t, err, "unsupported audit sink type")
+	})
+}
+
+func Test_multiSink(t *testing.T) {
+	failing := &fakeSink{err: errors.New("failed")}
+	succeeding := &fakeSink{}
+
+	err := multiSink{failing, succeeding}.Write(context.Background(), &Event{ID: "1"})
+	require.EqualError(t, err, "failed")
+	require.Len(t, failing.events, 1)
+	require.Len(t, succeeding.events, 1)
+}
+
+func Test_WithSink(t *testing.T) {
+	sink := &fakeSink{}
+
+	var found Sink
+	handler := WithSink(sink)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		found = FromContext(r.Context())
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPut, "/", nil))
+
+	require.Same(t, sink, found)
+	require.Nil(t, FromContext(context.Background()))
+}
+
+func Test_JSONLinesSink(t *testing.T) {
+	buf := &bytes.Buffer{}
+	sink := NewJSONLinesSink(buf)
+
+	require.NoError(t, sink.Write(context.Background(), &Event{ID: "1", Kind: KindRequest}))
+	require.NoError(t, sink.Write(context.Background(), &Event{ID: "2", Kind: KindOperationCompleted}))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	event := Event{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &event))
+	require.Equal(t, "2", event.ID)
+	require.Equal(t, KindOperationCompleted, event.Kind)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// ResourceType is the resource type under which audit events are saved in the data store.
+	ResourceType = "System.Audit/events"
+)
+
+// StoreSink saves events in the data store. Each event is saved under the root scope of its resource so that events
+// can be queried by plane or resource group.
+type StoreSink struct {
+	client store.StorageClient
+}
+
+var _ Sink = (*StoreSink)(nil)
+var _ Querier = (*StoreSink)(nil)
+
+// NewStoreSink creates a StoreSink.
+func NewStoreSink(client store.StorageClient) *StoreSink {
+	return &StoreSink{client: client}
+}
+
+// Write implements Sink.
+func (s *StoreSink) Write(ctx context.Context, event *Event) error {
+	if event.ResourceID == "" {
+		return errors.New("audit event has no resource ID")
+	}
+
+	id, err := resources.Parse(event.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	eventID, err := resources.Parse(id.RootScope() + "/providers/" + ResourceType + "/" + event.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.client.Save(ctx, &store.Object{
+		Metadata: store.Metadata{ID: eventID.String()},
+		Data:     event,
+	})
+}
+
+// Query implements Querier. Events are returned in the order they were recorded.
+func (s *StoreSink) Query(ctx context.Context, filter Filter) ([]Event, error) {
+	query := store.Query{
+		RootScope:      filter.Scope,
+		ScopeRecursive: true,
+		ResourceType:   ResourceType,
+	}
+
+	events := []Event{}
+	token := ""
+	for {
+		result, err := s.client.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			event := Event{}
+			if err := item.As(&event); err != nil {
+				return nil, err
+			}
+			if filter.Matches(&event) {
+				events = append(events, event)
+			}
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		token = result.PaginationToken
+	}
+
+	return Correlate(events), nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StoreSink_Write(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	client := store.NewMockStorageClient(mctrl)
+	sink := NewStoreSink(client)
+
+	event := &Event{
+		ID:         "7b9ac4e4-5a63-4d5d-9fd6-2c1e6c0c94a1",
+		ResourceID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/app",
+	}
+
+	client.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			require.Equal(t, "/planes/radius/local/resourceGroups/rg/providers/System.Audit/events/7b9ac4e4-5a63-4d5d-9fd6-2c1e6c0c94a1", obj.ID)
+			require.Same(t, event, obj.Data)
+			return nil
+		})
+
+	require.NoError(t, sink.Write(context.Background(), event))
+}
+
+func Test_StoreSink_Write_NoResourceID(t *testing.T) {
+	sink := NewStoreSink(store.NewMockStorageClient(gomock.NewController(t)))
+	require.Error(t, sink.Write(context.Background(), &Event{ID: "1"}))
+}
+
+func Test_StoreSink_Query(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	client := store.NewMockStorageClient(mctrl)
+	sink := NewStoreSink(client)
+
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	resourceID := "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/app"
+
+	expectedQuery := store.Query{RootScope: "/planes/radius/local/resourceGroups/rg", ScopeRecursive: true, ResourceType: ResourceType}
+	client.EXPECT().
+		Query(gomock.Any(), expectedQuery, gomock.Any()).
+		Return(&store.ObjectQueryResult{
+			Items: []store.Object{
+				*testutil.MustGetStoreObject(t, Event{ID: "old", Time: start.Add(-time.Hour), Kind: KindRequest, ResourceID: resourceID}),
+				*testutil.MustGetStoreObject(t, Event{ID: "request", Time: start, Kind: KindRequest, ResourceID: resourceID, Outcome: OutcomeAccepted, AsyncOperationID: "op-1"}),
+			},
+			PaginationToken: "next",
+		}, nil)
+	client.EXPECT().
+		Query(gomock.Any(), expectedQuery, gomock.Any()).
+		Return(&store.ObjectQueryResult{
+			Items: []store.Object{
+				*testutil.MustGetStoreObject(t, Event{ID: "completed", Time: start.Add(time.Minute), Kind: KindOperationCompleted, ResourceID: resourceID, AsyncOperationID: "op-1", AsyncOperationState: "Succeeded"}),
+			},
+		}, nil)
+
+	events, err := sink.Query(context.Background(), Filter{Scope: "/planes/radius/local/resourceGroups/rg", Since: start})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "request", events[0].ID)
+	require.Equal(t, start, events[0].Time)
+	require.Equal(t, "Succeeded", events[0].AsyncOperationState)
+	require.Equal(t, start.Add(time.Minute), *events[0].CompletedAt)
+}
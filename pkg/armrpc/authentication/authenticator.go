@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// MethodOIDC is the authentication method for OpenID Connect bearer tokens.
+	MethodOIDC = "OIDC"
+
+	// MethodKubernetes is the authentication method for tokens validated with the Kubernetes TokenReview API.
+	MethodKubernetes = "Kubernetes"
+
+	// MethodClientCertificate is the authentication method for TLS client certificates.
+	MethodClientCertificate = "ClientCertificate"
+
+	// MethodAnonymous is the authentication method for requests without credentials.
+	MethodAnonymous = "Anonymous"
+)
+
+var (
+	// ErrNoCredentials is returned by an Authenticator when the request does not carry credentials
+	// the authenticator understands. The next authenticator in the chain will be tried.
+	ErrNoCredentials = errors.New("the request does not contain credentials for this authenticator")
+)
+
+// Authenticator identifies the caller of a request.
+type Authenticator interface {
+	// Authenticate returns the principal of the request. Implementations return ErrNoCredentials
+	// when the request carries no credentials of the kind they handle, and any other error when
+	// the credentials are present but invalid.
+	Authenticate(req *http.Request) (*Principal, error)
+}
+
+// Options represents the authentication configuration of a server. Each configured method is tried in the order
+// OIDC, Kubernetes, ClientCertificate.
+type Options struct {
+	// OIDC configures validation of OpenID Connect bearer tokens.
+	OIDC *OIDCOptions `yaml:"oidc,omitempty"`
+
+	// Kubernetes configures validation of bearer tokens with the Kubernetes TokenReview API.
+	Kubernetes *KubernetesOptions `yaml:"kubernetes,omitempty"`
+
+	// ClientCertificate configures authentication with TLS client certificates.
+	ClientCertificate *ClientCertificateOptions `yaml:"clientCertificate,omitempty"`
+
+	// Anonymous allows requests without any credentials. These requests are assigned the
+	// system:anonymous principal in the system:unauthenticated group.
+	Anonymous bool `yaml:"anonymous,omitempty"`
+}
+
+// NewAuthenticators creates the authenticators configured in options. kubeConfig is only used when
+// Kubernetes authentication is configured.
+func NewAuthenticators(options *Options, kubeConfig *rest.Config) ([]Authenticator, error) {
+	if options == nil {
+		return nil, nil
+	}
+
+	authenticators := []Authenticator{}
+	if options.OIDC != nil {
+		authenticator, err := NewOIDCAuthenticator(*options.OIDC)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, authenticator)
+	}
+
+	if options.Kubernetes != nil {
+		if kubeConfig == nil {
+			return nil, errors.New("kubernetes authentication requires a kubernetes configuration")
+		}
+		clientset, err := kubernetes.NewForConfig(kubeConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+		}
+		authenticators = append(authenticators, NewTokenReviewAuthenticator(clientset.AuthenticationV1().TokenReviews(), options.Kubernetes.Audiences))
+	}
+
+	if options.ClientCertificate != nil {
+		authenticator, err := NewClientCertificateAuthenticator(*options.ClientCertificate)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, authenticator)
+	}
+
+	if len(authenticators) == 0 && !options.Anonymous {
+		return nil, errors.New("authentication is configured but no authentication method is enabled")
+	}
+
+	if options.Anonymous {
+		authenticators = append(authenticators, &anonymousAuthenticator{})
+	}
+
+	return authenticators, nil
+}
+
+// Authenticate returns a middleware that identifies the caller of each request using the given authenticators
+// and stores the principal in the request context. Requests that cannot be authenticated are rejected with
+// 401 Unauthorized. The health and version endpoints are not authenticated.
+func Authenticate(authenticators []Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/version" || r.URL.Path == "/healthz" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			logger := ucplog.FromContextOrDiscard(r.Context())
+			for _, authenticator := range authenticators {
+				principal, err := authenticator.Authenticate(r)
+				if errors.Is(err, ErrNoCredentials) {
+					continue
+				} else if err != nil {
+					logger.V(ucplog.LevelDebug).Info("request authentication failed", "error", err.Error())
+					handleErr(r.Context(), w, r)
+					return
+				}
+
+				next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+				return
+			}
+
+			logger.V(ucplog.LevelDebug).Info("request does not contain valid credentials")
+			handleErr(r.Context(), w, r)
+		})
+	}
+}
+
+// bearerToken returns the bearer token of the request, or an empty string if there is none.
+func bearerToken(req *http.Request) string {
+	header := req.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// hasCredentials returns true if the request carries a bearer token or a TLS client certificate.
+func hasCredentials(req *http.Request) bool {
+	return req.Header.Get("Authorization") != "" || (req.TLS != nil && len(req.TLS.PeerCertificates) > 0)
+}
+
+// anonymousAuthenticator assigns the anonymous principal to requests without credentials. Requests
+// that carry credentials no other authenticator accepted are still rejected.
+type anonymousAuthenticator struct{}
+
+// Authenticate implements Authenticator.
+func (a *anonymousAuthenticator) Authenticate(req *http.Request) (*Principal, error) {
+	if hasCredentials(req) {
+		return nil, ErrNoCredentials
+	}
+	return &Principal{Name: AnonymousName, Groups: []string{UnauthenticatedGroup}, Method: MethodAnonymous}, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAuthenticator struct {
+	token     string
+	principal *Principal
+}
+
+func (f *fakeAuthenticator) Authenticate(req *http.Request) (*Principal, error) {
+	token := bearerToken(req)
+	if token == "" {
+		return nil, ErrNoCredentials
+	} else if token != f.token {
+		return nil, errors.New("invalid token")
+	}
+	return f.principal, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	alice := &Principal{Name: "alice", Groups: []string{AuthenticatedGroup}}
+
+	tests := []struct {
+		name           string
+		path           string
+		token          string
+		authenticators []Authenticator
+		expectedStatus int
+		expected       *Principal
+	}{
+		{
+			name:           "authenticated",
+			token:          "alice-token",
+			authenticators: []Authenticator{&fakeAuthenticator{token: "alice-token", principal: alice}},
+			expectedStatus: http.StatusOK,
+			expected:       alice,
+		},
+		{
+			name:           "invalid credentials",
+			token:          "mallory-token",
+			authenticators: []Authenticator{&fakeAuthenticator{token: "alice-token", principal: alice}},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "no credentials",
+			authenticators: []Authenticator{&fakeAuthenticator{token: "alice-token", principal: alice}},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "anonymous",
+			authenticators: []Authenticator{&fakeAuthenticator{token: "alice-token", principal: alice}, &anonymousAuthenticator{}},
+			expectedStatus: http.StatusOK,
+			expected:       &Principal{Name: AnonymousName, Groups: []string{UnauthenticatedGroup}, Method: MethodAnonymous},
+		},
+		{
+			name:           "anonymous does not accept invalid credentials",
+			token:          "mallory-token",
+			authenticators: []Authenticator{&fakeAuthenticator{token: "alice-token", principal: alice}, &anonymousAuthenticator{}},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "health endpoint",
+			path:           "/healthz",
+			authenticators: []Authenticator{&fakeAuthenticator{token: "alice-token", principal: alice}},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *Principal
+			handler := Authenticate(tc.authenticators)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actual = PrincipalFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			path := tc.path
+			if path == "" {
+				path = "/planes/radius/local/resourcegroups/rg"
+			}
+			req := newBearerRequest(t, tc.token)
+			req.URL.Path = path
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedStatus, w.Code)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestNewAuthenticators(t *testing.T) {
+	authenticators, err := NewAuthenticators(nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, authenticators)
+
+	_, err = NewAuthenticators(&Options{}, nil)
+	require.ErrorContains(t, err, "no authentication method is enabled")
+
+	_, err = NewAuthenticators(&Options{Kubernetes: &KubernetesOptions{}}, nil)
+	require.ErrorContains(t, err, "requires a kubernetes configuration")
+
+	authenticators, err = NewAuthenticators(&Options{OIDC: &OIDCOptions{Issuer: "https://issuer", Audience: "radius"}, Anonymous: true}, nil)
+	require.NoError(t, err)
+	require.Len(t, authenticators, 2)
+	require.IsType(t, &OIDCAuthenticator{}, authenticators[0])
+	require.IsType(t, &anonymousAuthenticator{}, authenticators[1])
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+)
+
+const (
+	// RemoteUserHeader is the header an authenticating proxy uses to pass the name of the user.
+	RemoteUserHeader = "X-Remote-User"
+
+	// RemoteGroupHeader is the header an authenticating proxy uses to pass the groups of the user.
+	RemoteGroupHeader = "X-Remote-Group"
+)
+
+// ClientCertificateOptions represents the configuration of TLS client certificate authentication.
+type ClientCertificateOptions struct {
+	// CAFile is the path of the PEM encoded bundle of certificate authorities that issue client certificates.
+	CAFile string `yaml:"caFile"`
+
+	// ProxyNames is the list of certificate common names of authenticating proxies, such as the Kubernetes API server
+	// aggregation layer. Requests from these proxies are attributed to the user in the X-Remote-User and
+	// X-Remote-Group headers instead of the certificate subject.
+	ProxyNames []string `yaml:"proxyNames,omitempty"`
+}
+
+// ClientCertificateAuthenticator authenticates requests with the TLS client certificate presented to the server.
+// The common name of the certificate subject is the name of the principal and its organizations are the groups.
+type ClientCertificateAuthenticator struct {
+	roots      *x509.CertPool
+	proxyNames []string
+}
+
+var _ Authenticator = (*ClientCertificateAuthenticator)(nil)
+
+// NewClientCertificateAuthenticator creates a ClientCertificateAuthenticator from the configured CA bundle.
+func NewClientCertificateAuthenticator(options ClientCertificateOptions) (*ClientCertificateAuthenticator, error) {
+	if options.CAFile == "" {
+		return nil, errors.New("client certificate authentication requires a CA file")
+	}
+
+	b, err := os.ReadFile(options.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the client CA file: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("the client CA file %q does not contain any certificates", options.CAFile)
+	}
+
+	return &ClientCertificateAuthenticator{roots: roots, proxyNames: options.ProxyNames}, nil
+}
+
+// Authenticate implements Authenticator.
+func (a *ClientCertificateAuthenticator) Authenticate(req *http.Request) (*Principal, error) {
+	if req.TLS == nil || len(req.TLS.PeerCertificates) == 0 {
+		return nil, ErrNoCredentials
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range req.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	leaf := req.TLS.PeerCertificates[0]
+	_, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         a.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid client certificate: %w", err)
+	}
+
+	if leaf.Subject.CommonName == "" {
+		return nil, errors.New("invalid client certificate: subject common name is missing")
+	}
+
+	if slices.Contains(a.proxyNames, leaf.Subject.CommonName) {
+		user := req.Header.Get(RemoteUserHeader)
+		if user == "" {
+			return nil, fmt.Errorf("request from proxy %q is missing the %s header", leaf.Subject.CommonName, RemoteUserHeader)
+		}
+		return &Principal{
+			Name:   user,
+			Groups: append(append([]string{}, req.Header.Values(RemoteGroupHeader)...), AuthenticatedGroup),
+			Method: MethodClientCertificate,
+		}, nil
+	}
+
+	return &Principal{
+		Name:   leaf.Subject.CommonName,
+		Groups: append(append([]string{}, leaf.Subject.Organization...), AuthenticatedGroup),
+		Method: MethodClientCertificate,
+	}, nil
+}
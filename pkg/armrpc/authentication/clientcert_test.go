@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) issue(t *testing.T, subject pkix.Name, usage x509.ExtKeyUsage) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      subject,
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func (ca *testCA) writeFile(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "ca.crt")
+	err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}), 0600)
+	require.NoError(t, err)
+	return path
+}
+
+func newTLSRequest(t *testing.T, certs ...*x509.Certificate) *http.Request {
+	req, err := http.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+	require.NoError(t, err)
+	req.TLS = &tls.ConnectionState{PeerCertificates: certs}
+	return req
+}
+
+func TestClientCertificateAuthenticator(t *testing.T) {
+	ca := newTestCA(t)
+	authenticator, err := NewClientCertificateAuthenticator(ClientCertificateOptions{CAFile: ca.writeFile(t)})
+	require.NoError(t, err)
+
+	t.Run("valid certificate", func(t *testing.T) {
+		cert := ca.issue(t, pkix.Name{CommonName: "ci-pipeline", Organization: []string{"team-a"}}, x509.ExtKeyUsageClientAuth)
+
+		principal, err := authenticator.Authenticate(newTLSRequest(t, cert))
+		require.NoError(t, err)
+		require.Equal(t, &Principal{Name: "ci-pipeline", Groups: []string{"team-a", AuthenticatedGroup}, Method: MethodClientCertificate}, principal)
+	})
+
+	t.Run("certificate from another CA", func(t *testing.T) {
+		cert := newTestCA(t).issue(t, pkix.Name{CommonName: "ci-pipeline"}, x509.ExtKeyUsageClientAuth)
+
+		_, err := authenticator.Authenticate(newTLSRequest(t, cert))
+		require.ErrorContains(t, err, "invalid client certificate")
+	})
+
+	t.Run("server certificate", func(t *testing.T) {
+		cert := ca.issue(t, pkix.Name{CommonName: "ci-pipeline"}, x509.ExtKeyUsageServerAuth)
+
+		_, err := authenticator.Authenticate(newTLSRequest(t, cert))
+		require.ErrorContains(t, err, "invalid client certificate")
+	})
+
+	t.Run("no certificate", func(t *testing.T) {
+		_, err := authenticator.Authenticate(newTLSRequest(t))
+		require.ErrorIs(t, err, ErrNoCredentials)
+
+		_, err = authenticator.Authenticate(newBearerRequest(t, ""))
+		require.ErrorIs(t, err, ErrNoCredentials)
+	})
+}
+
+func TestClientCertificateAuthenticator_Proxy(t *testing.T) {
+	ca := newTestCA(t)
+	authenticator, err := NewClientCertificateAuthenticator(ClientCertificateOptions{CAFile: ca.writeFile(t), ProxyNames: []string{"front-proxy-client"}})
+	require.NoError(t, err)
+	proxy := ca.issue(t, pkix.Name{CommonName: "front-proxy-client"}, x509.ExtKeyUsageClientAuth)
+
+	t.Run("user from headers", func(t *testing.T) {
+		req := newTLSRequest(t, proxy)
+		req.Header.Set(RemoteUserHeader, "alice")
+		req.Header.Add(RemoteGroupHeader, "team-a")
+		req.Header.Add(RemoteGroupHeader, "system:authenticated")
+
+		principal, err := authenticator.Authenticate(req)
+		require.NoError(t, err)
+		require.Equal(t, &Principal{Name: "alice", Groups: []string{"team-a", "system:authenticated", AuthenticatedGroup}, Method: MethodClientCertificate}, principal)
+	})
+
+	t.Run("missing user header", func(t *testing.T) {
+		_, err := authenticator.Authenticate(newTLSRequest(t, proxy))
+		require.ErrorContains(t, err, "missing the X-Remote-User header")
+	})
+
+	t.Run("headers are ignored for other certificates", func(t *testing.T) {
+		req := newTLSRequest(t, ca.issue(t, pkix.Name{CommonName: "ci-pipeline"}, x509.ExtKeyUsageClientAuth))
+		req.Header.Set(RemoteUserHeader, "admin")
+
+		principal, err := authenticator.Authenticate(req)
+		require.NoError(t, err)
+		require.Equal(t, "ci-pipeline", principal.Name)
+	})
+}
+
+func TestNewClientCertificateAuthenticator_Invalid(t *testing.T) {
+	_, err := NewClientCertificateAuthenticator(ClientCertificateOptions{})
+	require.ErrorContains(t, err, "requires a CA file")
+
+	path := filepath.Join(t.TempDir(), "empty.crt")
+	require.NoError(t, os.WriteFile(path, []byte("not a certificate"), 0600))
+	_, err = NewClientCertificateAuthenticator(ClientCertificateOptions{CAFile: path})
+	require.ErrorContains(t, err, "does not contain any certificates")
+}
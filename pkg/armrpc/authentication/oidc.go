@@ -0,0 +1,224 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	defaultUsernameClaim = "sub"
+	defaultGroupsClaim   = "groups"
+
+	// defaultOIDCPrefix is the default prefix of the names and groups of OIDC principals. This prevents the
+	// claims of a token from matching the names and groups of principals authenticated by other methods.
+	defaultOIDCPrefix = "oidc:"
+
+	// noPrefix disables the prefix of the names or groups of OIDC principals.
+	noPrefix = "-"
+
+	// reservedPrefix is the prefix of names and groups that are reserved for system principals, such as
+	// Kubernetes service accounts and the system:authenticated group.
+	reservedPrefix = "system:"
+)
+
+// OIDCOptions represents the configuration of OpenID Connect bearer token authentication.
+type OIDCOptions struct {
+	// Issuer is the URL of the token issuer. Tokens must carry this value in the iss claim.
+	Issuer string `yaml:"issuer"`
+
+	// Audience is the expected value of the aud claim.
+	Audience string `yaml:"audience"`
+
+	// JWKSURL is the URL of the JSON Web Key Set of the issuer. If not set, it is discovered from
+	// the OpenID configuration document of the issuer.
+	JWKSURL string `yaml:"jwksURL,omitempty"`
+
+	// UsernameClaim is the claim used as the name of the principal. Defaults to "sub".
+	UsernameClaim string `yaml:"usernameClaim,omitempty"`
+
+	// GroupsClaim is the claim used as the groups of the principal. Defaults to "groups".
+	GroupsClaim string `yaml:"groupsClaim,omitempty"`
+
+	// UsernamePrefix is prepended to the name of the principal. Defaults to "oidc:", use "-" to disable the prefix.
+	UsernamePrefix string `yaml:"usernamePrefix,omitempty"`
+
+	// GroupsPrefix is prepended to the groups of the principal. Defaults to "oidc:", use "-" to disable the prefix.
+	GroupsPrefix string `yaml:"groupsPrefix,omitempty"`
+}
+
+// OIDCAuthenticator authenticates requests with bearer tokens issued by an OpenID Connect provider.
+type OIDCAuthenticator struct {
+	options OIDCOptions
+	client  *http.Client
+
+	mu   sync.Mutex
+	keys *keySet
+}
+
+var _ Authenticator = (*OIDCAuthenticator)(nil)
+
+// NewOIDCAuthenticator creates an OIDCAuthenticator. The key set of the issuer is fetched on the first request.
+func NewOIDCAuthenticator(options OIDCOptions) (*OIDCAuthenticator, error) {
+	if options.Issuer == "" {
+		return nil, errors.New("oidc issuer is required")
+	}
+	if options.Audience == "" {
+		return nil, errors.New("oidc audience is required")
+	}
+	if options.UsernameClaim == "" {
+		options.UsernameClaim = defaultUsernameClaim
+	}
+	if options.GroupsClaim == "" {
+		options.GroupsClaim = defaultGroupsClaim
+	}
+	options.UsernamePrefix = oidcPrefix(options.UsernamePrefix)
+	options.GroupsPrefix = oidcPrefix(options.GroupsPrefix)
+
+	return &OIDCAuthenticator{
+		options: options,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Authenticate implements Authenticator. Bearer tokens that were not issued by the configured issuer
+// are left to the other authenticators.
+func (a *OIDCAuthenticator) Authenticate(req *http.Request) (*Principal, error) {
+	token := bearerToken(req)
+	if token == "" {
+		return nil, ErrNoCredentials
+	}
+
+	unverified := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, unverified); err != nil {
+		return nil, ErrNoCredentials
+	}
+	if issuer, _ := unverified["iss"].(string); issuer != a.options.Issuer {
+		return nil, ErrNoCredentials
+	}
+
+	keys, err := a.keySet(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}))
+	_, err = parser.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return keys.key(req.Context(), kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	if !claims.VerifyIssuer(a.options.Issuer, true) {
+		return nil, errors.New("invalid token: unexpected issuer")
+	}
+	if !claims.VerifyAudience(a.options.Audience, true) {
+		return nil, errors.New("invalid token: unexpected audience")
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("invalid token: token is expired or has no expiry")
+	}
+
+	name, _ := claims[a.options.UsernameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("invalid token: claim %q is missing", a.options.UsernameClaim)
+	}
+
+	name = a.options.UsernamePrefix + name
+	if strings.HasPrefix(name, reservedPrefix) {
+		return nil, fmt.Errorf("invalid token: the name %q is reserved for system principals", name)
+	}
+
+	claimed := []string{}
+	switch value := claims[a.options.GroupsClaim].(type) {
+	case string:
+		claimed = append(claimed, value)
+	case []any:
+		for _, group := range value {
+			if s, ok := group.(string); ok {
+				claimed = append(claimed, s)
+			}
+		}
+	}
+
+	// Groups that are reserved for system principals are ignored rather than rejected, identity providers
+	// may return groups that are not intended for Radius.
+	groups := []string{}
+	for _, group := range claimed {
+		group = a.options.GroupsPrefix + group
+		if !strings.HasPrefix(group, reservedPrefix) {
+			groups = append(groups, group)
+		}
+	}
+
+	return &Principal{
+		Name:   name,
+		Groups: append(groups, AuthenticatedGroup),
+		Method: MethodOIDC,
+	}, nil
+}
+
+// oidcPrefix returns the prefix to use for the names or groups of OIDC principals from the configured value.
+func oidcPrefix(configured string) string {
+	switch configured {
+	case "":
+		return defaultOIDCPrefix
+	case noPrefix:
+		return ""
+	}
+
+	return configured
+}
+
+// keySet returns the key set of the issuer, discovering its URL if it is not configured.
+func (a *OIDCAuthenticator) keySet(ctx context.Context) (*keySet, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.keys != nil {
+		return a.keys, nil
+	}
+
+	url := a.options.JWKSURL
+	if url == "" {
+		discovery := struct {
+			JWKSURI string `json:"jwks_uri"`
+		}{}
+		configURL := strings.TrimSuffix(a.options.Issuer, "/") + "/.well-known/openid-configuration"
+		if err := getJSON(ctx, a.client, configURL, &discovery); err != nil {
+			return nil, fmt.Errorf("failed to discover the oidc configuration: %w", err)
+		}
+		if discovery.JWKSURI == "" {
+			return nil, errors.New("the oidc configuration does not contain a jwks_uri")
+		}
+		url = discovery.JWKSURI
+	}
+
+	a.keys = newKeySet(url, a.client)
+	return a.keys, nil
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+const testAudience = "radius"
+
+// testIssuer is a local stand-in for an OpenID Connect provider that serves a discovery document and a JWKS.
+type testIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+
+	jwksRequests int
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	issuer := &testIssuer{key: key, kid: "key-1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": issuer.server.URL, "jwks_uri": issuer.server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		issuer.jwksRequests++
+		_ = json.NewEncoder(w).Encode(jsonWebKeySet{Keys: []jsonWebKey{
+			{
+				KeyType: "RSA",
+				KeyID:   issuer.kid,
+				Use:     "sig",
+				N:       base64.RawURLEncoding.EncodeToString(issuer.key.N.Bytes()),
+				E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(issuer.key.E)).Bytes()),
+			},
+		}})
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+
+	return issuer
+}
+
+func (i *testIssuer) token(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = i.kid
+	signed, err := token.SignedString(i.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func (i *testIssuer) claims(subject string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss": i.server.URL,
+		"aud": testAudience,
+		"sub": subject,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func newBearerRequest(t *testing.T, token string) *http.Request {
+	req, err := http.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return req
+}
+
+func TestOIDCAuthenticator(t *testing.T) {
+	issuer := newTestIssuer(t)
+	authenticator, err := NewOIDCAuthenticator(OIDCOptions{Issuer: issuer.server.URL, Audience: testAudience})
+	require.NoError(t, err)
+
+	t.Run("valid token", func(t *testing.T) {
+		claims := issuer.claims("alice")
+		claims["groups"] = []string{"team-a"}
+
+		principal, err := authenticator.Authenticate(newBearerRequest(t, issuer.token(t, claims)))
+		require.NoError(t, err)
+		require.Equal(t, &Principal{Name: "oidc:alice", Groups: []string{"oidc:team-a", AuthenticatedGroup}, Method: MethodOIDC}, principal)
+	})
+
+	t.Run("system names and groups", func(t *testing.T) {
+		// The prefix prevents the claims from matching the names and groups of system principals.
+		claims := issuer.claims("system:serviceaccount:radius-system:applications-rp")
+		claims["groups"] = []string{"system:masters"}
+
+		principal, err := authenticator.Authenticate(newBearerRequest(t, issuer.token(t, claims)))
+		require.NoError(t, err)
+		require.Equal(t, &Principal{Name: "oidc:system:serviceaccount:radius-system:applications-rp", Groups: []string{"oidc:system:masters", AuthenticatedGroup}, Method: MethodOIDC}, principal)
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		_, err := authenticator.Authenticate(newBearerRequest(t, ""))
+		require.ErrorIs(t, err, ErrNoCredentials)
+	})
+
+	t.Run("token from another issuer", func(t *testing.T) {
+		claims := issuer.claims("alice")
+		claims["iss"] = "https://kubernetes.default.svc"
+
+		_, err := authenticator.Authenticate(newBearerRequest(t, issuer.token(t, claims)))
+		require.ErrorIs(t, err, ErrNoCredentials)
+	})
+
+	t.Run("not a jwt", func(t *testing.T) {
+		_, err := authenticator.Authenticate(newBearerRequest(t, "opaque-token"))
+		require.ErrorIs(t, err, ErrNoCredentials)
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		claims := issuer.claims("alice")
+		claims["aud"] = "someone-else"
+
+		_, err := authenticator.Authenticate(newBearerRequest(t, issuer.token(t, claims)))
+		require.ErrorContains(t, err, "unexpected audience")
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		claims := issuer.claims("alice")
+		claims["exp"] = time.Now().Add(-time.Hour).Unix()
+
+		_, err := authenticator.Authenticate(newBearerRequest(t, issuer.token(t, claims)))
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrNoCredentials)
+	})
+
+	t.Run("missing expiry", func(t *testing.T) {
+		claims := issuer.claims("alice")
+		delete(claims, "exp")
+
+		_, err := authenticator.Authenticate(newBearerRequest(t, issuer.token(t, claims)))
+		require.ErrorContains(t, err, "no expiry")
+	})
+
+	t.Run("signed with an unknown key", func(t *testing.T) {
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, issuer.claims("mallory"))
+		token.Header["kid"] = issuer.kid
+		signed, err := token.SignedString(other)
+		require.NoError(t, err)
+
+		_, err = authenticator.Authenticate(newBearerRequest(t, signed))
+		require.ErrorContains(t, err, "invalid token")
+	})
+
+	t.Run("unsigned token", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodNone, issuer.claims("mallory"))
+		signed, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+
+		_, err = authenticator.Authenticate(newBearerRequest(t, signed))
+		require.ErrorContains(t, err, "invalid token")
+	})
+}
+
+func TestOIDCAuthenticator_KeyRotation(t *testing.T) {
+	issuer := newTestIssuer(t)
+	authenticator, err := NewOIDCAuthenticator(OIDCOptions{Issuer: issuer.server.URL, Audience: testAudience, JWKSURL: issuer.server.URL + "/keys"})
+	require.NoError(t, err)
+
+	_, err = authenticator.Authenticate(newBearerRequest(t, issuer.token(t, issuer.claims("alice"))))
+	require.NoError(t, err)
+	require.Equal(t, 1, issuer.jwksRequests)
+
+	// The issuer rotates its signing key.
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issuer.key = key
+	issuer.kid = "key-2"
+
+	// The key set is not fetched again before the refresh interval elapses.
+	_, err = authenticator.Authenticate(newBearerRequest(t, issuer.token(t, issuer.claims("alice"))))
+	require.ErrorContains(t, err, "key-2")
+	require.Equal(t, 1, issuer.jwksRequests)
+
+	old := jwksRefreshInterval
+	jwksRefreshInterval = 0
+	t.Cleanup(func() { jwksRefreshInterval = old })
+
+	principal, err := authenticator.Authenticate(newBearerRequest(t, issuer.token(t, issuer.claims("alice"))))
+	require.NoError(t, err)
+	require.Equal(t, "oidc:alice", principal.Name)
+	require.Equal(t, 2, issuer.jwksRequests)
+}
+
+func TestOIDCAuthenticator_Prefix(t *testing.T) {
+	issuer := newTestIssuer(t)
+
+	t.Run("custom prefix", func(t *testing.T) {
+		authenticator, err := NewOIDCAuthenticator(OIDCOptions{Issuer: issuer.server.URL, Audience: testAudience, UsernamePrefix: "corp#", GroupsPrefix: "corp:"})
+		require.NoError(t, err)
+
+		claims := issuer.claims("alice")
+		claims["groups"] = []string{"team-a"}
+
+		principal, err := authenticator.Authenticate(newBearerRequest(t, issuer.token(t, claims)))
+		require.NoError(t, err)
+		require.Equal(t, &Principal{Name: "corp#alice", Groups: []string{"corp:team-a", AuthenticatedGroup}, Method: MethodOIDC}, principal)
+	})
+
+	t.Run("no prefix", func(t *testing.T) {
+		authenticator, err := NewOIDCAuthenticator(OIDCOptions{Issuer: issuer.server.URL, Audience: testAudience, UsernamePrefix: "-", GroupsPrefix: "-"})
+		require.NoError(t, err)
+
+		claims := issuer.claims("alice")
+		claims["groups"] = []string{"team-a", "system:masters"}
+
+		// Groups that are reserved for system principals are ignored.
+		principal, err := authenticator.Authenticate(newBearerRequest(t, issuer.token(t, claims)))
+		require.NoError(t, err)
+		require.Equal(t, &Principal{Name: "alice", Groups: []string{"team-a", AuthenticatedGroup}, Method: MethodOIDC}, principal)
+
+		// Names that are reserved for system principals are rejected.
+		_, err = authenticator.Authenticate(newBearerRequest(t, issuer.token(t, issuer.claims("system:anonymous"))))
+		require.ErrorContains(t, err, `the name "system:anonymous" is reserved for system principals`)
+	})
+}
+
+func TestNewOIDCAuthenticator_Invalid(t *testing.T) {
+	_, err := NewOIDCAuthenticator(OIDCOptions{Audience: testAudience})
+	require.ErrorContains(t, err, "issuer is required")
+
+	_, err = NewOIDCAuthenticator(OIDCOptions{Issuer: "https://issuer"})
+	require.ErrorContains(t, err, "audience is required")
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+)
+
+const (
+	// AnonymousName is the name of the principal assigned to requests without credentials when anonymous access is enabled.
+	AnonymousName = "system:anonymous"
+
+	// AuthenticatedGroup is the group that every authenticated principal belongs to.
+	AuthenticatedGroup = "system:authenticated"
+
+	// UnauthenticatedGroup is the group that the anonymous principal belongs to.
+	UnauthenticatedGroup = "system:unauthenticated"
+)
+
+// Principal represents the identity of the caller of a request.
+type Principal struct {
+	// Name is the name of the user, service account, or client certificate subject.
+	Name string
+
+	// Groups is the list of groups the principal belongs to.
+	Groups []string
+
+	// Method is the authentication method that identified the principal.
+	Method string
+}
+
+// IsAnonymous returns true if the principal represents an unauthenticated caller.
+func (p *Principal) IsAnonymous() bool {
+	return p.Name == AnonymousName
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of the context with the given principal.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal stored in the context, or nil if the request was not authenticated.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	if !ok {
+		return nil
+	}
+	return principal
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authenticationv1client "k8s.io/client-go/kubernetes/typed/authentication/v1"
+)
+
+// KubernetesOptions represents the configuration of Kubernetes TokenReview authentication.
+type KubernetesOptions struct {
+	// Audiences is the list of audiences the token must be issued for. If empty, the audience of the
+	// Kubernetes API server is used.
+	Audiences []string `yaml:"audiences,omitempty"`
+}
+
+// TokenReviewAuthenticator authenticates requests with bearer tokens validated by the Kubernetes TokenReview API.
+type TokenReviewAuthenticator struct {
+	client    authenticationv1client.TokenReviewInterface
+	audiences []string
+}
+
+var _ Authenticator = (*TokenReviewAuthenticator)(nil)
+
+// NewTokenReviewAuthenticator creates a TokenReviewAuthenticator.
+func NewTokenReviewAuthenticator(client authenticationv1client.TokenReviewInterface, audiences []string) *TokenReviewAuthenticator {
+	return &TokenReviewAuthenticator{client: client, audiences: audiences}
+}
+
+// Authenticate implements Authenticator.
+func (a *TokenReviewAuthenticator) Authenticate(req *http.Request) (*Principal, error) {
+	token := bearerToken(req)
+	if token == "" {
+		return nil, ErrNoCredentials
+	}
+
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: a.audiences,
+		},
+	}
+	result, err := a.client.Create(req.Context(), review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to review token: %w", err)
+	}
+
+	if !result.Status.Authenticated {
+		if result.Status.Error != "" {
+			return nil, fmt.Errorf("token was rejected: %s", result.Status.Error)
+		}
+		return nil, errors.New("token was rejected")
+	}
+
+	return &Principal{
+		Name:   result.Status.User.Username,
+		Groups: append(result.Status.User.Groups, AuthenticatedGroup),
+		Method: MethodKubernetes,
+	}, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newFakeTokenReviewer(t *testing.T, review func(spec authenticationv1.TokenReviewSpec) (authenticationv1.TokenReviewStatus, error)) *TokenReviewAuthenticator {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		request := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		status, err := review(request.Spec)
+		if err != nil {
+			return true, nil, err
+		}
+		return true, &authenticationv1.TokenReview{Spec: request.Spec, Status: status}, nil
+	})
+	return NewTokenReviewAuthenticator(clientset.AuthenticationV1().TokenReviews(), []string{"radius"})
+}
+
+func TestTokenReviewAuthenticator(t *testing.T) {
+	authenticator := newFakeTokenReviewer(t, func(spec authenticationv1.TokenReviewSpec) (authenticationv1.TokenReviewStatus, error) {
+		require.Equal(t, []string{"radius"}, spec.Audiences)
+		switch spec.Token {
+		case "valid":
+			return authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User: authenticationv1.UserInfo{
+					Username: "system:serviceaccount:radius-system:bicep-de",
+					Groups:   []string{"system:serviceaccounts"},
+				},
+			}, nil
+		case "broken":
+			return authenticationv1.TokenReviewStatus{}, errors.New("api server unavailable")
+		default:
+			return authenticationv1.TokenReviewStatus{Authenticated: false, Error: "token is invalid"}, nil
+		}
+	})
+
+	t.Run("valid token", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(newBearerRequest(t, "valid"))
+		require.NoError(t, err)
+		require.Equal(t, &Principal{
+			Name:   "system:serviceaccount:radius-system:bicep-de",
+			Groups: []string{"system:serviceaccounts", AuthenticatedGroup},
+			Method: MethodKubernetes,
+		}, principal)
+	})
+
+	t.Run("rejected token", func(t *testing.T) {
+		_, err := authenticator.Authenticate(newBearerRequest(t, "invalid"))
+		require.EqualError(t, err, "token was rejected: token is invalid")
+	})
+
+	t.Run("review failure", func(t *testing.T) {
+		_, err := authenticator.Authenticate(newBearerRequest(t, "broken"))
+		require.ErrorContains(t, err, "api server unavailable")
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		_, err := authenticator.Authenticate(newBearerRequest(t, ""))
+		require.ErrorIs(t, err, ErrNoCredentials)
+	})
+}
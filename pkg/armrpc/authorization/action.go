@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"net/http"
+	"path"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	verbRead   = "read"
+	verbWrite  = "write"
+	verbDelete = "delete"
+	verbAction = "action"
+)
+
+// ActionForOperation returns the action that is authorized for an operation on the resource id.
+//
+// Proxy operations don't classify the request, so the action is derived from the resource type of the id and the
+// HTTP method of the request. A proxied POST request is a custom action named by the last segment of the URL path.
+func ActionForOperation(operationType v1.OperationType, req *http.Request, id resources.ID) string {
+	resourceType := operationType.Type
+	method := strings.ToUpper(string(operationType.Method))
+
+	if operationType.Method == v1.OperationProxy {
+		if t := id.Type(); t != "" {
+			resourceType = t
+		}
+		method = req.Method
+		if method == http.MethodPost {
+			method = "ACTION" + strings.ToUpper(path.Base(req.URL.Path))
+		}
+	}
+
+	return strings.ToLower(resourceType + "/" + verbForMethod(method))
+}
+
+func verbForMethod(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, string(v1.OperationList), string(v1.OperationPlaneScopeList), string(v1.OperationGetImperative):
+		return verbRead
+	case http.MethodPut, http.MethodPatch, string(v1.OperationPutImperative), string(v1.OperationPutSubscriptions):
+		return verbWrite
+	case http.MethodDelete, string(v1.OperationDeleteImperative):
+		return verbDelete
+	case http.MethodPost:
+		return verbAction
+	}
+
+	if name, ok := strings.CutPrefix(method, "ACTION"); ok && name != "" {
+		return name + "/" + verbAction
+	}
+
+	return method
+}
+
+// isOperationStatusType returns true for the operation status and result types. These are addressed by an
+// unguessable operation id at the location scope and are readable by any authenticated principal so that
+// callers can track the asynchronous operations they started.
+func isOperationStatusType(resourceType string) bool {
+	resourceType = strings.ToLower(resourceType)
+	return strings.HasSuffix(resourceType, "/operationstatuses") || strings.HasSuffix(resourceType, "/operationresults")
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionForOperation(t *testing.T) {
+	tests := []struct {
+		name          string
+		operationType v1.OperationType
+		method        string
+		path          string
+		expected      string
+	}{
+		{
+			name:          "get",
+			operationType: v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationGet},
+			expected:      "applications.core/environments/read",
+		},
+		{
+			name:          "list",
+			operationType: v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationList},
+			expected:      "applications.core/environments/read",
+		},
+		{
+			name:          "put",
+			operationType: v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationPut},
+			expected:      "applications.core/environments/write",
+		},
+		{
+			name:          "patch",
+			operationType: v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationPatch},
+			expected:      "applications.core/environments/write",
+		},
+		{
+			name:          "delete",
+			operationType: v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationDelete},
+			expected:      "applications.core/environments/delete",
+		},
+		{
+			name:          "custom action",
+			operationType: v1.OperationType{Type: "Applications.Core/environments", Method: "ACTIONGETMETADATA"},
+			expected:      "applications.core/environments/getmetadata/action",
+		},
+		{
+			name:          "proxied get",
+			operationType: v1.OperationType{Type: "UCP/RADIUSPROXY", Method: v1.OperationProxy},
+			method:        http.MethodGet,
+			path:          "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env",
+			expected:      "applications.core/environments/read",
+		},
+		{
+			name:          "proxied delete",
+			operationType: v1.OperationType{Type: "UCP/RADIUSPROXY", Method: v1.OperationProxy},
+			method:        http.MethodDelete,
+			path:          "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env",
+			expected:      "applications.core/environments/delete",
+		},
+		{
+			name:          "proxied custom action",
+			operationType: v1.OperationType{Type: "UCP/RADIUSPROXY", Method: v1.OperationProxy},
+			method:        http.MethodPost,
+			path:          "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env/getMetadata",
+			expected:      "applications.core/environments/getmetadata/action",
+		},
+		{
+			name:          "proxied request without a resource type",
+			operationType: v1.OperationType{Type: "UCP/RADIUSPROXY", Method: v1.OperationProxy},
+			method:        http.MethodGet,
+			path:          "/planes",
+			expected:      "ucp/radiusproxy/read",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			method := tc.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			path := tc.path
+			if path == "" {
+				path = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env"
+			}
+
+			req, err := http.NewRequest(method, path, nil)
+			require.NoError(t, err)
+			id, err := resources.ParseByMethod(path, method)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, ActionForOperation(tc.operationType, req, id))
+		})
+	}
+}
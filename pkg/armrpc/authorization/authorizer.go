@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Authorizer evaluates role assignments to decide whether a principal may perform an action at a scope.
+type Authorizer struct {
+	roles       map[string]*role
+	assignments []assignment
+}
+
+type role struct {
+	actions    []*regexp.Regexp
+	notActions []*regexp.Regexp
+}
+
+type assignment struct {
+	role      *role
+	principal string
+	group     string
+	scope     string
+}
+
+// NewAuthorizer creates an Authorizer from the role definitions and assignments in options.
+func NewAuthorizer(options *Options) (*Authorizer, error) {
+	if options == nil {
+		return nil, errors.New("authorization options are required")
+	}
+
+	a := &Authorizer{roles: map[string]*role{}}
+	for _, definition := range append(slices.Clone(builtInRoles), options.RoleDefinitions...) {
+		key := strings.ToLower(definition.Name)
+		if definition.Name == "" {
+			return nil, errors.New("role definition name is required")
+		}
+		if _, ok := a.roles[key]; ok {
+			return nil, fmt.Errorf("role %q is defined more than once", definition.Name)
+		}
+
+		r := &role{}
+		for _, action := range definition.Actions {
+			r.actions = append(r.actions, compilePattern(action))
+		}
+		for _, action := range definition.NotActions {
+			r.notActions = append(r.notActions, compilePattern(action))
+		}
+		a.roles[key] = r
+	}
+
+	for _, ra := range options.RoleAssignments {
+		r, ok := a.roles[strings.ToLower(ra.Role)]
+		if !ok {
+			return nil, fmt.Errorf("role assignment refers to unknown role %q", ra.Role)
+		}
+		if (ra.Principal == "") == (ra.Group == "") {
+			return nil, fmt.Errorf("role assignment of role %q must specify either a principal or a group", ra.Role)
+		}
+		if !strings.HasPrefix(ra.Scope, "/") {
+			return nil, fmt.Errorf("role assignment of role %q has invalid scope %q: scope must begin with '/'", ra.Role, ra.Scope)
+		}
+
+		a.assignments = append(a.assignments, assignment{
+			role:      r,
+			principal: ra.Principal,
+			group:     ra.Group,
+			scope:     normalizeScope(ra.Scope),
+		})
+	}
+
+	return a, nil
+}
+
+// IsAuthorized returns true if any role assigned to the principal at the scope, or at a parent of the scope, grants the action.
+func (a *Authorizer) IsAuthorized(principal *authentication.Principal, action string, scope string) bool {
+	action = strings.ToLower(action)
+	scope = normalizeScope(scope)
+
+	for _, ra := range a.assignments {
+		if !ra.appliesTo(principal) || !inScope(ra.scope, scope) {
+			continue
+		}
+		if ra.role.allows(action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ra *assignment) appliesTo(principal *authentication.Principal) bool {
+	if ra.principal != "" {
+		return ra.principal == principal.Name
+	}
+	return slices.Contains(principal.Groups, ra.group)
+}
+
+func (r *role) allows(action string) bool {
+	matches := func(pattern *regexp.Regexp) bool { return pattern.MatchString(action) }
+	return slices.ContainsFunc(r.actions, matches) && !slices.ContainsFunc(r.notActions, matches)
+}
+
+// compilePattern converts an action pattern with '*' wildcards to a regular expression.
+func compilePattern(pattern string) *regexp.Regexp {
+	quoted := regexp.QuoteMeta(strings.ToLower(pattern))
+	return regexp.MustCompile("^" + strings.ReplaceAll(quoted, `\*`, ".*") + "$")
+}
+
+func normalizeScope(scope string) string {
+	return strings.TrimSuffix(strings.ToLower(scope), "/")
+}
+
+// inScope returns true if scope is equal to or a child of assignedScope.
+func inScope(assignedScope string, scope string) bool {
+	return assignedScope == "" || scope == assignedScope || strings.HasPrefix(scope, assignedScope+"/")
+}
+
+type authorizerKey struct{}
+
+// WithAuthorizer returns a middleware that stores the authorizer in the request context.
+func WithAuthorizer(authorizer *Authorizer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), authorizerKey{}, authorizer)))
+		})
+	}
+}
+
+// FromContext returns the authorizer stored in the context, or nil if authorization is not enabled.
+func FromContext(ctx context.Context) *Authorizer {
+	authorizer, ok := ctx.Value(authorizerKey{}).(*Authorizer)
+	if !ok {
+		return nil
+	}
+	return authorizer
+}
+
+// AuthorizeRequest checks whether the principal of the request may perform the operation on the resource of the
+// request. It returns nil if the request is authorized or authorization is not enabled, and the error response
+// to send otherwise.
+func AuthorizeRequest(ctx context.Context, req *http.Request, operationType v1.OperationType) rest.Response {
+	authorizer := FromContext(ctx)
+	if authorizer == nil {
+		return nil
+	}
+
+	principal := authentication.PrincipalFromContext(ctx)
+	if principal == nil {
+		return rest.NewClientAuthenticationFailedARMResponse()
+	}
+
+	rpcCtx := v1.ARMRequestContextFromContext(ctx)
+	action := ActionForOperation(operationType, req, rpcCtx.ResourceID)
+	if strings.HasSuffix(action, "/"+verbRead) && isOperationStatusType(strings.TrimSuffix(action, "/"+verbRead)) && !principal.IsAnonymous() {
+		return nil
+	}
+
+	scope := rpcCtx.ResourceID.String()
+	if authorizer.IsAuthorized(principal, action, scope) {
+		return nil
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info("request is not authorized", "principal", principal.Name, "action", action, "scope", scope)
+	return rest.NewForbiddenResponse(fmt.Sprintf("The principal '%s' does not have authorization to perform action '%s' over scope '%s'.", principal.Name, action, scope))
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const (
+	teamAScope = "/planes/radius/local/resourceGroups/team-a"
+	teamAEnv   = "/planes/radius/local/resourcegroups/team-a/providers/applications.core/environments/prod"
+	teamBEnv   = "/planes/radius/local/resourcegroups/team-b/providers/applications.core/environments/prod"
+)
+
+func newTestAuthorizer(t *testing.T) *Authorizer {
+	authorizer, err := NewAuthorizer(&Options{
+		RoleDefinitions: []RoleDefinition{
+			{
+				Name:       "Application Developer",
+				Actions:    []string{"Applications.Core/*"},
+				NotActions: []string{"Applications.Core/environments/write", "Applications.Core/environments/delete"},
+			},
+		},
+		RoleAssignments: []RoleAssignment{
+			{Role: RoleOwner, Principal: "admin", Scope: "/"},
+			{Role: RoleOwner, Group: "team-a", Scope: teamAScope},
+			{Role: "application developer", Principal: "dev", Scope: teamAScope + "/"},
+			{Role: RoleReader, Group: authentication.AuthenticatedGroup, Scope: "/planes/radius/local"},
+		},
+	})
+	require.NoError(t, err)
+	return authorizer
+}
+
+func TestAuthorizer_IsAuthorized(t *testing.T) {
+	authorizer := newTestAuthorizer(t)
+
+	admin := &authentication.Principal{Name: "admin", Groups: []string{authentication.AuthenticatedGroup}}
+	teamA := &authentication.Principal{Name: "alice", Groups: []string{"team-a", authentication.AuthenticatedGroup}}
+	dev := &authentication.Principal{Name: "dev", Groups: []string{authentication.AuthenticatedGroup}}
+	anonymous := &authentication.Principal{Name: authentication.AnonymousName, Groups: []string{authentication.UnauthenticatedGroup}}
+
+	tests := []struct {
+		name      string
+		principal *authentication.Principal
+		action    string
+		scope     string
+		expected  bool
+	}{
+		{"owner at root scope", admin, "applications.core/environments/delete", teamBEnv, true},
+		{"group owner in scope", teamA, "applications.core/environments/delete", teamAEnv, true},
+		{"group owner out of scope", teamA, "applications.core/environments/delete", teamBEnv, false},
+		{"group owner at the scope itself", teamA, "system.resources/resourcegroups/delete", "/planes/radius/local/resourcegroups/team-a", true},
+		{"scope prefix is not a parent", teamA, "applications.core/environments/delete", "/planes/radius/local/resourcegroups/team-ab/providers/applications.core/environments/prod", false},
+		{"reader can read", teamA, "applications.core/environments/read", teamBEnv, true},
+		{"custom role action", dev, "applications.core/containers/write", teamAEnv, true},
+		{"custom role not action", dev, "applications.core/environments/delete", teamAEnv, false},
+		{"custom role other provider", dev, "applications.datastores/rediscaches/write", teamAEnv, false},
+		{"anonymous", anonymous, "applications.core/environments/read", teamAEnv, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, authorizer.IsAuthorized(tc.principal, tc.action, tc.scope))
+		})
+	}
+}
+
+func TestNewAuthorizer_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *Options
+		err     string
+	}{
+		{"nil options", nil, "authorization options are required"},
+		{"unknown role", &Options{RoleAssignments: []RoleAssignment{{Role: "Admin", Principal: "alice", Scope: "/"}}}, `unknown role "Admin"`},
+		{"duplicate role", &Options{RoleDefinitions: []RoleDefinition{{Name: "owner", Actions: []string{"*"}}}}, `role "owner" is defined more than once`},
+		{"no subject", &Options{RoleAssignments: []RoleAssignment{{Role: RoleReader, Scope: "/"}}}, "either a principal or a group"},
+		{"both subjects", &Options{RoleAssignments: []RoleAssignment{{Role: RoleReader, Principal: "alice", Group: "team-a", Scope: "/"}}}, "either a principal or a group"},
+		{"relative scope", &Options{RoleAssignments: []RoleAssignment{{Role: RoleReader, Principal: "alice", Scope: "planes/radius"}}}, "scope must begin with '/'"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewAuthorizer(tc.options)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestAuthorizeRequest(t *testing.T) {
+	authorizer := newTestAuthorizer(t)
+	deleteEnv := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationDelete}
+
+	newContext := func(t *testing.T, method string, path string, principal *authentication.Principal, authorizer *Authorizer) (context.Context, *http.Request) {
+		req := httptest.NewRequest(method, path, nil)
+		rpcCtx, err := v1.FromARMRequest(req, "", "global")
+		require.NoError(t, err)
+
+		ctx := v1.WithARMRequestContext(context.Background(), rpcCtx)
+		if principal != nil {
+			ctx = authentication.WithPrincipal(ctx, principal)
+		}
+		if authorizer != nil {
+			ctx = context.WithValue(ctx, authorizerKey{}, authorizer)
+		}
+		return ctx, req.WithContext(ctx)
+	}
+
+	alice := &authentication.Principal{Name: "alice", Groups: []string{"team-a", authentication.AuthenticatedGroup}}
+	bob := &authentication.Principal{Name: "bob", Groups: []string{"team-b", authentication.AuthenticatedGroup}}
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		ctx, req := newContext(t, http.MethodDelete, teamBEnv, nil, nil)
+		require.Nil(t, AuthorizeRequest(ctx, req, deleteEnv))
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		ctx, req := newContext(t, http.MethodDelete, teamAEnv, alice, authorizer)
+		require.Nil(t, AuthorizeRequest(ctx, req, deleteEnv))
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx, req := newContext(t, http.MethodDelete, teamAEnv, bob, authorizer)
+		w := httptest.NewRecorder()
+		response := AuthorizeRequest(ctx, req, deleteEnv)
+		require.NotNil(t, response)
+		require.NoError(t, response.Apply(ctx, w, req))
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.Contains(t, w.Body.String(), v1.CodeAuthorizationFailed)
+		require.Contains(t, w.Body.String(), "The principal 'bob' does not have authorization to perform action 'applications.core/environments/delete'")
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		ctx, req := newContext(t, http.MethodDelete, teamAEnv, nil, authorizer)
+		w := httptest.NewRecorder()
+		response := AuthorizeRequest(ctx, req, deleteEnv)
+		require.NotNil(t, response)
+		require.NoError(t, response.Apply(ctx, w, req))
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("operation status", func(t *testing.T) {
+		statusPath := "/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/abcd"
+		getStatus := v1.OperationType{Type: "Applications.Core/operationStatuses", Method: v1.OperationGet}
+
+		ctx, req := newContext(t, http.MethodGet, statusPath, bob, authorizer)
+		require.Nil(t, AuthorizeRequest(ctx, req, getStatus))
+
+		anonymous := &authentication.Principal{Name: authentication.AnonymousName, Groups: []string{authentication.UnauthenticatedGroup}}
+		ctx, req = newContext(t, http.MethodGet, statusPath, anonymous, authorizer)
+		require.NotNil(t, AuthorizeRequest(ctx, req, getStatus))
+	})
+}
+
+// TestAuthorizer_DenyByDefault tests the configuration documented for running without anonymous access: Radius
+// components send their service account tokens, and only the Radius service accounts and the assigned users and
+// groups are granted roles.
+func TestAuthorizer_DenyByDefault(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		switch review.Spec.Token {
+		case "applications-rp":
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User: authenticationv1.UserInfo{
+					Username: "system:serviceaccount:radius-system:applications-rp",
+					Groups:   []string{"system:serviceaccounts", "system:serviceaccounts:radius-system"},
+				},
+			}
+		case "workload":
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User: authenticationv1.UserInfo{
+					Username: "system:serviceaccount:default:workload",
+					Groups:   []string{"system:serviceaccounts", "system:serviceaccounts:default"},
+				},
+			}
+		}
+		return true, review, nil
+	})
+
+	authorizer, err := NewAuthorizer(&Options{
+		RoleAssignments: []RoleAssignment{
+			{Role: RoleOwner, Group: "system:serviceaccounts:radius-system", Scope: "/"},
+		},
+	})
+	require.NoError(t, err)
+
+	authenticators := []authentication.Authenticator{authentication.NewTokenReviewAuthenticator(clientset.AuthenticationV1().TokenReviews(), nil)}
+	deleteEnv := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationDelete}
+	handler := authentication.Authenticate(authenticators)(WithAuthorizer(authorizer)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rpcCtx, err := v1.FromARMRequest(r, "", "global")
+		require.NoError(t, err)
+
+		ctx := v1.WithARMRequestContext(r.Context(), rpcCtx)
+		if response := AuthorizeRequest(ctx, r, deleteEnv); response != nil {
+			require.NoError(t, response.Apply(ctx, w, r))
+		}
+	})))
+
+	tests := []struct {
+		name     string
+		token    string
+		expected int
+	}{
+		{name: "radius component", token: "applications-rp", expected: http.StatusOK},
+		{name: "other service account", token: "workload", expected: http.StatusForbidden},
+		{name: "no credentials", expected: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, teamAEnv, nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			require.Equal(t, tc.expected, w.Code)
+		})
+	}
+}
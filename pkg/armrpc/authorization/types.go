@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+const (
+	// RoleOwner is the built-in role that grants all actions.
+	RoleOwner = "Owner"
+
+	// RoleReader is the built-in role that grants read actions.
+	RoleReader = "Reader"
+)
+
+// builtInRoles is the list of role definitions that are always available.
+var builtInRoles = []RoleDefinition{
+	{
+		Name:        RoleOwner,
+		Description: "Grants full access to manage all resources.",
+		Actions:     []string{"*"},
+	},
+	{
+		Name:        RoleReader,
+		Description: "Grants access to view all resources.",
+		Actions:     []string{"*/read"},
+	},
+}
+
+// Options represents the role-based access control configuration of a server.
+type Options struct {
+	// RoleDefinitions is the list of custom role definitions. The built-in Owner and Reader roles are always available.
+	RoleDefinitions []RoleDefinition `yaml:"roleDefinitions,omitempty"`
+
+	// RoleAssignments is the list of role assignments.
+	RoleAssignments []RoleAssignment `yaml:"roleAssignments,omitempty"`
+}
+
+// RoleDefinition defines a named set of actions.
+//
+// Actions have the form <resource type>/<verb>, for example "Applications.Core/environments/delete". The verb is
+// one of read, write, or delete, or <name>/action for custom actions such as "Applications.Core/environments/getmetadata/action".
+// The '*' wildcard matches any sequence of characters, e.g. "Applications.Core/*" or "*/read". Matching is case-insensitive.
+type RoleDefinition struct {
+	// Name is the name of the role.
+	Name string `yaml:"name"`
+
+	// Description is the description of the role.
+	Description string `yaml:"description,omitempty"`
+
+	// Actions is the list of actions granted by the role.
+	Actions []string `yaml:"actions"`
+
+	// NotActions is the list of actions excluded from Actions.
+	NotActions []string `yaml:"notActions,omitempty"`
+}
+
+// RoleAssignment grants a role to a principal or a group at a scope.
+type RoleAssignment struct {
+	// Role is the name of the role definition.
+	Role string `yaml:"role"`
+
+	// Principal is the name of the principal. Either Principal or Group must be set.
+	Principal string `yaml:"principal,omitempty"`
+
+	// Group is the name of the group. Either Principal or Group must be set.
+	Group string `yaml:"group,omitempty"`
+
+	// Scope is the resource ID of the plane, resource group, or resource the role is assigned at,
+	// e.g. "/planes/radius/local/resourceGroups/team-a". The scope "/" applies to all resources.
+	Scope string `yaml:"scope"`
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"strings"
+
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
+	"github.com/radius-project/radius/pkg/armrpc/openapi"
+)
+
+// OpenAPIDocument generates the OpenAPI v3 document of the operations registered for the namespace. The schemas of the
+// resources are generated from the versioned models of the newest API version registered with apiversions.
+func (b *Builder) OpenAPIDocument() (*openapi.Document, error) {
+	namespace := b.namespaceNode.Name
+	doc := openapi.NewDocument(namespace, "")
+
+	allVersions := map[string]bool{}
+	for _, h := range b.registrations {
+		if h == nil {
+			continue
+		}
+
+		versions := []string{}
+		var schema *openapi.Schema
+		if registration, ok := apiversions.Lookup(h.ResourceType); ok {
+			versions = registration.Versions()
+			if len(versions) > 0 {
+				schema = doc.SchemaOf(namespace+"."+versions[0]+".", registration.Model(versions[0]))
+			}
+		}
+
+		for _, version := range versions {
+			allVersions[version] = true
+		}
+
+		err := doc.AddResourceOperation(openapi.ResourceOperation{
+			ResourceType: h.ResourceType,
+			Path:         "/providers/" + openAPIPattern(h.ResourceType, h.ResourceNamePattern) + h.Path,
+			Method:       h.Method,
+			APIVersions:  versions,
+			Schema:       schema,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	versions := []string{}
+	for version := range allVersions {
+		versions = append(versions, version)
+	}
+	apiversions.SortNewestFirst(versions)
+	if len(versions) > 0 {
+		doc.Info.Version = versions[0]
+	}
+
+	if len(b.namespaceNode.availableOperations) > 0 {
+		if err := doc.AddProviderOperations(namespace, versions); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+// openAPIPattern restores the case of the resource type in the lowercase resource name pattern, e.g.
+// 'applications.core/httproutes/{httpRouteName}' becomes 'Applications.Core/httpRoutes/{httpRouteName}'.
+func openAPIPattern(resourceType, pattern string) string {
+	typeSegments := strings.Split(resourceType, "/")
+	segments := strings.Split(pattern, "/")
+
+	i := 0
+	for j, segment := range segments {
+		if strings.HasPrefix(segment, "{") || i >= len(typeSegments) {
+			continue
+		}
+		segments[j] = typeSegments[i]
+		i++
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	apiversions.NewConverter[rpctest.TestResourceDataModel]("Applications.Compute/virtualMachines").
+		Register(rpctest.TestAPIVersion, func() v1.VersionedModelInterface { return &rpctest.TestResource{} })
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	ns := newTestNamespace(t)
+	b := ns.GenerateBuilder()
+
+	doc, err := b.OpenAPIDocument()
+	require.NoError(t, err)
+
+	require.Equal(t, "Applications.Compute", doc.Info.Title)
+	require.Equal(t, rpctest.TestAPIVersion, doc.Info.Version)
+
+	ops := doc.Operations()
+	vmPath := "/{rootScope}/providers/Applications.Compute/virtualMachines/{virtualMachineName}"
+	require.Equal(t, []string{"get"}, ops["/{rootScope}/providers/Applications.Compute/virtualMachines"])
+	require.Equal(t, []string{"delete", "get", "patch", "put"}, ops[vmPath])
+	require.Equal(t, []string{"post"}, ops[vmPath+"/start"])
+	require.Equal(t, []string{"post"}, ops[vmPath+"/stop"])
+	require.Equal(t, []string{"delete", "get", "patch", "put"}, ops[vmPath+"/disks/{diskName}"])
+	require.Equal(t, []string{"post"}, ops[vmPath+"/disks/{diskName}/replace"])
+	require.Equal(t, []string{"delete", "get", "patch", "put"}, ops["/{rootScope}/providers/Applications.Compute/webAssemblies/{webAssemblyName}"])
+
+	// The schema of virtualMachines is generated from the registered versioned model.
+	put := doc.Paths[vmPath].Put
+	require.Equal(t, "VirtualMachines_CreateOrUpdate", put.OperationID)
+	schemaName := "Applications.Compute." + rpctest.TestAPIVersion + ".TestResource"
+	require.Equal(t, "#/components/schemas/"+schemaName, put.RequestBody.Content["application/json"].Schema.Ref)
+	require.Contains(t, doc.Components.Schemas, schemaName)
+	require.Equal(t, []any{rpctest.TestAPIVersion}, put.Parameters[len(put.Parameters)-1].Schema.Enum)
+
+	// Resource types without a registered converter have no schema.
+	diskPut := doc.Paths[vmPath+"/disks/{diskName}"].Put
+	require.Nil(t, diskPut.RequestBody.Content["application/json"].Schema)
+}
+
+func TestOpenAPIPattern(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		pattern      string
+		want         string
+	}{
+		{"Applications.Core/environments", "applications.core/environments", "Applications.Core/environments"},
+		{"Applications.Core/environments", "applications.core/environments/{environmentName}", "Applications.Core/environments/{environmentName}"},
+		{"Applications.Compute/virtualMachines/disks", "applications.compute/virtualmachines/{virtualMachineName}/disks/{diskName}", "Applications.Compute/virtualMachines/{virtualMachineName}/disks/{diskName}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			require.Equal(t, tt.want, openAPIPattern(tt.resourceType, tt.pattern))
+		})
+	}
+}
@@ -88,7 +88,8 @@ type ResourceOption[P interface {
 	// Put defines the operation for creating or updating a resource.
 	Put Operation[T]
 
-	// Patch defines the operation for updating a resource.
+	// Patch defines the operation for updating a resource. The default controllers apply the request body to
+	// the existing resource as a JSON merge patch (RFC 7396).
 	Patch Operation[T]
 
 	// Delete defines the operation for deleting a resource.
@@ -295,11 +296,11 @@ func (r *ResourceOption[P, T]) patchOutput(opts BuildOptions) *OperationRegistra
 
 		if r.Patch.AsyncJobController == nil {
 			h.APIController = func(opt controller.Options) (controller.Controller, error) {
-				return defaultoperation.NewDefaultSyncPut[P, T](opt, ro)
+				return defaultoperation.NewDefaultSyncPatch[P, T](opt, ro)
 			}
 		} else {
 			h.APIController = func(opt controller.Options) (controller.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut[P, T](opt, ro)
+				return defaultoperation.NewDefaultAsyncPatch[P, T](opt, ro)
 			}
 		}
 	}
@@ -350,7 +350,7 @@ func TestResourceOption_PatchOutput(t *testing.T) {
 
 		api, err := h.APIController(controller.Options{})
 		require.NoError(t, err)
-		_, ok := api.(*defaultoperation.DefaultSyncPut[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
+		_, ok := api.(*defaultoperation.DefaultSyncPatch[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
 		require.True(t, ok)
 		require.Equal(t, "Applications.Compute/virtualMachines", h.ResourceType)
 		require.Equal(t, "applications.compute/virtualmachines/{virtualMachineName}", h.ResourceNamePattern)
@@ -372,7 +372,7 @@ func TestResourceOption_PatchOutput(t *testing.T) {
 
 		api, err := h.APIController(controller.Options{})
 		require.NoError(t, err)
-		_, ok := api.(*defaultoperation.DefaultAsyncPut[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
+		_, ok := api.(*defaultoperation.DefaultAsyncPatch[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
 		require.True(t, ok)
 		require.Equal(t, "Applications.Compute/virtualMachines", h.ResourceType)
 		require.Equal(t, "applications.compute/virtualmachines/{virtualMachineName}", h.ResourceNamePattern)
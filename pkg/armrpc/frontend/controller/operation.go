@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -95,6 +96,54 @@ func (c *Operation[P, T]) GetResourceFromRequest(ctx context.Context, req *http.
 	return dm, nil
 }
 
+// GetResourceFromPatchRequest applies the JSON merge patch in the HTTP request body to the versioned model of
+// oldResource, and converts the result to the datamodel. The resource is validated by the request converter as if
+// the patched resource was sent in a PUT request.
+//
+// The versioned model doesn't include write-only properties, so they are added from oldResource if it implements
+// v1.WriteOnlyDataModel. Otherwise a patch that doesn't change them would remove them.
+func (c *Operation[P, T]) GetResourceFromPatchRequest(ctx context.Context, req *http.Request, oldResource *T) (*T, error) {
+	patch, err := ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	versioned, err := c.resourceOptions.ResponseConverter(oldResource, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := json.Marshal(versioned)
+	if err != nil {
+		return nil, err
+	}
+
+	if writeOnly, ok := any(oldResource).(v1.WriteOnlyDataModel); ok {
+		if properties := writeOnly.WriteOnlyProperties(); len(properties) > 0 {
+			b, err := json.Marshal(map[string]any{"properties": properties})
+			if err != nil {
+				return nil, err
+			}
+
+			original, err = ApplyMergePatch(original, b)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	content, err := ApplyMergePatch(original, patch)
+	if errors.Is(err, ErrInvalidMergePatch) {
+		return nil, &v1.ErrClientRP{Code: v1.CodeInvalidRequestContent, Message: err.Error()}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return c.resourceOptions.RequestConverter(content, serviceCtx.APIVersion)
+}
+
 // GetResource is the helper to get the resource via storage client.
 func (c *Operation[P, T]) GetResource(ctx context.Context, id resources.ID) (out *T, etag string, err error) {
 	etag = ""
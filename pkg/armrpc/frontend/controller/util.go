@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -33,6 +34,9 @@ var (
 	// ContentTypeHeaderKey is the header key of Content-Type
 	ContentTypeHeaderKey = http.CanonicalHeaderKey("Content-Type")
 
+	// MergePatchContentType is the content type of a JSON merge patch document (RFC 7396).
+	MergePatchContentType = "application/merge-patch+json"
+
 	// DefaultScheme is the default scheme used if there is no scheme in the URL.
 	DefaultSheme = "http"
 )
@@ -46,11 +50,13 @@ var (
 	ErrETagsDoNotMatch = errors.New("etags do not match")
 	// ErrResourceAlreadyExists represents the error of the resource being already existent at the moment.
 	ErrResourceAlreadyExists = errors.New("resource already exists")
+	// ErrInvalidMergePatch represents the error of a PATCH request body that is not a JSON object.
+	ErrInvalidMergePatch = errors.New("the request body of a PATCH request must be a JSON object")
 )
 
 // ReadJSONBody extracts the content from request - it reads the body of the request if the content type
-// is "application/json". It returns the body as a byte array or an error if the content type is not supported
-// or an error occurs while reading the body.
+// is "application/json", or "application/merge-patch+json" for PATCH requests. It returns the body as a byte array
+// or an error if the content type is not supported or an error occurs while reading the body.
 func ReadJSONBody(r *http.Request) ([]byte, error) {
 	defer r.Body.Close()
 
@@ -59,7 +65,7 @@ func ReadJSONBody(r *http.Request) ([]byte, error) {
 		contentType = contentType[0:i]
 	}
 
-	if contentType != "application/json" {
+	if contentType != "application/json" && !(r.Method == http.MethodPatch && contentType == MergePatchContentType) {
 		return nil, ErrUnsupportedContentType
 	}
 	data, err := io.ReadAll(r.Body)
@@ -69,6 +75,44 @@ func ReadJSONBody(r *http.Request) ([]byte, error) {
 	return data, nil
 }
 
+// ApplyMergePatch applies a JSON merge patch document to the original JSON object as described in RFC 7396.
+// Members of the patch replace the members of the original, null members are removed, and objects are merged
+// recursively. The patch must be a JSON object.
+func ApplyMergePatch(original []byte, patch []byte) ([]byte, error) {
+	patchObj := map[string]any{}
+	if err := json.Unmarshal(patch, &patchObj); err != nil || patchObj == nil {
+		return nil, ErrInvalidMergePatch
+	}
+
+	originalObj := map[string]any{}
+	if err := json.Unmarshal(original, &originalObj); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mergePatch(originalObj, patchObj))
+}
+
+func mergePatch(original any, patch any) any {
+	patchObj, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	originalObj, ok := original.(map[string]any)
+	if !ok {
+		originalObj = map[string]any{}
+	}
+
+	for k, v := range patchObj {
+		if v == nil {
+			delete(originalObj, k)
+			continue
+		}
+		originalObj[k] = mergePatch(originalObj[k], v)
+	}
+	return originalObj
+}
+
 // ValidateETag checks the If-Match and If-None-Match headers of the ARMRequestContext against the provided etag,
 // and returns an error if the etag does not match either header.
 func ValidateETag(armRequestContext v1.ARMRequestContext, etag string) error {
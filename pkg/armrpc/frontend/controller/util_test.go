@@ -36,20 +36,23 @@ func TestReadJSONBody(t *testing.T) {
 	require.NoError(t, err)
 
 	contentTypeTests := []struct {
+		method      string
 		contentType string
 		body        []byte
 		err         error
 	}{
-		{"application/json", content, nil},
-		{"application/json; charset=utf8", content, nil},
-		{"application/json;    charset=utf8", content, nil},
-		{"Application/Json;    charset=utf8    ", content, nil},
-		{"plain/text", content, ErrUnsupportedContentType},
+		{http.MethodPut, "application/json", content, nil},
+		{http.MethodPut, "application/json; charset=utf8", content, nil},
+		{http.MethodPut, "application/json;    charset=utf8", content, nil},
+		{http.MethodPut, "Application/Json;    charset=utf8    ", content, nil},
+		{http.MethodPut, "plain/text", content, ErrUnsupportedContentType},
+		{http.MethodPut, "application/merge-patch+json", content, ErrUnsupportedContentType},
+		{http.MethodPatch, "application/merge-patch+json; charset=utf8", content, nil},
 	}
 
 	for _, tc := range contentTypeTests {
-		t.Run(tc.contentType, func(t *testing.T) {
-			req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, "http://github.com", bytes.NewBuffer(tc.body))
+		t.Run(tc.method+" "+tc.contentType, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(context.Background(), tc.method, "http://github.com", bytes.NewBuffer(tc.body))
 			require.NoError(t, err)
 			req.Header.Set("Content-Type", tc.contentType)
 			// act
@@ -65,6 +68,61 @@ func TestReadJSONBody(t *testing.T) {
 	}
 }
 
+func TestApplyMergePatch(t *testing.T) {
+	original := `{"name":"r","tags":{"a":"1","b":"2"},"properties":{"env":{"A":"1","B":"2"},"ports":[1,2],"image":"nginx"}}`
+
+	tests := []struct {
+		name     string
+		patch    string
+		expected string
+		err      error
+	}{
+		{
+			name:     "replace nested value",
+			patch:    `{"properties":{"env":{"A":"3"}}}`,
+			expected: `{"name":"r","tags":{"a":"1","b":"2"},"properties":{"env":{"A":"3","B":"2"},"ports":[1,2],"image":"nginx"}}`,
+		},
+		{
+			name:     "remove members with null",
+			patch:    `{"tags":{"a":null},"properties":{"image":null}}`,
+			expected: `{"name":"r","tags":{"b":"2"},"properties":{"env":{"A":"1","B":"2"},"ports":[1,2]}}`,
+		},
+		{
+			name:     "replace arrays",
+			patch:    `{"properties":{"ports":[3]}}`,
+			expected: `{"name":"r","tags":{"a":"1","b":"2"},"properties":{"env":{"A":"1","B":"2"},"ports":[3],"image":"nginx"}}`,
+		},
+		{
+			name:     "replace object with value",
+			patch:    `{"tags":"none","location":{"region":"west","zone":null}}`,
+			expected: `{"name":"r","tags":"none","location":{"region":"west"},"properties":{"env":{"A":"1","B":"2"},"ports":[1,2],"image":"nginx"}}`,
+		},
+		{
+			name:  "patch is not an object",
+			patch: `["a"]`,
+			err:   ErrInvalidMergePatch,
+		},
+		{
+			name:  "patch is null",
+			patch: `null`,
+			err:   ErrInvalidMergePatch,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := ApplyMergePatch([]byte(original), []byte(tc.patch))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expected, string(result))
+		})
+	}
+}
+
 var tag string = uuid.New().String()
 
 func TestValidateEtag_IfMatch(t *testing.T) {
@@ -0,0 +1,229 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultAsyncPatch(t *testing.T) {
+	patchCases := []struct {
+		desc  string
+		found bool
+		state v1.ProvisioningState
+		qErr  error
+		rCode int
+		rErr  error
+	}{
+		{
+			desc:  "async-patch-success",
+			found: true,
+			state: v1.ProvisioningStateSucceeded,
+			rCode: http.StatusAccepted,
+		},
+		{
+			desc:  "async-patch-not-found",
+			found: false,
+			rCode: http.StatusNotFound,
+		},
+		{
+			desc:  "async-patch-in-progress",
+			found: true,
+			state: v1.ProvisioningStateUpdating,
+			rCode: http.StatusConflict,
+		},
+		{
+			desc:  "async-patch-enqueue-error",
+			found: true,
+			state: v1.ProvisioningStateSucceeded,
+			qErr:  errors.New("enqueuer client is unset"),
+			rErr:  errors.New("enqueuer client is unset"),
+		},
+	}
+
+	for _, tt := range patchCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			w := httptest.NewRecorder()
+			body := map[string]any{"properties": map[string]any{"propertyA": "newValue"}}
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPatch, resourceTestHeaderFile, body)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", ctrl.MergePatchContentType)
+
+			ctx := rpctest.NewARMRequestContext(req)
+			sCtx := v1.ARMRequestContextFromContext(ctx)
+
+			if tt.found {
+				existing := &TestResourceDataModel{}
+				require.NoError(t, json.Unmarshal(testutil.ReadFixture("resource-datamodel.json"), existing))
+				existing.SetProvisioningState(tt.state)
+
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(&store.Object{Metadata: store.Metadata{ID: sCtx.ResourceID.String()}, Data: existing}, nil).
+					Times(1)
+			} else {
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(nil, &store.ErrNotFound{ID: sCtx.ResourceID.String()}).
+					Times(1)
+			}
+
+			if tt.rCode == http.StatusAccepted || tt.qErr != nil {
+				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+						saved := obj.Data.(*TestResourceDataModel)
+						require.Equal(t, "newValue", saved.Properties.PropertyA)
+						require.Equal(t, "propertyBValue", saved.Properties.PropertyB)
+						require.Equal(t, v1.ProvisioningStateAccepted, saved.InternalMetadata.AsyncProvisioningState)
+						return nil
+					}).
+					Times(1)
+
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(tt.qErr).
+					Times(1)
+
+				if tt.qErr != nil {
+					mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(nil).
+						Times(1)
+				}
+			}
+
+			opts := ctrl.Options{
+				StorageClient: mds,
+				StatusManager: msm,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+				UpdateFilters: []ctrl.UpdateFilter[TestResourceDataModel]{
+					testValidateRequest,
+				},
+			}
+
+			ctl, err := NewDefaultAsyncPatch(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			if tt.rErr != nil {
+				require.Equal(t, tt.rErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.rCode, w.Result().StatusCode)
+		})
+	}
+}
+
+func TestDefaultAsyncPatch_WriteOnlyProperties(t *testing.T) {
+	patchCases := []struct {
+		desc   string
+		body   map[string]any
+		secret string
+	}{
+		{
+			desc:   "tags-only-patch-keeps-secret",
+			body:   map[string]any{"tags": map[string]any{"env": "test"}},
+			secret: "secretValue",
+		},
+		{
+			desc:   "patch-updates-secret",
+			body:   map[string]any{"properties": map[string]any{"secret": "newSecret"}},
+			secret: "newSecret",
+		},
+		{
+			desc:   "patch-removes-secret",
+			body:   map[string]any{"properties": map[string]any{"secret": nil}},
+			secret: "",
+		},
+	}
+
+	for _, tt := range patchCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPatch, resourceTestHeaderFile, tt.body)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", ctrl.MergePatchContentType)
+
+			ctx := rpctest.NewARMRequestContext(req)
+			sCtx := v1.ARMRequestContextFromContext(ctx)
+
+			existing := &TestResourceDataModel{}
+			require.NoError(t, json.Unmarshal(testutil.ReadFixture("resource-datamodel.json"), existing))
+			existing.Properties.Secret = "secretValue"
+
+			mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+				Return(&store.Object{Metadata: store.Metadata{ID: sCtx.ResourceID.String()}, Data: existing}, nil).
+				Times(1)
+			mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+					saved := obj.Data.(*TestResourceDataModel)
+					require.Equal(t, tt.secret, saved.Properties.Secret)
+					require.Equal(t, "propertyAValue", saved.Properties.PropertyA)
+					return nil
+				}).
+				Times(1)
+			msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).
+				Times(1)
+
+			opts := ctrl.Options{
+				StorageClient: mds,
+				StatusManager: msm,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+			}
+
+			ctl, err := NewDefaultAsyncPatch(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+
+			// The secret is not returned.
+			actual := map[string]any{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &actual))
+			require.NotContains(t, actual["properties"], "secret")
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultSyncPatch(t *testing.T) {
+	patchCases := []struct {
+		desc    string
+		body    any
+		ifMatch string
+		found   bool
+		rCode   int
+		rErr    bool
+
+		expectedProperties *TestResourceDataModelProperties
+		expectedTags       map[string]string
+	}{
+		{
+			desc:  "sync-patch-single-property",
+			body:  map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			found: true,
+			rCode: http.StatusOK,
+			expectedProperties: &TestResourceDataModelProperties{
+				Application: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0",
+				Environment: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/environments/env0",
+				PropertyA:   "newValue",
+				PropertyB:   "propertyBValue",
+			},
+			expectedTags: map[string]string{"team": "a"},
+		},
+		{
+			desc:  "sync-patch-remove-property-and-tag",
+			body:  map[string]any{"properties": map[string]any{"propertyB": nil}, "tags": map[string]any{"team": nil, "owner": "b"}},
+			found: true,
+			rCode: http.StatusOK,
+			expectedProperties: &TestResourceDataModelProperties{
+				Application: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0",
+				Environment: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/environments/env0",
+				PropertyA:   "propertyAValue",
+			},
+			expectedTags: map[string]string{"owner": "b"},
+		},
+		{
+			desc:  "sync-patch-not-found",
+			body:  map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			found: false,
+			rCode: http.StatusNotFound,
+		},
+		{
+			desc:    "sync-patch-if-match-mismatch",
+			body:    map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			ifMatch: "other-etag",
+			found:   true,
+			rCode:   http.StatusPreconditionFailed,
+		},
+		{
+			desc:    "sync-patch-if-match",
+			body:    map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			ifMatch: "etag-1",
+			found:   true,
+			rCode:   http.StatusOK,
+			expectedProperties: &TestResourceDataModelProperties{
+				Application: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0",
+				Environment: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/environments/env0",
+				PropertyA:   "newValue",
+				PropertyB:   "propertyBValue",
+			},
+			expectedTags: map[string]string{"team": "a"},
+		},
+		{
+			desc:  "sync-patch-update-filter",
+			body:  map[string]any{"properties": map[string]any{"application": "other-app"}},
+			found: true,
+			rCode: http.StatusBadRequest,
+		},
+		{
+			desc:  "sync-patch-not-an-object",
+			body:  []string{"properties"},
+			found: true,
+			rErr:  true,
+		},
+	}
+
+	for _, tt := range patchCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPatch, resourceTestHeaderFile, tt.body)
+			require.NoError(t, err)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			ctx := rpctest.NewARMRequestContext(req)
+			sCtx := v1.ARMRequestContextFromContext(ctx)
+
+			if tt.found {
+				existing := &TestResourceDataModel{}
+				require.NoError(t, json.Unmarshal(testutil.ReadFixture("resource-datamodel.json"), existing))
+				existing.Tags = map[string]string{"team": "a"}
+
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(&store.Object{Metadata: store.Metadata{ID: sCtx.ResourceID.String(), ETag: "etag-1"}, Data: existing}, nil).
+					Times(1)
+			} else {
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(nil, &store.ErrNotFound{ID: sCtx.ResourceID.String()}).
+					Times(1)
+			}
+
+			if tt.expectedProperties != nil {
+				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+						saved := obj.Data.(*TestResourceDataModel)
+						require.Equal(t, tt.expectedProperties, saved.Properties)
+						require.Equal(t, tt.expectedTags, saved.Tags)
+						require.Equal(t, v1.ProvisioningStateSucceeded, saved.InternalMetadata.AsyncProvisioningState)
+						return nil
+					}).
+					Times(1)
+			}
+
+			opts := ctrl.Options{
+				StorageClient: mds,
+				StatusManager: msm,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+				UpdateFilters: []ctrl.UpdateFilter[TestResourceDataModel]{
+					testValidateRequest,
+				},
+			}
+
+			ctl, err := NewDefaultSyncPatch(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			if tt.rErr {
+				require.ErrorAs(t, err, new(*v1.ErrClientRP))
+				return
+			}
+
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.rCode, w.Result().StatusCode)
+		})
+	}
+}
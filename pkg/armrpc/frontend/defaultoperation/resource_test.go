@@ -54,12 +54,21 @@ func (r *TestResourceDataModel) ResourceTypeName() string {
 	return "Applications.Core/resources"
 }
 
+// WriteOnlyProperties returns the secret of the resource, which is not returned by the API.
+func (r *TestResourceDataModel) WriteOnlyProperties() map[string]any {
+	if r.Properties == nil || r.Properties.Secret == "" {
+		return nil
+	}
+	return map[string]any{"secret": r.Properties.Secret}
+}
+
 // TestResourceDataModelProperties represents the properties of TestResourceDataModel.
 type TestResourceDataModelProperties struct {
 	Application string `json:"application"`
 	Environment string `json:"environment"`
 	PropertyA   string `json:"propertyA,omitempty"`
 	PropertyB   string `json:"propertyB,omitempty"`
+	Secret      string `json:"secret,omitempty"`
 }
 
 // TestResource represents test resource for api version.
@@ -80,6 +89,7 @@ type TestResourceProperties struct {
 	Application       *string               `json:"application,omitempty"`
 	PropertyA         *string               `json:"propertyA,omitempty"`
 	PropertyB         *string               `json:"propertyB,omitempty"`
+	Secret            *string               `json:"secret,omitempty"`
 }
 
 // ConvertTo converts a version specific TestResource into a version-agnostic resource, TestResourceDataModel.
@@ -103,6 +113,7 @@ func (src *TestResource) ConvertTo() (v1.DataModelInterface, error) {
 			Environment: to.String(src.Properties.Environment),
 			PropertyA:   to.String(src.Properties.PropertyA),
 			PropertyB:   to.String(src.Properties.PropertyB),
+			Secret:      to.String(src.Properties.Secret),
 		},
 	}
 	return converted, nil
@@ -127,6 +138,7 @@ func (dst *TestResource) ConvertFrom(src v1.DataModelInterface) error {
 		Application:       to.Ptr(dm.Properties.Application),
 		PropertyA:         to.Ptr(dm.Properties.PropertyA),
 		PropertyB:         to.Ptr(dm.Properties.PropertyB),
+		// Secret is write-only.
 	}
 
 	return nil
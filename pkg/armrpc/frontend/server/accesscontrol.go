@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"errors"
+
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"k8s.io/client-go/rest"
+)
+
+// NewAccessControl creates the authenticators and the authorizer for a server from its configuration. Both are empty
+// when the corresponding configuration is unset. Authorization requires authentication because role assignments are
+// evaluated against the authenticated principal.
+func NewAccessControl(authnOptions *authentication.Options, authzOptions *authorization.Options, kubeConfig *rest.Config) ([]authentication.Authenticator, *authorization.Authorizer, error) {
+	if authzOptions != nil && authnOptions == nil {
+		return nil, nil, errors.New("authorization requires authentication to be configured")
+	}
+
+	authenticators, err := authentication.NewAuthenticators(authnOptions, kubeConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if authzOptions == nil {
+		return authenticators, nil, nil
+	}
+
+	authorizer, err := authorization.NewAuthorizer(authzOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return authenticators, authorizer, nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewAccessControl(t *testing.T) {
+	oidc := &authentication.Options{OIDC: &authentication.OIDCOptions{Issuer: "https://issuer", Audience: "radius"}}
+
+	t.Run("disabled", func(t *testing.T) {
+		authenticators, authorizer, err := NewAccessControl(nil, nil, nil)
+		require.NoError(t, err)
+		require.Empty(t, authenticators)
+		require.Nil(t, authorizer)
+	})
+
+	t.Run("authentication only", func(t *testing.T) {
+		authenticators, authorizer, err := NewAccessControl(oidc, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, authenticators, 1)
+		require.Nil(t, authorizer)
+	})
+
+	t.Run("authentication and authorization", func(t *testing.T) {
+		authenticators, authorizer, err := NewAccessControl(oidc, &authorization.Options{}, nil)
+		require.NoError(t, err)
+		require.Len(t, authenticators, 1)
+		require.NotNil(t, authorizer)
+	})
+
+	t.Run("authorization requires authentication", func(t *testing.T) {
+		_, _, err := NewAccessControl(nil, &authorization.Options{}, nil)
+		require.ErrorContains(t, err, "authorization requires authentication")
+	})
+}
@@ -26,8 +26,13 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/locks"
+	"github.com/radius-project/radius/pkg/armrpc/notifications"
+	"github.com/radius-project/radius/pkg/armrpc/quotas"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -108,6 +113,52 @@ func HandlerForController(controller ctrl.Controller, operationType v1.Operation
 		// Set the operation type in the context.
 		rpcCtx.OperationType = operationType
 
+		// Record mutating operations in the audit log when it is enabled.
+		if sink := audit.FromContext(ctx); sink != nil && audit.IsAudited(req.Method) {
+			recorder := audit.NewResponseRecorder(w)
+			w = recorder
+			defer audit.RecordRequest(ctx, sink, req, operationType, recorder)
+		}
+
+		// Enforce role-based access control for the operation when it is enabled.
+		if response := authorization.AuthorizeRequest(ctx, req, operationType); response != nil {
+			if err := response.Apply(ctx, w, req); err != nil {
+				HandleError(ctx, w, req, err)
+			}
+			return
+		}
+
+		// Reject changes to scopes that are protected by a lock.
+		if response, err := locks.CheckRequest(ctx, req); response != nil || err != nil {
+			if err == nil {
+				err = response.Apply(ctx, w, req)
+			}
+			if err != nil {
+				HandleError(ctx, w, req, err)
+			}
+			return
+		}
+
+		// Reject changes that exceed a quota of the resource group or environment.
+		if response, err := quotas.CheckRequest(ctx, req); response != nil || err != nil {
+			if err == nil {
+				err = response.Apply(ctx, w, req)
+			}
+			if err != nil {
+				HandleError(ctx, w, req, err)
+			}
+			return
+		}
+
+		// Notify event subscriptions of the change that the request makes to the resource.
+		if publisher := notifications.FromContext(ctx); publisher != nil {
+			if change := publisher.ObserveRequest(ctx, req, operationType, rpcCtx.ResourceID); change != nil {
+				recorder := audit.NewResponseRecorder(w)
+				w = recorder
+				defer func() { change.Complete(ctx, recorder.StatusCode, recorder.Header()) }()
+			}
+		}
+
 		// Add OTEL labels for the telemetry.
 		withOtelLabelsForRequest(req)
 
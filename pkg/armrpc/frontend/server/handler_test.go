@@ -29,11 +29,18 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/golang/mock/gomock"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/locks"
+	"github.com/radius-project/radius/pkg/armrpc/notifications"
+	"github.com/radius-project/radius/pkg/armrpc/quotas"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
@@ -289,3 +296,221 @@ func Test_HandlerForController_OperationType(t *testing.T) {
 
 	require.Equal(t, expectedType.String(), rCtx.OperationType.String())
 }
+
+func Test_HandlerForController_Authorization(t *testing.T) {
+	authorizer, err := authorization.NewAuthorizer(&authorization.Options{
+		RoleAssignments: []authorization.RoleAssignment{
+			{Role: authorization.RoleOwner, Group: "team-a", Scope: "/planes/radius/local/resourceGroups/team-a"},
+		},
+	})
+	require.NoError(t, err)
+
+	operationType := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationDelete}
+	handler := authorization.WithAuthorizer(authorizer)(HandlerForController(&testAPIController{}, operationType))
+
+	tests := []struct {
+		name     string
+		groups   []string
+		expected int
+	}{
+		{name: "authorized", groups: []string{"team-a"}, expected: http.StatusOK},
+		{name: "forbidden", groups: []string{"team-b"}, expected: http.StatusForbidden},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/planes/radius/local/resourceGroups/team-a/providers/Applications.Core/environments/env?api-version=2023-10-01-preview", nil)
+			rpcCtx, err := v1.FromARMRequest(req, "", "global")
+			require.NoError(t, err)
+
+			ctx := v1.WithARMRequestContext(context.Background(), rpcCtx)
+			ctx = authentication.WithPrincipal(ctx, &authentication.Principal{Name: "alice", Groups: tc.groups})
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req.WithContext(ctx))
+
+			require.Equal(t, tc.expected, w.Code)
+		})
+	}
+}
+
+type testAuditSink struct {
+	events []*audit.Event
+}
+
+func (s *testAuditSink) Write(ctx context.Context, event *audit.Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func Test_HandlerForController_Audit(t *testing.T) {
+	sink := &testAuditSink{}
+	operationType := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationDelete}
+	handler := audit.WithSink(sink)(HandlerForController(&testAPIController{}, operationType))
+
+	for _, method := range []string{http.MethodGet, http.MethodDelete} {
+		req := httptest.NewRequest(method, "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env?api-version=2023-10-01-preview", nil)
+		rpcCtx, err := v1.FromARMRequest(req, "", "global")
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req.WithContext(v1.WithARMRequestContext(context.Background(), rpcCtx)))
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+
+	// Only the DELETE request is audited.
+	require.Len(t, sink.events, 1)
+	require.Equal(t, http.MethodDelete, sink.events[0].Method)
+	require.Equal(t, "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env", sink.events[0].ResourceID)
+	require.Equal(t, audit.OutcomeSucceeded, sink.events[0].Outcome)
+}
+
+func Test_HandlerForController_Locks(t *testing.T) {
+	const environmentID = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env"
+
+	mctrl := gomock.NewController(t)
+	provider := dataprovider.NewMockDataStorageProvider(mctrl)
+	client := store.NewMockStorageClient(mctrl)
+	provider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(client, nil).AnyTimes()
+	client.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&store.ObjectQueryResult{
+			Items: []store.Object{{Data: map[string]any{
+				"id":         "/planes/radius/local/resourceGroups/rg/providers/System.Locks/locks/lock0",
+				"properties": map[string]any{"level": "CanNotDelete", "scope": environmentID},
+			}}},
+		}, nil).AnyTimes()
+	client.EXPECT().Get(gomock.Any(), environmentID).Return(&store.Object{Data: map[string]any{}}, nil).AnyTimes()
+
+	operationType := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationDelete}
+	handler := locks.WithChecker(locks.NewChecker(provider))(HandlerForController(&testAPIController{}, operationType))
+
+	tests := []struct {
+		method   string
+		expected int
+	}{
+		{http.MethodGet, http.StatusOK},
+		{http.MethodPut, http.StatusOK},
+		{http.MethodDelete, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, environmentID+"?api-version=2023-10-01-preview", bytes.NewBufferString("{}"))
+			rpcCtx, err := v1.FromARMRequest(req, "", "global")
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req.WithContext(v1.WithARMRequestContext(context.Background(), rpcCtx)))
+			require.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+func Test_HandlerForController_Notifications(t *testing.T) {
+	const environmentID = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env"
+
+	received := make(chan notifications.Event, 10)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := notifications.Event{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	}))
+	defer receiver.Close()
+
+	mctrl := gomock.NewController(t)
+	provider := dataprovider.NewMockDataStorageProvider(mctrl)
+	client := store.NewMockStorageClient(mctrl)
+	provider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(client, nil).AnyTimes()
+	client.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&store.ObjectQueryResult{
+			Items: []store.Object{{Data: map[string]any{
+				"id":         "/planes/radius/local/providers/System.Events/eventSubscriptions/sub0",
+				"properties": map[string]any{"url": receiver.URL, "scope": "/planes/radius/local"},
+			}}},
+		}, nil).AnyTimes()
+	client.EXPECT().Get(gomock.Any(), environmentID).Return(nil, &store.ErrNotFound{ID: environmentID}).AnyTimes()
+	client.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	publisher := notifications.NewPublisher(provider, notifications.Options{})
+	publisher.Start(testcontext.New(t))
+	operationType := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationPut}
+	handler := notifications.WithPublisher(publisher)(HandlerForController(&testAPIController{}, operationType))
+
+	for _, method := range []string{http.MethodGet, http.MethodPut} {
+		req := httptest.NewRequest(method, environmentID+"?api-version=2023-10-01-preview", bytes.NewBufferString("{}"))
+		rpcCtx, err := v1.FromARMRequest(req, "", "global")
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req.WithContext(v1.WithARMRequestContext(context.Background(), rpcCtx)))
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+	publisher.Wait()
+
+	// Only the PUT request is notified, as the creation of the environment.
+	require.Len(t, received, 1)
+	event := <-received
+	require.Equal(t, "io.radapp.resource.created", event.Type)
+	require.Equal(t, environmentID, event.Subject)
+	require.Equal(t, "APPLICATIONS.CORE/ENVIRONMENTS|PUT", event.Data.OperationType)
+}
+
+func Test_HandlerForController_Quotas(t *testing.T) {
+	const (
+		resourceGroupID = "/planes/radius/local/resourceGroups/rg"
+		existingID      = resourceGroupID + "/providers/Applications.Datastores/redisCaches/existing"
+		newID           = resourceGroupID + "/providers/Applications.Datastores/redisCaches/new"
+	)
+
+	mctrl := gomock.NewController(t)
+	provider := dataprovider.NewMockDataStorageProvider(mctrl)
+	client := store.NewMockStorageClient(mctrl)
+	provider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(client, nil).AnyTimes()
+	client.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			if query.ResourceType == "System.Quotas/quotas" {
+				return &store.ObjectQueryResult{
+					Items: []store.Object{{Data: map[string]any{
+						"id": resourceGroupID + "/providers/System.Quotas/quotas/quota0",
+						"properties": map[string]any{
+							"scope":  resourceGroupID,
+							"limits": map[string]any{"resourceCounts": map[string]any{"Applications.Datastores/redisCaches": 1}},
+						},
+					}}},
+				}, nil
+			}
+			return &store.ObjectQueryResult{
+				Items: []store.Object{{Data: map[string]any{"id": existingID, "type": "Applications.Datastores/redisCaches"}}},
+			}, nil
+		}).AnyTimes()
+	client.EXPECT().Get(gomock.Any(), existingID).Return(&store.Object{Data: map[string]any{"id": existingID, "type": "Applications.Datastores/redisCaches"}}, nil).AnyTimes()
+	client.EXPECT().Get(gomock.Any(), newID).Return(nil, &store.ErrNotFound{ID: newID}).AnyTimes()
+
+	operationType := v1.OperationType{Type: "Applications.Datastores/redisCaches", Method: v1.OperationPut}
+	handler := quotas.WithChecker(quotas.NewChecker(provider))(HandlerForController(&testAPIController{}, operationType))
+
+	tests := []struct {
+		name     string
+		method   string
+		id       string
+		expected int
+	}{
+		{"get new resource", http.MethodGet, newID, http.StatusOK},
+		{"update existing resource", http.MethodPut, existingID, http.StatusOK},
+		{"create new resource", http.MethodPut, newID, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.id+"?api-version=2023-10-01-preview", bytes.NewBufferString("{}"))
+			rpcCtx, err := v1.FromARMRequest(req, "", "global")
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req.WithContext(v1.WithARMRequestContext(context.Background(), rpcCtx)))
+			require.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
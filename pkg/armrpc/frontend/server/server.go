@@ -21,7 +21,13 @@ import (
 	"net"
 	"net/http"
 
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/armrpc/locks"
+	"github.com/radius-project/radius/pkg/armrpc/notifications"
+	"github.com/radius-project/radius/pkg/armrpc/quotas"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/validator"
@@ -45,6 +51,27 @@ type Options struct {
 	EnableArmAuth bool
 	Configure     func(chi.Router) error
 	ArmCertMgr    *authentication.ArmCertManager
+
+	// Authenticators identify the caller of each request. Requests are not authenticated if empty.
+	Authenticators []authentication.Authenticator
+
+	// Authorizer enforces role-based access control for each operation. Requests are not authorized if nil.
+	Authorizer *authorization.Authorizer
+
+	// AuditSink records mutating operations. Operations are not audited if nil.
+	AuditSink audit.Sink
+
+	// LockChecker rejects operations that are prevented by locks. Locks are not enforced if nil.
+	LockChecker *locks.Checker
+
+	// QuotaChecker rejects operations that exceed quotas. Quotas are not enforced if nil.
+	QuotaChecker *quotas.Checker
+
+	// Publisher notifies event subscriptions of resource changes. Changes are not notified if nil.
+	Publisher *notifications.Publisher
+
+	// RateLimiter rejects requests that exceed the rate limits of their client. Requests are not limited if nil.
+	RateLimiter *ratelimit.Limiter
 }
 
 // New creates a frontend server that can listen on the provided address and serve requests - it creates an HTTP server with a router,
@@ -63,6 +90,27 @@ func New(ctx context.Context, options Options) (*http.Server, error) {
 	if options.EnableArmAuth {
 		r.Use(authentication.ClientCertValidator(options.ArmCertMgr))
 	}
+	if len(options.Authenticators) > 0 {
+		r.Use(authentication.Authenticate(options.Authenticators))
+	}
+	if options.RateLimiter != nil {
+		r.Use(ratelimit.WithLimiter(options.RateLimiter))
+	}
+	if options.Authorizer != nil {
+		r.Use(authorization.WithAuthorizer(options.Authorizer))
+	}
+	if options.AuditSink != nil {
+		r.Use(audit.WithSink(options.AuditSink))
+	}
+	if options.LockChecker != nil {
+		r.Use(locks.WithChecker(options.LockChecker))
+	}
+	if options.QuotaChecker != nil {
+		r.Use(quotas.WithChecker(options.QuotaChecker))
+	}
+	if options.Publisher != nil {
+		r.Use(notifications.WithPublisher(options.Publisher))
+	}
 	r.Use(servicecontext.ARMRequestCtx(options.PathBase, options.Location))
 
 	r.Get(versionEndpoint, version.ReportVersionHandler)
@@ -22,8 +22,14 @@ import (
 	"net/http"
 
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/locks"
+	"github.com/radius-project/radius/pkg/armrpc/notifications"
+	"github.com/radius-project/radius/pkg/armrpc/quotas"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
@@ -50,9 +56,31 @@ type Service struct {
 
 	// KubeClient is the Kubernetes controller runtime client.
 	KubeClient controller_runtime.Client
+
+	// Authenticators identify the caller of each request.
+	Authenticators []authentication.Authenticator
+
+	// Authorizer enforces role-based access control.
+	Authorizer *authorization.Authorizer
+
+	// AuditSink records mutating operations.
+	AuditSink audit.Sink
+
+	// LockChecker rejects operations that are prevented by locks.
+	LockChecker *locks.Checker
+
+	// QuotaChecker rejects operations that exceed quotas.
+	QuotaChecker *quotas.Checker
+
+	// Publisher notifies event subscriptions of resource changes and operation transitions.
+	Publisher *notifications.Publisher
+
+	// RateLimiter rejects requests that exceed the rate limits of their client.
+	RateLimiter *ratelimit.Limiter
 }
 
-// Init initializes web service - it initializes the StorageProvider, QueueProvider, OperationStatusManager, KubeClient and ARMCertManager
+// Init initializes web service - it initializes the StorageProvider, QueueProvider, OperationStatusManager, KubeClient, ARMCertManager,
+// Authenticators, Authorizer, AuditSink, LockChecker, QuotaChecker, Publisher and RateLimiter
 // with the given context and returns an error if any of the initialization fails.
 func (s *Service) Init(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -63,7 +91,9 @@ func (s *Service) Init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	s.OperationStatusManager = manager.New(s.StorageProvider, reqQueueClient, s.Options.Config.Env.RoleLocation)
+	s.Publisher = notifications.NewPublisher(s.StorageProvider, notifications.Options{})
+	s.Publisher.Start(ctx)
+	s.OperationStatusManager = manager.New(s.StorageProvider, reqQueueClient, s.Options.Config.Env.RoleLocation, s.Publisher)
 	s.KubeClient, err = kubeutil.NewRuntimeClient(s.Options.K8sConfig)
 	if err != nil {
 		return err
@@ -77,6 +107,24 @@ func (s *Service) Init(ctx context.Context) error {
 		}
 	}
 
+	s.Authenticators, s.Authorizer, err = NewAccessControl(s.Options.Config.Server.Authentication, s.Options.Config.Server.Authorization, s.Options.K8sConfig)
+	if err != nil {
+		return err
+	}
+
+	s.AuditSink, err = audit.NewSink(ctx, s.Options.Config.Audit, s.StorageProvider)
+	if err != nil {
+		return err
+	}
+
+	s.LockChecker = locks.NewChecker(s.StorageProvider)
+	s.QuotaChecker = quotas.NewChecker(s.StorageProvider)
+
+	s.RateLimiter, err = ratelimit.NewLimiter(s.Options.Config.Server.PathBase, s.Options.Config.Server.RateLimit)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -17,6 +17,10 @@ limitations under the License.
 package hostoptions
 
 import (
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
 	profilerprovider "github.com/radius-project/radius/pkg/profiler/provider"
 	"github.com/radius-project/radius/pkg/trace"
@@ -43,6 +47,10 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                    `yaml:"logging"`
 	Bicep            BicepOptions                             `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                         `yaml:"terraform,omitempty"`
+	Certificates     CertificateOptions                       `yaml:"certificates,omitempty"`
+
+	// Audit configures the audit log of mutating operations. Operations are not audited when unset.
+	Audit *audit.Options `yaml:"audit,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -59,6 +67,12 @@ type ServerOptions struct {
 	ArmMetadataEndpoint string `yaml:"armMetadataEndpoint,omitempty"`
 	// EnableAuth when set the arm client authetication will be performed
 	EnableArmAuth bool `yaml:"enableArmAuth,omitempty"`
+	// Authentication configures how callers are authenticated. Requests are not authenticated when unset.
+	Authentication *authentication.Options `yaml:"authentication,omitempty"`
+	// Authorization configures role-based access control. Requires Authentication. Requests are not authorized when unset.
+	Authorization *authorization.Options `yaml:"authorization,omitempty"`
+	// RateLimit configures the rate limiting of the requests of each client. Requests are not limited when unset.
+	RateLimit *ratelimit.Options `yaml:"rateLimit,omitempty"`
 }
 
 // WorkerServerOptions includes the worker server options.
@@ -84,3 +98,11 @@ type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string `yaml:"path,omitempty"`
 }
+
+// CertificateOptions includes options for the automatic issuance of gateway certificates.
+type CertificateOptions struct {
+	// RenewalInterval is how often managed certificates are checked for renewal, e.g. "12h".
+	RenewalInterval string `yaml:"renewalInterval,omitempty"`
+	// ACMEChallengePort is the port that serves ACME HTTP-01 challenges. HTTP-01 challenges are not served when it is 0.
+	ACMEChallengePort int `yaml:"acmeChallengePort,omitempty"`
+}
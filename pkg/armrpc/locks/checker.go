@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Checker finds the locks that prevent an operation. Locks are read from the data store so that locks created through
+// UCP are enforced by every resource provider that shares the data store.
+type Checker struct {
+	storageProvider dataprovider.DataStorageProvider
+}
+
+// NewChecker creates a Checker that reads locks and locked resources from the storage provider.
+func NewChecker(storageProvider dataprovider.DataStorageProvider) *Checker {
+	return &Checker{storageProvider: storageProvider}
+}
+
+// List returns the locks stored in the plane of the scope, including the locks of its resource groups.
+func (c *Checker) List(ctx context.Context, scope resources.ID) ([]datamodel.Lock, error) {
+	client, err := c.storageProvider.GetStorageClient(ctx, datamodel.LockResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := store.Query{
+		RootScope:      scope.PlaneScope(),
+		ScopeRecursive: true,
+		ResourceType:   datamodel.LockResourceType,
+	}
+
+	locks := []datamodel.Lock{}
+	token := ""
+	for {
+		result, err := client.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			lock := datamodel.Lock{}
+			if err := item.As(&lock); err != nil {
+				return nil, err
+			}
+			locks = append(locks, lock)
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		token = result.PaginationToken
+	}
+
+	return locks, nil
+}
+
+// Check returns the lock that prevents the request from changing the resource, or nil if the request is allowed.
+func (c *Checker) Check(ctx context.Context, req *http.Request, operation Operation, id resources.ID) (*datamodel.Lock, error) {
+	locks, err := c.List(ctx, id)
+	if err != nil || len(locks) == 0 {
+		return nil, err
+	}
+
+	target := Target{ID: id.String()}
+	if operation == OperationWrite {
+		target.Memberships = append(target.Memberships, requestMemberships(req)...)
+	}
+	stored, err := c.memberships(ctx, id.String())
+	if err != nil {
+		return nil, err
+	}
+	target.Memberships = append(target.Memberships, stored...)
+
+	return Find(locks, target, operation, func(scope string) ([]string, error) {
+		return c.memberships(ctx, scope)
+	})
+}
+
+// memberships returns the application and environment of the stored resource with the given ID. Scopes and resources
+// that do not exist have no memberships.
+func (c *Checker) memberships(ctx context.Context, id string) ([]string, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil || !parsed.IsResource() || parsed.ProviderNamespace() == "" {
+		return nil, nil
+	}
+
+	client, err := c.storageProvider.GetStorageClient(ctx, parsed.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := client.Get(ctx, parsed.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(obj.Data)
+	if err != nil {
+		return nil, err
+	}
+	return parseMemberships(b), nil
+}
+
+// requestMemberships returns the application and environment in the body of the request. The body is restored so that
+// it can be read by the controller.
+func requestMemberships(req *http.Request) []string {
+	if req.Body == nil {
+		return nil
+	}
+
+	b, err := io.ReadAll(req.Body)
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return nil
+	}
+	return parseMemberships(b)
+}
+
+func parseMemberships(b []byte) []string {
+	resource := struct {
+		Properties struct {
+			Application string `json:"application"`
+			Environment string `json:"environment"`
+		} `json:"properties"`
+	}{}
+	if err := json.Unmarshal(b, &resource); err != nil {
+		return nil
+	}
+
+	memberships := []string{}
+	for _, membership := range []string{resource.Properties.Application, resource.Properties.Environment} {
+		if membership != "" {
+			memberships = append(memberships, membership)
+		}
+	}
+	return memberships
+}
+
+type checkerKey struct{}
+
+// WithChecker returns a middleware that stores the lock checker in the request context.
+func WithChecker(checker *Checker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), checkerKey{}, checker)))
+		})
+	}
+}
+
+// FromContext returns the lock checker stored in the context, or nil if locks are not enforced.
+func FromContext(ctx context.Context) *Checker {
+	checker, ok := ctx.Value(checkerKey{}).(*Checker)
+	if !ok {
+		return nil
+	}
+	return checker
+}
+
+// CheckRequest checks whether a lock prevents the request from changing the resource of the request. It returns nil
+// if the request is allowed or locks are not enforced, and the error response to send otherwise.
+func CheckRequest(ctx context.Context, req *http.Request) (rest.Response, error) {
+	checker := FromContext(ctx)
+	if checker == nil {
+		return nil, nil
+	}
+
+	operation := OperationForRequest(req)
+	id := v1.ARMRequestContextFromContext(ctx).ResourceID
+	if operation == "" || id.String() == "" || IsLock(id.Type()) {
+		return nil, nil
+	}
+
+	lock, err := checker.Check(ctx, req, operation, id)
+	if err != nil || lock == nil {
+		return nil, err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info("request is prevented by a lock", "lock", lock.ID, "operation", operation, "scope", id.String())
+	return NewScopeLockedResponse(id.String(), operation, lock), nil
+}
+
+// NewScopeLockedResponse creates a 409 Conflict response for an operation that is prevented by the lock.
+func NewScopeLockedResponse(scope string, operation Operation, lock *datamodel.Lock) rest.Response {
+	return &rest.ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeScopeLocked,
+				Message: fmt.Sprintf("The scope '%s' cannot perform %s operation because it is protected by the %s lock '%s' on scope '%s'. Please remove the lock and try again.", scope, operation, lock.Properties.Level, lock.ID, lock.Properties.Scope),
+				Target:  scope,
+			},
+		},
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const testLockID = testRG + "/providers/System.Locks/locks/lock0"
+
+func setupChecker(t *testing.T, locks []datamodel.Lock) (*Checker, *store.MockStorageClient) {
+	mctrl := gomock.NewController(t)
+	provider := dataprovider.NewMockDataStorageProvider(mctrl)
+	client := store.NewMockStorageClient(mctrl)
+	provider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(client, nil).AnyTimes()
+
+	items := []store.Object{}
+	for _, lock := range locks {
+		items = append(items, *testutil.MustGetStoreObject(t, lock))
+	}
+	expectedQuery := store.Query{RootScope: "/planes/radius/local", ScopeRecursive: true, ResourceType: datamodel.LockResourceType}
+	client.EXPECT().Query(gomock.Any(), expectedQuery, gomock.Any()).Return(&store.ObjectQueryResult{Items: items}, nil).AnyTimes()
+
+	return NewChecker(provider), client
+}
+
+func newLock(level datamodel.LockLevel, scope string) datamodel.Lock {
+	return datamodel.Lock{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: testLockID, Name: "lock0", Type: datamodel.LockResourceType}},
+		Properties:   datamodel.LockProperties{Level: level, Scope: scope},
+	}
+}
+
+func newRequest(t *testing.T, checker *Checker, method string, path string, body string) (context.Context, *http.Request) {
+	req, err := http.NewRequest(method, path+"?api-version=2023-10-01-preview", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	if checker != nil {
+		ctx = context.WithValue(ctx, checkerKey{}, checker)
+	}
+	return ctx, req.WithContext(ctx)
+}
+
+func Test_CheckRequest_NotEnforced(t *testing.T) {
+	ctx, req := newRequest(t, nil, http.MethodDelete, testContainer, "")
+	resp, err := CheckRequest(ctx, req)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_CheckRequest_Read(t *testing.T) {
+	// The storage provider is not called for reads.
+	checker := NewChecker(dataprovider.NewMockDataStorageProvider(gomock.NewController(t)))
+	ctx, req := newRequest(t, checker, http.MethodGet, testContainer, "")
+	resp, err := CheckRequest(ctx, req)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_CheckRequest_Lock(t *testing.T) {
+	// Locks can always be removed.
+	checker := NewChecker(dataprovider.NewMockDataStorageProvider(gomock.NewController(t)))
+	ctx, req := newRequest(t, checker, http.MethodDelete, testLockID, "")
+	resp, err := CheckRequest(ctx, req)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_CheckRequest_NoLocks(t *testing.T) {
+	checker, _ := setupChecker(t, nil)
+	ctx, req := newRequest(t, checker, http.MethodDelete, testContainer, "")
+	resp, err := CheckRequest(ctx, req)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_CheckRequest_WriteReadOnlyApplication(t *testing.T) {
+	checker, client := setupChecker(t, []datamodel.Lock{newLock(datamodel.LockLevelReadOnly, testApplication)})
+	client.EXPECT().Get(gomock.Any(), testContainer).Return(nil, &store.ErrNotFound{ID: testContainer})
+
+	body := `{"properties":{"application":"` + testApplication + `"}}`
+	ctx, req := newRequest(t, checker, http.MethodPut, testContainer, body)
+	resp, err := CheckRequest(ctx, req)
+	require.NoError(t, err)
+
+	conflict, ok := resp.(*rest.ConflictResponse)
+	require.True(t, ok)
+	require.Equal(t, v1.CodeScopeLocked, conflict.Body.Error.Code)
+	require.Equal(t, testContainer, conflict.Body.Error.Target)
+	require.Contains(t, conflict.Body.Error.Message, testLockID)
+
+	w := httptest.NewRecorder()
+	require.NoError(t, resp.Apply(ctx, w, req))
+	require.Equal(t, http.StatusConflict, w.Code)
+
+	// The body can still be read by the controller.
+	b, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	require.Equal(t, body, string(b))
+}
+
+func Test_CheckRequest_WriteCanNotDeleteResource(t *testing.T) {
+	checker, client := setupChecker(t, []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, testContainer)})
+	client.EXPECT().Get(gomock.Any(), testContainer).Return(nil, &store.ErrNotFound{ID: testContainer})
+
+	ctx, req := newRequest(t, checker, http.MethodPut, testContainer, `{}`)
+	resp, err := CheckRequest(ctx, req)
+	require.NoError(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_CheckRequest_DeleteApplicationOfLockedResource(t *testing.T) {
+	checker, client := setupChecker(t, []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, testContainer)})
+	container := map[string]any{"properties": map[string]any{"application": testApplication}}
+	client.EXPECT().Get(gomock.Any(), testApplication).Return(&store.Object{Data: map[string]any{}}, nil)
+	client.EXPECT().Get(gomock.Any(), testContainer).Return(&store.Object{Data: container}, nil)
+
+	ctx, req := newRequest(t, checker, http.MethodDelete, testApplication, "")
+	resp, err := CheckRequest(ctx, req)
+	require.NoError(t, err)
+	require.IsType(t, &rest.ConflictResponse{}, resp)
+}
+
+func Test_CheckRequest_DeleteResourceGroupContainingLock(t *testing.T) {
+	checker, _ := setupChecker(t, []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, testContainer)})
+
+	ctx, req := newRequest(t, checker, http.MethodDelete, testRG, "")
+	resp, err := CheckRequest(ctx, req)
+	require.NoError(t, err)
+	require.IsType(t, &rest.ConflictResponse{}, resp)
+}
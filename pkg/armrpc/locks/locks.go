@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// Operation is the kind of change that a request makes to a scope.
+type Operation string
+
+const (
+	// OperationWrite creates, updates or runs an action on a scope.
+	OperationWrite Operation = "write"
+
+	// OperationDelete deletes a scope.
+	OperationDelete Operation = "delete"
+)
+
+// OperationForRequest returns the kind of change that the request makes, or an empty string if the request only reads.
+//
+// POST requests run actions. Actions whose names begin with 'get' or 'list' (for example listSecrets) only read and
+// are allowed on locked scopes.
+func OperationForRequest(req *http.Request) Operation {
+	switch req.Method {
+	case http.MethodDelete:
+		return OperationDelete
+	case http.MethodPut, http.MethodPatch:
+		return OperationWrite
+	case http.MethodPost:
+		action := strings.ToLower(req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:])
+		if strings.HasPrefix(action, "get") || strings.HasPrefix(action, "list") {
+			return ""
+		}
+		return OperationWrite
+	default:
+		return ""
+	}
+}
+
+// Prevents returns true if a lock of the given level prevents the operation.
+func Prevents(level datamodel.LockLevel, operation Operation) bool {
+	switch level {
+	case datamodel.LockLevelReadOnly:
+		return operation == OperationWrite || operation == OperationDelete
+	case datamodel.LockLevelCanNotDelete:
+		return operation == OperationDelete
+	default:
+		return false
+	}
+}
+
+// Target is a scope that an operation changes.
+type Target struct {
+	// ID is the ID of the plane, resource group or resource.
+	ID string
+
+	// Memberships are the IDs of the application and environment that the resource belongs to.
+	Memberships []string
+}
+
+// AppliesTo returns true if a lock on scope protects the target: the target is the locked scope, is contained in the
+// locked scope, or belongs to the locked application or environment.
+func AppliesTo(scope string, target Target) bool {
+	if Contains(scope, target.ID) {
+		return true
+	}
+	for _, membership := range target.Memberships {
+		if normalize(scope) == normalize(membership) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains returns true if scope is equal to or a parent of id. The comparison is case-insensitive.
+func Contains(scope string, id string) bool {
+	scope = normalize(scope)
+	id = normalize(id)
+	return scope != "" && (id == scope || strings.HasPrefix(id, scope+"/"))
+}
+
+// IsLock returns true if the ID refers to a lock or a collection of locks. Locks are not protected by other locks so
+// that a lock can always be removed.
+func IsLock(resourceType string) bool {
+	return strings.EqualFold(resourceType, datamodel.LockResourceType)
+}
+
+// Find returns the first lock that prevents the operation on the target, or nil if the operation is allowed.
+//
+// Deleting a scope also deletes its contents, so a delete is prevented by any lock on a scope that the target contains.
+// The members function returns the memberships of a locked scope so that deleting an application or environment is
+// prevented by locks on its resources. It may be nil if memberships are not needed.
+func Find(locks []datamodel.Lock, target Target, operation Operation, members func(scope string) ([]string, error)) (*datamodel.Lock, error) {
+	for i := range locks {
+		lock := &locks[i]
+		if !Prevents(lock.Properties.Level, operation) {
+			continue
+		}
+		if AppliesTo(lock.Properties.Scope, target) {
+			return lock, nil
+		}
+		if operation != OperationDelete {
+			continue
+		}
+		if Contains(target.ID, lock.Properties.Scope) {
+			return lock, nil
+		}
+		if members == nil {
+			continue
+		}
+		memberships, err := members(lock.Properties.Scope)
+		if err != nil {
+			return nil, err
+		}
+		for _, membership := range memberships {
+			if Contains(target.ID, membership) {
+				return lock, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func normalize(scope string) string {
+	return strings.TrimSuffix(strings.ToLower(scope), "/")
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRG          = "/planes/radius/local/resourceGroups/test-rg"
+	testApplication = testRG + "/providers/Applications.Core/applications/app"
+	testContainer   = testRG + "/providers/Applications.Core/containers/frontend"
+)
+
+func Test_OperationForRequest(t *testing.T) {
+	tests := []struct {
+		method   string
+		path     string
+		expected Operation
+	}{
+		{http.MethodGet, testContainer, ""},
+		{http.MethodHead, testContainer, ""},
+		{http.MethodPut, testContainer, OperationWrite},
+		{http.MethodPatch, testContainer, OperationWrite},
+		{http.MethodDelete, testContainer, OperationDelete},
+		{http.MethodPost, testRG + "/providers/Applications.Datastores/redisCaches/cache/listSecrets", ""},
+		{http.MethodPost, testApplication + "/getGraph", ""},
+		{http.MethodPost, testApplication + "/rollback", OperationWrite},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, tt.path, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, OperationForRequest(req))
+		})
+	}
+}
+
+func Test_Prevents(t *testing.T) {
+	require.True(t, Prevents(datamodel.LockLevelCanNotDelete, OperationDelete))
+	require.False(t, Prevents(datamodel.LockLevelCanNotDelete, OperationWrite))
+	require.True(t, Prevents(datamodel.LockLevelReadOnly, OperationDelete))
+	require.True(t, Prevents(datamodel.LockLevelReadOnly, OperationWrite))
+	require.False(t, Prevents("Unknown", OperationDelete))
+}
+
+func Test_AppliesTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		scope    string
+		target   Target
+		expected bool
+	}{
+		{"same scope", testContainer, Target{ID: testContainer}, true},
+		{"different casing", "/planes/radius/local/resourcegroups/TEST-RG/", Target{ID: testContainer}, true},
+		{"parent scope", testRG, Target{ID: testContainer}, true},
+		{"plane scope", "/planes/radius/local", Target{ID: testContainer}, true},
+		{"sibling scope", testRG + "/providers/Applications.Core/containers/front", Target{ID: testContainer}, false},
+		{"child scope", testContainer, Target{ID: testRG}, false},
+		{"application", testApplication, Target{ID: testContainer, Memberships: []string{testApplication}}, true},
+		{"other application", testRG + "/providers/Applications.Core/applications/other", Target{ID: testContainer, Memberships: []string{testApplication}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, AppliesTo(tt.scope, tt.target))
+		})
+	}
+}
+
+func Test_Find(t *testing.T) {
+	lock := func(level datamodel.LockLevel, scope string) datamodel.Lock {
+		return datamodel.Lock{Properties: datamodel.LockProperties{Level: level, Scope: scope}}
+	}
+	members := func(scope string) ([]string, error) {
+		if scope == testContainer {
+			return []string{testApplication}, nil
+		}
+		return nil, nil
+	}
+
+	tests := []struct {
+		name      string
+		locks     []datamodel.Lock
+		target    Target
+		operation Operation
+		expected  int
+	}{
+		{"no locks", nil, Target{ID: testContainer}, OperationDelete, -1},
+		{"delete locked resource", []datamodel.Lock{lock(datamodel.LockLevelCanNotDelete, testContainer)}, Target{ID: testContainer}, OperationDelete, 0},
+		{"write resource locked for delete", []datamodel.Lock{lock(datamodel.LockLevelCanNotDelete, testContainer)}, Target{ID: testContainer}, OperationWrite, -1},
+		{"write read-only resource", []datamodel.Lock{lock(datamodel.LockLevelCanNotDelete, testRG), lock(datamodel.LockLevelReadOnly, testRG)}, Target{ID: testContainer}, OperationWrite, 1},
+		{"write resource in read-only application", []datamodel.Lock{lock(datamodel.LockLevelReadOnly, testApplication)}, Target{ID: testContainer, Memberships: []string{testApplication}}, OperationWrite, 0},
+		{"delete resource group containing lock", []datamodel.Lock{lock(datamodel.LockLevelCanNotDelete, testContainer)}, Target{ID: testRG}, OperationDelete, 0},
+		{"delete application of locked resource", []datamodel.Lock{lock(datamodel.LockLevelCanNotDelete, testContainer)}, Target{ID: testApplication}, OperationDelete, 0},
+		{"delete other application", []datamodel.Lock{lock(datamodel.LockLevelCanNotDelete, testContainer)}, Target{ID: testRG + "/providers/Applications.Core/applications/other"}, OperationDelete, -1},
+		{"write application of locked resource", []datamodel.Lock{lock(datamodel.LockLevelReadOnly, testContainer)}, Target{ID: testApplication}, OperationWrite, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found, err := Find(tt.locks, tt.target, tt.operation, members)
+			require.NoError(t, err)
+			if tt.expected < 0 {
+				require.Nil(t, found)
+			} else {
+				require.Same(t, &tt.locks[tt.expected], found)
+			}
+		})
+	}
+
+	t.Run("members error", func(t *testing.T) {
+		_, err := Find([]datamodel.Lock{lock(datamodel.LockLevelCanNotDelete, testContainer)}, Target{ID: testApplication}, OperationDelete, func(string) ([]string, error) {
+			return nil, errors.New("failed")
+		})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// DeliveryResourceType is the resource type under which delivery attempts are saved in the data store.
+	DeliveryResourceType = "System.Events/eventSubscriptions/deliveries"
+)
+
+// Delivery is a record of an attempt to deliver an event to a subscription.
+type Delivery struct {
+	// ID is the unique identifier of the attempt.
+	ID string `json:"id"`
+
+	// SubscriptionID is the ID of the event subscription.
+	SubscriptionID string `json:"subscriptionId"`
+
+	// EventID is the ID of the delivered event.
+	EventID string `json:"eventId"`
+
+	// EventType is the type of the delivered event.
+	EventType string `json:"eventType"`
+
+	// Subject is the ID of the resource that the event is about.
+	Subject string `json:"subject"`
+
+	// Time is the time of the attempt.
+	Time time.Time `json:"time"`
+
+	// Attempt is the number of the attempt, starting at 1.
+	Attempt int `json:"attempt"`
+
+	// StatusCode is the HTTP status code returned by the endpoint. Zero if no response was received.
+	StatusCode int `json:"statusCode,omitempty"`
+
+	// Error describes why the attempt failed.
+	Error string `json:"error,omitempty"`
+
+	// Succeeded is true if the endpoint accepted the event.
+	Succeeded bool `json:"succeeded"`
+}
+
+// DeliveryLog saves delivery attempts in the data store under the event subscription they belong to.
+type DeliveryLog struct {
+	client store.StorageClient
+}
+
+// NewDeliveryLog creates a DeliveryLog.
+func NewDeliveryLog(client store.StorageClient) *DeliveryLog {
+	return &DeliveryLog{client: client}
+}
+
+// Save records a delivery attempt.
+func (l *DeliveryLog) Save(ctx context.Context, delivery *Delivery) error {
+	id, err := resources.Parse(delivery.SubscriptionID + "/deliveries/" + delivery.ID)
+	if err != nil {
+		return err
+	}
+
+	return l.client.Save(ctx, &store.Object{
+		Metadata: store.Metadata{ID: id.String()},
+		Data:     delivery,
+	})
+}
+
+// List returns the delivery attempts of the event subscription, oldest first.
+func (l *DeliveryLog) List(ctx context.Context, subscriptionID resources.ID) ([]Delivery, error) {
+	query := store.Query{
+		RootScope:    subscriptionID.RootScope(),
+		ResourceType: DeliveryResourceType,
+	}
+
+	deliveries := []Delivery{}
+	token := ""
+	for {
+		result, err := l.client.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			delivery := Delivery{}
+			if err := item.As(&delivery); err != nil {
+				return nil, err
+			}
+			if strings.EqualFold(delivery.SubscriptionID, subscriptionID.String()) {
+				deliveries = append(deliveries, delivery)
+			}
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		token = result.PaginationToken
+	}
+
+	slices.SortStableFunc(deliveries, func(a, b Delivery) int {
+		return a.Time.Compare(b.Time)
+	})
+	return deliveries, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DeliveryLog_Save(t *testing.T) {
+	client := store.NewMockStorageClient(gomock.NewController(t))
+	log := NewDeliveryLog(client)
+
+	delivery := &Delivery{ID: "d1", SubscriptionID: testSubscriptionID}
+	client.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			require.Equal(t, testSubscriptionID+"/deliveries/d1", obj.ID)
+			require.Same(t, delivery, obj.Data)
+			return nil
+		})
+
+	require.NoError(t, log.Save(context.Background(), delivery))
+}
+
+func Test_DeliveryLog_List(t *testing.T) {
+	client := store.NewMockStorageClient(gomock.NewController(t))
+	log := NewDeliveryLog(client)
+
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	expectedQuery := store.Query{RootScope: "/planes/radius/local/resourceGroups/rg", ResourceType: DeliveryResourceType}
+	client.EXPECT().
+		Query(gomock.Any(), expectedQuery, gomock.Any()).
+		Return(&store.ObjectQueryResult{
+			Items: []store.Object{
+				*testutil.MustGetStoreObject(t, Delivery{ID: "second", SubscriptionID: testSubscriptionID, Time: start.Add(time.Second), Attempt: 2}),
+				*testutil.MustGetStoreObject(t, Delivery{ID: "other", SubscriptionID: "/planes/radius/local/resourceGroups/rg/providers/System.Events/eventSubscriptions/other", Time: start}),
+			},
+			PaginationToken: "next",
+		}, nil)
+	client.EXPECT().
+		Query(gomock.Any(), expectedQuery, gomock.Any()).
+		Return(&store.ObjectQueryResult{
+			Items: []store.Object{
+				*testutil.MustGetStoreObject(t, Delivery{ID: "first", SubscriptionID: testSubscriptionID, Time: start, Attempt: 1}),
+			},
+		}, nil)
+
+	deliveries, err := log.List(context.Background(), resources.MustParse(testSubscriptionID))
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, "first", deliveries[0].ID)
+	require.Equal(t, "second", deliveries[1].ID)
+}
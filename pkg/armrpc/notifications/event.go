@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// SpecVersion is the version of the CloudEvents specification that events conform to.
+	SpecVersion = "1.0"
+
+	// ContentType is the content type of a delivery. Events are delivered in the CloudEvents structured content mode.
+	ContentType = "application/cloudevents+json"
+
+	// SignatureHeader is the header of a delivery that contains the HMAC-SHA256 signature of <timestamp>.<body>,
+	// computed with the secret of the subscription and formatted as sha256=<hex digest>.
+	SignatureHeader = "Radius-Signature"
+
+	// TimestampHeader is the header of a signed delivery that contains the time it was sent, in seconds since the Unix
+	// epoch.
+	TimestampHeader = "Radius-Timestamp"
+)
+
+// Event is a change notification in the CloudEvents JSON format.
+type Event struct {
+	// SpecVersion is the CloudEvents specification version.
+	SpecVersion string `json:"specversion"`
+
+	// ID is the unique identifier of the event.
+	ID string `json:"id"`
+
+	// Source is the plane or resource group of the resource that changed.
+	Source string `json:"source"`
+
+	// Type is the event type, e.g. io.radapp.resource.created.
+	Type string `json:"type"`
+
+	// Subject is the ID of the resource that changed.
+	Subject string `json:"subject"`
+
+	// Time is the time the change happened.
+	Time time.Time `json:"time"`
+
+	// DataContentType is the content type of Data.
+	DataContentType string `json:"datacontenttype"`
+
+	// Data describes the change.
+	Data EventData `json:"data"`
+}
+
+// EventData is the payload of a change notification.
+type EventData struct {
+	// ResourceID is the ID of the resource that changed.
+	ResourceID string `json:"resourceId"`
+
+	// ResourceType is the type of the resource that changed.
+	ResourceType string `json:"resourceType"`
+
+	// OperationType is the operation that changed the resource, e.g. APPLICATIONS.CORE/CONTAINERS|PUT.
+	OperationType string `json:"operationType,omitempty"`
+
+	// OperationID is the ID of the asynchronous operation that changes the resource.
+	OperationID string `json:"operationId,omitempty"`
+
+	// Status is the final state of the asynchronous operation for operation events.
+	Status string `json:"status,omitempty"`
+
+	// Error is the error of a failed or canceled asynchronous operation.
+	Error *v1.ErrorDetails `json:"error,omitempty"`
+}
+
+// NewEvent creates an event of the given type for the resource.
+func NewEvent(eventType datamodel.EventType, id resources.ID, data EventData) *Event {
+	data.ResourceID = id.String()
+	data.ResourceType = id.Type()
+
+	return &Event{
+		SpecVersion:     SpecVersion,
+		ID:              uuid.NewString(),
+		Source:          id.RootScope(),
+		Type:            string(eventType),
+		Subject:         id.String(),
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+}
+
+// IsNotified returns true if requests with the given HTTP method can change a resource.
+func IsNotified(method string) bool {
+	switch method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// Matches returns true if the subscription receives events of the given type for the resource. A subscription
+// receives the events of its scope and of every resource in it.
+func Matches(subscription *datamodel.EventSubscription, eventType datamodel.EventType, id resources.ID) bool {
+	scope := strings.TrimSuffix(strings.ToLower(subscription.Properties.Scope), "/")
+	resourceID := strings.ToLower(id.String())
+	if scope == "" || (resourceID != scope && !strings.HasPrefix(resourceID, scope+"/")) {
+		return false
+	}
+
+	resourceTypes := subscription.Properties.ResourceTypes
+	if len(resourceTypes) > 0 && !slices.ContainsFunc(resourceTypes, func(t string) bool { return strings.EqualFold(t, id.Type()) }) {
+		return false
+	}
+
+	eventTypes := subscription.Properties.EventTypes
+	if len(eventTypes) > 0 && !slices.Contains(eventTypes, eventType) {
+		return false
+	}
+
+	return true
+}
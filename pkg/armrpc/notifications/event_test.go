@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testContainerID = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/frontend"
+)
+
+func Test_NewEvent(t *testing.T) {
+	id := resources.MustParse(testContainerID)
+	event := NewEvent(datamodel.EventTypeResourceCreated, id, EventData{OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT"})
+
+	require.Equal(t, SpecVersion, event.SpecVersion)
+	require.NotEmpty(t, event.ID)
+	require.Equal(t, "/planes/radius/local/resourceGroups/rg", event.Source)
+	require.Equal(t, "io.radapp.resource.created", event.Type)
+	require.Equal(t, testContainerID, event.Subject)
+	require.False(t, event.Time.IsZero())
+	require.Equal(t, "application/json", event.DataContentType)
+	require.Equal(t, EventData{
+		ResourceID:    testContainerID,
+		ResourceType:  "Applications.Core/containers",
+		OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT",
+	}, event.Data)
+}
+
+func Test_IsNotified(t *testing.T) {
+	require.True(t, IsNotified(http.MethodPut))
+	require.True(t, IsNotified(http.MethodPatch))
+	require.True(t, IsNotified(http.MethodDelete))
+	require.False(t, IsNotified(http.MethodGet))
+	require.False(t, IsNotified(http.MethodPost))
+}
+
+func Test_Matches(t *testing.T) {
+	id := resources.MustParse(testContainerID)
+
+	tests := []struct {
+		name       string
+		properties datamodel.EventSubscriptionProperties
+		eventType  datamodel.EventType
+		expected   bool
+	}{
+		{
+			name:       "resource group scope",
+			properties: datamodel.EventSubscriptionProperties{Scope: "/planes/radius/local/resourceGroups/RG"},
+			eventType:  datamodel.EventTypeResourceDeleted,
+			expected:   true,
+		},
+		{
+			name:       "plane scope",
+			properties: datamodel.EventSubscriptionProperties{Scope: "/planes/radius/local"},
+			eventType:  datamodel.EventTypeResourceDeleted,
+			expected:   true,
+		},
+		{
+			name:       "resource scope",
+			properties: datamodel.EventSubscriptionProperties{Scope: testContainerID},
+			eventType:  datamodel.EventTypeResourceDeleted,
+			expected:   true,
+		},
+		{
+			name:       "other resource group",
+			properties: datamodel.EventSubscriptionProperties{Scope: "/planes/radius/local/resourceGroups/rg2"},
+			eventType:  datamodel.EventTypeResourceDeleted,
+			expected:   false,
+		},
+		{
+			name:       "resource group with the same prefix",
+			properties: datamodel.EventSubscriptionProperties{Scope: "/planes/radius/local/resourceGroups/r"},
+			eventType:  datamodel.EventTypeResourceDeleted,
+			expected:   false,
+		},
+		{
+			name: "matching resource type",
+			properties: datamodel.EventSubscriptionProperties{
+				Scope:         "/planes/radius/local",
+				ResourceTypes: []string{"Applications.Core/applications", "applications.core/containers"},
+			},
+			eventType: datamodel.EventTypeResourceDeleted,
+			expected:  true,
+		},
+		{
+			name: "other resource type",
+			properties: datamodel.EventSubscriptionProperties{
+				Scope:         "/planes/radius/local",
+				ResourceTypes: []string{"Applications.Core/applications"},
+			},
+			eventType: datamodel.EventTypeResourceDeleted,
+			expected:  false,
+		},
+		{
+			name: "matching event type",
+			properties: datamodel.EventSubscriptionProperties{
+				Scope:      "/planes/radius/local",
+				EventTypes: []datamodel.EventType{datamodel.EventTypeOperationFailed},
+			},
+			eventType: datamodel.EventTypeOperationFailed,
+			expected:  true,
+		},
+		{
+			name: "other event type",
+			properties: datamodel.EventSubscriptionProperties{
+				Scope:      "/planes/radius/local",
+				EventTypes: []datamodel.EventType{datamodel.EventTypeOperationFailed},
+			},
+			eventType: datamodel.EventTypeResourceDeleted,
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := &datamodel.EventSubscription{
+				BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: "/planes/radius/local/providers/System.Events/eventSubscriptions/sub"}},
+				Properties:   tt.properties,
+			}
+			require.Equal(t, tt.expected, Matches(subscription, tt.eventType, id))
+		})
+	}
+}
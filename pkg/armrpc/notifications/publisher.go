@@ -0,0 +1,383 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	defaultMaxAttempts    = 5
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = time.Minute
+	defaultTimeout        = 10 * time.Second
+	defaultWorkers        = 10
+	defaultQueueSize      = 1000
+
+	// maxResponseBodySize is the maximum number of bytes of a response body that are read before it is discarded.
+	maxResponseBodySize = 64 * 1024
+)
+
+// Options configures the delivery of events.
+type Options struct {
+	// MaxAttempts is the maximum number of attempts to deliver an event. Defaults to 5.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry. The delay doubles after each retry. Defaults to 1 second.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries. Defaults to 1 minute.
+	MaxBackoff time.Duration
+
+	// Timeout is the timeout of each attempt. Defaults to 10 seconds.
+	Timeout time.Duration
+
+	// Workers is the number of events that are delivered concurrently. Defaults to 10.
+	Workers int
+
+	// QueueSize is the maximum number of deliveries waiting for a worker. Events are dropped when the queue is full.
+	// Defaults to 1000.
+	QueueSize int
+}
+
+// delivery is an event waiting to be delivered to a subscription.
+type delivery struct {
+	subscription datamodel.EventSubscription
+	event        *Event
+}
+
+// Publisher delivers change notifications to the event subscriptions that match them. Subscriptions are read from
+// the data store so that subscriptions created through UCP receive the events of every resource provider that shares
+// the data store.
+type Publisher struct {
+	storageProvider dataprovider.DataStorageProvider
+	options         Options
+	client          *http.Client
+
+	// queue holds the deliveries waiting for a worker.
+	queue chan delivery
+
+	// deliveries tracks the deliveries that are queued or in progress.
+	deliveries sync.WaitGroup
+}
+
+// NewPublisher creates a Publisher that reads event subscriptions from the storage provider and records delivery
+// attempts in it. Events are delivered once the publisher is started.
+func NewPublisher(storageProvider dataprovider.DataStorageProvider, options Options) *Publisher {
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = defaultMaxAttempts
+	}
+	if options.InitialBackoff <= 0 {
+		options.InitialBackoff = defaultInitialBackoff
+	}
+	if options.MaxBackoff <= 0 {
+		options.MaxBackoff = defaultMaxBackoff
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = defaultTimeout
+	}
+	if options.Workers <= 0 {
+		options.Workers = defaultWorkers
+	}
+	if options.QueueSize <= 0 {
+		options.QueueSize = defaultQueueSize
+	}
+
+	return &Publisher{
+		storageProvider: storageProvider,
+		options:         options,
+		client:          &http.Client{Timeout: options.Timeout},
+		queue:           make(chan delivery, options.QueueSize),
+	}
+}
+
+// Start runs the workers that deliver events in the background until the context is cancelled. Deliveries that are
+// queued or waiting for a retry when the context is cancelled are dropped, they are not resumed after a restart.
+func (p *Publisher) Start(ctx context.Context) {
+	var workers sync.WaitGroup
+	for i := 0; i < p.options.Workers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			p.work(ctx)
+		}()
+	}
+
+	go func() {
+		workers.Wait()
+		p.drain(ctx)
+	}()
+}
+
+// work delivers the queued events until the context is cancelled.
+func (p *Publisher) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case d := <-p.queue:
+			p.deliver(ctx, &d.subscription, d.event)
+			p.deliveries.Done()
+		}
+	}
+}
+
+// drain drops the deliveries left in the queue once the workers have stopped.
+func (p *Publisher) drain(ctx context.Context) {
+	dropped := 0
+	for {
+		select {
+		case <-p.queue:
+			dropped++
+			p.deliveries.Done()
+		default:
+			if dropped > 0 {
+				logger := ucplog.FromContextOrDiscard(ctx)
+				logger.Info(fmt.Sprintf("dropped %d event deliveries because the publisher stopped", dropped))
+			}
+			return
+		}
+	}
+}
+
+// Subscriptions returns the event subscriptions stored in the plane of the resource, including the subscriptions of
+// its resource groups.
+func (p *Publisher) Subscriptions(ctx context.Context, id resources.ID) ([]datamodel.EventSubscription, error) {
+	client, err := p.storageProvider.GetStorageClient(ctx, datamodel.EventSubscriptionResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := store.Query{
+		RootScope:      id.PlaneScope(),
+		ScopeRecursive: true,
+		ResourceType:   datamodel.EventSubscriptionResourceType,
+	}
+
+	subscriptions := []datamodel.EventSubscription{}
+	token := ""
+	for {
+		result, err := client.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			subscription := datamodel.EventSubscription{}
+			if err := item.As(&subscription); err != nil {
+				return nil, err
+			}
+			subscriptions = append(subscriptions, subscription)
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		token = result.PaginationToken
+	}
+
+	return subscriptions, nil
+}
+
+// Publish sends an event of the given type for the resource to each matching subscription. Events are queued for the
+// workers of the publisher, and failures are recorded in the delivery log instead of being returned.
+func (p *Publisher) Publish(ctx context.Context, eventType datamodel.EventType, id resources.ID, data EventData) {
+	subscriptions, err := p.Subscriptions(ctx, id)
+	if err != nil {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Error(err, "failed to list event subscriptions", "resourceID", id.String())
+		return
+	}
+
+	p.publish(ctx, subscriptions, NewEvent(eventType, id, data))
+}
+
+// Wait blocks until the deliveries that are queued or in progress have finished.
+func (p *Publisher) Wait() {
+	p.deliveries.Wait()
+}
+
+// publish delivers the event to each of the subscriptions that match it.
+func (p *Publisher) publish(ctx context.Context, subscriptions []datamodel.EventSubscription, event *Event) {
+	id, err := resources.Parse(event.Subject)
+	if err != nil {
+		return
+	}
+
+	for _, subscription := range subscriptions {
+		if !Matches(&subscription, datamodel.EventType(event.Type), id) {
+			continue
+		}
+
+		p.deliveries.Add(1)
+		select {
+		case p.queue <- delivery{subscription: subscription, event: event}:
+		default:
+			p.deliveries.Done()
+			logger := ucplog.FromContextOrDiscard(ctx)
+			logger.Info("dropped event delivery because the queue is full", "subscriptionID", subscription.ID, "eventID", event.ID)
+		}
+	}
+}
+
+// deliver sends the event to the subscription, retrying with exponential backoff when the endpoint cannot be reached
+// or responds with an error that may be transient. Each attempt is recorded in the delivery log. Retries stop when the
+// context is cancelled.
+func (p *Publisher) deliver(ctx context.Context, subscription *datamodel.EventSubscription, event *Event) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("subscriptionID", subscription.ID, "eventID", event.ID)
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		logger.Error(err, "failed to marshal event")
+		return
+	}
+
+	var log *DeliveryLog
+	if client, err := p.storageProvider.GetStorageClient(ctx, DeliveryResourceType); err != nil {
+		logger.Error(err, "failed to get the storage client of the delivery log")
+	} else {
+		log = NewDeliveryLog(client)
+	}
+
+	backoff := p.options.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		statusCode, err := p.send(ctx, subscription, body)
+
+		delivery := &Delivery{
+			ID:             uuid.NewString(),
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Subject:        event.Subject,
+			Time:           time.Now().UTC(),
+			Attempt:        attempt,
+			StatusCode:     statusCode,
+			Succeeded:      err == nil,
+		}
+		if err != nil {
+			delivery.Error = err.Error()
+		}
+		if log != nil {
+			if saveErr := log.Save(ctx, delivery); saveErr != nil {
+				logger.Error(saveErr, "failed to record event delivery")
+			}
+		}
+
+		if err == nil {
+			return
+		}
+		if !isRetryable(statusCode) || attempt >= p.options.MaxAttempts {
+			logger.Info(fmt.Sprintf("failed to deliver event after %d attempts: %s", attempt, err.Error()))
+			return
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			logger.Info(fmt.Sprintf("stopped delivering event after %d attempts: %s", attempt, ctx.Err().Error()))
+			return
+		case <-timer.C:
+		}
+		backoff = min(2*backoff, p.options.MaxBackoff)
+	}
+}
+
+// send posts the body to the endpoint of the subscription. It returns the status code of the response and an error
+// if the endpoint did not accept the event.
+func (p *Publisher) send(ctx context.Context, subscription *datamodel.EventSubscription, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Properties.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", ContentType)
+	if subscription.Properties.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(TimestampHeader, timestamp)
+		req.Header.Set(SignatureHeader, Sign(subscription.Properties.Secret, timestamp, body))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodySize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("the endpoint responded with status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature of a delivery computed with the secret of the subscription over the timestamp and the
+// body joined by a period, in the format of the Radius-Signature header. Receivers verify a delivery by computing the
+// signature of the Radius-Timestamp header and the raw body, and reject deliveries with an old timestamp so that
+// captured deliveries can't be replayed.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// isRetryable returns true if a delivery that failed with the status code may succeed when retried. A zero status
+// code means that no response was received.
+func isRetryable(statusCode int) bool {
+	return statusCode == 0 ||
+		statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
+
+type publisherKey struct{}
+
+// WithPublisher returns a middleware that stores the publisher in the request context.
+func WithPublisher(publisher *Publisher) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), publisherKey{}, publisher)))
+		})
+	}
+}
+
+// FromContext returns the publisher stored in the context, or nil if change notifications are not enabled.
+func FromContext(ctx context.Context) *Publisher {
+	publisher, ok := ctx.Value(publisherKey{}).(*Publisher)
+	if !ok {
+		return nil
+	}
+	return publisher
+}
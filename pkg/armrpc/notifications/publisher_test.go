@@ -0,0 +1,343 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSubscriptionID = "/planes/radius/local/resourceGroups/rg/providers/System.Events/eventSubscriptions/sub"
+)
+
+// testEnvironment is a publisher backed by mock storage clients that serve the given subscriptions and capture the
+// delivery log.
+type testEnvironment struct {
+	publisher      *Publisher
+	resourceClient *store.MockStorageClient
+	cancel         context.CancelFunc
+
+	mu         sync.Mutex
+	deliveries []Delivery
+}
+
+func newTestEnvironment(t *testing.T, subscriptions ...datamodel.EventSubscription) *testEnvironment {
+	env := newTestEnvironmentWithOptions(t, Options{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}, subscriptions...)
+	env.Start(t)
+	return env
+}
+
+// newTestEnvironmentWithOptions creates a test environment whose publisher is not started.
+func newTestEnvironmentWithOptions(t *testing.T, options Options, subscriptions ...datamodel.EventSubscription) *testEnvironment {
+	mctrl := gomock.NewController(t)
+	provider := dataprovider.NewMockDataStorageProvider(mctrl)
+	subscriptionClient := store.NewMockStorageClient(mctrl)
+	deliveryClient := store.NewMockStorageClient(mctrl)
+	env := &testEnvironment{resourceClient: store.NewMockStorageClient(mctrl)}
+
+	provider.EXPECT().
+		GetStorageClient(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, resourceType string) (store.StorageClient, error) {
+			switch resourceType {
+			case datamodel.EventSubscriptionResourceType:
+				return subscriptionClient, nil
+			case DeliveryResourceType:
+				return deliveryClient, nil
+			default:
+				return env.resourceClient, nil
+			}
+		}).
+		AnyTimes()
+
+	items := []store.Object{}
+	for _, subscription := range subscriptions {
+		items = append(items, *testutil.MustGetStoreObject(t, subscription))
+	}
+	subscriptionClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local", ScopeRecursive: true, ResourceType: datamodel.EventSubscriptionResourceType}, gomock.Any()).
+		Return(&store.ObjectQueryResult{Items: items}, nil).
+		AnyTimes()
+
+	deliveryClient.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			delivery := obj.Data.(*Delivery)
+			require.Equal(t, delivery.SubscriptionID+"/deliveries/"+delivery.ID, obj.ID)
+
+			env.mu.Lock()
+			defer env.mu.Unlock()
+			env.deliveries = append(env.deliveries, *delivery)
+			return nil
+		}).
+		AnyTimes()
+
+	env.publisher = NewPublisher(provider, options)
+	return env
+}
+
+func (e *testEnvironment) Start(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	e.cancel = cancel
+	e.publisher.Start(ctx)
+}
+
+func (e *testEnvironment) Deliveries() []Delivery {
+	e.publisher.Wait()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.deliveries
+}
+
+func newTestSubscription(url string, secret string) datamodel.EventSubscription {
+	return datamodel.EventSubscription{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: testSubscriptionID, Name: "sub", Type: datamodel.EventSubscriptionResourceType}},
+		Properties: datamodel.EventSubscriptionProperties{
+			URL:    url,
+			Secret: secret,
+			Scope:  "/planes/radius/local/resourceGroups/rg",
+		},
+	}
+}
+
+// receiver is a local HTTP endpoint that records the events it receives and responds with the given status codes in
+// order, then with 200.
+type receiver struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	statusCodes []int
+	requests    []*http.Request
+	bodies      [][]byte
+}
+
+func newReceiver(t *testing.T, statusCodes ...int) *receiver {
+	r := &receiver{statusCodes: statusCodes}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.requests = append(r.requests, req)
+		r.bodies = append(r.bodies, body)
+
+		statusCode := http.StatusOK
+		if len(r.statusCodes) > 0 {
+			statusCode, r.statusCodes = r.statusCodes[0], r.statusCodes[1:]
+		}
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) Events(t *testing.T) []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := []Event{}
+	for _, body := range r.bodies {
+		event := Event{}
+		require.NoError(t, json.Unmarshal(body, &event))
+		events = append(events, event)
+	}
+	return events
+}
+
+func Test_Publisher_Publish(t *testing.T) {
+	r := newReceiver(t)
+	env := newTestEnvironment(t, newTestSubscription(r.URL, "s3cr3t"))
+
+	id := resources.MustParse(testContainerID)
+	env.publisher.Publish(context.Background(), datamodel.EventTypeResourceUpdated, id, EventData{OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT"})
+
+	deliveries := env.Deliveries()
+	require.Len(t, deliveries, 1)
+	require.True(t, deliveries[0].Succeeded)
+	require.Equal(t, 1, deliveries[0].Attempt)
+	require.Equal(t, http.StatusOK, deliveries[0].StatusCode)
+	require.Equal(t, testSubscriptionID, deliveries[0].SubscriptionID)
+	require.Equal(t, "io.radapp.resource.updated", deliveries[0].EventType)
+	require.Equal(t, testContainerID, deliveries[0].Subject)
+
+	events := r.Events(t)
+	require.Len(t, events, 1)
+	require.Equal(t, deliveries[0].EventID, events[0].ID)
+	require.Equal(t, "io.radapp.resource.updated", events[0].Type)
+	require.Equal(t, testContainerID, events[0].Subject)
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|PUT", events[0].Data.OperationType)
+
+	require.Equal(t, ContentType, r.requests[0].Header.Get("Content-Type"))
+	timestamp := r.requests[0].Header.Get(TimestampHeader)
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), time.Unix(seconds, 0), time.Minute)
+	require.Equal(t, Sign("s3cr3t", timestamp, r.bodies[0]), r.requests[0].Header.Get(SignatureHeader))
+}
+
+func Test_Publisher_Publish_NoSecret(t *testing.T) {
+	r := newReceiver(t)
+	env := newTestEnvironment(t, newTestSubscription(r.URL, ""))
+
+	env.publisher.Publish(context.Background(), datamodel.EventTypeResourceUpdated, resources.MustParse(testContainerID), EventData{})
+
+	require.Len(t, env.Deliveries(), 1)
+	require.Empty(t, r.requests[0].Header.Get(SignatureHeader))
+	require.Empty(t, r.requests[0].Header.Get(TimestampHeader))
+}
+
+func Test_Publisher_Publish_Filtered(t *testing.T) {
+	r := newReceiver(t)
+	subscription := newTestSubscription(r.URL, "")
+	subscription.Properties.EventTypes = []datamodel.EventType{datamodel.EventTypeResourceDeleted}
+	env := newTestEnvironment(t, subscription)
+
+	env.publisher.Publish(context.Background(), datamodel.EventTypeResourceUpdated, resources.MustParse(testContainerID), EventData{})
+	env.publisher.Publish(context.Background(), datamodel.EventTypeResourceDeleted, resources.MustParse("/planes/radius/local/resourceGroups/other/providers/Applications.Core/containers/frontend"), EventData{})
+
+	require.Empty(t, env.Deliveries())
+	require.Empty(t, r.Events(t))
+}
+
+func Test_Publisher_Publish_Retry(t *testing.T) {
+	r := newReceiver(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	env := newTestEnvironment(t, newTestSubscription(r.URL, ""))
+
+	env.publisher.Publish(context.Background(), datamodel.EventTypeResourceUpdated, resources.MustParse(testContainerID), EventData{})
+
+	deliveries := env.Deliveries()
+	require.Len(t, deliveries, 3)
+	require.Equal(t, []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}, []int{deliveries[0].StatusCode, deliveries[1].StatusCode, deliveries[2].StatusCode})
+	require.Equal(t, []int{1, 2, 3}, []int{deliveries[0].Attempt, deliveries[1].Attempt, deliveries[2].Attempt})
+	require.False(t, deliveries[0].Succeeded)
+	require.NotEmpty(t, deliveries[0].Error)
+	require.True(t, deliveries[2].Succeeded)
+
+	// Every attempt delivers the same event.
+	events := r.Events(t)
+	require.Len(t, events, 3)
+	require.Equal(t, events[0].ID, events[2].ID)
+}
+
+func Test_Publisher_Publish_MaxAttempts(t *testing.T) {
+	r := newReceiver(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+	env := newTestEnvironment(t, newTestSubscription(r.URL, ""))
+
+	env.publisher.Publish(context.Background(), datamodel.EventTypeResourceUpdated, resources.MustParse(testContainerID), EventData{})
+
+	deliveries := env.Deliveries()
+	require.Len(t, deliveries, 3)
+	for _, delivery := range deliveries {
+		require.False(t, delivery.Succeeded)
+	}
+}
+
+func Test_Publisher_Publish_NotRetryable(t *testing.T) {
+	r := newReceiver(t, http.StatusBadRequest)
+	env := newTestEnvironment(t, newTestSubscription(r.URL, ""))
+
+	env.publisher.Publish(context.Background(), datamodel.EventTypeResourceUpdated, resources.MustParse(testContainerID), EventData{})
+
+	deliveries := env.Deliveries()
+	require.Len(t, deliveries, 1)
+	require.False(t, deliveries[0].Succeeded)
+	require.Equal(t, http.StatusBadRequest, deliveries[0].StatusCode)
+	require.Equal(t, "the endpoint responded with status code 400", deliveries[0].Error)
+}
+
+func Test_Publisher_Publish_Unreachable(t *testing.T) {
+	r := newReceiver(t)
+	url := r.URL
+	r.Close()
+	env := newTestEnvironment(t, newTestSubscription(url, ""))
+
+	env.publisher.Publish(context.Background(), datamodel.EventTypeResourceUpdated, resources.MustParse(testContainerID), EventData{})
+
+	deliveries := env.Deliveries()
+	require.Len(t, deliveries, 3)
+	require.Zero(t, deliveries[2].StatusCode)
+	require.NotEmpty(t, deliveries[2].Error)
+}
+
+func Test_Publisher_Publish_Cancelled(t *testing.T) {
+	r := newReceiver(t, http.StatusServiceUnavailable)
+	env := newTestEnvironmentWithOptions(t, Options{MaxAttempts: 3, InitialBackoff: time.Hour}, newTestSubscription(r.URL, ""))
+	env.Start(t)
+
+	env.publisher.Publish(context.Background(), datamodel.EventTypeResourceUpdated, resources.MustParse(testContainerID), EventData{})
+	require.Eventually(t, func() bool {
+		env.mu.Lock()
+		defer env.mu.Unlock()
+		return len(env.deliveries) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Stopping the publisher interrupts the backoff before the retry.
+	env.cancel()
+	deliveries := env.Deliveries()
+	require.Len(t, deliveries, 1)
+	require.False(t, deliveries[0].Succeeded)
+	require.Len(t, r.Events(t), 1)
+}
+
+func Test_Publisher_Publish_QueueFull(t *testing.T) {
+	r := newReceiver(t)
+	env := newTestEnvironmentWithOptions(t, Options{QueueSize: 1}, newTestSubscription(r.URL, ""), newTestSubscription(r.URL, ""))
+
+	// The first delivery fills the queue of the publisher, which is not started yet, and the second one is dropped.
+	env.publisher.Publish(context.Background(), datamodel.EventTypeResourceUpdated, resources.MustParse(testContainerID), EventData{})
+	env.Start(t)
+
+	require.Len(t, env.Deliveries(), 1)
+	require.Len(t, r.Events(t), 1)
+}
+
+func Test_Sign(t *testing.T) {
+	// Computed with: echo -n '1700000000.{"id":"1"}' | openssl dgst -sha256 -hmac secret
+	require.Equal(t, "sha256=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54", Sign("secret", "1700000000", []byte(`{"id":"1"}`)))
+}
+
+func Test_WithPublisher(t *testing.T) {
+	publisher := NewPublisher(nil, Options{})
+	called := false
+	handler := WithPublisher(publisher)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Same(t, publisher, FromContext(r.Context()))
+		called = true
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	require.True(t, called)
+	require.Nil(t, FromContext(context.Background()))
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// asyncOperationHeader is the response header that refers to the status of an asynchronous operation.
+	asyncOperationHeader = "Azure-AsyncOperation"
+)
+
+var _ statusmanager.Observer = (*Publisher)(nil)
+
+// Change is a change to a resource requested through the frontend, which is published once the request is handled.
+type Change struct {
+	publisher     *Publisher
+	subscriptions []datamodel.EventSubscription
+	id            resources.ID
+	method        string
+	operationType v1.OperationType
+	existed       bool
+}
+
+// ObserveRequest prepares the notification of the change that the request makes to the resource. It must be called
+// before the request is handled because it checks whether the resource already exists. It returns nil if the request
+// cannot change the resource or no subscription receives the events of the resource. Proxied requests are notified by
+// the resource provider that handles them.
+func (p *Publisher) ObserveRequest(ctx context.Context, req *http.Request, operationType v1.OperationType, id resources.ID) *Change {
+	if !IsNotified(req.Method) || operationType.Method == v1.OperationProxy {
+		return nil
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	subscriptions, err := p.Subscriptions(ctx, id)
+	if err != nil {
+		logger.Error(err, "failed to list event subscriptions", "resourceID", id.String())
+		return nil
+	}
+
+	eventTypes := []datamodel.EventType{datamodel.EventTypeResourceDeleted}
+	if req.Method != http.MethodDelete {
+		eventTypes = []datamodel.EventType{datamodel.EventTypeResourceCreated, datamodel.EventTypeResourceUpdated}
+	}
+
+	change := &Change{publisher: p, id: id, method: req.Method, operationType: operationType}
+	for _, subscription := range subscriptions {
+		for _, eventType := range eventTypes {
+			if Matches(&subscription, eventType, id) {
+				change.subscriptions = append(change.subscriptions, subscription)
+				break
+			}
+		}
+	}
+	if len(change.subscriptions) == 0 {
+		return nil
+	}
+
+	if req.Method != http.MethodDelete {
+		change.existed, err = p.exists(ctx, id)
+		if err != nil {
+			logger.Error(err, "failed to check whether the resource exists", "resourceID", id.String())
+			return nil
+		}
+	}
+
+	return change
+}
+
+// Complete publishes the change after the request was handled with the given response status code and headers. A
+// create or update is published once the request is accepted. A delete is published once the resource is deleted,
+// which is when its asynchronous operation succeeds for asynchronous deletes.
+func (c *Change) Complete(ctx context.Context, statusCode int, header http.Header) {
+	// The server responds with 200 OK if the handler didn't write a response.
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	var eventType datamodel.EventType
+	switch {
+	case c.method == http.MethodDelete && statusCode == http.StatusOK:
+		eventType = datamodel.EventTypeResourceDeleted
+	case c.method != http.MethodDelete && statusCode >= 200 && statusCode < 300:
+		eventType = datamodel.EventTypeResourceUpdated
+		if !c.existed {
+			eventType = datamodel.EventTypeResourceCreated
+		}
+	default:
+		return
+	}
+
+	data := EventData{
+		OperationType: c.operationType.String(),
+		OperationID:   asyncOperationID(header.Get(asyncOperationHeader)),
+	}
+	c.publisher.publish(ctx, c.subscriptions, NewEvent(eventType, c.id, data))
+}
+
+// OperationUpdated implements statusmanager.Observer. It publishes an event when an asynchronous operation reaches a
+// final state, and a resource deleted event when an asynchronous delete succeeds.
+func (p *Publisher) OperationUpdated(ctx context.Context, status *statusmanager.Status) {
+	var eventType datamodel.EventType
+	switch status.Status {
+	case v1.ProvisioningStateSucceeded:
+		eventType = datamodel.EventTypeOperationSucceeded
+	case v1.ProvisioningStateFailed:
+		eventType = datamodel.EventTypeOperationFailed
+	case v1.ProvisioningStateCanceled:
+		eventType = datamodel.EventTypeOperationCanceled
+	default:
+		return
+	}
+
+	id, err := resources.Parse(status.LinkedResourceID)
+	if err != nil {
+		return
+	}
+
+	subscriptions, err := p.Subscriptions(ctx, id)
+	if err != nil {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Error(err, "failed to list event subscriptions", "resourceID", id.String())
+		return
+	}
+
+	data := EventData{
+		OperationType: status.OperationType,
+		OperationID:   status.Name,
+		Status:        string(status.Status),
+		Error:         status.Error,
+	}
+	p.publish(ctx, subscriptions, NewEvent(eventType, id, data))
+
+	operationType, ok := v1.ParseOperationType(status.OperationType)
+	if ok && operationType.Method == v1.OperationDelete && status.Status == v1.ProvisioningStateSucceeded {
+		p.publish(ctx, subscriptions, NewEvent(datamodel.EventTypeResourceDeleted, id, EventData{
+			OperationType: status.OperationType,
+			OperationID:   status.Name,
+		}))
+	}
+}
+
+// exists returns true if the resource is saved in the data store.
+func (p *Publisher) exists(ctx context.Context, id resources.ID) (bool, error) {
+	client, err := p.storageProvider.GetStorageClient(ctx, id.Type())
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.Get(ctx, id.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// asyncOperationID returns the operation ID at the end of the operation status URL.
+func asyncOperationID(statusURL string) string {
+	if statusURL == "" {
+		return ""
+	}
+	u, err := url.Parse(statusURL)
+	if err != nil {
+		return ""
+	}
+	return path.Base(u.Path)
+}
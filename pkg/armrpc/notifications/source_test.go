@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ObserveRequest(t *testing.T) {
+	id := resources.MustParse(testContainerID)
+	putOperation := v1.OperationType{Type: "Applications.Core/containers", Method: v1.OperationPut}
+	deleteOperation := v1.OperationType{Type: "Applications.Core/containers", Method: v1.OperationDelete}
+
+	tests := []struct {
+		name          string
+		method        string
+		operationType v1.OperationType
+		existing      bool
+		statusCode    int
+		header        http.Header
+		expected      []EventData
+		expectedType  string
+	}{
+		{
+			name:          "create",
+			method:        http.MethodPut,
+			operationType: putOperation,
+			statusCode:    http.StatusCreated,
+			header:        http.Header{"Azure-Asyncoperation": []string{"http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/op-1?api-version=2023-10-01-preview"}},
+			expectedType:  "io.radapp.resource.created",
+			expected:      []EventData{{OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT", OperationID: "op-1"}},
+		},
+		{
+			name:          "update",
+			method:        http.MethodPut,
+			operationType: putOperation,
+			existing:      true,
+			statusCode:    http.StatusOK,
+			expectedType:  "io.radapp.resource.updated",
+			expected:      []EventData{{OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT"}},
+		},
+		{
+			name:          "rejected update",
+			method:        http.MethodPut,
+			operationType: putOperation,
+			existing:      true,
+			statusCode:    http.StatusBadRequest,
+		},
+		{
+			name:          "synchronous delete",
+			method:        http.MethodDelete,
+			operationType: deleteOperation,
+			statusCode:    http.StatusOK,
+			expectedType:  "io.radapp.resource.deleted",
+			expected:      []EventData{{OperationType: "APPLICATIONS.CORE/CONTAINERS|DELETE"}},
+		},
+		{
+			// Asynchronous deletes are published when their operation succeeds.
+			name:          "asynchronous delete",
+			method:        http.MethodDelete,
+			operationType: deleteOperation,
+			statusCode:    http.StatusAccepted,
+		},
+		{
+			name:          "delete of a missing resource",
+			method:        http.MethodDelete,
+			operationType: deleteOperation,
+			statusCode:    http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReceiver(t)
+			env := newTestEnvironment(t, newTestSubscription(r.URL, ""))
+
+			if tt.method != http.MethodDelete {
+				var err error
+				if !tt.existing {
+					err = &store.ErrNotFound{ID: testContainerID}
+				}
+				env.resourceClient.EXPECT().Get(gomock.Any(), testContainerID).Return(&store.Object{}, err)
+			}
+
+			req := httptest.NewRequest(tt.method, testContainerID, nil)
+			change := env.publisher.ObserveRequest(context.Background(), req, tt.operationType, id)
+			require.NotNil(t, change)
+
+			header := tt.header
+			if header == nil {
+				header = http.Header{}
+			}
+			change.Complete(context.Background(), tt.statusCode, header)
+			env.publisher.Wait()
+
+			events := r.Events(t)
+			require.Len(t, events, len(tt.expected))
+			for i, expected := range tt.expected {
+				expected.ResourceID = testContainerID
+				expected.ResourceType = "Applications.Core/containers"
+				require.Equal(t, tt.expectedType, events[i].Type)
+				require.Equal(t, expected, events[i].Data)
+			}
+		})
+	}
+}
+
+func Test_ObserveRequest_NotObserved(t *testing.T) {
+	id := resources.MustParse(testContainerID)
+
+	t.Run("read", func(t *testing.T) {
+		env := newTestEnvironment(t, newTestSubscription("http://localhost", ""))
+		req := httptest.NewRequest(http.MethodGet, testContainerID, nil)
+		require.Nil(t, env.publisher.ObserveRequest(context.Background(), req, v1.OperationType{Type: "Applications.Core/containers", Method: v1.OperationGet}, id))
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		env := newTestEnvironment(t, newTestSubscription("http://localhost", ""))
+		req := httptest.NewRequest(http.MethodPut, testContainerID, nil)
+		require.Nil(t, env.publisher.ObserveRequest(context.Background(), req, v1.OperationType{Type: "Applications.Core/containers", Method: v1.OperationProxy}, id))
+	})
+
+	t.Run("no subscriptions", func(t *testing.T) {
+		env := newTestEnvironment(t)
+		req := httptest.NewRequest(http.MethodPut, testContainerID, nil)
+		require.Nil(t, env.publisher.ObserveRequest(context.Background(), req, v1.OperationType{Type: "Applications.Core/containers", Method: v1.OperationPut}, id))
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		subscription := newTestSubscription("http://localhost", "")
+		subscription.Properties.EventTypes = []datamodel.EventType{datamodel.EventTypeOperationFailed}
+		env := newTestEnvironment(t, subscription)
+		req := httptest.NewRequest(http.MethodPut, testContainerID, nil)
+		require.Nil(t, env.publisher.ObserveRequest(context.Background(), req, v1.OperationType{Type: "Applications.Core/containers", Method: v1.OperationPut}, id))
+	})
+}
+
+func Test_OperationUpdated(t *testing.T) {
+	newStatus := func(operationType string, state v1.ProvisioningState, err *v1.ErrorDetails) *statusmanager.Status {
+		return &statusmanager.Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				Name:   "op-1",
+				Status: state,
+				Error:  err,
+			},
+			LinkedResourceID: testContainerID,
+			OperationType:    operationType,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		status        *statusmanager.Status
+		expectedTypes []string
+	}{
+		{
+			name:          "put succeeded",
+			status:        newStatus("APPLICATIONS.CORE/CONTAINERS|PUT", v1.ProvisioningStateSucceeded, nil),
+			expectedTypes: []string{"io.radapp.operation.succeeded"},
+		},
+		{
+			name:          "put failed",
+			status:        newStatus("APPLICATIONS.CORE/CONTAINERS|PUT", v1.ProvisioningStateFailed, &v1.ErrorDetails{Code: "Internal", Message: "failed"}),
+			expectedTypes: []string{"io.radapp.operation.failed"},
+		},
+		{
+			name:          "put canceled",
+			status:        newStatus("APPLICATIONS.CORE/CONTAINERS|PUT", v1.ProvisioningStateCanceled, nil),
+			expectedTypes: []string{"io.radapp.operation.canceled"},
+		},
+		{
+			name:          "delete succeeded",
+			status:        newStatus("APPLICATIONS.CORE/CONTAINERS|DELETE", v1.ProvisioningStateSucceeded, nil),
+			expectedTypes: []string{"io.radapp.operation.succeeded", "io.radapp.resource.deleted"},
+		},
+		{
+			name:   "in progress",
+			status: newStatus("APPLICATIONS.CORE/CONTAINERS|PUT", v1.ProvisioningStateUpdating, nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReceiver(t)
+			env := newTestEnvironment(t, newTestSubscription(r.URL, ""))
+
+			env.publisher.OperationUpdated(context.Background(), tt.status)
+			env.publisher.Wait()
+
+			events := r.Events(t)
+			types := []string{}
+			for _, event := range events {
+				types = append(types, event.Type)
+				require.Equal(t, testContainerID, event.Subject)
+				require.Equal(t, "op-1", event.Data.OperationID)
+				require.Equal(t, tt.status.OperationType, event.Data.OperationType)
+			}
+			require.ElementsMatch(t, tt.expectedTypes, types)
+
+			for _, event := range events {
+				if event.Type == string(datamodel.EventTypeOperationFailed) {
+					require.Equal(t, string(v1.ProvisioningStateFailed), event.Data.Status)
+					require.Equal(t, tt.status.Error, event.Data.Error)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package openapi builds OpenAPI v3 documents that describe the ARM RPC APIs of resource providers.
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	// Version is the version of the OpenAPI specification of the documents.
+	Version = "3.0.3"
+
+	// RootScopeParameter is the name of the path parameter of the root scope of resources, for example
+	// 'planes/radius/local/resourceGroups/rg'.
+	RootScopeParameter = "rootScope"
+
+	// APIVersionParameter is the name of the query parameter of the API version.
+	APIVersionParameter = "api-version"
+)
+
+// Document is an OpenAPI v3 document. Only the parts of the specification used by ARM RPC APIs are modeled.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// Info is the metadata of a document.
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Components holds the reusable schemas of a document.
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas,omitempty"`
+}
+
+// PathItem describes the operations of a path.
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Put    *Operation `json:"put,omitempty"`
+	Patch  *Operation `json:"patch,omitempty"`
+	Post   *Operation `json:"post,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+}
+
+// Operation describes an operation of a path.
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter describes a path or query parameter of an operation.
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema,omitempty"`
+}
+
+// RequestBody describes the request body of an operation.
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response describes a response of an operation.
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType describes the content of a request or response body.
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+// Schema is a JSON schema of a value.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	ReadOnly             bool               `json:"readOnly,omitempty"`
+}
+
+// NewDocument creates an empty document.
+func NewDocument(title, version string) *Document {
+	return &Document{
+		OpenAPI: Version,
+		Info:    Info{Title: title, Version: version},
+		Paths:   map[string]*PathItem{},
+		Components: Components{
+			Schemas: map[string]*Schema{},
+		},
+	}
+}
+
+// Merge adds the paths and schemas of other to the document. It returns an error if both documents define the same
+// operation of a path or different schemas with the same name.
+func (d *Document) Merge(other *Document) error {
+	for path, item := range other.Paths {
+		for method, op := range item.operations() {
+			if err := d.addOperation(path, method, op); err != nil {
+				return err
+			}
+		}
+	}
+
+	for name, schema := range other.Components.Schemas {
+		if existing, ok := d.Components.Schemas[name]; ok && !reflect.DeepEqual(existing, schema) {
+			return fmt.Errorf("schema %q is defined by more than one document", name)
+		}
+		d.Components.Schemas[name] = schema
+	}
+
+	return nil
+}
+
+// Operations returns the methods of the operations of each path, in lowercase and sorted, e.g. ["get", "put"].
+func (d *Document) Operations() map[string][]string {
+	result := map[string][]string{}
+	for path, item := range d.Paths {
+		methods := []string{}
+		for method := range item.operations() {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+		result[path] = methods
+	}
+	return result
+}
+
+func (d *Document) addOperation(path, method string, op *Operation) error {
+	item, ok := d.Paths[path]
+	if !ok {
+		item = &PathItem{}
+		d.Paths[path] = item
+	}
+
+	var target **Operation
+	switch strings.ToLower(method) {
+	case "get":
+		target = &item.Get
+	case "put":
+		target = &item.Put
+	case "patch":
+		target = &item.Patch
+	case "post":
+		target = &item.Post
+	case "delete":
+		target = &item.Delete
+	default:
+		return fmt.Errorf("unsupported method %q for path %q", method, path)
+	}
+
+	if *target != nil {
+		return fmt.Errorf("operation %s %s is defined more than once", strings.ToUpper(method), path)
+	}
+	*target = op
+	return nil
+}
+
+func (p *PathItem) operations() map[string]*Operation {
+	ops := map[string]*Operation{}
+	for method, op := range map[string]*Operation{"get": p.Get, "put": p.Put, "patch": p.Patch, "post": p.Post, "delete": p.Delete} {
+		if op != nil {
+			ops[method] = op
+		}
+	}
+	return ops
+}
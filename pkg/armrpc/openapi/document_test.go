@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument_Merge(t *testing.T) {
+	newDoc := func(path string, op *Operation, schemas map[string]*Schema) *Document {
+		doc := NewDocument("test", "2023-10-01-preview")
+		doc.Paths[path] = &PathItem{Get: op}
+		for name, schema := range schemas {
+			doc.Components.Schemas[name] = schema
+		}
+		return doc
+	}
+
+	t.Run("disjoint documents", func(t *testing.T) {
+		doc := NewDocument("merged", "v1")
+		err := doc.Merge(newDoc("/a", &Operation{OperationID: "A_Get"}, map[string]*Schema{"A": {Type: "object"}}))
+		require.NoError(t, err)
+		err = doc.Merge(newDoc("/b", &Operation{OperationID: "B_Get"}, map[string]*Schema{"A": {Type: "object"}, "B": {Type: "string"}}))
+		require.NoError(t, err)
+
+		require.Equal(t, map[string][]string{"/a": {"get"}, "/b": {"get"}}, doc.Operations())
+		require.Len(t, doc.Components.Schemas, 2)
+	})
+
+	t.Run("same operation", func(t *testing.T) {
+		doc := NewDocument("merged", "v1")
+		require.NoError(t, doc.Merge(newDoc("/a", &Operation{OperationID: "A_Get"}, nil)))
+		err := doc.Merge(newDoc("/a", &Operation{OperationID: "A_Get"}, nil))
+		require.EqualError(t, err, "operation GET /a is defined more than once")
+	})
+
+	t.Run("different schemas with the same name", func(t *testing.T) {
+		doc := NewDocument("merged", "v1")
+		require.NoError(t, doc.Merge(newDoc("/a", &Operation{}, map[string]*Schema{"A": {Type: "object"}})))
+		err := doc.Merge(newDoc("/b", &Operation{}, map[string]*Schema{"A": {Type: "string"}}))
+		require.EqualError(t, err, "schema \"A\" is defined by more than one document")
+	})
+}
+
+func TestDocument_Operations(t *testing.T) {
+	doc := NewDocument("test", "v1")
+	doc.Paths["/a"] = &PathItem{Put: &Operation{}, Get: &Operation{}, Delete: &Operation{}}
+	doc.Paths["/a/start"] = &PathItem{Post: &Operation{}}
+
+	require.Equal(t, map[string][]string{
+		"/a":       {"delete", "get", "put"},
+		"/a/start": {"post"},
+	}, doc.Operations())
+}
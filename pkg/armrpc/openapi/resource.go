@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	customActionPrefix = "ACTION"
+	jsonContentType    = "application/json"
+)
+
+var pathParameterPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// ResourceOperation describes an operation of a resource type.
+type ResourceOperation struct {
+	// ResourceType is the fully qualified resource type, e.g. 'Applications.Core/environments'.
+	ResourceType string
+
+	// Path is the path of the operation relative to the root scope, e.g.
+	// '/providers/Applications.Core/environments/{environmentName}'.
+	Path string
+
+	// Method is the operation method. Custom actions use the 'ACTION' prefix followed by the name of the action.
+	Method v1.OperationMethod
+
+	// APIVersions are the supported API versions of the resource type.
+	APIVersions []string
+
+	// Schema is the schema of the resource, or nil if the schema is unknown.
+	Schema *Schema
+}
+
+// AddResourceOperation adds an operation of a resource type under the root scope to the document. Listing resources at
+// plane and resource group scope share a path, so adding the second of them is a no-op.
+func (d *Document) AddResourceOperation(op ResourceOperation) error {
+	path := "/{" + RootScopeParameter + "}" + op.Path
+	typeName := op.ResourceType[strings.LastIndex(op.ResourceType, "/")+1:]
+	tag := strings.ToUpper(typeName[:1]) + typeName[1:]
+
+	operation := &Operation{
+		Tags:       []string{tag},
+		Parameters: d.parameters(path, op.APIVersions),
+		Responses: map[string]*Response{
+			"default": d.errorResponse(),
+		},
+	}
+
+	method := http.MethodGet
+	switch op.Method {
+	case v1.OperationPlaneScopeList, v1.OperationList:
+		if item, ok := d.Paths[path]; ok && item.Get != nil {
+			return nil
+		}
+		operation.OperationID = tag + "_ListByScope"
+		operation.Responses["200"] = jsonResponse("OK", listSchema(op.Schema))
+
+	case v1.OperationGet:
+		operation.OperationID = tag + "_Get"
+		operation.Responses["200"] = jsonResponse("OK", op.Schema)
+
+	case v1.OperationPut:
+		method = http.MethodPut
+		operation.OperationID = tag + "_CreateOrUpdate"
+		operation.RequestBody = jsonRequestBody(op.Schema)
+		operation.Responses["200"] = jsonResponse("OK", op.Schema)
+		operation.Responses["201"] = jsonResponse("Created", op.Schema)
+
+	case v1.OperationPatch:
+		method = http.MethodPatch
+		operation.OperationID = tag + "_Update"
+		operation.RequestBody = jsonRequestBody(op.Schema)
+		operation.Responses["200"] = jsonResponse("OK", op.Schema)
+		operation.Responses["202"] = &Response{Description: "Accepted"}
+
+	case v1.OperationDelete:
+		method = http.MethodDelete
+		operation.OperationID = tag + "_Delete"
+		operation.Responses["200"] = &Response{Description: "OK"}
+		operation.Responses["202"] = &Response{Description: "Accepted"}
+		operation.Responses["204"] = &Response{Description: "No Content"}
+
+	default:
+		action, ok := strings.CutPrefix(strings.ToUpper(string(op.Method)), customActionPrefix)
+		if !ok || action == "" {
+			return fmt.Errorf("unsupported operation method %q for resource type %q", op.Method, op.ResourceType)
+		}
+		method = http.MethodPost
+		operation.OperationID = tag + "_" + path[strings.LastIndex(path, "/")+1:]
+		operation.Responses["200"] = &Response{Description: "OK"}
+	}
+
+	return d.addOperation(path, method, operation)
+}
+
+// AddProviderOperations adds the operation that lists the available operations of the namespace to the document.
+func (d *Document) AddProviderOperations(namespace string, apiVersions []string) error {
+	path := "/providers/" + namespace + "/operations"
+	return d.addOperation(path, http.MethodGet, &Operation{
+		OperationID: "Operations_List",
+		Tags:        []string{"Operations"},
+		Parameters:  d.parameters(path, apiVersions),
+		Responses: map[string]*Response{
+			"200":     {Description: "OK"},
+			"default": d.errorResponse(),
+		},
+	})
+}
+
+// ResourceSchema returns the schema of a tracked resource whose properties have the given schema.
+func ResourceSchema(properties *Schema) *Schema {
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"id":         {Type: "string", ReadOnly: true},
+			"name":       {Type: "string", ReadOnly: true},
+			"type":       {Type: "string", ReadOnly: true},
+			"location":   {Type: "string"},
+			"tags":       {Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+			"properties": properties,
+		},
+	}
+}
+
+func (d *Document) parameters(path string, apiVersions []string) []Parameter {
+	parameters := []Parameter{}
+	for _, match := range pathParameterPattern.FindAllStringSubmatch(path, -1) {
+		parameters = append(parameters, Parameter{Name: match[1], In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+
+	versions := &Schema{Type: "string"}
+	for _, version := range apiVersions {
+		versions.Enum = append(versions.Enum, version)
+	}
+	return append(parameters, Parameter{Name: APIVersionParameter, In: "query", Required: true, Schema: versions})
+}
+
+func (d *Document) errorResponse() *Response {
+	return jsonResponse("Error response describing why the operation failed.", d.SchemaOf("", v1.ErrorResponse{}))
+}
+
+func listSchema(item *Schema) *Schema {
+	if item == nil {
+		item = &Schema{}
+	}
+
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"value":    {Type: "array", Items: item},
+			"nextLink": {Type: "string"},
+		},
+	}
+}
+
+func jsonRequestBody(schema *Schema) *RequestBody {
+	return &RequestBody{Required: true, Content: map[string]*MediaType{jsonContentType: {Schema: schema}}}
+}
+
+func jsonResponse(description string, schema *Schema) *Response {
+	if schema == nil {
+		return &Response{Description: description}
+	}
+	return &Response{Description: description, Content: map[string]*MediaType{jsonContentType: {Schema: schema}}}
+}
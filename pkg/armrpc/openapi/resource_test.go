@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddResourceOperation(t *testing.T) {
+	const (
+		resourceType = "Applications.Core/environments"
+		listPath     = "/providers/Applications.Core/environments"
+		resourcePath = listPath + "/{environmentName}"
+	)
+	versions := []string{"2023-10-01-preview"}
+	schema := ResourceSchema(&Schema{Type: "object"})
+
+	doc := NewDocument("test", "v1")
+	for _, op := range []ResourceOperation{
+		{Method: v1.OperationPlaneScopeList, Path: listPath},
+		{Method: v1.OperationList, Path: listPath},
+		{Method: v1.OperationGet, Path: resourcePath},
+		{Method: v1.OperationPut, Path: resourcePath},
+		{Method: v1.OperationPatch, Path: resourcePath},
+		{Method: v1.OperationDelete, Path: resourcePath},
+		{Method: "ACTIONGETMETADATA", Path: resourcePath + "/getmetadata"},
+	} {
+		op.ResourceType = resourceType
+		op.APIVersions = versions
+		op.Schema = schema
+		require.NoError(t, doc.AddResourceOperation(op))
+	}
+
+	require.Equal(t, map[string][]string{
+		"/{rootScope}" + listPath:                      {"get"},
+		"/{rootScope}" + resourcePath:                  {"delete", "get", "patch", "put"},
+		"/{rootScope}" + resourcePath + "/getmetadata": {"post"},
+	}, doc.Operations())
+
+	list := doc.Paths["/{rootScope}"+listPath].Get
+	require.Equal(t, "Environments_ListByScope", list.OperationID)
+	require.Equal(t, schema, list.Responses["200"].Content[jsonContentType].Schema.Properties["value"].Items)
+
+	item := doc.Paths["/{rootScope}"+resourcePath]
+	require.Equal(t, "Environments_Get", item.Get.OperationID)
+	require.Equal(t, "Environments_CreateOrUpdate", item.Put.OperationID)
+	require.Equal(t, schema, item.Put.RequestBody.Content[jsonContentType].Schema)
+	require.Equal(t, "Environments_Update", item.Patch.OperationID)
+	require.Equal(t, "Environments_Delete", item.Delete.OperationID)
+	require.Equal(t, []Parameter{
+		{Name: RootScopeParameter, In: "path", Required: true, Schema: &Schema{Type: "string"}},
+		{Name: "environmentName", In: "path", Required: true, Schema: &Schema{Type: "string"}},
+		{Name: APIVersionParameter, In: "query", Required: true, Schema: &Schema{Type: "string", Enum: []any{"2023-10-01-preview"}}},
+	}, item.Get.Parameters)
+	require.Equal(t, "#/components/schemas/ErrorResponse", item.Get.Responses["default"].Content[jsonContentType].Schema.Ref)
+	require.Contains(t, doc.Components.Schemas, "ErrorResponse")
+
+	require.Equal(t, "Environments_getmetadata", doc.Paths["/{rootScope}"+resourcePath+"/getmetadata"].Post.OperationID)
+}
+
+func TestAddResourceOperation_Invalid(t *testing.T) {
+	doc := NewDocument("test", "v1")
+
+	err := doc.AddResourceOperation(ResourceOperation{ResourceType: "Applications.Core/environments", Method: "UNKNOWN"})
+	require.EqualError(t, err, "unsupported operation method \"UNKNOWN\" for resource type \"Applications.Core/environments\"")
+
+	op := ResourceOperation{ResourceType: "Applications.Core/environments", Path: "/providers/Applications.Core/environments/{environmentName}", Method: v1.OperationGet}
+	require.NoError(t, doc.AddResourceOperation(op))
+	require.Error(t, doc.AddResourceOperation(op))
+}
+
+func TestAddProviderOperations(t *testing.T) {
+	doc := NewDocument("test", "v1")
+
+	require.NoError(t, doc.AddProviderOperations("Applications.Core", []string{"2023-10-01-preview"}))
+	require.Equal(t, map[string][]string{"/providers/Applications.Core/operations": {"get"}}, doc.Operations())
+	require.Equal(t, "Operations_List", doc.Paths["/providers/Applications.Core/operations"].Get.OperationID)
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// SchemaOf returns the schema of the type of v. The schemas of named struct types are added to the components of the
+// document, named by the prefix followed by the name of the type, and referenced from the returned schema.
+//
+// Properties are named by their json tags. The generated API models have no json tags and implement json.Marshaler, so
+// the names of their properties are discovered by marshalling a value whose fields are all set.
+func (d *Document) SchemaOf(prefix string, v any) *Schema {
+	return d.schemaOf(prefix, reflect.TypeOf(v))
+}
+
+func (d *Document) schemaOf(prefix string, t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: d.schemaOf(prefix, t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: d.schemaOf(prefix, t.Elem())}
+	case reflect.Struct:
+		if t == timeType {
+			return &Schema{Type: "string", Format: "date-time"}
+		}
+		if t.Name() == "" {
+			return d.structSchema(prefix, t)
+		}
+
+		name := prefix + t.Name()
+		if _, ok := d.Components.Schemas[name]; !ok {
+			// Add a placeholder first so that recursive types terminate.
+			d.Components.Schemas[name] = &Schema{}
+			*d.Components.Schemas[name] = *d.structSchema(prefix, t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + name}
+	case reflect.Interface:
+		if base := polymorphicBase(t); base != nil {
+			return d.schemaOf(prefix, base)
+		}
+		return &Schema{}
+	default:
+		return &Schema{}
+	}
+}
+
+// polymorphicBase returns the base model of a polymorphic model of the generated API models, or nil if the interface
+// type is not a polymorphic model. Polymorphic models are interfaces whose only method returns the base model, e.g.
+// 'GetVolumeProperties() *VolumeProperties'.
+func polymorphicBase(t reflect.Type) reflect.Type {
+	if t.NumMethod() != 1 {
+		return nil
+	}
+
+	method := t.Method(0)
+	if method.Type.NumIn() != 0 || method.Type.NumOut() != 1 {
+		return nil
+	}
+
+	out := method.Type.Out(0)
+	if out.Kind() != reflect.Pointer || out.Elem().Kind() != reflect.Struct || "Get"+out.Elem().Name() != method.Name {
+		return nil
+	}
+	return out.Elem()
+}
+
+func (d *Document) structSchema(prefix string, t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	names := marshalledNames(t)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		// The fields of embedded structs are promoted even if the embedded type is unexported.
+		if !field.IsExported() && !(field.Anonymous && field.Type.Kind() == reflect.Struct) {
+			continue
+		}
+
+		name, omitEmpty, ok := propertyName(field, names)
+		if !ok {
+			continue
+		}
+
+		// The generated API models inline the additional properties of open objects.
+		if field.Name == "AdditionalProperties" && field.Type.Kind() == reflect.Map && field.Tag.Get("json") == "" {
+			schema.AdditionalProperties = d.schemaOf(prefix, field.Type.Elem())
+			continue
+		}
+
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			if embedded := d.schemaOf(prefix, field.Type); embedded.Ref != "" {
+				embedded = d.Components.Schemas[strings.TrimPrefix(embedded.Ref, "#/components/schemas/")]
+				for k, v := range embedded.Properties {
+					schema.Properties[k] = v
+				}
+				schema.Required = append(schema.Required, embedded.Required...)
+				continue
+			}
+		}
+
+		schema.Properties[name] = d.schemaOf(prefix, field.Type)
+		if field.Tag.Get("json") != "" && !omitEmpty && field.Type.Kind() != reflect.Pointer {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return schema
+}
+
+// propertyName returns the name of the property of the field, whether it is omitted when empty and false if the field
+// is not serialized.
+func propertyName(field reflect.StructField, marshalled []string) (string, bool, bool) {
+	if tag := field.Tag.Get("json"); tag != "" {
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			return "", false, false
+		}
+		if name == "" {
+			name = field.Name
+		}
+		return name, strings.Contains(options, "omitempty"), true
+	}
+
+	for _, name := range marshalled {
+		if strings.EqualFold(name, field.Name) {
+			return name, true, true
+		}
+	}
+	return strings.ToLower(field.Name[:1]) + field.Name[1:], true, true
+}
+
+// marshalledNames returns the property names of a marshalled value of the struct type whose pointer, slice and map
+// fields are set.
+func marshalledNames(t reflect.Type) []string {
+	if !reflect.PointerTo(t).Implements(reflect.TypeOf((*json.Marshaler)(nil)).Elem()) {
+		return nil
+	}
+
+	value := reflect.New(t)
+	for i := 0; i < t.NumField(); i++ {
+		field := value.Elem().Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		switch field.Kind() {
+		case reflect.Pointer:
+			field.Set(reflect.New(field.Type().Elem()))
+		case reflect.Slice:
+			field.Set(reflect.MakeSlice(field.Type(), 0, 0))
+		case reflect.Map:
+			field.Set(reflect.MakeMap(field.Type()))
+		}
+	}
+
+	b, err := json.Marshal(value.Interface())
+	if err != nil {
+		return nil
+	}
+
+	properties := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &properties); err != nil {
+		return nil
+	}
+
+	names := []string{}
+	for name := range properties {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type taggedModel struct {
+	Name     string            `json:"name"`
+	Count    *int32            `json:"count,omitempty"`
+	Created  time.Time         `json:"created"`
+	Data     []byte            `json:"data,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Ignored  string            `json:"-"`
+	Children []*taggedModel    `json:"children,omitempty"`
+	internal string
+}
+
+// generatedModel mimics the generated API models which have no json tags and implement json.Marshaler.
+type generatedModel struct {
+	ProvisioningState    *string
+	Kind                 generatedKind
+	AdditionalProperties map[string]any
+}
+
+func (m generatedModel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"provisioningState": m.ProvisioningState,
+		"kind":              m.Kind,
+	})
+}
+
+type generatedKind interface {
+	GetGeneratedKindBase() *GeneratedKindBase
+}
+
+type GeneratedKindBase struct {
+	Kind *string `json:"kind,omitempty"`
+}
+
+func (b *GeneratedKindBase) GetGeneratedKindBase() *GeneratedKindBase { return b }
+
+type embeddingModel struct {
+	taggedBase
+	Value string `json:"value"`
+}
+
+type taggedBase struct {
+	ID string `json:"id"`
+}
+
+func TestSchemaOf_TaggedStruct(t *testing.T) {
+	doc := NewDocument("test", "v1")
+
+	schema := doc.SchemaOf("Test.", &taggedModel{})
+	require.Equal(t, &Schema{Ref: "#/components/schemas/Test.taggedModel"}, schema)
+
+	component := doc.Components.Schemas["Test.taggedModel"]
+	require.NotNil(t, component)
+	require.Equal(t, "object", component.Type)
+	require.ElementsMatch(t, []string{"name", "created"}, component.Required)
+	require.Equal(t, &Schema{Type: "string"}, component.Properties["name"])
+	require.Equal(t, &Schema{Type: "integer", Format: "int32"}, component.Properties["count"])
+	require.Equal(t, &Schema{Type: "string", Format: "date-time"}, component.Properties["created"])
+	require.Equal(t, &Schema{Type: "string", Format: "byte"}, component.Properties["data"])
+	require.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}}, component.Properties["labels"])
+
+	// Recursive types reference their own component.
+	require.Equal(t, &Schema{Type: "array", Items: &Schema{Ref: "#/components/schemas/Test.taggedModel"}}, component.Properties["children"])
+
+	require.NotContains(t, component.Properties, "Ignored")
+	require.NotContains(t, component.Properties, "internal")
+	require.Len(t, component.Properties, 6)
+}
+
+func TestSchemaOf_GeneratedModel(t *testing.T) {
+	doc := NewDocument("test", "v1")
+
+	schema := doc.SchemaOf("", generatedModel{})
+	require.Equal(t, "#/components/schemas/generatedModel", schema.Ref)
+
+	component := doc.Components.Schemas["generatedModel"]
+	require.Equal(t, &Schema{Type: "string"}, component.Properties["provisioningState"])
+	require.Equal(t, &Schema{Ref: "#/components/schemas/GeneratedKindBase"}, component.Properties["kind"])
+	require.Equal(t, &Schema{}, component.AdditionalProperties)
+	require.NotContains(t, component.Properties, "additionalProperties")
+	require.Empty(t, component.Required)
+
+	require.Contains(t, doc.Components.Schemas["GeneratedKindBase"].Properties, "kind")
+}
+
+func TestSchemaOf_EmbeddedStruct(t *testing.T) {
+	doc := NewDocument("test", "v1")
+
+	doc.SchemaOf("", embeddingModel{})
+
+	component := doc.Components.Schemas["embeddingModel"]
+	require.Len(t, component.Properties, 2)
+	require.Contains(t, component.Properties, "id")
+	require.Contains(t, component.Properties, "value")
+	require.ElementsMatch(t, []string{"id", "value"}, component.Required)
+}
@@ -0,0 +1,446 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Checker computes the usage of quotas and finds the quotas that an operation exceeds. Quotas and resources are read
+// from the data store so that quotas created through UCP are enforced by every resource provider that shares the data
+// store.
+type Checker struct {
+	storageProvider dataprovider.DataStorageProvider
+}
+
+// NewChecker creates a Checker that reads quotas and resources from the storage provider.
+func NewChecker(storageProvider dataprovider.DataStorageProvider) *Checker {
+	return &Checker{storageProvider: storageProvider}
+}
+
+// List returns the quotas stored in the plane of the scope, including the quotas of its resource groups.
+func (c *Checker) List(ctx context.Context, scope resources.ID) ([]datamodel.Quota, error) {
+	client, err := c.storageProvider.GetStorageClient(ctx, datamodel.QuotaResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := store.Query{
+		RootScope:      scope.PlaneScope(),
+		ScopeRecursive: true,
+		ResourceType:   datamodel.QuotaResourceType,
+	}
+
+	quotas := []datamodel.Quota{}
+	err = queryAll(ctx, client, query, func(obj *store.Object) error {
+		quota := datamodel.Quota{}
+		if err := obj.As(&quota); err != nil {
+			return err
+		}
+		quotas = append(quotas, quota)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return quotas, nil
+}
+
+// Usage returns the current usage of the limits of the quota.
+func (c *Checker) Usage(ctx context.Context, quota *datamodel.Quota) (*datamodel.QuotaUsage, error) {
+	resources, err := c.resources(ctx, quota)
+	if err != nil {
+		return nil, err
+	}
+	return ComputeUsage(quota, resources), nil
+}
+
+// Check returns the violation if creating or updating the resource with the body of the request exceeds a quota, or
+// nil if the request is allowed.
+func (c *Checker) Check(ctx context.Context, req *http.Request, id resources.ID) (*Violation, error) {
+	quotas, err := c.List(ctx, id)
+	if err != nil || len(quotas) == 0 {
+		return nil, err
+	}
+
+	previous, err := c.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := Resource{ID: id.String(), Type: id.Type()}
+	if previous != nil {
+		resource = *previous
+	}
+	if err := c.applyRequest(ctx, req, &resource, previous == nil); err != nil {
+		return nil, err
+	}
+
+	for i := range quotas {
+		quota := &quotas[i]
+		if !AppliesTo(quota, resource) {
+			continue
+		}
+
+		change := Change{Resource: resource}
+		if previous != nil && AppliesTo(quota, *previous) {
+			change.Previous = previous
+		}
+
+		usage, err := c.Usage(ctx, quota)
+		if err != nil {
+			return nil, err
+		}
+		if violation := CheckChange(quota, usage, change); violation != nil {
+			return violation, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CheckRecipeExecution returns the violation if running the recipe of the resource exceeds the limit on recipe
+// executions in flight of a quota, or nil if the recipe can run.
+func (c *Checker) CheckRecipeExecution(ctx context.Context, resourceID string, environmentID string) (*Violation, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas, err := c.List(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := Resource{ID: id.String(), Type: id.Type(), Environment: environmentID}
+	for i := range quotas {
+		quota := &quotas[i]
+		if quota.Properties.Limits.MaxRecipeExecutions == nil || !AppliesTo(quota, resource) {
+			continue
+		}
+
+		resources, err := c.resources(ctx, quota)
+		if err != nil {
+			return nil, err
+		}
+		if violation := CheckRecipeExecution(quota, resources, resource.ID); violation != nil {
+			return violation, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// resources returns the resources that may count against the quota. The resources of a resource group are stored in
+// the resource group, but the resources of an environment may be stored in any resource group of the plane.
+func (c *Checker) resources(ctx context.Context, quota *datamodel.Quota) ([]Resource, error) {
+	scope, err := resources.Parse(quota.Properties.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.storageProvider.GetStorageClient(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	query := store.Query{RootScope: scope.String()}
+	if !IsResourceGroup(scope) {
+		query = store.Query{RootScope: scope.PlaneScope(), ScopeRecursive: true}
+	}
+
+	stored := []storedResource{}
+	err = queryAll(ctx, client, query, func(obj *store.Object) error {
+		resource := storedResource{}
+		if err := obj.As(&resource); err != nil {
+			return err
+		}
+		stored = append(stored, resource)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Resources that belong to an application belong to the environment of the application.
+	environments := map[string]string{}
+	for _, resource := range stored {
+		if strings.EqualFold(resource.Type, applicationResourceType) {
+			environments[normalize(resource.ID)] = resource.Properties.Environment
+		}
+	}
+
+	result := []Resource{}
+	for _, resource := range stored {
+		converted := resource.toResource()
+		if converted.Environment == "" && resource.Properties.Application != "" {
+			converted.Environment = environments[normalize(resource.Properties.Application)]
+		}
+		result = append(result, converted)
+	}
+
+	return result, nil
+}
+
+// get returns the stored resource with the given ID, or nil if the resource does not exist.
+func (c *Checker) get(ctx context.Context, id resources.ID) (*Resource, error) {
+	stored, err := c.getStored(ctx, id.String())
+	if err != nil || stored == nil {
+		return nil, err
+	}
+
+	resource := stored.toResource()
+	if resource.Environment == "" && stored.Properties.Application != "" {
+		resource.Environment, err = c.applicationEnvironment(ctx, stored.Properties.Application)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &resource, nil
+}
+
+// applyRequest updates the resource with the environment, application and replicas in the body of the request. The
+// body is restored so that it can be read by the controller. Properties which are not in the body of a PATCH request
+// are unchanged.
+func (c *Checker) applyRequest(ctx context.Context, req *http.Request, resource *Resource, created bool) error {
+	if req.Body == nil {
+		return nil
+	}
+
+	b, err := io.ReadAll(req.Body)
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return nil
+	}
+
+	requested := storedResource{}
+	if err := json.Unmarshal(b, &requested); err != nil {
+		// The controller rejects the invalid body.
+		return nil
+	}
+
+	if requested.Properties.Environment != "" {
+		resource.Environment = requested.Properties.Environment
+	} else if requested.Properties.Application != "" {
+		resource.Environment, err = c.applicationEnvironment(ctx, requested.Properties.Application)
+		if err != nil {
+			return err
+		}
+	}
+
+	if strings.EqualFold(resource.Type, containerResourceType) && (req.Method == http.MethodPut || requested.Properties.Extensions != nil || created) {
+		resource.Replicas = requested.replicas()
+	}
+
+	return nil
+}
+
+// applicationEnvironment returns the environment of the application, or an empty string if the application does not
+// exist.
+func (c *Checker) applicationEnvironment(ctx context.Context, applicationID string) (string, error) {
+	stored, err := c.getStored(ctx, applicationID)
+	if err != nil || stored == nil {
+		return "", err
+	}
+	return stored.Properties.Environment, nil
+}
+
+func (c *Checker) getStored(ctx context.Context, id string) (*storedResource, error) {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return nil, nil
+	}
+
+	client, err := c.storageProvider.GetStorageClient(ctx, parsed.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := client.Get(ctx, parsed.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	stored := &storedResource{}
+	if err := obj.As(stored); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+func queryAll(ctx context.Context, client store.StorageClient, query store.Query, fn func(obj *store.Object) error) error {
+	token := ""
+	for {
+		result, err := client.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return err
+		}
+
+		for i := range result.Items {
+			if err := fn(&result.Items[i]); err != nil {
+				return err
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+// storedResource is the subset of the properties of a resource that count against quotas. Both the stored datamodel
+// and the body of a request can be read as a storedResource.
+type storedResource struct {
+	ID                string               `json:"id"`
+	Type              string               `json:"type"`
+	ProvisioningState v1.ProvisioningState `json:"provisioningState"`
+	SystemData        v1.SystemData        `json:"systemData"`
+	Properties        struct {
+		Environment          string `json:"environment"`
+		Application          string `json:"application"`
+		Recipe               any    `json:"recipe"`
+		ResourceProvisioning string `json:"resourceProvisioning"`
+		Extensions           []struct {
+			Kind string `json:"kind"`
+
+			// Replicas is the number of replicas in the body of a request.
+			Replicas *int32 `json:"replicas"`
+
+			// ManualScaling is the manual scaling extension in the stored datamodel.
+			ManualScaling *struct {
+				Replicas *int32 `json:"replicas"`
+			} `json:"manualScaling"`
+		} `json:"extensions"`
+	} `json:"properties"`
+}
+
+func (r *storedResource) toResource() Resource {
+	resource := Resource{
+		ID:             r.ID,
+		Type:           r.Type,
+		Environment:    r.Properties.Environment,
+		LastModifiedAt: r.SystemData.LastModifiedAt,
+	}
+
+	if strings.EqualFold(r.Type, containerResourceType) {
+		resource.Replicas = r.replicas()
+	}
+
+	// Recipes run while resources are created or updated. A resource is provisioned by a recipe unless it is
+	// provisioned manually.
+	inProgress := !r.ProvisioningState.IsTerminal() && r.ProvisioningState != v1.ProvisioningStateDeleting
+	resource.RecipeInFlight = inProgress && r.Properties.Recipe != nil &&
+		!strings.EqualFold(r.Properties.ResourceProvisioning, "manual")
+
+	return resource
+}
+
+// replicas returns the number of replicas of a container, which is one unless the manual scaling extension is set.
+func (r *storedResource) replicas() int32 {
+	for _, extension := range r.Properties.Extensions {
+		if !strings.EqualFold(extension.Kind, "manualScaling") {
+			continue
+		}
+		if extension.Replicas != nil {
+			return *extension.Replicas
+		}
+		if extension.ManualScaling != nil && extension.ManualScaling.Replicas != nil {
+			return *extension.ManualScaling.Replicas
+		}
+	}
+	return 1
+}
+
+type checkerKey struct{}
+
+// WithChecker returns a middleware that stores the quota checker in the request context.
+func WithChecker(checker *Checker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), checkerKey{}, checker)))
+		})
+	}
+}
+
+// FromContext returns the quota checker stored in the context, or nil if quotas are not enforced.
+func FromContext(ctx context.Context) *Checker {
+	checker, ok := ctx.Value(checkerKey{}).(*Checker)
+	if !ok {
+		return nil
+	}
+	return checker
+}
+
+// CheckRequest checks whether creating or updating the resource of the request exceeds a quota. It returns nil if the
+// request is allowed or quotas are not enforced, and the error response to send otherwise.
+func CheckRequest(ctx context.Context, req *http.Request) (rest.Response, error) {
+	checker := FromContext(ctx)
+	if checker == nil || (req.Method != http.MethodPut && req.Method != http.MethodPatch) {
+		return nil, nil
+	}
+
+	id := v1.ARMRequestContextFromContext(ctx).ResourceID
+	if !id.IsResource() || id.ProviderNamespace() == "" || isSystemResource(id) {
+		return nil, nil
+	}
+
+	violation, err := checker.Check(ctx, req, id)
+	if err != nil || violation == nil {
+		return nil, err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info("request exceeds a quota", "quota", violation.Quota.ID, "limit", violation.Limit, "scope", id.String())
+	return NewQuotaExceededResponse(id.String(), violation), nil
+}
+
+// NewQuotaExceededResponse creates a 409 Conflict response for an operation that exceeds the quota.
+func NewQuotaExceededResponse(target string, violation *Violation) rest.Response {
+	return &rest.ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeQuotaExceeded,
+				Message: violation.Message(target),
+				Target:  target,
+			},
+		},
+	}
+}
+
+// isSystemResource returns true if the resource is managed by UCP, such as a quota or a lock. These resources do not
+// count against quotas.
+func isSystemResource(id resources.ID) bool {
+	return strings.HasPrefix(strings.ToLower(id.ProviderNamespace()), "system.")
+}
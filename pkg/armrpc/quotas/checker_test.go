@@ -0,0 +1,216 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotas
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+)
+
+const testContainerID = resourceGroupID + "/providers/Applications.Core/containers/c0"
+
+func setupChecker(t *testing.T, quotas []*datamodel.Quota, stored []any) (*Checker, *store.MockStorageClient) {
+	mctrl := gomock.NewController(t)
+	provider := dataprovider.NewMockDataStorageProvider(mctrl)
+	client := store.NewMockStorageClient(mctrl)
+	provider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(client, nil).AnyTimes()
+
+	quotaItems := []store.Object{}
+	for _, quota := range quotas {
+		quotaItems = append(quotaItems, *testutil.MustGetStoreObject(t, quota))
+	}
+	quotaQuery := store.Query{RootScope: "/planes/radius/local", ScopeRecursive: true, ResourceType: datamodel.QuotaResourceType}
+	client.EXPECT().Query(gomock.Any(), quotaQuery, gomock.Any()).Return(&store.ObjectQueryResult{Items: quotaItems}, nil).AnyTimes()
+
+	items := []store.Object{}
+	for _, resource := range stored {
+		items = append(items, *testutil.MustGetStoreObject(t, resource))
+	}
+	resourceQuery := store.Query{RootScope: resourceGroupID}
+	client.EXPECT().Query(gomock.Any(), resourceQuery, gomock.Any()).Return(&store.ObjectQueryResult{Items: items}, nil).AnyTimes()
+
+	return NewChecker(provider), client
+}
+
+func newContainer(id string, replicas int32) map[string]any {
+	return map[string]any{
+		"id":                id,
+		"type":              containerResourceType,
+		"provisioningState": "Succeeded",
+		"properties": map[string]any{
+			"extensions": []any{
+				map[string]any{"kind": "manualScaling", "manualScaling": map[string]any{"replicas": replicas}},
+			},
+		},
+	}
+}
+
+func newRequest(t *testing.T, checker *Checker, method string, path string, body string) (context.Context, *http.Request) {
+	req, err := http.NewRequest(method, path+"?api-version=2023-10-01-preview", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	if checker != nil {
+		ctx = context.WithValue(ctx, checkerKey{}, checker)
+	}
+	return ctx, req.WithContext(ctx)
+}
+
+func Test_Checker_Usage(t *testing.T) {
+	quota := newQuota(resourceGroupID, datamodel.QuotaLimits{MaxContainers: to.Ptr(int32(5)), MaxReplicas: to.Ptr(int32(10))})
+	checker, _ := setupChecker(t, []*datamodel.Quota{quota}, []any{
+		newContainer(resourceGroupID+"/providers/Applications.Core/containers/c1", 3),
+		newContainer(resourceGroupID+"/providers/Applications.Core/containers/c2", 2),
+	})
+
+	usage, err := checker.Usage(context.Background(), quota)
+	require.NoError(t, err)
+	require.Equal(t, &datamodel.QuotaUsage{ResourceCounts: map[string]int32{}, Containers: 2, Replicas: 5}, usage)
+}
+
+func Test_Checker_Check(t *testing.T) {
+	quota := newQuota(resourceGroupID, datamodel.QuotaLimits{MaxReplicas: to.Ptr(int32(4))})
+
+	t.Run("create within quota", func(t *testing.T) {
+		checker, client := setupChecker(t, []*datamodel.Quota{quota}, []any{
+			newContainer(resourceGroupID+"/providers/Applications.Core/containers/c1", 2),
+		})
+		client.EXPECT().Get(gomock.Any(), testContainerID).Return(nil, &store.ErrNotFound{ID: testContainerID})
+
+		ctx, req := newRequest(t, checker, http.MethodPut, testContainerID, `{"properties":{"extensions":[{"kind":"manualScaling","replicas":2}]}}`)
+		violation, err := checker.Check(ctx, req, v1.ARMRequestContextFromContext(ctx).ResourceID)
+		require.NoError(t, err)
+		require.Nil(t, violation)
+
+		// The body is restored for the controller.
+		b, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(b), "manualScaling")
+	})
+
+	t.Run("create exceeds quota", func(t *testing.T) {
+		checker, client := setupChecker(t, []*datamodel.Quota{quota}, []any{
+			newContainer(resourceGroupID+"/providers/Applications.Core/containers/c1", 2),
+		})
+		client.EXPECT().Get(gomock.Any(), testContainerID).Return(nil, &store.ErrNotFound{ID: testContainerID})
+
+		ctx, req := newRequest(t, checker, http.MethodPut, testContainerID, `{"properties":{"extensions":[{"kind":"manualScaling","replicas":3}]}}`)
+		violation, err := checker.Check(ctx, req, v1.ARMRequestContextFromContext(ctx).ResourceID)
+		require.NoError(t, err)
+		require.NotNil(t, violation)
+		require.Equal(t, LimitMaxReplicas, violation.Limit)
+		require.Equal(t, int32(5), violation.Requested)
+	})
+
+	t.Run("patch without extensions keeps the replicas", func(t *testing.T) {
+		existing := newContainer(testContainerID, 3)
+		checker, client := setupChecker(t, []*datamodel.Quota{quota}, []any{
+			existing,
+			newContainer(resourceGroupID+"/providers/Applications.Core/containers/c1", 2),
+		})
+		obj := *testutil.MustGetStoreObject(t, existing)
+		client.EXPECT().Get(gomock.Any(), testContainerID).Return(&obj, nil)
+
+		ctx, req := newRequest(t, checker, http.MethodPatch, testContainerID, `{"tags":{"a":"b"}}`)
+		violation, err := checker.Check(ctx, req, v1.ARMRequestContextFromContext(ctx).ResourceID)
+		require.NoError(t, err)
+		require.Nil(t, violation)
+	})
+}
+
+func Test_Checker_CheckRecipeExecution(t *testing.T) {
+	quota := newQuota(resourceGroupID, datamodel.QuotaLimits{MaxRecipeExecutions: to.Ptr(int32(1))})
+	redis := func(name string, lastModifiedAt string) map[string]any {
+		return map[string]any{
+			"id":                resourceGroupID + "/providers/Applications.Datastores/redisCaches/" + name,
+			"type":              redisType,
+			"provisioningState": "Accepted",
+			"systemData":        map[string]any{"lastModifiedAt": lastModifiedAt},
+			"properties":        map[string]any{"recipe": map[string]any{"name": "default"}},
+		}
+	}
+	checker, _ := setupChecker(t, []*datamodel.Quota{quota}, []any{
+		redis("r0", "2023-10-01T00:00:00Z"),
+		redis("r1", "2023-10-01T00:00:01Z"),
+	})
+
+	violation, err := checker.CheckRecipeExecution(context.Background(), resourceGroupID+"/providers/Applications.Datastores/redisCaches/r0", environmentID)
+	require.NoError(t, err)
+	require.Nil(t, violation)
+
+	violation, err = checker.CheckRecipeExecution(context.Background(), resourceGroupID+"/providers/Applications.Datastores/redisCaches/r1", environmentID)
+	require.NoError(t, err)
+	require.NotNil(t, violation)
+	require.Equal(t, LimitMaxRecipeExecutions, violation.Limit)
+}
+
+func Test_CheckRequest(t *testing.T) {
+	quota := newQuota(resourceGroupID, datamodel.QuotaLimits{MaxContainers: to.Ptr(int32(1))})
+
+	t.Run("quotas are not enforced", func(t *testing.T) {
+		ctx, req := newRequest(t, nil, http.MethodPut, testContainerID, `{}`)
+		resp, err := CheckRequest(ctx, req)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("get is allowed", func(t *testing.T) {
+		checker, _ := setupChecker(t, []*datamodel.Quota{quota}, nil)
+		ctx, req := newRequest(t, checker, http.MethodGet, testContainerID, "")
+		resp, err := CheckRequest(ctx, req)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("system resources are allowed", func(t *testing.T) {
+		checker, _ := setupChecker(t, []*datamodel.Quota{quota}, nil)
+		ctx, req := newRequest(t, checker, http.MethodPut, resourceGroupID+"/providers/System.Quotas/quotas/quota1", `{}`)
+		resp, err := CheckRequest(ctx, req)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("request exceeds quota", func(t *testing.T) {
+		checker, client := setupChecker(t, []*datamodel.Quota{quota}, []any{
+			newContainer(resourceGroupID+"/providers/Applications.Core/containers/c1", 1),
+		})
+		client.EXPECT().Get(gomock.Any(), testContainerID).Return(nil, &store.ErrNotFound{ID: testContainerID})
+
+		ctx, req := newRequest(t, checker, http.MethodPut, testContainerID, `{}`)
+		resp, err := CheckRequest(ctx, req)
+		require.NoError(t, err)
+
+		conflict, ok := resp.(*rest.ConflictResponse)
+		require.True(t, ok)
+		require.Equal(t, v1.CodeQuotaExceeded, conflict.Body.Error.Code)
+		require.Equal(t, testContainerID, conflict.Body.Error.Target)
+	})
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotas
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	applicationResourceType = "Applications.Core/applications"
+	environmentResourceType = "Applications.Core/environments"
+	containerResourceType   = "Applications.Core/containers"
+
+	// LimitMaxRecipeExecutions is the name of the limit on the number of recipe executions in flight.
+	LimitMaxRecipeExecutions = "maxRecipeExecutions"
+
+	// LimitMaxContainers is the name of the limit on the number of containers.
+	LimitMaxContainers = "maxContainers"
+
+	// LimitMaxReplicas is the name of the limit on the number of replicas of all the containers.
+	LimitMaxReplicas = "maxReplicas"
+)
+
+// Resource is a resource that counts against the quotas of its resource group and environment.
+type Resource struct {
+	// ID is the ID of the resource.
+	ID string
+
+	// Type is the fully-qualified resource type of the resource.
+	Type string
+
+	// Environment is the ID of the environment that the resource belongs to, directly or through its application.
+	Environment string
+
+	// Replicas is the number of replicas of a container. It is zero for other resource types.
+	Replicas int32
+
+	// RecipeInFlight is true if the recipe of the resource has been requested to run and has not finished.
+	RecipeInFlight bool
+
+	// LastModifiedAt is the time when the resource was last modified.
+	LastModifiedAt string
+}
+
+// Change is a change to a resource that may exceed a quota.
+type Change struct {
+	// Resource is the resource after the change.
+	Resource Resource
+
+	// Previous is the resource before the change, or nil if the change adds the resource to the scope of the quota.
+	Previous *Resource
+}
+
+// Violation describes a limit of a quota that an operation exceeds.
+type Violation struct {
+	// Quota is the quota that is exceeded.
+	Quota *datamodel.Quota
+
+	// Limit is the name of the limit that is exceeded: a resource type, or one of maxRecipeExecutions, maxContainers
+	// and maxReplicas.
+	Limit string
+
+	// Max is the value of the limit.
+	Max int32
+
+	// Requested is the usage of the limit that the operation requires.
+	Requested int32
+}
+
+// Message returns the description of the violation for the target of the operation.
+func (v *Violation) Message(target string) string {
+	limit := v.Limit
+	if strings.Contains(limit, "/") {
+		limit = fmt.Sprintf("the number of '%s' resources", limit)
+	}
+	return fmt.Sprintf("The operation on '%s' exceeds the quota '%s' on scope '%s': %s is limited to %d, but %d is required.", target, v.Quota.ID, v.Quota.Properties.Scope, limit, v.Max, v.Requested)
+}
+
+// IsResourceGroup returns true if the ID refers to a resource group.
+func IsResourceGroup(id resources.ID) bool {
+	segments := id.ScopeSegments()
+	return id.IsScope() && len(segments) > 0 && strings.EqualFold(segments[len(segments)-1].Type, "resourceGroups")
+}
+
+// IsEnvironment returns true if the ID refers to an environment.
+func IsEnvironment(id resources.ID) bool {
+	return id.IsResource() && strings.EqualFold(id.Type(), environmentResourceType)
+}
+
+// AppliesTo returns true if the quota applies to the resource: the resource is in the resource group of the quota or
+// belongs to the environment of the quota.
+func AppliesTo(quota *datamodel.Quota, resource Resource) bool {
+	scope := normalize(quota.Properties.Scope)
+	if scope == "" {
+		return false
+	}
+	if scope == normalize(resource.Environment) {
+		return true
+	}
+	return strings.HasPrefix(normalize(resource.ID), scope+"/providers/")
+}
+
+// ResourceCountLimit returns the limit on the number of resources of the resource type. Resource types are compared
+// case-insensitively.
+func ResourceCountLimit(limits datamodel.QuotaLimits, resourceType string) (int32, bool) {
+	for t, limit := range limits.ResourceCounts {
+		if strings.EqualFold(t, resourceType) {
+			return limit, true
+		}
+	}
+	return 0, false
+}
+
+// ComputeUsage returns the usage of the limits of the quota by the resources. Resources that the quota does not apply
+// to are ignored.
+func ComputeUsage(quota *datamodel.Quota, resources []Resource) *datamodel.QuotaUsage {
+	usage := &datamodel.QuotaUsage{ResourceCounts: map[string]int32{}}
+	for resourceType := range quota.Properties.Limits.ResourceCounts {
+		usage.ResourceCounts[resourceType] = 0
+	}
+
+	for _, resource := range resources {
+		if !AppliesTo(quota, resource) {
+			continue
+		}
+		for resourceType := range usage.ResourceCounts {
+			if strings.EqualFold(resourceType, resource.Type) {
+				usage.ResourceCounts[resourceType]++
+			}
+		}
+		if strings.EqualFold(resource.Type, containerResourceType) {
+			usage.Containers++
+			usage.Replicas += resource.Replicas
+		}
+		if resource.RecipeInFlight {
+			usage.RecipeExecutions++
+		}
+	}
+
+	return usage
+}
+
+// CheckChange returns the violation if the change exceeds a limit of the quota given its current usage, or nil if the
+// change is allowed. Changes that reduce the usage are always allowed.
+func CheckChange(quota *datamodel.Quota, usage *datamodel.QuotaUsage, change Change) *Violation {
+	limits := quota.Properties.Limits
+	resource := change.Resource
+	isContainer := strings.EqualFold(resource.Type, containerResourceType)
+
+	if change.Previous == nil {
+		if limit, ok := ResourceCountLimit(limits, resource.Type); ok {
+			current, _ := ResourceCountLimit(datamodel.QuotaLimits{ResourceCounts: usage.ResourceCounts}, resource.Type)
+			if current+1 > limit {
+				return &Violation{Quota: quota, Limit: resource.Type, Max: limit, Requested: current + 1}
+			}
+		}
+		if isContainer && limits.MaxContainers != nil && usage.Containers+1 > *limits.MaxContainers {
+			return &Violation{Quota: quota, Limit: LimitMaxContainers, Max: *limits.MaxContainers, Requested: usage.Containers + 1}
+		}
+	}
+
+	if isContainer && limits.MaxReplicas != nil {
+		previous := int32(0)
+		if change.Previous != nil {
+			previous = change.Previous.Replicas
+		}
+		requested := usage.Replicas - previous + resource.Replicas
+		if resource.Replicas > previous && requested > *limits.MaxReplicas {
+			return &Violation{Quota: quota, Limit: LimitMaxReplicas, Max: *limits.MaxReplicas, Requested: requested}
+		}
+	}
+
+	return nil
+}
+
+// CheckRecipeExecution returns the violation if running the recipe of the resource exceeds the limit of the quota on
+// recipe executions in flight, or nil if the recipe can run.
+//
+// The resource itself is in flight while its recipe runs, so only the other resources in flight are counted. Recipe
+// executions are admitted in the order that they were requested, so that the executions requested first are not
+// rejected because of executions requested later.
+func CheckRecipeExecution(quota *datamodel.Quota, resources []Resource, resourceID string) *Violation {
+	limit := quota.Properties.Limits.MaxRecipeExecutions
+	if limit == nil {
+		return nil
+	}
+
+	inFlight := []Resource{}
+	var self *Resource
+	for i := range resources {
+		resource := resources[i]
+		if !AppliesTo(quota, resource) {
+			continue
+		}
+		if normalize(resource.ID) == normalize(resourceID) {
+			self = &resource
+			continue
+		}
+		if resource.RecipeInFlight {
+			inFlight = append(inFlight, resource)
+		}
+	}
+
+	ahead := int32(0)
+	for _, resource := range inFlight {
+		if self == nil || requestedBefore(resource, *self) {
+			ahead++
+		}
+	}
+
+	if ahead >= *limit {
+		return &Violation{Quota: quota, Limit: LimitMaxRecipeExecutions, Max: *limit, Requested: ahead + 1}
+	}
+	return nil
+}
+
+// requestedBefore returns true if the change to a was requested before the change to b. Resources modified at the
+// same time are ordered by ID.
+func requestedBefore(a Resource, b Resource) bool {
+	at, _ := time.Parse(time.RFC3339Nano, a.LastModifiedAt)
+	bt, _ := time.Parse(time.RFC3339Nano, b.LastModifiedAt)
+	if !at.Equal(bt) {
+		return at.Before(bt)
+	}
+	return normalize(a.ID) < normalize(b.ID)
+}
+
+func normalize(id string) string {
+	return strings.TrimSuffix(strings.ToLower(id), "/")
+}
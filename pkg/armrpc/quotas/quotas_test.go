@@ -0,0 +1,261 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	resourceGroupID = "/planes/radius/local/resourceGroups/rg"
+	environmentID   = "/planes/radius/local/resourceGroups/env-rg/providers/Applications.Core/environments/env"
+	redisType       = "Applications.Datastores/redisCaches"
+)
+
+func newQuota(scope string, limits datamodel.QuotaLimits) *datamodel.Quota {
+	quota := &datamodel.Quota{Properties: datamodel.QuotaProperties{Scope: scope, Limits: limits}}
+	quota.ID = resourceGroupID + "/providers/System.Quotas/quotas/quota0"
+	return quota
+}
+
+func Test_IsResourceGroup(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected bool
+	}{
+		{"/planes/radius/local/resourceGroups/rg", true},
+		{"/planes/radius/local/resourcegroups/rg", true},
+		{"/planes/radius/local", false},
+		{environmentID, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			id, err := resources.Parse(tt.id)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, IsResourceGroup(id))
+		})
+	}
+}
+
+func Test_AppliesTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		scope    string
+		resource Resource
+		expected bool
+	}{
+		{
+			name:     "resource in resource group",
+			scope:    resourceGroupID,
+			resource: Resource{ID: "/planes/radius/local/resourcegroups/RG/providers/Applications.Core/containers/c"},
+			expected: true,
+		},
+		{
+			name:     "resource in another resource group",
+			scope:    resourceGroupID,
+			resource: Resource{ID: "/planes/radius/local/resourceGroups/rg2/providers/Applications.Core/containers/c"},
+			expected: false,
+		},
+		{
+			name:     "resource in environment",
+			scope:    environmentID,
+			resource: Resource{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/c", Environment: environmentID},
+			expected: true,
+		},
+		{
+			name:     "resource in another environment",
+			scope:    environmentID,
+			resource: Resource{ID: "/planes/radius/local/resourceGroups/env-rg/providers/Applications.Core/containers/c", Environment: environmentID + "2"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, AppliesTo(newQuota(tt.scope, datamodel.QuotaLimits{}), tt.resource))
+		})
+	}
+}
+
+func Test_ComputeUsage(t *testing.T) {
+	quota := newQuota(environmentID, datamodel.QuotaLimits{ResourceCounts: map[string]int32{redisType: 5, "Applications.Dapr/stateStores": 5}})
+	all := []Resource{
+		{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Datastores/redisCaches/r0", Type: redisType, Environment: environmentID, RecipeInFlight: true},
+		{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Datastores/redisCaches/r1", Type: "applications.datastores/rediscaches", Environment: environmentID},
+		{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Datastores/redisCaches/r2", Type: redisType, Environment: "other"},
+		{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/c0", Type: containerResourceType, Environment: environmentID, Replicas: 3},
+		{ID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/c1", Type: containerResourceType, Environment: environmentID, Replicas: 1},
+	}
+
+	usage := ComputeUsage(quota, all)
+	require.Equal(t, &datamodel.QuotaUsage{
+		ResourceCounts:   map[string]int32{redisType: 2, "Applications.Dapr/stateStores": 0},
+		RecipeExecutions: 1,
+		Containers:       2,
+		Replicas:         4,
+	}, usage)
+}
+
+func Test_CheckChange(t *testing.T) {
+	container := Resource{ID: resourceGroupID + "/providers/Applications.Core/containers/c", Type: containerResourceType, Replicas: 2}
+	redis := Resource{ID: resourceGroupID + "/providers/Applications.Datastores/redisCaches/r", Type: redisType}
+
+	tests := []struct {
+		name     string
+		limits   datamodel.QuotaLimits
+		usage    datamodel.QuotaUsage
+		change   Change
+		expected *Violation
+	}{
+		{
+			name:   "create within resource count",
+			limits: datamodel.QuotaLimits{ResourceCounts: map[string]int32{redisType: 2}},
+			usage:  datamodel.QuotaUsage{ResourceCounts: map[string]int32{redisType: 1}},
+			change: Change{Resource: redis},
+		},
+		{
+			name:     "create exceeds resource count",
+			limits:   datamodel.QuotaLimits{ResourceCounts: map[string]int32{redisType: 2}},
+			usage:    datamodel.QuotaUsage{ResourceCounts: map[string]int32{redisType: 2}},
+			change:   Change{Resource: redis},
+			expected: &Violation{Limit: redisType, Max: 2, Requested: 3},
+		},
+		{
+			name:   "update does not count",
+			limits: datamodel.QuotaLimits{ResourceCounts: map[string]int32{redisType: 2}},
+			usage:  datamodel.QuotaUsage{ResourceCounts: map[string]int32{redisType: 2}},
+			change: Change{Resource: redis, Previous: &redis},
+		},
+		{
+			name:     "create exceeds containers",
+			limits:   datamodel.QuotaLimits{MaxContainers: to.Ptr(int32(1))},
+			usage:    datamodel.QuotaUsage{Containers: 1, Replicas: 1},
+			change:   Change{Resource: container},
+			expected: &Violation{Limit: LimitMaxContainers, Max: 1, Requested: 2},
+		},
+		{
+			name:     "create exceeds replicas",
+			limits:   datamodel.QuotaLimits{MaxReplicas: to.Ptr(int32(4))},
+			usage:    datamodel.QuotaUsage{Containers: 1, Replicas: 3},
+			change:   Change{Resource: container},
+			expected: &Violation{Limit: LimitMaxReplicas, Max: 4, Requested: 5},
+		},
+		{
+			name:   "scale up within replicas",
+			limits: datamodel.QuotaLimits{MaxReplicas: to.Ptr(int32(4))},
+			usage:  datamodel.QuotaUsage{Containers: 2, Replicas: 3},
+			change: Change{Resource: container, Previous: &Resource{Type: containerResourceType, Replicas: 1}},
+		},
+		{
+			name:     "scale up exceeds replicas",
+			limits:   datamodel.QuotaLimits{MaxReplicas: to.Ptr(int32(4))},
+			usage:    datamodel.QuotaUsage{Containers: 2, Replicas: 4},
+			change:   Change{Resource: container, Previous: &Resource{Type: containerResourceType, Replicas: 1}},
+			expected: &Violation{Limit: LimitMaxReplicas, Max: 4, Requested: 5},
+		},
+		{
+			name:   "scale down is allowed over the limit",
+			limits: datamodel.QuotaLimits{MaxReplicas: to.Ptr(int32(4))},
+			usage:  datamodel.QuotaUsage{Containers: 2, Replicas: 8},
+			change: Change{Resource: container, Previous: &Resource{Type: containerResourceType, Replicas: 5}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quota := newQuota(resourceGroupID, tt.limits)
+			violation := CheckChange(quota, &tt.usage, tt.change)
+			if tt.expected == nil {
+				require.Nil(t, violation)
+				return
+			}
+
+			tt.expected.Quota = quota
+			require.Equal(t, tt.expected, violation)
+		})
+	}
+}
+
+func Test_CheckRecipeExecution(t *testing.T) {
+	quota := newQuota(resourceGroupID, datamodel.QuotaLimits{MaxRecipeExecutions: to.Ptr(int32(2))})
+	resource := func(name string, lastModifiedAt string, inFlight bool) Resource {
+		return Resource{
+			ID:             resourceGroupID + "/providers/Applications.Datastores/redisCaches/" + name,
+			Type:           redisType,
+			RecipeInFlight: inFlight,
+			LastModifiedAt: lastModifiedAt,
+		}
+	}
+	all := []Resource{
+		resource("r0", "2023-10-01T00:00:00Z", true),
+		resource("r1", "2023-10-01T00:00:01Z", true),
+		resource("r2", "2023-10-01T00:00:02Z", true),
+		resource("r3", "2023-10-01T00:00:00.5Z", false),
+	}
+
+	tests := []struct {
+		name     string
+		id       string
+		expected *Violation
+	}{
+		{
+			name: "first request",
+			id:   all[0].ID,
+		},
+		{
+			name: "second request",
+			id:   all[1].ID,
+		},
+		{
+			name:     "third request",
+			id:       all[2].ID,
+			expected: &Violation{Quota: quota, Limit: LimitMaxRecipeExecutions, Max: 2, Requested: 3},
+		},
+		{
+			name:     "resource that is not stored",
+			id:       resourceGroupID + "/providers/Applications.Datastores/redisCaches/unknown",
+			expected: &Violation{Quota: quota, Limit: LimitMaxRecipeExecutions, Max: 2, Requested: 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, CheckRecipeExecution(quota, all, tt.id))
+		})
+	}
+
+	t.Run("no limit", func(t *testing.T) {
+		require.Nil(t, CheckRecipeExecution(newQuota(resourceGroupID, datamodel.QuotaLimits{}), all, all[2].ID))
+	})
+}
+
+func Test_Violation_Message(t *testing.T) {
+	quota := newQuota(resourceGroupID, datamodel.QuotaLimits{})
+
+	violation := &Violation{Quota: quota, Limit: redisType, Max: 2, Requested: 3}
+	require.Equal(t, "The operation on 'target' exceeds the quota '/planes/radius/local/resourceGroups/rg/providers/System.Quotas/quotas/quota0' on scope '/planes/radius/local/resourceGroups/rg': the number of 'Applications.Datastores/redisCaches' resources is limited to 2, but 3 is required.", violation.Message("target"))
+
+	violation = &Violation{Quota: quota, Limit: LimitMaxReplicas, Max: 2, Requested: 3}
+	require.Equal(t, "The operation on 'target' exceeds the quota '/planes/radius/local/resourceGroups/rg/providers/System.Quotas/quotas/quota0' on scope '/planes/radius/local/resourceGroups/rg': maxReplicas is limited to 2, but 3 is required.", violation.Message("target"))
+}
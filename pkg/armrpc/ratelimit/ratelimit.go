@@ -0,0 +1,297 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit limits the rate of the requests of each client with token buckets.
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// OperationClassRead is the operation class of the requests that read resources.
+	OperationClassRead = "read"
+
+	// OperationClassWrite is the operation class of the requests that create, update or delete resources or invoke
+	// actions.
+	OperationClassWrite = "write"
+
+	// OperationClassAsyncStatus is the operation class of the requests that poll the status or the result of an
+	// asynchronous operation.
+	OperationClassAsyncStatus = "asyncStatus"
+
+	// bucketIdleTimeout is the time after which the bucket of a client that sent no requests is removed.
+	bucketIdleTimeout = 10 * time.Minute
+)
+
+// Options represents the rate limiting configuration. Every client has a token bucket for each operation
+// class, so that a client polling operation statuses cannot starve other clients or its own writes. Requests of the
+// operation classes without a limit are not limited.
+type Options struct {
+	// Read limits the requests that read resources.
+	Read *TokenBucketOptions `yaml:"read,omitempty"`
+
+	// Write limits the requests that create, update or delete resources or invoke actions.
+	Write *TokenBucketOptions `yaml:"write,omitempty"`
+
+	// AsyncStatus limits the requests that poll operationStatuses and operationResults.
+	AsyncStatus *TokenBucketOptions `yaml:"asyncStatus,omitempty"`
+
+	// Planes limits the requests to the resources of the planes of a type, keyed by plane type, e.g. 'azure' or 'aws'.
+	// All the requests of a client to the planes of the type share a single bucket instead of the buckets of the
+	// operation classes, which bounds the requests that a client can proxy to a cloud API.
+	Planes map[string]*TokenBucketOptions `yaml:"planes,omitempty"`
+}
+
+// TokenBucketOptions represents the configuration of a token bucket.
+type TokenBucketOptions struct {
+	// RequestsPerSecond is the rate at which the bucket is refilled.
+	RequestsPerSecond float64 `yaml:"requestsPerSecond"`
+
+	// Burst is the size of the bucket, which is the number of requests that are allowed at once. Defaults to
+	// RequestsPerSecond rounded up.
+	Burst int `yaml:"burst,omitempty"`
+}
+
+// Throttle describes a request that exceeds its rate limit.
+type Throttle struct {
+	// OperationClass is the operation class of the request.
+	OperationClass string
+
+	// PlaneType is the plane type of the request if it is limited by the limit of the plane type.
+	PlaneType string
+
+	// RetryAfter is the time after which the client can retry the request.
+	RetryAfter time.Duration
+}
+
+// Limiter limits the rate of the requests of each client with token buckets. Clients are identified by the
+// authenticated principal, or by IP address when the request is not authenticated.
+type Limiter struct {
+	pathBase string
+	classes  map[string]*TokenBucketOptions
+	planes   map[string]*TokenBucketOptions
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// NewLimiter creates a Limiter for the requests under the path base. It returns nil if options is nil and an
+// error if a limit is invalid.
+func NewLimiter(pathBase string, options *Options) (*Limiter, error) {
+	if options == nil {
+		return nil, nil
+	}
+
+	l := &Limiter{
+		pathBase: strings.ToLower(pathBase),
+		classes: map[string]*TokenBucketOptions{
+			OperationClassRead:        options.Read,
+			OperationClassWrite:       options.Write,
+			OperationClassAsyncStatus: options.AsyncStatus,
+		},
+		planes:  map[string]*TokenBucketOptions{},
+		now:     time.Now,
+		buckets: map[string]*bucket{},
+	}
+
+	for class, limit := range l.classes {
+		if err := validateTokenBucket(limit); err != nil {
+			return nil, fmt.Errorf("invalid rate limit for %s operations: %w", class, err)
+		}
+	}
+
+	for planeType, limit := range options.Planes {
+		if err := validateTokenBucket(limit); err != nil {
+			return nil, fmt.Errorf("invalid rate limit for plane type %q: %w", planeType, err)
+		}
+		l.planes[strings.ToLower(planeType)] = limit
+	}
+
+	return l, nil
+}
+
+func validateTokenBucket(limit *TokenBucketOptions) error {
+	if limit == nil {
+		return nil
+	}
+	if limit.RequestsPerSecond <= 0 {
+		return fmt.Errorf("requestsPerSecond must be greater than zero")
+	}
+	if limit.Burst < 0 {
+		return fmt.Errorf("burst must not be negative")
+	}
+	return nil
+}
+
+// Reserve takes a token from the bucket of the client and operation class of the request. It returns nil if the
+// request is allowed and the throttle of the request otherwise.
+func (l *Limiter) Reserve(r *http.Request) *Throttle {
+	class, planeType, limit := l.classify(r)
+	if limit == nil {
+		return nil
+	}
+
+	key := class
+	if planeType != "" {
+		key = "planes/" + planeType
+	}
+	key += "|" + clientIdentity(r)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		burst := limit.Burst
+		if burst == 0 {
+			burst = int(math.Ceil(limit.RequestsPerSecond))
+		}
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)}
+		l.buckets[key] = b
+	}
+	b.lastUsed = now
+
+	reservation := b.limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return nil
+	}
+
+	// The request is rejected, so the token is given back.
+	reservation.CancelAt(now)
+	return &Throttle{OperationClass: class, PlaneType: planeType, RetryAfter: delay}
+}
+
+// classify returns the operation class of the request, the plane type if the request is limited by the limit of the
+// plane type, and the limit of the request. The limit is nil if the request is not limited.
+func (l *Limiter) classify(r *http.Request) (string, string, *TokenBucketOptions) {
+	path := strings.ToLower(r.URL.Path)
+	if l.pathBase != "" {
+		if !strings.HasPrefix(path, l.pathBase) {
+			return "", "", nil
+		}
+		path = path[len(l.pathBase):]
+	}
+
+	// Only the requests to resources are limited, so that health probes and discovery endpoints are always served.
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if segments[0] != "planes" && segments[0] != "subscriptions" {
+		return "", "", nil
+	}
+
+	class := OperationClassWrite
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		class = OperationClassRead
+		for _, segment := range segments {
+			if segment == "operationstatuses" || segment == "operationresults" {
+				class = OperationClassAsyncStatus
+				break
+			}
+		}
+	}
+
+	// The requests to the resources of a plane, e.g. /planes/azure/azurecloud/subscriptions/..., are proxied to
+	// the cloud API of the plane.
+	if segments[0] == "planes" && len(segments) > 3 {
+		if limit, ok := l.planes[segments[1]]; ok && limit != nil {
+			return class, segments[1], limit
+		}
+	}
+
+	return class, "", l.classes[class]
+}
+
+// sweep removes the buckets of the clients that sent no requests for bucketIdleTimeout. It must be called with the
+// lock held.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < bucketIdleTimeout {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastUsed) >= bucketIdleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// clientIdentity returns the identity of the client of the request: the name of the authenticated principal, or the
+// IP address of the client if the request is not authenticated.
+func clientIdentity(r *http.Request) string {
+	if principal := authentication.PrincipalFromContext(r.Context()); principal != nil && !principal.IsAnonymous() {
+		return "principal:" + principal.Name
+	}
+	return "ip:" + middleware.ClientAddress(r)
+}
+
+// WithLimiter is the middleware that rejects the requests that exceed the rate limits of the limiter with 429 Too Many
+// Requests. It must run after authentication so that clients are identified by principal.
+func WithLimiter(limiter *Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			throttle := limiter.Reserve(r)
+			if throttle == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			metrics.DefaultRateLimitMetrics.RecordThrottledRequest(ctx, throttle.OperationClass, throttle.PlaneType)
+
+			logger := ucplog.FromContextOrDiscard(ctx)
+			logger.Info("request is throttled", "operationClass", throttle.OperationClass, "planeType", throttle.PlaneType, "retryAfter", throttle.RetryAfter.String())
+
+			resp := rest.NewTooManyRequestsResponse(throttleMessage(throttle), throttle.RetryAfter)
+			_ = resp.Apply(ctx, w, r)
+		})
+	}
+}
+
+func throttleMessage(throttle *Throttle) string {
+	target := fmt.Sprintf("%s operations", throttle.OperationClass)
+	if throttle.PlaneType != "" {
+		target = fmt.Sprintf("requests to '%s' planes", throttle.PlaneType)
+	}
+	return fmt.Sprintf("The number of %s of the client exceeds the rate limit. Retry after %d seconds.", target, rest.RetryAfterSeconds(throttle.RetryAfter))
+}
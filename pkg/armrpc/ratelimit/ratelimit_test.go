@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/middleware"
+)
+
+const (
+	testPathBase   = "/apis/api.ucp.dev/v1alpha3"
+	testResourceID = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env0"
+)
+
+func newTestLimiter(t *testing.T, options *Options) (*Limiter, *time.Time) {
+	limiter, err := NewLimiter(testPathBase, options)
+	require.NoError(t, err)
+
+	now := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func newTestRequest(method string, path string, remoteAddr string) *http.Request {
+	req := httptest.NewRequest(method, testPathBase+path, nil)
+	req.RemoteAddr = remoteAddr
+	return req
+}
+
+func TestNewLimiter(t *testing.T) {
+	limiter, err := NewLimiter(testPathBase, nil)
+	require.NoError(t, err)
+	require.Nil(t, limiter)
+
+	_, err = NewLimiter(testPathBase, &Options{Write: &TokenBucketOptions{RequestsPerSecond: 0}})
+	require.EqualError(t, err, "invalid rate limit for write operations: requestsPerSecond must be greater than zero")
+
+	_, err = NewLimiter(testPathBase, &Options{Planes: map[string]*TokenBucketOptions{"azure": {RequestsPerSecond: 1, Burst: -1}}})
+	require.EqualError(t, err, "invalid rate limit for plane type \"azure\": burst must not be negative")
+}
+
+func TestLimiter_Reserve(t *testing.T) {
+	limiter, now := newTestLimiter(t, &Options{
+		Read:        &TokenBucketOptions{RequestsPerSecond: 1, Burst: 2},
+		AsyncStatus: &TokenBucketOptions{RequestsPerSecond: 0.5},
+	})
+
+	read := newTestRequest(http.MethodGet, testResourceID, "10.0.0.1:1234")
+	require.Nil(t, limiter.Reserve(read))
+	require.Nil(t, limiter.Reserve(read))
+	require.Equal(t, &Throttle{OperationClass: OperationClassRead, RetryAfter: time.Second}, limiter.Reserve(read))
+
+	// Other clients have their own buckets.
+	require.Nil(t, limiter.Reserve(newTestRequest(http.MethodGet, testResourceID, "10.0.0.2:1234")))
+
+	// The bucket is refilled over time.
+	*now = now.Add(time.Second)
+	require.Nil(t, limiter.Reserve(read))
+
+	// Polling operation statuses does not consume the tokens of reads.
+	status := newTestRequest(http.MethodGet, "/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/op0", "10.0.0.1:1234")
+	require.Nil(t, limiter.Reserve(status))
+	require.Equal(t, &Throttle{OperationClass: OperationClassAsyncStatus, RetryAfter: 2 * time.Second}, limiter.Reserve(status))
+
+	// Writes are not limited.
+	for i := 0; i < 10; i++ {
+		require.Nil(t, limiter.Reserve(newTestRequest(http.MethodPut, testResourceID, "10.0.0.1:1234")))
+	}
+}
+
+func TestLimiter_Reserve_Principal(t *testing.T) {
+	limiter, _ := newTestLimiter(t, &Options{Write: &TokenBucketOptions{RequestsPerSecond: 1}})
+
+	newRequest := func(name string, remoteAddr string) *http.Request {
+		req := newTestRequest(http.MethodDelete, testResourceID, remoteAddr)
+		return req.WithContext(authentication.WithPrincipal(req.Context(), &authentication.Principal{Name: name}))
+	}
+
+	// Authenticated clients are identified by principal regardless of their address.
+	require.Nil(t, limiter.Reserve(newRequest("alice", "10.0.0.1:1234")))
+	require.NotNil(t, limiter.Reserve(newRequest("alice", "10.0.0.2:1234")))
+	require.Nil(t, limiter.Reserve(newRequest("bob", "10.0.0.1:1234")))
+
+	// Anonymous clients are identified by address.
+	require.Nil(t, limiter.Reserve(newRequest(authentication.AnonymousName, "10.0.0.3:1234")))
+	require.Nil(t, limiter.Reserve(newRequest(authentication.AnonymousName, "10.0.0.4:1234")))
+	require.NotNil(t, limiter.Reserve(newRequest(authentication.AnonymousName, "10.0.0.4:1234")))
+}
+
+func TestLimiter_Reserve_Planes(t *testing.T) {
+	limiter, _ := newTestLimiter(t, &Options{
+		Read:   &TokenBucketOptions{RequestsPerSecond: 100},
+		Planes: map[string]*TokenBucketOptions{"Azure": {RequestsPerSecond: 1}},
+	})
+
+	// Reads and writes proxied to Azure share the bucket of the plane type.
+	proxied := "/planes/azure/azurecloud/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa"
+	require.Nil(t, limiter.Reserve(newTestRequest(http.MethodGet, proxied, "10.0.0.1:1234")))
+	require.Equal(t, &Throttle{OperationClass: OperationClassWrite, PlaneType: "azure", RetryAfter: time.Second}, limiter.Reserve(newTestRequest(http.MethodPut, proxied, "10.0.0.1:1234")))
+
+	// The plane resource itself is limited by the limit of its operation class.
+	require.Nil(t, limiter.Reserve(newTestRequest(http.MethodGet, "/planes/azure/azurecloud", "10.0.0.1:1234")))
+
+	// Planes of other types are limited by the limits of the operation classes.
+	require.Nil(t, limiter.Reserve(newTestRequest(http.MethodGet, "/planes/aws/aws/accounts/0/regions/us-west-2/providers/AWS.S3/Bucket/b", "10.0.0.1:1234")))
+}
+
+func TestLimiter_Reserve_NotLimited(t *testing.T) {
+	limiter, _ := newTestLimiter(t, &Options{Read: &TokenBucketOptions{RequestsPerSecond: 1}})
+
+	for _, path := range []string{"/healthz", testPathBase + "/openapi", "/other" + testResourceID} {
+		for i := 0; i < 3; i++ {
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			require.Nil(t, limiter.Reserve(req), path)
+		}
+	}
+}
+
+func TestLimiter_Sweep(t *testing.T) {
+	limiter, now := newTestLimiter(t, &Options{Read: &TokenBucketOptions{RequestsPerSecond: 1}})
+
+	require.Nil(t, limiter.Reserve(newTestRequest(http.MethodGet, testResourceID, "10.0.0.1:1234")))
+	require.Len(t, limiter.buckets, 1)
+
+	*now = now.Add(bucketIdleTimeout)
+	require.Nil(t, limiter.Reserve(newTestRequest(http.MethodGet, testResourceID, "10.0.0.2:1234")))
+	require.Len(t, limiter.buckets, 1)
+}
+
+func TestWithLimiter(t *testing.T) {
+	limiter, _ := newTestLimiter(t, &Options{Write: &TokenBucketOptions{RequestsPerSecond: 0.25}})
+
+	handler := middleware.RemoveRemoteAddr(WithLimiter(limiter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newTestRequest(http.MethodPut, testResourceID, "10.0.0.1:1234"))
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newTestRequest(http.MethodPut, testResourceID, "10.0.0.1:1234"))
+	require.Equal(t, http.StatusTooManyRequests, w.Result().StatusCode)
+	require.Equal(t, "4", w.Header().Get("Retry-After"))
+
+	body := v1.ErrorResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, v1.CodeTooManyRequests, body.Error.Code)
+	require.Equal(t, "The number of write operations of the client exceeds the rate limit. Retry after 4 seconds.", body.Error.Message)
+
+	// The address removed by RemoveRemoteAddr still identifies the client.
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newTestRequest(http.MethodPut, testResourceID, "10.0.0.2:1234"))
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+}
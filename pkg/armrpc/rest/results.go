@@ -20,9 +20,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 	"net/textproto"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -714,6 +716,43 @@ func (r *ClientAuthenticationFailed) Apply(ctx context.Context, w http.ResponseW
 	return nil
 }
 
+// ForbiddenResponse represents an HTTP 403 with an ARM error payload.
+type ForbiddenResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewForbiddenResponse creates a ForbiddenResponse with CodeAuthorizationFailed code and the given message.
+func NewForbiddenResponse(message string) Response {
+	return &ForbiddenResponse{
+		Body: v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeAuthorizationFailed,
+				Message: message,
+			},
+		},
+	}
+}
+
+// Apply renders 403 Forbidden HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *ForbiddenResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusForbidden), logging.LogHTTPStatusCode, http.StatusForbidden)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
 // AsyncOperationResultResponse
 type AsyncOperationResultResponse struct {
 	Headers map[string]string
@@ -782,3 +821,55 @@ func (r *MethodNotAllowedResponse) Apply(ctx context.Context, w http.ResponseWri
 
 	return nil
 }
+
+// TooManyRequestsResponse represents an HTTP 429 with an ARM error payload and a Retry-After header.
+type TooManyRequestsResponse struct {
+	Body       v1.ErrorResponse
+	RetryAfter time.Duration
+}
+
+// NewTooManyRequestsResponse creates a TooManyRequestsResponse with CodeTooManyRequests code and the given message for
+// a request that exceeds a rate limit. The client can retry the request after retryAfter.
+func NewTooManyRequestsResponse(message string, retryAfter time.Duration) Response {
+	return &TooManyRequestsResponse{
+		Body: v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeTooManyRequests,
+				Message: message,
+			},
+		},
+		RetryAfter: retryAfter,
+	}
+}
+
+// Apply renders 429 TooManyRequests HTTP response into http.ResponseWriter by setting Content-Type and Retry-After
+// headers and serializing response.
+func (r *TooManyRequestsResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusTooManyRequests), logging.LogHTTPStatusCode, http.StatusTooManyRequests)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.Header().Add("Retry-After", strconv.Itoa(RetryAfterSeconds(r.RetryAfter)))
+	w.WriteHeader(http.StatusTooManyRequests)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
+// RetryAfterSeconds returns the value of the Retry-After header for the duration: the number of seconds rounded up,
+// and at least one second.
+func RetryAfterSeconds(d time.Duration) int {
+	seconds := int(math.Ceil(d.Seconds()))
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
@@ -147,6 +147,40 @@ func Test_OKResponse_WithBody(t *testing.T) {
 	require.Equal(t, payload, body)
 }
 
+func Test_TooManyRequestsResponse(t *testing.T) {
+	tests := []struct {
+		retryAfter time.Duration
+		expected   string
+	}{
+		{0, "1"},
+		{300 * time.Millisecond, "1"},
+		{time.Second, "1"},
+		{1500 * time.Millisecond, "2"},
+		{time.Minute, "60"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.retryAfter.String(), func(t *testing.T) {
+			response := NewTooManyRequestsResponse("slow down", tt.retryAfter)
+
+			req := httptest.NewRequest("GET", "http://example.com", nil)
+			w := httptest.NewRecorder()
+
+			err := response.Apply(context.TODO(), w, req)
+			require.NoError(t, err)
+
+			require.Equal(t, http.StatusTooManyRequests, w.Code)
+			require.Equal(t, []string{"application/json"}, w.Header()["Content-Type"])
+			require.Equal(t, tt.expected, w.Header().Get("Retry-After"))
+
+			body := v1.ErrorResponse{}
+			err = json.Unmarshal(w.Body.Bytes(), &body)
+			require.NoError(t, err)
+			require.Equal(t, v1.ErrorDetails{Code: v1.CodeTooManyRequests, Message: "slow down"}, body.Error)
+		})
+	}
+}
+
 func TestGetAsyncLocationPath(t *testing.T) {
 	operationID := uuid.New()
 
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpctest
+
+import (
+	"encoding/json"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/armrpc/openapi"
+)
+
+var specParameterPattern = regexp.MustCompile(`\{[^}]+\}`)
+
+type swaggerSpec struct {
+	Paths       map[string]map[string]json.RawMessage `json:"paths"`
+	Definitions map[string]swaggerDefinition          `json:"definitions"`
+}
+
+type swaggerDefinition struct {
+	Ref        string                       `json:"$ref"`
+	AllOf      []swaggerDefinition          `json:"allOf"`
+	Properties map[string]swaggerDefinition `json:"properties"`
+}
+
+type swaggerOperation struct {
+	Parameters []struct {
+		In     string            `json:"in"`
+		Schema swaggerDefinition `json:"schema"`
+	} `json:"parameters"`
+}
+
+// AssertOpenAPIDocument asserts that the generated OpenAPI document has the same operations as the checked-in OpenAPI
+// v2 spec file and that the resources of both have the same properties. Paths are compared case-insensitively and
+// regardless of the names of their parameters.
+func AssertOpenAPIDocument(t *testing.T, doc *openapi.Document, specs fs.FS, specPath string) {
+	content, err := fs.ReadFile(specs, specPath)
+	require.NoError(t, err)
+
+	spec := swaggerSpec{}
+	require.NoError(t, json.Unmarshal(content, &spec))
+
+	expected := map[string][]string{}
+	for path, item := range spec.Paths {
+		methods := []string{}
+		for method := range item {
+			if method != "parameters" {
+				methods = append(methods, method)
+			}
+		}
+		sort.Strings(methods)
+		expected[normalizeSpecPath(path)] = methods
+	}
+
+	actual := map[string][]string{}
+	for path, methods := range doc.Operations() {
+		actual[normalizeSpecPath(path)] = methods
+	}
+	require.Equal(t, expected, actual, "the generated OpenAPI document diverges from %s", specPath)
+
+	for path, item := range spec.Paths {
+		raw, ok := item["put"]
+		if !ok {
+			continue
+		}
+
+		operation := swaggerOperation{}
+		require.NoError(t, json.Unmarshal(raw, &operation))
+		for _, parameter := range operation.Parameters {
+			if parameter.In != "body" {
+				continue
+			}
+
+			resource := spec.resolve(parameter.Schema)
+			expectedProperties := spec.propertyNames(spec.resolve(resource.Properties["properties"]))
+			actualProperties := generatedPropertyNames(t, doc, path)
+			require.Equalf(t, expectedProperties, actualProperties, "the properties of the resource of %s diverge from %s", path, specPath)
+		}
+	}
+}
+
+func normalizeSpecPath(path string) string {
+	return specParameterPattern.ReplaceAllString(strings.ToLower(path), "{}")
+}
+
+func (s swaggerSpec) resolve(definition swaggerDefinition) swaggerDefinition {
+	if name, ok := strings.CutPrefix(definition.Ref, "#/definitions/"); ok {
+		return s.resolve(s.Definitions[name])
+	}
+	return definition
+}
+
+// propertyNames returns the sorted names of the properties of the definition, including the properties of the local
+// definitions that it is composed of.
+func (s swaggerSpec) propertyNames(definition swaggerDefinition) []string {
+	names := []string{}
+	for name := range definition.Properties {
+		names = append(names, name)
+	}
+	for _, composed := range definition.AllOf {
+		if strings.HasPrefix(composed.Ref, "#/definitions/") {
+			names = append(names, s.propertyNames(s.resolve(composed))...)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func generatedPropertyNames(t *testing.T, doc *openapi.Document, specPath string) []string {
+	var operation *openapi.Operation
+	for path, item := range doc.Paths {
+		if normalizeSpecPath(path) == normalizeSpecPath(specPath) {
+			operation = item.Put
+		}
+	}
+	require.NotNilf(t, operation, "the generated OpenAPI document has no PUT operation for %s", specPath)
+	require.NotNilf(t, operation.RequestBody, "the PUT operation of %s has no request body", specPath)
+
+	resource := resolveSchema(doc, operation.RequestBody.Content["application/json"].Schema)
+	require.NotNilf(t, resource, "the PUT operation of %s has no resource schema", specPath)
+
+	names := []string{}
+	if properties := resolveSchema(doc, resource.Properties["properties"]); properties != nil {
+		for name := range properties.Properties {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func resolveSchema(doc *openapi.Document, schema *openapi.Schema) *openapi.Schema {
+	if schema == nil || schema.Ref == "" {
+		return schema
+	}
+	return doc.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+}
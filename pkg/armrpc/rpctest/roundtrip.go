@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpctest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
+)
+
+// RunRoundTripTests runs the round trip conversion test of every registered converter for each of its API versions.
+// fixtures maps each resource type to the names of its fixture files, which are read from the testdata directory of the
+// package of each API version in apiDir, for example apiDir/v20231001preview/testdata. The test fails if a registered
+// resource type has no fixtures so that the converter of every resource type is covered.
+func RunRoundTripTests(t *testing.T, apiDir string, fixtures map[string][]string) {
+	registered := map[string]bool{}
+	for _, registration := range apiversions.Registered() {
+		resourceType := registration.ResourceType()
+		registered[strings.ToLower(resourceType)] = true
+
+		names, ok := fixtures[resourceType]
+		require.Truef(t, ok && len(names) > 0, "no round trip fixtures for the registered resource type %q", resourceType)
+
+		for _, version := range registration.Versions() {
+			for _, name := range names {
+				t.Run(resourceType+"/"+version+"/"+name, func(t *testing.T) {
+					content, err := os.ReadFile(filepath.Join(apiDir, apiversions.PackageName(version), "testdata", name))
+					require.NoError(t, err)
+					require.NoError(t, registration.RoundTrip(content, version))
+				})
+			}
+		}
+	}
+
+	for resourceType := range fixtures {
+		require.Truef(t, registered[strings.ToLower(resourceType)], "no converter is registered for the resource type %q", resourceType)
+	}
+}
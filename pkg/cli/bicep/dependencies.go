@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	// moduleReference matches the path of a module declaration, for example "module db './db.bicep' = {".
+	moduleReference = regexp.MustCompile(`\bmodule\s+[A-Za-z_][A-Za-z0-9_]*\s+'([^']+)'`)
+
+	// importReference matches the path of a compile-time import, for example "import { config } from './types.bicep'".
+	importReference = regexp.MustCompile(`\bimport\b[^\n]*?\bfrom\s+'([^']+)'`)
+
+	// fileReference matches the path of a file loaded by a function, for example "loadTextContent('nginx.conf')".
+	fileReference = regexp.MustCompile(`\bload(?:TextContent|FileAsBase64|JsonContent|YamlContent)\(\s*'([^']+)'`)
+)
+
+// registryPrefixes are the prefixes of module paths that refer to a registry rather than a local file.
+var registryPrefixes = []string{"br:", "br/", "ts:", "ts/"}
+
+// Dependencies returns the absolute paths of the local files that a Bicep or ARM-JSON template is built from: the
+// template itself, the local modules and imports it references (recursively), and the files it loads with functions
+// like loadTextContent. Modules published to a registry are not included.
+//
+// Dependencies are found by scanning the source of each Bicep file, so a reference in a comment is also included.
+// Referenced files do not need to exist.
+func Dependencies(filePath string) ([]string, error) {
+	root, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	visited := map[string]bool{}
+	var visit func(path string) error
+	visit = func(path string) error {
+		if visited[path] {
+			return nil
+		}
+		visited[path] = true
+		result = append(result, path)
+
+		if !strings.EqualFold(filepath.Ext(path), ".bicep") {
+			return nil
+		}
+
+		b, err := os.ReadFile(path)
+		if os.IsNotExist(err) && path != root {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("could not read file: %w", err)
+		}
+
+		for _, expression := range []*regexp.Regexp{moduleReference, importReference, fileReference} {
+			for _, match := range expression.FindAllStringSubmatch(string(b), -1) {
+				reference := match[1]
+				if isRegistryReference(reference) {
+					continue
+				}
+
+				if !filepath.IsAbs(reference) {
+					reference = filepath.Join(filepath.Dir(path), filepath.FromSlash(reference))
+				}
+
+				err := visit(filepath.Clean(reference))
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	err = visit(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func isRegistryReference(path string) bool {
+	for _, prefix := range registryPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
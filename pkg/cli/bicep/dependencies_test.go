@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Dependencies(t *testing.T) {
+	root, err := filepath.Abs(filepath.Join("testdata", "dependencies"))
+	require.NoError(t, err)
+
+	dependencies, err := Dependencies(filepath.Join("testdata", "dependencies", "app.bicep"))
+	require.NoError(t, err)
+
+	expected := []string{
+		filepath.Join(root, "app.bicep"),
+		filepath.Join(root, "modules", "database.bicep"),
+		filepath.Join(root, "modules", "shared.json"),
+		filepath.Join(root, "types.bicep"),
+		filepath.Join(root, "config", "database.json"),
+	}
+	require.ElementsMatch(t, expected, dependencies)
+}
+
+func Test_Dependencies_JSON(t *testing.T) {
+	path, err := filepath.Abs(filepath.Join("testdata", "test-parameters.json"))
+	require.NoError(t, err)
+
+	dependencies, err := Dependencies(filepath.Join("testdata", "test-parameters.json"))
+	require.NoError(t, err)
+	require.Equal(t, []string{path}, dependencies)
+}
+
+func Test_Dependencies_NotFound(t *testing.T) {
+	_, err := Dependencies(filepath.Join("testdata", "missing.bicep"))
+	require.Error(t, err)
+}
@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 
+	"github.com/radius-project/radius/pkg/armrpc/audit"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	ucp_v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -101,6 +102,7 @@ type ApplicationStatus struct {
 	Name          string
 	ResourceCount int
 	Gateways      []GatewayStatus
+	Jobs          []JobStatus
 }
 
 type GatewayStatus struct {
@@ -108,6 +110,20 @@ type GatewayStatus struct {
 	Endpoint string
 }
 
+type JobStatus struct {
+	Name     string
+	Schedule string
+	Status   string
+}
+
+// QuotaUsage is the usage of one limit of a quota.
+type QuotaUsage struct {
+	Quota string
+	Limit string
+	Used  int32
+	Max   int32
+}
+
 type EndpointOptions struct {
 	ResourceID ucpresources.ID
 }
@@ -143,6 +159,7 @@ type ApplicationsManagementClient interface {
 	ListAllResourcesOfTypeInEnvironment(ctx context.Context, environmentName string, resourceType string) ([]generated.GenericResource, error)
 	ListAllResourcesByEnvironment(ctx context.Context, environmentName string) ([]generated.GenericResource, error)
 	ShowResource(ctx context.Context, resourceType string, resourceName string) (generated.GenericResource, error)
+	ShowResourceByID(ctx context.Context, resourceID string) (generated.GenericResource, error)
 	DeleteResource(ctx context.Context, resourceType string, resourceName string) (bool, error)
 	ListApplications(ctx context.Context) ([]corerp.ApplicationResource, error)
 	ShowApplication(ctx context.Context, applicationName string) (corerp.ApplicationResource, error)
@@ -154,6 +171,14 @@ type ApplicationsManagementClient interface {
 	CreateApplicationIfNotFound(ctx context.Context, applicationName string, resource corerp.ApplicationResource) error
 
 	DeleteApplication(ctx context.Context, applicationName string) (bool, error)
+
+	// ListApplicationRevisions lists the revisions of an application, newest first.
+	ListApplicationRevisions(ctx context.Context, applicationName string) ([]corerp.ApplicationRevision, error)
+
+	// RollbackApplication rolls back an application to a previous revision and waits for the rollback to complete.
+	// The resources that are not part of the revision are deleted if deleteResources is true.
+	RollbackApplication(ctx context.Context, applicationName string, revision int32, deleteResources bool) error
+
 	CreateEnvironment(ctx context.Context, envName string, location string, envProperties *corerp.EnvironmentProperties) error
 
 	// ListEnvironmentsInResourceGroup lists all environments in the configured scope (assumes configured scope is a resource group)
@@ -170,6 +195,25 @@ type ApplicationsManagementClient interface {
 
 	// ShowRecipe shows recipe details including list of all parameters for a given recipe registered to an environment
 	ShowRecipe(ctx context.Context, environmentName string, recipe corerp.RecipeGetMetadata) (corerp.RecipeGetMetadataResponse, error)
+
+	// ListAuditEvents lists the audit events recorded under the filter scope, or the configured scope if the filter
+	// does not specify one.
+	ListAuditEvents(ctx context.Context, filter audit.Filter) ([]audit.Event, error)
+
+	// CreateOrUpdateLock creates or updates a lock stored in the scope, which is a plane or resource group ID.
+	CreateOrUpdateLock(ctx context.Context, scope string, lockName string, resource ucp_v20231001preview.LockResource) error
+
+	// ListLocks lists the locks stored in the scope, which is a plane or resource group ID. The locks of a plane
+	// include the locks of its resource groups.
+	ListLocks(ctx context.Context, scope string) ([]ucp_v20231001preview.LockResource, error)
+
+	// DeleteLock deletes a lock stored in the scope, which is a plane or resource group ID. It returns true if the
+	// lock existed.
+	DeleteLock(ctx context.Context, scope string, lockName string) (bool, error)
+
+	// ListQuotaUsage lists the usage of each limit of the quotas that apply to the scope, which is a resource group
+	// or environment ID.
+	ListQuotaUsage(ctx context.Context, scope string) ([]QuotaUsage, error)
 }
 
 // ShallowCopy creates a shallow copy of the DeploymentParameters object by iterating through the original object and
@@ -19,6 +19,7 @@ package clients
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -59,3 +60,25 @@ func Is404Error(err error) bool {
 
 	return false
 }
+
+// ScopeLockedError is returned when a lock prevents deleting a resource before any resource is deleted.
+type ScopeLockedError struct {
+	// Scope is the ID of the resource that cannot be deleted.
+	Scope string
+
+	// LockID is the ID of the lock that prevents the deletion.
+	LockID string
+
+	// LockScope is the scope that the lock protects.
+	LockScope string
+}
+
+// Error returns the error message of the ScopeLockedError.
+func (e *ScopeLockedError) Error() string {
+	return fmt.Sprintf("The resource %q cannot be deleted because it is protected by the lock %q on scope %q. Remove the lock using `rad lock delete` and try again.", e.Scope, e.LockID, e.LockScope)
+}
+
+// IsFriendlyError returns true. ScopeLockedError messages are displayed to the user without additional context.
+func (*ScopeLockedError) IsFriendlyError() bool {
+	return true
+}
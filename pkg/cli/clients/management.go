@@ -19,25 +19,34 @@ package clients
 import (
 	"context"
 	"net/http"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/locks"
+	"github.com/radius-project/radius/pkg/armrpc/quotas"
 	"github.com/radius-project/radius/pkg/azure/clientv2"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerpv20231001 "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	cntr_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/containers"
 	ext_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/extenders"
 	gtwy_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
 	hrt_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/httproutes"
+	job_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/jobs"
 	sstr_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/to"
 	ucpv20231001 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
@@ -62,6 +71,7 @@ var (
 		gtwy_ctrl.ResourceTypeName,
 		hrt_ctrl.ResourceTypeName,
 		cntr_ctrl.ResourceTypeName,
+		job_ctrl.ResourceTypeName,
 		sstr_ctrl.ResourceTypeName,
 	}
 )
@@ -186,6 +196,27 @@ func (amc *UCPApplicationsManagementClient) ShowResource(ctx context.Context, re
 	return getResponse.GenericResource, nil
 }
 
+// ShowResourceByID retrieves the resource with the given ID. Unlike ShowResource, the resource can be in a different
+// scope than the client.
+func (amc *UCPApplicationsManagementClient) ShowResourceByID(ctx context.Context, resourceID string) (generated.GenericResource, error) {
+	parsed, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	client, err := generated.NewGenericResourcesClient(parsed.RootScope(), parsed.Type(), &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	getResponse, err := client.Get(ctx, parsed.Name(), &generated.GenericResourcesClientGetOptions{})
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	return getResponse.GenericResource, nil
+}
+
 // DeleteResource creates a new client, sends a delete request to the resource, polls until the request is completed,
 // and returns a boolean indicating whether the resource was successfully deleted or not, and an error if one occurred.
 func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context, resourceType string, resourceName string) (bool, error) {
@@ -277,6 +308,18 @@ func (amc *UCPApplicationsManagementClient) DeleteApplication(ctx context.Contex
 		return false, err
 	}
 
+	// Check the locks before deleting anything so that a lock on one resource does not leave the application
+	// partially deleted.
+	err = amc.checkDeleteLocks(ctx, amc.applicationTargets(applicationName, "", resourcesWithApplication))
+	if err != nil {
+		return false, err
+	}
+
+	return amc.deleteApplication(ctx, applicationName, resourcesWithApplication)
+}
+
+// deleteApplication deletes the resources of the application and then the application.
+func (amc *UCPApplicationsManagementClient) deleteApplication(ctx context.Context, applicationName string, resourcesWithApplication []generated.GenericResource) (bool, error) {
 	g, groupCtx := errgroup.WithContext(ctx)
 	for _, resource := range resourcesWithApplication {
 		resource := resource
@@ -289,7 +332,7 @@ func (amc *UCPApplicationsManagementClient) DeleteApplication(ctx context.Contex
 		})
 	}
 
-	err = g.Wait()
+	err := g.Wait()
 	if err != nil {
 		return false, err
 	}
@@ -358,6 +401,44 @@ func (amc *UCPApplicationsManagementClient) CreateApplicationIfNotFound(ctx cont
 	return nil
 }
 
+// ListApplicationRevisions lists the revisions of an application, newest first.
+func (amc *UCPApplicationsManagementClient) ListApplicationRevisions(ctx context.Context, applicationName string) ([]corerpv20231001.ApplicationRevision, error) {
+	client, err := corerpv20231001.NewApplicationsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.ListRevisions(ctx, applicationName, map[string]any{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []corerpv20231001.ApplicationRevision{}
+	for _, revision := range response.Value {
+		if revision != nil {
+			results = append(results, *revision)
+		}
+	}
+	return results, nil
+}
+
+// RollbackApplication rolls back an application to a previous revision and waits for the rollback to complete.
+// The resources that are not part of the revision are deleted if deleteResources is true.
+func (amc *UCPApplicationsManagementClient) RollbackApplication(ctx context.Context, applicationName string, revision int32, deleteResources bool) error {
+	client, err := corerpv20231001.NewApplicationsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return err
+	}
+
+	poller, err := client.BeginRollback(ctx, applicationName, corerpv20231001.ApplicationRollbackRequest{Revision: &revision, DeleteResources: &deleteResources}, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
+
 // Creates a Radius Environment resource
 //
 
@@ -513,8 +594,27 @@ func (amc *UCPApplicationsManagementClient) DeleteEnv(ctx context.Context, envNa
 		return false, err
 	}
 
+	environmentID := amc.RootScope + "/providers/" + corerp_dm.EnvironmentResourceType + "/" + envName
+	targets := []locks.Target{{ID: environmentID}}
+	resourcesByApplication := map[string][]generated.GenericResource{}
 	for _, application := range applicationsWithEnv {
-		_, err := amc.DeleteApplication(ctx, *application.Name)
+		resourcesWithApplication, err := amc.ListAllResourcesByApplication(ctx, *application.Name)
+		if err != nil && !clientv2.Is404Error(err) {
+			return false, err
+		}
+		resourcesByApplication[*application.Name] = resourcesWithApplication
+		targets = append(targets, amc.applicationTargets(*application.Name, environmentID, resourcesWithApplication)...)
+	}
+
+	// Check the locks before deleting anything so that a lock on one resource does not leave the environment
+	// partially deleted.
+	err = amc.checkDeleteLocks(ctx, targets)
+	if err != nil {
+		return false, err
+	}
+
+	for _, application := range applicationsWithEnv {
+		_, err := amc.deleteApplication(ctx, *application.Name, resourcesByApplication[*application.Name])
 		if err != nil {
 			return false, err
 		}
@@ -634,3 +734,253 @@ func (amc *UCPApplicationsManagementClient) ShowRecipe(ctx context.Context, envi
 
 	return corerpv20231001.RecipeGetMetadataResponse(resp.RecipeGetMetadataResponse), nil
 }
+
+// ListAuditEvents lists the audit events recorded under the filter scope, or the configured scope if the filter
+// does not specify one.
+func (amc *UCPApplicationsManagementClient) ListAuditEvents(ctx context.Context, filter audit.Filter) ([]audit.Event, error) {
+	client, err := arm.NewClient("radius.AuditClient", "v0.0.1", &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := filter.Scope
+	if scope == "" {
+		scope = amc.RootScope
+	}
+
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.Endpoint(), scope, "providers/system.audit/events"))
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.Raw().URL.Query()
+	query.Set("api-version", ucpv20231001.Version)
+	if filter.ResourceID != "" {
+		query.Set("resourceId", filter.ResourceID)
+	}
+	if !filter.Since.IsZero() {
+		query.Set("since", filter.Since.UTC().Format(time.RFC3339))
+	}
+	if !filter.Until.IsZero() {
+		query.Set("until", filter.Until.UTC().Format(time.RFC3339))
+	}
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	resp, err := client.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	result := struct {
+		Value []audit.Event `json:"value"`
+	}{}
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
+
+// CreateOrUpdateLock creates or updates a lock stored in the scope, which is a plane or resource group ID.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdateLock(ctx context.Context, scope string, lockName string, resource ucpv20231001.LockResource) error {
+	client, err := ucpv20231001.NewLocksClient(scope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CreateOrUpdate(ctx, lockName, resource, nil)
+	return err
+}
+
+// ListLocks lists the locks stored in the scope, which is a plane or resource group ID. The locks of a plane include
+// the locks of its resource groups.
+func (amc *UCPApplicationsManagementClient) ListLocks(ctx context.Context, scope string) ([]ucpv20231001.LockResource, error) {
+	client, err := ucpv20231001.NewLocksClient(scope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []ucpv20231001.LockResource{}
+	pager := client.NewListByScopePager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, lock := range page.Value {
+			results = append(results, *lock)
+		}
+	}
+
+	return results, nil
+}
+
+// DeleteLock deletes a lock stored in the scope, which is a plane or resource group ID. It returns true if the lock
+// existed.
+func (amc *UCPApplicationsManagementClient) DeleteLock(ctx context.Context, scope string, lockName string) (bool, error) {
+	client, err := ucpv20231001.NewLocksClient(scope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return false, err
+	}
+
+	var respFromCtx *http.Response
+	ctxWithResp := runtime.WithCaptureResponse(ctx, &respFromCtx)
+
+	_, err = client.Delete(ctxWithResp, lockName, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return respFromCtx.StatusCode != 204, nil
+}
+
+// ListQuotaUsage lists the usage of each limit of the quotas that apply to the scope, which is a resource group or
+// environment ID. Quotas are listed from the plane of the scope because a quota stored in the plane may apply to it.
+func (amc *UCPApplicationsManagementClient) ListQuotaUsage(ctx context.Context, scope string) ([]QuotaUsage, error) {
+	id, err := resources.Parse(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := ucpv20231001.NewQuotasClient(id.PlaneScope(), &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	applicable := []*ucpv20231001.QuotaResource{}
+	pager := client.NewListByScopePager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, quota := range page.Value {
+			if quota.Properties != nil && strings.EqualFold(to.String(quota.Properties.Scope), scope) {
+				applicable = append(applicable, quota)
+			}
+		}
+	}
+
+	results := []QuotaUsage{}
+	for _, quota := range applicable {
+		quotaID, err := resources.ParseResource(to.String(quota.ID))
+		if err != nil {
+			return nil, err
+		}
+
+		usageClient, err := ucpv20231001.NewQuotasClient(quotaID.RootScope(), &aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		usage, err := usageClient.GetUsage(ctx, quotaID.Name(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, quotaUsageRows(quotaID.Name(), quota.Properties.Limits, &usage.QuotaUsage)...)
+	}
+
+	return results, nil
+}
+
+// quotaUsageRows returns the usage of each limit of the quota. Resource counts are sorted by resource type.
+func quotaUsageRows(quotaName string, limits *ucpv20231001.QuotaLimits, usage *ucpv20231001.QuotaUsage) []QuotaUsage {
+	if limits == nil {
+		return nil
+	}
+
+	rows := []QuotaUsage{}
+	resourceTypes := []string{}
+	for resourceType := range limits.ResourceCounts {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+	for _, resourceType := range resourceTypes {
+		rows = append(rows, QuotaUsage{
+			Quota: quotaName,
+			Limit: resourceType,
+			Used:  to.Int32(usage.ResourceCounts[resourceType]),
+			Max:   to.Int32(limits.ResourceCounts[resourceType]),
+		})
+	}
+
+	named := []struct {
+		limit string
+		used  *int32
+		max   *int32
+	}{
+		{quotas.LimitMaxRecipeExecutions, usage.RecipeExecutions, limits.MaxRecipeExecutions},
+		{quotas.LimitMaxContainers, usage.Containers, limits.MaxContainers},
+		{quotas.LimitMaxReplicas, usage.Replicas, limits.MaxReplicas},
+	}
+	for _, limit := range named {
+		if limit.max != nil {
+			rows = append(rows, QuotaUsage{Quota: quotaName, Limit: limit.limit, Used: to.Int32(limit.used), Max: *limit.max})
+		}
+	}
+
+	return rows
+}
+
+// applicationTargets returns the application and its resources as targets of a lock check.
+func (amc *UCPApplicationsManagementClient) applicationTargets(applicationName string, environmentID string, resourcesWithApplication []generated.GenericResource) []locks.Target {
+	applicationID := amc.RootScope + "/providers/" + corerp_dm.ApplicationResourceType + "/" + applicationName
+	targets := []locks.Target{{ID: applicationID}}
+	if environmentID != "" {
+		targets[0].Memberships = []string{environmentID}
+	}
+
+	for _, resource := range resourcesWithApplication {
+		target := locks.Target{ID: to.String(resource.ID), Memberships: []string{applicationID}}
+		if environment, ok := resource.Properties["environment"].(string); ok && environment != "" {
+			target.Memberships = append(target.Memberships, environment)
+		}
+		targets = append(targets, target)
+	}
+
+	return targets
+}
+
+// checkDeleteLocks returns a ScopeLockedError if a lock prevents deleting any of the targets. The server rejects each
+// delete that is prevented by a lock, but checking first avoids deleting some resources of an application before
+// failing on a locked one.
+func (amc *UCPApplicationsManagementClient) checkDeleteLocks(ctx context.Context, targets []locks.Target) error {
+	id, err := resources.ParseScope(amc.RootScope)
+	if err != nil {
+		return err
+	}
+
+	lockResources, err := amc.ListLocks(ctx, id.PlaneScope())
+	if err != nil {
+		return err
+	}
+	if len(lockResources) == 0 {
+		return nil
+	}
+
+	dm := []datamodel.Lock{}
+	for _, lockResource := range lockResources {
+		converted, err := lockResource.ConvertTo()
+		if err != nil {
+			return err
+		}
+		dm = append(dm, *converted.(*datamodel.Lock))
+	}
+
+	for _, target := range targets {
+		lock, err := locks.Find(dm, target, locks.OperationDelete, nil)
+		if err != nil {
+			return err
+		}
+		if lock != nil {
+			return &ScopeLockedError{Scope: target.ID, LockID: lock.ID, LockScope: lock.Properties.Scope}
+		}
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	audit "github.com/radius-project/radius/pkg/armrpc/audit"
 	generated "github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	v20231001preview0 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -79,6 +80,20 @@ func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateApplicatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateApplication", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateApplication), arg0, arg1, arg2)
 }
 
+// CreateOrUpdateLock mocks base method.
+func (m *MockApplicationsManagementClient) CreateOrUpdateLock(arg0 context.Context, arg1, arg2 string, arg3 v20231001preview0.LockResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateLock", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateLock indicates an expected call of CreateOrUpdateLock.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateLock(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateLock", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateLock), arg0, arg1, arg2, arg3)
+}
+
 // CreateUCPGroup mocks base method.
 func (m *MockApplicationsManagementClient) CreateUCPGroup(arg0 context.Context, arg1, arg2, arg3 string, arg4 v20231001preview0.ResourceGroupResource) error {
 	m.ctrl.T.Helper()
@@ -123,6 +138,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) DeleteEnv(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnv", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteEnv), arg0, arg1)
 }
 
+// DeleteLock mocks base method.
+func (m *MockApplicationsManagementClient) DeleteLock(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLock indicates an expected call of DeleteLock.
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteLock(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLock", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteLock), arg0, arg1, arg2)
+}
+
 // DeleteResource mocks base method.
 func (m *MockApplicationsManagementClient) DeleteResource(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +273,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListAllResourcesOfTypeIn
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllResourcesOfTypeInEnvironment", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAllResourcesOfTypeInEnvironment), arg0, arg1, arg2)
 }
 
+// ListApplicationRevisions mocks base method.
+func (m *MockApplicationsManagementClient) ListApplicationRevisions(arg0 context.Context, arg1 string) ([]v20231001preview.ApplicationRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplicationRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]v20231001preview.ApplicationRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApplicationRevisions indicates an expected call of ListApplicationRevisions.
+func (mr *MockApplicationsManagementClientMockRecorder) ListApplicationRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplicationRevisions", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListApplicationRevisions), arg0, arg1)
+}
+
 // ListApplications mocks base method.
 func (m *MockApplicationsManagementClient) ListApplications(arg0 context.Context) ([]v20231001preview.ApplicationResource, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +303,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListApplications(arg0 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListApplications), arg0)
 }
 
+// ListAuditEvents mocks base method.
+func (m *MockApplicationsManagementClient) ListAuditEvents(arg0 context.Context, arg1 audit.Filter) ([]audit.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", arg0, arg1)
+	ret0, _ := ret[0].([]audit.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockApplicationsManagementClientMockRecorder) ListAuditEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAuditEvents), arg0, arg1)
+}
+
 // ListEnvironmentsAll mocks base method.
 func (m *MockApplicationsManagementClient) ListEnvironmentsAll(arg0 context.Context) ([]v20231001preview.EnvironmentResource, error) {
 	m.ctrl.T.Helper()
@@ -288,6 +348,36 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListEnvironmentsInResour
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironmentsInResourceGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListEnvironmentsInResourceGroup), arg0)
 }
 
+// ListLocks mocks base method.
+func (m *MockApplicationsManagementClient) ListLocks(arg0 context.Context, arg1 string) ([]v20231001preview0.LockResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocks", arg0, arg1)
+	ret0, _ := ret[0].([]v20231001preview0.LockResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocks indicates an expected call of ListLocks.
+func (mr *MockApplicationsManagementClientMockRecorder) ListLocks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocks", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListLocks), arg0, arg1)
+}
+
+// ListQuotaUsage mocks base method.
+func (m *MockApplicationsManagementClient) ListQuotaUsage(arg0 context.Context, arg1 string) ([]QuotaUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQuotaUsage", arg0, arg1)
+	ret0, _ := ret[0].([]QuotaUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuotaUsage indicates an expected call of ListQuotaUsage.
+func (mr *MockApplicationsManagementClientMockRecorder) ListQuotaUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuotaUsage", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListQuotaUsage), arg0, arg1)
+}
+
 // ListUCPGroup mocks base method.
 func (m *MockApplicationsManagementClient) ListUCPGroup(arg0 context.Context, arg1, arg2 string) ([]v20231001preview0.ResourceGroupResource, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +393,20 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListUCPGroup(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListUCPGroup), arg0, arg1, arg2)
 }
 
+// RollbackApplication mocks base method.
+func (m *MockApplicationsManagementClient) RollbackApplication(arg0 context.Context, arg1 string, arg2 int32, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackApplication", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackApplication indicates an expected call of RollbackApplication.
+func (mr *MockApplicationsManagementClientMockRecorder) RollbackApplication(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackApplication", reflect.TypeOf((*MockApplicationsManagementClient)(nil).RollbackApplication), arg0, arg1, arg2, arg3)
+}
+
 // ShowApplication mocks base method.
 func (m *MockApplicationsManagementClient) ShowApplication(arg0 context.Context, arg1 string) (v20231001preview.ApplicationResource, error) {
 	m.ctrl.T.Helper()
@@ -348,6 +452,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) ShowResource(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowResource", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ShowResource), arg0, arg1, arg2)
 }
 
+// ShowResourceByID mocks base method.
+func (m *MockApplicationsManagementClient) ShowResourceByID(arg0 context.Context, arg1 string) (generated.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowResourceByID", arg0, arg1)
+	ret0, _ := ret[0].(generated.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowResourceByID indicates an expected call of ShowResourceByID.
+func (mr *MockApplicationsManagementClientMockRecorder) ShowResourceByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowResourceByID", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ShowResourceByID), arg0, arg1)
+}
+
 // ShowUCPGroup mocks base method.
 func (m *MockApplicationsManagementClient) ShowUCPGroup(arg0 context.Context, arg1, arg2, arg3 string) (v20231001preview0.ResourceGroupResource, error) {
 	m.ctrl.T.Helper()
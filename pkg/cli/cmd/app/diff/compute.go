@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// applicationsType is the resource type of a Radius application.
+	applicationsType = "Applications.Core/applications"
+)
+
+// locationProperties describe where a resource is deployed rather than how it is configured. They are removed
+// from resources before they are compared, along with the read-only properties that are set by the server.
+var locationProperties = []string{"application", "environment"}
+
+// normalizeLive converts resources read from the server to snapshots.
+func normalizeLive(resources []generated.GenericResource, scope string) []snapshotResource {
+	snapshots := []snapshotResource{}
+	for _, resource := range resources {
+		snapshots = append(snapshots, snapshotResource{
+			Type:       to.String(resource.Type),
+			Name:       to.String(resource.Name),
+			Properties: normalizeProperties(to.String(resource.Type), resource.Properties, scope),
+		})
+	}
+
+	return snapshots
+}
+
+// normalizeTemplate converts the resources declared in a template to snapshots. Only resources that are
+// part of the application are included: the application itself, and Radius resources that belong to it.
+// Resources with a name that is only known after deploy can't be matched and are skipped.
+func normalizeTemplate(resources []preview.Resource, scope string, applicationName string) []snapshotResource {
+	applicationID := strings.ToLower("/providers/" + applicationsType + "/" + applicationName)
+
+	snapshots := []snapshotResource{}
+	for _, resource := range resources {
+		if !resource.Radius || resource.Name == "" {
+			continue
+		}
+
+		properties, _ := resource.Body["properties"].(map[string]any)
+		isApplication := strings.EqualFold(resource.Type, applicationsType) && strings.EqualFold(resource.Name, applicationName)
+		if !isApplication {
+			application, ok := properties["application"].(string)
+			if !ok || strings.ToLower(relativeID(application, scope)) != applicationID {
+				continue
+			}
+		}
+
+		snapshots = append(snapshots, snapshotResource{
+			Type:       resource.Type,
+			Name:       resource.Name,
+			Properties: normalizeProperties(resource.Type, properties, scope),
+		})
+	}
+
+	return snapshots
+}
+
+// normalizeProperties removes read-only and location properties and makes the IDs of resources in the given scope
+// relative to the scope, so that resources deployed to different resource groups can be compared.
+func normalizeProperties(resourceType string, properties map[string]any, scope string) map[string]any {
+	result := map[string]any{}
+	for k, v := range properties {
+		ignored := preview.IsReadOnlyProperty(resourceType, k)
+		for _, name := range locationProperties {
+			if strings.EqualFold(k, name) {
+				ignored = true
+				break
+			}
+		}
+
+		if !ignored {
+			result[k] = relativeIDs(v, scope)
+		}
+	}
+
+	return result
+}
+
+func relativeIDs(v any, scope string) any {
+	switch value := v.(type) {
+	case string:
+		return relativeID(value, scope)
+	case map[string]any:
+		result := map[string]any{}
+		for k, item := range value {
+			result[k] = relativeIDs(item, scope)
+		}
+		return result
+	case []any:
+		result := make([]any, len(value))
+		for i, item := range value {
+			result[i] = relativeIDs(item, scope)
+		}
+		return result
+	}
+
+	return v
+}
+
+// relativeID removes the scope from a resource ID in the scope, for example
+// "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/web" becomes
+// "/providers/Applications.Core/containers/web".
+func relativeID(s string, scope string) string {
+	prefix := strings.ToLower(scope + "/providers/")
+	if scope != "" && strings.HasPrefix(strings.ToLower(s), prefix) {
+		return s[len(scope):]
+	}
+
+	return s
+}
+
+func resourceKey(resourceType string, name string) string {
+	return strings.ToLower(resourceType + "/" + name)
+}
+
+// compare compares the resources of the source with those of the target.
+func compare(source string, target string, sourceResources []snapshotResource, targetResources []snapshotResource) *comparison {
+	result := &comparison{Source: source, Target: target, Resources: []resourceDiff{}}
+
+	targets := map[string]snapshotResource{}
+	for _, resource := range targetResources {
+		targets[resourceKey(resource.Type, resource.Name)] = resource
+	}
+
+	for _, resource := range sourceResources {
+		key := resourceKey(resource.Type, resource.Name)
+		other, ok := targets[key]
+		if !ok {
+			result.Resources = append(result.Resources, resourceDiff{Type: resource.Type, Name: resource.Name, Status: statusOnlyInSource})
+			continue
+		}
+		delete(targets, key)
+
+		changes := preview.Diff(resource.Type, resource.Properties, other.Properties)
+
+		diff := resourceDiff{Type: resource.Type, Name: resource.Name, Status: statusUnchanged}
+		if len(changes) > 0 {
+			diff.Status = statusModified
+			diff.Changes = changes
+		}
+		result.Resources = append(result.Resources, diff)
+	}
+
+	for _, resource := range targetResources {
+		if _, ok := targets[resourceKey(resource.Type, resource.Name)]; ok {
+			result.Resources = append(result.Resources, resourceDiff{Type: resource.Type, Name: resource.Name, Status: statusOnlyInTarget})
+		}
+	}
+
+	sort.Slice(result.Resources, func(i, j int) bool {
+		a, b := result.Resources[i], result.Resources[j]
+		if !strings.EqualFold(a.Type, b.Type) {
+			return strings.ToLower(a.Type) < strings.ToLower(b.Type)
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+
+	for _, resource := range result.Resources {
+		if isDrift(resource) {
+			result.Drift = true
+		}
+	}
+
+	return result
+}
+
+// isDrift returns true if the resource differs. Values that are only known after deploy can't be compared and
+// are not considered drift.
+func isDrift(resource resourceDiff) bool {
+	if resource.Status != statusModified {
+		return resource.Status != statusUnchanged
+	}
+
+	for _, change := range resource.Changes {
+		if change.Kind != preview.ChangeUnknown {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	stagingScope    = "/planes/radius/local/resourceGroups/staging"
+	productionScope = "/planes/radius/local/resourceGroups/production"
+)
+
+func Test_normalizeLive(t *testing.T) {
+	resources := []generated.GenericResource{
+		{
+			ID:   to.Ptr(stagingScope + "/providers/Applications.Core/containers/web"),
+			Name: to.Ptr("web"),
+			Type: to.Ptr("Applications.Core/containers"),
+			Properties: map[string]any{
+				"application":       stagingScope + "/providers/Applications.Core/applications/demo",
+				"environment":       stagingScope + "/providers/Applications.Core/environments/staging",
+				"provisioningState": "Succeeded",
+				"status":            map[string]any{"outputResources": []any{}},
+				"container":         map[string]any{"image": "web:v1"},
+				"connections": map[string]any{
+					"db":    map[string]any{"source": stagingScope + "/providers/Applications.Datastores/redisCaches/db"},
+					"cloud": map[string]any{"source": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/s"},
+				},
+			},
+		},
+		{
+			ID:   to.Ptr(stagingScope + "/providers/Applications.Core/gateways/gateway"),
+			Name: to.Ptr("gateway"),
+			Type: to.Ptr("Applications.Core/gateways"),
+			Properties: map[string]any{
+				"url":    "http://staging.localhost",
+				"routes": []any{map[string]any{"path": "/"}},
+			},
+		},
+	}
+
+	require.Equal(t, []snapshotResource{
+		{
+			Type: "Applications.Core/containers",
+			Name: "web",
+			Properties: map[string]any{
+				"container": map[string]any{"image": "web:v1"},
+				"connections": map[string]any{
+					"db":    map[string]any{"source": "/providers/Applications.Datastores/redisCaches/db"},
+					"cloud": map[string]any{"source": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/s"},
+				},
+			},
+		},
+		{
+			Type:       "Applications.Core/gateways",
+			Name:       "gateway",
+			Properties: map[string]any{"routes": []any{map[string]any{"path": "/"}}},
+		},
+	}, normalizeLive(resources, stagingScope))
+}
+
+func Test_normalizeTemplate(t *testing.T) {
+	applicationID := stagingScope + "/providers/Applications.Core/applications/demo"
+	resources := []preview.Resource{
+		{Type: "Applications.Core/environments", Name: "staging", Radius: true, Body: map[string]any{"properties": map[string]any{}}},
+		{Type: "Applications.Core/applications", Name: "demo", Radius: true, Body: map[string]any{"properties": map[string]any{"environment": "x"}}},
+		{Type: "Applications.Core/containers", Name: "web", Radius: true, Body: map[string]any{"properties": map[string]any{"application": applicationID, "container": map[string]any{"image": "web:v1"}}}},
+		{Type: "Applications.Core/containers", Name: "other", Radius: true, Body: map[string]any{"properties": map[string]any{"application": stagingScope + "/providers/Applications.Core/applications/other"}}},
+		{Type: "Applications.Core/containers", Name: "", Radius: true, Body: map[string]any{"properties": map[string]any{"application": applicationID}}},
+		{Type: "Microsoft.Storage/storageAccounts", Name: "storage", Body: map[string]any{}},
+	}
+
+	require.Equal(t, []snapshotResource{
+		{Type: "Applications.Core/applications", Name: "demo", Properties: map[string]any{}},
+		{Type: "Applications.Core/containers", Name: "web", Properties: map[string]any{"container": map[string]any{"image": "web:v1"}}},
+	}, normalizeTemplate(resources, stagingScope, "demo"))
+}
+
+func Test_compare(t *testing.T) {
+	source := []snapshotResource{
+		{Type: "Applications.Core/applications", Name: "demo", Properties: map[string]any{}},
+		{Type: "Applications.Core/containers", Name: "web", Properties: map[string]any{"container": map[string]any{"image": "web:v1"}}},
+		{Type: "Applications.Core/containers", Name: "legacy", Properties: map[string]any{}},
+	}
+	target := []snapshotResource{
+		{Type: "applications.core/applications", Name: "demo", Properties: map[string]any{}},
+		{Type: "Applications.Core/containers", Name: "web", Properties: map[string]any{"container": map[string]any{"image": "web:v2"}}},
+		{Type: "Applications.Core/containers", Name: "worker", Properties: map[string]any{}},
+	}
+
+	result := compare("source", "target", source, target)
+	require.True(t, result.Drift)
+	require.Equal(t, []resourceDiff{
+		{Type: "Applications.Core/applications", Name: "demo", Status: statusUnchanged},
+		{Type: "Applications.Core/containers", Name: "legacy", Status: statusOnlyInSource},
+		{
+			Type:   "Applications.Core/containers",
+			Name:   "web",
+			Status: statusModified,
+			Changes: []preview.PropertyChange{
+				{Path: "properties.container.image", Kind: preview.ChangeModified, Before: "web:v1", After: "web:v2"},
+			},
+		},
+		{Type: "Applications.Core/containers", Name: "worker", Status: statusOnlyInTarget},
+	}, result.Resources)
+}
+
+func Test_compare_NoDrift(t *testing.T) {
+	source := []snapshotResource{
+		{Type: "Applications.Core/containers", Name: "web", Properties: map[string]any{"container": map[string]any{"image": "web:v1"}, "extensions": []any{}}},
+	}
+	target := []snapshotResource{
+		{Type: "Applications.Core/containers", Name: "web", Properties: map[string]any{"container": map[string]any{"image": "web:v1", "env": map[string]any{"A": preview.Unknown{}}}}},
+	}
+
+	// Empty values that the template does not declare are ignored, and unknown values are not drift.
+	result := compare("source", "target", source, target)
+	require.False(t, result.Drift)
+	require.Equal(t, statusModified, result.Resources[0].Status)
+	require.Equal(t, preview.ChangeUnknown, result.Resources[0].Changes[0].Kind)
+}
+
+func Test_compare_RemovedProperty(t *testing.T) {
+	connections := map[string]any{"db": map[string]any{"source": "/providers/Applications.Datastores/redisCaches/db"}}
+	source := []snapshotResource{
+		{Type: "Applications.Core/containers", Name: "web", Properties: map[string]any{"container": map[string]any{"image": "web:v1"}, "connections": connections}},
+	}
+	target := []snapshotResource{
+		{Type: "Applications.Core/containers", Name: "web", Properties: map[string]any{"container": map[string]any{"image": "web:v1"}}},
+	}
+
+	// Properties that the template no longer declares are removed when it is deployed.
+	result := compare("source", "target", source, target)
+	require.True(t, result.Drift)
+	require.Equal(t, []preview.PropertyChange{
+		{Path: "properties.connections", Kind: preview.ChangeRemoved, Before: connections},
+	}, result.Resources[0].Changes)
+}
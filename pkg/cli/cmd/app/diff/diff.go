@@ -0,0 +1,334 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/preview"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fileFlag              = "file"
+	targetGroupFlag       = "target-group"
+	targetApplicationFlag = "target-application"
+	targetEnvironmentFlag = "target-environment"
+)
+
+// NewCommand creates an instance of the `rad app diff` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "diff [application]",
+		Short: "Compare a deployed Radius Application with a template or another application",
+		Long: `Compare a deployed Radius Application with a template or another application.
+
+The application's resources are read from the server and normalized before they are compared: properties set by
+the server (like 'status'), and the application and environment a resource belongs to, are ignored, and the IDs
+of resources in the same resource group are compared relative to the resource group.
+
+Use '--file' to compare the application with a Bicep or ARM template, for example the template in source control.
+Properties that the template does not declare are not compared, because the server sets default values for them.
+
+Use '--target-group', '--target-application' and '--target-environment' to compare the application with another
+application, for example the same application deployed to a production environment.
+
+The command exits with a non-zero exit code if any differences are found, so it can be used to gate pipelines.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Compare the current application with a Bicep template
+rad app diff --file app.bicep
+
+# Compare an application with a Bicep template, passing parameters
+rad app diff my-app --file app.bicep --parameters version=latest
+
+# Compare an application with the same application in another resource group
+rad app diff my-app --target-group production
+
+# Compare an application with another application deployed to a specific environment
+rad app diff my-app --target-application my-app-prod --target-environment production
+
+# Output the differences in JSON format
+rad app diff my-app --target-group production --output json
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().StringP(fileFlag, "f", "", "The Bicep or ARM template to compare the application with")
+	cmd.Flags().String(targetGroupFlag, "", "The resource group of the application to compare with. Defaults to the resource group of the application")
+	cmd.Flags().String(targetApplicationFlag, "", "The name of the application to compare with. Defaults to the name of the application")
+	cmd.Flags().String(targetEnvironmentFlag, "", "The environment the application to compare with must be deployed to")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad app diff` command.
+type Runner struct {
+	Bicep             bicep.Interface
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	EnvironmentName string
+	Format          string
+	FilePath        string
+	Parameters      clients.DeploymentParameters
+
+	TargetScope           string
+	TargetApplicationName string
+	TargetEnvironmentName string
+}
+
+// NewRunner creates an instance of the runner for the `rad app diff` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Bicep:             factory.GetBicep(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad app diff` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.FilePath, err = cmd.Flags().GetString(fileFlag)
+	if err != nil {
+		return err
+	}
+
+	targetGroup, err := cmd.Flags().GetString(targetGroupFlag)
+	if err != nil {
+		return err
+	}
+	r.TargetApplicationName, err = cmd.Flags().GetString(targetApplicationFlag)
+	if err != nil {
+		return err
+	}
+	r.TargetEnvironmentName, err = cmd.Flags().GetString(targetEnvironmentFlag)
+	if err != nil {
+		return err
+	}
+
+	hasTarget := targetGroup != "" || r.TargetApplicationName != "" || r.TargetEnvironmentName != ""
+	switch {
+	case r.FilePath != "" && hasTarget:
+		return clierrors.Message("The '--%s' flag cannot be combined with '--%s', '--%s' or '--%s'.", fileFlag, targetGroupFlag, targetApplicationFlag, targetEnvironmentFlag)
+	case r.FilePath == "" && !hasTarget:
+		return clierrors.Message("Specify a template to compare with using '--%s', or an application to compare with using '--%s', '--%s' or '--%s'.", fileFlag, targetGroupFlag, targetApplicationFlag, targetEnvironmentFlag)
+	}
+
+	if r.FilePath != "" {
+		r.EnvironmentName, err = cli.RequireEnvironmentName(cmd, args, *workspace)
+		if err != nil {
+			return err
+		}
+
+		parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+		if err != nil {
+			return err
+		}
+
+		parser := bicep.ParameterParser{FileSystem: bicep.OSFileSystem{}}
+		r.Parameters, err = parser.Parse(parameterArgs...)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	r.TargetScope = scope
+	if targetGroup != "" {
+		r.TargetScope = fmt.Sprintf("/planes/radius/local/resourceGroups/%s", targetGroup)
+	}
+	if r.TargetApplicationName == "" {
+		r.TargetApplicationName = r.ApplicationName
+	}
+
+	if r.TargetScope == scope && r.TargetApplicationName == r.ApplicationName {
+		return clierrors.Message("The application cannot be compared with itself. Use '--%s' or '--%s' to choose a different application.", targetGroupFlag, targetApplicationFlag)
+	}
+
+	return nil
+}
+
+// Run runs the `rad app diff` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	source, err := loadApplication(ctx, client, r.Workspace.Scope, r.ApplicationName, "")
+	if err != nil {
+		return err
+	}
+
+	var result *comparison
+	if r.FilePath != "" {
+		target, err := r.loadTemplate()
+		if err != nil {
+			return err
+		}
+
+		result = compare(describeApplication(r.Workspace.Scope, r.ApplicationName), fmt.Sprintf("template %q", r.FilePath), source, target)
+	} else {
+		workspace := *r.Workspace
+		workspace.Scope = r.TargetScope
+		targetClient, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, workspace)
+		if err != nil {
+			return err
+		}
+
+		target, err := loadApplication(ctx, targetClient, r.TargetScope, r.TargetApplicationName, r.TargetEnvironmentName)
+		if err != nil {
+			return err
+		}
+
+		result = compare(describeApplication(r.Workspace.Scope, r.ApplicationName), describeApplication(r.TargetScope, r.TargetApplicationName), source, target)
+	}
+
+	if r.Format == output.FormatJson {
+		err = r.Output.WriteFormatted(r.Format, result, output.FormatterOptions{})
+		if err != nil {
+			return err
+		}
+	} else {
+		r.Output.LogInfo("%s", display(result))
+	}
+
+	if result.Drift {
+		return clierrors.Message("Drift detected between %s and %s.", result.Source, result.Target)
+	}
+
+	return nil
+}
+
+// loadTemplate compiles and evaluates the template, using the application and environment being compared
+// for the 'application' and 'environment' parameters.
+func (r *Runner) loadTemplate() ([]snapshotResource, error) {
+	template, err := r.Bicep.PrepareTemplate(r.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := clients.ShallowCopy(r.Parameters)
+	environmentID := r.Workspace.Scope + "/providers/applications.core/environments/" + r.EnvironmentName
+	err = bicep.InjectEnvironmentParam(template, parameters, environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID := r.Workspace.Scope + "/providers/applications.core/applications/" + r.ApplicationName
+	err = bicep.InjectApplicationParam(template, parameters, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	declared, err := preview.Evaluate(template, preview.EvaluateOptions{Scope: r.Workspace.Scope, Parameters: parameters})
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeTemplate(declared, r.Workspace.Scope, r.ApplicationName), nil
+}
+
+// loadApplication reads the application and its resources. If environmentName is set, the application
+// must be deployed to that environment.
+func loadApplication(ctx context.Context, client clients.ApplicationsManagementClient, scope string, applicationName string, environmentName string) ([]snapshotResource, error) {
+	application, err := client.ShowResource(ctx, applicationsType, applicationName)
+	if clients.Is404Error(err) {
+		return nil, clierrors.Message("The application %q was not found in resource group %q.", applicationName, groupName(scope))
+	} else if err != nil {
+		return nil, err
+	}
+
+	if environmentName != "" {
+		environmentID, _ := application.Properties["environment"].(string)
+		parsed, err := resources.ParseResource(environmentID)
+		if err != nil || !strings.EqualFold(parsed.Name(), environmentName) {
+			return nil, clierrors.Message("The application %q in resource group %q is not deployed to the environment %q.", applicationName, groupName(scope), environmentName)
+		}
+	}
+
+	applicationResources, err := client.ListAllResourcesByApplication(ctx, applicationName)
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeLive(append([]generated.GenericResource{application}, applicationResources...), scope), nil
+}
+
+func describeApplication(scope string, applicationName string) string {
+	return fmt.Sprintf("application %q in resource group %q", applicationName, groupName(scope))
+}
+
+func groupName(scope string) string {
+	parsed, err := resources.ParseScope(scope)
+	if err != nil {
+		return scope
+	}
+
+	return parsed.FindScope(resources_radius.ScopeResourceGroups)
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "rad app diff with template",
+			Input:         []string{"test-app", "--file", "app.bicep", "-p", "version=latest"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, obj framework.Runner) {
+				runner := obj.(*Runner)
+				require.Equal(t, "app.bicep", runner.FilePath)
+				require.Equal(t, radcli.TestEnvironmentName, runner.EnvironmentName)
+				require.Equal(t, clients.DeploymentParameters{"version": {"value": "latest"}}, runner.Parameters)
+			},
+		},
+		{
+			Name:          "rad app diff with target group",
+			Input:         []string{"test-app", "--target-group", "production", "--target-environment", "prod"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, obj framework.Runner) {
+				runner := obj.(*Runner)
+				require.Equal(t, productionScope, runner.TargetScope)
+				require.Equal(t, "test-app", runner.TargetApplicationName)
+				require.Equal(t, "prod", runner.TargetEnvironmentName)
+			},
+		},
+		{
+			Name:          "rad app diff with target application",
+			Input:         []string{"-a", "test-app", "--target-application", "test-app-prod", "-o", "json"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, obj framework.Runner) {
+				runner := obj.(*Runner)
+				require.Equal(t, runner.Workspace.Scope, runner.TargetScope)
+				require.Equal(t, output.FormatJson, runner.Format)
+			},
+		},
+		{
+			Name:          "rad app diff without template or target",
+			Input:         []string{"test-app"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "rad app diff with template and target",
+			Input:         []string{"test-app", "--file", "app.bicep", "--target-group", "production"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "rad app diff with itself",
+			Input:         []string{"test-app", "--target-environment", "prod"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "rad app diff without application",
+			Input:         []string{"--target-group", "production"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	application := func(scope string, name string, environment string) generated.GenericResource {
+		return generated.GenericResource{
+			ID:         to.Ptr(scope + "/providers/Applications.Core/applications/" + name),
+			Name:       to.Ptr(name),
+			Type:       to.Ptr("Applications.Core/applications"),
+			Properties: map[string]any{"environment": scope + "/providers/Applications.Core/environments/" + environment},
+		}
+	}
+	container := func(scope string, application string, name string, image string) generated.GenericResource {
+		return generated.GenericResource{
+			ID:   to.Ptr(scope + "/providers/Applications.Core/containers/" + name),
+			Name: to.Ptr(name),
+			Type: to.Ptr("Applications.Core/containers"),
+			Properties: map[string]any{
+				"application":       scope + "/providers/Applications.Core/applications/" + application,
+				"provisioningState": "Succeeded",
+				"container":         map[string]any{"image": image},
+			},
+		}
+	}
+
+	t.Run("Drift between applications", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			ShowResource(gomock.Any(), "Applications.Core/applications", "demo").
+			Return(application(stagingScope, "demo", "staging"), nil).
+			Times(1)
+		client.EXPECT().
+			ListAllResourcesByApplication(gomock.Any(), "demo").
+			Return([]generated.GenericResource{container(stagingScope, "demo", "web", "web:v2")}, nil).
+			Times(1)
+		client.EXPECT().
+			ShowResource(gomock.Any(), "Applications.Core/applications", "demo-prod").
+			Return(application(productionScope, "demo-prod", "production"), nil).
+			Times(1)
+		client.EXPECT().
+			ListAllResourcesByApplication(gomock.Any(), "demo-prod").
+			Return([]generated.GenericResource{container(productionScope, "demo-prod", "web", "web:v1")}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:     &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:                outputSink,
+			Workspace:             &workspaces.Workspace{Scope: stagingScope},
+			ApplicationName:       "demo",
+			Format:                output.FormatTable,
+			TargetScope:           productionScope,
+			TargetApplicationName: "demo-prod",
+			TargetEnvironmentName: "production",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message(`Drift detected between application "demo" in resource group "staging" and application "demo-prod" in resource group "production".`), err)
+
+		// The applications have different names, so they are reported separately.
+		require.Len(t, outputSink.Writes, 1)
+		text := outputSink.Writes[0].(output.LogOutput).Params[0].(string)
+		require.Contains(t, text, `~ properties.container.image: "web:v2" => "web:v1"`)
+		require.Contains(t, text, `- Applications.Core/applications demo (only in application "demo" in resource group "staging")`)
+		require.Contains(t, text, "Drift detected: 1 modified, 1 only in source, 1 only in target, 0 unchanged.")
+	})
+
+	t.Run("Wrong target environment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			ShowResource(gomock.Any(), "Applications.Core/applications", "demo").
+			Return(application(stagingScope, "demo", "staging"), nil).
+			Times(2)
+		client.EXPECT().
+			ListAllResourcesByApplication(gomock.Any(), "demo").
+			Return([]generated.GenericResource{}, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory:     &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:                &output.MockOutput{},
+			Workspace:             &workspaces.Workspace{Scope: stagingScope},
+			ApplicationName:       "demo",
+			TargetScope:           productionScope,
+			TargetApplicationName: "demo",
+			TargetEnvironmentName: "production",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message(`The application "demo" in resource group "production" is not deployed to the environment "production".`), err)
+	})
+
+	t.Run("No drift from template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			ShowResource(gomock.Any(), "Applications.Core/applications", "demo").
+			Return(application(stagingScope, "demo", "staging"), nil).
+			Times(1)
+		client.EXPECT().
+			ListAllResourcesByApplication(gomock.Any(), "demo").
+			Return([]generated.GenericResource{container(stagingScope, "demo", "web", "web:v1")}, nil).
+			Times(1)
+
+		template := map[string]any{
+			"imports": map[string]any{"radius": map[string]any{"provider": "Radius"}},
+			"parameters": map[string]any{
+				"application": map[string]any{"type": "string"},
+				"environment": map[string]any{"type": "string"},
+			},
+			"resources": map[string]any{
+				"app": map[string]any{
+					"import": "radius",
+					"type":   "Applications.Core/applications@2023-10-01-preview",
+					"properties": map[string]any{
+						"name":       "demo",
+						"properties": map[string]any{"environment": "[parameters('environment')]"},
+					},
+				},
+				"web": map[string]any{
+					"import": "radius",
+					"type":   "Applications.Core/containers@2023-10-01-preview",
+					"properties": map[string]any{
+						"name": "web",
+						"properties": map[string]any{
+							"application": "[parameters('application')]",
+							"container":   map[string]any{"image": "web:v1"},
+						},
+					},
+				},
+			},
+		}
+		bicepMock := bicep.NewMockInterface(ctrl)
+		bicepMock.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(template, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Bicep:             bicepMock,
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: stagingScope},
+			ApplicationName:   "demo",
+			EnvironmentName:   "staging",
+			Format:            output.FormatJson,
+			FilePath:          "app.bicep",
+			Parameters:        clients.DeploymentParameters{},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, []any{
+			output.FormattedOutput{
+				Format: output.FormatJson,
+				Obj: &comparison{
+					Source: `application "demo" in resource group "staging"`,
+					Target: `template "app.bicep"`,
+					Resources: []resourceDiff{
+						{Type: "Applications.Core/applications", Name: "demo", Status: statusUnchanged},
+						{Type: "Applications.Core/containers", Name: "web", Status: statusUnchanged},
+					},
+				},
+			},
+		}, outputSink.Writes)
+	})
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/preview"
+)
+
+// display builds the human-readable output of a comparison. Unchanged resources are only counted.
+func display(result *comparison) string {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "Comparing %s with %s:\n", result.Source, result.Target)
+
+	counts := map[resourceStatus]int{}
+	for _, resource := range result.Resources {
+		counts[resource.Status]++
+
+		switch resource.Status {
+		case statusOnlyInSource:
+			fmt.Fprintf(sb, "\n  - %s %s (only in %s)\n", resource.Type, resource.Name, result.Source)
+		case statusOnlyInTarget:
+			fmt.Fprintf(sb, "\n  + %s %s (only in %s)\n", resource.Type, resource.Name, result.Target)
+		case statusModified:
+			fmt.Fprintf(sb, "\n  ~ %s %s (%s)\n", resource.Type, resource.Name, resource.Status)
+			for _, change := range resource.Changes {
+				writeChange(sb, change)
+			}
+		}
+	}
+
+	if result.Drift {
+		fmt.Fprintf(sb, "\nDrift detected: %d modified, %d only in source, %d only in target, %d unchanged.",
+			counts[statusModified], counts[statusOnlyInSource], counts[statusOnlyInTarget], counts[statusUnchanged])
+	} else {
+		fmt.Fprintf(sb, "\nNo drift detected: %d resources compared.", len(result.Resources))
+	}
+
+	return sb.String()
+}
+
+func writeChange(sb *strings.Builder, change preview.PropertyChange) {
+	switch change.Kind {
+	case preview.ChangeAdded:
+		fmt.Fprintf(sb, "      + %s: %s\n", change.Path, formatValue(change.After))
+	case preview.ChangeRemoved:
+		fmt.Fprintf(sb, "      - %s: %s\n", change.Path, formatValue(change.Before))
+	default:
+		fmt.Fprintf(sb, "      ~ %s: %s => %s\n", change.Path, formatValue(change.Before), formatValue(change.After))
+	}
+}
+
+func formatValue(v any) string {
+	if u, ok := v.(preview.Unknown); ok {
+		return u.String()
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"github.com/radius-project/radius/pkg/cli/preview"
+)
+
+// resourceStatus describes how a resource differs between the source and the target of a comparison.
+type resourceStatus string
+
+const (
+	// statusUnchanged means the resource is identical in the source and the target.
+	statusUnchanged resourceStatus = "Unchanged"
+
+	// statusModified means the resource exists in both the source and the target with different properties.
+	statusModified resourceStatus = "Modified"
+
+	// statusOnlyInSource means the resource only exists in the source.
+	statusOnlyInSource resourceStatus = "OnlyInSource"
+
+	// statusOnlyInTarget means the resource only exists in the target.
+	statusOnlyInTarget resourceStatus = "OnlyInTarget"
+)
+
+// comparison is the result of comparing an application with a template or another application.
+//
+// The comparison supports serialization to JSON.
+type comparison struct {
+	// Source describes the application that is compared, for example `application "demo" in resource group "staging"`.
+	Source string `json:"source"`
+
+	// Target describes what the application is compared with.
+	Target string `json:"target"`
+
+	// Drift is true if the source and the target differ.
+	Drift bool `json:"drift"`
+
+	// Resources contains the comparison of each resource, sorted by type and name.
+	Resources []resourceDiff `json:"resources"`
+}
+
+// resourceDiff is the comparison of a single resource.
+type resourceDiff struct {
+	// Type is the resource type.
+	Type string `json:"type"`
+
+	// Name is the resource name.
+	Name string `json:"name"`
+
+	// Status describes how the resource differs.
+	Status resourceStatus `json:"status"`
+
+	// Changes contains the property-level differences, from the source to the target.
+	Changes []preview.PropertyChange `json:"changes,omitempty"`
+}
+
+// snapshotResource is the normalized state of a resource that is used for comparison.
+type snapshotResource struct {
+	// Type is the resource type.
+	Type string
+
+	// Name is the resource name.
+	Name string
+
+	// Properties contains the normalized properties of the resource.
+	Properties map[string]any
+}
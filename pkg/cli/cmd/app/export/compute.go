@@ -0,0 +1,423 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// applicationsType is the resource type of a Radius application.
+	applicationsType = "Applications.Core/applications"
+
+	// secretStoresType is the resource type of a Radius secret store.
+	secretStoresType = "Applications.Core/secretStores"
+
+	// volumesType is the resource type of a Radius volume. Volumes reference secrets by name and don't hold
+	// their values.
+	volumesType = "Applications.Core/volumes"
+
+	// apiVersion is the API version used for the exported resources.
+	apiVersion = "2023-10-01-preview"
+
+	// environmentParameter is the name of the parameter for the environment ID. 'rad deploy' sets it
+	// automatically.
+	environmentParameter = "environment"
+
+	// defaultLocation is the location used for resources that don't have one.
+	defaultLocation = "global"
+)
+
+// readOnlyProperties are set by the server and can't be deployed. The properties listed for the empty
+// type apply to every resource type.
+var readOnlyProperties = map[string][]string{
+	"":                                {"provisioningState", "status"},
+	"applications.core/gateways":      {"url"},
+	"applications.core/httproutes":    {"scheme", "url"},
+	"applications.dapr/pubsubbrokers": {"componentName"},
+	"applications.dapr/secretstores":  {"componentName"},
+	"applications.dapr/statestores":   {"componentName"},
+}
+
+// recipeProperties are the properties exported for a resource provisioned by a recipe. The other properties
+// of these resources are outputs of the recipe.
+var recipeProperties = []string{"application", "environment", "recipe", "resourceProvisioning"}
+
+// secretKeyPattern matches the names of properties and environment variables that hold secrets.
+var secretKeyPattern = regexp.MustCompile(`(?i)(password|passwd|secret|token|apikey|api_key|connectionstring|credential|privatekey)`)
+
+// reservedNames can't be used as symbolic names in Bicep.
+var reservedNames = []string{"az", "existing", "false", "for", "func", "if", "import", "in", "metadata", "module", "null", "output", "param", "radius", "resource", "sys", "targetScope", "true", "type", "var"}
+
+// builder reconstructs a template from the resources of an application.
+type builder struct {
+	template      *exportTemplate
+	environmentID string
+
+	// names are the symbolic names in use, lowercased. Parameters and resources share a namespace in Bicep.
+	names map[string]bool
+
+	// symbols maps the lowercased ID of each exported resource to its symbolic name.
+	symbols map[string]string
+
+	// externals maps the lowercased ID of each resource referenced but not exported to its parameter.
+	externals map[string]string
+}
+
+// compute reconstructs a deployable template from an application and its resources.
+//
+// References between the resources (the edges of the application graph) become references between symbols
+// and determine the order of the resources. The environment becomes the 'environment' parameter, and the IDs
+// of other resources the application references, like environment-scoped or cloud resources, become
+// parameters that default to their current value. Secrets become secure parameters without a default value.
+func compute(application generated.GenericResource, applicationResources []generated.GenericResource) *exportTemplate {
+	b := &builder{
+		template:  &exportTemplate{},
+		names:     map[string]bool{},
+		symbols:   map[string]string{},
+		externals: map[string]string{},
+	}
+	for _, name := range reservedNames {
+		b.names[strings.ToLower(name)] = true
+	}
+
+	b.environmentID, _ = application.Properties["environment"].(string)
+	b.template.Parameters = append(b.template.Parameters, exportParameter{
+		Name:        b.uniqueName(environmentParameter),
+		Description: "The ID of the Radius environment to deploy the application to.",
+	})
+
+	sorted := append([]generated.GenericResource{}, applicationResources...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := strings.ToLower(to.String(sorted[i].Type)), strings.ToLower(to.String(sorted[j].Type))
+		if a != b {
+			return a < b
+		}
+		return strings.ToLower(to.String(sorted[i].Name)) < strings.ToLower(to.String(sorted[j].Name))
+	})
+	all := append([]generated.GenericResource{application}, sorted...)
+
+	ids := map[string]string{}
+	for _, resource := range all {
+		symbol := b.uniqueName(symbolName(resource))
+		b.symbols[strings.ToLower(to.String(resource.ID))] = symbol
+		ids[symbol] = to.String(resource.ID)
+	}
+
+	exported := []exportResource{}
+	for _, resource := range all {
+		symbol := b.symbols[strings.ToLower(to.String(resource.ID))]
+		properties := b.properties(resource, symbol)
+
+		location := to.String(resource.Location)
+		if location == "" {
+			location = defaultLocation
+		}
+
+		exported = append(exported, exportResource{
+			Symbol:     symbol,
+			Type:       to.String(resource.Type),
+			Name:       to.String(resource.Name),
+			Location:   location,
+			Properties: properties,
+			DependsOn:  dependencies(properties),
+		})
+	}
+
+	b.template.Resources = orderResources(exported, ids)
+	return b.template
+}
+
+// properties converts the properties of a resource to the properties of an exported resource.
+func (b *builder) properties(resource generated.GenericResource, symbol string) map[string]any {
+	resourceType := to.String(resource.Type)
+	recipe := isRecipeProvisioned(resource.Properties)
+
+	result := map[string]any{}
+	for _, key := range sortedKeys(resource.Properties) {
+		if isReadOnly(resourceType, key) || (recipe && !containsFold(recipeProperties, key)) {
+			continue
+		}
+
+		result[key] = b.value(resourceType, to.String(resource.Name), symbol, []string{key}, resource.Properties[key], isSecretKey(resourceType, key))
+	}
+
+	return result
+}
+
+// value converts a property value. Strings are replaced with references to resources and parameters where
+// needed. If sensitive is true the value holds secrets.
+func (b *builder) value(resourceType string, resourceName string, symbol string, path []string, v any, sensitive bool) any {
+	switch value := v.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for _, key := range sortedKeys(value) {
+			result[key] = b.value(resourceType, resourceName, symbol, append(path[:len(path):len(path)], key), value[key], sensitive || isSecretKey(resourceType, key))
+		}
+		return result
+	case []any:
+		result := make([]any, len(value))
+		for i, item := range value {
+			result[i] = b.value(resourceType, resourceName, symbol, append(path[:len(path):len(path)], fmt.Sprint(i)), item, sensitive)
+		}
+		return result
+	case string:
+		if sensitive || isSecretStoreValue(resourceType, path) {
+			return b.secretParameter(resourceName, symbol, path)
+		}
+		return b.reference(value)
+	}
+
+	return v
+}
+
+// reference replaces a resource ID with a reference to the resource or to a parameter. Other strings are
+// returned unchanged.
+func (b *builder) reference(value string) any {
+	key := strings.ToLower(value)
+	if b.environmentID != "" && key == strings.ToLower(b.environmentID) {
+		return parameterRef{Name: environmentParameter}
+	}
+	if symbol, ok := b.symbols[key]; ok {
+		return resourceRef{Symbol: symbol}
+	}
+	if name, ok := b.externals[key]; ok {
+		return parameterRef{Name: name}
+	}
+
+	parsed, err := resources.ParseResource(value)
+	if err != nil {
+		return value
+	}
+
+	name := b.uniqueName(identifier(parsed.Name(), "id"))
+	b.externals[key] = name
+	b.template.Parameters = append(b.template.Parameters, exportParameter{
+		Name:        name,
+		Description: fmt.Sprintf("The ID of the %s resource %q used by the application.", parsed.Type(), parsed.Name()),
+		Default:     value,
+	})
+
+	return parameterRef{Name: name}
+}
+
+// secretParameter adds a secure parameter for a secret value.
+func (b *builder) secretParameter(resourceName string, symbol string, path []string) any {
+	name := b.uniqueName(identifier(append([]string{symbol}, path...)...))
+	b.template.Parameters = append(b.template.Parameters, exportParameter{
+		Name:        name,
+		Description: fmt.Sprintf("The value of '%s' for the resource %q.", strings.Join(path, "."), resourceName),
+		Secure:      true,
+	})
+
+	return parameterRef{Name: name}
+}
+
+// uniqueName returns the candidate if it is not in use, or the candidate with a numeric suffix.
+func (b *builder) uniqueName(candidate string) string {
+	name := candidate
+	for i := 2; b.names[strings.ToLower(name)]; i++ {
+		name = fmt.Sprintf("%s%d", candidate, i)
+	}
+
+	b.names[strings.ToLower(name)] = true
+	return name
+}
+
+// symbolName returns the preferred symbolic name of a resource.
+func symbolName(resource generated.GenericResource) string {
+	name := identifier(to.String(resource.Name))
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		resourceType := to.String(resource.Type)
+		name = identifier(resourceType[strings.LastIndex(resourceType, "/")+1:], name)
+	}
+	if name == "" {
+		name = "resource"
+	}
+
+	return name
+}
+
+// identifier converts the words in parts to a camelCase identifier, for example "my-app" becomes "myApp" and
+// "DB_PASSWORD" becomes "dbPassword".
+func identifier(parts ...string) string {
+	words := []string{}
+	for _, part := range parts {
+		words = append(words, strings.FieldsFunc(part, func(r rune) bool {
+			return !(r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)))
+		})...)
+	}
+
+	sb := strings.Builder{}
+	for i, word := range words {
+		if strings.ToUpper(word) == word {
+			word = strings.ToLower(word)
+		}
+		if i == 0 {
+			sb.WriteString(strings.ToLower(word[:1]) + word[1:])
+		} else {
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+
+	return sb.String()
+}
+
+// orderResources orders resources so that each resource comes after the resources it depends on, keeping
+// the original order where possible. Radius allows resources to reference each other in a cycle, which a
+// template can't express, so references that would complete a cycle are replaced with the resource ID.
+// ids maps the symbolic name of each resource to its ID.
+func orderResources(exported []exportResource, ids map[string]string) []exportResource {
+	ordered := []exportResource{}
+	done := map[string]bool{}
+	for len(ordered) < len(exported) {
+		next := -1
+		for i, resource := range exported {
+			if done[resource.Symbol] {
+				continue
+			}
+			if next == -1 {
+				next = i
+			}
+
+			ready := true
+			for _, dependency := range resource.DependsOn {
+				if !done[dependency] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				next = i
+				break
+			}
+		}
+
+		resource := exported[next]
+		for _, dependency := range resource.DependsOn {
+			if !done[dependency] && dependency != resource.Symbol {
+				resource.Properties = replaceReference(resource.Properties, dependency, ids[dependency]).(map[string]any)
+			}
+		}
+		resource.DependsOn = dependencies(resource.Properties)
+
+		done[resource.Symbol] = true
+		ordered = append(ordered, resource)
+	}
+
+	return ordered
+}
+
+// replaceReference replaces references to the symbol with the literal ID of the resource.
+func replaceReference(v any, symbol string, id string) any {
+	switch value := v.(type) {
+	case resourceRef:
+		if value.Symbol == symbol {
+			return id
+		}
+	case map[string]any:
+		for k, item := range value {
+			value[k] = replaceReference(item, symbol, id)
+		}
+	case []any:
+		for i, item := range value {
+			value[i] = replaceReference(item, symbol, id)
+		}
+	}
+
+	return v
+}
+
+// dependencies returns the sorted symbolic names of the resources referenced by a value.
+func dependencies(v any) []string {
+	found := map[string]bool{}
+	var visit func(v any)
+	visit = func(v any) {
+		switch value := v.(type) {
+		case resourceRef:
+			found[value.Symbol] = true
+		case map[string]any:
+			for _, item := range value {
+				visit(item)
+			}
+		case []any:
+			for _, item := range value {
+				visit(item)
+			}
+		}
+	}
+	visit(v)
+
+	result := []string{}
+	for symbol := range found {
+		result = append(result, symbol)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func isReadOnly(resourceType string, key string) bool {
+	return containsFold(readOnlyProperties[""], key) || containsFold(readOnlyProperties[strings.ToLower(resourceType)], key)
+}
+
+// isRecipeProvisioned returns true if the resource is provisioned by a recipe.
+func isRecipeProvisioned(properties map[string]any) bool {
+	if _, ok := properties["recipe"]; !ok {
+		return false
+	}
+
+	provisioning, _ := properties["resourceProvisioning"].(string)
+	return !strings.EqualFold(provisioning, "manual")
+}
+
+// isSecretKey returns true if the values of the property hold secrets.
+func isSecretKey(resourceType string, key string) bool {
+	return !strings.EqualFold(resourceType, volumesType) && secretKeyPattern.MatchString(key)
+}
+
+// isSecretStoreValue returns true if the path is the value of a secret in a secret store, for example
+// "data.password.value".
+func isSecretStoreValue(resourceType string, path []string) bool {
+	return strings.EqualFold(resourceType, secretStoresType) && len(path) == 3 && path[0] == "data" && path[2] == "value"
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	scope         = "/planes/radius/local/resourceGroups/test-group"
+	environmentID = scope + "/providers/Applications.Core/environments/test-env"
+	applicationID = scope + "/providers/Applications.Core/applications/demo"
+)
+
+func resource(resourceType string, name string, properties map[string]any) generated.GenericResource {
+	return generated.GenericResource{
+		ID:         to.Ptr(scope + "/providers/" + resourceType + "/" + name),
+		Name:       to.Ptr(name),
+		Type:       to.Ptr(resourceType),
+		Location:   to.Ptr("global"),
+		Properties: properties,
+	}
+}
+
+func testApplication() generated.GenericResource {
+	return resource("Applications.Core/applications", "demo", map[string]any{
+		"environment":       environmentID,
+		"provisioningState": "Succeeded",
+		"status":            map[string]any{"compute": map[string]any{"kind": "kubernetes"}},
+	})
+}
+
+func Test_Compute(t *testing.T) {
+	mongoID := scope + "/providers/Applications.Datastores/mongoDatabases/shared-mongo"
+	resources := []generated.GenericResource{
+		resource("Applications.Datastores/redisCaches", "cache", map[string]any{
+			"application":          applicationID,
+			"environment":          environmentID,
+			"resourceProvisioning": "recipe",
+			"recipe":               map[string]any{"name": "default"},
+			"host":                 "cache.svc.cluster.local",
+			"port":                 float64(6379),
+		}),
+		resource("Applications.Core/containers", "front-end", map[string]any{
+			"application": applicationID,
+			"container": map[string]any{
+				"image": "frontend:v1",
+				"env": map[string]any{
+					"DB_PASSWORD": "hunter2",
+					"LOG_LEVEL":   "debug",
+				},
+			},
+			"connections": map[string]any{
+				"cache": map[string]any{"source": scope + "/providers/Applications.Datastores/redisCaches/cache"},
+				"mongo": map[string]any{"source": mongoID},
+				"other": map[string]any{"source": mongoID},
+			},
+			"provisioningState": "Succeeded",
+		}),
+		resource("Applications.Core/httpRoutes", "route", map[string]any{
+			"application": applicationID,
+			"port":        float64(80),
+			"scheme":      "http",
+			"url":         "http://route:80",
+		}),
+	}
+
+	template := compute(testApplication(), resources)
+
+	require.Equal(t, []exportParameter{
+		{Name: "environment", Description: "The ID of the Radius environment to deploy the application to."},
+		{Name: "sharedMongoId", Description: `The ID of the Applications.Datastores/mongoDatabases resource "shared-mongo" used by the application.`, Default: mongoID},
+		{Name: "frontEndContainerEnvDbPassword", Description: `The value of 'container.env.DB_PASSWORD' for the resource "front-end".`, Secure: true},
+	}, template.Parameters)
+
+	require.Equal(t, []exportResource{
+		{
+			Symbol:     "demo",
+			Type:       "Applications.Core/applications",
+			Name:       "demo",
+			Location:   "global",
+			Properties: map[string]any{"environment": parameterRef{Name: "environment"}},
+			DependsOn:  []string{},
+		},
+		{
+			Symbol:   "route",
+			Type:     "Applications.Core/httpRoutes",
+			Name:     "route",
+			Location: "global",
+			Properties: map[string]any{
+				"application": resourceRef{Symbol: "demo"},
+				"port":        float64(80),
+			},
+			DependsOn: []string{"demo"},
+		},
+		{
+			Symbol:   "cache",
+			Type:     "Applications.Datastores/redisCaches",
+			Name:     "cache",
+			Location: "global",
+			Properties: map[string]any{
+				"application":          resourceRef{Symbol: "demo"},
+				"environment":          parameterRef{Name: "environment"},
+				"resourceProvisioning": "recipe",
+				"recipe":               map[string]any{"name": "default"},
+			},
+			DependsOn: []string{"demo"},
+		},
+		{
+			Symbol:   "frontEnd",
+			Type:     "Applications.Core/containers",
+			Name:     "front-end",
+			Location: "global",
+			Properties: map[string]any{
+				"application": resourceRef{Symbol: "demo"},
+				"container": map[string]any{
+					"image": "frontend:v1",
+					"env": map[string]any{
+						"DB_PASSWORD": parameterRef{Name: "frontEndContainerEnvDbPassword"},
+						"LOG_LEVEL":   "debug",
+					},
+				},
+				"connections": map[string]any{
+					"cache": map[string]any{"source": resourceRef{Symbol: "cache"}},
+					"mongo": map[string]any{"source": parameterRef{Name: "sharedMongoId"}},
+					"other": map[string]any{"source": parameterRef{Name: "sharedMongoId"}},
+				},
+			},
+			DependsOn: []string{"cache", "demo"},
+		},
+	}, template.Resources)
+}
+
+func Test_Compute_Cycle(t *testing.T) {
+	container := func(name string, connection string) generated.GenericResource {
+		return resource("Applications.Core/containers", name, map[string]any{
+			"application": applicationID,
+			"container":   map[string]any{"image": name},
+			"connections": map[string]any{
+				connection: map[string]any{"source": scope + "/providers/Applications.Core/containers/" + connection},
+			},
+		})
+	}
+
+	template := compute(testApplication(), []generated.GenericResource{container("a", "b"), container("b", "a")})
+	require.Len(t, template.Resources, 3)
+
+	// The reference from 'a' to 'b' would complete a cycle, so it is replaced with the ID of 'b'.
+	a := template.Resources[1]
+	require.Equal(t, "a", a.Symbol)
+	require.Equal(t, []string{"demo"}, a.DependsOn)
+	require.Equal(t, scope+"/providers/Applications.Core/containers/b", a.Properties["connections"].(map[string]any)["b"].(map[string]any)["source"])
+
+	b := template.Resources[2]
+	require.Equal(t, "b", b.Symbol)
+	require.Equal(t, []string{"a", "demo"}, b.DependsOn)
+}
+
+func Test_Compute_SecretStoreAndVolume(t *testing.T) {
+	resources := []generated.GenericResource{
+		resource("Applications.Core/secretStores", "secrets", map[string]any{
+			"application": applicationID,
+			"type":        "generic",
+			"data": map[string]any{
+				"username": map[string]any{"value": "admin", "encoding": "raw"},
+			},
+		}),
+		resource("Applications.Core/volumes", "vault", map[string]any{
+			"application": applicationID,
+			"kind":        "azure.com.keyvault",
+			"secrets": map[string]any{
+				"password": map[string]any{"name": "db-password"},
+			},
+		}),
+	}
+
+	template := compute(testApplication(), resources)
+	require.Len(t, template.Parameters, 2)
+	require.Equal(t, "secretsDataUsernameValue", template.Parameters[1].Name)
+	require.True(t, template.Parameters[1].Secure)
+
+	secretStore := template.Resources[1]
+	require.Equal(t, "secrets", secretStore.Symbol)
+	require.Equal(t, map[string]any{"value": parameterRef{Name: "secretsDataUsernameValue"}, "encoding": "raw"}, secretStore.Properties["data"].(map[string]any)["username"])
+
+	// Volumes reference secrets by name, the names are not secret.
+	volume := template.Resources[2]
+	require.Equal(t, map[string]any{"password": map[string]any{"name": "db-password"}}, volume.Properties["secrets"])
+}
+
+func Test_Identifier(t *testing.T) {
+	tests := []struct {
+		parts    []string
+		expected string
+	}{
+		{[]string{"my-app"}, "myApp"},
+		{[]string{"frontend"}, "frontend"},
+		{[]string{"web", "container", "env", "DB_PASSWORD"}, "webContainerEnvDbPassword"},
+		{[]string{"MyApp"}, "myApp"},
+		{[]string{"a.b_c"}, "aBC"},
+		{[]string{"---"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			require.Equal(t, tt.expected, identifier(tt.parts...))
+		})
+	}
+}
+
+func Test_SymbolName(t *testing.T) {
+	require.Equal(t, "frontEnd", symbolName(resource("Applications.Core/containers", "front-end", nil)))
+	require.Equal(t, "containers1web", symbolName(resource("Applications.Core/containers", "1web", nil)))
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fileFlag   = "file"
+	formatFlag = "format"
+
+	formatBicep = "bicep"
+	formatJSON  = "json"
+)
+
+// NewCommand creates an instance of the `rad app export` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "export [application]",
+		Short: "Export a deployed Radius Application as a Bicep or ARM JSON template",
+		Long: `Export a deployed Radius Application as a Bicep or ARM JSON template.
+
+The application and its resources are read from the server and written as a template that can be deployed with
+'rad deploy', for example to recreate the application in another environment or to bring an application that was
+created without a template under source control.
+
+References between the resources of the application become references between the resources of the template. The
+environment becomes the 'environment' parameter, which 'rad deploy' sets automatically, and the IDs of other
+resources the application uses become parameters that default to their current value. Secrets are not exported:
+they become secure parameters that must be provided when the template is deployed.
+
+Properties set by the server are not exported. For resources provisioned by a recipe only the recipe is exported,
+because the other properties are outputs of the recipe.
+
+The template is written to standard output unless '--file' is set. The format is Bicep unless '--format' is set
+or the file has a '.json' extension.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Export the current application as Bicep
+rad app export
+
+# Export an application to a Bicep file
+rad app export my-app --file app.bicep
+
+# Export an application to an ARM JSON file
+rad app export my-app --file app.json
+
+# Export an application in a specific resource group as ARM JSON
+rad app export my-app --group my-group --format json
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	cmd.Flags().StringP(fileFlag, "f", "", "The file to write the template to. Defaults to standard output")
+	cmd.Flags().String(formatFlag, "", "The format of the template: 'bicep' or 'json'. Defaults to the format matching the file extension, or 'bicep'")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad app export` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	FilePath        string
+	Format          string
+}
+
+// NewRunner creates an instance of the runner for the `rad app export` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad app export` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	r.FilePath, err = cmd.Flags().GetString(fileFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Format, err = cmd.Flags().GetString(formatFlag)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case r.Format == "" && strings.EqualFold(filepath.Ext(r.FilePath), ".json"):
+		r.Format = formatJSON
+	case r.Format == "":
+		r.Format = formatBicep
+	case r.Format != formatBicep && r.Format != formatJSON:
+		return clierrors.Message("The format %q is not supported. Supported formats are %q and %q.", r.Format, formatBicep, formatJSON)
+	}
+
+	return nil
+}
+
+// Run runs the `rad app export` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	application, err := client.ShowResource(ctx, applicationsType, r.ApplicationName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The application %q was not found or has been deleted.", r.ApplicationName)
+	} else if err != nil {
+		return err
+	}
+
+	applicationResources, err := client.ListAllResourcesByApplication(ctx, r.ApplicationName)
+	if err != nil {
+		return err
+	}
+
+	template := compute(application, applicationResources)
+
+	var content []byte
+	if r.Format == formatJSON {
+		content, err = renderJSON(template)
+		if err != nil {
+			return err
+		}
+	} else {
+		content = []byte(renderBicep(template))
+	}
+
+	if r.FilePath == "" {
+		r.Output.LogInfo("%s", strings.TrimSuffix(string(content), "\n"))
+		return nil
+	}
+
+	err = os.WriteFile(r.FilePath, content, 0644)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to write the template to %q.", r.FilePath)
+	}
+
+	r.Output.LogInfo("Exported application %q with %d resources to %q.", r.ApplicationName, len(template.Resources), r.FilePath)
+
+	secure := 0
+	for _, parameter := range template.Parameters {
+		if parameter.Secure {
+			secure++
+		}
+	}
+	if secure > 0 {
+		r.Output.LogInfo("Secrets were not exported. Provide values for the %d secure parameters when deploying the template.", secure)
+	}
+
+	return nil
+}
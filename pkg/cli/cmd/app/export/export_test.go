@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "rad app export to stdout",
+			Input:         []string{"test-app"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, obj framework.Runner) {
+				runner := obj.(*Runner)
+				require.Equal(t, "test-app", runner.ApplicationName)
+				require.Equal(t, "", runner.FilePath)
+				require.Equal(t, formatBicep, runner.Format)
+			},
+		},
+		{
+			Name:          "rad app export to json file",
+			Input:         []string{"test-app", "--file", "app.JSON"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, obj framework.Runner) {
+				runner := obj.(*Runner)
+				require.Equal(t, "app.JSON", runner.FilePath)
+				require.Equal(t, formatJSON, runner.Format)
+			},
+		},
+		{
+			Name:          "rad app export with format",
+			Input:         []string{"-a", "test-app", "-f", "template.txt", "--format", "json"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, obj framework.Runner) {
+				runner := obj.(*Runner)
+				require.Equal(t, formatJSON, runner.Format)
+			},
+		},
+		{
+			Name:          "rad app export with unsupported format",
+			Input:         []string{"test-app", "--format", "yaml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "rad app export without application",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "rad app export with too many args",
+			Input:         []string{"a", "b"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	setup := func(t *testing.T) *clients.MockApplicationsManagementClient {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			ShowResource(gomock.Any(), "Applications.Core/applications", "demo").
+			Return(testApplication(), nil).
+			Times(1)
+		client.EXPECT().
+			ListAllResourcesByApplication(gomock.Any(), "demo").
+			Return([]generated.GenericResource{
+				resource("Applications.Core/containers", "web", map[string]any{
+					"application": applicationID,
+					"container":   map[string]any{"image": "web:v1", "env": map[string]any{"PASSWORD": "secret"}},
+				}),
+			}, nil).
+			Times(1)
+		return client
+	}
+
+	t.Run("Bicep to stdout", func(t *testing.T) {
+		client := setup(t)
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: scope},
+			ApplicationName:   "demo",
+			Format:            formatBicep,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 1)
+		log := outputSink.Writes[0].(output.LogOutput)
+		require.Equal(t, "%s", log.Format)
+		require.Contains(t, log.Params[0], "resource web 'Applications.Core/containers@2023-10-01-preview' = {")
+		require.Contains(t, log.Params[0], "PASSWORD: webContainerEnvPassword")
+	})
+
+	t.Run("JSON to file", func(t *testing.T) {
+		client := setup(t)
+		outputSink := &output.MockOutput{}
+		file := filepath.Join(t.TempDir(), "app.json")
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: scope},
+			ApplicationName:   "demo",
+			FilePath:          file,
+			Format:            formatJSON,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		require.Contains(t, string(content), `"application": "[reference('demo').id]"`)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Exported application %q with %d resources to %q.",
+				Params: []any{"demo", 2, file},
+			},
+			output.LogOutput{
+				Format: "Secrets were not exported. Provide values for the %d secure parameters when deploying the template.",
+				Params: []any{1},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Application not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			ShowResource(gomock.Any(), "Applications.Core/applications", "demo").
+			Return(generated.GenericResource{}, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Scope: scope},
+			ApplicationName:   "demo",
+			Format:            formatBicep,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The application %q was not found or has been deleted.", "demo"), err)
+	})
+}
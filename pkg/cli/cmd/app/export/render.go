@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// bicepIndent is the indentation of a nested Bicep object or array.
+	bicepIndent = "  "
+
+	// armSchema is the schema of an ARM JSON template.
+	armSchema = "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#"
+
+	// armLanguageVersion is the language version of an ARM JSON template that uses symbolic names and
+	// imports, as produced by the Bicep compiler for Radius templates.
+	armLanguageVersion = "1.9-experimental"
+)
+
+// bicepIdentifierPattern matches property names that don't need to be quoted in Bicep.
+var bicepIdentifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// renderBicep renders the template as a Bicep file.
+func renderBicep(template *exportTemplate) string {
+	sb := &strings.Builder{}
+	sb.WriteString("import radius as radius\n")
+
+	for _, parameter := range template.Parameters {
+		sb.WriteString("\n")
+		if parameter.Secure {
+			sb.WriteString("@secure()\n")
+		}
+		fmt.Fprintf(sb, "@description(%s)\n", bicepString(parameter.Description))
+		fmt.Fprintf(sb, "param %s string", parameter.Name)
+		if parameter.Default != nil {
+			sb.WriteString(" = ")
+			writeBicepValue(sb, parameter.Default, "")
+		}
+		sb.WriteString("\n")
+	}
+
+	for _, resource := range template.Resources {
+		sb.WriteString("\n")
+		fmt.Fprintf(sb, "resource %s %s = {\n", resource.Symbol, bicepString(resource.Type+"@"+apiVersion))
+		fmt.Fprintf(sb, "%sname: %s\n", bicepIndent, bicepString(resource.Name))
+		fmt.Fprintf(sb, "%slocation: %s\n", bicepIndent, bicepString(resource.Location))
+		fmt.Fprintf(sb, "%sproperties: ", bicepIndent)
+		writeBicepValue(sb, resource.Properties, bicepIndent)
+		sb.WriteString("\n}\n")
+	}
+
+	return sb.String()
+}
+
+func writeBicepValue(sb *strings.Builder, v any, indent string) {
+	switch value := v.(type) {
+	case parameterRef:
+		sb.WriteString(value.Name)
+	case resourceRef:
+		sb.WriteString(value.Symbol + ".id")
+	case string:
+		sb.WriteString(bicepString(value))
+	case bool:
+		sb.WriteString(strconv.FormatBool(value))
+	case float64:
+		if value == math.Trunc(value) && math.Abs(value) < 1<<53 {
+			sb.WriteString(strconv.FormatInt(int64(value), 10))
+		} else {
+			// Bicep doesn't have literals for fractional numbers.
+			fmt.Fprintf(sb, "json(%s)", bicepString(strconv.FormatFloat(value, 'f', -1, 64)))
+		}
+	case map[string]any:
+		if len(value) == 0 {
+			sb.WriteString("{}")
+			return
+		}
+
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		sb.WriteString("{\n")
+		for _, key := range keys {
+			name := key
+			if !bicepIdentifierPattern.MatchString(key) {
+				name = bicepString(key)
+			}
+			fmt.Fprintf(sb, "%s%s%s: ", indent, bicepIndent, name)
+			writeBicepValue(sb, value[key], indent+bicepIndent)
+			sb.WriteString("\n")
+		}
+		sb.WriteString(indent + "}")
+	case []any:
+		if len(value) == 0 {
+			sb.WriteString("[]")
+			return
+		}
+
+		sb.WriteString("[\n")
+		for _, item := range value {
+			sb.WriteString(indent + bicepIndent)
+			writeBicepValue(sb, item, indent+bicepIndent)
+			sb.WriteString("\n")
+		}
+		sb.WriteString(indent + "]")
+	case nil:
+		sb.WriteString("null")
+	default:
+		// Numbers decoded with json.Number and other scalar values.
+		sb.WriteString(fmt.Sprint(value))
+	}
+}
+
+// bicepString quotes a string as a Bicep string literal.
+func bicepString(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `'`, `\'`, "${", `\${`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return "'" + replacer.Replace(s) + "'"
+}
+
+// renderJSON renders the template as an ARM JSON template, in the format the Bicep compiler produces for
+// Radius templates.
+func renderJSON(template *exportTemplate) ([]byte, error) {
+	parameters := map[string]any{}
+	for _, parameter := range template.Parameters {
+		p := map[string]any{
+			"type":     "string",
+			"metadata": map[string]any{"description": parameter.Description},
+		}
+		if parameter.Secure {
+			p["type"] = "securestring"
+		}
+		if parameter.Default != nil {
+			p["defaultValue"] = armValue(parameter.Default)
+		}
+		parameters[parameter.Name] = p
+	}
+
+	resources := map[string]any{}
+	for _, resource := range template.Resources {
+		r := map[string]any{
+			"import": "radius",
+			"type":   resource.Type + "@" + apiVersion,
+			"properties": map[string]any{
+				"name":       armValue(resource.Name),
+				"location":   armValue(resource.Location),
+				"properties": armValue(resource.Properties),
+			},
+		}
+		if len(resource.DependsOn) > 0 {
+			r["dependsOn"] = resource.DependsOn
+		}
+		resources[resource.Symbol] = r
+	}
+
+	document := map[string]any{
+		"$schema":         armSchema,
+		"languageVersion": armLanguageVersion,
+		"contentVersion":  "1.0.0.0",
+		"imports": map[string]any{
+			"radius": map[string]any{"provider": "Radius", "version": "latest"},
+		},
+		"parameters": parameters,
+		"resources":  resources,
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(document)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// armValue converts references to ARM template expressions.
+func armValue(v any) any {
+	switch value := v.(type) {
+	case parameterRef:
+		return fmt.Sprintf("[parameters('%s')]", value.Name)
+	case resourceRef:
+		return fmt.Sprintf("[reference('%s').id]", value.Symbol)
+	case string:
+		// Strings in brackets are expressions in ARM templates, a second bracket escapes them.
+		if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+			return "[" + value
+		}
+		return value
+	case map[string]any:
+		result := map[string]any{}
+		for k, item := range value {
+			result[k] = armValue(item)
+		}
+		return result
+	case []any:
+		result := make([]any, len(value))
+		for i, item := range value {
+			result[i] = armValue(item)
+		}
+		return result
+	}
+
+	return v
+}
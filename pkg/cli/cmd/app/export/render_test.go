@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/preview"
+	"github.com/stretchr/testify/require"
+)
+
+func testTemplate() *exportTemplate {
+	return compute(testApplication(), []generated.GenericResource{
+		resource("Applications.Core/containers", "web", map[string]any{
+			"application": applicationID,
+			"container": map[string]any{
+				"image":   "web:v1",
+				"command": []any{"/bin/sh"},
+				"args":    []any{"-c", "echo 'hello ${USER}'"},
+				"env": map[string]any{
+					"API_TOKEN": "abc",
+				},
+				"ports": map[string]any{
+					"http-web": map[string]any{"containerPort": float64(8080)},
+				},
+			},
+			"connections": map[string]any{
+				"db": map[string]any{"source": scope + "/providers/Applications.Datastores/sqlDatabases/db"},
+			},
+			"extensions": []any{},
+		}),
+		resource("Applications.Datastores/sqlDatabases", "db", map[string]any{
+			"application":          applicationID,
+			"environment":          environmentID,
+			"resourceProvisioning": "manual",
+			"server":               "[server]",
+			"port":                 float64(1433),
+			"scale":                1.5,
+		}),
+	})
+}
+
+func Test_RenderBicep(t *testing.T) {
+	expected := `import radius as radius
+
+@description('The ID of the Radius environment to deploy the application to.')
+param environment string
+
+@secure()
+@description('The value of \'container.env.API_TOKEN\' for the resource "web".')
+param webContainerEnvApiToken string
+
+resource demo 'Applications.Core/applications@2023-10-01-preview' = {
+  name: 'demo'
+  location: 'global'
+  properties: {
+    environment: environment
+  }
+}
+
+resource db 'Applications.Datastores/sqlDatabases@2023-10-01-preview' = {
+  name: 'db'
+  location: 'global'
+  properties: {
+    application: demo.id
+    environment: environment
+    port: 1433
+    resourceProvisioning: 'manual'
+    scale: json('1.5')
+    server: '[server]'
+  }
+}
+
+resource web 'Applications.Core/containers@2023-10-01-preview' = {
+  name: 'web'
+  location: 'global'
+  properties: {
+    application: demo.id
+    connections: {
+      db: {
+        source: db.id
+      }
+    }
+    container: {
+      args: [
+        '-c'
+        'echo \'hello \${USER}\''
+      ]
+      command: [
+        '/bin/sh'
+      ]
+      env: {
+        API_TOKEN: webContainerEnvApiToken
+      }
+      image: 'web:v1'
+      ports: {
+        'http-web': {
+          containerPort: 8080
+        }
+      }
+    }
+    extensions: []
+  }
+}
+`
+	require.Equal(t, expected, renderBicep(testTemplate()))
+}
+
+func Test_RenderJSON(t *testing.T) {
+	content, err := renderJSON(testTemplate())
+	require.NoError(t, err)
+
+	document := map[string]any{}
+	err = json.Unmarshal(content, &document)
+	require.NoError(t, err)
+
+	web := document["resources"].(map[string]any)["web"].(map[string]any)
+	require.Equal(t, []any{"db", "demo"}, web["dependsOn"])
+	require.Equal(t, "Applications.Core/containers@2023-10-01-preview", web["type"])
+
+	parameters := document["parameters"].(map[string]any)
+	require.Equal(t, "securestring", parameters["webContainerEnvApiToken"].(map[string]any)["type"])
+
+	// The template evaluates to the exported resources.
+	resources, err := preview.Evaluate(document, preview.EvaluateOptions{
+		Scope: scope,
+		Parameters: clients.DeploymentParameters{
+			"environment":             {"value": environmentID},
+			"webContainerEnvApiToken": {"value": "abc"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resources, 3)
+
+	byName := map[string]preview.Resource{}
+	for _, resource := range resources {
+		byName[resource.Name] = resource
+	}
+
+	db := byName["db"].Body["properties"].(map[string]any)
+	require.Equal(t, applicationID, db["application"])
+	require.Equal(t, environmentID, db["environment"])
+	require.Equal(t, "[server]", db["server"])
+
+	container := byName["web"].Body["properties"].(map[string]any)
+	require.Equal(t, scope+"/providers/Applications.Datastores/sqlDatabases/db", container["connections"].(map[string]any)["db"].(map[string]any)["source"])
+	require.Equal(t, "echo 'hello ${USER}'", container["container"].(map[string]any)["args"].([]any)[1])
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+// exportTemplate is a deployable template reconstructed from a running application. It is rendered as
+// Bicep or as ARM JSON.
+type exportTemplate struct {
+	// Parameters are the parameters of the template, in the order they are declared.
+	Parameters []exportParameter
+
+	// Resources are the resources of the template, ordered so that each resource comes after the
+	// resources it depends on.
+	Resources []exportResource
+}
+
+// exportParameter is a parameter of an exported template.
+type exportParameter struct {
+	// Name is the name of the parameter.
+	Name string
+
+	// Description describes the parameter.
+	Description string
+
+	// Secure is true if the parameter holds a secret. Secure parameters never have a default value.
+	Secure bool
+
+	// Default is the default value of the parameter, or nil if the parameter is required.
+	Default any
+}
+
+// exportResource is a resource of an exported template.
+type exportResource struct {
+	// Symbol is the symbolic name of the resource in the template.
+	Symbol string
+
+	// Type is the resource type, for example 'Applications.Core/containers'.
+	Type string
+
+	// Name is the name of the resource.
+	Name string
+
+	// Location is the location of the resource.
+	Location string
+
+	// Properties are the properties of the resource. Values can be parameterRef or resourceRef.
+	Properties map[string]any
+
+	// DependsOn are the symbolic names of the resources this resource references.
+	DependsOn []string
+}
+
+// parameterRef is a property value that references a parameter of the template.
+type parameterRef struct {
+	Name string
+}
+
+// resourceRef is a property value that references the ID of another resource in the template.
+type resourceRef struct {
+	Symbol string
+}
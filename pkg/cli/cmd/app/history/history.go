@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad app history` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "history [application]",
+		Short: "List the revisions of a Radius Application",
+		Long: `List the revisions of a Radius Application.
+
+A revision is recorded each time the resources of an application change, and each time it is rolled back. Only the most recent revisions are kept. Use 'rad app rollback' to return an application to a previous revision.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# List the revisions of the current application
+rad app history
+
+# List the revisions of a specified application
+rad app history my-app
+
+# List the revisions of a specified application in a specified resource group
+rad app history my-app --group my-group
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad app history` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Workspace         *workspaces.Workspace
+	Output            output.Interface
+
+	ApplicationName string
+	Format          string
+}
+
+// revision is the table view of an application revision.
+type revision struct {
+	Revision      int32
+	Created       string
+	Reason        string
+	State         string
+	ResourceCount int
+}
+
+// NewRunner creates an instance of the runner for the `rad app history` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad app history` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad app history` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	revisions, err := client.ListApplicationRevisions(ctx, r.ApplicationName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The application %q was not found or has been deleted.", r.ApplicationName)
+	} else if err != nil {
+		return err
+	}
+
+	if r.Format != output.FormatTable {
+		return r.Output.WriteFormatted(r.Format, revisions, objectformats.GetApplicationRevisionsTableFormat())
+	}
+
+	if len(revisions) == 0 {
+		r.Output.LogInfo("No revisions have been recorded for application %q.", r.ApplicationName)
+		return nil
+	}
+
+	views := []revision{}
+	for _, item := range revisions {
+		views = append(views, toView(item))
+	}
+
+	return r.Output.WriteFormatted(r.Format, views, objectformats.GetApplicationRevisionsTableFormat())
+}
+
+func toView(item corerp.ApplicationRevision) revision {
+	view := revision{
+		ResourceCount: len(item.Resources),
+	}
+	if item.Revision != nil {
+		view.Revision = *item.Revision
+	}
+	if item.CreatedAt != nil {
+		view.Created = item.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	if item.Reason != nil {
+		view.Reason = string(*item.Reason)
+		if *item.Reason == corerp.ApplicationRevisionReasonRollback && item.RolledBackFrom != nil {
+			view.Reason = fmt.Sprintf("%s (from %d)", view.Reason, *item.RolledBackFrom)
+		}
+	}
+	if item.ProvisioningState != nil {
+		view.State = string(*item.ProvisioningState)
+	}
+
+	return view
+}
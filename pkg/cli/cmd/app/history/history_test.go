@@ -0,0 +1,235 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "History Command with default application",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspaceAndApplication(t),
+			},
+		},
+		{
+			Name:          "History Command with positional arg",
+			Input:         []string{"test-app"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         config,
+			},
+		},
+		{
+			Name:          "History Command without application",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         config,
+			},
+		},
+		{
+			Name:          "History Command with incorrect args",
+			Input:         []string{"foo", "bar"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         config,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	revisions := []corerp.ApplicationRevision{
+		{
+			Revision:          to.Ptr(int32(3)),
+			CreatedAt:         to.Ptr(createdAt.Add(time.Hour)),
+			Reason:            to.Ptr(corerp.ApplicationRevisionReasonRollback),
+			RolledBackFrom:    to.Ptr(int32(2)),
+			ProvisioningState: to.Ptr(corerp.ProvisioningStateSucceeded),
+			Resources:         []*corerp.ApplicationRevisionResource{{Name: to.Ptr("frontend")}},
+		},
+		{
+			Revision:          to.Ptr(int32(2)),
+			CreatedAt:         to.Ptr(createdAt),
+			Reason:            to.Ptr(corerp.ApplicationRevisionReasonDeployment),
+			ProvisioningState: to.Ptr(corerp.ProvisioningStateSucceeded),
+			Resources:         []*corerp.ApplicationRevisionResource{{Name: to.Ptr("frontend")}, {Name: to.Ptr("backend")}},
+		},
+	}
+
+	t.Run("Success: table", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListApplicationRevisions(gomock.Any(), "test-app").
+			Return(revisions, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Format:            "table",
+			Output:            outputSink,
+			ApplicationName:   "test-app",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []revision{
+					{Revision: 3, Created: "2024-01-02T04:04:05Z", Reason: "Rollback (from 2)", State: "Succeeded", ResourceCount: 1},
+					{Revision: 2, Created: "2024-01-02T03:04:05Z", Reason: "Deployment", State: "Succeeded", ResourceCount: 2},
+				},
+				Options: objectformats.GetApplicationRevisionsTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: json", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListApplicationRevisions(gomock.Any(), "test-app").
+			Return(revisions, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Format:            "json",
+			Output:            outputSink,
+			ApplicationName:   "test-app",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     revisions,
+				Options: objectformats.GetApplicationRevisionsTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: no revisions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListApplicationRevisions(gomock.Any(), "test-app").
+			Return([]corerp.ApplicationRevision{}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Format:            "table",
+			Output:            outputSink,
+			ApplicationName:   "test-app",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No revisions have been recorded for application %q.",
+				Params: []any{"test-app"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: Application Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListApplicationRevisions(gomock.Any(), "test-app").
+			Return(nil, radcli.Create404Error()).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Format:            "table",
+			Output:            outputSink,
+			ApplicationName:   "test-app",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The application \"test-app\" was not found or has been deleted."), err)
+		require.Empty(t, outputSink.Writes)
+	})
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollback
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+const (
+	revisionFlag         = "revision"
+	deleteResourcesFlag  = "delete-resources"
+	rollbackConfirmation = "Are you sure you want to roll back application '%v' to revision %d?"
+	notFoundMessage      = "The application %q or its revision %d was not found. Use 'rad app history' to list the revisions of the application."
+)
+
+// NewCommand creates an instance of the `rad app rollback` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "rollback [application]",
+		Short: "Roll back a Radius Application to a previous revision",
+		Long: `Roll back a Radius Application to a previous revision.
+
+Rolling back re-applies the resources recorded in the revision: resources that changed since the revision are restored and resources that were removed are recreated. Resources that were added since the revision are kept unless '--delete-resources' is specified. The resources that are not part of the revision are listed before the rollback starts. Recipes are executed again using the recipes currently registered with the environment. The rollback is recorded as a new revision.
+
+Use 'rad app history' to list the revisions of an application.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Roll back the current application to revision 2
+rad app rollback --revision 2
+
+# Roll back a specified application to revision 2 and bypass the confirmation prompt
+rad app rollback my-app --revision 2 --yes
+
+# Roll back the current application to revision 2 and delete the resources added since revision 2
+rad app rollback --revision 2 --delete-resources
+
+# Roll back a specified application in a specified resource group
+rad app rollback my-app --group my-group --revision 2
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+	cmd.Flags().Int32(revisionFlag, 0, "The revision to roll back to")
+	_ = cmd.MarkFlagRequired(revisionFlag)
+	cmd.Flags().Bool(deleteResourcesFlag, false, "Delete the resources of the application that are not part of the revision")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad app rollback` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	InputPrompter     prompt.Interface
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	Revision        int32
+	DeleteResources bool
+	Confirm         bool
+}
+
+// NewRunner creates an instance of the runner for the `rad app rollback` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		InputPrompter:     factory.GetPrompter(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad app rollback` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Revision, err = cmd.Flags().GetInt32(revisionFlag)
+	if err != nil {
+		return err
+	}
+	if r.Revision < 1 {
+		return clierrors.Message("The revision must be a positive number. Use 'rad app history' to list the revisions of the application.")
+	}
+
+	r.DeleteResources, err = cmd.Flags().GetBool(deleteResourcesFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Confirm, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad app rollback` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	added, err := r.addedResources(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if len(added) > 0 {
+		if r.DeleteResources {
+			r.Output.LogInfo("The following resources are not part of revision %d and will be deleted:", r.Revision)
+		} else {
+			r.Output.LogInfo("The following resources are not part of revision %d and will be kept. Use '--%s' to delete them:", r.Revision, deleteResourcesFlag)
+		}
+		for _, resource := range added {
+			r.Output.LogInfo("  %s %s", to.String(resource.Type), to.String(resource.Name))
+		}
+	}
+
+	if !r.Confirm {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(rollbackConfirmation, r.ApplicationName, r.Revision), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	r.Output.LogInfo("Rolling back application %q to revision %d...", r.ApplicationName, r.Revision)
+
+	err = client.RollbackApplication(ctx, r.ApplicationName, r.Revision, r.DeleteResources)
+	if clients.Is404Error(err) {
+		return clierrors.Message(notFoundMessage, r.ApplicationName, r.Revision)
+	} else if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Application %q was rolled back to revision %d.", r.ApplicationName, r.Revision)
+	return nil
+}
+
+// addedResources returns the resources of the application that are not part of the revision.
+func (r *Runner) addedResources(ctx context.Context, client clients.ApplicationsManagementClient) ([]generated.GenericResource, error) {
+	revisions, err := client.ListApplicationRevisions(ctx, r.ApplicationName)
+	if clients.Is404Error(err) {
+		return nil, clierrors.Message(notFoundMessage, r.ApplicationName, r.Revision)
+	} else if err != nil {
+		return nil, err
+	}
+
+	var revision *corerp.ApplicationRevision
+	for i := range revisions {
+		if to.Int32(revisions[i].Revision) == r.Revision {
+			revision = &revisions[i]
+			break
+		}
+	}
+	if revision == nil {
+		return nil, clierrors.Message(notFoundMessage, r.ApplicationName, r.Revision)
+	}
+
+	ids := map[string]bool{}
+	for _, resource := range revision.Resources {
+		if resource != nil {
+			ids[strings.ToLower(to.String(resource.ID))] = true
+		}
+	}
+
+	applicationResources, err := client.ListAllResourcesByApplication(ctx, r.ApplicationName)
+	if err != nil {
+		return nil, err
+	}
+
+	added := []generated.GenericResource{}
+	for _, resource := range applicationResources {
+		if !ids[strings.ToLower(to.String(resource.ID))] {
+			added = append(added, resource)
+		}
+	}
+
+	return added, nil
+}
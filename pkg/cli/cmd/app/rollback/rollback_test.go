@@ -0,0 +1,292 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Rollback Command with default application",
+			Input:         []string{"--revision", "2"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspaceAndApplication(t),
+			},
+		},
+		{
+			Name:          "Rollback Command with positional arg",
+			Input:         []string{"test-app", "--revision", "2", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         config,
+			},
+		},
+		{
+			Name:          "Rollback Command with delete resources",
+			Input:         []string{"test-app", "--revision", "2", "--delete-resources"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         config,
+			},
+		},
+		{
+			Name:          "Rollback Command without revision",
+			Input:         []string{"test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         config,
+			},
+		},
+		{
+			Name:          "Rollback Command with invalid revision",
+			Input:         []string{"test-app", "--revision", "0"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         config,
+			},
+		},
+		{
+			Name:          "Rollback Command with incorrect args",
+			Input:         []string{"foo", "bar", "--revision", "2"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         config,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	frontendID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend"
+	backendID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/backend"
+
+	// expectResources sets up revision 2 with the frontend container while the application also has a backend container.
+	expectResources := func(appManagementClient *clients.MockApplicationsManagementClient) {
+		appManagementClient.EXPECT().
+			ListApplicationRevisions(gomock.Any(), "test-app").
+			Return([]v20231001preview.ApplicationRevision{
+				{Revision: to.Ptr(int32(2)), Resources: []*v20231001preview.ApplicationRevisionResource{{ID: to.Ptr(frontendID)}}},
+			}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListAllResourcesByApplication(gomock.Any(), "test-app").
+			Return([]generated.GenericResource{
+				{ID: to.Ptr(frontendID), Type: to.Ptr("Applications.Core/containers"), Name: to.Ptr("frontend")},
+				{ID: to.Ptr(backendID), Type: to.Ptr("Applications.Core/containers"), Name: to.Ptr("backend")},
+			}, nil).
+			Times(1)
+	}
+
+	t.Run("Success: confirmed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(rollbackConfirmation, "test-app", 2)).
+			Return(prompt.ConfirmYes, nil).
+			Times(1)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		expectResources(appManagementClient)
+		appManagementClient.EXPECT().
+			RollbackApplication(gomock.Any(), "test-app", int32(2), false).
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			InputPrompter:     promptMock,
+			Workspace:         workspace,
+			Output:            outputSink,
+			ApplicationName:   "test-app",
+			Revision:          2,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "The following resources are not part of revision %d and will be kept. Use '--%s' to delete them:",
+				Params: []any{int32(2), deleteResourcesFlag},
+			},
+			output.LogOutput{
+				Format: "  %s %s",
+				Params: []any{"Applications.Core/containers", "backend"},
+			},
+			output.LogOutput{
+				Format: "Rolling back application %q to revision %d...",
+				Params: []any{"test-app", int32(2)},
+			},
+			output.LogOutput{
+				Format: "Application %q was rolled back to revision %d.",
+				Params: []any{"test-app", int32(2)},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: delete resources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		expectResources(appManagementClient)
+		appManagementClient.EXPECT().
+			RollbackApplication(gomock.Any(), "test-app", int32(2), true).
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Output:            outputSink,
+			ApplicationName:   "test-app",
+			Revision:          2,
+			DeleteResources:   true,
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, output.LogOutput{
+			Format: "The following resources are not part of revision %d and will be deleted:",
+			Params: []any{int32(2)},
+		}, outputSink.Writes[0])
+	})
+
+	t.Run("Success: declined", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(rollbackConfirmation, "test-app", 2)).
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		expectResources(appManagementClient)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			InputPrompter:     promptMock,
+			Workspace:         workspace,
+			Output:            outputSink,
+			ApplicationName:   "test-app",
+			Revision:          2,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Len(t, outputSink.Writes, 2)
+	})
+
+	t.Run("Error: not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListApplicationRevisions(gomock.Any(), "test-app").
+			Return([]v20231001preview.ApplicationRevision{{Revision: to.Ptr(int32(2))}}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Output:            outputSink,
+			ApplicationName:   "test-app",
+			Revision:          7,
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message(notFoundMessage, "test-app", int32(7)), err)
+	})
+
+	t.Run("Error: rollback failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		expectResources(appManagementClient)
+		appManagementClient.EXPECT().
+			RollbackApplication(gomock.Any(), "test-app", int32(2), false).
+			Return(errors.New("rollback failed")).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Output:            &output.MockOutput{},
+			ApplicationName:   "test-app",
+			Revision:          2,
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.EqualError(t, err, "rollback failed")
+	})
+}
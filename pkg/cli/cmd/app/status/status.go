@@ -18,9 +18,11 @@ package status
 
 import (
 	"context"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
@@ -28,10 +30,21 @@ import (
 	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	job_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/jobs"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/spf13/cobra"
 )
 
+const (
+	jobScheduleOnce    = "once"
+	jobStatusRunning   = "Running"
+	jobStatusCompleted = "Completed"
+	jobStatusScheduled = "Scheduled"
+	jobStatusFailed    = "Failed"
+	jobStatusCanceled  = "Canceled"
+)
+
 // NewCommand creates an instance of the `rad app status` command and runner.
 func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
@@ -39,7 +52,7 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Show Radius Application status",
-		Long:  `Show Radius Application status, such as public endpoints and resource count. Shows details for the user's default application (if configured) by default.`,
+		Long:  `Show Radius Application status, such as public endpoints, job status and resource count. Shows details for the user's default application (if configured) by default.`,
 		Args:  cobra.MaximumNArgs(1),
 		Example: `
 # Show status of current application
@@ -168,6 +181,10 @@ func (r *Runner) Run(ctx context.Context) error {
 				Endpoint: *publicEndpoint,
 			})
 		}
+
+		if strings.EqualFold(resourceID.Type(), job_ctrl.ResourceTypeName) {
+			applicationStatus.Jobs = append(applicationStatus.Jobs, getJobStatus(resource))
+		}
 	}
 
 	err = r.Output.WriteFormatted(r.Format, applicationStatus, objectformats.GetApplicationStatusTableFormat())
@@ -185,5 +202,46 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	if r.Format == output.FormatTable && len(applicationStatus.Jobs) > 0 {
+		// Print newline for readability
+		r.Output.LogInfo("")
+
+		err = r.Output.WriteFormatted(r.Format, applicationStatus.Jobs, objectformats.GetApplicationJobsTableFormat())
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// getJobStatus reports the status of an Applications.Core/jobs resource based on its provisioning state. A one-shot
+// job is only provisioned successfully once it has run to completion, while a scheduled job is provisioned as soon as
+// its schedule is registered.
+func getJobStatus(resource generated.GenericResource) clients.JobStatus {
+	schedule, _ := resource.Properties["schedule"].(string)
+	state, _ := resource.Properties["provisioningState"].(string)
+
+	status := jobStatusRunning
+	switch v20231001preview.ProvisioningState(state) {
+	case v20231001preview.ProvisioningStateSucceeded:
+		status = jobStatusCompleted
+		if schedule != "" {
+			status = jobStatusScheduled
+		}
+	case v20231001preview.ProvisioningStateFailed:
+		status = jobStatusFailed
+	case v20231001preview.ProvisioningStateCanceled:
+		status = jobStatusCanceled
+	}
+
+	if schedule == "" {
+		schedule = jobScheduleOnce
+	}
+
+	return clients.JobStatus{
+		Name:     *resource.Name,
+		Schedule: schedule,
+		Status:   status,
+	}
+}
@@ -196,6 +196,111 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expected, outputSink.Writes)
 	})
 
+	t.Run("Success: Application With Jobs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		application := v20231001preview.ApplicationResource{
+			Name: to.Ptr("test-app"),
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+
+		resourceList := []generated.GenericResource{
+			{
+				Name: to.Ptr("test-migration"),
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/jobs/test-migration"),
+				Properties: map[string]any{
+					"provisioningState": "Succeeded",
+				},
+			},
+			{
+				Name: to.Ptr("test-cleanup"),
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/jobs/test-cleanup"),
+				Properties: map[string]any{
+					"provisioningState": "Succeeded",
+					"schedule":          "0 * * * *",
+				},
+			},
+			{
+				Name: to.Ptr("test-import"),
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/jobs/test-import"),
+				Properties: map[string]any{
+					"provisioningState": "Failed",
+				},
+			},
+			{
+				Name: to.Ptr("test-report"),
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/jobs/test-report"),
+				Properties: map[string]any{
+					"provisioningState": "Updating",
+				},
+			},
+		}
+
+		appManagementClient.EXPECT().
+			ListAllResourcesByApplication(gomock.Any(), "test-app").
+			Return(resourceList, nil).
+			Times(1)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			Times(len(resourceList))
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{
+				ApplicationsManagementClient: appManagementClient,
+				DiagnosticsClient:            diagnosticsClient,
+			},
+			Workspace: &workspaces.Workspace{
+				Name:  "kind-kind",
+				Scope: "/planes/radius/local/resourceGroups/test-group",
+			},
+			Format:          "table",
+			Output:          outputSink,
+			ApplicationName: "test-app",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		applicationStatus := clients.ApplicationStatus{
+			Name:          "test-app",
+			ResourceCount: 4,
+			Jobs: []clients.JobStatus{
+				{Name: "test-migration", Schedule: "once", Status: "Completed"},
+				{Name: "test-cleanup", Schedule: "0 * * * *", Status: "Scheduled"},
+				{Name: "test-import", Schedule: "once", Status: "Failed"},
+				{Name: "test-report", Schedule: "once", Status: "Running"},
+			},
+		}
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus,
+				Options: objectformats.GetApplicationStatusTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus.Jobs,
+				Options: objectformats.GetApplicationJobsTableFormat(),
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
 	t.Run("Error: Application Not Found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -0,0 +1,239 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	sinceFlag    = "since"
+	untilFlag    = "until"
+	resourceFlag = "resource"
+)
+
+// NewCommand creates an instance of the `rad audit` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List the audit log of a resource group",
+		Long: `List the audit log of a resource group.
+
+Each create, update, delete, and action request handled by Radius is recorded in the audit log with the identity of the caller, the target resource, and the outcome. Requests that start an asynchronous operation also show the final state of the operation once it completes.
+
+The audit log is only available when the store audit sink is configured for Radius.`,
+		Args: cobra.NoArgs,
+		Example: `
+# List the audit events of the current resource group
+rad audit
+
+# List the audit events of the last hour
+rad audit --since 1h
+
+# List the audit events in a time window
+rad audit --since 2024-01-02T00:00:00Z --until 2024-01-03T00:00:00Z
+
+# List the audit events of a resource and its child resources
+rad audit --resource Applications.Core/applications/my-app
+
+# List the audit events of a specified resource group as JSON
+rad audit --group my-group --output json
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().String(sinceFlag, "", "Only list events recorded at or after this time. Accepts an RFC 3339 timestamp or a duration such as 30m or 24h")
+	cmd.Flags().String(untilFlag, "", "Only list events recorded before this time. Accepts an RFC 3339 timestamp or a duration such as 30m or 24h")
+	cmd.Flags().String(resourceFlag, "", "Only list events of a resource and its child resources. Accepts a resource ID or a resource type and name such as Applications.Core/containers/frontend")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad audit` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Workspace         *workspaces.Workspace
+	Output            output.Interface
+
+	Filter audit.Filter
+	Format string
+
+	// now returns the current time. Durations passed to --since and --until are relative to it.
+	now func() time.Time
+}
+
+// event is the table view of an audit event.
+type event struct {
+	Time      string
+	Principal string
+	Operation string
+	Resource  string
+	Outcome   string
+	State     string
+}
+
+// NewRunner creates an instance of the runner for the `rad audit` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+		now:               time.Now,
+	}
+}
+
+// Validate runs validation for the `rad audit` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+	r.Filter.Scope = scope
+
+	now := r.now
+	if now == nil {
+		now = time.Now
+	}
+
+	since, err := cmd.Flags().GetString(sinceFlag)
+	if err != nil {
+		return err
+	}
+	if r.Filter.Since, err = parseTime(since, now()); err != nil {
+		return clierrors.Message("The value of --%s is invalid: %s", sinceFlag, err.Error())
+	}
+
+	until, err := cmd.Flags().GetString(untilFlag)
+	if err != nil {
+		return err
+	}
+	if r.Filter.Until, err = parseTime(until, now()); err != nil {
+		return clierrors.Message("The value of --%s is invalid: %s", untilFlag, err.Error())
+	}
+
+	if !r.Filter.Since.IsZero() && !r.Filter.Until.IsZero() && !r.Filter.Since.Before(r.Filter.Until) {
+		return clierrors.Message("The value of --%s must be earlier than the value of --%s.", sinceFlag, untilFlag)
+	}
+
+	resource, err := cmd.Flags().GetString(resourceFlag)
+	if err != nil {
+		return err
+	}
+	if resource != "" && !strings.HasPrefix(resource, "/") {
+		resource = scope + "/providers/" + resource
+	}
+	r.Filter.ResourceID = resource
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad audit` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	events, err := client.ListAuditEvents(ctx, r.Filter)
+	if err != nil {
+		return err
+	}
+
+	if r.Format != output.FormatTable {
+		return r.Output.WriteFormatted(r.Format, events, objectformats.GetAuditEventTableFormat())
+	}
+
+	if len(events) == 0 {
+		r.Output.LogInfo("No audit events were found.")
+		return nil
+	}
+
+	views := []event{}
+	for _, item := range events {
+		views = append(views, toView(item))
+	}
+
+	return r.Output.WriteFormatted(r.Format, views, objectformats.GetAuditEventTableFormat())
+}
+
+// parseTime parses an RFC 3339 timestamp, or a duration that is subtracted from now.
+func parseTime(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("%q is not an RFC 3339 timestamp or a positive duration", value)
+	}
+
+	return now.Add(-d), nil
+}
+
+func toView(item audit.Event) event {
+	view := event{
+		Time:      item.Time.UTC().Format(time.RFC3339),
+		Principal: item.Principal,
+		Operation: item.OperationType,
+		Resource:  item.ResourceID,
+		Outcome:   item.Outcome,
+		State:     item.AsyncOperationState,
+	}
+	if view.Principal == "" {
+		view.Principal = "-"
+	}
+
+	return view
+}
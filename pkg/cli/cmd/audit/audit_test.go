@@ -0,0 +1,288 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Audit Command with defaults",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, audit.Filter{Scope: "/planes/radius/local/resourceGroups/test-resource-group"}, runner.Filter)
+			},
+		},
+		{
+			Name:          "Audit Command with filters",
+			Input:         []string{"--group", "other-group", "--since", "2024-01-02T00:00:00Z", "--until", "2024-01-03T00:00:00Z", "--resource", "Applications.Core/applications/my-app"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				expected := audit.Filter{
+					Scope:      "/planes/radius/local/resourceGroups/other-group",
+					ResourceID: "/planes/radius/local/resourceGroups/other-group/providers/Applications.Core/applications/my-app",
+					Since:      time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					Until:      time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				}
+				require.Equal(t, expected, runner.Filter)
+			},
+		},
+		{
+			Name:          "Audit Command with resource ID",
+			Input:         []string{"--resource", "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Core/applications/my-app"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Core/applications/my-app", runner.Filter.ResourceID)
+			},
+		},
+		{
+			Name:          "Audit Command with invalid since",
+			Input:         []string{"--since", "yesterday"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Audit Command with since after until",
+			Input:         []string{"--since", "1h", "--until", "2h"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Audit Command with positional arg",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_parseTime(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	parsed, err := parseTime("", now)
+	require.NoError(t, err)
+	require.True(t, parsed.IsZero())
+
+	parsed, err = parseTime("90m", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 2, 1, 34, 5, 0, time.UTC), parsed)
+
+	parsed, err = parseTime("2024-01-01T00:00:00+02:00", now)
+	require.NoError(t, err)
+	require.True(t, parsed.Equal(time.Date(2023, 12, 31, 22, 0, 0, 0, time.UTC)))
+
+	_, err = parseTime("-1h", now)
+	require.Error(t, err)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+	filter := audit.Filter{Scope: workspace.Scope}
+
+	requestTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	completedAt := requestTime.Add(time.Minute)
+	events := []audit.Event{
+		{
+			ID:                  "1",
+			Time:                requestTime,
+			Kind:                audit.KindRequest,
+			Principal:           "alice",
+			ResourceID:          workspace.Scope + "/providers/Applications.Core/containers/frontend",
+			OperationType:       "APPLICATIONS.CORE/CONTAINERS|PUT",
+			Outcome:             audit.OutcomeAccepted,
+			AsyncOperationID:    "op-1",
+			AsyncOperationState: "Succeeded",
+			CompletedAt:         &completedAt,
+		},
+		{
+			ID:            "2",
+			Time:          requestTime.Add(time.Hour),
+			Kind:          audit.KindRequest,
+			ResourceID:    workspace.Scope + "/providers/Applications.Core/containers/backend",
+			OperationType: "APPLICATIONS.CORE/CONTAINERS|DELETE",
+			Outcome:       audit.OutcomeFailed,
+		},
+	}
+
+	t.Run("Success: table", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListAuditEvents(gomock.Any(), filter).
+			Return(events, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Format:            "table",
+			Output:            outputSink,
+			Filter:            filter,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []event{
+					{
+						Time:      "2024-01-02T03:04:05Z",
+						Principal: "alice",
+						Operation: "APPLICATIONS.CORE/CONTAINERS|PUT",
+						Resource:  workspace.Scope + "/providers/Applications.Core/containers/frontend",
+						Outcome:   "Accepted",
+						State:     "Succeeded",
+					},
+					{
+						Time:      "2024-01-02T04:04:05Z",
+						Principal: "-",
+						Operation: "APPLICATIONS.CORE/CONTAINERS|DELETE",
+						Resource:  workspace.Scope + "/providers/Applications.Core/containers/backend",
+						Outcome:   "Failed",
+					},
+				},
+				Options: objectformats.GetAuditEventTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: json", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListAuditEvents(gomock.Any(), filter).
+			Return(events, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Format:            "json",
+			Output:            outputSink,
+			Filter:            filter,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     events,
+				Options: objectformats.GetAuditEventTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: no events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListAuditEvents(gomock.Any(), filter).
+			Return([]audit.Event{}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Format:            "table",
+			Output:            outputSink,
+			Filter:            filter,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No audit events were found.",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListAuditEvents(gomock.Any(), filter).
+			Return(nil, errors.New("failed")).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Format:            "table",
+			Output:            &output.MockOutput{},
+			Filter:            filter,
+		}
+
+		err := runner.Run(context.Background())
+		require.EqualError(t, err, "failed")
+	})
+}
@@ -19,6 +19,7 @@ package deploy
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli"
@@ -30,6 +31,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/preview"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/to"
@@ -67,6 +69,10 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	
 	You can specify parameters using multiple sources. Parameters can be overridden based on the 
 	order the are provided. Parameters appearing later in the argument list will override those defined earlier.
+
+	Use the '--preview' flag to see the changes the deployment would make without applying them. The preview lists,
+	for each Radius resource, whether it will be created, updated or left unchanged along with a property-level diff
+	and the recipe that will run for it. Use '--output json' to produce the preview in a machine-readable format.
 	`,
 		Example: `
 # deploy a Bicep template
@@ -98,6 +104,12 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# preview the changes a deployment would make
+rad deploy myapp.bicep --preview
+
+# preview the changes a deployment would make in JSON format
+rad deploy myapp.bicep --preview --output json
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -108,6 +120,8 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("preview", false, "Show the changes the deployment would make without applying them")
 
 	return cmd, runner
 }
@@ -126,6 +140,8 @@ type Runner struct {
 	Parameters      map[string]map[string]any
 	Workspace       *workspaces.Workspace
 	Providers       *clients.Providers
+	Preview         bool
+	Format          string
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -221,6 +237,19 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 	r.FilePath = args[0]
 
+	// `rad run` shares this validation but does not support previewing.
+	if cmd.Flags().Lookup("preview") != nil {
+		r.Preview, err = cmd.Flags().GetBool("preview")
+		if err != nil {
+			return err
+		}
+
+		r.Format, err = cli.RequireOutput(cmd)
+		if err != nil {
+			return err
+		}
+	}
+
 	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
 	if err != nil {
 		return err
@@ -246,6 +275,10 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.Preview {
+		return r.runPreview(ctx, template)
+	}
+
 	// Create application if specified. This supports the case where the application resource
 	// is not specified in Bicep. Creating the application automatically helps us "bootstrap" in a new environment.
 	if r.ApplicationName != "" {
@@ -303,3 +336,30 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// runPreview computes the changes the deployment would make and displays them without deploying.
+func (r *Runner) runPreview(ctx context.Context, template map[string]any) error {
+	changeSet, err := r.Deploy.Preview(ctx, deploy.Options{
+		ConnectionFactory: r.ConnectionFactory,
+		Workspace:         *r.Workspace,
+		Template:          template,
+		Parameters:        r.Parameters,
+		Providers:         r.Providers,
+	})
+	if err != nil {
+		return err
+	}
+
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, changeSet, output.FormatterOptions{})
+	}
+
+	text := &strings.Builder{}
+	err = preview.WriteText(text, changeSet)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("%s", strings.TrimSuffix(text.String(), "\n"))
+	return nil
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/preview"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/to"
@@ -211,6 +212,34 @@ func Test_Validate(t *testing.T) {
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
 }
 
+func Test_Validate_Preview(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "rad deploy - preview with json output",
+			Input:         []string{"app.bicep", "--preview", "-o", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, obj framework.Runner) {
+				runner := obj.(*Runner)
+				require.True(t, runner.Preview)
+				require.Equal(t, output.FormatJson, runner.Format)
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
 func Test_Run(t *testing.T) {
 	t.Run("Environment-scoped deployment with az provider", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -492,3 +521,92 @@ func Test_Run(t *testing.T) {
 		require.Empty(t, outputSink.Writes)
 	})
 }
+
+func Test_Run_Preview(t *testing.T) {
+	changeSet := &preview.ChangeSet{
+		Resources: []preview.ResourceChange{
+			{
+				ID:     "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend",
+				Type:   "Applications.Core/containers",
+				Name:   "frontend",
+				Action: preview.ActionCreate,
+				Changes: []preview.PropertyChange{
+					{Path: "properties.container.image", Kind: preview.ChangeAdded, After: "nginx"},
+				},
+			},
+		},
+		Summary: map[preview.Action]int{preview.ActionCreate: 1},
+	}
+
+	setup := func(t *testing.T, format string) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+
+		bicep := bicep.NewMockInterface(ctrl)
+		bicep.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(map[string]any{}, nil).
+			Times(1)
+
+		// Previewing must not create the application or deploy anything.
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			Preview(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o deploy.Options) (*preview.ChangeSet, error) {
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-group", o.Workspace.Scope)
+				require.NotNil(t, o.Providers.Radius)
+				return changeSet, nil
+			}).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		workspace := &workspaces.Workspace{Name: "kind-kind", Scope: "/planes/radius/local/resourceGroups/test-group"}
+		runner := &Runner{
+			Bicep:           bicep,
+			Deploy:          deployMock,
+			Output:          outputSink,
+			FilePath:        "app.bicep",
+			ApplicationName: "test-application",
+			EnvironmentName: radcli.TestEnvironmentName,
+			Parameters:      map[string]map[string]any{},
+			Workspace:       workspace,
+			Providers: &clients.Providers{
+				Radius: &clients.RadiusProvider{
+					EnvironmentID: workspace.Scope + "/providers/applications.core/environments/" + radcli.TestEnvironmentName,
+					ApplicationID: workspace.Scope + "/providers/applications.core/applications/test-application",
+				},
+			},
+			Preview: true,
+			Format:  format,
+		}
+
+		return runner, outputSink
+	}
+
+	t.Run("Text", func(t *testing.T) {
+		runner, outputSink := setup(t, output.FormatTable)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 1)
+		log := outputSink.Writes[0].(output.LogOutput)
+		require.Equal(t, "%s", log.Format)
+		require.Contains(t, log.Params[0], "+ Applications.Core/containers frontend (Create)")
+		require.Contains(t, log.Params[0], `+ properties.container.image: "nginx"`)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		runner, outputSink := setup(t, output.FormatJson)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, []any{
+			output.FormattedOutput{
+				Format:  output.FormatJson,
+				Obj:     changeSet,
+				Options: output.FormatterOptions{},
+			},
+		}, outputSink.Writes)
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/spf13/cobra"
 )
 
@@ -122,8 +123,9 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 // Run runs the `rad env run` command.
 //
 
-// Run attempts to retrieve environment details from an ApplicationsManagementClient and write the details to an
-// output in a specified format, returning an error if any of these operations fail.
+// Run attempts to retrieve environment details and the usage of the quotas of the environment from an
+// ApplicationsManagementClient and write them to an output in a specified format, returning an error if any of these
+// operations fail.
 func (r *Runner) Run(ctx context.Context) error {
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
 	if err != nil {
@@ -142,5 +144,18 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	usage, err := client.ListQuotaUsage(ctx, to.String(environment.ID))
+	if err != nil {
+		return err
+	}
+
+	if len(usage) > 0 {
+		r.Output.LogInfo("")
+		err = r.Output.WriteFormatted(r.Format, usage, objectformats.GetQuotaUsageTableFormat())
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
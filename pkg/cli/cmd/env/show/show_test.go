@@ -98,6 +98,7 @@ func Test_Show(t *testing.T) {
 		defer ctrl.Finish()
 
 		environment := v20231001preview.EnvironmentResource{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"),
 			Name: to.Ptr("test-env"),
 		}
 
@@ -106,6 +107,10 @@ func Test_Show(t *testing.T) {
 			GetEnvDetails(gomock.Any(), "test-env").
 			Return(environment, nil).
 			Times(1)
+		appManagementClient.EXPECT().
+			ListQuotaUsage(gomock.Any(), "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env").
+			Return([]clients.QuotaUsage{}, nil).
+			Times(1)
 
 		workspace := &workspaces.Workspace{
 			Connection: map[string]any{
@@ -114,8 +114,8 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 // Run runs the `rad group show` command.
 //
 
-// Run creates an ApplicationsManagementClient, retrieves a resource group, and writes the resource group to an
-// output, returning an error if any of these steps fail.
+// Run creates an ApplicationsManagementClient, retrieves a resource group, and writes the resource group and the
+// usage of the quotas of the resource group to an output, returning an error if any of these steps fail.
 func (r *Runner) Run(ctx context.Context) error {
 
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
@@ -129,10 +129,22 @@ func (r *Runner) Run(ctx context.Context) error {
 	}
 
 	err = r.Output.WriteFormatted(r.Format, resourceGroup, objectformats.GetResourceGroupTableFormat())
+	if err != nil {
+		return err
+	}
 
+	usage, err := client.ListQuotaUsage(ctx, "/planes/radius/local/resourceGroups/"+r.UCPResourceGroupName)
 	if err != nil {
 		return err
 	}
-	return err
 
+	if len(usage) > 0 {
+		r.Output.LogInfo("")
+		err = r.Output.WriteFormatted(r.Format, usage, objectformats.GetQuotaUsageTableFormat())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
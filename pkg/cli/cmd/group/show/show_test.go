@@ -89,6 +89,7 @@ func Test_Run(t *testing.T) {
 
 		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
 		appManagementClient.EXPECT().ShowUCPGroup(gomock.Any(), gomock.Any(), gomock.Any(), "testrg").Return(testResourceGroup, nil)
+		appManagementClient.EXPECT().ListQuotaUsage(gomock.Any(), id).Return([]clients.QuotaUsage{}, nil)
 
 		workspace := &workspaces.Workspace{
 			Connection: map[string]any{
@@ -125,4 +126,61 @@ func Test_Run(t *testing.T) {
 
 	})
 
+	t.Run("Validate rad group show with quotas", func(t *testing.T) {
+		id := "/planes/radius/local/resourceGroups/testrg"
+		name := "testrg"
+
+		testResourceGroup := v20231001preview.ResourceGroupResource{
+			ID:   &id,
+			Name: &name,
+		}
+		usage := []clients.QuotaUsage{
+			{Quota: "quota0", Limit: "Applications.Datastores/redisCaches", Used: 2, Max: 5},
+			{Quota: "quota0", Limit: "maxContainers", Used: 1, Max: 10},
+		}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().ShowUCPGroup(gomock.Any(), gomock.Any(), gomock.Any(), "testrg").Return(testResourceGroup, nil)
+		appManagementClient.EXPECT().ListQuotaUsage(gomock.Any(), id).Return(usage, nil)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+
+			Name: "kind-kind",
+		}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:    &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:            workspace,
+			UCPResourceGroupName: "testrg",
+			Format:               "table",
+			Output:               outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     testResourceGroup,
+				Options: objectformats.GetResourceGroupTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     usage,
+				Options: objectformats.GetQuotaUsageTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -56,6 +56,9 @@ rad install kubernetes --set key=value
 
 # Install Radius with the intermediate root CA certificate in the current Kubernetes context
 rad install kubernetes --set-file global.rootCA.cert=/path/to/rootCA.crt
+
+# Install Radius without Contour, for clusters whose environments use the Kubernetes Gateway API
+rad install kubernetes --skip-contour-install
 `,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -65,6 +68,7 @@ rad install kubernetes --set-file global.rootCA.cert=/path/to/rootCA.crt
 	cmd.Flags().BoolVar(&runner.Reinstall, "reinstall", false, "Specify to force reinstallation of Radius")
 	cmd.Flags().StringVar(&runner.Chart, "chart", "", "Specify a file path to a helm chart to install Radius from")
 	cmd.Flags().StringArrayVar(&runner.Set, "set", []string{}, "Set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
+	cmd.Flags().BoolVar(&runner.SkipContourInstall, "skip-contour-install", false, "Specify to skip the installation of Contour, for example when gateways use the Kubernetes Gateway API")
 	cmd.Flags().StringArrayVar(&runner.SetFile, "set-file", []string{}, "Set values from files on the command line (can specify multiple or separate files with commas: key1=filename1,key2=filename2)")
 
 	return cmd, runner
@@ -75,11 +79,12 @@ type Runner struct {
 	Helm   helm.Interface
 	Output output.Interface
 
-	KubeContext string
-	Chart       string
-	Reinstall   bool
-	Set         []string
-	SetFile     []string
+	KubeContext        string
+	Chart              string
+	Reinstall          bool
+	Set                []string
+	SetFile            []string
+	SkipContourInstall bool
 }
 
 // NewRunner creates an instance of the runner for the `rad install kubernetes` command.
@@ -107,6 +112,9 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 // to the cli version. It then returns any errors that occur during the installation.
 func (r *Runner) Run(ctx context.Context) error {
 	cliOptions := helm.CLIClusterOptions{
+		Contour: helm.ContourOptions{
+			Disabled: r.SkipContourInstall,
+		},
 		Radius: helm.RadiusOptions{
 			Reinstall:   r.Reinstall,
 			ChartPath:   r.Chart,
@@ -93,6 +93,37 @@ func Test_Run(t *testing.T) {
 		}
 		require.Equal(t, expectedWrites, outputMock.Writes)
 	})
+	t.Run("Success: Install without Contour", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		helmMock := helm.NewMockInterface(ctrl)
+		outputMock := &output.MockOutput{}
+
+		ctx := context.Background()
+		runner := &Runner{
+			Helm:   helmMock,
+			Output: outputMock,
+
+			KubeContext:        "test-context",
+			SkipContourInstall: true,
+		}
+
+		helmMock.EXPECT().CheckRadiusInstall("test-context").
+			Return(helm.InstallState{}, nil).
+			Times(1)
+
+		expectedOptions := helm.PopulateDefaultClusterOptions(helm.CLIClusterOptions{
+			Contour: helm.ContourOptions{
+				Disabled: true,
+			},
+		})
+		require.True(t, expectedOptions.Contour.Disabled)
+		helmMock.EXPECT().InstallRadius(ctx, expectedOptions, "test-context").
+			Return(true, nil).
+			Times(1)
+
+		err := runner.Run(ctx)
+		require.NoError(t, err)
+	})
 	t.Run("Success: Already Installed", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		helmMock := helm.NewMockInterface(ctrl)
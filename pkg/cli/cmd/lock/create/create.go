@@ -0,0 +1,237 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	ucp_v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+const (
+	applicationFlag = "application"
+	levelFlag       = "level"
+	resourceFlag    = "resource"
+	planeFlag       = "plane"
+	notesFlag       = "notes"
+)
+
+// NewCommand creates an instance of the `rad lock create` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "create name",
+		Short: "Create or update a lock",
+		Long: `Create or update a lock.
+
+A lock protects a resource group, an application, a resource, or the whole plane from being deleted or changed. The lock applies to the scope and to everything in it. A CanNotDelete lock prevents deletes, and a ReadOnly lock prevents deletes and changes.
+
+By default the lock is stored in the resource group of the workspace and protects the resource group. Use --application or --resource to protect an application or a resource in the resource group, or --plane to store the lock in the plane and protect the whole plane.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Prevent deleting the current resource group or any resource in it
+rad lock create protect-group --level CanNotDelete
+
+# Prevent changing or deleting an application and its resources
+rad lock create freeze-app --level ReadOnly --application my-app --notes "Release freeze"
+
+# Prevent deleting an environment and the applications deployed to it
+rad lock create protect-prod --level CanNotDelete --resource Applications.Core/environments/prod
+
+# Prevent deleting anything in the plane
+rad lock create protect-all --level CanNotDelete --plane
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	cmd.Flags().String(levelFlag, "", "The level of the lock. Allowed values are CanNotDelete and ReadOnly")
+	cmd.Flags().String(resourceFlag, "", "The resource to lock, as a resource type and name such as Applications.Core/containers/frontend")
+	cmd.Flags().Bool(planeFlag, false, "Store the lock in the plane and lock the whole plane")
+	cmd.Flags().String(notesFlag, "", "Notes about the lock")
+	_ = cmd.MarkFlagRequired(levelFlag)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad lock create` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Workspace         *workspaces.Workspace
+	Output            output.Interface
+
+	// LockName is the name of the lock.
+	LockName string
+
+	// StorageScope is the plane or resource group that stores the lock.
+	StorageScope string
+
+	// Lock is the lock to create or update.
+	Lock ucp_v20231001preview.LockResource
+}
+
+// NewRunner creates an instance of the runner for the `rad lock create` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad lock create` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+	r.LockName = args[0]
+
+	level, err := cmd.Flags().GetString(levelFlag)
+	if err != nil {
+		return err
+	}
+	lockLevel, ok := parseLevel(level)
+	if !ok {
+		return clierrors.Message("The value of --%s must be one of %s.", levelFlag, strings.Join(levelNames(), ", "))
+	}
+
+	application, err := cmd.Flags().GetString(applicationFlag)
+	if err != nil {
+		return err
+	}
+	resource, err := cmd.Flags().GetString(resourceFlag)
+	if err != nil {
+		return err
+	}
+	plane, err := cmd.Flags().GetBool(planeFlag)
+	if err != nil {
+		return err
+	}
+	notes, err := cmd.Flags().GetString(notesFlag)
+	if err != nil {
+		return err
+	}
+
+	if countSet(application != "", resource != "", plane) > 1 {
+		return clierrors.Message("Only one of --%s, --%s, and --%s can be specified.", applicationFlag, resourceFlag, planeFlag)
+	}
+
+	r.StorageScope = scope
+	lockScope := scope
+	switch {
+	case plane:
+		id, err := resources.ParseScope(scope)
+		if err != nil {
+			return err
+		}
+		r.StorageScope = id.PlaneScope()
+		lockScope = r.StorageScope
+	case application != "":
+		lockScope = scope + "/providers/" + corerp_dm.ApplicationResourceType + "/" + application
+	case resource != "":
+		parts := strings.Split(resource, "/")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return clierrors.Message("The value of --%s must be a resource type and name such as Applications.Core/containers/frontend.", resourceFlag)
+		}
+		lockScope = scope + "/providers/" + resource
+	}
+
+	r.Lock = ucp_v20231001preview.LockResource{
+		Properties: &ucp_v20231001preview.LockProperties{
+			Level: &lockLevel,
+			Scope: to.Ptr(lockScope),
+		},
+	}
+	if notes != "" {
+		r.Lock.Properties.Notes = to.Ptr(notes)
+	}
+
+	return nil
+}
+
+// Run runs the `rad lock create` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Creating lock %q on %s...", r.LockName, *r.Lock.Properties.Scope)
+	err = client.CreateOrUpdateLock(ctx, r.StorageScope, r.LockName, r.Lock)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Lock %q created.", r.LockName)
+	return nil
+}
+
+// parseLevel returns the lock level that matches the value, ignoring case.
+func parseLevel(value string) (ucp_v20231001preview.LockLevel, bool) {
+	for _, level := range ucp_v20231001preview.PossibleLockLevelValues() {
+		if strings.EqualFold(string(level), value) {
+			return level, true
+		}
+	}
+
+	return "", false
+}
+
+func levelNames() []string {
+	names := []string{}
+	for _, level := range ucp_v20231001preview.PossibleLockLevelValues() {
+		names = append(names, string(level))
+	}
+
+	return names
+}
+
+func countSet(values ...bool) int {
+	count := 0
+	for _, value := range values {
+		if value {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	ucp_v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	groupScope = "/planes/radius/local/resourceGroups/test-resource-group"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Create Command locking the resource group",
+			Input:         []string{"protect", "--level", "CanNotDelete"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "protect", runner.LockName)
+				require.Equal(t, groupScope, runner.StorageScope)
+				require.Equal(t, ucp_v20231001preview.LockLevelCanNotDelete, *runner.Lock.Properties.Level)
+				require.Equal(t, groupScope, *runner.Lock.Properties.Scope)
+				require.Nil(t, runner.Lock.Properties.Notes)
+			},
+		},
+		{
+			Name:          "Create Command locking an application",
+			Input:         []string{"freeze", "--level", "readonly", "-a", "my-app", "--notes", "Release freeze"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, groupScope, runner.StorageScope)
+				require.Equal(t, ucp_v20231001preview.LockLevelReadOnly, *runner.Lock.Properties.Level)
+				require.Equal(t, groupScope+"/providers/Applications.Core/applications/my-app", *runner.Lock.Properties.Scope)
+				require.Equal(t, "Release freeze", *runner.Lock.Properties.Notes)
+			},
+		},
+		{
+			Name:          "Create Command locking a resource",
+			Input:         []string{"protect", "--level", "CanNotDelete", "--resource", "Applications.Core/environments/prod"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, groupScope+"/providers/Applications.Core/environments/prod", *runner.Lock.Properties.Scope)
+			},
+		},
+		{
+			Name:          "Create Command locking the plane",
+			Input:         []string{"protect", "--level", "CanNotDelete", "--plane"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "/planes/radius/local", runner.StorageScope)
+				require.Equal(t, "/planes/radius/local", *runner.Lock.Properties.Scope)
+			},
+		},
+		{
+			Name:          "Create Command without level",
+			Input:         []string{"protect"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Create Command with invalid level",
+			Input:         []string{"protect", "--level", "NoWrite"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Create Command with invalid resource",
+			Input:         []string{"protect", "--level", "ReadOnly", "--resource", "containers/frontend"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Create Command with application and plane",
+			Input:         []string{"protect", "--level", "ReadOnly", "-a", "my-app", "--plane"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Create Command without name",
+			Input:         []string{"--level", "ReadOnly"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	lock := ucp_v20231001preview.LockResource{
+		Properties: &ucp_v20231001preview.LockProperties{
+			Level: to.Ptr(ucp_v20231001preview.LockLevelCanNotDelete),
+			Scope: to.Ptr(groupScope),
+		},
+	}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		CreateOrUpdateLock(gomock.Any(), groupScope, "protect", lock).
+		Return(nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{Scope: groupScope},
+		Output:            outputSink,
+		LockName:          "protect",
+		StorageScope:      groupScope,
+		Lock:              lock,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.LogOutput{
+			Format: "Creating lock %q on %s...",
+			Params: []any{"protect", groupScope},
+		},
+		output.LogOutput{
+			Format: "Lock %q created.",
+			Params: []any{"protect"},
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+const (
+	planeFlag = "plane"
+)
+
+// NewCommand creates an instance of the `rad lock delete` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "delete name",
+		Short: "Delete a lock",
+		Long: `Delete a lock.
+
+Deletes a lock stored in the resource group of the workspace, or in the plane with --plane.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Delete a lock of the current resource group
+rad lock delete protect-group
+
+# Delete a lock of the plane without prompting
+rad lock delete protect-all --plane --yes
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+	cmd.Flags().Bool(planeFlag, false, "Delete a lock stored in the plane")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad lock delete` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	InputPrompter     prompt.Interface
+	Workspace         *workspaces.Workspace
+	Output            output.Interface
+
+	LockName     string
+	StorageScope string
+	Confirm      bool
+}
+
+// NewRunner creates an instance of the runner for the `rad lock delete` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad lock delete` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+	r.StorageScope = scope
+	r.LockName = args[0]
+
+	plane, err := cmd.Flags().GetBool(planeFlag)
+	if err != nil {
+		return err
+	}
+	if plane {
+		id, err := resources.ParseScope(scope)
+		if err != nil {
+			return err
+		}
+		r.StorageScope = id.PlaneScope()
+	}
+
+	r.Confirm, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad lock delete` command.
+func (r *Runner) Run(ctx context.Context) error {
+	if !r.Confirm {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf("Are you sure you want to delete lock '%v' from '%v'?", r.LockName, r.StorageScope), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := client.DeleteLock(ctx, r.StorageScope, r.LockName)
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		r.Output.LogInfo("Lock deleted")
+	} else {
+		r.Output.LogInfo("Lock '%s' does not exist or has already been deleted.", r.LockName)
+	}
+
+	return nil
+}
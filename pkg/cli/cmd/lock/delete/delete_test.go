@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Delete Command with resource group lock",
+			Input:         []string{"protect"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "protect", runner.LockName)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group", runner.StorageScope)
+				require.False(t, runner.Confirm)
+			},
+		},
+		{
+			Name:          "Delete Command with plane lock",
+			Input:         []string{"protect", "--plane", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "/planes/radius/local", runner.StorageScope)
+				require.True(t, runner.Confirm)
+			},
+		},
+		{
+			Name:          "Delete Command without name",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	scope := "/planes/radius/local/resourceGroups/test-group"
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().DeleteLock(gomock.Any(), scope, "protect").Return(true, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Scope: scope},
+			Output:            outputSink,
+			LockName:          "protect",
+			StorageScope:      scope,
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Lock deleted",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().DeleteLock(gomock.Any(), scope, "protect").Return(false, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Scope: scope},
+			Output:            outputSink,
+			LockName:          "protect",
+			StorageScope:      scope,
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Lock '%s' does not exist or has already been deleted.",
+				Params: []any{"protect"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Answer no on confirmation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		prompter := prompt.NewMockInterface(ctrl)
+		prompter.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, "Are you sure you want to delete lock 'protect' from '"+scope+"'?").
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: clients.NewMockApplicationsManagementClient(ctrl)},
+			Workspace:         &workspaces.Workspace{Scope: scope},
+			InputPrompter:     prompter,
+			Output:            outputSink,
+			LockName:          "protect",
+			StorageScope:      scope,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, outputSink.Writes)
+	})
+}
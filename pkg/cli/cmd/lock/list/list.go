@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	ucp_v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad lock list` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List locks",
+		Long: `List locks.
+
+Lists the locks stored in the resource group of the workspace and the locks stored in its plane, which apply to every resource group.`,
+		Args: cobra.NoArgs,
+		Example: `
+# List the locks of the current resource group
+rad lock list
+
+# List the locks of a specified resource group as JSON
+rad lock list --group my-group --output json
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad lock list` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Workspace         *workspaces.Workspace
+	Output            output.Interface
+	Format            string
+}
+
+// NewRunner creates an instance of the runner for the `rad lock list` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad lock list` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad lock list` command.
+func (r *Runner) Run(ctx context.Context) error {
+	id, err := resources.ParseScope(r.Workspace.Scope)
+	if err != nil {
+		return err
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	// Listing at the plane returns the locks of every resource group, so keep the ones that apply to the workspace.
+	all, err := client.ListLocks(ctx, id.PlaneScope())
+	if err != nil {
+		return err
+	}
+
+	results := []ucp_v20231001preview.LockResource{}
+	for _, lock := range all {
+		if lock.ID == nil {
+			continue
+		}
+
+		lockID, err := resources.ParseResource(*lock.ID)
+		if err != nil {
+			continue
+		}
+
+		rootScope := lockID.RootScope()
+		if strings.EqualFold(rootScope, id.PlaneScope()) || strings.EqualFold(rootScope, id.RootScope()) {
+			results = append(results, lock)
+		}
+	}
+
+	return r.Output.WriteFormatted(r.Format, results, objectformats.GetLockTableFormat())
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	ucp_v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "List Command with defaults",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group", runner.Workspace.Scope)
+				require.Equal(t, "table", runner.Format)
+			},
+		},
+		{
+			Name:          "List Command with group",
+			Input:         []string{"-g", "other-group", "-o", "json"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "/planes/radius/local/resourceGroups/other-group", runner.Workspace.Scope)
+				require.Equal(t, "json", runner.Format)
+			},
+		},
+		{
+			Name:          "List Command with positional arg",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newLock := func(id string) ucp_v20231001preview.LockResource {
+		return ucp_v20231001preview.LockResource{
+			ID: to.Ptr(id),
+			Properties: &ucp_v20231001preview.LockProperties{
+				Level: to.Ptr(ucp_v20231001preview.LockLevelCanNotDelete),
+			},
+		}
+	}
+	planeLock := newLock("/planes/radius/local/providers/System.Locks/locks/all")
+	groupLock := newLock("/planes/radius/local/resourceGroups/test-group/providers/System.Locks/locks/group")
+	otherLock := newLock("/planes/radius/local/resourceGroups/other-group/providers/System.Locks/locks/other")
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		ListLocks(gomock.Any(), "/planes/radius/local").
+		Return([]ucp_v20231001preview.LockResource{planeLock, groupLock, otherLock}, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+		Format:            "table",
+		Output:            outputSink,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format:  "table",
+			Obj:     []ucp_v20231001preview.LockResource{planeLock, groupLock},
+			Options: objectformats.GetLockTableFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
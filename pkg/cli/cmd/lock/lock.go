@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	lock_create "github.com/radius-project/radius/pkg/cli/cmd/lock/create"
+	lock_delete "github.com/radius-project/radius/pkg/cli/cmd/lock/delete"
+	lock_list "github.com/radius-project/radius/pkg/cli/cmd/lock/list"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad lock` command, with subcommands for creating, listing, and deleting locks.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "lock",
+		Short: "Manage locks",
+		Long: `Manage locks
+
+Locks protect a plane, a resource group, an application, or a resource from being deleted or changed. A CanNotDelete lock prevents deleting the locked scope and anything in it. A ReadOnly lock also prevents creating, updating, or running actions on them.
+
+A lock must be deleted before the resources it protects can be deleted or changed.
+`,
+		Example: `
+# Prevent deleting the current resource group or any resource in it
+rad lock create protect-group --level CanNotDelete
+
+# List the locks of the current resource group
+rad lock list
+
+# Delete a lock
+rad lock delete protect-group
+`,
+	}
+
+	create, _ := lock_create.NewCommand(factory)
+	cmd.AddCommand(create)
+
+	list, _ := lock_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	delete, _ := lock_delete.NewCommand(factory)
+	cmd.AddCommand(delete)
+
+	return cmd
+}
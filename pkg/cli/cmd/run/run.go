@@ -53,6 +53,8 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 The run command compiles a Bicep or ARM template and runs it in your default environment (unless otherwise specified). It also automatically port-forwards container ports and streams container logs to a user's terminal.
 		
 The run command accepts the same parameters as the 'rad deploy' command. See the 'rad deploy' help for more information.
+
+Use the '--watch' flag to redeploy the application each time the template or one of its local modules changes. Only the resources that changed are redeployed, and port-forwards and logs follow the new replicas as they start. Use '--watch-path' to also watch other files or directories, such as a local build context.
 	`,
 		Example: `
 # Run app.bicep
@@ -66,6 +68,12 @@ rad run app.bicep --parameters version=latest
 
 # Run app.bicep and specify parameters from multiple sources
 rad run app.bicep --parameters @myfile.json --parameters version=latest
+
+# Run app.bicep and redeploy when it changes
+rad run app.bicep --watch
+
+# Run app.bicep and redeploy when it or the contents of the ./src directory change
+rad run app.bicep --watch --watch-path ./src
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -76,15 +84,25 @@ rad run app.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	cmd.Flags().StringArrayP("parameters", "p", []string{}, "Specify parameters for the deployment")
+	cmd.Flags().Bool(watchFlag, false, "Redeploy the application when the template or its local modules change")
+	cmd.Flags().StringArray(watchPathFlag, []string{}, "Specify additional files or directories to watch for changes, requires --watch")
 
 	return cmd, runner
 }
 
+const (
+	watchFlag     = "watch"
+	watchPathFlag = "watch-path"
+)
+
 // Runner is the runner implementation for the `rad run` command.
 type Runner struct {
 	deploycmd.Runner
 	Logstream   logstream.Interface
 	Portforward portforward.Interface
+
+	Watch      bool
+	WatchPaths []string
 }
 
 // NewRunner creates a new instance of the `rad run` runner.
@@ -112,6 +130,26 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return clierrors.Message("No application was specified. Use --application to specify the application name.")
 	}
 
+	r.Watch, err = cmd.Flags().GetBool(watchFlag)
+	if err != nil {
+		return err
+	}
+
+	r.WatchPaths, err = cmd.Flags().GetStringArray(watchPathFlag)
+	if err != nil {
+		return err
+	}
+
+	if len(r.WatchPaths) > 0 && !r.Watch {
+		return clierrors.Message("The --watch-path flag can only be used with --watch.")
+	}
+
+	for _, path := range r.WatchPaths {
+		if _, err := os.Stat(path); err != nil {
+			return clierrors.Message("The watch path %q could not be found.", path)
+		}
+	}
+
 	return nil
 }
 
@@ -129,6 +167,9 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	kubeContext, ok := r.Workspace.KubernetesContext()
 	if !ok {
+		if r.Watch {
+			return r.watch(ctx)
+		}
 		return nil
 	}
 
@@ -159,7 +200,7 @@ func (r *Runner) Run(ctx context.Context) error {
 		return clierrors.Message("Only kubernetes runtimes are supported.")
 	}
 
-	// We start three background jobs and wait for them to complete.
+	// We start three background jobs, and a fourth in watch mode, and wait for them to complete.
 	group, ctx := errgroup.WithContext(ctx)
 
 	// 1. Display port-forward messages
@@ -193,6 +234,13 @@ func (r *Runner) Run(ctx context.Context) error {
 		})
 	})
 
+	// 4. Redeploy on changes. The port-forward and log stream sessions stay open and attach to the new replicas.
+	if r.Watch {
+		group.Go(func() error {
+			return r.watch(ctx)
+		})
+	}
+
 	err = group.Wait()
 
 	// context.Canceled here means the user canceled.
@@ -104,6 +104,51 @@ func Test_Validate(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			Name:          "rad run - valid with watch",
+			Input:         []string{"app.bicep", "-e", "prod", "-a", "my-app", "--watch", "--watch-path", "."},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), "prod").
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+		},
+		{
+			Name:          "rad run - watch path requires watch invalid",
+			Input:         []string{"app.bicep", "-e", "prod", "-a", "my-app", "--watch-path", "."},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), "prod").
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+		},
+		{
+			Name:          "rad run - watch path not found invalid",
+			Input:         []string{"app.bicep", "-e", "prod", "-a", "my-app", "--watch", "--watch-path", "does-not-exist"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), "prod").
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+		},
 		{
 			Name:          "rad run - fallback workspace invalid",
 			Input:         []string{"app.bicep"},
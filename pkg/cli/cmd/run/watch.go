@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package run
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/preview"
+)
+
+// debounceInterval is how long to wait for changes to settle before redeploying. Editors often write a file
+// several times when saving, and a build may touch many files.
+var debounceInterval = 500 * time.Millisecond
+
+// watchTargets are the files and directories that trigger a redeploy when they change.
+type watchTargets struct {
+	// files are the absolute paths of the template and its local dependencies.
+	files map[string]bool
+
+	// directories are the absolute paths of directories that are watched recursively.
+	directories []string
+}
+
+// matches returns true if a change to the given path should trigger a redeploy.
+func (t *watchTargets) matches(path string) bool {
+	if t.files[path] {
+		return true
+	}
+
+	for _, directory := range t.directories {
+		if path == directory || strings.HasPrefix(path, directory+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// watchTargets finds the files and directories to watch. The dependencies of the template are found again
+// after each redeploy since modules may have been added or removed.
+func (r *Runner) watchTargets() (*watchTargets, error) {
+	files, err := bicep.Dependencies(r.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := &watchTargets{files: map[string]bool{}}
+	for _, file := range files {
+		targets.files[file] = true
+	}
+
+	for _, path := range r.WatchPaths {
+		path, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not watch %q: %w", path, err)
+		}
+
+		if info.IsDir() {
+			targets.directories = append(targets.directories, path)
+		} else {
+			targets.files[path] = true
+		}
+	}
+
+	return targets, nil
+}
+
+// addWatches registers the targets with the watcher. Files are watched through their parent directory so that
+// changes are detected when an editor replaces the file rather than writing to it.
+func addWatches(watcher *fsnotify.Watcher, targets *watchTargets) error {
+	for file := range targets.files {
+		// The directory of a missing module may not exist yet, the build will fail until it does.
+		err := watcher.Add(filepath.Dir(file))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	for _, directory := range targets.directories {
+		err := addDirectory(watcher, directory)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addDirectory watches a directory and its subdirectories, skipping hidden directories like ".git".
+func addDirectory(watcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.IsDir() {
+			return nil
+		}
+
+		if path != root && strings.HasPrefix(entry.Name(), ".") {
+			return filepath.SkipDir
+		}
+
+		return watcher.Add(path)
+	})
+}
+
+// watch redeploys the application each time the template, its modules, or the additional watch paths change. It
+// blocks until the context is canceled. Failures to build or deploy are reported and the watch continues, so that
+// they can be fixed by editing the files again.
+func (r *Runner) watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	targets, err := r.watchTargets()
+	if err != nil {
+		return err
+	}
+
+	err = addWatches(watcher, targets)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Watching for changes to %s...", r.FilePath)
+
+	timer := time.NewTimer(debounceInterval)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			// New directories under a watched directory need to be watched too.
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() && targets.matches(event.Name) {
+					_ = addDirectory(watcher, event.Name)
+				}
+			}
+
+			if event.Op == fsnotify.Chmod || !targets.matches(event.Name) {
+				continue
+			}
+
+			timer.Reset(debounceInterval)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			r.Output.LogInfo("Error watching for changes: %v", err)
+
+		case <-timer.C:
+			r.Output.LogInfo("")
+			r.Output.LogInfo("Changes detected, redeploying...")
+
+			err := r.redeploy(ctx)
+			if ctx.Err() != nil {
+				return nil
+			} else if err != nil {
+				r.Output.LogInfo("Redeploy failed: %v", err)
+			}
+
+			updated, err := r.watchTargets()
+			if err != nil {
+				r.Output.LogInfo("Error watching for changes: %v", err)
+				continue
+			}
+
+			targets = updated
+			err = addWatches(watcher, targets)
+			if err != nil {
+				r.Output.LogInfo("Error watching for changes: %v", err)
+			}
+
+			r.Output.LogInfo("Watching for changes to %s...", r.FilePath)
+		}
+	}
+}
+
+// redeploy builds the template and deploys the resources that changed since the last deployment. Port-forwards and
+// log streams are not restarted, they follow the new replicas of the application as they are created.
+func (r *Runner) redeploy(ctx context.Context) error {
+	template, err := r.Bicep.PrepareTemplate(r.FilePath)
+	if err != nil {
+		return err
+	}
+
+	options := deploy.Options{
+		ConnectionFactory: r.ConnectionFactory,
+		Workspace:         *r.Workspace,
+		Template:          template,
+		Parameters:        r.Parameters,
+		Providers:         r.Providers,
+	}
+
+	changeSet, err := r.Deploy.Preview(ctx, options)
+	if err != nil {
+		// The preview does not support every template, in that case the whole template is deployed.
+		r.Output.LogInfo("Unable to determine the changed resources, deploying all resources: %v", err)
+	} else if !changeSet.HasChanges() {
+		r.Output.LogInfo("No resources changed.")
+		return nil
+	} else {
+		options.Template = preview.ExcludeUnchanged(template, changeSet)
+	}
+
+	options.ProgressText = fmt.Sprintf(
+		"Redeploying template '%v' for application '%v' and environment '%v' from workspace '%v'...\n\n"+
+			"Deployment In Progress... ", r.FilePath, r.ApplicationName, r.EnvironmentName, r.Workspace.Name)
+	options.CompletionText = "Deployment Complete"
+
+	_, err = r.Deploy.DeployWithProgress(ctx, options)
+	return err
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package run
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	deploycmd "github.com/radius-project/radius/pkg/cli/cmd/deploy"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/preview"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+func testTemplate() map[string]any {
+	return map[string]any{
+		"resources": map[string]any{
+			"app": map[string]any{
+				"import":     "radius",
+				"type":       "Applications.Core/applications@2023-10-01-preview",
+				"properties": map[string]any{"name": "test-application"},
+			},
+			"frontend": map[string]any{
+				"import": "radius",
+				"type":   "Applications.Core/containers@2023-10-01-preview",
+				"properties": map[string]any{
+					"name":       "frontend",
+					"properties": map[string]any{"container": map[string]any{"image": "nginx"}},
+				},
+			},
+		},
+	}
+}
+
+func newWatchRunner(ctrl *gomock.Controller, filePath string) (*Runner, *bicep.MockInterface, *deploy.MockInterface, *output.MockOutput) {
+	bicepMock := bicep.NewMockInterface(ctrl)
+	deployMock := deploy.NewMockInterface(ctrl)
+	outputSink := &output.MockOutput{}
+
+	runner := &Runner{
+		Runner: deploycmd.Runner{
+			Bicep:           bicepMock,
+			Deploy:          deployMock,
+			Output:          outputSink,
+			FilePath:        filePath,
+			ApplicationName: "test-application",
+			EnvironmentName: "test-environment",
+			Parameters:      map[string]map[string]any{},
+			Workspace:       &workspaces.Workspace{Name: "kind-kind"},
+			Providers:       &clients.Providers{Radius: &clients.RadiusProvider{}},
+		},
+		Watch: true,
+	}
+
+	return runner, bicepMock, deployMock, outputSink
+}
+
+func Test_WatchTargets(t *testing.T) {
+	directory := t.TempDir()
+	file := filepath.Join(directory, "app.json")
+	require.NoError(t, os.WriteFile(file, []byte("{}"), 0644))
+	src := filepath.Join(directory, "src")
+	require.NoError(t, os.Mkdir(src, 0755))
+
+	runner := &Runner{Runner: deploycmd.Runner{FilePath: file}, WatchPaths: []string{src}}
+	targets, err := runner.watchTargets()
+	require.NoError(t, err)
+
+	require.True(t, targets.matches(file))
+	require.True(t, targets.matches(src))
+	require.True(t, targets.matches(filepath.Join(src, "main.go")))
+	require.False(t, targets.matches(filepath.Join(directory, "other.json")))
+	require.False(t, targets.matches(src+"-other"))
+}
+
+func Test_Redeploy(t *testing.T) {
+	t.Run("deploys changed resources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		runner, bicepMock, deployMock, outputSink := newWatchRunner(ctrl, "app.bicep")
+
+		bicepMock.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(testTemplate(), nil).
+			Times(1)
+		deployMock.EXPECT().
+			Preview(gomock.Any(), gomock.Any()).
+			Return(&preview.ChangeSet{Resources: []preview.ResourceChange{
+				{Name: "test-application", Symbol: "app", Action: preview.ActionNoChange},
+				{Name: "frontend", Symbol: "frontend", Action: preview.ActionUpdate},
+			}}, nil).
+			Times(1)
+
+		var deployed deploy.Options
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+				deployed = o
+				return clients.DeploymentResult{}, nil
+			}).
+			Times(1)
+
+		err := runner.redeploy(context.Background())
+		require.NoError(t, err)
+
+		resources := deployed.Template["resources"].(map[string]any)
+		require.Equal(t, true, resources["app"].(map[string]any)["existing"])
+		require.Equal(t, testTemplate()["resources"].(map[string]any)["frontend"], resources["frontend"])
+		require.Empty(t, outputSink.Writes)
+	})
+
+	t.Run("skips deploy without changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		runner, bicepMock, deployMock, outputSink := newWatchRunner(ctrl, "app.bicep")
+
+		bicepMock.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(testTemplate(), nil).
+			Times(1)
+		deployMock.EXPECT().
+			Preview(gomock.Any(), gomock.Any()).
+			Return(&preview.ChangeSet{Resources: []preview.ResourceChange{
+				{Name: "test-application", Symbol: "app", Action: preview.ActionNoChange},
+				{Name: "frontend", Symbol: "frontend", Action: preview.ActionNoChange},
+			}}, nil).
+			Times(1)
+
+		err := runner.redeploy(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{output.LogOutput{Format: "No resources changed."}}, outputSink.Writes)
+	})
+
+	t.Run("deploys whole template when preview fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		runner, bicepMock, deployMock, _ := newWatchRunner(ctrl, "app.bicep")
+
+		bicepMock.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(testTemplate(), nil).
+			Times(1)
+		deployMock.EXPECT().
+			Preview(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("unsupported function")).
+			Times(1)
+
+		var deployed deploy.Options
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+				deployed = o
+				return clients.DeploymentResult{}, nil
+			}).
+			Times(1)
+
+		err := runner.redeploy(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, testTemplate(), deployed.Template)
+	})
+
+	t.Run("build failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		runner, bicepMock, _, _ := newWatchRunner(ctrl, "app.bicep")
+
+		bicepMock.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(nil, errors.New("syntax error")).
+			Times(1)
+
+		err := runner.redeploy(context.Background())
+		require.EqualError(t, err, "syntax error")
+	})
+}
+
+func Test_Watch(t *testing.T) {
+	previous := debounceInterval
+	debounceInterval = 10 * time.Millisecond
+	t.Cleanup(func() { debounceInterval = previous })
+
+	directory := t.TempDir()
+	file := filepath.Join(directory, "app.json")
+	require.NoError(t, os.WriteFile(file, []byte("{}"), 0644))
+
+	ctrl := gomock.NewController(t)
+	runner, bicepMock, deployMock, _ := newWatchRunner(ctrl, file)
+
+	bicepMock.EXPECT().
+		PrepareTemplate(file).
+		Return(testTemplate(), nil).
+		AnyTimes()
+	deployMock.EXPECT().
+		Preview(gomock.Any(), gomock.Any()).
+		Return(&preview.ChangeSet{Resources: []preview.ResourceChange{
+			{Name: "frontend", Symbol: "frontend", Action: preview.ActionUpdate},
+		}}, nil).
+		AnyTimes()
+
+	deployed := make(chan struct{}, 10)
+	deployMock.EXPECT().
+		DeployWithProgress(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+			deployed <- struct{}{}
+			return clients.DeploymentResult{}, nil
+		}).
+		MinTimes(1)
+
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	result := make(chan error, 1)
+	go func() {
+		result <- runner.watch(ctx)
+	}()
+
+	// The watcher may not be registered yet, so keep changing the file until it is redeployed.
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for redeployed := false; !redeployed; {
+		select {
+		case <-deployed:
+			redeployed = true
+		case <-ticker.C:
+			require.NoError(t, os.WriteFile(file, []byte(`{"resources": {}}`), 0644))
+		case <-ctx.Done():
+			require.Fail(t, "timed out waiting for redeploy")
+		}
+	}
+
+	cancel()
+	require.NoError(t, <-result)
+}
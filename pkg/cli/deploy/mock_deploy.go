@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	clients "github.com/radius-project/radius/pkg/cli/clients"
+	preview "github.com/radius-project/radius/pkg/cli/preview"
 )
 
 // MockInterface is a mock of Interface interface.
@@ -49,3 +50,18 @@ func (mr *MockInterfaceMockRecorder) DeployWithProgress(arg0, arg1 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployWithProgress", reflect.TypeOf((*MockInterface)(nil).DeployWithProgress), arg0, arg1)
 }
+
+// Preview mocks base method.
+func (m *MockInterface) Preview(arg0 context.Context, arg1 Options) (*preview.ChangeSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preview", arg0, arg1)
+	ret0, _ := ret[0].(*preview.ChangeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preview indicates an expected call of Preview.
+func (mr *MockInterfaceMockRecorder) Preview(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preview", reflect.TypeOf((*MockInterface)(nil).Preview), arg0, arg1)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// Preview injects environment and application parameters into the template the same way as DeployWithProgress,
+// and computes the changes the deployment would make to the resources in the workspace without applying them.
+func Preview(ctx context.Context, options Options) (*preview.ChangeSet, error) {
+	client, err := options.ConnectionFactory.CreateApplicationsManagementClient(ctx, options.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bicep.InjectEnvironmentParam(options.Template, options.Parameters, options.Providers.Radius.EnvironmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bicep.InjectApplicationParam(options.Template, options.Parameters, options.Providers.Radius.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return preview.Preview(ctx, preview.Options{
+		Client:          client,
+		Template:        options.Template,
+		Parameters:      options.Parameters,
+		Scope:           options.Workspace.Scope,
+		EnvironmentName: resourceName(options.Providers.Radius.EnvironmentID),
+		ApplicationName: resourceName(options.Providers.Radius.ApplicationID),
+	})
+}
+
+// resourceName returns the name of the resource with the given ID, or an empty string if the ID is not set.
+func resourceName(id string) string {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.Name()
+}
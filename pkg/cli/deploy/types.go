@@ -21,6 +21,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/preview"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 )
 
@@ -29,6 +30,9 @@ type Interface interface {
 	// DeployWithProgress runs a deployment and displays progress to the user. This is intended to be used
 	// from the CLI and thus logs to the console.
 	DeployWithProgress(ctx context.Context, options Options) (clients.DeploymentResult, error)
+
+	// Preview computes the changes a deployment would make without applying them.
+	Preview(ctx context.Context, options Options) (*preview.ChangeSet, error)
 }
 
 // Options contains options to be used with DeployWithProgress.
@@ -67,3 +71,8 @@ type Impl struct {
 func (*Impl) DeployWithProgress(ctx context.Context, options Options) (clients.DeploymentResult, error) {
 	return DeployWithProgress(ctx, options)
 }
+
+// Preview computes the changes a deployment would make without applying them.
+func (*Impl) Preview(ctx context.Context, options Options) (*preview.ChangeSet, error) {
+	return Preview(ctx, options)
+}
@@ -34,7 +34,8 @@ const (
 )
 
 type CLIClusterOptions struct {
-	Radius RadiusOptions
+	Contour ContourOptions
+	Radius  RadiusOptions
 }
 
 type ClusterOptions struct {
@@ -68,6 +69,10 @@ func PopulateDefaultClusterOptions(cliOptions CLIClusterOptions) ClusterOptions
 	options := NewDefaultClusterOptions()
 
 	// If any of the CLI options are provided, override the default options.
+	if cliOptions.Contour.Disabled {
+		options.Contour.Disabled = cliOptions.Contour.Disabled
+	}
+
 	if cliOptions.Radius.Reinstall {
 		options.Radius.Reinstall = cliOptions.Radius.Reinstall
 	}
@@ -102,7 +107,7 @@ func Install(ctx context.Context, clusterOptions ClusterOptions, kubeContext str
 }
 
 // InstallOnCluster applies the Helm charts for Radius and Contour to the cluster, and returns whether an existing
-// installation was found. If an error occurs, it is returned.
+// installation was found. The Contour chart is skipped when Contour is disabled. If an error occurs, it is returned.
 func InstallOnCluster(ctx context.Context, options ClusterOptions, kubeContext string) (bool, error) {
 	// Do note: the namespace passed in to rad install kubernetes
 	// doesn't match the namespace where we deploy radius.
@@ -114,6 +119,10 @@ func InstallOnCluster(ctx context.Context, options ClusterOptions, kubeContext s
 		return false, err
 	}
 
+	if options.Contour.Disabled {
+		return foundExisting, nil
+	}
+
 	err = ApplyContourHelmChart(options.Contour, kubeContext)
 	if err != nil {
 		return false, err
@@ -25,6 +25,9 @@ import (
 
 func Test_CanSetCLIOptions(t *testing.T) {
 	cliOptions := CLIClusterOptions{
+		Contour: ContourOptions{
+			Disabled: true,
+		},
 		Radius: RadiusOptions{
 			ChartPath: "chartpath",
 			Reinstall: true,
@@ -34,6 +37,8 @@ func Test_CanSetCLIOptions(t *testing.T) {
 
 	require.Equal(t, "chartpath", clusterOptions.Radius.ChartPath)
 	require.Equal(t, true, clusterOptions.Radius.Reinstall)
+	require.Equal(t, true, clusterOptions.Contour.Disabled)
+	require.Equal(t, ContourChartDefaultVersion, clusterOptions.Contour.ChartVersion)
 
 }
 
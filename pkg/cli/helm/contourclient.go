@@ -38,6 +38,10 @@ const (
 type ContourOptions struct {
 	ChartVersion string
 	HostNetwork  bool
+
+	// Disabled skips the installation of Contour, for clusters that expose gateways through another
+	// implementation such as the Kubernetes Gateway API.
+	Disabled bool
 }
 
 // // ApplyContourHelmChart checks if a Contour Helm chart has been installed, and if not, installs it with the given
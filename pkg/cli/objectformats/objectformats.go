@@ -55,6 +55,139 @@ func GetApplicationGatewaysTableFormat() output.FormatterOptions {
 	}
 }
 
+// GetApplicationJobsTableFormat() returns a FormatterOptions object which contains a list of columns to be used for
+// formatting the output of a list of application jobs.
+func GetApplicationJobsTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "JOB",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "SCHEDULE",
+				JSONPath: "{ .Schedule }",
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .Status }",
+			},
+		},
+	}
+}
+
+// GetApplicationRevisionsTableFormat() returns a FormatterOptions object which contains a list of columns to be used for
+// formatting the output of the revision history of an application.
+func GetApplicationRevisionsTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "REVISION",
+				JSONPath: "{ .Revision }",
+			},
+			{
+				Heading:  "CREATED",
+				JSONPath: "{ .Created }",
+			},
+			{
+				Heading:  "REASON",
+				JSONPath: "{ .Reason }",
+			},
+			{
+				Heading:  "STATE",
+				JSONPath: "{ .State }",
+			},
+			{
+				Heading:  "RESOURCES",
+				JSONPath: "{ .ResourceCount }",
+			},
+		},
+	}
+}
+
+// GetAuditEventTableFormat() returns a FormatterOptions object which contains a list of columns to be used for
+// formatting the output of the audit log.
+func GetAuditEventTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "TIME",
+				JSONPath: "{ .Time }",
+			},
+			{
+				Heading:  "PRINCIPAL",
+				JSONPath: "{ .Principal }",
+			},
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .Operation }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Resource }",
+			},
+			{
+				Heading:  "OUTCOME",
+				JSONPath: "{ .Outcome }",
+			},
+			{
+				Heading:  "OPERATION STATE",
+				JSONPath: "{ .State }",
+			},
+		},
+	}
+}
+
+// GetLockTableFormat() returns a FormatterOptions object which contains a list of columns to be used for formatting
+// the output of locks.
+func GetLockTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "LEVEL",
+				JSONPath: "{ .Properties.Level }",
+			},
+			{
+				Heading:  "SCOPE",
+				JSONPath: "{ .Properties.Scope }",
+			},
+			{
+				Heading:  "NOTES",
+				JSONPath: "{ .Properties.Notes }",
+			},
+		},
+	}
+}
+
+// GetQuotaUsageTableFormat() returns a FormatterOptions object which contains a list of columns to be used for
+// formatting the usage of the limits of quotas.
+func GetQuotaUsageTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "QUOTA",
+				JSONPath: "{ .Quota }",
+			},
+			{
+				Heading:  "LIMIT",
+				JSONPath: "{ .Limit }",
+			},
+			{
+				Heading:  "USED",
+				JSONPath: "{ .Used }",
+			},
+			{
+				Heading:  "MAX",
+				JSONPath: "{ .Max }",
+			},
+		},
+	}
+}
+
 // GetResourceTableFormat() returns a FormatterOptions struct containing two columns, one for the resource name and one for
 // the resource type.
 func GetResourceTableFormat() output.FormatterOptions {
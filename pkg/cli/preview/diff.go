@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// readOnlyProperties are the top-level properties that the server sets for every resource type.
+var readOnlyProperties = []string{"provisioningState", "status"}
+
+// readOnlyResourceProperties are the top-level properties that the server sets for specific resource types,
+// by lowercase resource type.
+var readOnlyResourceProperties = map[string][]string{
+	"applications.core/gateways":      {"url"},
+	"applications.core/httproutes":    {"scheme", "url"},
+	"applications.dapr/pubsubbrokers": {"componentName"},
+	"applications.dapr/secretstores":  {"componentName"},
+	"applications.dapr/statestores":   {"componentName"},
+}
+
+// IsReadOnlyProperty returns true if the top-level property of a resource of the given type is set by the server
+// and can't be declared in a template.
+func IsReadOnlyProperty(resourceType string, name string) bool {
+	for _, property := range readOnlyProperties {
+		if strings.EqualFold(property, name) {
+			return true
+		}
+	}
+
+	for _, property := range readOnlyResourceProperties[strings.ToLower(resourceType)] {
+		if strings.EqualFold(property, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Diff compares the properties that a template declares for a resource of the given type with the current
+// properties of the resource, and returns the changes sorted by path. Read-only properties, like
+// "provisioningState" and "status", are set by the server and so are not reported as removed. Neither are
+// empty values, like the empty "extensions" that the server returns for a container that has none.
+func Diff(resourceType string, current map[string]any, desired map[string]any) []PropertyChange {
+	return diffProperties(current, desired, func(name string, before any) bool {
+		return IsReadOnlyProperty(resourceType, name) || isEmpty(before)
+	})
+}
+
+// diffProperties compares two sets of properties. Top-level properties that are only present in current are
+// reported as removed, unless ignoreRemoval returns true for them.
+func diffProperties(current map[string]any, desired map[string]any, ignoreRemoval func(name string, before any) bool) []PropertyChange {
+	changes := []PropertyChange{}
+	diffObject("properties", normalize(current), normalize(desired), ignoreRemoval, &changes)
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes
+}
+
+func diffObject(path string, current any, desired any, ignoreRemoval func(name string, before any) bool, changes *[]PropertyChange) {
+	currentObject, _ := current.(map[string]any)
+	desiredObject, _ := desired.(map[string]any)
+
+	for key, after := range desiredObject {
+		childPath := path + "." + key
+		before, ok := currentObject[key]
+		if !ok {
+			// New objects are listed property by property, so that each value is shown on its own line.
+			if obj, isObject := after.(map[string]any); isObject && len(obj) > 0 {
+				diffObject(childPath, nil, after, nil, changes)
+			} else if after != nil {
+				*changes = append(*changes, newChange(childPath, ChangeAdded, nil, after))
+			}
+			continue
+		}
+
+		diffValue(childPath, before, after, changes)
+	}
+
+	for key, before := range currentObject {
+		if ignoreRemoval != nil && ignoreRemoval(key, before) {
+			continue
+		}
+		if _, ok := desiredObject[key]; !ok && before != nil {
+			*changes = append(*changes, PropertyChange{Path: path + "." + key, Kind: ChangeRemoved, Before: before})
+		}
+	}
+}
+
+func diffValue(path string, before any, after any, changes *[]PropertyChange) {
+	_, beforeIsObject := before.(map[string]any)
+	_, afterIsObject := after.(map[string]any)
+	switch {
+	case isUnknown(after):
+		*changes = append(*changes, PropertyChange{Path: path, Kind: ChangeUnknown, Before: before, After: after})
+	case after == SensitiveValueText:
+		// The server does not return secrets, so changes to them can't be detected.
+	case beforeIsObject && afterIsObject:
+		diffObject(path, before, after, nil, changes)
+	case !valuesEqual(before, after):
+		*changes = append(*changes, newChange(path, ChangeModified, before, after))
+	}
+}
+
+// isEmpty returns true for empty objects and arrays.
+func isEmpty(v any) bool {
+	switch value := v.(type) {
+	case map[string]any:
+		return len(value) == 0
+	case []any:
+		return len(value) == 0
+	}
+
+	return false
+}
+
+func newChange(path string, kind ChangeKind, before any, after any) PropertyChange {
+	if containsUnknown(after) {
+		kind = ChangeUnknown
+	}
+
+	return PropertyChange{Path: path, Kind: kind, Before: before, After: after}
+}
+
+// valuesEqual compares two values. Resource IDs, including IDs relative to their scope, are compared
+// case-insensitively because the server normalizes the casing of resource types.
+func valuesEqual(a any, b any) bool {
+	if sa, ok := a.(string); ok {
+		if sb, ok := b.(string); ok && isResourceID(sa) && isResourceID(sb) {
+			return strings.EqualFold(sa, sb)
+		}
+	}
+
+	switch av := a.(type) {
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !valuesEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			if other, ok := bv[k]; !ok || !valuesEqual(v, other) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func isResourceID(s string) bool {
+	lower := strings.ToLower(s)
+	return strings.HasPrefix(lower, "/planes/") || strings.HasPrefix(lower, "/subscriptions/") || strings.HasPrefix(lower, "/providers/")
+}
+
+func containsUnknown(v any) bool {
+	switch value := v.(type) {
+	case Unknown:
+		return true
+	case map[string]any:
+		for _, item := range value {
+			if containsUnknown(item) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range value {
+			if containsUnknown(item) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
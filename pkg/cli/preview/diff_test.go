@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Diff(t *testing.T) {
+	current := map[string]any{
+		"provisioningState": "Succeeded",
+		"application":       "/planes/radius/local/resourcegroups/test-group/providers/applications.core/applications/demo-app",
+		"container": map[string]any{
+			"image": "nginx:1.0",
+			"env": map[string]any{
+				"A": "1",
+				"B": "2",
+			},
+			"ports": []any{float64(80)},
+		},
+		"status": map[string]any{"outputResources": []any{}},
+	}
+	desired := map[string]any{
+		"application": testApplicationID,
+		"container": map[string]any{
+			"image": "nginx:2.0",
+			"env": map[string]any{
+				"A": "1",
+				"C": Unknown{Expression: "[reference('x').y]"},
+			},
+			"ports": []any{int64(80)},
+		},
+		"extensions": []any{map[string]any{"kind": "daprSidecar"}},
+		"secret":     SensitiveValueText,
+	}
+
+	changes := Diff("Applications.Core/containers", current, desired)
+	require.Equal(t, []PropertyChange{
+		{Path: "properties.container.env.B", Kind: ChangeRemoved, Before: "2"},
+		{Path: "properties.container.env.C", Kind: ChangeUnknown, After: Unknown{Expression: "[reference('x').y]"}},
+		{Path: "properties.container.image", Kind: ChangeModified, Before: "nginx:1.0", After: "nginx:2.0"},
+		{Path: "properties.extensions", Kind: ChangeAdded, After: []any{map[string]any{"kind": "daprSidecar"}}},
+		{Path: "properties.secret", Kind: ChangeAdded, After: SensitiveValueText},
+	}, changes)
+}
+
+func Test_Diff_NoChange(t *testing.T) {
+	current := map[string]any{
+		"provisioningState": "Succeeded",
+		"container":         map[string]any{"image": "nginx", "ports": []any{float64(80)}},
+		"extensions":        []any{},
+	}
+	desired := map[string]any{
+		"container": map[string]any{"image": "nginx", "ports": []any{int64(80)}},
+	}
+
+	require.Empty(t, Diff("Applications.Core/containers", current, desired))
+}
+
+func Test_Diff_RemovedProperty(t *testing.T) {
+	current := map[string]any{
+		"provisioningState": "Succeeded",
+		"container":         map[string]any{"image": "nginx"},
+		"connections":       map[string]any{"db": map[string]any{"source": "/providers/Applications.Datastores/redisCaches/db"}},
+	}
+	desired := map[string]any{
+		"container": map[string]any{"image": "nginx"},
+	}
+
+	// Deploying the template removes the connections that were set by a previous deployment.
+	require.Equal(t, []PropertyChange{
+		{Path: "properties.connections", Kind: ChangeRemoved, Before: map[string]any{"db": map[string]any{"source": "/providers/Applications.Datastores/redisCaches/db"}}},
+	}, Diff("Applications.Core/containers", current, desired))
+}
+
+func Test_Diff_ReadOnlyProperties(t *testing.T) {
+	current := map[string]any{
+		"provisioningState": "Succeeded",
+		"status":            map[string]any{"outputResources": []any{}},
+		"url":               "http://demo.localhost",
+		"routes":            []any{map[string]any{"path": "/"}},
+	}
+	desired := map[string]any{
+		"routes": []any{map[string]any{"path": "/"}},
+	}
+
+	require.Empty(t, Diff("Applications.Core/gateways", current, desired))
+
+	// The url is only read-only for gateways.
+	require.Equal(t, []PropertyChange{
+		{Path: "properties.url", Kind: ChangeRemoved, Before: "http://demo.localhost"},
+	}, Diff("Applications.Core/extenders", current, desired))
+}
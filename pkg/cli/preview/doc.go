@@ -0,0 +1,24 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// preview computes the change set for a Bicep or ARM-JSON deployment without applying it.
+//
+// The template is evaluated on the client: parameters, variables, conditions, copy loops, and nested
+// modules are resolved, and values that can only be known once the deployment runs are reported as
+// unknown. The resulting Radius resources are then compared against their current state, read using the
+// resource IDs in the scope each resource is deployed to, to produce a per-resource action and a
+// property-level diff.
+package preview
@@ -0,0 +1,257 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// node is a parsed template expression.
+type node interface{}
+
+// literalNode is a string or integer literal.
+type literalNode struct {
+	value any
+}
+
+// callNode is a function call, for example "parameters('name')".
+type callNode struct {
+	name string
+	args []node
+}
+
+// propertyNode is a property access, for example "reference('app').id".
+type propertyNode struct {
+	target node
+	name   string
+}
+
+// indexNode is an index access, for example "variables('list')[0]".
+type indexNode struct {
+	target node
+	index  node
+}
+
+// isExpression returns true if the string is a template expression. Strings that start with "[[" are
+// escaped literals.
+func isExpression(s string) bool {
+	return len(s) >= 2 && strings.HasPrefix(s, "[") && !strings.HasPrefix(s, "[[") && strings.HasSuffix(s, "]")
+}
+
+// unescapeLiteral removes the escape from a literal string that starts with "[[".
+func unescapeLiteral(s string) string {
+	if strings.HasPrefix(s, "[[") {
+		return s[1:]
+	}
+
+	return s
+}
+
+// parseExpression parses the body of a template expression, without the enclosing brackets.
+func parseExpression(text string) (node, error) {
+	p := &parser{text: text}
+	n, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipWhitespace()
+	if p.pos != len(p.text) {
+		return nil, p.errorf("unexpected %q", p.text[p.pos:])
+	}
+
+	return n, nil
+}
+
+type parser struct {
+	text string
+	pos  int
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid expression %q at position %d: %s", p.text, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) skipWhitespace() {
+	for p.pos < len(p.text) && unicode.IsSpace(rune(p.text[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *parser) peek() byte {
+	if p.pos < len(p.text) {
+		return p.text[p.pos]
+	}
+
+	return 0
+}
+
+func (p *parser) expect(c byte) error {
+	p.skipWhitespace()
+	if p.peek() != c {
+		return p.errorf("expected %q", c)
+	}
+
+	p.pos++
+	return nil
+}
+
+func (p *parser) parseValue() (node, error) {
+	n, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipWhitespace()
+		switch p.peek() {
+		case '.':
+			p.pos++
+			p.skipWhitespace()
+			name := p.parseIdentifier()
+			if name == "" {
+				return nil, p.errorf("expected property name")
+			}
+			n = &propertyNode{target: n, name: name}
+		case '[':
+			p.pos++
+			index, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(']'); err != nil {
+				return nil, err
+			}
+			n = &indexNode{target: n, index: index}
+		default:
+			return n, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	p.skipWhitespace()
+	c := p.peek()
+	switch {
+	case c == '\'':
+		return p.parseString()
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	}
+
+	name := p.parseIdentifier()
+	if name == "" {
+		return nil, p.errorf("expected a value")
+	}
+
+	p.skipWhitespace()
+	if p.peek() != '(' {
+		switch strings.ToLower(name) {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		case "null":
+			return &literalNode{value: nil}, nil
+		}
+		return nil, p.errorf("expected '(' after %q", name)
+	}
+	p.pos++
+
+	call := &callNode{name: name}
+	p.skipWhitespace()
+	if p.peek() == ')' {
+		p.pos++
+		return call, nil
+	}
+
+	for {
+		arg, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		call.args = append(call.args, arg)
+
+		p.skipWhitespace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return call, nil
+		default:
+			return nil, p.errorf("expected ',' or ')'")
+		}
+	}
+}
+
+func (p *parser) parseIdentifier() string {
+	start := p.pos
+	for p.pos < len(p.text) {
+		c := rune(p.text[p.pos])
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' && c != '$' {
+			break
+		}
+		p.pos++
+	}
+
+	return p.text[start:p.pos]
+}
+
+func (p *parser) parseString() (node, error) {
+	// Skip the opening quote. A quote is escaped by doubling it.
+	p.pos++
+	sb := strings.Builder{}
+	for p.pos < len(p.text) {
+		c := p.text[p.pos]
+		p.pos++
+		if c != '\'' {
+			sb.WriteByte(c)
+			continue
+		}
+
+		if p.peek() == '\'' {
+			sb.WriteByte('\'')
+			p.pos++
+			continue
+		}
+
+		return &literalNode{value: sb.String()}, nil
+	}
+
+	return nil, p.errorf("unterminated string")
+}
+
+func (p *parser) parseNumber() (node, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	for p.pos < len(p.text) && p.text[p.pos] >= '0' && p.text[p.pos] <= '9' {
+		p.pos++
+	}
+
+	value, err := strconv.ParseInt(p.text[start:p.pos], 10, 64)
+	if err != nil {
+		return nil, p.errorf("invalid number %q", p.text[start:p.pos])
+	}
+
+	return &literalNode{value: value}, nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+var actionSymbols = map[Action]string{
+	ActionCreate:    "+",
+	ActionUpdate:    "~",
+	ActionNoChange:  "=",
+	ActionUnmanaged: "!",
+	ActionIgnore:    "*",
+}
+
+var changeSymbols = map[ChangeKind]string{
+	ChangeAdded:    "+",
+	ChangeModified: "~",
+	ChangeRemoved:  "-",
+	ChangeUnknown:  "~",
+}
+
+// WriteText writes a human-readable description of the change set, in the style of a plan.
+func WriteText(w io.Writer, changeSet *ChangeSet) error {
+	sb := &strings.Builder{}
+	sb.WriteString("Resource and property-level changes:\n")
+	if len(changeSet.Resources) == 0 {
+		sb.WriteString("\n  The template does not declare any resources.\n")
+	}
+
+	for _, resource := range changeSet.Resources {
+		fmt.Fprintf(sb, "\n  %s %s %s (%s)\n", actionSymbols[resource.Action], resource.Type, resource.Name, resource.Action)
+		if resource.Message != "" {
+			fmt.Fprintf(sb, "      %s\n", resource.Message)
+		}
+
+		for _, change := range resource.Changes {
+			switch change.Kind {
+			case ChangeAdded:
+				fmt.Fprintf(sb, "      + %s: %s\n", change.Path, formatDiffValue(change.After))
+			case ChangeRemoved:
+				fmt.Fprintf(sb, "      - %s: %s\n", change.Path, formatDiffValue(change.Before))
+			default:
+				if change.Before == nil {
+					fmt.Fprintf(sb, "      %s %s: %s\n", changeSymbols[change.Kind], change.Path, formatDiffValue(change.After))
+				} else {
+					fmt.Fprintf(sb, "      %s %s: %s => %s\n", changeSymbols[change.Kind], change.Path, formatDiffValue(change.Before), formatDiffValue(change.After))
+				}
+			}
+		}
+
+		if resource.Recipe != nil {
+			writeRecipe(sb, resource.Recipe)
+		}
+	}
+
+	fmt.Fprintf(sb, "\nPreview: %d to create, %d to update, %d unchanged, %d not managed by the template, %d ignored.\n",
+		changeSet.Summary[ActionCreate],
+		changeSet.Summary[ActionUpdate],
+		changeSet.Summary[ActionNoChange],
+		changeSet.Summary[ActionUnmanaged],
+		changeSet.Summary[ActionIgnore])
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeRecipe(sb *strings.Builder, recipe *RecipePlan) {
+	fmt.Fprintf(sb, "      recipe %q", recipe.Name)
+	if recipe.TemplateKind != "" {
+		fmt.Fprintf(sb, " (%s: %s)", recipe.TemplateKind, recipe.TemplatePath)
+	}
+	sb.WriteString("\n")
+
+	if recipe.Message != "" {
+		fmt.Fprintf(sb, "        %s\n", recipe.Message)
+	}
+
+	keys := []string{}
+	for k := range recipe.Parameters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(sb, "        parameter %s: %s\n", k, formatDiffValue(recipe.Parameters[k]))
+	}
+
+	for _, id := range recipe.OutputResources {
+		fmt.Fprintf(sb, "        currently provisions %s\n", id)
+	}
+}
+
+func formatDiffValue(v any) string {
+	if u, ok := v.(Unknown); ok {
+		return u.String()
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,967 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// function is a template function whose arguments are evaluated before it is called. Functions are not
+// called if any of their arguments is unknown.
+type function func(args []any) (any, error)
+
+// functions contains the template functions that can be evaluated on the client. Functions that need access
+// to the template, like parameters() and reference(), are implemented by the evaluator. Any other function
+// produces an unknown value.
+var functions = map[string]function{
+	"add":             arithmetic(func(a, b int64) (int64, error) { return a + b, nil }),
+	"and":             and,
+	"bool":            toBool,
+	"coalesce":        coalesce,
+	"concat":          concat,
+	"contains":        contains,
+	"createarray":     func(args []any) (any, error) { return append([]any{}, args...), nil },
+	"createobject":    createObject,
+	"div":             arithmetic(divide),
+	"empty":           empty,
+	"endswith":        stringPredicate(strings.HasSuffix),
+	"equals":          equals,
+	"false":           func(args []any) (any, error) { return false, nil },
+	"first":           first,
+	"format":          format,
+	"greater":         compare(func(c int) bool { return c > 0 }),
+	"greaterorequals": compare(func(c int) bool { return c >= 0 }),
+	"indexof":         indexOf,
+	"int":             toInt,
+	"join":            join,
+	"json":            parseJSON,
+	"last":            last,
+	"lastindexof":     lastIndexOf,
+	"length":          length,
+	"less":            compare(func(c int) bool { return c < 0 }),
+	"lessorequals":    compare(func(c int) bool { return c <= 0 }),
+	"max":             minMax(func(a, b int64) bool { return a > b }),
+	"min":             minMax(func(a, b int64) bool { return a < b }),
+	"mod":             arithmetic(modulo),
+	"mul":             arithmetic(func(a, b int64) (int64, error) { return a * b, nil }),
+	"not":             not,
+	"null":            func(args []any) (any, error) { return nil, nil },
+	"objectkeys":      objectKeys,
+	"or":              or,
+	"range":           rangeFunction,
+	"replace":         replace,
+	"skip":            skip,
+	"split":           split,
+	"startswith":      stringPredicate(strings.HasPrefix),
+	"string":          toStringValue,
+	"sub":             arithmetic(func(a, b int64) (int64, error) { return a - b, nil }),
+	"substring":       substring,
+	"take":            take,
+	"tolower":         stringTransform(strings.ToLower),
+	"toupper":         stringTransform(strings.ToUpper),
+	"trim":            stringTransform(strings.TrimSpace),
+	"true":            func(args []any) (any, error) { return true, nil },
+	"union":           union,
+	"uri":             uri,
+}
+
+func requireArgs(args []any, min int, max int) error {
+	switch {
+	case min == max && len(args) != min:
+		return fmt.Errorf("expected %d arguments, got %d", min, len(args))
+	case len(args) < min:
+		return fmt.Errorf("expected at least %d arguments, got %d", min, len(args))
+	case max >= 0 && len(args) > max:
+		return fmt.Errorf("expected at most %d arguments, got %d", max, len(args))
+	}
+
+	return nil
+}
+
+// asInt converts a JSON or expression number to an integer.
+func asInt(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case float64:
+		if n == math.Trunc(n) {
+			return int64(n), true
+		}
+	case float32:
+		if float64(n) == math.Trunc(float64(n)) {
+			return int64(n), true
+		}
+	}
+
+	return 0, false
+}
+
+func asString(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("expected a string, got %s", describe(v))
+	}
+
+	return s, nil
+}
+
+func asBool(v any) (bool, error) {
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected a boolean, got %s", describe(v))
+	}
+
+	return b, nil
+}
+
+func describe(v any) string {
+	if v == nil {
+		return "null"
+	}
+
+	return reflect.TypeOf(v).String()
+}
+
+// normalize converts numbers to int64 where possible so that values from JSON and from expressions compare equal.
+func normalize(v any) any {
+	switch value := v.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for k, item := range value {
+			result[k] = normalize(item)
+		}
+		return result
+	case []any:
+		result := make([]any, len(value))
+		for i, item := range value {
+			result[i] = normalize(item)
+		}
+		return result
+	}
+
+	if n, ok := asInt(v); ok {
+		return n
+	}
+
+	return v
+}
+
+// formatValue formats a value the way string() does.
+func formatValue(v any) string {
+	switch value := v.(type) {
+	case string:
+		return value
+	case bool:
+		if value {
+			return "True"
+		}
+		return "False"
+	case nil:
+		return ""
+	case Unknown:
+		return value.String()
+	}
+
+	if n, ok := asInt(v); ok {
+		return strconv.FormatInt(n, 10)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
+
+func and(args []any) (any, error) {
+	if err := requireArgs(args, 2, -1); err != nil {
+		return nil, err
+	}
+
+	for _, arg := range args {
+		b, err := asBool(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !b {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func or(args []any) (any, error) {
+	if err := requireArgs(args, 2, -1); err != nil {
+		return nil, err
+	}
+
+	for _, arg := range args {
+		b, err := asBool(arg)
+		if err != nil {
+			return nil, err
+		}
+		if b {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func not(args []any) (any, error) {
+	if err := requireArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+
+	b, err := asBool(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return !b, nil
+}
+
+func toBool(args []any) (any, error) {
+	if err := requireArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+
+	switch v := args[0].(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(v)
+	}
+
+	if n, ok := asInt(args[0]); ok {
+		return n != 0, nil
+	}
+
+	return nil, fmt.Errorf("cannot convert %s to a boolean", describe(args[0]))
+}
+
+func toInt(args []any) (any, error) {
+	if err := requireArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+
+	if s, ok := args[0].(string); ok {
+		return strconv.ParseInt(s, 10, 64)
+	}
+
+	if n, ok := asInt(args[0]); ok {
+		return n, nil
+	}
+
+	return nil, fmt.Errorf("cannot convert %s to an integer", describe(args[0]))
+}
+
+func toStringValue(args []any) (any, error) {
+	if err := requireArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+
+	return formatValue(args[0]), nil
+}
+
+func parseJSON(args []any) (any, error) {
+	if err := requireArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+
+	s, err := asString(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var result any
+	if err := json.Unmarshal([]byte(s), &result); err != nil {
+		return nil, err
+	}
+
+	return normalize(result), nil
+}
+
+func coalesce(args []any) (any, error) {
+	for _, arg := range args {
+		if arg != nil {
+			return arg, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func concat(args []any) (any, error) {
+	if len(args) == 0 {
+		return "", nil
+	}
+
+	if _, ok := args[0].([]any); ok {
+		result := []any{}
+		for _, arg := range args {
+			items, ok := arg.([]any)
+			if !ok {
+				return nil, fmt.Errorf("expected an array, got %s", describe(arg))
+			}
+			result = append(result, items...)
+		}
+		return result, nil
+	}
+
+	sb := strings.Builder{}
+	for _, arg := range args {
+		sb.WriteString(formatValue(arg))
+	}
+
+	return sb.String(), nil
+}
+
+func contains(args []any) (any, error) {
+	if err := requireArgs(args, 2, 2); err != nil {
+		return nil, err
+	}
+
+	switch container := args[0].(type) {
+	case string:
+		return strings.Contains(container, formatValue(args[1])), nil
+	case []any:
+		for _, item := range container {
+			if reflect.DeepEqual(normalize(item), normalize(args[1])) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case map[string]any:
+		key, err := asString(args[1])
+		if err != nil {
+			return nil, err
+		}
+		for k := range container {
+			if strings.EqualFold(k, key) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	return nil, fmt.Errorf("cannot search %s", describe(args[0]))
+}
+
+func createObject(args []any) (any, error) {
+	if len(args)%2 != 0 {
+		return nil, fmt.Errorf("expected an even number of arguments, got %d", len(args))
+	}
+
+	result := map[string]any{}
+	for i := 0; i < len(args); i += 2 {
+		key, err := asString(args[i])
+		if err != nil {
+			return nil, err
+		}
+		result[key] = args[i+1]
+	}
+
+	return result, nil
+}
+
+func empty(args []any) (any, error) {
+	if err := requireArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+
+	switch v := args[0].(type) {
+	case nil:
+		return true, nil
+	case string:
+		return v == "", nil
+	case []any:
+		return len(v) == 0, nil
+	case map[string]any:
+		return len(v) == 0, nil
+	}
+
+	return nil, fmt.Errorf("cannot check whether %s is empty", describe(args[0]))
+}
+
+func equals(args []any) (any, error) {
+	if err := requireArgs(args, 2, 2); err != nil {
+		return nil, err
+	}
+
+	return reflect.DeepEqual(normalize(args[0]), normalize(args[1])), nil
+}
+
+func first(args []any) (any, error) {
+	if err := requireArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+
+	switch v := args[0].(type) {
+	case string:
+		if v == "" {
+			return "", nil
+		}
+		return v[:1], nil
+	case []any:
+		if len(v) == 0 {
+			return nil, nil
+		}
+		return v[0], nil
+	}
+
+	return nil, fmt.Errorf("expected a string or array, got %s", describe(args[0]))
+}
+
+func last(args []any) (any, error) {
+	if err := requireArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+
+	switch v := args[0].(type) {
+	case string:
+		if v == "" {
+			return "", nil
+		}
+		return v[len(v)-1:], nil
+	case []any:
+		if len(v) == 0 {
+			return nil, nil
+		}
+		return v[len(v)-1], nil
+	}
+
+	return nil, fmt.Errorf("expected a string or array, got %s", describe(args[0]))
+}
+
+// format implements the .NET composite format syntax used by the format() function. Format specifiers
+// like "{0:D}" are accepted and ignored.
+func format(args []any) (any, error) {
+	if err := requireArgs(args, 1, -1); err != nil {
+		return nil, err
+	}
+
+	f, err := asString(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	sb := strings.Builder{}
+	for i := 0; i < len(f); i++ {
+		c := f[i]
+		switch {
+		case c == '{' && i+1 < len(f) && f[i+1] == '{':
+			sb.WriteByte('{')
+			i++
+		case c == '}' && i+1 < len(f) && f[i+1] == '}':
+			sb.WriteByte('}')
+			i++
+		case c == '{':
+			end := strings.IndexByte(f[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid format string %q", f)
+			}
+			placeholder := f[i+1 : i+end]
+			if colon := strings.IndexByte(placeholder, ':'); colon >= 0 {
+				placeholder = placeholder[:colon]
+			}
+			index, err := strconv.Atoi(strings.TrimSpace(placeholder))
+			if err != nil || index < 0 || index+1 >= len(args) {
+				return nil, fmt.Errorf("invalid format placeholder %q in %q", f[i:i+end+1], f)
+			}
+			sb.WriteString(formatValue(args[index+1]))
+			i += end
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func indexOf(args []any) (any, error) {
+	if err := requireArgs(args, 2, 2); err != nil {
+		return nil, err
+	}
+
+	if s, ok := args[0].(string); ok {
+		return int64(strings.Index(strings.ToLower(s), strings.ToLower(formatValue(args[1])))), nil
+	}
+
+	items, ok := args[0].([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a string or array, got %s", describe(args[0]))
+	}
+	for i, item := range items {
+		if reflect.DeepEqual(normalize(item), normalize(args[1])) {
+			return int64(i), nil
+		}
+	}
+
+	return int64(-1), nil
+}
+
+func lastIndexOf(args []any) (any, error) {
+	if err := requireArgs(args, 2, 2); err != nil {
+		return nil, err
+	}
+
+	if s, ok := args[0].(string); ok {
+		return int64(strings.LastIndex(strings.ToLower(s), strings.ToLower(formatValue(args[1])))), nil
+	}
+
+	items, ok := args[0].([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a string or array, got %s", describe(args[0]))
+	}
+	for i := len(items) - 1; i >= 0; i-- {
+		if reflect.DeepEqual(normalize(items[i]), normalize(args[1])) {
+			return int64(i), nil
+		}
+	}
+
+	return int64(-1), nil
+}
+
+func join(args []any) (any, error) {
+	if err := requireArgs(args, 2, 2); err != nil {
+		return nil, err
+	}
+
+	items, ok := args[0].([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an array, got %s", describe(args[0]))
+	}
+	delimiter, err := asString(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, len(items))
+	for i, item := range items {
+		values[i] = formatValue(item)
+	}
+
+	return strings.Join(values, delimiter), nil
+}
+
+func length(args []any) (any, error) {
+	if err := requireArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+
+	switch v := args[0].(type) {
+	case string:
+		return int64(len(v)), nil
+	case []any:
+		return int64(len(v)), nil
+	case map[string]any:
+		return int64(len(v)), nil
+	}
+
+	return nil, fmt.Errorf("cannot compute the length of %s", describe(args[0]))
+}
+
+func objectKeys(args []any) (any, error) {
+	if err := requireArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+
+	obj, ok := args[0].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an object, got %s", describe(args[0]))
+	}
+
+	keys := []string{}
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := make([]any, len(keys))
+	for i, k := range keys {
+		result[i] = k
+	}
+
+	return result, nil
+}
+
+func rangeFunction(args []any) (any, error) {
+	if err := requireArgs(args, 2, 2); err != nil {
+		return nil, err
+	}
+
+	start, ok := asInt(args[0])
+	if !ok {
+		return nil, fmt.Errorf("expected an integer, got %s", describe(args[0]))
+	}
+	count, ok := asInt(args[1])
+	if !ok || count < 0 {
+		return nil, fmt.Errorf("expected a non-negative integer, got %v", args[1])
+	}
+
+	result := make([]any, count)
+	for i := range result {
+		result[i] = start + int64(i)
+	}
+
+	return result, nil
+}
+
+func replace(args []any) (any, error) {
+	if err := requireArgs(args, 3, 3); err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 3)
+	for i, arg := range args {
+		s, err := asString(arg)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = s
+	}
+
+	return strings.ReplaceAll(values[0], values[1], values[2]), nil
+}
+
+func skip(args []any) (any, error) {
+	if err := requireArgs(args, 2, 2); err != nil {
+		return nil, err
+	}
+
+	n, ok := asInt(args[1])
+	if !ok {
+		return nil, fmt.Errorf("expected an integer, got %s", describe(args[1]))
+	}
+
+	switch v := args[0].(type) {
+	case string:
+		return v[clamp(n, len(v)):], nil
+	case []any:
+		return v[clamp(n, len(v)):], nil
+	}
+
+	return nil, fmt.Errorf("expected a string or array, got %s", describe(args[0]))
+}
+
+func take(args []any) (any, error) {
+	if err := requireArgs(args, 2, 2); err != nil {
+		return nil, err
+	}
+
+	n, ok := asInt(args[1])
+	if !ok {
+		return nil, fmt.Errorf("expected an integer, got %s", describe(args[1]))
+	}
+
+	switch v := args[0].(type) {
+	case string:
+		return v[:clamp(n, len(v))], nil
+	case []any:
+		return v[:clamp(n, len(v))], nil
+	}
+
+	return nil, fmt.Errorf("expected a string or array, got %s", describe(args[0]))
+}
+
+func clamp(n int64, max int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > int64(max) {
+		return max
+	}
+
+	return int(n)
+}
+
+func split(args []any) (any, error) {
+	if err := requireArgs(args, 2, 2); err != nil {
+		return nil, err
+	}
+
+	s, err := asString(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	delimiters := []string{}
+	switch v := args[1].(type) {
+	case string:
+		delimiters = append(delimiters, v)
+	case []any:
+		for _, item := range v {
+			d, err := asString(item)
+			if err != nil {
+				return nil, err
+			}
+			delimiters = append(delimiters, d)
+		}
+	default:
+		return nil, fmt.Errorf("expected a string or array, got %s", describe(args[1]))
+	}
+
+	parts := []string{s}
+	for _, d := range delimiters {
+		next := []string{}
+		for _, part := range parts {
+			next = append(next, strings.Split(part, d)...)
+		}
+		parts = next
+	}
+
+	result := make([]any, len(parts))
+	for i, part := range parts {
+		result[i] = part
+	}
+
+	return result, nil
+}
+
+func substring(args []any) (any, error) {
+	if err := requireArgs(args, 2, 3); err != nil {
+		return nil, err
+	}
+
+	s, err := asString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	start, ok := asInt(args[1])
+	if !ok || start < 0 || start > int64(len(s)) {
+		return nil, fmt.Errorf("invalid start index %v for %q", args[1], s)
+	}
+	end := int64(len(s))
+	if len(args) == 3 {
+		n, ok := asInt(args[2])
+		if !ok || n < 0 || start+n > int64(len(s)) {
+			return nil, fmt.Errorf("invalid length %v for %q", args[2], s)
+		}
+		end = start + n
+	}
+
+	return s[start:end], nil
+}
+
+func union(args []any) (any, error) {
+	if err := requireArgs(args, 1, -1); err != nil {
+		return nil, err
+	}
+
+	if _, ok := args[0].([]any); ok {
+		result := []any{}
+		for _, arg := range args {
+			items, ok := arg.([]any)
+			if !ok {
+				return nil, fmt.Errorf("expected an array, got %s", describe(arg))
+			}
+			for _, item := range items {
+				found := false
+				for _, existing := range result {
+					if reflect.DeepEqual(normalize(existing), normalize(item)) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					result = append(result, item)
+				}
+			}
+		}
+		return result, nil
+	}
+
+	result := map[string]any{}
+	for _, arg := range args {
+		obj, ok := arg.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected an object, got %s", describe(arg))
+		}
+		for k, v := range obj {
+			result[k] = v
+		}
+	}
+
+	return result, nil
+}
+
+func uri(args []any) (any, error) {
+	if err := requireArgs(args, 2, 2); err != nil {
+		return nil, err
+	}
+
+	base, err := asString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	relative, err := asString(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasSuffix(base, "/") {
+		return base + strings.TrimPrefix(relative, "/"), nil
+	}
+
+	if i := strings.LastIndex(base, "/"); i >= 0 && i > strings.Index(base, "://")+2 {
+		base = base[:i]
+	}
+
+	return base + "/" + strings.TrimPrefix(relative, "/"), nil
+}
+
+func arithmetic(op func(a, b int64) (int64, error)) function {
+	return func(args []any) (any, error) {
+		if err := requireArgs(args, 2, 2); err != nil {
+			return nil, err
+		}
+
+		a, ok := asInt(args[0])
+		if !ok {
+			return nil, fmt.Errorf("expected an integer, got %s", describe(args[0]))
+		}
+		b, ok := asInt(args[1])
+		if !ok {
+			return nil, fmt.Errorf("expected an integer, got %s", describe(args[1]))
+		}
+
+		return op(a, b)
+	}
+}
+
+func divide(a, b int64) (int64, error) {
+	if b == 0 {
+		return 0, fmt.Errorf("division by zero")
+	}
+
+	return a / b, nil
+}
+
+func modulo(a, b int64) (int64, error) {
+	if b == 0 {
+		return 0, fmt.Errorf("division by zero")
+	}
+
+	return a % b, nil
+}
+
+func minMax(better func(a, b int64) bool) function {
+	return func(args []any) (any, error) {
+		if len(args) == 1 {
+			if items, ok := args[0].([]any); ok {
+				args = items
+			}
+		}
+		if err := requireArgs(args, 1, -1); err != nil {
+			return nil, err
+		}
+
+		var result int64
+		for i, arg := range args {
+			n, ok := asInt(arg)
+			if !ok {
+				return nil, fmt.Errorf("expected an integer, got %s", describe(arg))
+			}
+			if i == 0 || better(n, result) {
+				result = n
+			}
+		}
+
+		return result, nil
+	}
+}
+
+func compare(test func(int) bool) function {
+	return func(args []any) (any, error) {
+		if err := requireArgs(args, 2, 2); err != nil {
+			return nil, err
+		}
+
+		if a, ok := asInt(args[0]); ok {
+			b, ok := asInt(args[1])
+			if !ok {
+				return nil, fmt.Errorf("expected an integer, got %s", describe(args[1]))
+			}
+			switch {
+			case a < b:
+				return test(-1), nil
+			case a > b:
+				return test(1), nil
+			default:
+				return test(0), nil
+			}
+		}
+
+		a, err := asString(args[0])
+		if err != nil {
+			return nil, err
+		}
+		b, err := asString(args[1])
+		if err != nil {
+			return nil, err
+		}
+
+		return test(strings.Compare(a, b)), nil
+	}
+}
+
+func stringPredicate(test func(s, value string) bool) function {
+	return func(args []any) (any, error) {
+		if err := requireArgs(args, 2, 2); err != nil {
+			return nil, err
+		}
+
+		s, err := asString(args[0])
+		if err != nil {
+			return nil, err
+		}
+		value, err := asString(args[1])
+		if err != nil {
+			return nil, err
+		}
+
+		return test(strings.ToLower(s), strings.ToLower(value)), nil
+	}
+}
+
+func stringTransform(transform func(string) string) function {
+	return func(args []any) (any, error) {
+		if err := requireArgs(args, 1, 1); err != nil {
+			return nil, err
+		}
+
+		s, err := asString(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return transform(s), nil
+	}
+}
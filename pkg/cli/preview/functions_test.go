@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Expressions(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		expected   any
+	}{
+		{name: "string literal", expression: "[  'it''s'  ]", expected: "it's"},
+		{name: "escaped literal", expression: "[[not an expression]", expected: "[not an expression]"},
+		{name: "plain string", expression: "hello", expected: "hello"},
+		{name: "format", expression: "[format('{0}-{1:D}-{{x}}', 'a', 3)]", expected: "a-3-{x}"},
+		{name: "concat strings", expression: "[concat('a', 'b', 1)]", expected: "ab1"},
+		{name: "concat arrays", expression: "[concat(createArray(1), createArray(2, 3))]", expected: []any{int64(1), int64(2), int64(3)}},
+		{name: "if", expression: "[if(equals(1, 1), 'yes', 'no')]", expected: "yes"},
+		{name: "logic", expression: "[and(true(), or(false(), not(false())))]", expected: true},
+		{name: "toLower", expression: "[toLower('ABC')]", expected: "abc"},
+		{name: "length", expression: "[length(createObject('a', 1, 'b', 2))]", expected: int64(2)},
+		{name: "empty", expression: "[empty('')]", expected: true},
+		{name: "contains", expression: "[contains(createArray('a', 'b'), 'b')]", expected: true},
+		{name: "union", expression: "[union(createObject('a', 1), createObject('b', 2))]", expected: map[string]any{"a": int64(1), "b": int64(2)}},
+		{name: "json and property", expression: "[json('{\"a\": {\"b\": [1, 2]}}').a.b[1]]", expected: int64(2)},
+		{name: "split and join", expression: "[join(split('a,b;c', createArray(',', ';')), '-')]", expected: "a-b-c"},
+		{name: "arithmetic", expression: "[add(mul(2, 3), sub(10, div(9, 3)))]", expected: int64(13)},
+		{name: "coalesce", expression: "[coalesce(null(), 'x')]", expected: "x"},
+		{name: "string", expression: "[string(createObject('a', true()))]", expected: `{"a":true}`},
+		{name: "substring", expression: "[substring('radius', 1, 3)]", expected: "adi"},
+		{name: "unsupported function is unknown", expression: "[uniqueString('a')]", expected: Unknown{Expression: "[uniqueString('a')]"}},
+		{name: "unknown propagates", expression: "[toLower(uniqueString('a'))]", expected: Unknown{Expression: "[toLower(uniqueString('a'))]"}},
+		{name: "unknown in object", expression: "[createObject('a', newGuid())]", expected: map[string]any{"a": Unknown{Expression: "[createObject('a', newGuid())]"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := newEvaluator(map[string]any{}, "/planes/radius/local/resourceGroups/test", nil, &[]string{})
+			require.NoError(t, err)
+
+			actual, err := e.evaluateValue(tt.expression)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_Expressions_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		err        string
+	}{
+		{name: "unterminated string", expression: "['abc]", err: "unterminated string"},
+		{name: "missing paren", expression: "[concat('a']", err: "expected ',' or ')'"},
+		{name: "trailing text", expression: "[concat('a') x]", err: "unexpected"},
+		{name: "wrong argument type", expression: "[not('a')]", err: "not(): expected a boolean"},
+		{name: "bad format placeholder", expression: "[format('{1}', 'a')]", err: "invalid format placeholder"},
+		{name: "index out of range", expression: "[createArray(1)[3]]", err: "out of range"},
+		{name: "copyIndex outside loop", expression: "[copyIndex()]", err: "copyIndex() can only be used inside a copy loop"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := newEvaluator(map[string]any{}, "/planes/radius/local/resourceGroups/test", nil, &[]string{})
+			require.NoError(t, err)
+
+			_, err = e.evaluateValue(tt.expression)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// environmentsType is the resource type of a Radius environment.
+	environmentsType = "Applications.Core/environments"
+
+	// defaultRecipeName is the recipe that is used when a resource does not specify one.
+	defaultRecipeName = "default"
+
+	// manualProvisioning is the value of resourceProvisioning for resources that do not use a recipe.
+	manualProvisioning = "manual"
+)
+
+// recipeNamespaces are the namespaces of resource types that are provisioned by recipes.
+var recipeNamespaces = []string{"applications.dapr/", "applications.datastores/", "applications.messaging/"}
+
+// Options contains options for Preview.
+type Options struct {
+	// Client is used to read the current state of resources.
+	Client clients.ApplicationsManagementClient
+
+	// Template is the ARM-JSON template to preview.
+	Template map[string]any
+
+	// Parameters are the parameters passed to the deployment.
+	Parameters clients.DeploymentParameters
+
+	// Scope is the UCP scope that Radius resources are deployed to.
+	Scope string
+
+	// EnvironmentName is the name of the environment used to look up recipes for resources that do not
+	// declare their environment.
+	EnvironmentName string
+
+	// ApplicationName is the name of the application being deployed. If set, resources in the application
+	// that are not declared in the template are listed as unmanaged.
+	ApplicationName string
+}
+
+// Preview evaluates the template and compares the resources it declares against their current state.
+func Preview(ctx context.Context, options Options) (*ChangeSet, error) {
+	declared, err := Evaluate(options.Template, EvaluateOptions{Scope: options.Scope, Parameters: options.Parameters})
+	if err != nil {
+		return nil, err
+	}
+
+	p := &previewer{options: options, declared: declared, environments: map[string]map[string]any{}}
+	changeSet := &ChangeSet{Resources: []ResourceChange{}, Summary: map[Action]int{}}
+	for _, resource := range declared {
+		change, err := p.previewResource(ctx, resource)
+		if err != nil {
+			return nil, err
+		}
+		changeSet.Resources = append(changeSet.Resources, change)
+	}
+
+	if options.ApplicationName != "" {
+		unmanaged, err := p.unmanagedResources(ctx, changeSet.Resources)
+		if err != nil {
+			return nil, err
+		}
+		changeSet.Resources = append(changeSet.Resources, unmanaged...)
+	}
+
+	for _, change := range changeSet.Resources {
+		changeSet.Summary[change.Action]++
+	}
+
+	return changeSet, nil
+}
+
+type previewer struct {
+	options  Options
+	declared []Resource
+
+	// environments caches the recipes registered on each environment, by lowercase environment name.
+	environments map[string]map[string]any
+}
+
+func (p *previewer) previewResource(ctx context.Context, resource Resource) (ResourceChange, error) {
+	change := ResourceChange{
+		ID:      resource.ID,
+		Type:    resource.Type,
+		Name:    resource.Name,
+		Message: resource.Message,
+		Symbol:  resource.Symbol,
+	}
+	if resource.Module != "" {
+		change.Symbol = resource.Module
+	}
+	if change.Name == "" {
+		change.Name = UnknownValueText
+	}
+
+	switch {
+	case !resource.Radius:
+		change.Action = ActionIgnore
+		if change.Message == "" {
+			change.Message = "Only Radius resources are previewed."
+		}
+		return change, nil
+	case resource.Body == nil:
+		change.Action = ActionIgnore
+		return change, nil
+	case resource.Name == "":
+		change.Action = ActionIgnore
+		change.Message = "The name of the resource is only known after deploy."
+		return change, nil
+	case resource.ID == "":
+		change.Action = ActionIgnore
+		if change.Message == "" {
+			change.Message = "The ID of the resource is only known after deploy."
+		}
+		return change, nil
+	}
+
+	// The current state is read using the ID of the resource, resources can declare a different scope than the
+	// one that the template is deployed to.
+	desired := asObject(resource.Body["properties"])
+	current, err := p.options.Client.ShowResourceByID(ctx, resource.ID)
+	if clients.Is404Error(err) {
+		change.Action = ActionCreate
+		change.Changes = Diff(resource.Type, nil, desired)
+	} else if err != nil {
+		return ResourceChange{}, fmt.Errorf("failed to read the current state of %s %q: %w", resource.Type, resource.Name, err)
+	} else {
+		change.Changes = Diff(resource.Type, current.Properties, desired)
+		change.Action = ActionUpdate
+		if len(change.Changes) == 0 {
+			change.Action = ActionNoChange
+		}
+	}
+
+	if usesRecipe(resource.Type, desired) {
+		change.Recipe, err = p.recipePlan(ctx, resource.Type, desired, current.Properties)
+		if err != nil {
+			return ResourceChange{}, err
+		}
+	}
+
+	return change, nil
+}
+
+// usesRecipe returns true if the resource is provisioned by a recipe.
+func usesRecipe(resourceType string, properties map[string]any) bool {
+	if provisioning, ok := properties["resourceProvisioning"].(string); ok && strings.EqualFold(provisioning, manualProvisioning) {
+		return false
+	}
+
+	if _, ok := properties["recipe"]; ok {
+		return true
+	}
+
+	lower := strings.ToLower(resourceType)
+	for _, namespace := range recipeNamespaces {
+		if strings.HasPrefix(lower, namespace) {
+			return true
+		}
+	}
+
+	return strings.EqualFold(resourceType, "Applications.Core/extenders")
+}
+
+// recipePlan describes the recipe the resource will execute, using the recipe registered on its environment.
+func (p *previewer) recipePlan(ctx context.Context, resourceType string, desired map[string]any, current map[string]any) (*RecipePlan, error) {
+	recipe := asObject(desired["recipe"])
+	plan := &RecipePlan{Name: defaultRecipeName, Parameters: map[string]any{}}
+	if name, ok := recipe["name"]; ok {
+		if isUnknown(name) {
+			plan.Name = UnknownValueText
+			plan.Message = "The name of the recipe is only known after deploy."
+			return plan, nil
+		}
+		plan.Name = fmt.Sprint(name)
+	}
+
+	environmentName := p.options.EnvironmentName
+	if id, ok := desired["environment"].(string); ok {
+		if parsed, err := resources.ParseResource(id); err == nil {
+			environmentName = parsed.Name()
+		}
+	} else if isUnknown(desired["environment"]) {
+		plan.Message = "The environment of the resource is only known after deploy."
+		return plan, nil
+	}
+
+	if environmentName == "" {
+		plan.Message = "The resource does not specify an environment."
+		return plan, nil
+	}
+
+	recipes, err := p.environmentRecipes(ctx, environmentName)
+	if err != nil {
+		return nil, err
+	}
+
+	var registration map[string]any
+	for t, byName := range recipes {
+		if strings.EqualFold(t, resourceType) {
+			if r, ok := lookup(asObject(byName), plan.Name); ok {
+				registration = asObject(r)
+			}
+		}
+	}
+
+	if registration == nil {
+		plan.Message = fmt.Sprintf("No recipe named %q is registered for %s in environment %q.", plan.Name, resourceType, environmentName)
+		return plan, nil
+	}
+
+	plan.TemplateKind = fmt.Sprint(registration["templateKind"])
+	plan.TemplatePath = fmt.Sprint(registration["templatePath"])
+	for k, v := range asObject(registration["parameters"]) {
+		plan.Parameters[k] = v
+	}
+	for k, v := range asObject(recipe["parameters"]) {
+		plan.Parameters[k] = v
+	}
+
+	status := asObject(current["status"])
+	for _, outputResource := range asSlice(status["outputResources"]) {
+		if id, ok := asObject(outputResource)["id"].(string); ok {
+			plan.OutputResources = append(plan.OutputResources, id)
+		}
+	}
+
+	return plan, nil
+}
+
+// environmentRecipes returns the recipes registered on an environment. Environments declared in the template
+// take precedence over the current state of the environment.
+func (p *previewer) environmentRecipes(ctx context.Context, name string) (map[string]any, error) {
+	key := strings.ToLower(name)
+	if recipes, ok := p.environments[key]; ok {
+		return recipes, nil
+	}
+
+	for _, resource := range p.declared {
+		if strings.EqualFold(resource.Type, environmentsType) && strings.EqualFold(resource.Name, name) {
+			recipes := asObject(asObject(resource.Body["properties"])["recipes"])
+			p.environments[key] = recipes
+			return recipes, nil
+		}
+	}
+
+	recipes := map[string]any{}
+	environment, err := p.options.Client.GetEnvDetails(ctx, name)
+	if err != nil && !clients.Is404Error(err) {
+		return nil, fmt.Errorf("failed to read environment %q: %w", name, err)
+	}
+
+	if err == nil && environment.Properties != nil {
+		for resourceType, byName := range environment.Properties.Recipes {
+			registrations := map[string]any{}
+			for recipeName, r := range byName {
+				if r == nil {
+					continue
+				}
+				properties := r.GetRecipeProperties()
+				registrations[recipeName] = map[string]any{
+					"templateKind": to.String(properties.TemplateKind),
+					"templatePath": to.String(properties.TemplatePath),
+					"parameters":   properties.Parameters,
+				}
+			}
+			recipes[resourceType] = registrations
+		}
+	}
+
+	p.environments[key] = recipes
+	return recipes, nil
+}
+
+// unmanagedResources lists the resources in the application that are not declared in the template.
+func (p *previewer) unmanagedResources(ctx context.Context, changes []ResourceChange) ([]ResourceChange, error) {
+	existing, err := p.options.Client.ListAllResourcesByApplication(ctx, p.options.ApplicationName)
+	if clients.Is404Error(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to list the resources of application %q: %w", p.options.ApplicationName, err)
+	}
+
+	declared := map[string]bool{}
+	for _, change := range changes {
+		declared[strings.ToLower(change.ID)] = true
+	}
+
+	unmanaged := []ResourceChange{}
+	for _, resource := range existing {
+		id := to.String(resource.ID)
+		if declared[strings.ToLower(id)] {
+			continue
+		}
+
+		unmanaged = append(unmanaged, ResourceChange{
+			ID:      id,
+			Type:    to.String(resource.Type),
+			Name:    to.String(resource.Name),
+			Action:  ActionUnmanaged,
+			Message: "The resource is not declared in the template. Deployments are incremental, so it will not be deleted.",
+		})
+	}
+
+	return unmanaged, nil
+}
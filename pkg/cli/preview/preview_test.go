@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func notFound() error {
+	return &azcore.ResponseError{ErrorCode: v1.CodeNotFound, StatusCode: 404}
+}
+
+func setupPreviewMocks(t *testing.T) *clients.MockApplicationsManagementClient {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockApplicationsManagementClient(ctrl)
+
+	current := map[string]map[string]any{
+		"demo-app": {
+			"environment":       testEnvironmentID,
+			"provisioningState": "Succeeded",
+		},
+		"backend": {
+			"application": testApplicationID,
+			"container":   map[string]any{"image": "backend:v0"},
+		},
+		"db-backend": {
+			"application": testApplicationID,
+			"environment": testEnvironmentID,
+			"recipe":      map[string]any{"name": "azure", "parameters": map[string]any{"size": "small"}},
+			"status": map[string]any{
+				"outputResources": []any{
+					map[string]any{"id": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Sql/servers/db"},
+				},
+			},
+		},
+	}
+
+	client.EXPECT().
+		ShowResourceByID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string) (generated.GenericResource, error) {
+			parsed := resources.MustParse(id)
+			properties, ok := current[parsed.Name()]
+			if !ok || !strings.EqualFold(parsed.RootScope(), testScope) {
+				return generated.GenericResource{}, notFound()
+			}
+			return generated.GenericResource{ID: to.Ptr(id), Name: to.Ptr(parsed.Name()), Type: to.Ptr(parsed.Type()), Properties: properties}, nil
+		}).
+		AnyTimes()
+
+	client.EXPECT().
+		GetEnvDetails(gomock.Any(), "test-env").
+		Return(corerp.EnvironmentResource{
+			Properties: &corerp.EnvironmentProperties{
+				Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+					"Applications.Datastores/sqlDatabases": {
+						"azure": &corerp.BicepRecipeProperties{
+							TemplateKind: to.Ptr("bicep"),
+							TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/sql:latest"),
+							Parameters:   map[string]any{"size": "large", "region": "westus"},
+						},
+					},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	client.EXPECT().
+		ListAllResourcesByApplication(gomock.Any(), "demo-app").
+		Return([]generated.GenericResource{
+			{
+				ID:   to.Ptr(testScope + "/providers/applications.core/containers/backend"),
+				Name: to.Ptr("backend"),
+				Type: to.Ptr("Applications.Core/containers"),
+			},
+			{
+				ID:   to.Ptr(testScope + "/providers/Applications.Core/containers/old"),
+				Name: to.Ptr("old"),
+				Type: to.Ptr("Applications.Core/containers"),
+			},
+		}, nil).
+		Times(1)
+
+	return client
+}
+
+func Test_Preview(t *testing.T) {
+	client := setupPreviewMocks(t)
+
+	changeSet, err := Preview(context.Background(), Options{
+		Client:          client,
+		Template:        loadTemplate(t),
+		Parameters:      testParameters(),
+		Scope:           testScope,
+		EnvironmentName: "test-env",
+		ApplicationName: "demo-app",
+	})
+	require.NoError(t, err)
+
+	actions := map[string]Action{}
+	for _, change := range changeSet.Resources {
+		actions[change.Name] = change.Action
+	}
+	require.Equal(t, map[string]Action{
+		"demo-app":          ActionNoChange,
+		"backend":           ActionUpdate,
+		"db-backend":        ActionNoChange,
+		"demo-app-frontend": ActionCreate,
+		UnknownValueText:    ActionIgnore,
+		"worker-0":          ActionCreate,
+		"worker-1":          ActionCreate,
+		"old":               ActionUnmanaged,
+	}, actions)
+	require.Equal(t, map[Action]int{
+		ActionCreate:    3,
+		ActionUpdate:    1,
+		ActionNoChange:  2,
+		ActionIgnore:    1,
+		ActionUnmanaged: 1,
+	}, changeSet.Summary)
+
+	backend := changeSet.Resources[1]
+	require.Equal(t, []PropertyChange{
+		{Path: "properties.container.image", Kind: ChangeModified, Before: "backend:v0", After: "backend:v1"},
+	}, backend.Changes)
+
+	db := changeSet.Resources[2]
+	require.Equal(t, &RecipePlan{
+		Name:            "azure",
+		TemplateKind:    "bicep",
+		TemplatePath:    "ghcr.io/radius-project/recipes/sql:latest",
+		Parameters:      map[string]any{"size": "small", "region": "westus"},
+		OutputResources: []string{"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Sql/servers/db"},
+	}, db.Recipe)
+
+	text := &bytes.Buffer{}
+	require.NoError(t, WriteText(text, changeSet))
+	require.Contains(t, text.String(), "~ Applications.Core/containers backend (Update)")
+	require.Contains(t, text.String(), `~ properties.container.image: "backend:v0" => "backend:v1"`)
+	require.Contains(t, text.String(), `+ properties.container.image: "nginx:latest"`)
+	require.Contains(t, text.String(), `~ properties.container.env.BACKEND: (known after deploy)`)
+	require.Contains(t, text.String(), `+ properties.container.env.PASSWORD: "(sensitive)"`)
+	require.Contains(t, text.String(), "* Microsoft.Storage/storageAccounts (known after deploy) (Ignore)")
+	require.Contains(t, text.String(), `recipe "azure" (bicep: ghcr.io/radius-project/recipes/sql:latest)`)
+	require.Contains(t, text.String(), "Preview: 3 to create, 1 to update, 2 unchanged, 1 not managed by the template, 1 ignored.")
+	require.NotContains(t, text.String(), "hunter2")
+}
+
+func Test_Preview_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockApplicationsManagementClient(ctrl)
+	client.EXPECT().
+		ShowResourceByID(gomock.Any(), testApplicationID).
+		Return(generated.GenericResource{}, errors.New("connection refused")).
+		Times(1)
+
+	_, err := Preview(context.Background(), Options{
+		Client:     client,
+		Template:   loadTemplate(t),
+		Parameters: testParameters(),
+		Scope:      testScope,
+	})
+	require.ErrorContains(t, err, `failed to read the current state of Applications.Core/applications "demo-app": connection refused`)
+}
+
+func Test_Preview_Scope(t *testing.T) {
+	productionApplicationID := "/planes/radius/local/resourceGroups/production/providers/Applications.Core/applications/demo-app"
+	template := map[string]any{
+		"imports": map[string]any{"radius": map[string]any{"provider": "Radius"}},
+		"resources": map[string]any{
+			"app": map[string]any{
+				"import":     "radius",
+				"type":       "Applications.Core/applications@2023-10-01-preview",
+				"scope":      "/planes/radius/local/resourceGroups/production",
+				"properties": map[string]any{"name": "demo-app", "properties": map[string]any{"environment": testEnvironmentID}},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockApplicationsManagementClient(ctrl)
+
+	// The resource is read from the scope it declares, rather than the scope the template is deployed to.
+	client.EXPECT().
+		ShowResourceByID(gomock.Any(), productionApplicationID).
+		Return(generated.GenericResource{Properties: map[string]any{"environment": testEnvironmentID}}, nil).
+		Times(1)
+
+	changeSet, err := Preview(context.Background(), Options{Client: client, Template: template, Scope: testScope})
+	require.NoError(t, err)
+	require.Len(t, changeSet.Resources, 1)
+	require.Equal(t, productionApplicationID, changeSet.Resources[0].ID)
+	require.Equal(t, ActionNoChange, changeSet.Resources[0].Action)
+}
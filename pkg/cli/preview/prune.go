@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"strings"
+)
+
+// HasChanges returns true if deploying the template may change any resource. Resources that are not
+// previewed are assumed to change.
+func (c *ChangeSet) HasChanges() bool {
+	for _, change := range c.Resources {
+		if change.Action != ActionNoChange && change.Action != ActionUnmanaged {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ExcludeUnchanged returns a copy of the template in which the resources that the change set reports as unchanged
+// are declared as existing. Deploying the result only writes the resources that changed, while expressions that
+// reference the unchanged resources are still resolved by the deployment engine.
+//
+// Modules and templates that do not use symbolic names are deployed as they are.
+func ExcludeUnchanged(template map[string]any, changeSet *ChangeSet) map[string]any {
+	declarations, ok := template["resources"].(map[string]any)
+	if !ok {
+		return template
+	}
+
+	result := map[string]any{}
+	for k, v := range template {
+		result[k] = v
+	}
+
+	resources := map[string]any{}
+	for symbol, declaration := range declarations {
+		resources[symbol] = declaration
+
+		definition, ok := declaration.(map[string]any)
+		if !ok || !unchanged(symbol, changeSet) {
+			continue
+		}
+
+		resourceType, _ := definition["type"].(string)
+		resourceType, _, _ = strings.Cut(resourceType, "@")
+		if existing, _ := definition["existing"].(bool); existing || strings.EqualFold(resourceType, deploymentsType) {
+			continue
+		}
+
+		resources[symbol] = existingDeclaration(definition)
+	}
+
+	result["resources"] = resources
+	return result
+}
+
+// unchanged returns true if the change set contains the resource with the given symbol, including each of its
+// copies, and none of them change.
+func unchanged(symbol string, changeSet *ChangeSet) bool {
+	found := false
+	for _, change := range changeSet.Resources {
+		if change.Symbol != symbol && !strings.HasPrefix(change.Symbol, symbol+"[") {
+			continue
+		}
+
+		if change.Action != ActionNoChange {
+			return false
+		}
+		found = true
+	}
+
+	return found
+}
+
+// existingDeclaration converts a resource declaration to a declaration of an existing resource with the same name.
+func existingDeclaration(definition map[string]any) map[string]any {
+	result := map[string]any{"existing": true}
+	for k, v := range definition {
+		if templateKeywords[strings.ToLower(k)] {
+			result[k] = v
+		}
+	}
+
+	// Extensible resources (like Radius resources) declare their body in "properties". Other resources
+	// declare it inline alongside the template keywords.
+	if _, extensible := definition["import"].(string); extensible {
+		result["properties"] = map[string]any{"name": asObject(definition["properties"])["name"]}
+	} else {
+		result["name"] = definition["name"]
+	}
+
+	return result
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ChangeSet_HasChanges(t *testing.T) {
+	changeSet := &ChangeSet{Resources: []ResourceChange{
+		{Name: "app", Action: ActionNoChange},
+		{Name: "old", Action: ActionUnmanaged},
+	}}
+	require.False(t, changeSet.HasChanges())
+
+	changeSet.Resources = append(changeSet.Resources, ResourceChange{Name: "storage", Action: ActionIgnore})
+	require.True(t, changeSet.HasChanges())
+}
+
+func Test_ExcludeUnchanged(t *testing.T) {
+	client := setupPreviewMocks(t)
+	template := loadTemplate(t)
+
+	changeSet, err := Preview(context.Background(), Options{
+		Client:          client,
+		Template:        template,
+		Parameters:      testParameters(),
+		Scope:           testScope,
+		EnvironmentName: "test-env",
+		ApplicationName: "demo-app",
+	})
+	require.NoError(t, err)
+
+	result := ExcludeUnchanged(template, changeSet)
+	resources := result["resources"].(map[string]any)
+
+	// The application is unchanged, so it is read rather than deployed.
+	require.Equal(t, map[string]any{
+		"existing":   true,
+		"import":     "radius",
+		"type":       "Applications.Core/applications@2023-10-01-preview",
+		"properties": map[string]any{"name": "[variables('prefix')]"},
+	}, resources["app"])
+
+	// Expressions in the name of an existing resource are kept, since they are resolved when the resource is read.
+	require.Equal(t, map[string]any{
+		"existing":   true,
+		"import":     "radius",
+		"type":       "Applications.Datastores/sqlDatabases@2023-10-01-preview",
+		"properties": map[string]any{"name": "[format('db-{0}', reference('backend').outputs.name.value)]"},
+	}, resources["db"])
+
+	// Modules are deployed as declared.
+	require.Equal(t, template["resources"].(map[string]any)["backend"], resources["backend"])
+
+	// Resources that change are deployed as declared.
+	require.Equal(t, template["resources"].(map[string]any)["frontend"], resources["frontend"])
+	require.Equal(t, template["resources"].(map[string]any)["workers"], resources["workers"])
+
+	// The input template is not modified.
+	require.NotContains(t, template["resources"].(map[string]any)["app"], "existing")
+}
+
+func Test_ExcludeUnchanged_NonSymbolic(t *testing.T) {
+	template := map[string]any{"resources": []any{map[string]any{"type": "Applications.Core/applications"}}}
+	require.Equal(t, template, ExcludeUnchanged(template, &ChangeSet{}))
+}
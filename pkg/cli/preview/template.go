@@ -0,0 +1,1120 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+const (
+	// radiusProvider is the name of the Bicep extensibility provider for Radius resources.
+	radiusProvider = "Radius"
+
+	// radiusNamespacePrefix is the prefix of the namespace of Radius resource types.
+	radiusNamespacePrefix = "applications."
+
+	// deploymentsType is the resource type of a nested deployment (a Bicep module).
+	deploymentsType = "Microsoft.Resources/deployments"
+
+	// SensitiveValueText is the text used to display a value that is derived from a secure parameter.
+	SensitiveValueText = "(sensitive)"
+)
+
+// templateKeywords are the properties of a resource declaration that are not part of the resource body.
+var templateKeywords = map[string]bool{
+	"apiversion": true,
+	"comments":   true,
+	"condition":  true,
+	"copy":       true,
+	"dependson":  true,
+	"existing":   true,
+	"import":     true,
+	"metadata":   true,
+	"scope":      true,
+	"type":       true,
+}
+
+// structuralFunctions are functions that build a value from their arguments without inspecting them. An
+// unknown argument to one of these functions only makes part of the result unknown.
+var structuralFunctions = map[string]bool{
+	"createarray":  true,
+	"createobject": true,
+}
+
+// Resource is a resource declared in a template, with its expressions evaluated.
+type Resource struct {
+	// Symbol is the symbolic name of the resource in the template. Resources declared in a copy loop
+	// have the index appended, for example "containers[1]".
+	Symbol string
+
+	// Module is the symbolic name of the top-level module that declares the resource. It is empty for
+	// resources declared directly in the template.
+	Module string
+
+	// Type is the fully-qualified resource type, without the API version.
+	Type string
+
+	// APIVersion is the API version of the resource.
+	APIVersion string
+
+	// Name is the name of the resource. It is empty if the name is only known after deploy.
+	Name string
+
+	// ID is the resource ID. It is only computed for Radius resources with a known name.
+	ID string
+
+	// Radius is true if the resource is managed by Radius.
+	Radius bool
+
+	// Body is the evaluated body of the resource, including "name" and "properties".
+	Body map[string]any
+
+	// Message contains additional information about how the resource was evaluated, for example a
+	// condition that is only known after deploy.
+	Message string
+}
+
+// EvaluateOptions contains options for Evaluate.
+type EvaluateOptions struct {
+	// Scope is the UCP scope that Radius resources are deployed to, for example
+	// "/planes/radius/local/resourceGroups/default".
+	Scope string
+
+	// Parameters are the parameters passed to the deployment.
+	Parameters clients.DeploymentParameters
+}
+
+// Evaluate evaluates an ARM-JSON template and returns the resources it will deploy, including the
+// resources declared in nested modules. Values that are only known once the deployment runs are
+// represented by Unknown, and values derived from secure parameters are redacted.
+func Evaluate(template map[string]any, options EvaluateOptions) ([]Resource, error) {
+	parameters := map[string]any{}
+	for name, parameter := range options.Parameters {
+		if value, ok := parameter["value"]; ok {
+			parameters[name] = normalize(value)
+		} else {
+			// Key Vault references and similar are resolved by the deployment engine.
+			parameters[name] = Unknown{Expression: fmt.Sprintf("parameters('%s')", name)}
+		}
+	}
+
+	secrets := []string{}
+	e, err := newEvaluator(template, options.Scope, parameters, &secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.evaluateResources(); err != nil {
+		return nil, err
+	}
+
+	for i := range e.resources {
+		e.resources[i].Body = redact(e.resources[i].Body, secrets).(map[string]any)
+	}
+
+	return e.resources, nil
+}
+
+// evaluator evaluates a single template. Nested templates use their own evaluator.
+type evaluator struct {
+	scope   string
+	imports map[string]string
+
+	parameterDefinitions map[string]any
+	parameters           map[string]any
+	variableDefinitions  map[string]any
+	variables            map[string]any
+	evaluating           map[string]bool
+	secrets              *[]string
+
+	entries   []*entry
+	resources []Resource
+
+	copyIndexes map[string]int64
+	copyName    string
+}
+
+type entryState int
+
+const (
+	entryPending entryState = iota
+	entryEvaluating
+	entryDone
+)
+
+// entry is a resource declaration in the template. A declaration with a copy loop produces several instances.
+type entry struct {
+	symbol     string
+	definition map[string]any
+	state      entryState
+	copied     bool
+	instances  []*instance
+}
+
+// instance is a single evaluated resource.
+type instance struct {
+	index     int
+	resource  Resource
+	reference any
+	full      any
+	deployed  bool
+}
+
+func newEvaluator(template map[string]any, scope string, parameters map[string]any, secrets *[]string) (*evaluator, error) {
+	e := &evaluator{
+		scope:                scope,
+		imports:              map[string]string{},
+		parameterDefinitions: asObject(template["parameters"]),
+		parameters:           map[string]any{},
+		variableDefinitions:  asObject(template["variables"]),
+		variables:            map[string]any{},
+		evaluating:           map[string]bool{},
+		secrets:              secrets,
+		copyIndexes:          map[string]int64{},
+	}
+
+	for alias, i := range asObject(template["imports"]) {
+		e.imports[alias] = fmt.Sprint(asObject(i)["provider"])
+	}
+
+	for name, value := range parameters {
+		e.parameters[strings.ToLower(name)] = value
+	}
+
+	switch declarations := template["resources"].(type) {
+	case map[string]any:
+		symbols := []string{}
+		for symbol := range declarations {
+			symbols = append(symbols, symbol)
+		}
+		sort.Strings(symbols)
+
+		for _, symbol := range symbols {
+			e.entries = append(e.entries, &entry{symbol: symbol, definition: asObject(declarations[symbol])})
+		}
+	case []any:
+		for i, declaration := range declarations {
+			e.entries = append(e.entries, &entry{symbol: fmt.Sprintf("resources[%d]", i), definition: asObject(declaration)})
+		}
+	case nil:
+	default:
+		return nil, fmt.Errorf("template resources must be an object or an array")
+	}
+
+	e.trackSecrets()
+	return e, nil
+}
+
+func asObject(v any) map[string]any {
+	if obj, ok := v.(map[string]any); ok {
+		return obj
+	}
+
+	return map[string]any{}
+}
+
+// lookup finds a property of an object, preferring an exact match over a case-insensitive one.
+func lookup(obj map[string]any, name string) (any, bool) {
+	if v, ok := obj[name]; ok {
+		return v, true
+	}
+
+	for k, v := range obj {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+func (e *evaluator) evaluateResources() error {
+	for _, entry := range e.entries {
+		if err := e.resolve(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *evaluator) findEntry(symbol string) *entry {
+	for _, entry := range e.entries {
+		if entry.symbol == symbol {
+			return entry
+		}
+	}
+
+	return nil
+}
+
+// resolve evaluates a resource declaration if it has not been evaluated yet. Dependencies are resolved first
+// so that resources are listed in the order they are deployed.
+func (e *evaluator) resolve(entry *entry) error {
+	switch entry.state {
+	case entryDone:
+		return nil
+	case entryEvaluating:
+		return fmt.Errorf("resource %q has a circular dependency", entry.symbol)
+	}
+
+	entry.state = entryEvaluating
+	if dependencies, ok := entry.definition["dependsOn"].([]any); ok {
+		for _, dependency := range dependencies {
+			symbol, ok := dependency.(string)
+			if !ok {
+				continue
+			}
+			if dependsOn := e.findEntry(symbol); dependsOn != nil {
+				if err := e.resolve(dependsOn); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	count := int64(1)
+	previousCopy := e.copyName
+	defer func() { e.copyName = previousCopy }()
+
+	if copy, ok := entry.definition["copy"].(map[string]any); ok {
+		entry.copied = true
+		e.copyName = fmt.Sprint(copy["name"])
+
+		value, err := e.evaluateValue(copy["count"])
+		if err != nil {
+			return fmt.Errorf("resource %q: %w", entry.symbol, err)
+		}
+
+		if _, ok := value.(Unknown); ok {
+			resource, err := e.declaredResource(entry, nil)
+			if err != nil {
+				return err
+			}
+			resource.Message = "The number of copies is only known after deploy."
+			e.resources = append(e.resources, resource)
+			entry.state = entryDone
+			return nil
+		}
+
+		n, ok := asInt(value)
+		if !ok || n < 0 {
+			return fmt.Errorf("resource %q: copy count must be a non-negative integer", entry.symbol)
+		}
+		count = n
+	}
+
+	for i := int64(0); i < count; i++ {
+		if entry.copied {
+			e.copyIndexes[strings.ToLower(e.copyName)] = i
+		}
+
+		instance, err := e.evaluateInstance(entry, int(i))
+		if err != nil {
+			return fmt.Errorf("resource %q: %w", entry.symbol, err)
+		}
+		entry.instances = append(entry.instances, instance)
+	}
+
+	entry.state = entryDone
+	return nil
+}
+
+// declaredResource returns the resource with the type information from its declaration, without evaluating the body.
+func (e *evaluator) declaredResource(entry *entry, index *int) (Resource, error) {
+	resource := Resource{Symbol: entry.symbol}
+	if index != nil {
+		resource.Symbol = fmt.Sprintf("%s[%d]", entry.symbol, *index)
+	}
+
+	resourceType, ok := entry.definition["type"].(string)
+	if !ok {
+		return Resource{}, fmt.Errorf("resource %q does not declare a type", entry.symbol)
+	}
+
+	resource.Type, resource.APIVersion, _ = strings.Cut(resourceType, "@")
+	if resource.APIVersion == "" {
+		resource.APIVersion, _ = entry.definition["apiVersion"].(string)
+	}
+
+	provider := ""
+	if alias, ok := entry.definition["import"].(string); ok {
+		provider = e.imports[alias]
+	}
+	resource.Radius = strings.EqualFold(provider, radiusProvider) || strings.HasPrefix(strings.ToLower(resource.Type), radiusNamespacePrefix)
+
+	return resource, nil
+}
+
+func (e *evaluator) evaluateInstance(entry *entry, index int) (*instance, error) {
+	var i *int
+	if entry.copied {
+		i = &index
+	}
+
+	resource, err := e.declaredResource(entry, i)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &instance{index: index, deployed: true}
+	if condition, ok := entry.definition["condition"]; ok {
+		value, err := e.evaluateValue(condition)
+		if err != nil {
+			return nil, err
+		}
+
+		switch c := value.(type) {
+		case Unknown:
+			resource.Message = "The resource is only deployed if a condition that is known after deploy is true."
+		case bool:
+			if !c {
+				result.deployed = false
+				return result, nil
+			}
+		default:
+			return nil, fmt.Errorf("condition must be a boolean, got %s", describe(value))
+		}
+	}
+
+	// Extensible resources (like Radius resources) declare their body in "properties". Other resources
+	// declare it inline alongside the template keywords.
+	_, extensible := entry.definition["import"].(string)
+	declaration := map[string]any{}
+	if extensible {
+		declaration = asObject(entry.definition["properties"])
+	} else {
+		for k, v := range entry.definition {
+			if !templateKeywords[strings.ToLower(k)] {
+				declaration[k] = v
+			}
+		}
+	}
+
+	if strings.EqualFold(resource.Type, deploymentsType) {
+		return e.evaluateModule(entry, resource, declaration, result)
+	}
+
+	value, err := e.evaluateValue(declaration)
+	if err != nil {
+		return nil, err
+	}
+	body := value.(map[string]any)
+	resource.Body = body
+
+	if name, ok := body["name"].(string); ok {
+		resource.Name = name
+		if resource.Radius {
+			scope, err := e.resourceScope(entry)
+			if err != nil {
+				return nil, err
+			}
+
+			if scope == "" {
+				resource.Message = "The scope of the resource is only known after deploy."
+			} else {
+				resource.ID = resourceID(scope, resource.Type, name)
+			}
+		}
+	}
+
+	full := map[string]any{}
+	for k, v := range body {
+		full[k] = v
+	}
+	full["type"] = resource.Type
+	full["apiVersion"] = resource.APIVersion
+	if resource.ID != "" {
+		full["id"] = resource.ID
+	} else {
+		full["id"] = Unknown{Expression: fmt.Sprintf("reference('%s').id", resource.Symbol)}
+	}
+
+	result.full = full
+	result.reference = full
+	if !extensible {
+		result.reference = body["properties"]
+	}
+
+	if existing, _ := entry.definition["existing"].(bool); existing {
+		result.deployed = false
+	}
+
+	result.resource = resource
+	if result.deployed {
+		e.resources = append(e.resources, resource)
+	}
+
+	return result, nil
+}
+
+// evaluateModule evaluates a nested template. The resources of the nested template are listed in place of the module.
+func (e *evaluator) evaluateModule(entry *entry, resource Resource, declaration map[string]any, result *instance) (*instance, error) {
+	properties := map[string]any{}
+	for k, v := range asObject(declaration["properties"]) {
+		properties[k] = v
+	}
+
+	template, ok := properties["template"].(map[string]any)
+	options := asObject(properties["expressionEvaluationOptions"])
+	if !ok || !strings.EqualFold(fmt.Sprint(options["scope"]), "inner") {
+		resource.Message = "Only modules with an inline template and inner expression scope can be previewed."
+		result.resource = resource
+		e.resources = append(e.resources, resource)
+		return result, nil
+	}
+	delete(properties, "template")
+
+	value, err := e.evaluateValue(properties)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := map[string]any{}
+	for name, parameter := range asObject(value.(map[string]any)["parameters"]) {
+		if v, ok := lookup(asObject(parameter), "value"); ok {
+			parameters[name] = v
+		} else {
+			parameters[name] = Unknown{Expression: fmt.Sprintf("parameters('%s')", name)}
+		}
+	}
+
+	scope := e.scope
+	if declared, ok := declaration["resourceGroup"]; ok {
+		value, err := e.evaluateValue(declared)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceGroup, ok := value.(string)
+		if !ok {
+			resource.Message = "The resource group of the module is only known after deploy."
+			result.resource = resource
+			e.resources = append(e.resources, resource)
+			return result, nil
+		}
+
+		scope, err = withResourceGroup(e.scope, resourceGroup)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	child, err := newEvaluator(template, scope, parameters, e.secrets)
+	if err != nil {
+		return nil, err
+	}
+	if err := child.evaluateResources(); err != nil {
+		return nil, err
+	}
+	for _, r := range child.resources {
+		r.Module = resource.Symbol
+		e.resources = append(e.resources, r)
+	}
+
+	outputs := map[string]any{}
+	for name, output := range asObject(template["outputs"]) {
+		value, err := child.evaluateValue(asObject(output)["value"])
+		if err != nil {
+			return nil, fmt.Errorf("output %q: %w", name, err)
+		}
+		outputs[name] = map[string]any{"type": asObject(output)["type"], "value": value}
+	}
+
+	result.resource = resource
+	result.full = map[string]any{"properties": map[string]any{"outputs": outputs}}
+	result.reference = map[string]any{"outputs": outputs}
+	result.deployed = false
+	return result, nil
+}
+
+// resourceScope returns the scope that a resource is deployed to. Resources that declare a scope are deployed to
+// it, other resources are deployed to the scope of the deployment. The scope is empty if it is only known after deploy.
+func (e *evaluator) resourceScope(entry *entry) (string, error) {
+	declared, ok := entry.definition["scope"]
+	if !ok {
+		return e.scope, nil
+	}
+
+	value, err := e.evaluateValue(declared)
+	if err != nil {
+		return "", err
+	}
+
+	switch scope := value.(type) {
+	case Unknown:
+		return "", nil
+	case string:
+		if _, err := resources.ParseScope(scope); err != nil {
+			return "", fmt.Errorf("scope %q must be the ID of a resource group", scope)
+		}
+		return scope, nil
+	}
+
+	return "", fmt.Errorf("scope must be a string, got %s", describe(value))
+}
+
+// withResourceGroup replaces the resource group of a scope, for example for a module that is deployed to another
+// resource group.
+func withResourceGroup(scope string, resourceGroup string) (string, error) {
+	parsed, err := resources.ParseScope(scope)
+	if err != nil {
+		return "", err
+	}
+
+	found := false
+	segments := []resources.ScopeSegment{}
+	for _, segment := range parsed.ScopeSegments() {
+		if strings.EqualFold(segment.Type, resources_radius.ScopeResourceGroups) {
+			segment.Name = resourceGroup
+			found = true
+		}
+		segments = append(segments, segment)
+	}
+
+	if !found {
+		return "", fmt.Errorf("the scope %q does not have a resource group", scope)
+	}
+
+	return resources.MakeUCPID(segments, nil, nil), nil
+}
+
+// resourceID computes the ID of a resource from its scope, type, and name. Nested types use one
+// name segment per type segment.
+func resourceID(scope string, resourceType string, name string) string {
+	typeSegments := strings.Split(resourceType, "/")
+	nameSegments := strings.Split(name, "/")
+	if len(typeSegments) < 2 || len(typeSegments)-1 != len(nameSegments) {
+		return ""
+	}
+
+	parsed, err := resources.ParseScope(scope)
+	if err != nil {
+		return ""
+	}
+
+	segments := []resources.TypeSegment{{Type: typeSegments[0] + "/" + typeSegments[1], Name: nameSegments[0]}}
+	for i, t := range typeSegments[2:] {
+		segments = append(segments, resources.TypeSegment{Type: t, Name: nameSegments[i+1]})
+	}
+
+	return resources.MakeUCPID(parsed.ScopeSegments(), segments, nil)
+}
+
+func (e *evaluator) parameter(name string) (any, error) {
+	key := strings.ToLower(name)
+	if value, ok := e.parameters[key]; ok {
+		return value, nil
+	}
+
+	definition, ok := lookup(e.parameterDefinitions, name)
+	if !ok {
+		return nil, fmt.Errorf("the template does not declare a parameter named %q", name)
+	}
+
+	defaultValue, ok := lookup(asObject(definition), "defaultValue")
+	if !ok {
+		return nil, fmt.Errorf("no value was provided for the parameter %q", name)
+	}
+
+	if e.evaluating["parameters:"+key] {
+		return nil, fmt.Errorf("the default value of parameter %q refers to itself", name)
+	}
+	e.evaluating["parameters:"+key] = true
+	defer delete(e.evaluating, "parameters:"+key)
+
+	value, err := e.evaluateValue(defaultValue)
+	if err != nil {
+		return nil, fmt.Errorf("parameter %q: %w", name, err)
+	}
+
+	e.parameters[key] = value
+	return value, nil
+}
+
+// trackSecrets records the values of secure parameters so they can be redacted from the output.
+func (e *evaluator) trackSecrets() {
+	for name, definition := range e.parameterDefinitions {
+		parameterType, _ := lookup(asObject(definition), "type")
+		if t := strings.ToLower(fmt.Sprint(parameterType)); t != "securestring" && t != "secureobject" {
+			continue
+		}
+
+		if value, ok := e.parameters[strings.ToLower(name)]; ok {
+			collectStrings(value, e.secrets)
+		}
+	}
+}
+
+func collectStrings(v any, result *[]string) {
+	switch value := v.(type) {
+	case string:
+		if value != "" {
+			*result = append(*result, value)
+		}
+	case map[string]any:
+		for _, item := range value {
+			collectStrings(item, result)
+		}
+	case []any:
+		for _, item := range value {
+			collectStrings(item, result)
+		}
+	}
+}
+
+// redact replaces strings that contain a secret with SensitiveValueText.
+func redact(v any, secrets []string) any {
+	switch value := v.(type) {
+	case string:
+		for _, secret := range secrets {
+			if strings.Contains(value, secret) {
+				return SensitiveValueText
+			}
+		}
+		return value
+	case map[string]any:
+		result := map[string]any{}
+		for k, item := range value {
+			result[k] = redact(item, secrets)
+		}
+		return result
+	case []any:
+		result := make([]any, len(value))
+		for i, item := range value {
+			result[i] = redact(item, secrets)
+		}
+		return result
+	}
+
+	return v
+}
+
+func (e *evaluator) variable(name string) (any, error) {
+	key := strings.ToLower(name)
+	if value, ok := e.variables[key]; ok {
+		return value, nil
+	}
+
+	if e.evaluating["variables:"+key] {
+		return nil, fmt.Errorf("variable %q refers to itself", name)
+	}
+	e.evaluating["variables:"+key] = true
+	defer delete(e.evaluating, "variables:"+key)
+
+	var value any
+	var err error
+	if definition, ok := lookup(e.variableDefinitions, name); ok && !strings.EqualFold(name, "copy") {
+		value, err = e.evaluateValue(definition)
+	} else {
+		value, err = e.copyVariable(name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("variable %q: %w", name, err)
+	}
+
+	e.variables[key] = value
+	return value, nil
+}
+
+// copyVariable evaluates a variable declared with a copy loop in the "copy" section of the variables.
+func (e *evaluator) copyVariable(name string) (any, error) {
+	copies, _ := lookup(e.variableDefinitions, "copy")
+	for _, c := range asSlice(copies) {
+		definition := asObject(c)
+		if !strings.EqualFold(fmt.Sprint(definition["name"]), name) {
+			continue
+		}
+
+		count, err := e.evaluateValue(definition["count"])
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := count.(Unknown); ok {
+			return count, nil
+		}
+		n, ok := asInt(count)
+		if !ok || n < 0 {
+			return nil, fmt.Errorf("copy count must be a non-negative integer")
+		}
+
+		previousCopy := e.copyName
+		e.copyName = name
+		defer func() { e.copyName = previousCopy }()
+
+		result := make([]any, n)
+		for i := int64(0); i < n; i++ {
+			e.copyIndexes[strings.ToLower(name)] = i
+			result[i], err = e.evaluateValue(definition["input"])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("the template does not declare a variable named %q", name)
+}
+
+func asSlice(v any) []any {
+	if s, ok := v.([]any); ok {
+		return s
+	}
+
+	return nil
+}
+
+// evaluateValue evaluates all expressions in a JSON value.
+func (e *evaluator) evaluateValue(v any) (any, error) {
+	switch value := v.(type) {
+	case string:
+		if !isExpression(value) {
+			return unescapeLiteral(value), nil
+		}
+
+		n, err := parseExpression(value[1 : len(value)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		return e.evaluateNode(n, value)
+	case map[string]any:
+		result := map[string]any{}
+		for k, item := range value {
+			evaluated, err := e.evaluateValue(item)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = evaluated
+		}
+		return result, nil
+	case []any:
+		result := make([]any, len(value))
+		for i, item := range value {
+			evaluated, err := e.evaluateValue(item)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = evaluated
+		}
+		return result, nil
+	}
+
+	return normalize(v), nil
+}
+
+// evaluateNode evaluates a parsed expression. The text of the expression is used to describe unknown values.
+func (e *evaluator) evaluateNode(n node, text string) (any, error) {
+	unknown := Unknown{Expression: text}
+
+	switch n := n.(type) {
+	case *literalNode:
+		return n.value, nil
+
+	case *propertyNode:
+		target, err := e.evaluateNode(n.target, text)
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := target.(type) {
+		case Unknown:
+			return unknown, nil
+		case map[string]any:
+			if value, ok := lookup(t, n.name); ok {
+				return value, nil
+			}
+			// Properties that are not declared in the template are computed by the server.
+			return unknown, nil
+		}
+
+		return nil, fmt.Errorf("cannot access property %q of %s", n.name, describe(target))
+
+	case *indexNode:
+		target, err := e.evaluateNode(n.target, text)
+		if err != nil {
+			return nil, err
+		}
+		index, err := e.evaluateNode(n.index, text)
+		if err != nil {
+			return nil, err
+		}
+		if isUnknown(target) || isUnknown(index) {
+			return unknown, nil
+		}
+
+		switch t := target.(type) {
+		case []any:
+			i, ok := asInt(index)
+			if !ok || i < 0 || i >= int64(len(t)) {
+				return nil, fmt.Errorf("index %v is out of range", index)
+			}
+			return t[i], nil
+		case map[string]any:
+			key, err := asString(index)
+			if err != nil {
+				return nil, err
+			}
+			if value, ok := lookup(t, key); ok {
+				return value, nil
+			}
+			return unknown, nil
+		}
+
+		return nil, fmt.Errorf("cannot index %s", describe(target))
+
+	case *callNode:
+		return e.call(n, text)
+	}
+
+	return nil, fmt.Errorf("unsupported expression %q", text)
+}
+
+func isUnknown(v any) bool {
+	_, ok := v.(Unknown)
+	return ok
+}
+
+func (e *evaluator) call(n *callNode, text string) (any, error) {
+	unknown := Unknown{Expression: text}
+	name := strings.ToLower(n.name)
+
+	// if() only evaluates the branch that is selected.
+	if name == "if" {
+		if len(n.args) != 3 {
+			return nil, fmt.Errorf("if(): expected 3 arguments, got %d", len(n.args))
+		}
+		condition, err := e.evaluateNode(n.args[0], text)
+		if err != nil {
+			return nil, err
+		}
+		switch c := condition.(type) {
+		case Unknown:
+			return unknown, nil
+		case bool:
+			if c {
+				return e.evaluateNode(n.args[1], text)
+			}
+			return e.evaluateNode(n.args[2], text)
+		}
+		return nil, fmt.Errorf("if(): expected a boolean condition, got %s", describe(condition))
+	}
+
+	args := make([]any, len(n.args))
+	for i, arg := range n.args {
+		value, err := e.evaluateNode(arg, text)
+		if err != nil {
+			return nil, err
+		}
+		if isUnknown(value) && !structuralFunctions[name] {
+			return unknown, nil
+		}
+		args[i] = value
+	}
+
+	var result any
+	var err error
+	switch name {
+	case "parameters":
+		result, err = e.callWithName(args, e.parameter)
+	case "variables":
+		result, err = e.callWithName(args, e.variable)
+	case "reference":
+		result, err = e.reference(args, unknown)
+	case "resourceid":
+		result, err = e.resourceIDFunction(args, unknown)
+	case "copyindex":
+		result, err = e.copyIndex(args)
+	case "resourcegroup":
+		result, err = e.resourceGroup()
+	default:
+		f, ok := functions[name]
+		if !ok {
+			// Functions like uniqueString() and newGuid() are only evaluated by the deployment engine.
+			return unknown, nil
+		}
+		result, err = f(args)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s(): %w", n.name, err)
+	}
+
+	return result, nil
+}
+
+func (e *evaluator) callWithName(args []any, f func(string) (any, error)) (any, error) {
+	if err := requireArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+
+	name, err := asString(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return f(name)
+}
+
+// reference returns the body of a resource declared in the template. Properties that are not declared
+// in the template are unknown until the resource is deployed.
+func (e *evaluator) reference(args []any, unknown Unknown) (any, error) {
+	if err := requireArgs(args, 1, 3); err != nil {
+		return nil, err
+	}
+
+	target, err := asString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	full := len(args) == 3 && strings.EqualFold(fmt.Sprint(args[2]), "full")
+
+	instance, err := e.findInstance(target)
+	if err != nil {
+		return nil, err
+	}
+	if instance == nil {
+		return unknown, nil
+	}
+
+	if full {
+		return instance.full, nil
+	}
+	if instance.reference == nil {
+		return unknown, nil
+	}
+
+	return instance.reference, nil
+}
+
+// findInstance finds a resource by symbolic name or resource ID.
+func (e *evaluator) findInstance(target string) (*instance, error) {
+	symbol, index := target, -1
+	if open := strings.LastIndex(target, "["); open > 0 && strings.HasSuffix(target, "]") {
+		if i, err := strconv.Atoi(target[open+1 : len(target)-1]); err == nil {
+			symbol, index = target[:open], i
+		}
+	}
+
+	if entry := e.findEntry(symbol); entry != nil {
+		if err := e.resolve(entry); err != nil {
+			return nil, err
+		}
+
+		for _, instance := range entry.instances {
+			if (index < 0 && !entry.copied) || instance.index == index {
+				return instance, nil
+			}
+		}
+
+		return nil, nil
+	}
+
+	for _, entry := range e.entries {
+		if entry.state == entryPending {
+			if err := e.resolve(entry); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, instance := range entry.instances {
+			if instance.resource.ID != "" && strings.EqualFold(instance.resource.ID, target) {
+				return instance, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// resourceIDFunction implements resourceId() for Radius resources, which are deployed to the scope of the deployment.
+func (e *evaluator) resourceIDFunction(args []any, unknown Unknown) (any, error) {
+	for i, arg := range args {
+		resourceType, ok := arg.(string)
+		if !ok || !strings.Contains(resourceType, "/") {
+			continue
+		}
+
+		if i > 0 || !strings.HasPrefix(strings.ToLower(resourceType), radiusNamespacePrefix) {
+			return unknown, nil
+		}
+
+		names := []string{}
+		for _, name := range args[i+1:] {
+			s, err := asString(name)
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, s)
+		}
+
+		id := resourceID(e.scope, resourceType, strings.Join(names, "/"))
+		if id == "" {
+			return nil, fmt.Errorf("the names %v do not match the resource type %q", names, resourceType)
+		}
+
+		return id, nil
+	}
+
+	return nil, errors.New("expected a resource type")
+}
+
+func (e *evaluator) copyIndex(args []any) (any, error) {
+	if err := requireArgs(args, 0, 2); err != nil {
+		return nil, err
+	}
+
+	name := e.copyName
+	offset := int64(0)
+	for _, arg := range args {
+		if s, ok := arg.(string); ok {
+			name = s
+		} else if n, ok := asInt(arg); ok {
+			offset = n
+		} else {
+			return nil, fmt.Errorf("unexpected argument %v", arg)
+		}
+	}
+
+	index, ok := e.copyIndexes[strings.ToLower(name)]
+	if name == "" || !ok {
+		return nil, errors.New("copyIndex() can only be used inside a copy loop")
+	}
+
+	return index + offset, nil
+}
+
+func (e *evaluator) resourceGroup() (any, error) {
+	result := map[string]any{
+		"id":       e.scope,
+		"location": Unknown{Expression: "resourceGroup().location"},
+	}
+
+	if parsed, err := resources.ParseScope(e.scope); err == nil {
+		result["name"] = parsed.FindScope(resources_radius.ScopeResourceGroups)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testScope         = "/planes/radius/local/resourceGroups/test-group"
+	testEnvironmentID = testScope + "/providers/Applications.Core/environments/test-env"
+	testApplicationID = testScope + "/providers/Applications.Core/applications/demo-app"
+)
+
+func loadTemplate(t *testing.T) map[string]any {
+	b, err := os.ReadFile("testdata/app.json")
+	require.NoError(t, err)
+
+	template := map[string]any{}
+	require.NoError(t, json.Unmarshal(b, &template))
+	return template
+}
+
+func testParameters() clients.DeploymentParameters {
+	return clients.DeploymentParameters{
+		"environment": {"value": testEnvironmentID},
+		"password":    {"value": "hunter2"},
+	}
+}
+
+func Test_Evaluate(t *testing.T) {
+	resources, err := Evaluate(loadTemplate(t), EvaluateOptions{Scope: testScope, Parameters: testParameters()})
+	require.NoError(t, err)
+
+	symbols := []string{}
+	for _, resource := range resources {
+		symbols = append(symbols, resource.Symbol)
+	}
+
+	// Dependencies are listed first, the module is replaced by its resources, and the conditional cache is not deployed.
+	require.Equal(t, []string{"app", "backend", "db", "frontend", "storage", "workers[0]", "workers[1]"}, symbols)
+
+	app := resources[0]
+	require.Equal(t, "Applications.Core/applications", app.Type)
+	require.Equal(t, "2023-10-01-preview", app.APIVersion)
+	require.Equal(t, "demo-app", app.Name)
+	require.Equal(t, testApplicationID, app.ID)
+	require.True(t, app.Radius)
+
+	backend := resources[1]
+	require.Equal(t, "backend", backend.Name)
+	require.Equal(t, testApplicationID, backend.Body["properties"].(map[string]any)["application"])
+
+	// The name of the database uses an output of the module.
+	db := resources[2]
+	require.Equal(t, "db-backend", db.Name)
+	require.Equal(t, testScope+"/providers/Applications.Datastores/sqlDatabases/db-backend", db.ID)
+
+	frontend := resources[3]
+	require.Equal(t, "demo-app-frontend", frontend.Name)
+	properties := frontend.Body["properties"].(map[string]any)
+	container := properties["container"].(map[string]any)
+	require.Equal(t, "nginx:latest", container["image"])
+	env := container["env"].(map[string]any)
+	require.Equal(t, SensitiveValueText, env["PASSWORD"])
+	require.Equal(t, Unknown{Expression: "[reference('backend').properties.status]"}, env["BACKEND"])
+	require.Equal(t, db.ID, properties["connections"].(map[string]any)["db"].(map[string]any)["source"])
+
+	storage := resources[4]
+	require.False(t, storage.Radius)
+	require.Empty(t, storage.Name)
+	require.Equal(t, "westus", storage.Body["location"])
+
+	require.Equal(t, "worker-0", resources[5].Name)
+	require.Equal(t, "worker-1", resources[6].Name)
+}
+
+func Test_Evaluate_ConditionAndParameters(t *testing.T) {
+	parameters := testParameters()
+	parameters["enableCache"] = map[string]any{"value": true}
+	parameters["replicas"] = map[string]any{"value": float64(0)}
+
+	resources, err := Evaluate(loadTemplate(t), EvaluateOptions{Scope: testScope, Parameters: parameters})
+	require.NoError(t, err)
+
+	names := []string{}
+	for _, resource := range resources {
+		names = append(names, resource.Name)
+	}
+	require.Equal(t, []string{"demo-app", "backend", "cache", "db-backend", "demo-app-frontend", ""}, names)
+}
+
+func Test_Evaluate_ArrayResources(t *testing.T) {
+	template := map[string]any{
+		"parameters": map[string]any{
+			"name": map[string]any{"type": "string"},
+		},
+		"resources": []any{
+			map[string]any{
+				"type":       "Applications.Core/applications",
+				"apiVersion": "2023-10-01-preview",
+				"name":       "[parameters('name')]",
+				"properties": map[string]any{},
+			},
+			map[string]any{
+				"type":       "Applications.Core/containers",
+				"apiVersion": "2023-10-01-preview",
+				"name":       "web",
+				"properties": map[string]any{
+					"application": "[resourceId('Applications.Core/applications', parameters('name'))]",
+					"other":       "[reference(resourceId('Applications.Core/applications', parameters('name'))).missing]",
+				},
+			},
+		},
+	}
+
+	resources, err := Evaluate(template, EvaluateOptions{
+		Scope:      testScope,
+		Parameters: clients.DeploymentParameters{"name": {"value": "demo-app"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	require.Equal(t, "resources[1]", resources[1].Symbol)
+	require.Equal(t, testApplicationID, resources[1].Body["properties"].(map[string]any)["application"])
+	require.True(t, isUnknown(resources[1].Body["properties"].(map[string]any)["other"]))
+}
+
+func Test_Evaluate_Scope(t *testing.T) {
+	productionScope := "/planes/radius/local/resourceGroups/production"
+	template := map[string]any{
+		"imports": map[string]any{"radius": map[string]any{"provider": "Radius"}},
+		"resources": map[string]any{
+			"app": map[string]any{
+				"import":     "radius",
+				"type":       "Applications.Core/applications@2023-10-01-preview",
+				"scope":      productionScope,
+				"properties": map[string]any{"name": "demo-app"},
+			},
+			"unknown": map[string]any{
+				"import":     "radius",
+				"type":       "Applications.Core/containers@2023-10-01-preview",
+				"scope":      "[reference('app').missing]",
+				"properties": map[string]any{"name": "unknown"},
+			},
+			"module": map[string]any{
+				"type":          "Microsoft.Resources/deployments",
+				"apiVersion":    "2022-09-01",
+				"name":          "module",
+				"resourceGroup": "staging",
+				"properties": map[string]any{
+					"expressionEvaluationOptions": map[string]any{"scope": "inner"},
+					"template": map[string]any{
+						"imports": map[string]any{"radius": map[string]any{"provider": "Radius"}},
+						"resources": map[string]any{
+							"web": map[string]any{
+								"import":     "radius",
+								"type":       "Applications.Core/containers@2023-10-01-preview",
+								"properties": map[string]any{"name": "web"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resources, err := Evaluate(template, EvaluateOptions{Scope: testScope})
+	require.NoError(t, err)
+
+	ids := map[string]string{}
+	for _, resource := range resources {
+		ids[resource.Name] = resource.ID
+	}
+	require.Equal(t, map[string]string{
+		"demo-app": productionScope + "/providers/Applications.Core/applications/demo-app",
+		"unknown":  "",
+		"web":      "/planes/radius/local/resourceGroups/staging/providers/Applications.Core/containers/web",
+	}, ids)
+}
+
+func Test_Evaluate_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		template map[string]any
+		err      string
+	}{
+		{
+			name: "missing parameter",
+			template: map[string]any{
+				"parameters": map[string]any{"name": map[string]any{"type": "string"}},
+				"resources": map[string]any{
+					"app": map[string]any{"type": "Applications.Core/applications@2023-10-01-preview", "name": "[parameters('name')]"},
+				},
+			},
+			err: `no value was provided for the parameter "name"`,
+		},
+		{
+			name: "circular reference",
+			template: map[string]any{
+				"resources": map[string]any{
+					"a": map[string]any{"type": "Applications.Core/containers@2023-10-01-preview", "name": "[reference('b').name]"},
+					"b": map[string]any{"type": "Applications.Core/containers@2023-10-01-preview", "name": "[reference('a').name]"},
+				},
+			},
+			err: `resource "a" has a circular dependency`,
+		},
+		{
+			name: "missing type",
+			template: map[string]any{
+				"resources": map[string]any{"a": map[string]any{"name": "a"}},
+			},
+			err: `resource "a" does not declare a type`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Evaluate(tt.template, EvaluateOptions{Scope: testScope})
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
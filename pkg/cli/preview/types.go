@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"encoding/json"
+)
+
+// Action describes what a deployment will do to a resource.
+type Action string
+
+const (
+	// ActionCreate means the resource does not exist and will be created.
+	ActionCreate Action = "Create"
+
+	// ActionUpdate means the resource exists and its properties will change.
+	ActionUpdate Action = "Update"
+
+	// ActionNoChange means the resource exists and the deployment will not change its properties.
+	ActionNoChange Action = "NoChange"
+
+	// ActionUnmanaged means the resource exists in the application but is not declared in the template.
+	// Radius deployments are incremental, so these resources are left in place rather than deleted.
+	ActionUnmanaged Action = "Unmanaged"
+
+	// ActionIgnore means the resource is not a Radius resource and is not previewed.
+	ActionIgnore Action = "Ignore"
+)
+
+// ChangeKind describes how a single property will change.
+type ChangeKind string
+
+const (
+	// ChangeAdded means the property will be set and has no current value.
+	ChangeAdded ChangeKind = "Added"
+
+	// ChangeModified means the property will be set to a different value.
+	ChangeModified ChangeKind = "Modified"
+
+	// ChangeRemoved means the property has a current value that the template does not set.
+	ChangeRemoved ChangeKind = "Removed"
+
+	// ChangeUnknown means the new value of the property can only be determined during deployment.
+	ChangeUnknown ChangeKind = "Unknown"
+)
+
+// UnknownValueText is the text used to display a value that is only known after deployment.
+const UnknownValueText = "(known after deploy)"
+
+// Unknown is a value that can only be determined once the deployment runs, for example a property that
+// is computed by the server or the output of another resource.
+type Unknown struct {
+	// Expression is the template expression that produced the value.
+	Expression string
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Unknown) MarshalJSON() ([]byte, error) {
+	return json.Marshal(UnknownValueText)
+}
+
+// String implements fmt.Stringer.
+func (u Unknown) String() string {
+	return UnknownValueText
+}
+
+// ChangeSet is the result of previewing a deployment.
+type ChangeSet struct {
+	// Resources contains the change for each resource in the template, in template order, followed by
+	// any resources in the application that are not part of the template.
+	Resources []ResourceChange `json:"resources"`
+
+	// Summary counts the resources for each action.
+	Summary map[Action]int `json:"summary"`
+}
+
+// ResourceChange describes the change to a single resource.
+type ResourceChange struct {
+	// ID is the resource ID. It may be empty if the name of the resource is only known after deployment.
+	ID string `json:"id,omitempty"`
+
+	// Type is the fully-qualified resource type.
+	Type string `json:"type"`
+
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// Action is the action the deployment will take on the resource.
+	Action Action `json:"action"`
+
+	// Changes contains the property-level differences for an update or create.
+	Changes []PropertyChange `json:"changes,omitempty"`
+
+	// Recipe contains the recipe that will be executed for the resource, if any.
+	Recipe *RecipePlan `json:"recipe,omitempty"`
+
+	// Message contains additional information, such as why the resource is ignored.
+	Message string `json:"message,omitempty"`
+
+	// Symbol is the symbolic name of the resource or module that declares the resource in the template. It is
+	// empty for unmanaged resources.
+	Symbol string `json:"-"`
+}
+
+// PropertyChange describes the change to a single property of a resource.
+type PropertyChange struct {
+	// Path is the path of the property relative to the resource, for example "properties.container.image".
+	Path string `json:"path"`
+
+	// Kind is the kind of change.
+	Kind ChangeKind `json:"kind"`
+
+	// Before is the current value of the property.
+	Before any `json:"before,omitempty"`
+
+	// After is the new value of the property.
+	After any `json:"after,omitempty"`
+}
+
+// RecipePlan describes the recipe that a portable resource will execute.
+type RecipePlan struct {
+	// Name is the name of the recipe.
+	Name string `json:"name"`
+
+	// TemplateKind is the kind of the recipe template, for example "bicep" or "terraform".
+	TemplateKind string `json:"templateKind,omitempty"`
+
+	// TemplatePath is the path of the recipe template.
+	TemplatePath string `json:"templatePath,omitempty"`
+
+	// Parameters contains the parameters the recipe will be executed with. Parameters set on the resource
+	// override those registered on the environment.
+	Parameters map[string]any `json:"parameters,omitempty"`
+
+	// OutputResources contains the IDs of the resources created by the last execution of the recipe. The
+	// recipe is executed by the server, so the resources it will create or change are not previewed.
+	OutputResources []string `json:"outputResources,omitempty"`
+
+	// Message contains additional information, such as a missing recipe registration.
+	Message string `json:"message,omitempty"`
+}
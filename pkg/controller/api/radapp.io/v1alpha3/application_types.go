@@ -0,0 +1,89 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApplicationSpec defines the desired state of Application
+type ApplicationSpec struct {
+	// Environment is the name of the Radius environment to use. If unset the value 'default' will be
+	// used as the environment name.
+	// +kubebuilder:validation:Optional
+	Environment string `json:"environment,omitempty"`
+
+	// Namespace is the Kubernetes namespace used for the resources of the application. If unset the
+	// namespace of the Application will be used.
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ApplicationStatus defines the observed state of Application
+type ApplicationStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Application. It corresponds to the
+	// Application's generation, which is updated on mutation by the API Server.
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+
+	// Environment is the resource ID of the environment.
+	// +kubebuilder:validation:Optional
+	Environment string `json:"environment,omitempty"`
+
+	// Scope is the resource ID of the scope.
+	// +kubebuilder:validation:Optional
+	Scope string `json:"scope,omitempty"`
+
+	// Resource is the resource ID of the application.
+	// +kubebuilder:validation:Optional
+	Resource string `json:"resource,omitempty"`
+
+	// Conditions describe the current state of the Application.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:categories={"all","radius"}
+//+kubebuilder:printcolumn:name="Environment",type="string",JSONPath=".spec.environment",description="Name of the environment of the application"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the application is ready"
+//+kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",description="Reason for the current state of the application"
+//+kubebuilder:subresource:status
+
+// Application is the Schema for the applications API
+type Application struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationSpec   `json:"spec,omitempty"`
+	Status ApplicationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ApplicationList contains a list of Application
+type ApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Application `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Application{}, &ApplicationList{})
+}
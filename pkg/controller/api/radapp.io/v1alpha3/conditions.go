@@ -0,0 +1,53 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+const (
+	// ConditionReady is the type of the condition that indicates whether the resource is ready.
+	ConditionReady = "Ready"
+
+	// ConditionProvisioning is the type of the condition that indicates whether an operation is in progress.
+	ConditionProvisioning = "Provisioning"
+
+	// ConditionDegraded is the type of the condition that indicates whether the last operation failed.
+	ConditionDegraded = "Degraded"
+
+	// ReasonReconciled indicates that the resource was successfully reconciled.
+	ReasonReconciled = "Reconciled"
+
+	// ReasonUpdating indicates that the Radius resource is being created or updated.
+	ReasonUpdating = "Updating"
+
+	// ReasonDeleting indicates that the Radius resource is being deleted.
+	ReasonDeleting = "Deleting"
+
+	// ReasonProvisioningFailed indicates that the Radius resource could not be created or updated.
+	ReasonProvisioningFailed = "ProvisioningFailed"
+
+	// ReasonEnvironmentNotFound indicates that the environment referenced by the resource does not exist.
+	ReasonEnvironmentNotFound = "EnvironmentNotFound"
+
+	// ReasonDependencyError indicates that the environment or application of the resource could not be resolved.
+	ReasonDependencyError = "DependencyError"
+
+	// ReasonWaitingForDependents indicates that deletion of the resource is blocked until the resources that
+	// depend on it are deleted.
+	ReasonWaitingForDependents = "WaitingForDependents"
+
+	// ReasonDeletionFailed indicates that the Radius resource could not be deleted.
+	ReasonDeletionFailed = "DeletionFailed"
+)
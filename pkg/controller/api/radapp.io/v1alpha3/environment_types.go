@@ -0,0 +1,138 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// EnvironmentSpec defines the desired state of Environment
+type EnvironmentSpec struct {
+	// ResourceGroup is the name of the Radius resource group that contains the environment. If unset the
+	// name of the Environment will be used as the resource group name.
+	// +kubebuilder:validation:Optional
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+
+	// Namespace is the Kubernetes namespace used for the resources deployed to the environment. If unset
+	// the namespace of the Environment will be used.
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Providers configures the cloud providers of the environment.
+	// +kubebuilder:validation:Optional
+	Providers *EnvironmentProviders `json:"providers,omitempty"`
+
+	// Recipes is the list of recipes registered with the environment.
+	// +kubebuilder:validation:Optional
+	Recipes []EnvironmentRecipe `json:"recipes,omitempty"`
+}
+
+// EnvironmentProviders configures the cloud providers of an Environment.
+type EnvironmentProviders struct {
+	// Azure configures the Azure provider.
+	// +kubebuilder:validation:Optional
+	Azure *EnvironmentProvider `json:"azure,omitempty"`
+
+	// AWS configures the AWS provider.
+	// +kubebuilder:validation:Optional
+	AWS *EnvironmentProvider `json:"aws,omitempty"`
+}
+
+// EnvironmentProvider configures a cloud provider of an Environment.
+type EnvironmentProvider struct {
+	// Scope is the target scope of the provider. eg: '/subscriptions/{id}/resourceGroups/{name}' for Azure
+	// or '/planes/aws/aws/accounts/{id}/regions/{name}' for AWS.
+	// +kubebuilder:validation:Required
+	Scope string `json:"scope"`
+}
+
+// EnvironmentRecipe registers a recipe with an Environment.
+type EnvironmentRecipe struct {
+	// Name is the name of the recipe. eg: 'default'.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// ResourceType is the type of resource the recipe creates. eg: 'Applications.Datastores/redisCaches'.
+	// +kubebuilder:validation:Required
+	ResourceType string `json:"resourceType"`
+
+	// TemplateKind is the kind of template used by the recipe.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=bicep;terraform
+	TemplateKind string `json:"templateKind"`
+
+	// TemplatePath is the path to the template used by the recipe.
+	// +kubebuilder:validation:Required
+	TemplatePath string `json:"templatePath"`
+
+	// TemplateVersion is the version of the template. Only used by Terraform recipes.
+	// +kubebuilder:validation:Optional
+	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// Parameters are the default parameters passed to the recipe.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+}
+
+// EnvironmentStatus defines the observed state of Environment
+type EnvironmentStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Environment. It corresponds to the
+	// Environment's generation, which is updated on mutation by the API Server.
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+
+	// Resource is the resource ID of the environment.
+	// +kubebuilder:validation:Optional
+	Resource string `json:"resource,omitempty"`
+
+	// Conditions describe the current state of the Environment.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:categories={"all","radius"}
+//+kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".spec.namespace",description="Kubernetes namespace of the environment"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the environment is ready"
+//+kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",description="Reason for the current state of the environment"
+//+kubebuilder:subresource:status
+
+// Environment is the Schema for the environments API
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec   `json:"spec,omitempty"`
+	Status EnvironmentStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environment
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Environment{}, &EnvironmentList{})
+}
@@ -96,6 +96,14 @@ type RecipeStatus struct {
 	// Secret specifies a reference to the secret being managed by this Recipe.
 	// +kubebuilder:validation:Optional
 	Secret corev1.ObjectReference `json:"secret,omitempty"`
+
+	// Conditions describe the current state of the Recipe. The Ready condition reports whether the resource
+	// is provisioned, Provisioning reports whether an operation is in progress, and Degraded reports whether
+	// the last operation failed.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // ResourceOperation describes the status of an in-progress provisioning operation.
@@ -123,6 +131,9 @@ const (
 //+kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Type of resource the recipe should create"
 //+kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretName",description="Name of the secret to create"
 //+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phrase",description="Status of the resource"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the resource is ready"
+//+kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",description="Reason for the current state of the resource"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 //+kubebuilder:subresource:status
 
 // Recipe is the Schema for the recipes API
@@ -0,0 +1,168 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ResourceSpec defines the desired state of Resource
+type ResourceSpec struct {
+	// Type is the type of resource to create. eg: 'Applications.Core/gateways'.
+	// +kubebuilder:validation:Required
+	Type string `json:"type,omitempty"`
+
+	// Properties are the properties of the resource. The application and environment properties are set
+	// by the controller.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Properties *runtime.RawExtension `json:"properties,omitempty"`
+
+	// Recipe configures the recipe used to provision the resource. Only used by resource types that support
+	// recipes.
+	// +kubebuilder:validation:Optional
+	Recipe *ResourceRecipe `json:"recipe,omitempty"`
+
+	// SecretName is the name of a Kubernetes secret to create once the resource is created.
+	// +kubebuilder:validation:Optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// Environment is the name of the Radius environment to use. If unset the value 'default' will be
+	// used as the environment name.
+	Environment string `json:"environment,omitempty"`
+
+	// Application is the name of the Radius application to use. If unset the namespace of the
+	// Resource will be used as the application name.
+	Application string `json:"application,omitempty"`
+}
+
+// ResourceRecipe configures the recipe used to provision a Resource.
+type ResourceRecipe struct {
+	// Name is the name of the recipe registered with the environment. If unset the value 'default' will be used.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+
+	// Parameters are the parameters passed to the recipe.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+}
+
+// ResourceStatus defines the observed state of Resource
+type ResourceStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Resource. It corresponds to the
+	// Resource's generation, which is updated on mutation by the API Server.
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+
+	// Application is the resource ID of the application.
+	// +kubebuilder:validation:Optional
+	Application string `json:"application,omitempty"`
+
+	// Environment is the resource ID of the environment.
+	// +kubebuilder:validation:Optional
+	Environment string `json:"environment,omitempty"`
+
+	// Scope is the resource ID of the scope.
+	// +kubebuilder:validation:Optional
+	Scope string `json:"scope,omitempty"`
+
+	// Resource is the resource ID of the resource.
+	// +kubebuilder:validation:Optional
+	Resource string `json:"resource,omitempty"`
+
+	// Operation tracks the status of an in-progress provisioning operation.
+	// +kubebuilder:validation:Optional
+	Operation *ResourceOperation `json:"operation,omitempty"`
+
+	// Phrase indicates the current status of the Resource.
+	// +kubebuilder:validation:Optional
+	Phrase RecipePhrase `json:"phrase,omitempty"`
+
+	// ProvisioningState is the provisioning state of the resource reported by Radius.
+	// +kubebuilder:validation:Optional
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// OutputResources are the resources created by Radius for the resource.
+	// +kubebuilder:validation:Optional
+	OutputResources []OutputResource `json:"outputResources,omitempty"`
+
+	// ConnectionValues are the non-secret values that can be used to connect to the resource.
+	// +kubebuilder:validation:Optional
+	ConnectionValues map[string]string `json:"connectionValues,omitempty"`
+
+	// Secret specifies a reference to the secret being managed by this Resource.
+	// +kubebuilder:validation:Optional
+	Secret corev1.ObjectReference `json:"secret,omitempty"`
+
+	// Conditions describe the current state of the Resource. The Ready condition reports whether the resource
+	// is provisioned, Provisioning reports whether an operation is in progress, and Degraded reports whether
+	// the last operation failed.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// OutputResource describes a resource created by Radius.
+type OutputResource struct {
+	// ID is the resource ID of the output resource.
+	ID string `json:"id,omitempty"`
+
+	// LocalID is the logical identifier of the output resource within the Radius resource.
+	// +kubebuilder:validation:Optional
+	LocalID string `json:"localId,omitempty"`
+
+	// RadiusManaged indicates whether the lifecycle of the output resource is managed by Radius.
+	// +kubebuilder:validation:Optional
+	RadiusManaged *bool `json:"radiusManaged,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:categories={"all","radius"}
+//+kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Type of the resource"
+//+kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretName",description="Name of the secret to create"
+//+kubebuilder:printcolumn:name="Provisioning State",type="string",JSONPath=".status.provisioningState",description="Provisioning state of the resource"
+//+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phrase",description="Status of the resource"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the resource is ready"
+//+kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",description="Reason for the current state of the resource"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+//+kubebuilder:subresource:status
+
+// Resource is the Schema for the resources API
+type Resource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceSpec   `json:"spec,omitempty"`
+	Status ResourceStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ResourceList contains a list of Resource
+type ResourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Resource `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Resource{}, &ResourceList{})
+}
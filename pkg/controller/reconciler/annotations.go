@@ -24,42 +24,42 @@ import (
 	"strings"
 
 	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
-	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-type deploymentPhrase string
+type workloadPhrase string
 
 const (
-	deploymentPhraseWaiting  deploymentPhrase = "Waiting"
-	deploymentPhraseUpdating deploymentPhrase = "Updating"
-	deploymentPhraseReady    deploymentPhrase = "Ready"
-	deploymentPhraseDeleting deploymentPhrase = "Deleting"
-	deploymentPhraseFailed   deploymentPhrase = "Failed"
+	workloadPhraseWaiting  workloadPhrase = "Waiting"
+	workloadPhraseUpdating workloadPhrase = "Updating"
+	workloadPhraseReady    workloadPhrase = "Ready"
+	workloadPhraseDeleting workloadPhrase = "Deleting"
+	workloadPhraseFailed   workloadPhrase = "Failed"
 )
 
-// deploymentAnnotations represents the user-provided configuration and the status (Radius related status)
-// of the Deployment.
-type deploymentAnnotations struct {
-	// Configuration is the configuration of the Deployment provided by the user via annotations.
-	// This will be nil if Radius is not enabled for the Deployment.
-	Configuration *deploymentConfiguration
+// workloadAnnotations represents the user-provided configuration and the status (Radius related status)
+// of a workload such as a Deployment.
+type workloadAnnotations struct {
+	// Configuration is the configuration of the workload provided by the user via annotations.
+	// This will be nil if Radius is not enabled for the workload.
+	Configuration *workloadConfiguration
 
 	//ConfigurationHash is the hash of the user-provided configuration.
-	// This will be used to diff the configuration and determine if the Deployment needs to be updated.
+	// This will be used to diff the configuration and determine if the workload needs to be updated.
 	ConfigurationHash string
 
-	// Status is the status of the Deployment (Radius related status).
-	Status *deploymentStatus
+	// Status is the status of the workload (Radius related status).
+	Status *workloadStatus
 }
 
-// deploymentConfiguration is the configuration of the Deployment provided by the user via annotations.
-type deploymentConfiguration struct {
+// workloadConfiguration is the configuration of the workload provided by the user via annotations.
+type workloadConfiguration struct {
 	Application string            `json:"application,omitempty"`
 	Environment string            `json:"environment,omitempty"`
 	Connections map[string]string `json:"connections,omitempty"`
 }
 
-func (c *deploymentConfiguration) computeHash() (string, error) {
+func (c *workloadConfiguration) computeHash() (string, error) {
 	b, err := json.Marshal(c)
 	if err != nil {
 		return "", err
@@ -70,29 +70,30 @@ func (c *deploymentConfiguration) computeHash() (string, error) {
 	return hash, nil
 }
 
-type deploymentStatus struct {
+type workloadStatus struct {
 	Scope       string                              `json:"scope,omitempty"`
 	Application string                              `json:"application,omitempty"`
 	Environment string                              `json:"environment,omitempty"`
 	Container   string                              `json:"container,omitempty"`
 	Operation   *radappiov1alpha3.ResourceOperation `json:"operation,omitempty"`
-	Phrase      deploymentPhrase                    `json:"phrase,omitempty"`
+	Phrase      workloadPhrase                      `json:"phrase,omitempty"`
 }
 
-// readAnnotations reads the annotations from a Deployment.
+// readAnnotations reads the annotations from a workload.
 //
 // This includes the configuration specified by the user, the hash of the configuration, and the status.
-func readAnnotations(deployment *appsv1.Deployment) (*deploymentAnnotations, error) {
-	if deployment.Annotations == nil {
+func readAnnotations(workload client.Object) (*workloadAnnotations, error) {
+	objectAnnotations := workload.GetAnnotations()
+	if objectAnnotations == nil {
 		return nil, nil
 	}
 
-	result := deploymentAnnotations{
-		ConfigurationHash: deployment.Annotations[AnnotationRadiusConfigurationHash],
+	result := workloadAnnotations{
+		ConfigurationHash: objectAnnotations[AnnotationRadiusConfigurationHash],
 	}
 
-	s := deploymentStatus{}
-	status := deployment.Annotations[AnnotationRadiusStatus]
+	s := workloadStatus{}
+	status := objectAnnotations[AnnotationRadiusStatus]
 	if status != "" {
 		err := json.Unmarshal([]byte(status), &s)
 		if err != nil {
@@ -102,20 +103,20 @@ func readAnnotations(deployment *appsv1.Deployment) (*deploymentAnnotations, err
 
 	result.Status = &s
 
-	// Note: we need to read and return the configuration even if Radius is not enabled for the Deployment.
+	// Note: we need to read and return the configuration even if Radius is not enabled for the workload.
 	// This is important so that can clean up previsouly created connections when Radius is disabled.
-	enabled := deployment.Annotations[AnnotationRadiusEnabled]
+	enabled := objectAnnotations[AnnotationRadiusEnabled]
 	if !strings.EqualFold(enabled, "true") {
 		return &result, nil
 	}
 
-	result.Configuration = &deploymentConfiguration{
-		Environment: deployment.Annotations[AnnotationRadiusEnvironment],
-		Application: deployment.Annotations[AnnotationRadiusApplication],
+	result.Configuration = &workloadConfiguration{
+		Environment: objectAnnotations[AnnotationRadiusEnvironment],
+		Application: objectAnnotations[AnnotationRadiusApplication],
 		Connections: map[string]string{},
 	}
 
-	for k, v := range deployment.Annotations {
+	for k, v := range objectAnnotations {
 		if strings.HasPrefix(k, AnnotationRadiusConnectionPrefix) {
 			result.Configuration.Connections[strings.TrimPrefix(k, AnnotationRadiusConnectionPrefix)] = v
 		}
@@ -124,12 +125,14 @@ func readAnnotations(deployment *appsv1.Deployment) (*deploymentAnnotations, err
 	return &result, nil
 }
 
-// ApplyToDeployment applies the configuration and status to a Deployment.
+// ApplyToWorkload applies the configuration and status to a workload.
 //
-// This should be used before saving the Deployment's state.
-func (annotations *deploymentAnnotations) ApplyToDeployment(deployment *appsv1.Deployment) error {
-	if deployment.Annotations == nil {
-		deployment.Annotations = map[string]string{}
+// This should be used before saving the workload's state.
+func (annotations *workloadAnnotations) ApplyToWorkload(workload client.Object) error {
+	objectAnnotations := workload.GetAnnotations()
+	if objectAnnotations == nil {
+		objectAnnotations = map[string]string{}
+		workload.SetAnnotations(objectAnnotations)
 	}
 
 	status := ""
@@ -142,10 +145,10 @@ func (annotations *deploymentAnnotations) ApplyToDeployment(deployment *appsv1.D
 		status = string(b)
 	}
 
-	deployment.Annotations[AnnotationRadiusStatus] = status
+	objectAnnotations[AnnotationRadiusStatus] = status
 
 	if annotations.Configuration == nil {
-		deployment.Annotations[AnnotationRadiusEnabled] = "false"
+		objectAnnotations[AnnotationRadiusEnabled] = "false"
 		return nil
 	}
 
@@ -154,21 +157,21 @@ func (annotations *deploymentAnnotations) ApplyToDeployment(deployment *appsv1.D
 		return err
 	}
 
-	deployment.Annotations[AnnotationRadiusConfigurationHash] = hash
-	deployment.Annotations[AnnotationRadiusEnabled] = "true"
+	objectAnnotations[AnnotationRadiusConfigurationHash] = hash
+	objectAnnotations[AnnotationRadiusEnabled] = "true"
 
 	for k, v := range annotations.Configuration.Connections {
-		deployment.Annotations[AnnotationRadiusConnectionPrefix+k] = v
+		objectAnnotations[AnnotationRadiusConnectionPrefix+k] = v
 	}
 
 	return nil
 }
 
-// IsUpToDate returns true if the Deployment is up to date with the configuration.
+// IsUpToDate returns true if the workload is up to date with the configuration.
 //
 // This should be used to determine if the Radius container needs to be updated based
 // on a change made by the user.
-func (annotations *deploymentAnnotations) IsUpToDate() bool {
+func (annotations *workloadAnnotations) IsUpToDate() bool {
 	if annotations.ConfigurationHash == "" {
 		return false
 	}
@@ -0,0 +1,274 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/go-logr/logr"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ApplicationReconciler reconciles an Application object.
+type ApplicationReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Radius is the Radius client.
+	Radius RadiusClient
+
+	// DelayInterval is the amount of time to wait between operations.
+	DelayInterval time.Duration
+}
+
+// Reconcile is the main reconciliation loop for the Application resource.
+func (r *ApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", "Application", "name", req.Name, "namespace", req.Namespace)
+	ctx = logr.NewContext(ctx, logger)
+
+	application := radappiov1alpha3.Application{}
+	err := r.Client.Get(ctx, req.NamespacedName, &application)
+	if apierrors.IsNotFound(err) {
+		// This can happen due to a data-race if the application is created and then deleted before we can
+		// reconcile it. There's nothing to do here.
+		logger.Info("Application is being deleted.")
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "Unable to fetch resource.")
+		return ctrl.Result{}, err
+	}
+
+	// Unlike recipes and workloads, applications are created and deleted synchronously by the Radius API.
+	if application.DeletionTimestamp != nil {
+		return r.reconcileDelete(ctx, &application)
+	}
+
+	return r.reconcileUpdate(ctx, &application)
+}
+
+func (r *ApplicationReconciler) reconcileUpdate(ctx context.Context, application *radappiov1alpha3.Application) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Ensure that our finalizer is present before we start any operations.
+	if controllerutil.AddFinalizer(application, ApplicationFinalizer) {
+		err := r.Client.Update(ctx, application)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	application.Status.ObservedGeneration = application.Generation
+
+	environmentName := applicationEnvironmentName(application)
+	environmentID, err := findEnvironment(ctx, r.Radius, "/planes/radius/local", environmentName)
+	if err != nil {
+		return r.reconcileFailed(ctx, application, radappiov1alpha3.ReasonProvisioningFailed, fmt.Errorf("failed to find environment: %w", err))
+	} else if environmentID == nil {
+		// The environment might not have been created yet, for example if it's defined by an Environment in the
+		// same GitOps repository. Keep polling until it shows up.
+		message := fmt.Sprintf("Could not find an environment named %q.", environmentName)
+		logger.Info(message)
+		r.EventRecorder.Event(application, corev1.EventTypeWarning, "DependencyError", message)
+		setReadyCondition(&application.Status.Conditions, application.Generation, metav1.ConditionFalse, radappiov1alpha3.ReasonEnvironmentNotFound, message)
+		err = r.Client.Status().Update(ctx, application)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+	}
+
+	resourceGroupID := applicationResourceGroupID(environmentName, application.Name)
+	resourceID := resourceGroupID + "/providers/Applications.Core/applications/" + application.Name
+
+	if application.Status.Resource != "" && !strings.EqualFold(application.Status.Resource, resourceID) {
+		// If we get here it means that the environment changed, so we should delete the old application.
+		logger.Info("Application is already created but is out-of-date")
+		err = deleteApplication(ctx, r.Radius, application.Status.Resource)
+		if err != nil {
+			return r.reconcileFailed(ctx, application, radappiov1alpha3.ReasonProvisioningFailed, fmt.Errorf("failed to delete application: %w", err))
+		}
+
+		application.Status.Resource = ""
+	}
+
+	err = createResourceGroupIfNotExists(ctx, r.Radius, resourceGroupID)
+	if err != nil {
+		return r.reconcileFailed(ctx, application, radappiov1alpha3.ReasonProvisioningFailed, fmt.Errorf("failed to create resource group: %w", err))
+	}
+
+	namespace := application.Namespace
+	if application.Spec.Namespace != "" {
+		namespace = application.Spec.Namespace
+	}
+
+	err = createOrUpdateApplication(ctx, r.Radius, *environmentID, resourceID, namespace)
+	if err != nil {
+		return r.reconcileFailed(ctx, application, radappiov1alpha3.ReasonProvisioningFailed, fmt.Errorf("failed to create or update application: %w", err))
+	}
+
+	logger.Info("Resource is in desired state.", "resourceId", resourceID)
+
+	application.Status.Environment = *environmentID
+	application.Status.Scope = resourceGroupID
+	application.Status.Resource = resourceID
+	setReadyCondition(&application.Status.Conditions, application.Generation, metav1.ConditionTrue, radappiov1alpha3.ReasonReconciled, "Application is ready.")
+	err = r.Client.Status().Update(ctx, application)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.EventRecorder.Event(application, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
+	return ctrl.Result{}, nil
+}
+
+func (r *ApplicationReconciler) reconcileDelete(ctx context.Context, application *radappiov1alpha3.Application) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	application.Status.ObservedGeneration = application.Generation
+
+	// Recipes and Resources must be deleted before the application they are deployed to.
+	dependents, err := r.findDependents(ctx, application)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if len(dependents) > 0 {
+		message := fmt.Sprintf("Waiting for dependents to be deleted: %s.", strings.Join(dependents, ", "))
+		logger.Info(message)
+		setReadyCondition(&application.Status.Conditions, application.Generation, metav1.ConditionFalse, radappiov1alpha3.ReasonWaitingForDependents, message)
+		err = r.Client.Status().Update(ctx, application)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+	}
+
+	if application.Status.Resource != "" {
+		err = deleteApplication(ctx, r.Radius, application.Status.Resource)
+		if err != nil {
+			return r.reconcileFailed(ctx, application, radappiov1alpha3.ReasonDeletionFailed, fmt.Errorf("failed to delete application: %w", err))
+		}
+
+		application.Status.Resource = ""
+	}
+
+	logger.Info("Resource is deleted.")
+
+	// At this point we've cleaned up everything. We can remove the finalizer which will allow deletion of the
+	// application.
+	if controllerutil.RemoveFinalizer(application, ApplicationFinalizer) {
+		err := r.Client.Update(ctx, application)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	r.EventRecorder.Event(application, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
+	return ctrl.Result{}, nil
+}
+
+// findDependents returns the names of the Recipes and Resources that use the application.
+func (r *ApplicationReconciler) findDependents(ctx context.Context, application *radappiov1alpha3.Application) ([]string, error) {
+	dependents := []string{}
+
+	recipes := radappiov1alpha3.RecipeList{}
+	err := r.Client.List(ctx, &recipes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recipes: %w", err)
+	}
+
+	for _, recipe := range recipes.Items {
+		if strings.EqualFold(recipeApplicationName(&recipe), application.Name) &&
+			strings.EqualFold(recipeEnvironmentName(&recipe), applicationEnvironmentName(application)) {
+			dependents = append(dependents, "Recipe "+recipe.Namespace+"/"+recipe.Name)
+		}
+	}
+
+	resources := radappiov1alpha3.ResourceList{}
+	err = r.Client.List(ctx, &resources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+
+	for _, resource := range resources.Items {
+		if strings.EqualFold(resourceApplicationName(&resource), application.Name) &&
+			strings.EqualFold(resourceEnvironmentName(&resource), applicationEnvironmentName(application)) {
+			dependents = append(dependents, "Resource "+resource.Namespace+"/"+resource.Name)
+		}
+	}
+
+	return dependents, nil
+}
+
+// reconcileFailed records a failure to reconcile the application and returns the error so the reconcile is retried.
+func (r *ApplicationReconciler) reconcileFailed(ctx context.Context, application *radappiov1alpha3.Application, reason string, err error) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Error(err, "Unable to reconcile resource.")
+	r.EventRecorder.Event(application, corev1.EventTypeWarning, "ResourceError", err.Error())
+
+	setReadyCondition(&application.Status.Conditions, application.Generation, metav1.ConditionFalse, reason, err.Error())
+	updateErr := r.Client.Status().Update(ctx, application)
+	if updateErr != nil {
+		return ctrl.Result{}, updateErr
+	}
+
+	return ctrl.Result{}, err
+}
+
+func (r *ApplicationReconciler) requeueDelay() time.Duration {
+	delay := r.DelayInterval
+	if delay == 0 {
+		delay = PollingDelay
+	}
+
+	return delay
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&radappiov1alpha3.Application{}).
+		Complete(r)
+}
+
+// applicationEnvironmentName returns the name of the environment of the Application.
+func applicationEnvironmentName(application *radappiov1alpha3.Application) string {
+	if application.Spec.Environment != "" {
+		return application.Spec.Environment
+	}
+
+	return "default"
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Creates an Application before its environment exists.
+//
+// Then deletes the Application, which must wait for the Recipes using it to be deleted first.
+func Test_ApplicationReconciler_WaitForEnvironment_ThenRecipeBlocksDeletion(t *testing.T) {
+	ctx := testcontext.New(t)
+	radius, client := SetupEnvironmentTest(t)
+
+	name := types.NamespacedName{Namespace: "application-basic", Name: "application-basic"}
+	err := client.Create(ctx, &corev1.Namespace{ObjectMeta: ctrl.ObjectMeta{Name: name.Namespace}})
+	require.NoError(t, err)
+
+	application := &radappiov1alpha3.Application{
+		ObjectMeta: ctrl.ObjectMeta{Namespace: name.Namespace, Name: name.Name},
+		Spec: radappiov1alpha3.ApplicationSpec{
+			Namespace: "application-basic-resources",
+		},
+	}
+	err = client.Create(ctx, application)
+	require.NoError(t, err)
+
+	// Application will be waiting for environment to be created.
+	_ = waitForApplicationCondition(t, client, name, metav1.ConditionFalse, radappiov1alpha3.ReasonEnvironmentNotFound)
+
+	createEnvironment(radius, "default")
+
+	status := waitForApplicationCondition(t, client, name, metav1.ConditionTrue, radappiov1alpha3.ReasonReconciled)
+	require.Equal(t, "/planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/default", status.Environment)
+	require.Equal(t, "/planes/radius/local/resourcegroups/default-application-basic", status.Scope)
+
+	response, err := radius.Applications(status.Scope).Get(ctx, name.Name, nil)
+	require.NoError(t, err)
+	require.Equal(t, "application-basic-resources", *response.Properties.Extensions[0].(*corerpv20231001preview.KubernetesNamespaceExtension).Namespace)
+
+	// The recipe uses the application because it's in the namespace with the same name.
+	recipe := makeRecipe(types.NamespacedName{Namespace: name.Namespace, Name: "recipe"}, "Applications.Core/extenders")
+	err = client.Create(ctx, recipe)
+	require.NoError(t, err)
+
+	err = client.Delete(ctx, application)
+	require.NoError(t, err)
+
+	_ = waitForApplicationCondition(t, client, name, metav1.ConditionFalse, radappiov1alpha3.ReasonWaitingForDependents)
+
+	// Remove the recipe so the application can be deleted.
+	err = client.Delete(ctx, recipe)
+	require.NoError(t, err)
+
+	waitForDeleted(t, client, name, &radappiov1alpha3.Application{})
+
+	_, err = radius.Applications(status.Scope).Get(ctx, name.Name, nil)
+	require.Error(t, err)
+}
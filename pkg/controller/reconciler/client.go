@@ -61,6 +61,9 @@ type ContainerClient interface {
 }
 
 type EnvironmentClient interface {
+	CreateOrUpdate(ctx context.Context, environmentName string, resource corerpv20231001preview.EnvironmentResource, options *corerpv20231001preview.EnvironmentsClientCreateOrUpdateOptions) (corerpv20231001preview.EnvironmentsClientCreateOrUpdateResponse, error)
+	Delete(ctx context.Context, environmentName string, options *corerpv20231001preview.EnvironmentsClientDeleteOptions) (corerpv20231001preview.EnvironmentsClientDeleteResponse, error)
+	Get(ctx context.Context, environmentName string, options *corerpv20231001preview.EnvironmentsClientGetOptions) (corerpv20231001preview.EnvironmentsClientGetResponse, error)
 	List(ctx context.Context, options *corerpv20231001preview.EnvironmentsClientListByScopeOptions) (corerpv20231001preview.EnvironmentsClientListByScopeResponse, error)
 }
 
@@ -183,6 +186,18 @@ type EnvironmentClientImpl struct {
 	inner *corerpv20231001preview.EnvironmentsClient
 }
 
+func (ec *EnvironmentClientImpl) CreateOrUpdate(ctx context.Context, environmentName string, resource corerpv20231001preview.EnvironmentResource, options *corerpv20231001preview.EnvironmentsClientCreateOrUpdateOptions) (corerpv20231001preview.EnvironmentsClientCreateOrUpdateResponse, error) {
+	return ec.inner.CreateOrUpdate(ctx, environmentName, resource, options)
+}
+
+func (ec *EnvironmentClientImpl) Delete(ctx context.Context, environmentName string, options *corerpv20231001preview.EnvironmentsClientDeleteOptions) (corerpv20231001preview.EnvironmentsClientDeleteResponse, error) {
+	return ec.inner.Delete(ctx, environmentName, options)
+}
+
+func (ec *EnvironmentClientImpl) Get(ctx context.Context, environmentName string, options *corerpv20231001preview.EnvironmentsClientGetOptions) (corerpv20231001preview.EnvironmentsClientGetResponse, error) {
+	return ec.inner.Get(ctx, environmentName, options)
+}
+
 func (ec *EnvironmentClientImpl) List(ctx context.Context, options *corerpv20231001preview.EnvironmentsClientListByScopeOptions) (corerpv20231001preview.EnvironmentsClientListByScopeResponse, error) {
 	result := corerpv20231001preview.EnvironmentsClientListByScopeResponse{}
 	pager := ec.inner.NewListByScopePager(options)
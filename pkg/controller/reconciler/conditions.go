@@ -0,0 +1,65 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+// setCondition sets a condition of a resource.
+func setCondition(conditions *[]metav1.Condition, generation int64, conditionType string, status metav1.ConditionStatus, reason string, message string) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// setReadyCondition sets the Ready condition of a resource.
+func setReadyCondition(conditions *[]metav1.Condition, generation int64, status metav1.ConditionStatus, reason string, message string) {
+	setCondition(conditions, generation, radappiov1alpha3.ConditionReady, status, reason, message)
+}
+
+// setOperationStartedConditions sets the conditions of a resource when a PUT or DELETE operation is started.
+func setOperationStartedConditions(conditions *[]metav1.Condition, generation int64, operationKind string, message string) {
+	reason := radappiov1alpha3.ReasonUpdating
+	if operationKind == radappiov1alpha3.OperationKindDelete {
+		reason = radappiov1alpha3.ReasonDeleting
+	}
+
+	setCondition(conditions, generation, radappiov1alpha3.ConditionReady, metav1.ConditionFalse, reason, message)
+	setCondition(conditions, generation, radappiov1alpha3.ConditionProvisioning, metav1.ConditionTrue, reason, message)
+}
+
+// setFailedConditions sets the conditions of a resource when an operation or one of its dependencies failed.
+func setFailedConditions(conditions *[]metav1.Condition, generation int64, reason string, message string) {
+	setCondition(conditions, generation, radappiov1alpha3.ConditionReady, metav1.ConditionFalse, reason, message)
+	setCondition(conditions, generation, radappiov1alpha3.ConditionProvisioning, metav1.ConditionFalse, reason, message)
+	setCondition(conditions, generation, radappiov1alpha3.ConditionDegraded, metav1.ConditionTrue, reason, message)
+}
+
+// setReconciledConditions sets the conditions of a resource when it has reached its desired state.
+func setReconciledConditions(conditions *[]metav1.Condition, generation int64, message string) {
+	setCondition(conditions, generation, radappiov1alpha3.ConditionReady, metav1.ConditionTrue, radappiov1alpha3.ReasonReconciled, message)
+	setCondition(conditions, generation, radappiov1alpha3.ConditionProvisioning, metav1.ConditionFalse, radappiov1alpha3.ReasonReconciled, message)
+	setCondition(conditions, generation, radappiov1alpha3.ConditionDegraded, metav1.ConditionFalse, radappiov1alpha3.ReasonReconciled, message)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+func requireCondition(t *testing.T, conditions []metav1.Condition, conditionType string, status metav1.ConditionStatus, reason string) {
+	condition := meta.FindStatusCondition(conditions, conditionType)
+	require.NotNil(t, condition, "condition %s not found", conditionType)
+	require.Equal(t, status, condition.Status, "condition %s", conditionType)
+	require.Equal(t, reason, condition.Reason, "condition %s", conditionType)
+}
+
+func Test_setConditions_Lifecycle(t *testing.T) {
+	conditions := []metav1.Condition{}
+
+	setOperationStartedConditions(&conditions, 1, radappiov1alpha3.OperationKindPut, "Creating or updating resource.")
+	requireCondition(t, conditions, radappiov1alpha3.ConditionReady, metav1.ConditionFalse, radappiov1alpha3.ReasonUpdating)
+	requireCondition(t, conditions, radappiov1alpha3.ConditionProvisioning, metav1.ConditionTrue, radappiov1alpha3.ReasonUpdating)
+	require.Nil(t, meta.FindStatusCondition(conditions, radappiov1alpha3.ConditionDegraded))
+
+	setFailedConditions(&conditions, 1, radappiov1alpha3.ReasonProvisioningFailed, "BadRequest: oh noes")
+	requireCondition(t, conditions, radappiov1alpha3.ConditionReady, metav1.ConditionFalse, radappiov1alpha3.ReasonProvisioningFailed)
+	requireCondition(t, conditions, radappiov1alpha3.ConditionProvisioning, metav1.ConditionFalse, radappiov1alpha3.ReasonProvisioningFailed)
+	requireCondition(t, conditions, radappiov1alpha3.ConditionDegraded, metav1.ConditionTrue, radappiov1alpha3.ReasonProvisioningFailed)
+	require.Equal(t, "BadRequest: oh noes", meta.FindStatusCondition(conditions, radappiov1alpha3.ConditionDegraded).Message)
+
+	// Retrying the operation keeps the Degraded condition until the operation succeeds.
+	setOperationStartedConditions(&conditions, 2, radappiov1alpha3.OperationKindPut, "Creating or updating resource.")
+	requireCondition(t, conditions, radappiov1alpha3.ConditionProvisioning, metav1.ConditionTrue, radappiov1alpha3.ReasonUpdating)
+	requireCondition(t, conditions, radappiov1alpha3.ConditionDegraded, metav1.ConditionTrue, radappiov1alpha3.ReasonProvisioningFailed)
+
+	setReconciledConditions(&conditions, 2, "Resource is ready.")
+	requireCondition(t, conditions, radappiov1alpha3.ConditionReady, metav1.ConditionTrue, radappiov1alpha3.ReasonReconciled)
+	requireCondition(t, conditions, radappiov1alpha3.ConditionProvisioning, metav1.ConditionFalse, radappiov1alpha3.ReasonReconciled)
+	requireCondition(t, conditions, radappiov1alpha3.ConditionDegraded, metav1.ConditionFalse, radappiov1alpha3.ReasonReconciled)
+	for _, condition := range conditions {
+		require.Equal(t, int64(2), condition.ObservedGeneration)
+	}
+
+	setOperationStartedConditions(&conditions, 3, radappiov1alpha3.OperationKindDelete, "Deleting resource.")
+	requireCondition(t, conditions, radappiov1alpha3.ConditionReady, metav1.ConditionFalse, radappiov1alpha3.ReasonDeleting)
+	requireCondition(t, conditions, radappiov1alpha3.ConditionProvisioning, metav1.ConditionTrue, radappiov1alpha3.ReasonDeleting)
+}
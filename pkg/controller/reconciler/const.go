@@ -22,13 +22,13 @@ const (
 	// PollingDelay is the amount of time to wait between polling for the status of a resource.
 	PollingDelay time.Duration = 5 * time.Second
 
-	// AnnotationRadiusEnabled is the name of the annotation that indicates if a Deployment has Radius enabled.
+	// AnnotationRadiusEnabled is the name of the annotation that indicates if a workload has Radius enabled.
 	AnnotationRadiusEnabled = "radapp.io/enabled"
 
 	// AnnotationRadiusConnectionPrefix is the name of the annotation that indicates the name of the connection to use.
 	AnnotationRadiusConnectionPrefix = "radapp.io/connection-"
 
-	// AnnotationRadiusStatus is the name of the annotation that indicates the status of a Deployment.
+	// AnnotationRadiusStatus is the name of the annotation that indicates the status of a workload.
 	AnnotationRadiusStatus = "radapp.io/status"
 
 	// AnnotationRadiusConfigurationHash is the name of the annotation that indicates the hash of the configuration.
@@ -39,12 +39,30 @@ const (
 	AnnotationRadiusEnvironment = "radapp.io/environment"
 
 	// AnnotationRadiusApplication is the name of the annotation that indicates the name of the application. If unset,
-	// the namespace of the Deployment will be used as the application name.
+	// the namespace of the workload will be used as the application name.
 	AnnotationRadiusApplication = "radapp.io/application"
 
 	// DeploymentFinalizer is the name of the finalizer added to Deployments.
 	DeploymentFinalizer = "radapp.io/deployment-finalizer"
 
+	// StatefulSetFinalizer is the name of the finalizer added to StatefulSets.
+	StatefulSetFinalizer = "radapp.io/statefulset-finalizer"
+
+	// DaemonSetFinalizer is the name of the finalizer added to DaemonSets.
+	DaemonSetFinalizer = "radapp.io/daemonset-finalizer"
+
+	// CronJobFinalizer is the name of the finalizer added to CronJobs.
+	CronJobFinalizer = "radapp.io/cronjob-finalizer"
+
 	// RecipeFinalizer is the name of the finalizer added to Recipes.
 	RecipeFinalizer = "radapp.io/recipe-finalizer"
+
+	// ResourceFinalizer is the name of the finalizer added to Resources.
+	ResourceFinalizer = "radapp.io/resource-finalizer"
+
+	// EnvironmentFinalizer is the name of the finalizer added to Environments.
+	EnvironmentFinalizer = "radapp.io/environment-finalizer"
+
+	// ApplicationFinalizer is the name of the finalizer added to Applications.
+	ApplicationFinalizer = "radapp.io/application-finalizer"
 )
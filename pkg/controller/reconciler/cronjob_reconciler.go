@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// cronJobKind describes the CronJob workload kind.
+var cronJobKind = workloadKind{
+	GroupVersionKind: batchv1.SchemeGroupVersion.WithKind("CronJob"),
+	Finalizer:        CronJobFinalizer,
+	NewObject: func() client.Object {
+		return &batchv1.CronJob{}
+	},
+	NewList: func() client.ObjectList {
+		return &batchv1.CronJobList{}
+	},
+	PodTemplate: func(workload client.Object) *corev1.PodTemplateSpec {
+		return &workload.(*batchv1.CronJob).Spec.JobTemplate.Spec.Template
+	},
+}
+
+// CronJobReconciler reconciles a CronJob object.
+type CronJobReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Radius is the Radius client.
+	Radius RadiusClient
+
+	// DelayInterval is the amount of time to wait between operations.
+	DelayInterval time.Duration
+}
+
+// Reconcile is the main reconciliation loop for the CronJob resource.
+func (r *CronJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.workload().Reconcile(ctx, req)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CronJobReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return r.workload().SetupWithManager(mgr)
+}
+
+func (r *CronJobReconciler) workload() *workloadReconciler {
+	return &workloadReconciler{
+		Client:        r.Client,
+		Scheme:        r.Scheme,
+		EventRecorder: r.EventRecorder,
+		Radius:        r.Radius,
+		DelayInterval: r.DelayInterval,
+		Kind:          cronJobKind,
+	}
+}
@@ -115,7 +115,7 @@ func Test_DeploymentReconciler_RadiusEnabled_ThenDeploymentDeleted(t *testing.T)
 	require.NoError(t, err)
 
 	// We should not have created a secret reference since there are no connections.
-	require.False(t, removeSecretReference(deployment, deployment.Name+"-connections"))
+	require.False(t, removeSecretReference(&deployment.Spec.Template, deployment.Name+"-connections"))
 
 	container, err := radius.Containers(annotations.Status.Scope).Get(ctx, deployment.Name, nil)
 	require.NoError(t, err)
@@ -478,11 +478,11 @@ func makeDeployment(name types.NamespacedName) *appsv1.Deployment {
 	}
 }
 
-func waitForStateWaiting(t *testing.T, client client.Client, name types.NamespacedName) *deploymentAnnotations {
+func waitForStateWaiting(t *testing.T, client client.Client, name types.NamespacedName) *workloadAnnotations {
 	ctx := testcontext.New(t)
 
 	logger := t
-	var annotations *deploymentAnnotations
+	var annotations *workloadAnnotations
 	require.EventuallyWithTf(t, func(t *assert.CollectT) {
 		logger.Logf("Fetching Deployment: %+v", name)
 		current := &appsv1.Deployment{}
@@ -494,7 +494,7 @@ func waitForStateWaiting(t *testing.T, client client.Client, name types.Namespac
 		assert.NotNil(t, annotations)
 		logger.Logf("Annotations.Status: %+v", annotations.Status)
 
-		if assert.NotNil(t, annotations.Status) && assert.Equal(t, deploymentPhraseWaiting, annotations.Status.Phrase) {
+		if assert.NotNil(t, annotations.Status) && assert.Equal(t, workloadPhraseWaiting, annotations.Status.Phrase) {
 			assert.Empty(t, annotations.Status.Operation)
 		}
 	}, deploymentTestWaitDuration, deploymentTestWaitInterval, "waiting for state to be Waiting")
@@ -502,11 +502,11 @@ func waitForStateWaiting(t *testing.T, client client.Client, name types.Namespac
 	return annotations
 }
 
-func waitForStateUpdating(t *testing.T, client client.Client, name types.NamespacedName) *deploymentAnnotations {
+func waitForStateUpdating(t *testing.T, client client.Client, name types.NamespacedName) *workloadAnnotations {
 	ctx := testcontext.New(t)
 
 	logger := t
-	var annotations *deploymentAnnotations
+	var annotations *workloadAnnotations
 	require.EventuallyWithTf(t, func(t *assert.CollectT) {
 		logger.Logf("Fetching Deployment: %+v", name)
 		current := &appsv1.Deployment{}
@@ -518,7 +518,7 @@ func waitForStateUpdating(t *testing.T, client client.Client, name types.Namespa
 		assert.NotNil(t, annotations)
 		logger.Logf("Annotations.Status: %+v", annotations.Status)
 
-		if assert.NotNil(t, annotations.Status) && assert.Equal(t, deploymentPhraseUpdating, annotations.Status.Phrase) {
+		if assert.NotNil(t, annotations.Status) && assert.Equal(t, workloadPhraseUpdating, annotations.Status.Phrase) {
 			assert.NotEmpty(t, annotations.Status.Operation)
 		}
 	}, deploymentTestWaitDuration, deploymentTestWaitInterval, "waiting for state to be Updating")
@@ -526,11 +526,11 @@ func waitForStateUpdating(t *testing.T, client client.Client, name types.Namespa
 	return annotations
 }
 
-func waitForStateReady(t *testing.T, client client.Client, name types.NamespacedName) *deploymentAnnotations {
+func waitForStateReady(t *testing.T, client client.Client, name types.NamespacedName) *workloadAnnotations {
 	ctx := testcontext.New(t)
 
 	logger := t
-	var annotations *deploymentAnnotations
+	var annotations *workloadAnnotations
 	require.EventuallyWithTf(t, func(t *assert.CollectT) {
 		logger.Logf("Fetching Deployment: %+v", name)
 		current := &appsv1.Deployment{}
@@ -542,7 +542,7 @@ func waitForStateReady(t *testing.T, client client.Client, name types.Namespaced
 		assert.NotNil(t, annotations)
 		logger.Logf("Annotations.Status: %+v", annotations.Status)
 
-		if assert.NotNil(t, annotations.Status) && assert.Equal(t, deploymentPhraseReady, annotations.Status.Phrase) {
+		if assert.NotNil(t, annotations.Status) && assert.Equal(t, workloadPhraseReady, annotations.Status.Phrase) {
 			assert.Empty(t, annotations.Status.Operation)
 		}
 	}, deploymentTestWaitDuration, deploymentTestWaitInterval, "waiting for state to be Ready")
@@ -550,11 +550,11 @@ func waitForStateReady(t *testing.T, client client.Client, name types.Namespaced
 	return annotations
 }
 
-func waitForStateDeleting(t *testing.T, client client.Client, name types.NamespacedName) *deploymentAnnotations {
+func waitForStateDeleting(t *testing.T, client client.Client, name types.NamespacedName) *workloadAnnotations {
 	ctx := testcontext.New(t)
 
 	logger := t
-	var annotations *deploymentAnnotations
+	var annotations *workloadAnnotations
 	require.EventuallyWithTf(t, func(t *assert.CollectT) {
 		logger.Logf("Fetching Deployment: %+v", name)
 		current := &appsv1.Deployment{}
@@ -566,7 +566,7 @@ func waitForStateDeleting(t *testing.T, client client.Client, name types.Namespa
 		assert.NotNil(t, annotations)
 		logger.Logf("Annotations.Status: %+v", annotations.Status)
 
-		if assert.NotNil(t, annotations.Status) && assert.Equal(t, deploymentPhraseDeleting, annotations.Status.Phrase) {
+		if assert.NotNil(t, annotations.Status) && assert.Equal(t, workloadPhraseDeleting, annotations.Status.Phrase) {
 			assert.NotEmpty(t, annotations.Status.Operation)
 		}
 	}, deploymentTestWaitDuration, deploymentTestWaitInterval, "waiting for state to be Deleting")
@@ -574,11 +574,11 @@ func waitForStateDeleting(t *testing.T, client client.Client, name types.Namespa
 	return annotations
 }
 
-func waitForRadiusContainerDeleted(t *testing.T, client client.Client, name types.NamespacedName) *deploymentAnnotations {
+func waitForRadiusContainerDeleted(t *testing.T, client client.Client, name types.NamespacedName) *workloadAnnotations {
 	ctx := testcontext.New(t)
 
 	logger := t
-	var annotations *deploymentAnnotations
+	var annotations *workloadAnnotations
 	require.EventuallyWithTf(t, func(t *assert.CollectT) {
 		logger.Logf("Fetching Deployment: %+v", name)
 		current := &appsv1.Deployment{}
@@ -0,0 +1,340 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/go-logr/logr"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// EnvironmentReconciler reconciles an Environment object.
+type EnvironmentReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Radius is the Radius client.
+	Radius RadiusClient
+
+	// DelayInterval is the amount of time to wait between operations.
+	DelayInterval time.Duration
+}
+
+// Reconcile is the main reconciliation loop for the Environment resource.
+func (r *EnvironmentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", "Environment", "name", req.Name, "namespace", req.Namespace)
+	ctx = logr.NewContext(ctx, logger)
+
+	environment := radappiov1alpha3.Environment{}
+	err := r.Client.Get(ctx, req.NamespacedName, &environment)
+	if apierrors.IsNotFound(err) {
+		// This can happen due to a data-race if the environment is created and then deleted before we can
+		// reconcile it. There's nothing to do here.
+		logger.Info("Environment is being deleted.")
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "Unable to fetch resource.")
+		return ctrl.Result{}, err
+	}
+
+	// Unlike recipes and workloads, environments are created and deleted synchronously by the Radius API.
+	if environment.DeletionTimestamp != nil {
+		return r.reconcileDelete(ctx, &environment)
+	}
+
+	return r.reconcileUpdate(ctx, &environment)
+}
+
+func (r *EnvironmentReconciler) reconcileUpdate(ctx context.Context, environment *radappiov1alpha3.Environment) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Ensure that our finalizer is present before we start any operations.
+	if controllerutil.AddFinalizer(environment, EnvironmentFinalizer) {
+		err := r.Client.Update(ctx, environment)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	environment.Status.ObservedGeneration = environment.Generation
+
+	properties, err := environmentProperties(environment)
+	if err != nil {
+		// The spec is invalid, there's no point in retrying until it changes.
+		logger.Error(err, "Invalid environment.")
+		r.EventRecorder.Event(environment, corev1.EventTypeWarning, "ResourceError", err.Error())
+		setReadyCondition(&environment.Status.Conditions, environment.Generation, metav1.ConditionFalse, radappiov1alpha3.ReasonProvisioningFailed, err.Error())
+		return ctrl.Result{}, r.Client.Status().Update(ctx, environment)
+	}
+
+	resourceGroupID := "/planes/radius/local/resourceGroups/" + environmentResourceGroupName(environment)
+	resourceID := resourceGroupID + "/providers/Applications.Core/environments/" + environment.Name
+
+	if environment.Status.Resource != "" && !strings.EqualFold(environment.Status.Resource, resourceID) {
+		// If we get here it means that the resource group changed, so we should delete the old environment.
+		logger.Info("Environment is already created but is out-of-date")
+		err = deleteEnvironment(ctx, r.Radius, environment.Status.Resource)
+		if err != nil {
+			return r.reconcileFailed(ctx, environment, radappiov1alpha3.ReasonProvisioningFailed, err)
+		}
+
+		environment.Status.Resource = ""
+	}
+
+	err = createResourceGroupIfNotExists(ctx, r.Radius, resourceGroupID)
+	if err != nil {
+		return r.reconcileFailed(ctx, environment, radappiov1alpha3.ReasonProvisioningFailed, fmt.Errorf("failed to create resource group: %w", err))
+	}
+
+	err = createOrUpdateEnvironment(ctx, r.Radius, resourceID, properties)
+	if err != nil {
+		return r.reconcileFailed(ctx, environment, radappiov1alpha3.ReasonProvisioningFailed, fmt.Errorf("failed to create or update environment: %w", err))
+	}
+
+	logger.Info("Resource is in desired state.", "resourceId", resourceID)
+
+	environment.Status.Resource = resourceID
+	setReadyCondition(&environment.Status.Conditions, environment.Generation, metav1.ConditionTrue, radappiov1alpha3.ReasonReconciled, "Environment is ready.")
+	err = r.Client.Status().Update(ctx, environment)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.EventRecorder.Event(environment, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
+	return ctrl.Result{}, nil
+}
+
+func (r *EnvironmentReconciler) reconcileDelete(ctx context.Context, environment *radappiov1alpha3.Environment) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	environment.Status.ObservedGeneration = environment.Generation
+
+	// Applications, recipes and resources must be deleted before the environment they are deployed to.
+	dependents, err := r.findDependents(ctx, environment)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if len(dependents) > 0 {
+		message := fmt.Sprintf("Waiting for dependents to be deleted: %s.", strings.Join(dependents, ", "))
+		logger.Info(message)
+		setReadyCondition(&environment.Status.Conditions, environment.Generation, metav1.ConditionFalse, radappiov1alpha3.ReasonWaitingForDependents, message)
+		err = r.Client.Status().Update(ctx, environment)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+	}
+
+	if environment.Status.Resource != "" {
+		err = deleteEnvironment(ctx, r.Radius, environment.Status.Resource)
+		if err != nil {
+			return r.reconcileFailed(ctx, environment, radappiov1alpha3.ReasonDeletionFailed, fmt.Errorf("failed to delete environment: %w", err))
+		}
+
+		environment.Status.Resource = ""
+	}
+
+	logger.Info("Resource is deleted.")
+
+	// At this point we've cleaned up everything. We can remove the finalizer which will allow deletion of the
+	// environment.
+	if controllerutil.RemoveFinalizer(environment, EnvironmentFinalizer) {
+		err := r.Client.Update(ctx, environment)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	r.EventRecorder.Event(environment, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
+	return ctrl.Result{}, nil
+}
+
+// findDependents returns the names of the Applications, Recipes and Resources that use the environment.
+func (r *EnvironmentReconciler) findDependents(ctx context.Context, environment *radappiov1alpha3.Environment) ([]string, error) {
+	dependents := []string{}
+
+	applications := radappiov1alpha3.ApplicationList{}
+	err := r.Client.List(ctx, &applications)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+
+	for _, application := range applications.Items {
+		if strings.EqualFold(applicationEnvironmentName(&application), environment.Name) {
+			dependents = append(dependents, "Application "+application.Namespace+"/"+application.Name)
+		}
+	}
+
+	recipes := radappiov1alpha3.RecipeList{}
+	err = r.Client.List(ctx, &recipes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recipes: %w", err)
+	}
+
+	for _, recipe := range recipes.Items {
+		if strings.EqualFold(recipeEnvironmentName(&recipe), environment.Name) {
+			dependents = append(dependents, "Recipe "+recipe.Namespace+"/"+recipe.Name)
+		}
+	}
+
+	resources := radappiov1alpha3.ResourceList{}
+	err = r.Client.List(ctx, &resources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+
+	for _, resource := range resources.Items {
+		if strings.EqualFold(resourceEnvironmentName(&resource), environment.Name) {
+			dependents = append(dependents, "Resource "+resource.Namespace+"/"+resource.Name)
+		}
+	}
+
+	return dependents, nil
+}
+
+// reconcileFailed records a failure to reconcile the environment and returns the error so the reconcile is retried.
+func (r *EnvironmentReconciler) reconcileFailed(ctx context.Context, environment *radappiov1alpha3.Environment, reason string, err error) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Error(err, "Unable to reconcile resource.")
+	r.EventRecorder.Event(environment, corev1.EventTypeWarning, "ResourceError", err.Error())
+
+	setReadyCondition(&environment.Status.Conditions, environment.Generation, metav1.ConditionFalse, reason, err.Error())
+	updateErr := r.Client.Status().Update(ctx, environment)
+	if updateErr != nil {
+		return ctrl.Result{}, updateErr
+	}
+
+	return ctrl.Result{}, err
+}
+
+func (r *EnvironmentReconciler) requeueDelay() time.Duration {
+	delay := r.DelayInterval
+	if delay == 0 {
+		delay = PollingDelay
+	}
+
+	return delay
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *EnvironmentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&radappiov1alpha3.Environment{}).
+		Complete(r)
+}
+
+// environmentResourceGroupName returns the name of the Radius resource group that contains the environment.
+func environmentResourceGroupName(environment *radappiov1alpha3.Environment) string {
+	if environment.Spec.ResourceGroup != "" {
+		return environment.Spec.ResourceGroup
+	}
+
+	return environment.Name
+}
+
+// environmentProperties converts the spec of the Environment to the properties of the Radius environment.
+func environmentProperties(environment *radappiov1alpha3.Environment) (*corerpv20231001preview.EnvironmentProperties, error) {
+	namespace := environment.Namespace
+	if environment.Spec.Namespace != "" {
+		namespace = environment.Spec.Namespace
+	}
+
+	properties := &corerpv20231001preview.EnvironmentProperties{
+		Compute: &corerpv20231001preview.KubernetesCompute{
+			Kind:      to.Ptr(corerpv20231001preview.EnvironmentComputeKindKubernetes),
+			Namespace: to.Ptr(namespace),
+		},
+	}
+
+	if environment.Spec.Providers != nil {
+		properties.Providers = &corerpv20231001preview.Providers{}
+		if environment.Spec.Providers.Azure != nil {
+			properties.Providers.Azure = &corerpv20231001preview.ProvidersAzure{Scope: to.Ptr(environment.Spec.Providers.Azure.Scope)}
+		}
+		if environment.Spec.Providers.AWS != nil {
+			properties.Providers.Aws = &corerpv20231001preview.ProvidersAws{Scope: to.Ptr(environment.Spec.Providers.AWS.Scope)}
+		}
+	}
+
+	if len(environment.Spec.Recipes) > 0 {
+		properties.Recipes = map[string]map[string]corerpv20231001preview.RecipePropertiesClassification{}
+	}
+
+	for _, recipe := range environment.Spec.Recipes {
+		parameters := map[string]any{}
+		if recipe.Parameters != nil && len(recipe.Parameters.Raw) > 0 {
+			err := json.Unmarshal(recipe.Parameters.Raw, &parameters)
+			if err != nil {
+				return nil, fmt.Errorf("the parameters of recipe %q for resource type %q must be an object: %w", recipe.Name, recipe.ResourceType, err)
+			}
+		}
+
+		var recipeProperties corerpv20231001preview.RecipePropertiesClassification
+		switch recipe.TemplateKind {
+		case recipes.TemplateKindBicep:
+			recipeProperties = &corerpv20231001preview.BicepRecipeProperties{
+				TemplateKind: to.Ptr(recipe.TemplateKind),
+				TemplatePath: to.Ptr(recipe.TemplatePath),
+				Parameters:   parameters,
+			}
+		case recipes.TemplateKindTerraform:
+			recipeProperties = &corerpv20231001preview.TerraformRecipeProperties{
+				TemplateKind:    to.Ptr(recipe.TemplateKind),
+				TemplatePath:    to.Ptr(recipe.TemplatePath),
+				TemplateVersion: to.Ptr(recipe.TemplateVersion),
+				Parameters:      parameters,
+			}
+		default:
+			return nil, fmt.Errorf("recipe %q for resource type %q has unsupported template kind %q", recipe.Name, recipe.ResourceType, recipe.TemplateKind)
+		}
+
+		if _, ok := properties.Recipes[recipe.ResourceType][recipe.Name]; ok {
+			return nil, fmt.Errorf("recipe %q for resource type %q is registered more than once", recipe.Name, recipe.ResourceType)
+		}
+
+		if properties.Recipes[recipe.ResourceType] == nil {
+			properties.Recipes[recipe.ResourceType] = map[string]corerpv20231001preview.RecipePropertiesClassification{}
+		}
+		properties.Recipes[recipe.ResourceType][recipe.Name] = recipeProperties
+	}
+
+	return properties, nil
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+	"time"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	environmentTestWaitDuration            = time.Second * 10
+	environmentTestWaitInterval            = time.Second * 1
+	environmentTestControllerDelayInterval = time.Millisecond * 100
+)
+
+func SetupEnvironmentTest(t *testing.T) (*mockRadiusClient, client.Client) {
+	SkipWithoutEnvironment(t)
+
+	// Shut down the manager when the test exits.
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	mgr, err := ctrl.NewManager(config, ctrl.Options{
+		Scheme: scheme,
+
+		// Suppress metrics in tests to avoid conflicts.
+		MetricsBindAddress: "0",
+	})
+	require.NoError(t, err)
+
+	radius := NewMockRadiusClient()
+	err = (&EnvironmentReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("environment-controller"),
+		Radius:        radius,
+		DelayInterval: environmentTestControllerDelayInterval,
+	}).SetupWithManager(mgr)
+	require.NoError(t, err)
+
+	err = (&ApplicationReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("application-controller"),
+		Radius:        radius,
+		DelayInterval: environmentTestControllerDelayInterval,
+	}).SetupWithManager(mgr)
+	require.NoError(t, err)
+
+	go func() {
+		err := mgr.Start(ctx)
+		require.NoError(t, err)
+	}()
+
+	return radius, mgr.GetClient()
+}
+
+// Creates an Environment and an Application.
+//
+// Then deletes the Environment, which must wait for the Application to be deleted first.
+func Test_EnvironmentReconciler_Basic(t *testing.T) {
+	ctx := testcontext.New(t)
+	radius, client := SetupEnvironmentTest(t)
+
+	name := types.NamespacedName{Namespace: "environment-basic", Name: "test-environment-basic"}
+	err := client.Create(ctx, &corev1.Namespace{ObjectMeta: ctrl.ObjectMeta{Name: name.Namespace}})
+	require.NoError(t, err)
+
+	environment := &radappiov1alpha3.Environment{
+		ObjectMeta: ctrl.ObjectMeta{Namespace: name.Namespace, Name: name.Name},
+		Spec: radappiov1alpha3.EnvironmentSpec{
+			Recipes: []radappiov1alpha3.EnvironmentRecipe{
+				{
+					Name:         "default",
+					ResourceType: "Applications.Datastores/redisCaches",
+					TemplateKind: "bicep",
+					TemplatePath: "ghcr.io/radius-project/recipes/local-dev/rediscaches:latest",
+				},
+			},
+		},
+	}
+	err = client.Create(ctx, environment)
+	require.NoError(t, err)
+
+	status := waitForEnvironmentReady(t, client, name)
+	environmentID := "/planes/radius/local/resourceGroups/test-environment-basic/providers/Applications.Core/environments/test-environment-basic"
+	require.Equal(t, environmentID, status.Resource)
+
+	response, err := radius.Environments("/planes/radius/local/resourceGroups/test-environment-basic").Get(ctx, name.Name, nil)
+	require.NoError(t, err)
+	require.Equal(t, "environment-basic", *response.Properties.Compute.(*corerpv20231001preview.KubernetesCompute).Namespace)
+	require.Contains(t, response.Properties.Recipes, "Applications.Datastores/redisCaches")
+
+	applicationName := types.NamespacedName{Namespace: name.Namespace, Name: "test-application-basic"}
+	application := &radappiov1alpha3.Application{
+		ObjectMeta: ctrl.ObjectMeta{Namespace: applicationName.Namespace, Name: applicationName.Name},
+		Spec: radappiov1alpha3.ApplicationSpec{
+			Environment: name.Name,
+		},
+	}
+	err = client.Create(ctx, application)
+	require.NoError(t, err)
+
+	applicationStatus := waitForApplicationCondition(t, client, applicationName, metav1.ConditionTrue, radappiov1alpha3.ReasonReconciled)
+	require.Equal(t, environmentID, applicationStatus.Environment)
+	require.Equal(t, "/planes/radius/local/resourcegroups/test-environment-basic-test-application-basic/providers/Applications.Core/applications/test-application-basic", applicationStatus.Resource)
+
+	// Deleting the environment is blocked by the application.
+	err = client.Delete(ctx, environment)
+	require.NoError(t, err)
+
+	_ = waitForEnvironmentCondition(t, client, name, metav1.ConditionFalse, radappiov1alpha3.ReasonWaitingForDependents)
+
+	_, err = radius.Environments("/planes/radius/local/resourceGroups/test-environment-basic").Get(ctx, name.Name, nil)
+	require.NoError(t, err)
+
+	err = client.Delete(ctx, application)
+	require.NoError(t, err)
+
+	waitForDeleted(t, client, applicationName, &radappiov1alpha3.Application{})
+	waitForDeleted(t, client, name, &radappiov1alpha3.Environment{})
+
+	_, err = radius.Environments("/planes/radius/local/resourceGroups/test-environment-basic").Get(ctx, name.Name, nil)
+	require.Error(t, err)
+}
+
+func Test_environmentProperties(t *testing.T) {
+	environment := &radappiov1alpha3.Environment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test"},
+		Spec: radappiov1alpha3.EnvironmentSpec{
+			Providers: &radappiov1alpha3.EnvironmentProviders{
+				Azure: &radappiov1alpha3.EnvironmentProvider{Scope: "/subscriptions/sub/resourceGroups/rg"},
+				AWS:   &radappiov1alpha3.EnvironmentProvider{Scope: "/planes/aws/aws/accounts/account/regions/region"},
+			},
+			Recipes: []radappiov1alpha3.EnvironmentRecipe{
+				{
+					Name:         "default",
+					ResourceType: "Applications.Datastores/redisCaches",
+					TemplateKind: "bicep",
+					TemplatePath: "ghcr.io/radius-project/recipes/rediscaches:latest",
+					Parameters:   &runtime.RawExtension{Raw: []byte(`{"size":"small"}`)},
+				},
+				{
+					Name:            "terraform",
+					ResourceType:    "Applications.Datastores/redisCaches",
+					TemplateKind:    "terraform",
+					TemplatePath:    "Azure/redis/azurerm",
+					TemplateVersion: "1.0.0",
+				},
+			},
+		},
+	}
+
+	properties, err := environmentProperties(environment)
+	require.NoError(t, err)
+
+	expected := &corerpv20231001preview.EnvironmentProperties{
+		Compute: &corerpv20231001preview.KubernetesCompute{
+			Kind:      to.Ptr("kubernetes"),
+			Namespace: to.Ptr("test-namespace"),
+		},
+		Providers: &corerpv20231001preview.Providers{
+			Azure: &corerpv20231001preview.ProvidersAzure{Scope: to.Ptr("/subscriptions/sub/resourceGroups/rg")},
+			Aws:   &corerpv20231001preview.ProvidersAws{Scope: to.Ptr("/planes/aws/aws/accounts/account/regions/region")},
+		},
+		Recipes: map[string]map[string]corerpv20231001preview.RecipePropertiesClassification{
+			"Applications.Datastores/redisCaches": {
+				"default": &corerpv20231001preview.BicepRecipeProperties{
+					TemplateKind: to.Ptr("bicep"),
+					TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/rediscaches:latest"),
+					Parameters:   map[string]any{"size": "small"},
+				},
+				"terraform": &corerpv20231001preview.TerraformRecipeProperties{
+					TemplateKind:    to.Ptr("terraform"),
+					TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+					TemplateVersion: to.Ptr("1.0.0"),
+					Parameters:      map[string]any{},
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, properties)
+}
+
+func Test_environmentProperties_Namespace(t *testing.T) {
+	environment := &radappiov1alpha3.Environment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test"},
+		Spec:       radappiov1alpha3.EnvironmentSpec{Namespace: "other-namespace"},
+	}
+
+	properties, err := environmentProperties(environment)
+	require.NoError(t, err)
+	require.Equal(t, "other-namespace", *properties.Compute.(*corerpv20231001preview.KubernetesCompute).Namespace)
+	require.Nil(t, properties.Providers)
+	require.Nil(t, properties.Recipes)
+}
+
+func Test_environmentProperties_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		recipes  []radappiov1alpha3.EnvironmentRecipe
+		expected string
+	}{
+		{
+			name: "unsupported template kind",
+			recipes: []radappiov1alpha3.EnvironmentRecipe{
+				{Name: "default", ResourceType: "Applications.Datastores/redisCaches", TemplateKind: "helm", TemplatePath: "path"},
+			},
+			expected: "recipe \"default\" for resource type \"Applications.Datastores/redisCaches\" has unsupported template kind \"helm\"",
+		},
+		{
+			name: "duplicate recipe",
+			recipes: []radappiov1alpha3.EnvironmentRecipe{
+				{Name: "default", ResourceType: "Applications.Datastores/redisCaches", TemplateKind: "bicep", TemplatePath: "path"},
+				{Name: "default", ResourceType: "Applications.Datastores/redisCaches", TemplateKind: "bicep", TemplatePath: "other-path"},
+			},
+			expected: "recipe \"default\" for resource type \"Applications.Datastores/redisCaches\" is registered more than once",
+		},
+		{
+			name: "parameters are not an object",
+			recipes: []radappiov1alpha3.EnvironmentRecipe{
+				{Name: "default", ResourceType: "Applications.Datastores/redisCaches", TemplateKind: "bicep", TemplatePath: "path", Parameters: &runtime.RawExtension{Raw: []byte(`[1, 2]`)}},
+			},
+			expected: "the parameters of recipe \"default\" for resource type \"Applications.Datastores/redisCaches\" must be an object",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			environment := &radappiov1alpha3.Environment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test"},
+				Spec:       radappiov1alpha3.EnvironmentSpec{Recipes: tt.recipes},
+			}
+
+			_, err := environmentProperties(environment)
+			require.ErrorContains(t, err, tt.expected)
+		})
+	}
+}
+
+func waitForEnvironmentReady(t *testing.T, client client.Client, name types.NamespacedName) *radappiov1alpha3.EnvironmentStatus {
+	return waitForEnvironmentCondition(t, client, name, metav1.ConditionTrue, radappiov1alpha3.ReasonReconciled)
+}
+
+func waitForEnvironmentCondition(t *testing.T, client client.Client, name types.NamespacedName, status metav1.ConditionStatus, reason string) *radappiov1alpha3.EnvironmentStatus {
+	ctx := testcontext.New(t)
+
+	logger := t
+	current := &radappiov1alpha3.Environment{}
+	require.EventuallyWithTf(t, func(t *assert.CollectT) {
+		logger.Logf("Fetching Environment: %+v", name)
+		err := client.Get(ctx, name, current)
+		require.NoError(t, err)
+
+		condition := meta.FindStatusCondition(current.Status.Conditions, radappiov1alpha3.ConditionReady)
+		logger.Logf("Ready condition: %+v", condition)
+		if assert.NotNil(t, condition) {
+			assert.Equal(t, status, condition.Status)
+			assert.Equal(t, reason, condition.Reason)
+		}
+	}, environmentTestWaitDuration, environmentTestWaitInterval, "waiting for Ready condition to be %s (%s)", status, reason)
+
+	return &current.Status
+}
+
+func waitForApplicationCondition(t *testing.T, client client.Client, name types.NamespacedName, status metav1.ConditionStatus, reason string) *radappiov1alpha3.ApplicationStatus {
+	ctx := testcontext.New(t)
+
+	logger := t
+	current := &radappiov1alpha3.Application{}
+	require.EventuallyWithTf(t, func(t *assert.CollectT) {
+		logger.Logf("Fetching Application: %+v", name)
+		err := client.Get(ctx, name, current)
+		require.NoError(t, err)
+
+		condition := meta.FindStatusCondition(current.Status.Conditions, radappiov1alpha3.ConditionReady)
+		logger.Logf("Ready condition: %+v", condition)
+		if assert.NotNil(t, condition) {
+			assert.Equal(t, status, condition.Status)
+			assert.Equal(t, reason, condition.Reason)
+		}
+	}, environmentTestWaitDuration, environmentTestWaitInterval, "waiting for Ready condition to be %s (%s)", status, reason)
+
+	return &current.Status
+}
+
+func waitForDeleted(t *testing.T, client client.Client, name types.NamespacedName, obj client.Object) {
+	ctx := testcontext.New(t)
+
+	logger := t
+	require.Eventuallyf(t, func() bool {
+		logger.Logf("Fetching %T: %+v", obj, name)
+		err := client.Get(ctx, name, obj)
+		return apierrors.IsNotFound(err)
+	}, environmentTestWaitDuration, environmentTestWaitInterval, "waiting for %T to be deleted", obj)
+}
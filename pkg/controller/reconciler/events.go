@@ -0,0 +1,102 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// EventReasonOperationStarted is the reason of the event recorded when a PUT or DELETE operation is started.
+	EventReasonOperationStarted = "OperationStarted"
+
+	// EventReasonOperationSucceeded is the reason of the event recorded when a PUT or DELETE operation succeeds.
+	EventReasonOperationSucceeded = "OperationSucceeded"
+
+	// EventReasonOperationFailed is the reason of the event recorded when a PUT or DELETE operation fails.
+	EventReasonOperationFailed = "OperationFailed"
+)
+
+// recordOperationStarted records an event when a PUT or DELETE operation is started for a Radius resource.
+func recordOperationStarted(recorder record.EventRecorder, object runtime.Object, operationKind string, resourceID string) {
+	recorder.Eventf(object, corev1.EventTypeNormal, EventReasonOperationStarted, "Started %s operation for %s.", operationKind, resourceID)
+}
+
+// recordOperationSucceeded records an event when a PUT or DELETE operation succeeds for a Radius resource.
+func recordOperationSucceeded(recorder record.EventRecorder, object runtime.Object, operationKind string, resourceID string) {
+	recorder.Eventf(object, corev1.EventTypeNormal, EventReasonOperationSucceeded, "Completed %s operation for %s.", operationKind, resourceID)
+}
+
+// recordOperationFailed records an event when a PUT or DELETE operation fails for a Radius resource. The message
+// includes the error details returned by Radius.
+func recordOperationFailed(recorder record.EventRecorder, object runtime.Object, operationKind string, resourceID string, err error) {
+	recorder.Eventf(object, corev1.EventTypeWarning, EventReasonOperationFailed, "%s operation for %s failed: %s", operationKind, resourceID, errorDetails(err))
+}
+
+// errorDetails returns a human-readable description of an error. The error details returned by Radius are
+// flattened into a single line so they can be read with kubectl.
+func errorDetails(err error) string {
+	responseErr := &azcore.ResponseError{}
+	if !errors.As(err, &responseErr) || responseErr.RawResponse == nil || responseErr.RawResponse.Body == nil {
+		return err.Error()
+	}
+
+	body, readErr := io.ReadAll(responseErr.RawResponse.Body)
+	if readErr != nil {
+		return err.Error()
+	}
+
+	// Restore the body so the error can still be inspected by other code.
+	responseErr.RawResponse.Body = io.NopCloser(bytes.NewReader(body))
+
+	response := v1.ErrorResponse{}
+	if json.Unmarshal(body, &response) != nil || response.Error.Code == "" {
+		return err.Error()
+	}
+
+	return formatErrorDetails(response.Error)
+}
+
+func formatErrorDetails(details v1.ErrorDetails) string {
+	message := fmt.Sprintf("%s: %s", details.Code, details.Message)
+	if details.Target != "" {
+		message += fmt.Sprintf(" (target: %s)", details.Target)
+	}
+
+	if len(details.Details) == 0 {
+		return message
+	}
+
+	inner := []string{}
+	for _, detail := range details.Details {
+		inner = append(inner, formatErrorDetails(detail))
+	}
+
+	return message + " [" + strings.Join(inner, "; ") + "]"
+}
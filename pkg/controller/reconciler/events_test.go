@@ -0,0 +1,83 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+func newResponseError(t *testing.T, statusCode int, body string) error {
+	request, err := http.NewRequest(http.MethodPut, "http://localhost/planes/radius/local/resourceGroups/test/providers/Applications.Core/extenders/test", nil)
+	require.NoError(t, err)
+
+	response := &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    request,
+	}
+	return runtime.NewResponseError(response)
+}
+
+func Test_errorDetails(t *testing.T) {
+	t.Run("plain error", func(t *testing.T) {
+		require.Equal(t, "oh noes", errorDetails(errors.New("oh noes")))
+	})
+
+	t.Run("response error", func(t *testing.T) {
+		err := newResponseError(t, http.StatusBadRequest, `{"error":{"code":"BadRequest","message":"the recipe failed","target":"recipe","details":[{"code":"RecipeDeploymentFailed","message":"timeout"},{"code":"Conflict","message":"already exists"}]}}`)
+		require.Equal(t, "BadRequest: the recipe failed (target: recipe) [RecipeDeploymentFailed: timeout; Conflict: already exists]", errorDetails(err))
+
+		// The body can be read again.
+		require.Equal(t, errorDetails(err), errorDetails(err))
+	})
+
+	t.Run("wrapped response error", func(t *testing.T) {
+		err := newResponseError(t, http.StatusInternalServerError, `{"error":{"code":"Internal","message":"something went wrong"}}`)
+		require.Equal(t, "Internal: something went wrong", errorDetails(errors.Join(errors.New("failed"), err)))
+	})
+
+	t.Run("response error without error details", func(t *testing.T) {
+		err := newResponseError(t, http.StatusInternalServerError, `not json`)
+		require.Equal(t, err.Error(), errorDetails(err))
+	})
+}
+
+func Test_recordOperationEvents(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	recipe := &radappiov1alpha3.Recipe{}
+	resourceID := "/planes/radius/local/resourceGroups/test/providers/Applications.Core/extenders/test"
+
+	recordOperationStarted(recorder, recipe, radappiov1alpha3.OperationKindPut, resourceID)
+	recordOperationSucceeded(recorder, recipe, radappiov1alpha3.OperationKindPut, resourceID)
+	recordOperationFailed(recorder, recipe, radappiov1alpha3.OperationKindDelete, resourceID, errors.New("oh noes"))
+
+	require.Equal(t, corev1.EventTypeNormal+" "+EventReasonOperationStarted+" Started PUT operation for "+resourceID+".", <-recorder.Events)
+	require.Equal(t, corev1.EventTypeNormal+" "+EventReasonOperationSucceeded+" Completed PUT operation for "+resourceID+".", <-recorder.Events)
+	require.Equal(t, corev1.EventTypeWarning+" "+EventReasonOperationFailed+" DELETE operation for "+resourceID+" failed: oh noes", <-recorder.Events)
+}
@@ -253,6 +253,47 @@ type mockEnvironmentClient struct {
 	scope string
 }
 
+func (ec *mockEnvironmentClient) id(environmentName string) string {
+	return ec.scope + "/providers/Applications.Core/environments/" + environmentName
+}
+
+func (ec *mockEnvironmentClient) CreateOrUpdate(ctx context.Context, environmentName string, resource corerpv20231001preview.EnvironmentResource, options *corerpv20231001preview.EnvironmentsClientCreateOrUpdateOptions) (corerpv20231001preview.EnvironmentsClientCreateOrUpdateResponse, error) {
+	id := ec.id(environmentName)
+
+	ec.mock.lock.Lock()
+	defer ec.mock.lock.Unlock()
+
+	resource.ID = to.Ptr(id)
+	resource.Name = to.Ptr(environmentName)
+	ec.mock.environments[id] = resource
+	return corerpv20231001preview.EnvironmentsClientCreateOrUpdateResponse{EnvironmentResource: resource}, nil
+}
+
+func (ec *mockEnvironmentClient) Delete(ctx context.Context, environmentName string, options *corerpv20231001preview.EnvironmentsClientDeleteOptions) (corerpv20231001preview.EnvironmentsClientDeleteResponse, error) {
+	id := ec.id(environmentName)
+
+	ec.mock.lock.Lock()
+	defer ec.mock.lock.Unlock()
+
+	delete(ec.mock.environments, id)
+	return corerpv20231001preview.EnvironmentsClientDeleteResponse{}, nil
+}
+
+func (ec *mockEnvironmentClient) Get(ctx context.Context, environmentName string, options *corerpv20231001preview.EnvironmentsClientGetOptions) (corerpv20231001preview.EnvironmentsClientGetResponse, error) {
+	id := ec.id(environmentName)
+
+	ec.mock.lock.Lock()
+	defer ec.mock.lock.Unlock()
+
+	environment, ok := ec.mock.environments[id]
+	if !ok {
+		err := &azcore.ResponseError{ErrorCode: v1.CodeNotFound, StatusCode: http.StatusNotFound}
+		return corerpv20231001preview.EnvironmentsClientGetResponse{}, err
+	}
+
+	return corerpv20231001preview.EnvironmentsClientGetResponse{EnvironmentResource: environment}, nil
+}
+
 func (ec *mockEnvironmentClient) List(ctx context.Context, options *corerpv20231001preview.EnvironmentsClientListByScopeOptions) (corerpv20231001preview.EnvironmentsClientListByScopeResponse, error) {
 	ec.mock.lock.Lock()
 	defer ec.mock.lock.Unlock()
@@ -120,6 +120,7 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 	//
 	// The only difference between these two codepaths is how they handle success.
 	if recipe.Status.Operation.OperationKind == radappiov1alpha3.OperationKindPut {
+		resourceID := recipe.Status.Scope + "/providers/" + recipe.Spec.Type + "/" + recipe.Name
 		poller, err := r.Radius.Resources(recipe.Status.Scope, recipe.Spec.Type).ContinueCreateOperation(ctx, recipe.Status.Operation.ResumeToken)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to continue PUT operation: %w", err)
@@ -138,11 +139,12 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 		_, err = poller.Result(ctx)
 		if err != nil {
 			// Operation failed, reset state and retry.
-			r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "ResourceError", err.Error())
+			recordOperationFailed(r.EventRecorder, recipe, radappiov1alpha3.OperationKindPut, resourceID, err)
 			logger.Error(err, "Update failed.")
 
 			recipe.Status.Operation = nil
 			recipe.Status.Phrase = radappiov1alpha3.PhraseFailed
+			setFailedConditions(&recipe.Status.Conditions, recipe.Generation, radappiov1alpha3.ReasonProvisioningFailed, errorDetails(err))
 
 			err = r.Client.Status().Update(ctx, recipe)
 			if err != nil {
@@ -155,8 +157,9 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 		// If we get here, the operation was a success. Update the status and continue.
 		//
 		// NOTE: we don't need to save the status here, because we're going to continue reconciling.
+		recordOperationSucceeded(r.EventRecorder, recipe, radappiov1alpha3.OperationKindPut, resourceID)
 		recipe.Status.Operation = nil
-		recipe.Status.Resource = recipe.Status.Scope + "/providers/" + recipe.Spec.Type + "/" + recipe.Name
+		recipe.Status.Resource = resourceID
 		return ctrl.Result{}, nil
 
 	} else if recipe.Status.Operation.OperationKind == radappiov1alpha3.OperationKindDelete {
@@ -178,11 +181,12 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 		_, err = poller.Result(ctx)
 		if err != nil {
 			// Operation failed, reset state and retry.
-			r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "ResourceError", err.Error())
+			recordOperationFailed(r.EventRecorder, recipe, radappiov1alpha3.OperationKindDelete, recipe.Status.Resource, err)
 			logger.Error(err, "Delete failed.")
 
 			recipe.Status.Operation = nil
 			recipe.Status.Phrase = radappiov1alpha3.PhraseFailed
+			setFailedConditions(&recipe.Status.Conditions, recipe.Generation, radappiov1alpha3.ReasonDeletionFailed, errorDetails(err))
 
 			err = r.Client.Status().Update(ctx, recipe)
 			if err != nil {
@@ -195,6 +199,7 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 		// If we get here, the operation was a success. Update the status and continue.
 		//
 		// NOTE: we don't need to save the status here, because we're going to continue reconciling.
+		recordOperationSucceeded(r.EventRecorder, recipe, radappiov1alpha3.OperationKindDelete, recipe.Status.Resource)
 		recipe.Status.Operation = nil
 		recipe.Status.Resource = ""
 		return ctrl.Result{}, nil
@@ -232,20 +237,17 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 	// fully processed any status changes until the async operation completes.
 	recipe.Status.ObservedGeneration = recipe.Generation
 
-	environmentName := "default"
-	if recipe.Spec.Environment != "" {
-		environmentName = recipe.Spec.Environment
-	}
-
-	applicationName := recipe.Namespace
-	if recipe.Spec.Application != "" {
-		applicationName = recipe.Spec.Application
-	}
-
-	resourceGroupID, environmentID, applicationID, err := resolveDependencies(ctx, r.Radius, "/planes/radius/local", environmentName, applicationName)
+	resourceGroupID, environmentID, applicationID, err := resolveDependencies(ctx, r.Radius, "/planes/radius/local", recipeEnvironmentName(recipe), recipeApplicationName(recipe))
 	if err != nil {
 		r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "DependencyError", err.Error())
 		logger.Error(err, "Unable to resolve dependencies.")
+
+		setFailedConditions(&recipe.Status.Conditions, recipe.Generation, radappiov1alpha3.ReasonDependencyError, err.Error())
+		updateErr := r.Client.Status().Update(ctx, recipe)
+		if updateErr != nil {
+			return ctrl.Result{}, updateErr
+		}
+
 		return ctrl.Result{}, fmt.Errorf("failed to resolve dependencies: %w", err)
 	}
 
@@ -256,7 +258,14 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 	updatePoller, deletePoller, err := r.startPutOrDeleteOperationIfNeeded(ctx, recipe)
 	if err != nil {
 		logger.Error(err, "Unable to create or update resource.")
-		r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "ResourceError", err.Error())
+		r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "ResourceError", errorDetails(err))
+
+		setFailedConditions(&recipe.Status.Conditions, recipe.Generation, radappiov1alpha3.ReasonProvisioningFailed, errorDetails(err))
+		updateErr := r.Client.Status().Update(ctx, recipe)
+		if updateErr != nil {
+			return ctrl.Result{}, updateErr
+		}
+
 		return ctrl.Result{}, err
 	} else if updatePoller != nil {
 		// We've successfully started an operation. Update the status and requeue.
@@ -265,8 +274,12 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 			return ctrl.Result{}, fmt.Errorf("failed to get operation token: %w", err)
 		}
 
+		resourceID := recipe.Status.Scope + "/providers/" + recipe.Spec.Type + "/" + recipe.Name
+		recordOperationStarted(r.EventRecorder, recipe, radappiov1alpha3.OperationKindPut, resourceID)
+
 		recipe.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindPut}
 		recipe.Status.Phrase = radappiov1alpha3.PhraseUpdating
+		setOperationStartedConditions(&recipe.Status.Conditions, recipe.Generation, radappiov1alpha3.OperationKindPut, "Creating or updating "+resourceID+".")
 		err = r.Client.Status().Update(ctx, recipe)
 		if err != nil {
 			return ctrl.Result{}, err
@@ -280,8 +293,11 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 			return ctrl.Result{}, fmt.Errorf("failed to get operation token: %w", err)
 		}
 
+		recordOperationStarted(r.EventRecorder, recipe, radappiov1alpha3.OperationKindDelete, recipe.Status.Resource)
+
 		recipe.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindDelete}
 		recipe.Status.Phrase = radappiov1alpha3.PhraseDeleting
+		setOperationStartedConditions(&recipe.Status.Conditions, recipe.Generation, radappiov1alpha3.OperationKindDelete, "Deleting "+recipe.Status.Resource+".")
 		err = r.Client.Status().Update(ctx, recipe)
 		if err != nil {
 			return ctrl.Result{}, err
@@ -299,6 +315,7 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 	}
 
 	recipe.Status.Phrase = radappiov1alpha3.PhraseReady
+	setReconciledConditions(&recipe.Status.Conditions, recipe.Generation, "Resource "+recipe.Status.Resource+" is ready.")
 	err = r.Client.Status().Update(ctx, recipe)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -320,7 +337,14 @@ func (r *RecipeReconciler) reconcileDelete(ctx context.Context, recipe *radappio
 	poller, err := r.startDeleteOperationIfNeeded(ctx, recipe)
 	if err != nil {
 		logger.Error(err, "Unable to delete resource.")
-		r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "ResourceError", err.Error())
+		r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "ResourceError", errorDetails(err))
+
+		setFailedConditions(&recipe.Status.Conditions, recipe.Generation, radappiov1alpha3.ReasonDeletionFailed, errorDetails(err))
+		updateErr := r.Client.Status().Update(ctx, recipe)
+		if updateErr != nil {
+			return ctrl.Result{}, updateErr
+		}
+
 		return ctrl.Result{}, err
 	} else if poller != nil {
 		// We've successfully started an operation. Update the status and requeue.
@@ -329,8 +353,11 @@ func (r *RecipeReconciler) reconcileDelete(ctx context.Context, recipe *radappio
 			return ctrl.Result{}, fmt.Errorf("failed to get operation token: %w", err)
 		}
 
+		recordOperationStarted(r.EventRecorder, recipe, radappiov1alpha3.OperationKindDelete, recipe.Status.Resource)
+
 		recipe.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindDelete}
 		recipe.Status.Phrase = radappiov1alpha3.PhraseDeleting
+		setOperationStartedConditions(&recipe.Status.Conditions, recipe.Generation, radappiov1alpha3.OperationKindDelete, "Deleting "+recipe.Status.Resource+".")
 		err = r.Client.Status().Update(ctx, recipe)
 		if err != nil {
 			return ctrl.Result{}, err
@@ -568,3 +595,21 @@ func (r *RecipeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.Secret{}).
 		Complete(r)
 }
+
+// recipeEnvironmentName returns the name of the environment of the Recipe.
+func recipeEnvironmentName(recipe *radappiov1alpha3.Recipe) string {
+	if recipe.Spec.Environment != "" {
+		return recipe.Spec.Environment
+	}
+
+	return "default"
+}
+
+// recipeApplicationName returns the name of the application of the Recipe.
+func recipeApplicationName(recipe *radappiov1alpha3.Recipe) string {
+	if recipe.Spec.Application != "" {
+		return recipe.Spec.Application
+	}
+
+	return recipe.Namespace
+}
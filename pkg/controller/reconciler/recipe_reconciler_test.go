@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -231,9 +232,15 @@ func Test_RecipeReconciler_FailureRecovery(t *testing.T) {
 	// Recipe should (eventually) start a new provisioning operation
 	status = waitForRecipeStateUpdating(t, client, name, operation)
 
+	// The failure is reported in the conditions until the next operation completes.
+	requireCondition(t, status.Conditions, radappiov1alpha3.ConditionProvisioning, metav1.ConditionTrue, radappiov1alpha3.ReasonUpdating)
+	requireCondition(t, status.Conditions, radappiov1alpha3.ConditionDegraded, metav1.ConditionTrue, radappiov1alpha3.ReasonProvisioningFailed)
+
 	// Complete the operation, successfully this time.
 	radius.CompleteOperation(status.Operation.ResumeToken, nil)
-	_ = waitForRecipeStateReady(t, client, name)
+	status = waitForRecipeStateReady(t, client, name)
+	requireCondition(t, status.Conditions, radappiov1alpha3.ConditionReady, metav1.ConditionTrue, radappiov1alpha3.ReasonReconciled)
+	requireCondition(t, status.Conditions, radappiov1alpha3.ConditionDegraded, metav1.ConditionFalse, radappiov1alpha3.ReasonReconciled)
 
 	err = client.Delete(ctx, recipe)
 	require.NoError(t, err)
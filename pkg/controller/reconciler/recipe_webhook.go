@@ -0,0 +1,116 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/rp/portableresources"
+)
+
+var _ admission.CustomValidator = (*RecipeWebhook)(nil)
+
+// RecipeWebhook validates Recipes when they are created or updated.
+type RecipeWebhook struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Radius is the Radius client.
+	Radius RadiusClient
+}
+
+// SetupWebhookWithManager sets up the webhook with the Manager.
+func (w *RecipeWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&radappiov1alpha3.Recipe{}).
+		WithValidator(w).
+		Complete()
+}
+
+// ValidateCreate validates a Recipe that is being created.
+func (w *RecipeWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	recipe, ok := obj.(*radappiov1alpha3.Recipe)
+	if !ok {
+		return nil, fmt.Errorf("expected a Recipe but got a %T", obj)
+	}
+
+	return w.validate(ctx, recipe)
+}
+
+// ValidateUpdate validates a Recipe that is being updated.
+func (w *RecipeWebhook) ValidateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	recipe, ok := newObj.(*radappiov1alpha3.Recipe)
+	if !ok {
+		return nil, fmt.Errorf("expected a Recipe but got a %T", newObj)
+	}
+
+	// The controller updates the finalizers and status of the Recipe during deletion. There's no
+	// value in validating the Recipe at that point.
+	if recipe.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	return w.validate(ctx, recipe)
+}
+
+// ValidateDelete validates a Recipe that is being deleted. Deletion is always allowed.
+func (w *RecipeWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *RecipeWebhook) validate(ctx context.Context, recipe *radappiov1alpha3.Recipe) (admission.Warnings, error) {
+	errs := field.ErrorList{}
+	spec := field.NewPath("spec")
+
+	if !portableresources.IsValidPortableResourceType(recipe.Spec.Type) {
+		errs = append(errs, field.Invalid(spec.Child("type"), recipe.Spec.Type, "must be a resource type that supports recipes, such as 'Applications.Datastores/redisCaches' or 'Applications.Core/extenders'"))
+	}
+
+	if recipe.Spec.SecretName != "" {
+		for _, message := range validation.IsDNS1123Subdomain(recipe.Spec.SecretName) {
+			errs = append(errs, field.Invalid(spec.Child("secretName"), recipe.Spec.SecretName, message))
+		}
+	}
+
+	if err := validateResourceName(spec.Child("application"), recipeApplicationName(recipe)); err != nil {
+		errs = append(errs, err)
+	}
+
+	var warnings admission.Warnings
+	if err := validateResourceName(spec.Child("environment"), recipeEnvironmentName(recipe)); err != nil {
+		errs = append(errs, err)
+	} else if len(errs) == 0 {
+		// Only check the environment when everything else is valid, this requires calls to Radius.
+		warnings = validateEnvironmentExists(ctx, w.Client, w.Radius, recipeEnvironmentName(recipe))
+	}
+
+	if len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(radappiov1alpha3.GroupVersion.WithKind("Recipe").GroupKind(), recipe.Name, errs)
+	}
+
+	return warnings, nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+// newWebhookTestClient creates a fake Kubernetes client for testing webhooks. Webhooks don't need the envtest
+// environment because they only read from the client.
+func newWebhookTestClient(t *testing.T, objects ...client.Object) client.Client {
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, radappiov1alpha3.AddToScheme(s))
+
+	return fake.NewClientBuilder().WithScheme(s).WithObjects(objects...).Build()
+}
+
+func Test_RecipeWebhook_Valid(t *testing.T) {
+	ctx := testcontext.New(t)
+	radius := NewMockRadiusClient()
+	createEnvironment(radius, "default")
+
+	webhook := &RecipeWebhook{Client: newWebhookTestClient(t), Radius: radius}
+
+	recipe := makeRecipe(types.NamespacedName{Namespace: "default", Name: "test"}, "Applications.Datastores/redisCaches")
+	recipe.Spec.SecretName = "redis-secret"
+
+	warnings, err := webhook.ValidateCreate(ctx, recipe)
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+
+	warnings, err = webhook.ValidateUpdate(ctx, recipe, recipe)
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+}
+
+func Test_RecipeWebhook_EnvironmentResource(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	// The environment will be created by the controller, it doesn't need to exist in Radius yet.
+	environment := &radappiov1alpha3.Environment{ObjectMeta: ctrl.ObjectMeta{Namespace: "radius-system", Name: "production"}}
+	webhook := &RecipeWebhook{Client: newWebhookTestClient(t, environment), Radius: NewMockRadiusClient()}
+
+	recipe := makeRecipe(types.NamespacedName{Namespace: "default", Name: "test"}, "Applications.Core/extenders")
+	recipe.Spec.Environment = "production"
+
+	_, err := webhook.ValidateCreate(ctx, recipe)
+	require.NoError(t, err)
+}
+
+func Test_RecipeWebhook_EnvironmentNotFound(t *testing.T) {
+	ctx := testcontext.New(t)
+	webhook := &RecipeWebhook{Client: newWebhookTestClient(t), Radius: NewMockRadiusClient()}
+
+	recipe := makeRecipe(types.NamespacedName{Namespace: "default", Name: "test"}, "Applications.Core/extenders")
+	recipe.Spec.Environment = "production"
+
+	// The environment doesn't exist yet, this is allowed because it may be created later.
+	warnings, err := webhook.ValidateCreate(ctx, recipe)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0], `environment "production" does not exist. Create it with 'rad env create production'`)
+}
+
+func Test_RecipeWebhook_Invalid(t *testing.T) {
+	ctx := testcontext.New(t)
+	radius := NewMockRadiusClient()
+	createEnvironment(radius, "default")
+
+	webhook := &RecipeWebhook{Client: newWebhookTestClient(t), Radius: radius}
+
+	tests := []struct {
+		name     string
+		modify   func(recipe *radappiov1alpha3.Recipe)
+		expected string
+	}{
+		{
+			name:     "invalid type",
+			modify:   func(recipe *radappiov1alpha3.Recipe) { recipe.Spec.Type = "Applications.Datastores/redis" },
+			expected: `spec.type: Invalid value: "Applications.Datastores/redis": must be a resource type that supports recipes`,
+		},
+		{
+			name:     "type without recipes",
+			modify:   func(recipe *radappiov1alpha3.Recipe) { recipe.Spec.Type = "Applications.Core/containers" },
+			expected: `spec.type: Invalid value: "Applications.Core/containers"`,
+		},
+		{
+			name:     "invalid secret name",
+			modify:   func(recipe *radappiov1alpha3.Recipe) { recipe.Spec.SecretName = "Not_A_Secret" },
+			expected: `spec.secretName: Invalid value: "Not_A_Secret"`,
+		},
+		{
+			name:     "invalid application name",
+			modify:   func(recipe *radappiov1alpha3.Recipe) { recipe.Spec.Application = "my_app" },
+			expected: `spec.application: Invalid value: "my_app": must start with a letter`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			recipe := makeRecipe(types.NamespacedName{Namespace: "default", Name: "test"}, "Applications.Datastores/redisCaches")
+			tc.modify(recipe)
+
+			_, err := webhook.ValidateCreate(ctx, recipe)
+			require.Error(t, err)
+			require.True(t, apierrors.IsInvalid(err))
+			require.Contains(t, err.Error(), tc.expected)
+		})
+	}
+}
+
+func Test_RecipeWebhook_Deleting(t *testing.T) {
+	ctx := testcontext.New(t)
+	webhook := &RecipeWebhook{Client: newWebhookTestClient(t), Radius: NewMockRadiusClient()}
+
+	recipe := makeRecipe(types.NamespacedName{Namespace: "default", Name: "test"}, "invalid")
+	now := metav1.Now()
+	recipe.DeletionTimestamp = &now
+
+	_, err := webhook.ValidateUpdate(ctx, recipe, recipe)
+	require.NoError(t, err)
+
+	_, err = webhook.ValidateDelete(ctx, recipe)
+	require.NoError(t, err)
+}
@@ -0,0 +1,653 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/go-logr/logr"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ResourceReconciler reconciles a Resource object.
+type ResourceReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Radius is the Radius client.
+	Radius RadiusClient
+
+	// DelayInterval is the amount of time to wait between operations.
+	DelayInterval time.Duration
+}
+
+// Reconcile is the main reconciliation loop for the Resource resource.
+func (r *ResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", "Resource", "name", req.Name, "namespace", req.Namespace)
+	ctx = logr.NewContext(ctx, logger)
+
+	resource := radappiov1alpha3.Resource{}
+	err := r.Client.Get(ctx, req.NamespacedName, &resource)
+	if apierrors.IsNotFound(err) {
+		// This can happen due to a data-race if the resource is created and then deleted before we can
+		// reconcile it. There's nothing to do here.
+		logger.Info("Resource is being deleted.")
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "Unable to fetch resource.")
+		return ctrl.Result{}, err
+	}
+
+	// Our algorithm is as follows:
+	//
+	// 1. Check if we have an "operation" in progress. If so, check it's status.
+	//   a. If the operation is still in progress, then queue another reconcile (polling).
+	//   b. If the operation completed successfully then update the status and continue processing (happy-path).
+	//   c. If the operation failed then update the status and continue processing (retry).
+	// 2. If the resource is being deleted then process deletion.
+	//   a. This may require us to start a DELETE operation. After that we can continue polling.
+	// 3. If the resource is not being deleted then process this as a creation or update.
+	//   a. This may require us to start a PUT operation. After that we can continue polling.
+	//
+	// We do it this way because it guarantees that we only have one operation going at a time. The resume
+	// token of the operation is stored in the status, so an operation can be resumed after a restart.
+
+	if resource.Status.Operation != nil {
+		// NOTE: if reconcileOperation completes successfully, then it will return a "zero" result,
+		// this means the operation has completed and we should continue processing.
+		result, err := r.reconcileOperation(ctx, &resource)
+		if err != nil {
+			logger.Error(err, "Unable to reconcile in-progress operation.")
+			return ctrl.Result{}, err
+		} else if result.IsZero() {
+			// NOTE: if reconcileOperation completes successfully, then it will return a "zero" result,
+			// this means the operation has completed and we should continue processing.
+			logger.Info("Operation completed successfully.")
+		} else {
+			logger.Info("Requeueing to continue operation.")
+			return result, nil
+		}
+	}
+
+	if resource.DeletionTimestamp != nil {
+		return r.reconcileDelete(ctx, &resource)
+	}
+
+	return r.reconcileUpdate(ctx, &resource)
+}
+
+// reconcileOperation reconciles a Resource that has an operation in progress.
+func (r *ResourceReconciler) reconcileOperation(ctx context.Context, resource *radappiov1alpha3.Resource) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// NOTE: the pollers are actually different types, so we have to duplicate the code
+	// for the PUT and DELETE handling.
+	//
+	// The only difference between these two codepaths is how they handle success.
+	if resource.Status.Operation.OperationKind == radappiov1alpha3.OperationKindPut {
+		resourceID := resource.Status.Scope + "/providers/" + resource.Spec.Type + "/" + resource.Name
+		poller, err := r.Radius.Resources(resource.Status.Scope, resource.Spec.Type).ContinueCreateOperation(ctx, resource.Status.Operation.ResumeToken)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to continue PUT operation: %w", err)
+		}
+
+		_, err = poller.Poll(ctx)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to poll operation status: %w", err)
+		}
+
+		if !poller.Done() {
+			return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+		}
+
+		// If we get here, the operation is complete.
+		_, err = poller.Result(ctx)
+		if err != nil {
+			// Operation failed, reset state and retry.
+			recordOperationFailed(r.EventRecorder, resource, radappiov1alpha3.OperationKindPut, resourceID, err)
+			logger.Error(err, "Update failed.")
+
+			resource.Status.Operation = nil
+			resource.Status.Phrase = radappiov1alpha3.PhraseFailed
+			setFailedConditions(&resource.Status.Conditions, resource.Generation, radappiov1alpha3.ReasonProvisioningFailed, errorDetails(err))
+
+			err = r.Client.Status().Update(ctx, resource)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
+			return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+		}
+
+		// If we get here, the operation was a success. Update the status and continue.
+		//
+		// NOTE: we don't need to save the status here, because we're going to continue reconciling.
+		recordOperationSucceeded(r.EventRecorder, resource, radappiov1alpha3.OperationKindPut, resourceID)
+		resource.Status.Operation = nil
+		resource.Status.Resource = resourceID
+		return ctrl.Result{}, nil
+
+	} else if resource.Status.Operation.OperationKind == radappiov1alpha3.OperationKindDelete {
+		poller, err := r.Radius.Resources(resource.Status.Scope, resource.Spec.Type).ContinueDeleteOperation(ctx, resource.Status.Operation.ResumeToken)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to continue DELETE operation: %w", err)
+		}
+
+		_, err = poller.Poll(ctx)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to poll operation status: %w", err)
+		}
+
+		if !poller.Done() {
+			return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+		}
+
+		// If we get here, the operation is complete.
+		_, err = poller.Result(ctx)
+		if err != nil {
+			// Operation failed, reset state and retry.
+			recordOperationFailed(r.EventRecorder, resource, radappiov1alpha3.OperationKindDelete, resource.Status.Resource, err)
+			logger.Error(err, "Delete failed.")
+
+			resource.Status.Operation = nil
+			resource.Status.Phrase = radappiov1alpha3.PhraseFailed
+			setFailedConditions(&resource.Status.Conditions, resource.Generation, radappiov1alpha3.ReasonDeletionFailed, errorDetails(err))
+
+			err = r.Client.Status().Update(ctx, resource)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
+			return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+		}
+
+		// If we get here, the operation was a success. Update the status and continue.
+		//
+		// NOTE: we don't need to save the status here, because we're going to continue reconciling.
+		recordOperationSucceeded(r.EventRecorder, resource, radappiov1alpha3.OperationKindDelete, resource.Status.Resource)
+		resource.Status.Operation = nil
+		resource.Status.Resource = ""
+		resource.Status.ProvisioningState = ""
+		resource.Status.OutputResources = nil
+		resource.Status.ConnectionValues = nil
+		return ctrl.Result{}, nil
+	}
+
+	// If we get here, this was an unknown operation kind. This is a bug in our code, or someone
+	// tampered with the status of the object. Just reset the state and move on.
+	logger.Error(fmt.Errorf("unknown operation kind: %s", resource.Status.Operation.OperationKind), "Unknown operation kind.")
+
+	resource.Status.Operation = nil
+	resource.Status.Phrase = radappiov1alpha3.PhraseFailed
+
+	err := r.Client.Status().Update(ctx, resource)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *ResourceReconciler) reconcileUpdate(ctx context.Context, resource *radappiov1alpha3.Resource) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Ensure that our finalizer is present before we start any operations.
+	if controllerutil.AddFinalizer(resource, ResourceFinalizer) {
+		err := r.Client.Update(ctx, resource)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	resourceGroupID, environmentID, applicationID, err := resolveDependencies(ctx, r.Radius, "/planes/radius/local", resourceEnvironmentName(resource), resourceApplicationName(resource))
+	if err != nil {
+		r.EventRecorder.Event(resource, corev1.EventTypeWarning, "DependencyError", err.Error())
+		logger.Error(err, "Unable to resolve dependencies.")
+
+		setFailedConditions(&resource.Status.Conditions, resource.Generation, radappiov1alpha3.ReasonDependencyError, err.Error())
+		updateErr := r.Client.Status().Update(ctx, resource)
+		if updateErr != nil {
+			return ctrl.Result{}, updateErr
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to resolve dependencies: %w", err)
+	}
+
+	resource.Status.Scope = resourceGroupID
+	resource.Status.Environment = environmentID
+	resource.Status.Application = applicationID
+
+	updatePoller, deletePoller, err := r.startPutOrDeleteOperationIfNeeded(ctx, resource)
+	if err != nil {
+		logger.Error(err, "Unable to create or update resource.")
+		r.EventRecorder.Event(resource, corev1.EventTypeWarning, "ResourceError", errorDetails(err))
+
+		setFailedConditions(&resource.Status.Conditions, resource.Generation, radappiov1alpha3.ReasonProvisioningFailed, errorDetails(err))
+		updateErr := r.Client.Status().Update(ctx, resource)
+		if updateErr != nil {
+			return ctrl.Result{}, updateErr
+		}
+
+		return ctrl.Result{}, err
+	} else if updatePoller != nil {
+		// We've successfully started an operation. Update the status and requeue.
+		token, err := updatePoller.ResumeToken()
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get operation token: %w", err)
+		}
+
+		resourceID := resource.Status.Scope + "/providers/" + resource.Spec.Type + "/" + resource.Name
+		recordOperationStarted(r.EventRecorder, resource, radappiov1alpha3.OperationKindPut, resourceID)
+
+		resource.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindPut}
+		resource.Status.Phrase = radappiov1alpha3.PhraseUpdating
+		setOperationStartedConditions(&resource.Status.Conditions, resource.Generation, radappiov1alpha3.OperationKindPut, "Creating or updating "+resourceID+".")
+		err = r.Client.Status().Update(ctx, resource)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+	} else if deletePoller != nil {
+		// We've successfully started an operation. Update the status and requeue.
+		token, err := deletePoller.ResumeToken()
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get operation token: %w", err)
+		}
+
+		recordOperationStarted(r.EventRecorder, resource, radappiov1alpha3.OperationKindDelete, resource.Status.Resource)
+
+		resource.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindDelete}
+		resource.Status.Phrase = radappiov1alpha3.PhraseDeleting
+		setOperationStartedConditions(&resource.Status.Conditions, resource.Generation, radappiov1alpha3.OperationKindDelete, "Deleting "+resource.Status.Resource+".")
+		err = r.Client.Status().Update(ctx, resource)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+	}
+
+	// If we get here then it means we can process the result of the operation.
+	logger.Info("Resource is in desired state.", "resourceId", resource.Status.Resource)
+
+	err = r.updateStatusAndSecret(ctx, resource)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	resource.Status.Phrase = radappiov1alpha3.PhraseReady
+	setReconciledConditions(&resource.Status.Conditions, resource.Generation, "Resource "+resource.Status.Resource+" is ready.")
+	err = r.Client.Status().Update(ctx, resource)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.EventRecorder.Event(resource, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
+	return ctrl.Result{}, nil
+}
+
+func (r *ResourceReconciler) reconcileDelete(ctx context.Context, resource *radappiov1alpha3.Resource) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Since we're going to reconcile, update the observed generation.
+	resource.Status.ObservedGeneration = resource.Generation
+
+	poller, err := r.startDeleteOperationIfNeeded(ctx, resource)
+	if err != nil {
+		logger.Error(err, "Unable to delete resource.")
+		r.EventRecorder.Event(resource, corev1.EventTypeWarning, "ResourceError", errorDetails(err))
+
+		setFailedConditions(&resource.Status.Conditions, resource.Generation, radappiov1alpha3.ReasonDeletionFailed, errorDetails(err))
+		updateErr := r.Client.Status().Update(ctx, resource)
+		if updateErr != nil {
+			return ctrl.Result{}, updateErr
+		}
+
+		return ctrl.Result{}, err
+	} else if poller != nil {
+		// We've successfully started an operation. Update the status and requeue.
+		token, err := poller.ResumeToken()
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get operation token: %w", err)
+		}
+
+		recordOperationStarted(r.EventRecorder, resource, radappiov1alpha3.OperationKindDelete, resource.Status.Resource)
+
+		resource.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindDelete}
+		resource.Status.Phrase = radappiov1alpha3.PhraseDeleting
+		setOperationStartedConditions(&resource.Status.Conditions, resource.Generation, radappiov1alpha3.OperationKindDelete, "Deleting "+resource.Status.Resource+".")
+		err = r.Client.Status().Update(ctx, resource)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+	}
+
+	logger.Info("Resource is deleted.")
+
+	err = r.deleteSecret(ctx, resource)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to process secret %s: %w", resource.Spec.SecretName, err)
+	}
+
+	// At this point we've cleaned up everything. We can remove the finalizer which will allow deletion of the
+	// resource.
+	if controllerutil.RemoveFinalizer(resource, ResourceFinalizer) {
+		err := r.Client.Update(ctx, resource)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	r.EventRecorder.Event(resource, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
+	return ctrl.Result{}, nil
+}
+
+func (r *ResourceReconciler) startPutOrDeleteOperationIfNeeded(ctx context.Context, resource *radappiov1alpha3.Resource) (Poller[generated.GenericResourcesClientCreateOrUpdateResponse], Poller[generated.GenericResourcesClientDeleteResponse], error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	resourceID := resource.Status.Scope + "/providers/" + resource.Spec.Type + "/" + resource.Name
+	if resource.Status.Resource != "" && !strings.EqualFold(resource.Status.Resource, resourceID) {
+		// If we get here it means that the environment, application or type changed, so we should delete
+		// the old resource and create a new one.
+		logger.Info("Resource is already created but is out-of-date")
+
+		logger.Info("Starting DELETE operation.")
+		poller, err := deleteResource(ctx, r.Radius, resource.Status.Resource)
+		if err != nil {
+			return nil, nil, err
+		} else if poller != nil {
+			return nil, poller, nil
+		}
+
+		// Deletion was synchronous
+		resource.Status.Resource = ""
+	}
+
+	// Unlike a Recipe, the properties of a Resource can change. The observed generation is recorded when
+	// we start a PUT operation, so a resource with a newer generation (or a failed update) is out-of-date.
+	//
+	// Note: we separate this check from the previous block, because it could complete synchronously.
+	if resource.Status.Resource != "" && resource.Status.ObservedGeneration == resource.Generation && resource.Status.Phrase != radappiov1alpha3.PhraseFailed {
+		logger.Info("Resource is already created and is up-to-date.")
+		return nil, nil, nil
+	}
+
+	properties, err := resourceProperties(resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger.Info("Starting PUT operation.")
+	resource.Status.ObservedGeneration = resource.Generation
+	poller, err := createOrUpdateResource(ctx, r.Radius, resourceID, properties)
+	if err != nil {
+		return nil, nil, err
+	} else if poller != nil {
+		return poller, nil, nil
+	}
+
+	// Update was synchronous
+	resource.Status.Resource = resourceID
+	return nil, nil, nil
+}
+
+func (r *ResourceReconciler) startDeleteOperationIfNeeded(ctx context.Context, resource *radappiov1alpha3.Resource) (Poller[generated.GenericResourcesClientDeleteResponse], error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if resource.Status.Resource == "" {
+		logger.Info("Resource is already deleted (or was never created).")
+		return nil, nil
+	}
+
+	logger.Info("Starting DELETE operation.")
+	poller, err := deleteResource(ctx, r.Radius, resource.Status.Resource)
+	if err != nil {
+		return nil, err
+	} else if poller != nil {
+		return poller, err
+	}
+
+	// Deletion was synchronous
+
+	resource.Status.Resource = ""
+	return nil, nil
+}
+
+// updateStatusAndSecret reads the state of the Radius resource and reports it in the status of the Resource. The
+// connection values (including secrets) are written to the secret if one was requested.
+func (r *ResourceReconciler) updateStatusAndSecret(ctx context.Context, resource *radappiov1alpha3.Resource) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	result, err := fetchResource(ctx, r.Radius, resource.Status.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to read resource: %w", err)
+	}
+
+	values, err := resourceToConnectionValues(resource.Name, result.GenericResource)
+	if err != nil {
+		return fmt.Errorf("failed to read connection values: %w", err)
+	}
+
+	resource.Status.ProvisioningState, resource.Status.OutputResources = resourceStatusFromProperties(result.Properties)
+	resource.Status.ConnectionValues = values
+
+	// If the secret name changed, delete the old secret.
+	if resource.Spec.SecretName != resource.Status.Secret.Name && resource.Status.Secret.Name != "" {
+		err = r.deleteSecret(ctx, resource)
+		if err != nil {
+			return err
+		}
+	}
+
+	if resource.Spec.SecretName == "" {
+		logger.Info("No secret name specified, skipping secret creation")
+		resource.Status.Secret = corev1.ObjectReference{}
+		return nil
+	}
+
+	logger.Info("Creating or updating secret.", "secret", resource.Spec.SecretName)
+	data := map[string][]byte{}
+	for k, v := range values {
+		data[k] = []byte(v)
+	}
+
+	secrets, err := r.Radius.Resources(resource.Status.Scope, resource.Spec.Type).ListSecrets(ctx, resource.Name)
+	if clients.Is404Error(err) {
+		// Safe to ignore. Not everything implements this.
+	} else if err != nil {
+		return fmt.Errorf("failed to list secrets: %w", err)
+	} else {
+		for k, v := range secrets.Value {
+			data[k] = []byte(*v)
+		}
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resource.Spec.SecretName,
+			Namespace: resource.Namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		// envtest has some quirky behavior around StringData which makes it hard to test. So we're
+		// using Data directly.
+		secret.Data = data
+		return controllerutil.SetControllerReference(resource, secret, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update secret %s: %w", secret.Name, err)
+	}
+
+	resource.Status.Secret = corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Namespace:  secret.Namespace,
+		Name:       secret.Name,
+		UID:        secret.UID,
+	}
+
+	return nil
+}
+
+func (r *ResourceReconciler) deleteSecret(ctx context.Context, resource *radappiov1alpha3.Resource) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if resource.Status.Secret.Name != "" {
+		logger.Info("Deleting secret.", "secret", resource.Status.Secret.Name)
+		err := r.Client.Delete(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resource.Status.Secret.Name,
+				Namespace: resource.Namespace,
+			},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete secret %s: %w", resource.Status.Secret.Name, err)
+		}
+	}
+
+	resource.Status.Secret = corev1.ObjectReference{}
+	return nil
+}
+
+func (r *ResourceReconciler) requeueDelay() time.Duration {
+	delay := r.DelayInterval
+	if delay == 0 {
+		delay = PollingDelay
+	}
+
+	return delay
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&radappiov1alpha3.Resource{}).
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
+
+// resourceEnvironmentName returns the name of the environment of the Resource.
+func resourceEnvironmentName(resource *radappiov1alpha3.Resource) string {
+	if resource.Spec.Environment != "" {
+		return resource.Spec.Environment
+	}
+
+	return "default"
+}
+
+// resourceApplicationName returns the name of the application of the Resource.
+func resourceApplicationName(resource *radappiov1alpha3.Resource) string {
+	if resource.Spec.Application != "" {
+		return resource.Spec.Application
+	}
+
+	return resource.Namespace
+}
+
+// resourceProperties builds the properties of the Radius resource from the spec and status of the Resource.
+func resourceProperties(resource *radappiov1alpha3.Resource) (map[string]any, error) {
+	properties := map[string]any{}
+	if resource.Spec.Properties != nil && len(resource.Spec.Properties.Raw) > 0 {
+		err := json.Unmarshal(resource.Spec.Properties.Raw, &properties)
+		if err != nil {
+			return nil, fmt.Errorf("the properties of the resource must be an object: %w", err)
+		}
+	}
+
+	properties["application"] = resource.Status.Application
+	properties["environment"] = resource.Status.Environment
+
+	if resource.Spec.Recipe != nil {
+		recipe := map[string]any{}
+		if resource.Spec.Recipe.Name != "" {
+			recipe["name"] = resource.Spec.Recipe.Name
+		}
+
+		if resource.Spec.Recipe.Parameters != nil && len(resource.Spec.Recipe.Parameters.Raw) > 0 {
+			parameters := map[string]any{}
+			err := json.Unmarshal(resource.Spec.Recipe.Parameters.Raw, &parameters)
+			if err != nil {
+				return nil, fmt.Errorf("the recipe parameters of the resource must be an object: %w", err)
+			}
+
+			recipe["parameters"] = parameters
+		}
+
+		properties["recipe"] = recipe
+	}
+
+	return properties, nil
+}
+
+// resourceStatusFromProperties reads the provisioning state and output resources from the properties of a
+// Radius resource.
+func resourceStatusFromProperties(properties map[string]any) (string, []radappiov1alpha3.OutputResource) {
+	provisioningState, _ := properties["provisioningState"].(string)
+
+	status, ok := properties["status"].(map[string]any)
+	if !ok {
+		return provisioningState, nil
+	}
+
+	items, ok := status["outputResources"].([]any)
+	if !ok {
+		return provisioningState, nil
+	}
+
+	outputResources := []radappiov1alpha3.OutputResource{}
+	for _, item := range items {
+		values, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		outputResource := radappiov1alpha3.OutputResource{}
+		outputResource.ID, _ = values["id"].(string)
+		outputResource.LocalID, _ = values["localId"].(string)
+		if radiusManaged, ok := values["radiusManaged"].(bool); ok {
+			outputResource.RadiusManaged = &radiusManaged
+		}
+
+		outputResources = append(outputResources, outputResource)
+	}
+
+	return provisioningState, outputResources
+}
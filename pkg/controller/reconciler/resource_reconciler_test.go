@@ -0,0 +1,239 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+	"time"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	resourceTestWaitDuration            = time.Second * 10
+	resourceTestWaitInterval            = time.Second * 1
+	resourceTestControllerDelayInterval = time.Millisecond * 100
+)
+
+func SetupResourceTest(t *testing.T) (*mockRadiusClient, client.Client) {
+	SkipWithoutEnvironment(t)
+
+	// Shut down the manager when the test exits.
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	mgr, err := ctrl.NewManager(config, ctrl.Options{
+		Scheme: scheme,
+
+		// Suppress metrics in tests to avoid conflicts.
+		MetricsBindAddress: "0",
+	})
+	require.NoError(t, err)
+
+	radius := NewMockRadiusClient()
+	err = (&ResourceReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("resource-controller"),
+		Radius:        radius,
+		DelayInterval: resourceTestControllerDelayInterval,
+	}).SetupWithManager(mgr)
+	require.NoError(t, err)
+
+	go func() {
+		err := mgr.Start(ctx)
+		require.NoError(t, err)
+	}()
+
+	return radius, mgr.GetClient()
+}
+
+func Test_ResourceReconciler_Basic(t *testing.T) {
+	ctx := testcontext.New(t)
+	radius, client := SetupResourceTest(t)
+
+	name := types.NamespacedName{Namespace: "resource-basic", Name: "test-resource-basic"}
+	err := client.Create(ctx, &corev1.Namespace{ObjectMeta: ctrl.ObjectMeta{Name: name.Namespace}})
+	require.NoError(t, err)
+
+	resource := makeResource(name, "Applications.Core/extenders", `{"host": "example.com", "port": 443}`)
+	resource.Spec.SecretName = "resource-basic-secret"
+	err = client.Create(ctx, resource)
+	require.NoError(t, err)
+
+	// Resource will be waiting for environment to be created.
+	createEnvironment(radius, "default")
+
+	// Resource will be waiting for extender to complete provisioning.
+	status := waitForResourceState(t, client, name, radappiov1alpha3.PhraseUpdating)
+	require.Equal(t, "/planes/radius/local/resourcegroups/default-resource-basic", status.Scope)
+	require.Equal(t, "/planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/default", status.Environment)
+	require.Equal(t, "/planes/radius/local/resourcegroups/default-resource-basic/providers/Applications.Core/applications/resource-basic", status.Application)
+
+	radius.CompleteOperation(status.Operation.ResumeToken, nil)
+
+	// Resource will update after operation completes
+	status = waitForResourceState(t, client, name, radappiov1alpha3.PhraseReady)
+	require.Equal(t, "/planes/radius/local/resourcegroups/default-resource-basic/providers/Applications.Core/extenders/test-resource-basic", status.Resource)
+	require.Equal(t, map[string]string{"host": "example.com", "port": "443"}, status.ConnectionValues)
+
+	extender, err := radius.Resources(status.Scope, "Applications.Core/extenders").Get(ctx, name.Name)
+	require.NoError(t, err)
+	require.Equal(t, "example.com", extender.Properties["host"])
+	require.Equal(t, status.Application, extender.Properties["application"])
+
+	secret := corev1.Secret{}
+	err = client.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: "resource-basic-secret"}, &secret)
+	require.NoError(t, err)
+	require.Equal(t, "example.com", string(secret.Data["host"]))
+
+	// Changing the properties will update the resource.
+	current := &radappiov1alpha3.Resource{}
+	err = client.Get(ctx, name, current)
+	require.NoError(t, err)
+
+	current.Spec.Properties = &runtime.RawExtension{Raw: []byte(`{"host": "example.org", "port": 443}`)}
+	err = client.Update(ctx, current)
+	require.NoError(t, err)
+
+	status = waitForResourceState(t, client, name, radappiov1alpha3.PhraseUpdating)
+	radius.CompleteOperation(status.Operation.ResumeToken, nil)
+
+	status = waitForResourceState(t, client, name, radappiov1alpha3.PhraseReady)
+	require.Equal(t, "example.org", status.ConnectionValues["host"])
+
+	err = client.Delete(ctx, current)
+	require.NoError(t, err)
+
+	// Deletion of the resource is in progress.
+	status = waitForResourceState(t, client, name, radappiov1alpha3.PhraseDeleting)
+	radius.CompleteOperation(status.Operation.ResumeToken, nil)
+
+	// Now deleting of the resource object can complete.
+	waitForDeleted(t, client, name, &radappiov1alpha3.Resource{})
+}
+
+func Test_resourceProperties(t *testing.T) {
+	resource := makeResource(types.NamespacedName{Namespace: "default", Name: "test"}, "Applications.Datastores/redisCaches", `{"host": "example.com"}`)
+	resource.Spec.Recipe = &radappiov1alpha3.ResourceRecipe{
+		Name:       "small",
+		Parameters: &runtime.RawExtension{Raw: []byte(`{"size": "S"}`)},
+	}
+	resource.Status.Environment = "/planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/default"
+	resource.Status.Application = "/planes/radius/local/resourcegroups/default-default/providers/Applications.Core/applications/default"
+
+	properties, err := resourceProperties(resource)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"host":        "example.com",
+		"environment": resource.Status.Environment,
+		"application": resource.Status.Application,
+		"recipe": map[string]any{
+			"name":       "small",
+			"parameters": map[string]any{"size": "S"},
+		},
+	}
+	require.Equal(t, expected, properties)
+}
+
+func Test_resourceProperties_Invalid(t *testing.T) {
+	resource := makeResource(types.NamespacedName{Namespace: "default", Name: "test"}, "Applications.Core/extenders", `["not", "an", "object"]`)
+
+	_, err := resourceProperties(resource)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the properties of the resource must be an object")
+}
+
+func Test_resourceStatusFromProperties(t *testing.T) {
+	properties := map[string]any{
+		"provisioningState": "Succeeded",
+		"status": map[string]any{
+			"outputResources": []any{
+				map[string]any{"id": "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis", "localId": "Deployment", "radiusManaged": true},
+				map[string]any{"id": "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"},
+			},
+		},
+	}
+
+	provisioningState, outputResources := resourceStatusFromProperties(properties)
+	require.Equal(t, "Succeeded", provisioningState)
+
+	expected := []radappiov1alpha3.OutputResource{
+		{ID: "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis", LocalID: "Deployment", RadiusManaged: to.Ptr(true)},
+		{ID: "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"},
+	}
+	require.Equal(t, expected, outputResources)
+
+	provisioningState, outputResources = resourceStatusFromProperties(map[string]any{})
+	require.Empty(t, provisioningState)
+	require.Nil(t, outputResources)
+}
+
+func makeResource(name types.NamespacedName, resourceType string, properties string) *radappiov1alpha3.Resource {
+	return &radappiov1alpha3.Resource{
+		ObjectMeta: ctrl.ObjectMeta{
+			Namespace: name.Namespace,
+			Name:      name.Name,
+		},
+		Spec: radappiov1alpha3.ResourceSpec{
+			Type:       resourceType,
+			Properties: &runtime.RawExtension{Raw: []byte(properties)},
+		},
+	}
+}
+
+func waitForResourceState(t *testing.T, client client.Client, name types.NamespacedName, phrase radappiov1alpha3.RecipePhrase) *radappiov1alpha3.ResourceStatus {
+	ctx := testcontext.New(t)
+
+	logger := t
+	status := &radappiov1alpha3.ResourceStatus{}
+	require.EventuallyWithTf(t, func(t *assert.CollectT) {
+		logger.Logf("Fetching Resource: %+v", name)
+		current := &radappiov1alpha3.Resource{}
+		err := client.Get(ctx, name, current)
+		if apierrors.IsNotFound(err) {
+			assert.Fail(t, "resource not found")
+			return
+		}
+		require.NoError(t, err)
+
+		status = &current.Status
+		logger.Logf("Resource.Status: %+v", current.Status)
+		assert.Equal(t, status.ObservedGeneration, current.Generation, "Status is not updated")
+
+		if assert.Equal(t, phrase, current.Status.Phrase) {
+			if phrase == radappiov1alpha3.PhraseReady {
+				assert.Empty(t, current.Status.Operation)
+			} else {
+				assert.NotEmpty(t, current.Status.Operation)
+			}
+		}
+	}, resourceTestWaitDuration, resourceTestWaitInterval, "failed to enter %s state", phrase)
+
+	return status
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// statefulSetKind describes the StatefulSet workload kind.
+var statefulSetKind = workloadKind{
+	GroupVersionKind: appsv1.SchemeGroupVersion.WithKind("StatefulSet"),
+	Finalizer:        StatefulSetFinalizer,
+	NewObject: func() client.Object {
+		return &appsv1.StatefulSet{}
+	},
+	NewList: func() client.ObjectList {
+		return &appsv1.StatefulSetList{}
+	},
+	PodTemplate: func(workload client.Object) *corev1.PodTemplateSpec {
+		return &workload.(*appsv1.StatefulSet).Spec.Template
+	},
+}
+
+// StatefulSetReconciler reconciles a StatefulSet object.
+type StatefulSetReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Radius is the Radius client.
+	Radius RadiusClient
+
+	// DelayInterval is the amount of time to wait between operations.
+	DelayInterval time.Duration
+}
+
+// Reconcile is the main reconciliation loop for the StatefulSet resource.
+func (r *StatefulSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.workload().Reconcile(ctx, req)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *StatefulSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return r.workload().SetupWithManager(mgr)
+}
+
+func (r *StatefulSetReconciler) workload() *workloadReconciler {
+	return &workloadReconciler{
+		Client:        r.Client,
+		Scheme:        r.Scheme,
+		EventRecorder: r.EventRecorder,
+		Radius:        r.Radius,
+		DelayInterval: r.DelayInterval,
+		Kind:          statefulSetKind,
+	}
+}
@@ -41,9 +41,7 @@ func resolveDependencies(ctx context.Context, radius RadiusClient, scope string,
 
 	environmentID = *found
 
-	// NOTE: using resource groups with lowercase here is a workaround for a casing bug in `rad app graph`.
-	// When https://github.com/radius-project/radius/issues/6422 is fixed we can use the more correct casing.
-	resourceGroupID = fmt.Sprintf("/planes/radius/local/resourcegroups/%s-%s", environmentName, applicationName)
+	resourceGroupID = applicationResourceGroupID(environmentName, applicationName)
 	err = createResourceGroupIfNotExists(ctx, radius, resourceGroupID)
 	if err != nil {
 		return "", "", "", fmt.Errorf("failed to create resource group: %w", err)
@@ -58,6 +56,13 @@ func resolveDependencies(ctx context.Context, radius RadiusClient, scope string,
 	return resourceGroupID, environmentID, applicationID, nil
 }
 
+// applicationResourceGroupID returns the resource ID of the resource group used for the resources of an application.
+func applicationResourceGroupID(environmentName string, applicationName string) string {
+	// NOTE: using resource groups with lowercase here is a workaround for a casing bug in `rad app graph`.
+	// When https://github.com/radius-project/radius/issues/6422 is fixed we can use the more correct casing.
+	return fmt.Sprintf("/planes/radius/local/resourcegroups/%s-%s", environmentName, applicationName)
+}
+
 func findEnvironment(ctx context.Context, radius RadiusClient, scope string, environmentName string) (*string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx).WithValues("scope", scope)
 	logger.Info("Listing environments.")
@@ -130,6 +135,18 @@ func createApplicationIfNotExists(ctx context.Context, radius RadiusClient, envi
 		return nil
 	}
 
+	return createOrUpdateApplication(ctx, radius, environmentID, applicationID, id.Name())
+}
+
+func createOrUpdateApplication(ctx context.Context, radius RadiusClient, environmentID string, applicationID string, namespace string) error {
+	id, err := resources.Parse(applicationID)
+	if err != nil {
+		return err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("scope", id.RootScope(), "application", applicationID, "environment", environmentID)
+	logger.Info("Creating or updating application.")
+
 	app := corerpv20231001preview.ApplicationResource{
 		Location: to.Ptr(v1.LocationGlobal),
 		Name:     to.Ptr(id.Name()),
@@ -138,7 +155,7 @@ func createApplicationIfNotExists(ctx context.Context, radius RadiusClient, envi
 			Extensions: []corerpv20231001preview.ExtensionClassification{
 				&corerpv20231001preview.KubernetesNamespaceExtension{
 					Kind:      to.Ptr("kubernetesNamespace"),
-					Namespace: to.Ptr(id.Name()),
+					Namespace: to.Ptr(namespace),
 				},
 			},
 		},
@@ -151,6 +168,62 @@ func createApplicationIfNotExists(ctx context.Context, radius RadiusClient, envi
 	return nil
 }
 
+func deleteApplication(ctx context.Context, radius RadiusClient, applicationID string) error {
+	id, err := resources.Parse(applicationID)
+	if err != nil {
+		return err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("scope", id.RootScope(), "application", applicationID)
+	logger.Info("Deleting application.")
+
+	_, err = radius.Applications(id.RootScope()).Delete(ctx, id.Name(), nil)
+	if err != nil && !clients.Is404Error(err) {
+		return err
+	}
+
+	return nil
+}
+
+func createOrUpdateEnvironment(ctx context.Context, radius RadiusClient, environmentID string, properties *corerpv20231001preview.EnvironmentProperties) error {
+	id, err := resources.Parse(environmentID)
+	if err != nil {
+		return err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("scope", id.RootScope(), "environment", environmentID)
+	logger.Info("Creating or updating environment.")
+
+	env := corerpv20231001preview.EnvironmentResource{
+		Location:   to.Ptr(v1.LocationGlobal),
+		Name:       to.Ptr(id.Name()),
+		Properties: properties,
+	}
+	_, err = radius.Environments(id.RootScope()).CreateOrUpdate(ctx, id.Name(), env, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func deleteEnvironment(ctx context.Context, radius RadiusClient, environmentID string) error {
+	id, err := resources.Parse(environmentID)
+	if err != nil {
+		return err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("scope", id.RootScope(), "environment", environmentID)
+	logger.Info("Deleting environment.")
+
+	_, err = radius.Environments(id.RootScope()).Delete(ctx, id.Name(), nil)
+	if err != nil && !clients.Is404Error(err) {
+		return err
+	}
+
+	return nil
+}
+
 func deleteResource(ctx context.Context, radius RadiusClient, resourceID string) (Poller[generated.GenericResourcesClientDeleteResponse], error) {
 	id, err := resources.Parse(resourceID)
 	if err != nil {
@@ -0,0 +1,68 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+// resourceNameRegex matches the names that are valid for Radius environments and applications.
+var resourceNameRegex = regexp.MustCompile(`^[a-zA-Z]([-a-zA-Z0-9]{0,61}[a-zA-Z0-9])?$`)
+
+// validateResourceName validates the name of a Radius environment or application.
+func validateResourceName(path *field.Path, name string) *field.Error {
+	if !resourceNameRegex.MatchString(name) {
+		return field.Invalid(path, name, "must start with a letter, contain only letters, numbers and '-', end with a letter or number, and be at most 63 characters")
+	}
+
+	return nil
+}
+
+// validateEnvironmentExists checks that an environment with the given name exists, either as a Radius environment
+// or as an Environment resource. A missing environment results in a warning rather than an error because it may be
+// created later, for example when a directory of manifests is applied or synced by a GitOps tool.
+func validateEnvironmentExists(ctx context.Context, c client.Client, radius RadiusClient, name string) admission.Warnings {
+	environments := radappiov1alpha3.EnvironmentList{}
+	err := c.List(ctx, &environments)
+	if err != nil {
+		return admission.Warnings{fmt.Sprintf("unable to verify that environment %q exists: %v", name, err)}
+	}
+
+	for _, environment := range environments.Items {
+		if strings.EqualFold(environment.Name, name) {
+			return nil
+		}
+	}
+
+	found, err := findEnvironment(ctx, radius, "/planes/radius/local", name)
+	if err != nil {
+		return admission.Warnings{fmt.Sprintf("unable to verify that environment %q exists: %v", name, err)}
+	} else if found == nil {
+		return admission.Warnings{fmt.Sprintf("environment %q does not exist. Create it with 'rad env create %s' or a radapp.io Environment, resources will not be deployed until it exists", name, name)}
+	}
+
+	return nil
+}
@@ -0,0 +1,433 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// workloadController is implemented by the workload reconcilers.
+type workloadController interface {
+	reconcile.Reconciler
+	SetupWithManager(mgr ctrl.Manager) error
+}
+
+// workloadTestCase describes a workload kind exercised by the shared workload tests.
+type workloadTestCase struct {
+	kind          workloadKind
+	newReconciler func(mgr ctrl.Manager, radius RadiusClient) workloadController
+	makeWorkload  func(name types.NamespacedName) client.Object
+	resourceID    string
+}
+
+func workloadTestCases() []workloadTestCase {
+	return []workloadTestCase{
+		{
+			kind: statefulSetKind,
+			newReconciler: func(mgr ctrl.Manager, radius RadiusClient) workloadController {
+				return &StatefulSetReconciler{
+					Client:        mgr.GetClient(),
+					Scheme:        mgr.GetScheme(),
+					EventRecorder: mgr.GetEventRecorderFor("statefulset-controller"),
+					Radius:        radius,
+					DelayInterval: deploymentTestControllerDelayInterval,
+				}
+			},
+			makeWorkload: func(name types.NamespacedName) client.Object { return makeStatefulSet(name) },
+			resourceID:   "providers/apps/StatefulSet",
+		},
+		{
+			kind: daemonSetKind,
+			newReconciler: func(mgr ctrl.Manager, radius RadiusClient) workloadController {
+				return &DaemonSetReconciler{
+					Client:        mgr.GetClient(),
+					Scheme:        mgr.GetScheme(),
+					EventRecorder: mgr.GetEventRecorderFor("daemonset-controller"),
+					Radius:        radius,
+					DelayInterval: deploymentTestControllerDelayInterval,
+				}
+			},
+			makeWorkload: func(name types.NamespacedName) client.Object { return makeDaemonSet(name) },
+			resourceID:   "providers/apps/DaemonSet",
+		},
+		{
+			kind: cronJobKind,
+			newReconciler: func(mgr ctrl.Manager, radius RadiusClient) workloadController {
+				return &CronJobReconciler{
+					Client:        mgr.GetClient(),
+					Scheme:        mgr.GetScheme(),
+					EventRecorder: mgr.GetEventRecorderFor("cronjob-controller"),
+					Radius:        radius,
+					DelayInterval: deploymentTestControllerDelayInterval,
+				}
+			},
+			makeWorkload: func(name types.NamespacedName) client.Object { return makeCronJob(name) },
+			resourceID:   "providers/batch/CronJob",
+		},
+	}
+}
+
+func SetupWorkloadTest(t *testing.T, tc workloadTestCase) (*mockRadiusClient, client.Client) {
+	SkipWithoutEnvironment(t)
+
+	// Shut down the manager when the test exits.
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	mgr, err := ctrl.NewManager(config, ctrl.Options{
+		Scheme: scheme,
+
+		// Suppress metrics in tests to avoid conflicts.
+		MetricsBindAddress: "0",
+	})
+	require.NoError(t, err)
+
+	radius := NewMockRadiusClient()
+	err = tc.newReconciler(mgr, radius).SetupWithManager(mgr)
+	require.NoError(t, err)
+
+	go func() {
+		err := mgr.Start(ctx)
+		require.NoError(t, err)
+	}()
+
+	return radius, mgr.GetClient()
+}
+
+func Test_workloadKind_PodTemplate(t *testing.T) {
+	name := types.NamespacedName{Namespace: "default", Name: "test"}
+
+	deployment := makeDeployment(name)
+	require.Same(t, &deployment.Spec.Template, deploymentKind.PodTemplate(deployment))
+
+	statefulSet := makeStatefulSet(name)
+	require.Same(t, &statefulSet.Spec.Template, statefulSetKind.PodTemplate(statefulSet))
+
+	daemonSet := makeDaemonSet(name)
+	require.Same(t, &daemonSet.Spec.Template, daemonSetKind.PodTemplate(daemonSet))
+
+	cronJob := makeCronJob(name)
+	require.Same(t, &cronJob.Spec.JobTemplate.Spec.Template, cronJobKind.PodTemplate(cronJob))
+}
+
+func Test_workloadReconciler_workloadResourceID(t *testing.T) {
+	name := types.NamespacedName{Namespace: "test-namespace", Name: "test"}
+
+	tests := []struct {
+		kind     workloadKind
+		workload client.Object
+		expected string
+	}{
+		{deploymentKind, makeDeployment(name), "/planes/kubernetes/local/namespaces/test-namespace/providers/apps/Deployment/test"},
+		{statefulSetKind, makeStatefulSet(name), "/planes/kubernetes/local/namespaces/test-namespace/providers/apps/StatefulSet/test"},
+		{daemonSetKind, makeDaemonSet(name), "/planes/kubernetes/local/namespaces/test-namespace/providers/apps/DaemonSet/test"},
+		{cronJobKind, makeCronJob(name), "/planes/kubernetes/local/namespaces/test-namespace/providers/batch/CronJob/test"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind.GroupVersionKind.Kind, func(t *testing.T) {
+			r := &workloadReconciler{Kind: tt.kind}
+			require.Equal(t, tt.expected, r.workloadResourceID(tt.workload))
+		})
+	}
+}
+
+// Creates a workload with Radius enabled and a connection to a recipe.
+//
+// Then exercises the cleanup path by deleting the workload.
+func Test_WorkloadReconciler_Connections_ThenWorkloadDeleted(t *testing.T) {
+	for _, tc := range workloadTestCases() {
+		tc := tc
+		t.Run(tc.kind.GroupVersionKind.Kind, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			radius, client := SetupWorkloadTest(t, tc)
+
+			lower := "workload-" + strings.ToLower(tc.kind.GroupVersionKind.Kind)
+			name := types.NamespacedName{Namespace: lower + "-connections", Name: "test-" + lower + "-connections"}
+			secretName := types.NamespacedName{Namespace: name.Namespace, Name: fmt.Sprintf("%s-connections", name.Name)}
+			err := client.Create(ctx, &corev1.Namespace{ObjectMeta: ctrl.ObjectMeta{Name: name.Namespace}})
+			require.NoError(t, err)
+
+			workload := tc.makeWorkload(name)
+			workload.GetAnnotations()[AnnotationRadiusEnabled] = "true"
+			workload.GetAnnotations()[AnnotationRadiusConnectionPrefix+"a"] = "recipe-a"
+			err = client.Create(ctx, workload)
+			require.NoError(t, err)
+
+			// Workload will be waiting for environment to be created.
+			createEnvironment(radius, "default")
+
+			// Workload will be waiting for recipe resources to be created.
+			annotations := waitForWorkloadState(t, client, tc.kind, name, workloadPhraseWaiting)
+
+			recipeA := makeRecipe(types.NamespacedName{Namespace: name.Namespace, Name: "recipe-a"}, "Applications.Core/extenders")
+			err = client.Create(ctx, recipeA)
+			require.NoError(t, err)
+
+			extenderA := generated.GenericResource{
+				Properties: map[string]any{
+					"a-value": "a",
+				},
+			}
+			poller, err := radius.Resources(annotations.Status.Scope, "Applications.Core/extenders").BeginCreateOrUpdate(ctx, recipeA.Name, extenderA, nil)
+			require.NoError(t, err)
+			token, err := poller.ResumeToken()
+			require.NoError(t, err)
+			radius.CompleteOperation(token, nil)
+
+			// Mark the recipe as provisioned.
+			recipeA.Status = radappiov1alpha3.RecipeStatus{
+				Resource: annotations.Status.Scope + "/providers/Applications.Core/extenders/" + recipeA.Name,
+			}
+			err = client.Status().Update(ctx, recipeA)
+			require.NoError(t, err)
+
+			// Workload will be waiting for container to complete deployment.
+			annotations = waitForWorkloadState(t, client, tc.kind, name, workloadPhraseUpdating)
+			radius.CompleteOperation(annotations.Status.Operation.ResumeToken, nil)
+
+			// Workload will update after operation completes.
+			annotations = waitForWorkloadState(t, client, tc.kind, name, workloadPhraseReady)
+
+			container, err := radius.Containers(annotations.Status.Scope).Get(ctx, name.Name, nil)
+			require.NoError(t, err)
+			require.Equal(t, "manual", string(*container.Properties.ResourceProvisioning))
+			require.Equal(t, []*v20231001preview.ResourceReference{{ID: to.Ptr("/planes/kubernetes/local/namespaces/" + name.Namespace + "/" + tc.resourceID + "/" + name.Name)}}, container.Properties.Resources)
+
+			// Secret should have been created.
+			secret := corev1.Secret{}
+			err = client.Get(ctx, secretName, &secret)
+			require.NoError(t, err)
+			require.Equal(t, map[string][]byte{"CONNECTION_A_A-VALUE": []byte("a")}, secret.Data)
+
+			// Secret should be mapped as env-vars in the pod template.
+			err = client.Get(ctx, name, workload)
+			require.NoError(t, err)
+
+			template := tc.kind.PodTemplate(workload)
+			expectedEnvFrom := []corev1.EnvFromSource{
+				{
+					SecretRef: &corev1.SecretEnvSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secretName.Name},
+						Optional:             to.Ptr(false),
+					},
+				},
+			}
+			require.Equal(t, expectedEnvFrom, template.Spec.Containers[0].EnvFrom)
+			require.NotEmpty(t, template.Annotations[kubernetes.AnnotationSecretHash])
+
+			err = client.Delete(ctx, workload)
+			require.NoError(t, err)
+
+			// Deletion of the container is in progress.
+			annotations = waitForWorkloadState(t, client, tc.kind, name, workloadPhraseDeleting)
+			radius.CompleteOperation(annotations.Status.Operation.ResumeToken, nil)
+
+			// Now deleting of the workload object can complete.
+			waitForWorkloadDeleted(t, client, tc.kind, name)
+		})
+	}
+}
+
+// Creates a workload with Radius enabled.
+//
+// Then exercises the cleanup path by disabling Radius.
+func Test_WorkloadReconciler_RadiusEnabled_ThenRadiusDisabled(t *testing.T) {
+	for _, tc := range workloadTestCases() {
+		tc := tc
+		t.Run(tc.kind.GroupVersionKind.Kind, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			radius, client := SetupWorkloadTest(t, tc)
+
+			lower := "workload-" + strings.ToLower(tc.kind.GroupVersionKind.Kind)
+			name := types.NamespacedName{Namespace: lower + "-enabled-disabled", Name: "test-" + lower + "-enabled-disabled"}
+			err := client.Create(ctx, &corev1.Namespace{ObjectMeta: ctrl.ObjectMeta{Name: name.Namespace}})
+			require.NoError(t, err)
+
+			workload := tc.makeWorkload(name)
+			workload.GetAnnotations()[AnnotationRadiusEnabled] = "true"
+			err = client.Create(ctx, workload)
+			require.NoError(t, err)
+
+			// Workload will be waiting for environment to be created.
+			createEnvironment(radius, "default")
+
+			// Workload will be waiting for container to complete deployment.
+			annotations := waitForWorkloadState(t, client, tc.kind, name, workloadPhraseUpdating)
+			radius.CompleteOperation(annotations.Status.Operation.ResumeToken, nil)
+
+			// Workload will update after operation completes.
+			_ = waitForWorkloadState(t, client, tc.kind, name, workloadPhraseReady)
+
+			// Trigger cleanup by disabling Radius.
+			err = client.Get(ctx, name, workload)
+			require.NoError(t, err)
+			workload.GetAnnotations()[AnnotationRadiusEnabled] = "false"
+			err = client.Update(ctx, workload)
+			require.NoError(t, err)
+
+			// Deletion of the container is in progress.
+			annotations = waitForWorkloadState(t, client, tc.kind, name, workloadPhraseDeleting)
+			radius.CompleteOperation(annotations.Status.Operation.ResumeToken, nil)
+
+			ctx = testcontext.New(t)
+			require.EventuallyWithTf(t, func(t *assert.CollectT) {
+				current := tc.kind.NewObject()
+				err := client.Get(ctx, name, current)
+				require.NoError(t, err)
+
+				assert.NotContains(t, current.GetAnnotations(), AnnotationRadiusStatus)
+				assert.NotContains(t, current.GetAnnotations(), AnnotationRadiusConfigurationHash)
+			}, deploymentTestWaitDuration, deploymentTestWaitInterval, "waiting for Radius to be removed")
+		})
+	}
+}
+
+func makePodTemplate(name types.NamespacedName) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"app": name.Name,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  name.Name,
+					Image: "nginx:latest",
+				},
+			},
+		},
+	}
+}
+
+func makeStatefulSet(name types.NamespacedName) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name.Name,
+			Namespace:   name.Namespace,
+			Annotations: map[string]string{},
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name.Name,
+				},
+			},
+			ServiceName: name.Name,
+			Template:    makePodTemplate(name),
+		},
+	}
+}
+
+func makeDaemonSet(name types.NamespacedName) *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name.Name,
+			Namespace:   name.Namespace,
+			Annotations: map[string]string{},
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name.Name,
+				},
+			},
+			Template: makePodTemplate(name),
+		},
+	}
+}
+
+func makeCronJob(name types.NamespacedName) *batchv1.CronJob {
+	template := makePodTemplate(name)
+	template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name.Name,
+			Namespace:   name.Namespace,
+			Annotations: map[string]string{},
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "*/5 * * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: template,
+				},
+			},
+		},
+	}
+}
+
+func waitForWorkloadState(t *testing.T, client client.Client, kind workloadKind, name types.NamespacedName, phrase workloadPhrase) *workloadAnnotations {
+	ctx := testcontext.New(t)
+
+	logger := t
+	var annotations *workloadAnnotations
+	require.EventuallyWithTf(t, func(t *assert.CollectT) {
+		logger.Logf("Fetching %s: %+v", kind.GroupVersionKind.Kind, name)
+		current := kind.NewObject()
+		err := client.Get(ctx, name, current)
+		require.NoError(t, err)
+
+		annotations, err = readAnnotations(current)
+		require.NoError(t, err)
+		assert.NotNil(t, annotations)
+		logger.Logf("Annotations.Status: %+v", annotations.Status)
+
+		if assert.NotNil(t, annotations.Status) && assert.Equal(t, phrase, annotations.Status.Phrase) {
+			if phrase == workloadPhraseUpdating || phrase == workloadPhraseDeleting {
+				assert.NotEmpty(t, annotations.Status.Operation)
+			} else {
+				assert.Empty(t, annotations.Status.Operation)
+			}
+		}
+	}, deploymentTestWaitDuration, deploymentTestWaitInterval, "waiting for state to be %s", phrase)
+
+	return annotations
+}
+
+func waitForWorkloadDeleted(t *testing.T, client client.Client, kind workloadKind, name types.NamespacedName) {
+	ctx := testcontext.New(t)
+
+	logger := t
+	require.Eventuallyf(t, func() bool {
+		logger.Logf("Fetching %s: %+v", kind.GroupVersionKind.Kind, name)
+		err := client.Get(ctx, name, kind.NewObject())
+		return apierrors.IsNotFound(err)
+	}, deploymentTestWaitDuration, deploymentTestWaitInterval, "waiting for %s to be deleted", kind.GroupVersionKind.Kind)
+}
@@ -18,19 +18,18 @@ package reconciler
 
 import (
 	"github.com/radius-project/radius/pkg/to"
-	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 )
 
-// addSecretReference adds a secret reference to the deployment. Returns true if the secret was added, and false if it already exists.
+// addSecretReference adds a secret reference to the pod template of a workload. Returns true if the secret was added, and false if it already exists.
 //
 // This function is idempotent and will not add the secret reference if it already exists.
-func addSecretReference(deployment *appsv1.Deployment, secretName string) bool {
+func addSecretReference(template *corev1.PodTemplateSpec, secretName string) bool {
 	// For now we're just interested in the first container.
-	container := &deployment.Spec.Template.Spec.Containers[0]
+	container := &template.Spec.Containers[0]
 
 	index := -1
-	for i := range deployment.Spec.Template.Spec.Containers[0].EnvFrom {
+	for i := range template.Spec.Containers[0].EnvFrom {
 		if container.EnvFrom[i].SecretRef != nil && container.EnvFrom[i].SecretRef.Name == secretName {
 			index = i
 			break
@@ -52,14 +51,14 @@ func addSecretReference(deployment *appsv1.Deployment, secretName string) bool {
 	return true
 }
 
-// removeSecretReference removes the secret reference from the deployment. Returns true if the secret was removed, and false if it was not found.
-func removeSecretReference(deployment *appsv1.Deployment, secretName string) bool {
+// removeSecretReference removes the secret reference from the pod template of a workload. Returns true if the secret was removed, and false if it was not found.
+func removeSecretReference(template *corev1.PodTemplateSpec, secretName string) bool {
 
 	// For now we're just interested in the first container.
-	container := &deployment.Spec.Template.Spec.Containers[0]
+	container := &template.Spec.Containers[0]
 
 	index := -1
-	for i := range deployment.Spec.Template.Spec.Containers[0].EnvFrom {
+	for i := range template.Spec.Containers[0].EnvFrom {
 		if container.EnvFrom[i].SecretRef != nil && container.EnvFrom[i].SecretRef.Name == secretName {
 			index = i
 			break
@@ -70,7 +69,7 @@ func removeSecretReference(deployment *appsv1.Deployment, secretName string) boo
 		return false
 	}
 
-	// Remove the secret from the deployment.
+	// Remove the secret from the pod template.
 	container.EnvFrom = append(container.EnvFrom[0:index], container.EnvFrom[index+1:]...)
 	return true
 }
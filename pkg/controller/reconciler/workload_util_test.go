@@ -41,7 +41,7 @@ func Test_addSecretReference_AlreadyPresent(t *testing.T) {
 	deployment := makeDeployment(types.NamespacedName{})
 	deployment.Spec.Template.Spec.Containers[0].EnvFrom = expected
 
-	result := addSecretReference(deployment, "secret")
+	result := addSecretReference(&deployment.Spec.Template, "secret")
 	require.False(t, result)
 	require.Equal(t, expected, deployment.Spec.Template.Spec.Containers[0].EnvFrom)
 }
@@ -70,7 +70,7 @@ func Test_addSecretReference_ReferenceAdded(t *testing.T) {
 		},
 	}
 
-	result := addSecretReference(deployment, "secret")
+	result := addSecretReference(&deployment.Spec.Template, "secret")
 	require.True(t, result)
 	require.Equal(t, expected, deployment.Spec.Template.Spec.Containers[0].EnvFrom)
 }
@@ -88,7 +88,7 @@ func Test_removeSecretReference_AlreadyRemoved(t *testing.T) {
 	deployment := makeDeployment(types.NamespacedName{})
 	deployment.Spec.Template.Spec.Containers[0].EnvFrom = expected
 
-	result := removeSecretReference(deployment, "secret")
+	result := removeSecretReference(&deployment.Spec.Template, "secret")
 	require.False(t, result)
 	require.Equal(t, expected, deployment.Spec.Template.Spec.Containers[0].EnvFrom)
 }
@@ -116,7 +116,7 @@ func Test_removeSecretReference_ReferenceRemoved(t *testing.T) {
 		},
 	}
 
-	result := removeSecretReference(deployment, "secret")
+	result := removeSecretReference(&deployment.Spec.Template, "secret")
 	require.True(t, result)
 	require.Equal(t, expected, deployment.Spec.Template.Spec.Containers[0].EnvFrom)
 }
@@ -0,0 +1,273 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/kubernetes"
+)
+
+// connectionNameRegex matches the valid names of a connection. The name of the connection is used in
+// the names of environment variables, so it is more restrictive than the annotation syntax.
+var connectionNameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([-_a-zA-Z0-9]*[a-zA-Z0-9])?$`)
+
+// WorkloadWebhook validates the radapp.io annotations of workloads (Deployments, StatefulSets, DaemonSets and CronJobs)
+// when they are created or updated.
+type WorkloadWebhook struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Radius is the Radius client.
+	Radius RadiusClient
+}
+
+// SetupWebhookWithManager sets up the webhook with the Manager for each of the supported workload kinds.
+func (w *WorkloadWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	for _, kind := range []workloadKind{deploymentKind, statefulSetKind, daemonSetKind, cronJobKind} {
+		err := ctrl.NewWebhookManagedBy(mgr).
+			For(kind.NewObject()).
+			WithValidator(&workloadValidator{webhook: w, kind: kind}).
+			Complete()
+		if err != nil {
+			return fmt.Errorf("failed to setup webhook for %s: %w", kind.GroupVersionKind.Kind, err)
+		}
+	}
+
+	return nil
+}
+
+var _ admission.CustomValidator = (*workloadValidator)(nil)
+
+// workloadValidator validates a single kind of workload.
+type workloadValidator struct {
+	webhook *WorkloadWebhook
+	kind    workloadKind
+}
+
+// ValidateCreate validates a workload that is being created.
+func (v *workloadValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	workload, ok := obj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("expected a %s but got a %T", v.kind.GroupVersionKind.Kind, obj)
+	}
+
+	return v.webhook.validate(ctx, v.kind, workload, nil)
+}
+
+// ValidateUpdate validates a workload that is being updated.
+func (v *workloadValidator) ValidateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	workload, ok := newObj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("expected a %s but got a %T", v.kind.GroupVersionKind.Kind, newObj)
+	}
+
+	old, ok := oldObj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("expected a %s but got a %T", v.kind.GroupVersionKind.Kind, oldObj)
+	}
+
+	// The controller updates the finalizers and annotations of the workload during deletion. There's no
+	// value in validating the workload at that point.
+	if workload.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+
+	return v.webhook.validate(ctx, v.kind, workload, old)
+}
+
+// ValidateDelete validates a workload that is being deleted. Deletion is always allowed.
+func (v *workloadValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate validates the radapp.io annotations of a workload. The old workload is nil when the workload is being created.
+func (w *WorkloadWebhook) validate(ctx context.Context, kind workloadKind, workload client.Object, old client.Object) (admission.Warnings, error) {
+	// Workloads rendered by Radius use radapp.io annotations for other purposes.
+	if _, ok := workload.GetLabels()[kubernetes.LabelRadiusResource]; ok {
+		return nil, nil
+	}
+
+	warnings, errs := validateWorkloadAnnotations(workload.GetAnnotations())
+	if len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(kind.GroupVersionKind.GroupKind(), workload.GetName(), errs)
+	}
+
+	annotations, err := readAnnotations(workload)
+	if err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "annotations").Key(AnnotationRadiusStatus), workload.GetAnnotations()[AnnotationRadiusStatus], err.Error()))
+		return warnings, apierrors.NewInvalid(kind.GroupVersionKind.GroupKind(), workload.GetName(), errs)
+	} else if annotations == nil || annotations.Configuration == nil {
+		// Radius is not enabled for this workload.
+		return warnings, nil
+	}
+
+	// The controller updates the workload every time it is reconciled. Only check the dependencies of the workload
+	// when the user changes the configuration, this requires calls to Radius.
+	if old != nil {
+		previous, err := readAnnotations(old)
+		if err == nil && previous != nil && previous.Configuration != nil {
+			previousHash, _ := previous.Configuration.computeHash()
+			currentHash, _ := annotations.Configuration.computeHash()
+			if previousHash == currentHash {
+				return warnings, nil
+			}
+		}
+	}
+
+	environmentName := "default"
+	if annotations.Configuration.Environment != "" {
+		environmentName = annotations.Configuration.Environment
+	}
+
+	warnings = append(warnings, validateEnvironmentExists(ctx, w.Client, w.Radius, environmentName)...)
+
+	names := []string{}
+	for name := range annotations.Configuration.Connections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		source := annotations.Configuration.Connections[name]
+		recipe := radappiov1alpha3.Recipe{}
+		err := w.Client.Get(ctx, client.ObjectKey{Namespace: workload.GetNamespace(), Name: source}, &recipe)
+		if apierrors.IsNotFound(err) {
+			warnings = append(warnings, fmt.Sprintf("connection %q refers to Recipe %q which does not exist in namespace %q. The workload will not be updated until it is created", name, source, workload.GetNamespace()))
+		} else if err != nil {
+			warnings = append(warnings, fmt.Sprintf("unable to verify that Recipe %q exists: %v", source, err))
+		}
+	}
+
+	return warnings, nil
+}
+
+// validateWorkloadAnnotations validates the syntax of the radapp.io annotations of a workload.
+func validateWorkloadAnnotations(annotations map[string]string) (admission.Warnings, field.ErrorList) {
+	var warnings admission.Warnings
+	errs := field.ErrorList{}
+	path := field.NewPath("metadata", "annotations")
+
+	keys := []string{}
+	for key := range annotations {
+		if strings.HasPrefix(key, "radapp.io/") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := annotations[key]
+		switch {
+		case key == AnnotationRadiusEnabled:
+			if !strings.EqualFold(value, "true") && !strings.EqualFold(value, "false") {
+				errs = append(errs, field.Invalid(path.Key(key), value, "must be 'true' or 'false'"))
+			}
+
+		case key == AnnotationRadiusEnvironment || key == AnnotationRadiusApplication:
+			if err := validateResourceName(path.Key(key), value); err != nil {
+				errs = append(errs, err)
+			}
+
+		case strings.HasPrefix(key, AnnotationRadiusConnectionPrefix):
+			name := strings.TrimPrefix(key, AnnotationRadiusConnectionPrefix)
+			if !connectionNameRegex.MatchString(name) {
+				errs = append(errs, field.Invalid(path.Key(key), value, fmt.Sprintf("the connection name %q must contain only letters, numbers, '-' and '_', and must start and end with a letter or number", name)))
+			}
+
+			for _, message := range validation.IsDNS1123Subdomain(value) {
+				errs = append(errs, field.Invalid(path.Key(key), value, "must be the name of a Recipe: "+message))
+			}
+
+		case key == AnnotationRadiusStatus || key == AnnotationRadiusConfigurationHash:
+			// These annotations are managed by the controller.
+
+		default:
+			// Other radapp.io annotations are used by Radius for other purposes. Only reject annotations that
+			// look like a typo of one of ours.
+			if suggestion := suggestWorkloadAnnotation(key); suggestion != "" {
+				errs = append(errs, field.Invalid(path.Key(key), value, fmt.Sprintf("unknown annotation, did you mean '%s'?", suggestion)))
+			} else {
+				warnings = append(warnings, fmt.Sprintf("unknown annotation %q. Supported annotations are '%s', '%s', '%s' and '%s<name>'", key, AnnotationRadiusEnabled, AnnotationRadiusEnvironment, AnnotationRadiusApplication, AnnotationRadiusConnectionPrefix))
+			}
+		}
+	}
+
+	return warnings, errs
+}
+
+// suggestWorkloadAnnotation returns the annotation that the user probably meant when the given key is a typo of
+// one of the supported annotations, or an empty string.
+func suggestWorkloadAnnotation(key string) string {
+	// eg: radapp.io/conection-redis -> "conection" and "redis"
+	word, rest, hasRest := strings.Cut(strings.TrimPrefix(key, "radapp.io/"), "-")
+
+	candidates := []string{AnnotationRadiusEnabled, AnnotationRadiusEnvironment, AnnotationRadiusApplication, strings.TrimSuffix(AnnotationRadiusConnectionPrefix, "-")}
+	for _, candidate := range candidates {
+		if editDistance(strings.ToLower(word), strings.TrimPrefix(candidate, "radapp.io/")) > 2 {
+			continue
+		}
+
+		if candidate+"-" != AnnotationRadiusConnectionPrefix {
+			return candidate
+		} else if hasRest && rest != "" {
+			return AnnotationRadiusConnectionPrefix + rest
+		}
+
+		return AnnotationRadiusConnectionPrefix + "<name>"
+	}
+
+	return ""
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
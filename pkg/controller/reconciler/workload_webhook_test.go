@@ -0,0 +1,213 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+func Test_WorkloadWebhook_Valid(t *testing.T) {
+	ctx := testcontext.New(t)
+	radius := NewMockRadiusClient()
+	createEnvironment(radius, "default")
+
+	name := types.NamespacedName{Namespace: "default", Name: "test"}
+	recipe := makeRecipe(types.NamespacedName{Namespace: "default", Name: "redis"}, "Applications.Datastores/redisCaches")
+	webhook := &WorkloadWebhook{Client: newWebhookTestClient(t, recipe), Radius: radius}
+
+	for _, kind := range []workloadKind{deploymentKind, statefulSetKind, daemonSetKind, cronJobKind} {
+		t.Run(kind.GroupVersionKind.Kind, func(t *testing.T) {
+			validator := &workloadValidator{webhook: webhook, kind: kind}
+
+			workload := kind.NewObject()
+			workload.SetNamespace(name.Namespace)
+			workload.SetName(name.Name)
+			workload.SetAnnotations(map[string]string{
+				AnnotationRadiusEnabled:                     "true",
+				AnnotationRadiusApplication:                 "my-app",
+				AnnotationRadiusConnectionPrefix + "cache":  "redis",
+				AnnotationRadiusConnectionPrefix + "cache2": "redis",
+			})
+
+			warnings, err := validator.ValidateCreate(ctx, workload)
+			require.NoError(t, err)
+			require.Empty(t, warnings)
+		})
+	}
+}
+
+func Test_WorkloadWebhook_NotEnabled(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	// No environment exists, but that doesn't matter when Radius is not enabled.
+	webhook := &WorkloadWebhook{Client: newWebhookTestClient(t), Radius: NewMockRadiusClient()}
+	validator := &workloadValidator{webhook: webhook, kind: deploymentKind}
+
+	deployment := makeDeployment(types.NamespacedName{Namespace: "default", Name: "test"})
+	deployment.Annotations = map[string]string{AnnotationRadiusEnabled: "false"}
+
+	warnings, err := validator.ValidateCreate(ctx, deployment)
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+}
+
+func Test_WorkloadWebhook_RenderedByRadius(t *testing.T) {
+	ctx := testcontext.New(t)
+	webhook := &WorkloadWebhook{Client: newWebhookTestClient(t), Radius: NewMockRadiusClient()}
+	validator := &workloadValidator{webhook: webhook, kind: deploymentKind}
+
+	deployment := makeDeployment(types.NamespacedName{Namespace: "default", Name: "test"})
+	deployment.Labels = map[string]string{kubernetes.LabelRadiusResource: "test"}
+	deployment.Annotations = map[string]string{"radapp.io/enabld": "true"}
+
+	warnings, err := validator.ValidateCreate(ctx, deployment)
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+}
+
+func Test_WorkloadWebhook_Warnings(t *testing.T) {
+	ctx := testcontext.New(t)
+	radius := NewMockRadiusClient()
+	createEnvironment(radius, "default")
+
+	webhook := &WorkloadWebhook{Client: newWebhookTestClient(t), Radius: radius}
+	validator := &workloadValidator{webhook: webhook, kind: deploymentKind}
+
+	deployment := makeDeployment(types.NamespacedName{Namespace: "default", Name: "test"})
+	deployment.Annotations = map[string]string{
+		AnnotationRadiusEnabled:                    "true",
+		AnnotationRadiusConnectionPrefix + "cache": "redis",
+		"radapp.io/owner":                          "team-a",
+	}
+
+	// The Recipe doesn't exist yet, this is allowed because it may be created later.
+	warnings, err := validator.ValidateCreate(ctx, deployment)
+	require.NoError(t, err)
+	require.Len(t, warnings, 2)
+	require.Contains(t, warnings[0], `unknown annotation "radapp.io/owner"`)
+	require.Contains(t, warnings[1], `connection "cache" refers to Recipe "redis" which does not exist in namespace "default"`)
+
+	// Updates that don't change the configuration don't check the connections.
+	warnings, err = validator.ValidateUpdate(ctx, deployment, deployment)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+}
+
+func Test_WorkloadWebhook_EnvironmentNotFound(t *testing.T) {
+	ctx := testcontext.New(t)
+	webhook := &WorkloadWebhook{Client: newWebhookTestClient(t), Radius: NewMockRadiusClient()}
+	validator := &workloadValidator{webhook: webhook, kind: deploymentKind}
+
+	deployment := makeDeployment(types.NamespacedName{Namespace: "default", Name: "test"})
+	deployment.Annotations = map[string]string{
+		AnnotationRadiusEnabled:     "true",
+		AnnotationRadiusEnvironment: "production",
+	}
+
+	// The environment doesn't exist yet, this is allowed because it may be created later.
+	warnings, err := validator.ValidateCreate(ctx, deployment)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0], `environment "production" does not exist`)
+}
+
+func Test_WorkloadWebhook_Invalid(t *testing.T) {
+	ctx := testcontext.New(t)
+	radius := NewMockRadiusClient()
+	createEnvironment(radius, "default")
+
+	webhook := &WorkloadWebhook{Client: newWebhookTestClient(t), Radius: radius}
+	validator := &workloadValidator{webhook: webhook, kind: deploymentKind}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    string
+	}{
+		{
+			name:        "invalid enabled",
+			annotations: map[string]string{AnnotationRadiusEnabled: "yes"},
+			expected:    `metadata.annotations[radapp.io/enabled]: Invalid value: "yes": must be 'true' or 'false'`,
+		},
+		{
+			name:        "typo in enabled",
+			annotations: map[string]string{"radapp.io/enabeld": "true"},
+			expected:    `metadata.annotations[radapp.io/enabeld]: Invalid value: "true": unknown annotation, did you mean 'radapp.io/enabled'?`,
+		},
+		{
+			name:        "typo in connection",
+			annotations: map[string]string{AnnotationRadiusEnabled: "true", "radapp.io/conection-redis": "redis"},
+			expected:    `metadata.annotations[radapp.io/conection-redis]: Invalid value: "redis": unknown annotation, did you mean 'radapp.io/connection-redis'?`,
+		},
+		{
+			name:        "invalid connection name",
+			annotations: map[string]string{AnnotationRadiusEnabled: "true", AnnotationRadiusConnectionPrefix + "cache.": "redis"},
+			expected:    `the connection name "cache." must contain only letters, numbers, '-' and '_'`,
+		},
+		{
+			name:        "invalid connection target",
+			annotations: map[string]string{AnnotationRadiusEnabled: "true", AnnotationRadiusConnectionPrefix + "cache": "Redis_Cache"},
+			expected:    `metadata.annotations[radapp.io/connection-cache]: Invalid value: "Redis_Cache": must be the name of a Recipe`,
+		},
+		{
+			name:        "invalid environment name",
+			annotations: map[string]string{AnnotationRadiusEnabled: "true", AnnotationRadiusEnvironment: "-prod"},
+			expected:    `metadata.annotations[radapp.io/environment]: Invalid value: "-prod": must start with a letter`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deployment := makeDeployment(types.NamespacedName{Namespace: "default", Name: "test"})
+			deployment.Annotations = tc.annotations
+
+			_, err := validator.ValidateCreate(ctx, deployment)
+			require.Error(t, err)
+			require.True(t, apierrors.IsInvalid(err))
+			require.Contains(t, err.Error(), tc.expected)
+		})
+	}
+}
+
+func Test_suggestWorkloadAnnotation(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{key: "radapp.io/enable", expected: AnnotationRadiusEnabled},
+		{key: "radapp.io/Enabled", expected: AnnotationRadiusEnabled},
+		{key: "radapp.io/enviroment", expected: AnnotationRadiusEnvironment},
+		{key: "radapp.io/applicaton", expected: AnnotationRadiusApplication},
+		{key: "radapp.io/connections-redis", expected: "radapp.io/connection-redis"},
+		{key: "radapp.io/connection", expected: "radapp.io/connection-<name>"},
+		{key: "radapp.io/identity-type", expected: ""},
+		{key: "radapp.io/secret-hash", expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.key, func(t *testing.T) {
+			require.Equal(t, tc.expected, suggestWorkloadAnnotation(tc.key))
+		})
+	}
+}
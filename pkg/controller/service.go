@@ -83,6 +83,24 @@ func (s *Service) Run(ctx context.Context) error {
 	}
 
 	logger.Info("Registering controllers.")
+	err = (&reconciler.EnvironmentReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("environment-controller"),
+		Radius:        reconciler.NewClient(s.Options.UCPConnection),
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "Environment", err)
+	}
+	err = (&reconciler.ApplicationReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("application-controller"),
+		Radius:        reconciler.NewClient(s.Options.UCPConnection),
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "Application", err)
+	}
 	err = (&reconciler.RecipeReconciler{
 		Client:        mgr.GetClient(),
 		Scheme:        mgr.GetScheme(),
@@ -92,6 +110,15 @@ func (s *Service) Run(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to setup %s controller: %w", "Recipe", err)
 	}
+	err = (&reconciler.ResourceReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("resource-controller"),
+		Radius:        reconciler.NewClient(s.Options.UCPConnection),
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "Resource", err)
+	}
 	err = (&reconciler.DeploymentReconciler{
 		Client:        mgr.GetClient(),
 		Scheme:        mgr.GetScheme(),
@@ -101,11 +128,52 @@ func (s *Service) Run(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to setup %s controller: %w", "Deployment", err)
 	}
+	err = (&reconciler.StatefulSetReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("radius-statefulset-controller"),
+		Radius:        reconciler.NewClient(s.Options.UCPConnection),
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "StatefulSet", err)
+	}
+	err = (&reconciler.DaemonSetReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("radius-daemonset-controller"),
+		Radius:        reconciler.NewClient(s.Options.UCPConnection),
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "DaemonSet", err)
+	}
+	err = (&reconciler.CronJobReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("radius-cronjob-controller"),
+		Radius:        reconciler.NewClient(s.Options.UCPConnection),
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "CronJob", err)
+	}
 
 	if s.TLSCertDir == "" {
 		logger.Info("Webhooks will be skipped. TLS certificates not present.")
 	} else {
 		logger.Info("Registering webhooks.")
+		err = (&reconciler.RecipeWebhook{
+			Client: mgr.GetClient(),
+			Radius: reconciler.NewClient(s.Options.UCPConnection),
+		}).SetupWebhookWithManager(mgr)
+		if err != nil {
+			return fmt.Errorf("failed to setup %s webhook: %w", "Recipe", err)
+		}
+		err = (&reconciler.WorkloadWebhook{
+			Client: mgr.GetClient(),
+			Radius: reconciler.NewClient(s.Options.UCPConnection),
+		}).SetupWebhookWithManager(mgr)
+		if err != nil {
+			return fmt.Errorf("failed to setup %s webhook: %w", "Workload", err)
+		}
 	}
 
 	logger.Info("Registering health checks.")
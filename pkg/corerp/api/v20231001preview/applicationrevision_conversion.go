@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// ConvertTo converts from the versioned ApplicationRevision to version-agnostic datamodel.
+func (src *ApplicationRevision) ConvertTo() (v1.DataModelInterface, error) {
+	reason, err := toApplicationRevisionReasonDataModel(src.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := &datamodel.ApplicationRevision{
+		Revision:          to.Int32(src.Revision),
+		Reason:            reason,
+		RolledBackFrom:    to.Int32(src.RolledBackFrom),
+		ProvisioningState: toProvisioningStateDataModel(src.ProvisioningState),
+		Resources:         []datamodel.ApplicationRevisionResource{},
+	}
+	if src.CreatedAt != nil {
+		converted.CreatedAt = *src.CreatedAt
+	}
+
+	for _, r := range src.Resources {
+		if r == nil {
+			continue
+		}
+		resource := datamodel.ApplicationRevisionResource{
+			ID:         to.String(r.ID),
+			Type:       to.String(r.Type),
+			Name:       to.String(r.Name),
+			Properties: r.Properties,
+		}
+		if r.Recipe != nil {
+			resource.Recipe = &rpv1.RecipeStatus{
+				TemplateKind:    to.String(r.Recipe.TemplateKind),
+				TemplatePath:    to.String(r.Recipe.TemplatePath),
+				TemplateVersion: to.String(r.Recipe.TemplateVersion),
+			}
+		}
+		converted.Resources = append(converted.Resources, resource)
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned ApplicationRevision.
+func (dst *ApplicationRevision) ConvertFrom(src v1.DataModelInterface) error {
+	revision, ok := src.(*datamodel.ApplicationRevision)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.Revision = to.Ptr(revision.Revision)
+	dst.CreatedAt = to.Ptr(revision.CreatedAt.UTC().Truncate(time.Second))
+	dst.Reason = to.Ptr(ApplicationRevisionReason(revision.Reason))
+	dst.ProvisioningState = fromProvisioningStateDataModel(revision.ProvisioningState)
+	if revision.RolledBackFrom != 0 {
+		dst.RolledBackFrom = to.Ptr(revision.RolledBackFrom)
+	}
+
+	dst.Resources = []*ApplicationRevisionResource{}
+	for _, r := range revision.Resources {
+		dst.Resources = append(dst.Resources, &ApplicationRevisionResource{
+			ID:         to.Ptr(r.ID),
+			Type:       to.Ptr(r.Type),
+			Name:       to.Ptr(r.Name),
+			Properties: r.Properties,
+			Recipe:     fromRecipeStatus(r.Recipe),
+		})
+	}
+
+	return nil
+}
+
+func toApplicationRevisionReasonDataModel(reason *ApplicationRevisionReason) (datamodel.ApplicationRevisionReason, error) {
+	if reason == nil {
+		return datamodel.ApplicationRevisionReasonDeployment, nil
+	}
+
+	switch *reason {
+	case ApplicationRevisionReasonDeployment:
+		return datamodel.ApplicationRevisionReasonDeployment, nil
+	case ApplicationRevisionReasonRollback:
+		return datamodel.ApplicationRevisionReasonRollback, nil
+	default:
+		return "", &v1.ErrModelConversion{PropertyName: "$.reason", ValidValue: fmt.Sprintf("one of %s", PossibleApplicationRevisionReasonValues())}
+	}
+}
+
+// ConvertTo converts from the versioned ApplicationRollbackRequest to version-agnostic datamodel.
+func (src *ApplicationRollbackRequest) ConvertTo() (v1.DataModelInterface, error) {
+	return &datamodel.ApplicationRollbackRequest{
+		Revision:        to.Int32(src.Revision),
+		DeleteResources: to.Bool(src.DeleteResources),
+	}, nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationRevisionConvertVersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("applicationrevision.json")
+	r := &ApplicationRevision{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	revision := dm.(*datamodel.ApplicationRevision)
+	require.Equal(t, int32(3), revision.Revision)
+	require.Equal(t, time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC), revision.CreatedAt)
+	require.Equal(t, datamodel.ApplicationRevisionReasonRollback, revision.Reason)
+	require.Equal(t, int32(1), revision.RolledBackFrom)
+	require.Equal(t, v1.ProvisioningStateSucceeded, revision.ProvisioningState)
+	require.Len(t, revision.Resources, 2)
+	require.Equal(t, "Applications.Core/applications", revision.Resources[0].Type)
+	require.Nil(t, revision.Resources[0].Recipe)
+	require.Equal(t, "redis", revision.Resources[1].Name)
+	require.Equal(t, &rpv1.RecipeStatus{TemplateKind: "bicep", TemplatePath: "ghcr.io/radius-project/recipes/redis:1.1", TemplateVersion: "1.1"}, revision.Resources[1].Recipe)
+}
+
+func TestApplicationRevisionConvertDataModelToVersioned(t *testing.T) {
+	revision := &datamodel.ApplicationRevision{
+		ID:                "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app0/revisions/2",
+		Revision:          2,
+		CreatedAt:         time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+		Reason:            datamodel.ApplicationRevisionReasonDeployment,
+		ProvisioningState: v1.ProvisioningStateSucceeded,
+		Resources: []datamodel.ApplicationRevisionResource{
+			{
+				ID:         "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend",
+				Type:       "Applications.Core/containers",
+				Name:       "frontend",
+				Properties: map[string]any{"container": map[string]any{"image": "nginx"}},
+			},
+		},
+	}
+
+	versioned := &ApplicationRevision{}
+	err := versioned.ConvertFrom(revision)
+	require.NoError(t, err)
+
+	require.Equal(t, int32(2), *versioned.Revision)
+	require.Equal(t, ApplicationRevisionReasonDeployment, *versioned.Reason)
+	require.Nil(t, versioned.RolledBackFrom)
+	require.Equal(t, ProvisioningStateSucceeded, *versioned.ProvisioningState)
+	require.Len(t, versioned.Resources, 1)
+	require.Equal(t, "frontend", to.String(versioned.Resources[0].Name))
+	require.Nil(t, versioned.Resources[0].Recipe)
+}
+
+func TestApplicationRevisionConvertFromValidation(t *testing.T) {
+	versioned := &ApplicationRevision{}
+	err := versioned.ConvertFrom(&datamodel.Application{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
+
+func TestApplicationRevisionConvertInvalidReason(t *testing.T) {
+	r := &ApplicationRevision{Reason: to.Ptr(ApplicationRevisionReason("Unknown"))}
+	_, err := r.ConvertTo()
+	require.Error(t, err)
+}
@@ -29,72 +29,6 @@ import (
 func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
 
-	connections := make(map[string]datamodel.ConnectionProperties)
-	for key, val := range src.Properties.Connections {
-		if val != nil {
-			roles := []string{}
-			var kind datamodel.IAMKind
-
-			if val.Iam != nil {
-				for _, r := range val.Iam.Roles {
-					roles = append(roles, to.String(r))
-				}
-				kind = toKindDataModel(val.Iam.Kind)
-			}
-
-			var disableDefaultEnvVars bool
-			if val.DisableDefaultEnvVars != nil {
-				disableDefaultEnvVars = to.Bool(val.DisableDefaultEnvVars)
-			}
-
-			connections[key] = datamodel.ConnectionProperties{
-				Source:                to.String(val.Source),
-				DisableDefaultEnvVars: &disableDefaultEnvVars,
-				IAM: datamodel.IAMProperties{
-					Kind:  kind,
-					Roles: roles,
-				},
-			}
-		}
-	}
-
-	var livenessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.LivenessProbe != nil {
-		livenessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.LivenessProbe)
-	}
-
-	var readinessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.ReadinessProbe != nil {
-		readinessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.ReadinessProbe)
-	}
-
-	ports := make(map[string]datamodel.ContainerPort)
-	for key, val := range src.Properties.Container.Ports {
-		port := datamodel.ContainerPort{
-			ContainerPort: to.Int32(val.ContainerPort),
-			Protocol:      toPortProtocolDataModel(val.Protocol),
-			Provides:      to.String(val.Provides),
-		}
-
-		if val.Port != nil {
-			port.Port = to.Int32(val.Port)
-		}
-
-		if val.Scheme != nil {
-			port.Scheme = to.String(val.Scheme)
-		}
-
-		ports[key] = port
-	}
-
-	var volumes map[string]datamodel.VolumeProperties
-	if src.Properties.Container.Volumes != nil {
-		volumes = make(map[string]datamodel.VolumeProperties)
-		for key, val := range src.Properties.Container.Volumes {
-			volumes[key] = toVolumePropertiesDataModel(val)
-		}
-	}
-
 	var extensions []datamodel.Extension
 	if src.Properties.Extensions != nil {
 		for _, e := range src.Properties.Extensions {
@@ -120,19 +54,8 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 			BasicResourceProperties: rpv1.BasicResourceProperties{
 				Application: to.String(src.Properties.Application),
 			},
-			Connections: connections,
-			Container: datamodel.Container{
-				Image:           to.String(src.Properties.Container.Image),
-				ImagePullPolicy: toImagePullPolicyDataModel(src.Properties.Container.ImagePullPolicy),
-				Env:             to.StringMap(src.Properties.Container.Env),
-				LivenessProbe:   livenessProbe,
-				Ports:           ports,
-				ReadinessProbe:  readinessProbe,
-				Volumes:         volumes,
-				Command:         stringSlice(src.Properties.Container.Command),
-				Args:            stringSlice(src.Properties.Container.Args),
-				WorkingDir:      to.String(src.Properties.Container.WorkingDir),
-			},
+			Connections:          toConnectionsDataModel(src.Properties.Connections),
+			Container:            toContainerDataModel(src.Properties.Container),
 			Extensions:           extensions,
 			Runtimes:             toRuntimePropertiesDataModel(src.Properties.Runtimes),
 			ResourceProvisioning: toContainerResourceProvisioningDataModel(src.Properties.ResourceProvisioning),
@@ -159,67 +82,6 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 		return v1.ErrInvalidModelConversion
 	}
 
-	connections := make(map[string]*ConnectionProperties)
-	for key, val := range c.Properties.Connections {
-		roles := []*string{}
-		var kind *IAMKind
-
-		for _, r := range val.IAM.Roles {
-			roles = append(roles, to.Ptr(r))
-		}
-
-		kind = fromKindDataModel(val.IAM.Kind)
-
-		var disableDefaultEnvVars bool
-		if val.DisableDefaultEnvVars != nil {
-			disableDefaultEnvVars = to.Bool(val.DisableDefaultEnvVars)
-		}
-
-		connections[key] = &ConnectionProperties{
-			Source:                to.Ptr(val.Source),
-			DisableDefaultEnvVars: &disableDefaultEnvVars,
-			Iam: &IamProperties{
-				Kind:  kind,
-				Roles: roles,
-			},
-		}
-	}
-
-	var livenessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.LivenessProbe.IsEmpty() {
-		livenessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.LivenessProbe)
-	}
-
-	var readinessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.ReadinessProbe.IsEmpty() {
-		readinessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.ReadinessProbe)
-	}
-
-	ports := make(map[string]*ContainerPortProperties)
-	for key, val := range c.Properties.Container.Ports {
-		ports[key] = &ContainerPortProperties{
-			ContainerPort: to.Ptr(val.ContainerPort),
-			Protocol:      fromPortProtocolDataModel(val.Protocol),
-			Provides:      to.Ptr(val.Provides),
-		}
-
-		if val.Port != 0 {
-			ports[key].Port = to.Ptr(val.Port)
-		}
-
-		if val.Scheme != "" {
-			ports[key].Scheme = to.Ptr(val.Scheme)
-		}
-	}
-
-	var volumes map[string]VolumeClassification
-	if c.Properties.Container.Volumes != nil {
-		volumes = make(map[string]VolumeClassification)
-		for key, val := range c.Properties.Container.Volumes {
-			volumes[key] = fromVolumePropertiesDataModel(val)
-		}
-	}
-
 	var extensions []ExtensionClassification
 	if c.Properties.Extensions != nil {
 		for _, e := range c.Properties.Extensions {
@@ -246,21 +108,10 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResourcesDataModel(c.Properties.Status.OutputResources),
 		},
-		ProvisioningState: fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
-		Application:       to.Ptr(c.Properties.Application),
-		Connections:       connections,
-		Container: &Container{
-			Image:           to.Ptr(c.Properties.Container.Image),
-			ImagePullPolicy: fromImagePullPolicyDataModel(c.Properties.Container.ImagePullPolicy),
-			Env:             *to.StringMapPtr(c.Properties.Container.Env),
-			LivenessProbe:   livenessProbe,
-			Ports:           ports,
-			ReadinessProbe:  readinessProbe,
-			Volumes:         volumes,
-			Command:         to.SliceOfPtrs(c.Properties.Container.Command...),
-			Args:            to.SliceOfPtrs(c.Properties.Container.Args...),
-			WorkingDir:      to.Ptr(c.Properties.Container.WorkingDir),
-		},
+		ProvisioningState:    fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
+		Application:          to.Ptr(c.Properties.Application),
+		Connections:          fromConnectionsDataModel(c.Properties.Connections),
+		Container:            fromContainerDataModel(c.Properties.Container),
 		Extensions:           extensions,
 		Identity:             identity,
 		Runtimes:             fromRuntimePropertiesDataModel(c.Properties.Runtimes),
@@ -747,3 +598,172 @@ func fromExtensionClassificationFields(e datamodel.Extension) (map[string]string
 
 	return ann, lbl
 }
+
+func toConnectionsDataModel(connections map[string]*ConnectionProperties) map[string]datamodel.ConnectionProperties {
+	converted := make(map[string]datamodel.ConnectionProperties)
+	for key, val := range connections {
+		if val != nil {
+			roles := []string{}
+			var kind datamodel.IAMKind
+
+			if val.Iam != nil {
+				for _, r := range val.Iam.Roles {
+					roles = append(roles, to.String(r))
+				}
+				kind = toKindDataModel(val.Iam.Kind)
+			}
+
+			var disableDefaultEnvVars bool
+			if val.DisableDefaultEnvVars != nil {
+				disableDefaultEnvVars = to.Bool(val.DisableDefaultEnvVars)
+			}
+
+			converted[key] = datamodel.ConnectionProperties{
+				Source:                to.String(val.Source),
+				DisableDefaultEnvVars: &disableDefaultEnvVars,
+				IAM: datamodel.IAMProperties{
+					Kind:  kind,
+					Roles: roles,
+				},
+			}
+		}
+	}
+
+	return converted
+}
+
+func fromConnectionsDataModel(connections map[string]datamodel.ConnectionProperties) map[string]*ConnectionProperties {
+	converted := make(map[string]*ConnectionProperties)
+	for key, val := range connections {
+		roles := []*string{}
+		var kind *IAMKind
+
+		for _, r := range val.IAM.Roles {
+			roles = append(roles, to.Ptr(r))
+		}
+
+		kind = fromKindDataModel(val.IAM.Kind)
+
+		var disableDefaultEnvVars bool
+		if val.DisableDefaultEnvVars != nil {
+			disableDefaultEnvVars = to.Bool(val.DisableDefaultEnvVars)
+		}
+
+		converted[key] = &ConnectionProperties{
+			Source:                to.Ptr(val.Source),
+			DisableDefaultEnvVars: &disableDefaultEnvVars,
+			Iam: &IamProperties{
+				Kind:  kind,
+				Roles: roles,
+			},
+		}
+	}
+
+	return converted
+}
+
+func toContainerDataModel(container *Container) datamodel.Container {
+	if container == nil {
+		return datamodel.Container{}
+	}
+
+	var livenessProbe datamodel.HealthProbeProperties
+	if container.LivenessProbe != nil {
+		livenessProbe = toHealthProbePropertiesDataModel(container.LivenessProbe)
+	}
+
+	var readinessProbe datamodel.HealthProbeProperties
+	if container.ReadinessProbe != nil {
+		readinessProbe = toHealthProbePropertiesDataModel(container.ReadinessProbe)
+	}
+
+	ports := make(map[string]datamodel.ContainerPort)
+	for key, val := range container.Ports {
+		port := datamodel.ContainerPort{
+			ContainerPort: to.Int32(val.ContainerPort),
+			Protocol:      toPortProtocolDataModel(val.Protocol),
+			Provides:      to.String(val.Provides),
+		}
+
+		if val.Port != nil {
+			port.Port = to.Int32(val.Port)
+		}
+
+		if val.Scheme != nil {
+			port.Scheme = to.String(val.Scheme)
+		}
+
+		ports[key] = port
+	}
+
+	var volumes map[string]datamodel.VolumeProperties
+	if container.Volumes != nil {
+		volumes = make(map[string]datamodel.VolumeProperties)
+		for key, val := range container.Volumes {
+			volumes[key] = toVolumePropertiesDataModel(val)
+		}
+	}
+
+	return datamodel.Container{
+		Image:           to.String(container.Image),
+		ImagePullPolicy: toImagePullPolicyDataModel(container.ImagePullPolicy),
+		Env:             to.StringMap(container.Env),
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         stringSlice(container.Command),
+		Args:            stringSlice(container.Args),
+		WorkingDir:      to.String(container.WorkingDir),
+	}
+}
+
+func fromContainerDataModel(container datamodel.Container) *Container {
+	var livenessProbe HealthProbePropertiesClassification
+	if !container.LivenessProbe.IsEmpty() {
+		livenessProbe = fromHealthProbePropertiesDataModel(container.LivenessProbe)
+	}
+
+	var readinessProbe HealthProbePropertiesClassification
+	if !container.ReadinessProbe.IsEmpty() {
+		readinessProbe = fromHealthProbePropertiesDataModel(container.ReadinessProbe)
+	}
+
+	ports := make(map[string]*ContainerPortProperties)
+	for key, val := range container.Ports {
+		ports[key] = &ContainerPortProperties{
+			ContainerPort: to.Ptr(val.ContainerPort),
+			Protocol:      fromPortProtocolDataModel(val.Protocol),
+			Provides:      to.Ptr(val.Provides),
+		}
+
+		if val.Port != 0 {
+			ports[key].Port = to.Ptr(val.Port)
+		}
+
+		if val.Scheme != "" {
+			ports[key].Scheme = to.Ptr(val.Scheme)
+		}
+	}
+
+	var volumes map[string]VolumeClassification
+	if container.Volumes != nil {
+		volumes = make(map[string]VolumeClassification)
+		for key, val := range container.Volumes {
+			volumes[key] = fromVolumePropertiesDataModel(val)
+		}
+	}
+
+	return &Container{
+		Image:           to.Ptr(container.Image),
+		ImagePullPolicy: fromImagePullPolicyDataModel(container.ImagePullPolicy),
+		Env:             *to.StringMapPtr(container.Env),
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         to.SliceOfPtrs(container.Command...),
+		Args:            to.SliceOfPtrs(container.Args...),
+		WorkingDir:      to.Ptr(container.WorkingDir),
+	}
+}
@@ -107,6 +107,14 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.Simulated = true
 	}
 
+	if src.Properties.Gateway != nil {
+		gateway, err := toEnvironmentGatewayDataModel(src.Properties.Gateway)
+		if err != nil {
+			return nil, err
+		}
+		converted.Properties.Gateway = gateway
+	}
+
 	var extensions []datamodel.Extension
 	if src.Properties.Extensions != nil {
 		for _, e := range src.Properties.Extensions {
@@ -169,6 +177,13 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.Simulated = to.Ptr(env.Properties.Simulated)
 	}
 
+	if env.Properties.Gateway != nil {
+		dst.Properties.Gateway = &EnvironmentGateway{
+			Kind:             to.Ptr(EnvironmentGatewayKind(env.Properties.Gateway.Kind)),
+			GatewayClassName: toStringPtr(env.Properties.Gateway.GatewayClassName),
+		}
+	}
+
 	var extensions []ExtensionClassification
 	if env.Properties.Extensions != nil {
 		for _, e := range env.Properties.Extensions {
@@ -264,6 +279,30 @@ func fromEnvironmentComputeKind(kind rpv1.EnvironmentComputeKind) *string {
 	return &k
 }
 
+func toEnvironmentGatewayDataModel(gateway *EnvironmentGateway) (*datamodel.EnvironmentGateway, error) {
+	converted := &datamodel.EnvironmentGateway{
+		Kind:             datamodel.EnvironmentGatewayKindContour,
+		GatewayClassName: to.String(gateway.GatewayClassName),
+	}
+
+	if gateway.Kind != nil {
+		switch *gateway.Kind {
+		case EnvironmentGatewayKindContour:
+			converted.Kind = datamodel.EnvironmentGatewayKindContour
+		case EnvironmentGatewayKindGatewayAPI:
+			converted.Kind = datamodel.EnvironmentGatewayKindGatewayAPI
+		default:
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.gateway.kind", ValidValue: "[contour gatewayAPI]"}
+		}
+	}
+
+	if converted.Kind == datamodel.EnvironmentGatewayKindGatewayAPI && converted.GatewayClassName == "" {
+		return nil, v1.NewClientErrInvalidRequest("gatewayClassName must be specified when the gateway kind is 'gatewayAPI'")
+	}
+
+	return converted, nil
+}
+
 // fromExtensionClassificationEnvDataModel: Converts from base datamodel to versioned datamodel
 func fromEnvExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassification {
 	switch e.Kind {
@@ -39,18 +39,23 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 			tls.CertificateFrom = to.String(src.Properties.TLS.CertificateFrom)
 			tls.MinimumProtocolVersion = toTLSMinVersionDataModel(src.Properties.TLS.MinimumProtocolVersion)
 		}
+
+		if src.Properties.TLS.CertificateIssuer != nil {
+			issuer, err := toCertificateIssuerDataModel(src.Properties.TLS.CertificateIssuer)
+			if err != nil {
+				return nil, err
+			}
+
+			tls.CertificateIssuer = issuer
+			tls.MinimumProtocolVersion = toTLSMinVersionDataModel(src.Properties.TLS.MinimumProtocolVersion)
+		}
 	}
 
 	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
 	routes := []datamodel.GatewayRoute{}
 	if src.Properties.Routes != nil {
 		for _, r := range src.Properties.Routes {
-			s := datamodel.GatewayRoute{
-				Destination:   to.String(r.Destination),
-				Path:          to.String(r.Path),
-				ReplacePrefix: to.String(r.ReplacePrefix),
-			}
-			routes = append(routes, s)
+			routes = append(routes, toGatewayRouteDataModel(r))
 		}
 	}
 
@@ -101,6 +106,7 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 	if g.Properties.TLS != nil {
 		tls = &GatewayTLS{
 			CertificateFrom:        to.Ptr(g.Properties.TLS.CertificateFrom),
+			CertificateIssuer:      fromCertificateIssuerDataModel(g.Properties.TLS.CertificateIssuer),
 			MinimumProtocolVersion: fromTLSMinVersionDataModel(g.Properties.TLS.MinimumProtocolVersion),
 			SSLPassthrough:         to.Ptr(g.Properties.TLS.SSLPassthrough),
 		}
@@ -109,12 +115,7 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 	routes := []*GatewayRoute{}
 	if g.Properties.Routes != nil {
 		for _, r := range g.Properties.Routes {
-			s := &GatewayRoute{
-				Destination:   to.Ptr(r.Destination),
-				Path:          to.Ptr(r.Path),
-				ReplacePrefix: to.Ptr(r.ReplacePrefix),
-			}
-			routes = append(routes, s)
+			routes = append(routes, fromGatewayRouteDataModel(r))
 		}
 	}
 
@@ -147,6 +148,215 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 	return nil
 }
 
+func toGatewayRouteDataModel(r *GatewayRoute) datamodel.GatewayRoute {
+	route := datamodel.GatewayRoute{
+		Destination:     to.String(r.Destination),
+		Path:            to.String(r.Path),
+		ReplacePrefix:   to.String(r.ReplacePrefix),
+		Methods:         stringSlice(r.Methods),
+		RequestHeaders:  toGatewayRouteHeaderPolicyDataModel(r.RequestHeaders),
+		ResponseHeaders: toGatewayRouteHeaderPolicyDataModel(r.ResponseHeaders),
+	}
+
+	for _, d := range r.Destinations {
+		route.Destinations = append(route.Destinations, datamodel.GatewayRouteDestination{
+			Destination: to.String(d.Destination),
+			Weight:      to.Int32(d.Weight),
+		})
+	}
+
+	for _, h := range r.Headers {
+		route.Headers = append(route.Headers, datamodel.GatewayRouteHeaderMatch{
+			Name:     to.String(h.Name),
+			Exact:    to.String(h.Exact),
+			Contains: to.String(h.Contains),
+			Present:  to.Bool(h.Present),
+		})
+	}
+
+	for _, q := range r.QueryParameters {
+		route.QueryParameters = append(route.QueryParameters, datamodel.GatewayRouteQueryParameterMatch{
+			Name:    to.String(q.Name),
+			Exact:   to.String(q.Exact),
+			Prefix:  to.String(q.Prefix),
+			Present: to.Bool(q.Present),
+		})
+	}
+
+	if r.TimeoutPolicy != nil {
+		route.TimeoutPolicy = &datamodel.GatewayRouteTimeoutPolicy{
+			Response: to.String(r.TimeoutPolicy.Response),
+			Idle:     to.String(r.TimeoutPolicy.Idle),
+		}
+	}
+
+	if r.RetryPolicy != nil {
+		route.RetryPolicy = &datamodel.GatewayRouteRetryPolicy{
+			Count:         to.Int32(r.RetryPolicy.Count),
+			PerTryTimeout: to.String(r.RetryPolicy.PerTryTimeout),
+			RetryOn:       stringSlice(r.RetryPolicy.RetryOn),
+		}
+	}
+
+	return route
+}
+
+func toGatewayRouteHeaderPolicyDataModel(p *GatewayRouteHeaderPolicy) *datamodel.GatewayRouteHeaderPolicy {
+	if p == nil {
+		return nil
+	}
+
+	policy := &datamodel.GatewayRouteHeaderPolicy{
+		Remove: stringSlice(p.Remove),
+	}
+	if p.Set != nil {
+		policy.Set = to.StringMap(p.Set)
+	}
+
+	return policy
+}
+
+func fromGatewayRouteDataModel(r datamodel.GatewayRoute) *GatewayRoute {
+	route := &GatewayRoute{
+		Destination:     to.Ptr(r.Destination),
+		Path:            to.Ptr(r.Path),
+		ReplacePrefix:   to.Ptr(r.ReplacePrefix),
+		RequestHeaders:  fromGatewayRouteHeaderPolicyDataModel(r.RequestHeaders),
+		ResponseHeaders: fromGatewayRouteHeaderPolicyDataModel(r.ResponseHeaders),
+	}
+
+	if len(r.Methods) > 0 {
+		route.Methods = to.SliceOfPtrs(r.Methods...)
+	}
+
+	for _, d := range r.Destinations {
+		route.Destinations = append(route.Destinations, &GatewayRouteDestination{
+			Destination: to.Ptr(d.Destination),
+			Weight:      to.Ptr(d.Weight),
+		})
+	}
+
+	for _, h := range r.Headers {
+		match := &GatewayRouteHeaderMatch{
+			Name: to.Ptr(h.Name),
+		}
+		if h.Exact != "" {
+			match.Exact = to.Ptr(h.Exact)
+		}
+		if h.Contains != "" {
+			match.Contains = to.Ptr(h.Contains)
+		}
+		if h.Present {
+			match.Present = to.Ptr(h.Present)
+		}
+		route.Headers = append(route.Headers, match)
+	}
+
+	for _, q := range r.QueryParameters {
+		match := &GatewayRouteQueryParameterMatch{
+			Name: to.Ptr(q.Name),
+		}
+		if q.Exact != "" {
+			match.Exact = to.Ptr(q.Exact)
+		}
+		if q.Prefix != "" {
+			match.Prefix = to.Ptr(q.Prefix)
+		}
+		if q.Present {
+			match.Present = to.Ptr(q.Present)
+		}
+		route.QueryParameters = append(route.QueryParameters, match)
+	}
+
+	if r.TimeoutPolicy != nil {
+		route.TimeoutPolicy = &GatewayRouteTimeoutPolicy{
+			Response: to.Ptr(r.TimeoutPolicy.Response),
+			Idle:     to.Ptr(r.TimeoutPolicy.Idle),
+		}
+	}
+
+	if r.RetryPolicy != nil {
+		route.RetryPolicy = &GatewayRouteRetryPolicy{
+			Count:         to.Ptr(r.RetryPolicy.Count),
+			PerTryTimeout: to.Ptr(r.RetryPolicy.PerTryTimeout),
+		}
+		if len(r.RetryPolicy.RetryOn) > 0 {
+			route.RetryPolicy.RetryOn = to.SliceOfPtrs(r.RetryPolicy.RetryOn...)
+		}
+	}
+
+	return route
+}
+
+func fromGatewayRouteHeaderPolicyDataModel(p *datamodel.GatewayRouteHeaderPolicy) *GatewayRouteHeaderPolicy {
+	if p == nil {
+		return nil
+	}
+
+	policy := &GatewayRouteHeaderPolicy{}
+	if len(p.Set) > 0 {
+		policy.Set = *to.StringMapPtr(p.Set)
+	}
+	if len(p.Remove) > 0 {
+		policy.Remove = to.SliceOfPtrs(p.Remove...)
+	}
+
+	return policy
+}
+
+func toCertificateIssuerDataModel(issuer *GatewayCertificateIssuer) (*datamodel.GatewayCertificateIssuer, error) {
+	converted := &datamodel.GatewayCertificateIssuer{
+		ACMEDirectoryURL: to.String(issuer.AcmeDirectoryURL),
+		Email:            to.String(issuer.Email),
+		RenewBefore:      to.String(issuer.RenewBefore),
+	}
+
+	var kind GatewayCertificateIssuerKind
+	if issuer.Kind != nil {
+		kind = *issuer.Kind
+	}
+
+	switch kind {
+	case GatewayCertificateIssuerKindAcme:
+		converted.Kind = datamodel.GatewayCertificateIssuerKindACME
+	case GatewayCertificateIssuerKindSelfSigned:
+		converted.Kind = datamodel.GatewayCertificateIssuerKindSelfSigned
+	default:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.tls.certificateIssuer.kind", ValidValue: "[acme selfSigned]"}
+	}
+
+	return converted, nil
+}
+
+func fromCertificateIssuerDataModel(issuer *datamodel.GatewayCertificateIssuer) *GatewayCertificateIssuer {
+	if issuer == nil {
+		return nil
+	}
+
+	var kind GatewayCertificateIssuerKind
+	switch issuer.Kind {
+	case datamodel.GatewayCertificateIssuerKindACME:
+		kind = GatewayCertificateIssuerKindAcme
+	case datamodel.GatewayCertificateIssuerKindSelfSigned:
+		kind = GatewayCertificateIssuerKindSelfSigned
+	}
+
+	converted := &GatewayCertificateIssuer{
+		Kind: &kind,
+	}
+	if issuer.ACMEDirectoryURL != "" {
+		converted.AcmeDirectoryURL = to.Ptr(issuer.ACMEDirectoryURL)
+	}
+	if issuer.Email != "" {
+		converted.Email = to.Ptr(issuer.Email)
+	}
+	if issuer.RenewBefore != "" {
+		converted.RenewBefore = to.Ptr(issuer.RenewBefore)
+	}
+
+	return converted
+}
+
 func toTLSMinVersionDataModel(tlsMinVersion *TLSMinVersion) datamodel.MinimumTLSProtocolVersion {
 	if tlsMinVersion == nil {
 		return datamodel.DefaultTLSMinVersion
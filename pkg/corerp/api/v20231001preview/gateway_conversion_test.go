@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
 
@@ -250,6 +251,144 @@ func TestGatewayTLSTerminationConvertDataModelToVersioned_NoMinProtocolVersion(t
 	require.Equal(t, TLSMinVersionTls12, *versioned.Properties.TLS.MinimumProtocolVersion)
 }
 
+func TestGatewayCertificateIssuerConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresource-with-certificateissuer.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, "", gw.Properties.TLS.CertificateFrom)
+	require.Equal(t, datamodel.TLSMinVersion13, gw.Properties.TLS.MinimumProtocolVersion)
+	expected := &datamodel.GatewayCertificateIssuer{
+		Kind:             datamodel.GatewayCertificateIssuerKindACME,
+		ACMEDirectoryURL: "https://acme.example.com/directory",
+		Email:            "admin@example.com",
+		RenewBefore:      "240h",
+	}
+	require.Equal(t, expected, gw.Properties.TLS.CertificateIssuer)
+}
+
+func TestGatewayCertificateIssuerConvertVersionedToDataModel_InvalidKind(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresource-invalid-certificateissuer.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	_, err = r.ConvertTo()
+
+	// assert
+	require.Equal(t, &v1.ErrModelConversion{PropertyName: "$.properties.tls.certificateIssuer.kind", ValidValue: "[acme selfSigned]"}, err)
+}
+
+func TestGatewayCertificateIssuerConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-certificateissuer.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &GatewayCertificateIssuer{Kind: to.Ptr(GatewayCertificateIssuerKindSelfSigned)}, versioned.Properties.TLS.CertificateIssuer)
+	require.Equal(t, TLSMinVersionTls12, *versioned.Properties.TLS.MinimumProtocolVersion)
+}
+
+func TestGatewayRoutingRulesConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresource-with-routingrules.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	expected := datamodel.GatewayRoute{
+		Path: "/api",
+		Destinations: []datamodel.GatewayRouteDestination{
+			{Destination: "stable", Weight: 90},
+			{Destination: "canary", Weight: 10},
+		},
+		Headers: []datamodel.GatewayRouteHeaderMatch{
+			{Name: "x-canary", Exact: "true"},
+			{Name: "user-agent", Contains: "Mobile"},
+			{Name: "authorization", Present: true},
+		},
+		QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{
+			{Name: "version", Prefix: "v2"},
+		},
+		Methods: []string{"GET", "POST"},
+		TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{
+			Response: "30s",
+			Idle:     "1m",
+		},
+		RetryPolicy: &datamodel.GatewayRouteRetryPolicy{
+			Count:         3,
+			PerTryTimeout: "2s",
+			RetryOn:       []string{"5xx", "reset"},
+		},
+		RequestHeaders: &datamodel.GatewayRouteHeaderPolicy{
+			Set:    map[string]string{"x-forwarded-by": "radius"},
+			Remove: []string{"x-debug"},
+		},
+		ResponseHeaders: &datamodel.GatewayRouteHeaderPolicy{
+			Remove: []string{"server"},
+		},
+	}
+	require.Equal(t, []datamodel.GatewayRoute{expected}, gw.Properties.Routes)
+}
+
+func TestGatewayRoutingRulesConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-routingrules.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	route := versioned.Properties.Routes[0]
+	require.Equal(t, "/api", *route.Path)
+	require.Len(t, route.Destinations, 2)
+	require.Equal(t, "canary", *route.Destinations[1].Destination)
+	require.Equal(t, int32(10), *route.Destinations[1].Weight)
+	require.Equal(t, "true", *route.Headers[0].Exact)
+	require.Nil(t, route.Headers[0].Contains)
+	require.Equal(t, "Mobile", *route.Headers[1].Contains)
+	require.True(t, *route.Headers[2].Present)
+	require.Equal(t, "v2", *route.QueryParameters[0].Prefix)
+	require.Equal(t, []*string{to.Ptr("GET"), to.Ptr("POST")}, route.Methods)
+	require.Equal(t, "30s", *route.TimeoutPolicy.Response)
+	require.Equal(t, "1m", *route.TimeoutPolicy.Idle)
+	require.Equal(t, int32(3), *route.RetryPolicy.Count)
+	require.Equal(t, "2s", *route.RetryPolicy.PerTryTimeout)
+	require.Equal(t, []*string{to.Ptr("5xx"), to.Ptr("reset")}, route.RetryPolicy.RetryOn)
+	require.Equal(t, map[string]*string{"x-forwarded-by": to.Ptr("radius")}, route.RequestHeaders.Set)
+	require.Equal(t, []*string{to.Ptr("x-debug")}, route.RequestHeaders.Remove)
+	require.Nil(t, route.ResponseHeaders.Set)
+	require.Equal(t, []*string{to.Ptr("server")}, route.ResponseHeaders.Remove)
+}
+
 func TestGatewayConvertFromValidation(t *testing.T) {
 	validationTests := []struct {
 		src v1.DataModelInterface
@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// ConvertTo converts from the versioned Job resource to version-agnostic datamodel.
+func (src *JobResource) ConvertTo() (v1.DataModelInterface, error) {
+	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+
+	var extensions []datamodel.Extension
+	if src.Properties.Extensions != nil {
+		for _, e := range src.Properties.Extensions {
+			extensions = append(extensions, toExtensionDataModel(e))
+		}
+	}
+
+	converted := &datamodel.JobResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     to.String(src.Type),
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion:      Version,
+				AsyncProvisioningState: toProvisioningStateDataModel(src.Properties.ProvisioningState),
+			},
+		},
+		Properties: datamodel.JobProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: to.String(src.Properties.Application),
+			},
+			Connections:       toConnectionsDataModel(src.Properties.Connections),
+			Container:         toContainerDataModel(src.Properties.Container),
+			Extensions:        extensions,
+			Runtimes:          toRuntimePropertiesDataModel(src.Properties.Runtimes),
+			Schedule:          to.String(src.Properties.Schedule),
+			RestartPolicy:     toJobRestartPolicyDataModel(src.Properties.RestartPolicy),
+			BackoffLimit:      src.Properties.BackoffLimit,
+			ConcurrencyPolicy: toJobConcurrencyPolicyDataModel(src.Properties.ConcurrencyPolicy),
+		},
+	}
+
+	if src.Properties.ActiveDeadlineSeconds != nil {
+		converted.Properties.ActiveDeadlineSeconds = to.Ptr(int64(*src.Properties.ActiveDeadlineSeconds))
+	}
+
+	if src.Properties.Identity != nil {
+		converted.Properties.Identity = &rpv1.IdentitySettings{
+			Kind:       toIdentityKindDataModel(src.Properties.Identity.Kind),
+			OIDCIssuer: to.String(src.Properties.Identity.OidcIssuer),
+			Resource:   to.String(src.Properties.Identity.Resource),
+		}
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Job resource.
+func (dst *JobResource) ConvertFrom(src v1.DataModelInterface) error {
+	j, ok := src.(*datamodel.JobResource)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	var extensions []ExtensionClassification
+	if j.Properties.Extensions != nil {
+		for _, e := range j.Properties.Extensions {
+			extensions = append(extensions, fromExtensionClassificationDataModel(e))
+		}
+	}
+
+	var identity *IdentitySettings
+	if j.Properties.Identity != nil {
+		identity = &IdentitySettings{
+			Kind:       fromIdentityKind(j.Properties.Identity.Kind),
+			Resource:   to.Ptr(j.Properties.Identity.Resource),
+			OidcIssuer: to.Ptr(j.Properties.Identity.OIDCIssuer),
+		}
+	}
+
+	var activeDeadlineSeconds *int32
+	if j.Properties.ActiveDeadlineSeconds != nil {
+		activeDeadlineSeconds = to.Ptr(int32(*j.Properties.ActiveDeadlineSeconds))
+	}
+
+	var schedule *string
+	if j.Properties.Schedule != "" {
+		schedule = to.Ptr(j.Properties.Schedule)
+	}
+
+	dst.ID = to.Ptr(j.ID)
+	dst.Name = to.Ptr(j.Name)
+	dst.Type = to.Ptr(j.Type)
+	dst.SystemData = fromSystemDataModel(j.SystemData)
+	dst.Location = to.Ptr(j.Location)
+	dst.Tags = *to.StringMapPtr(j.Tags)
+	dst.Properties = &JobProperties{
+		Status: &ResourceStatus{
+			OutputResources: toOutputResourcesDataModel(j.Properties.Status.OutputResources),
+		},
+		ProvisioningState:     fromProvisioningStateDataModel(j.InternalMetadata.AsyncProvisioningState),
+		Application:           to.Ptr(j.Properties.Application),
+		Connections:           fromConnectionsDataModel(j.Properties.Connections),
+		Container:             fromContainerDataModel(j.Properties.Container),
+		Extensions:            extensions,
+		Identity:              identity,
+		Runtimes:              fromRuntimePropertiesDataModel(j.Properties.Runtimes),
+		Schedule:              schedule,
+		RestartPolicy:         fromJobRestartPolicyDataModel(j.Properties.RestartPolicy),
+		BackoffLimit:          j.Properties.BackoffLimit,
+		ActiveDeadlineSeconds: activeDeadlineSeconds,
+		ConcurrencyPolicy:     fromJobConcurrencyPolicyDataModel(j.Properties.ConcurrencyPolicy),
+	}
+
+	return nil
+}
+
+func toJobRestartPolicyDataModel(rp *JobRestartPolicy) string {
+	if rp == nil {
+		return ""
+	}
+
+	switch *rp {
+	case JobRestartPolicyNever:
+		return "Never"
+	case JobRestartPolicyOnFailure:
+		return "OnFailure"
+	default:
+		return ""
+	}
+}
+
+func fromJobRestartPolicyDataModel(rp string) *JobRestartPolicy {
+	switch rp {
+	case "Never":
+		return to.Ptr(JobRestartPolicyNever)
+	case "OnFailure":
+		return to.Ptr(JobRestartPolicyOnFailure)
+	default:
+		return nil
+	}
+}
+
+func toJobConcurrencyPolicyDataModel(policy *JobConcurrencyPolicy) datamodel.JobConcurrencyPolicy {
+	if policy == nil {
+		return ""
+	}
+
+	switch *policy {
+	case JobConcurrencyPolicyAllow:
+		return datamodel.JobConcurrencyPolicyAllow
+	case JobConcurrencyPolicyForbid:
+		return datamodel.JobConcurrencyPolicyForbid
+	case JobConcurrencyPolicyReplace:
+		return datamodel.JobConcurrencyPolicyReplace
+	default:
+		return ""
+	}
+}
+
+func fromJobConcurrencyPolicyDataModel(policy datamodel.JobConcurrencyPolicy) *JobConcurrencyPolicy {
+	switch policy {
+	case datamodel.JobConcurrencyPolicyAllow:
+		return to.Ptr(JobConcurrencyPolicyAllow)
+	case datamodel.JobConcurrencyPolicyForbid:
+		return to.Ptr(JobConcurrencyPolicyForbid)
+	case datamodel.JobConcurrencyPolicyReplace:
+		return to.Ptr(JobConcurrencyPolicyReplace)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("jobresource.json")
+	r := &JobResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	job := dm.(*datamodel.JobResource)
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/jobs/job0", job.ID)
+	require.Equal(t, "job0", job.Name)
+	require.Equal(t, "Applications.Core/jobs", job.Type)
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0", job.Properties.Application)
+	require.Equal(t, "2023-10-01-preview", job.InternalMetadata.UpdatedAPIVersion)
+
+	val, ok := job.Properties.Connections["inventory"]
+	require.True(t, ok)
+	require.Equal(t, "inventory_route_id", val.Source)
+	require.Equal(t, true, *val.DisableDefaultEnvVars)
+	require.Equal(t, "read", val.IAM.Roles[0])
+
+	require.Equal(t, "ghcr.io/radius-project/migrations", job.Properties.Container.Image)
+	require.Equal(t, map[string]string{"MODE": "migrate"}, job.Properties.Container.Env)
+	require.Equal(t, []string{"/bin/sh"}, job.Properties.Container.Command)
+	require.Equal(t, []string{"-c", "./migrate.sh"}, job.Properties.Container.Args)
+	require.Equal(t, "/app", job.Properties.Container.WorkingDir)
+
+	require.Equal(t, "*/5 * * * *", job.Properties.Schedule)
+	require.True(t, job.IsScheduled())
+	require.Equal(t, "Never", job.Properties.RestartPolicy)
+	require.Equal(t, to.Ptr(int32(3)), job.Properties.BackoffLimit)
+	require.Equal(t, to.Ptr(int64(600)), job.Properties.ActiveDeadlineSeconds)
+	require.Equal(t, datamodel.JobConcurrencyPolicyForbid, job.Properties.ConcurrencyPolicy)
+	require.Len(t, job.Properties.Extensions, 1)
+	require.Equal(t, datamodel.KubernetesMetadata, job.Properties.Extensions[0].Kind)
+}
+
+func TestJobConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("jobresourcedatamodel.json")
+	r := &datamodel.JobResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &JobResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/jobs/job0", *versioned.ID)
+	require.Equal(t, "job0", *versioned.Name)
+	require.Equal(t, "Applications.Core/jobs", *versioned.Type)
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0", *versioned.Properties.Application)
+	require.Equal(t, "inventory_route_id", *versioned.Properties.Connections["inventory"].Source)
+	require.Equal(t, "ghcr.io/radius-project/migrations", *versioned.Properties.Container.Image)
+	require.Equal(t, to.SliceOfPtrs([]string{"/bin/sh"}...), versioned.Properties.Container.Command)
+	require.Equal(t, resourcetypeutil.MustPopulateResourceStatus(&ResourceStatus{}), versioned.Properties.Status)
+
+	require.Equal(t, to.Ptr("*/5 * * * *"), versioned.Properties.Schedule)
+	require.Equal(t, to.Ptr(JobRestartPolicyNever), versioned.Properties.RestartPolicy)
+	require.Equal(t, to.Ptr(int32(3)), versioned.Properties.BackoffLimit)
+	require.Equal(t, to.Ptr(int32(600)), versioned.Properties.ActiveDeadlineSeconds)
+	require.Equal(t, to.Ptr(JobConcurrencyPolicyForbid), versioned.Properties.ConcurrencyPolicy)
+	require.Equal(t, "kubernetesMetadata", *versioned.Properties.Extensions[0].GetExtension().Kind)
+}
+
+func TestJobConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &JobResource{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorAs(t, tc.err, &err)
+	}
+}
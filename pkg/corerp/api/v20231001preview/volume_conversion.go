@@ -72,6 +72,28 @@ func (src *VolumeResource) ConvertTo() (v1.DataModelInterface, error) {
 			}
 		}
 		converted.Properties.AzureKeyVault = dm
+	case *PersistentVolumeClaimVolumeProperties:
+		converted.Properties.PersistentVolumeClaim = &datamodel.PersistentVolumeClaimVolumeProperties{
+			Size:             to.String(p.Size),
+			StorageClassName: to.String(p.StorageClassName),
+			AccessMode:       toAccessModeDataModel(p.AccessMode),
+			RetentionPolicy:  toRetentionPolicyDataModel(p.RetentionPolicy),
+		}
+	case *ConfigMapVolumeProperties:
+		dm := &datamodel.ConfigMapVolumeProperties{}
+		if p.Data != nil {
+			dm.Data = to.StringMap(p.Data)
+		}
+		converted.Properties.ConfigMap = dm
+	case *EmptyDirVolumeProperties:
+		dm := &datamodel.EmptyDirVolumeProperties{
+			ManagedStore: datamodel.ManagedStoreDisk,
+			SizeLimit:    to.String(p.SizeLimit),
+		}
+		if p.ManagedStore != nil {
+			dm.ManagedStore = toManagedStoreDataModel(p.ManagedStore)
+		}
+		converted.Properties.EmptyDir = dm
 	}
 	return converted, nil
 }
@@ -121,11 +143,92 @@ func (dst *VolumeResource) ConvertFrom(src v1.DataModelInterface) error {
 			}
 		}
 		dst.Properties = p
+	case datamodel.PersistentVolumeClaimVolume:
+		pvc := resource.Properties.PersistentVolumeClaim
+		dst.Properties = &PersistentVolumeClaimVolumeProperties{
+			Status: &ResourceStatus{
+				OutputResources: toOutputResourcesDataModel(resource.Properties.Status.OutputResources),
+			},
+			Kind:              to.Ptr(resource.Properties.Kind),
+			Application:       to.Ptr(resource.Properties.Application),
+			Size:              to.Ptr(pvc.Size),
+			StorageClassName:  toStringPtr(pvc.StorageClassName),
+			AccessMode:        fromAccessModeDataModel(pvc.AccessMode),
+			RetentionPolicy:   fromRetentionPolicyDataModel(pvc.RetentionPolicy),
+			ProvisioningState: fromProvisioningStateDataModel(resource.InternalMetadata.AsyncProvisioningState),
+		}
+	case datamodel.ConfigMapVolume:
+		dst.Properties = &ConfigMapVolumeProperties{
+			Status: &ResourceStatus{
+				OutputResources: toOutputResourcesDataModel(resource.Properties.Status.OutputResources),
+			},
+			Kind:              to.Ptr(resource.Properties.Kind),
+			Application:       to.Ptr(resource.Properties.Application),
+			Data:              *to.StringMapPtr(resource.Properties.ConfigMap.Data),
+			ProvisioningState: fromProvisioningStateDataModel(resource.InternalMetadata.AsyncProvisioningState),
+		}
+	case datamodel.EmptyDirVolume:
+		emptyDir := resource.Properties.EmptyDir
+		dst.Properties = &EmptyDirVolumeProperties{
+			Status: &ResourceStatus{
+				OutputResources: toOutputResourcesDataModel(resource.Properties.Status.OutputResources),
+			},
+			Kind:              to.Ptr(resource.Properties.Kind),
+			Application:       to.Ptr(resource.Properties.Application),
+			ManagedStore:      fromManagedStoreDataModel(emptyDir.ManagedStore),
+			SizeLimit:         toStringPtr(emptyDir.SizeLimit),
+			ProvisioningState: fromProvisioningStateDataModel(resource.InternalMetadata.AsyncProvisioningState),
+		}
 	}
 
 	return nil
 }
 
+func toAccessModeDataModel(mode *PersistentVolumeAccessMode) datamodel.PersistentVolumeAccessMode {
+	if mode == nil {
+		return datamodel.PersistentVolumeAccessModeReadWriteOnce
+	}
+
+	switch *mode {
+	case PersistentVolumeAccessModeReadOnlyMany:
+		return datamodel.PersistentVolumeAccessModeReadOnlyMany
+	case PersistentVolumeAccessModeReadWriteMany:
+		return datamodel.PersistentVolumeAccessModeReadWriteMany
+	case PersistentVolumeAccessModeReadWriteOncePod:
+		return datamodel.PersistentVolumeAccessModeReadWriteOncePod
+	default:
+		return datamodel.PersistentVolumeAccessModeReadWriteOnce
+	}
+}
+
+func fromAccessModeDataModel(mode datamodel.PersistentVolumeAccessMode) *PersistentVolumeAccessMode {
+	m := PersistentVolumeAccessModeReadWriteOnce
+	switch mode {
+	case datamodel.PersistentVolumeAccessModeReadOnlyMany:
+		m = PersistentVolumeAccessModeReadOnlyMany
+	case datamodel.PersistentVolumeAccessModeReadWriteMany:
+		m = PersistentVolumeAccessModeReadWriteMany
+	case datamodel.PersistentVolumeAccessModeReadWriteOncePod:
+		m = PersistentVolumeAccessModeReadWriteOncePod
+	}
+	return &m
+}
+
+func toRetentionPolicyDataModel(policy *VolumeRetentionPolicy) datamodel.VolumeRetentionPolicy {
+	if policy != nil && *policy == VolumeRetentionPolicyRetain {
+		return datamodel.VolumeRetentionPolicyRetain
+	}
+	return datamodel.VolumeRetentionPolicyDelete
+}
+
+func fromRetentionPolicyDataModel(policy datamodel.VolumeRetentionPolicy) *VolumeRetentionPolicy {
+	p := VolumeRetentionPolicyDelete
+	if policy == datamodel.VolumeRetentionPolicyRetain {
+		p = VolumeRetentionPolicyRetain
+	}
+	return &p
+}
+
 func toStringPtr(v string) *string {
 	if v == "" {
 		return nil
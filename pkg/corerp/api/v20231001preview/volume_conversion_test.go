@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
 
@@ -92,3 +93,82 @@ func TestVolumeConvertFromValidation(t *testing.T) {
 		require.ErrorAs(t, tc.err, &err)
 	}
 }
+
+func TestKubernetesVolumeConvertVersionedToDataModel(t *testing.T) {
+	tests := []struct {
+		versioned string
+		datamodel string
+	}{
+		{"volume-k8s-pvc.json", "volume-k8s-pvc-datamodel.json"},
+		{"volume-k8s-configmap.json", "volume-k8s-configmap-datamodel.json"},
+		{"volume-k8s-emptydir.json", "volume-k8s-emptydir-datamodel.json"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.versioned, func(t *testing.T) {
+			r := &VolumeResource{}
+			err := json.Unmarshal(testutil.ReadFixture(tc.versioned), r)
+			require.NoError(t, err)
+
+			expected := &datamodel.VolumeResource{}
+			err = json.Unmarshal(testutil.ReadFixture(tc.datamodel), expected)
+			require.NoError(t, err)
+
+			dm, err := r.ConvertTo()
+			require.NoError(t, err)
+
+			ct := dm.(*datamodel.VolumeResource)
+			require.Equal(t, expected.Properties.Kind, ct.Properties.Kind)
+			require.Equal(t, expected.Properties.Application, ct.Properties.Application)
+			require.Equal(t, expected.Properties.PersistentVolumeClaim, ct.Properties.PersistentVolumeClaim)
+			require.Equal(t, expected.Properties.ConfigMap, ct.Properties.ConfigMap)
+			require.Equal(t, expected.Properties.EmptyDir, ct.Properties.EmptyDir)
+		})
+	}
+}
+
+func TestKubernetesVolumeConvertDataModelToVersioned(t *testing.T) {
+	tests := []struct {
+		versioned string
+		datamodel string
+	}{
+		{"volume-k8s-pvc.json", "volume-k8s-pvc-datamodel.json"},
+		{"volume-k8s-configmap.json", "volume-k8s-configmap-datamodel.json"},
+		{"volume-k8s-emptydir.json", "volume-k8s-emptydir-datamodel.json"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.datamodel, func(t *testing.T) {
+			r := &datamodel.VolumeResource{}
+			err := json.Unmarshal(testutil.ReadFixture(tc.datamodel), r)
+			require.NoError(t, err)
+
+			expected := &VolumeResource{}
+			err = json.Unmarshal(testutil.ReadFixture(tc.versioned), expected)
+			require.NoError(t, err)
+
+			versioned := &VolumeResource{}
+			err = versioned.ConvertFrom(r)
+			require.NoError(t, err)
+			require.Equal(t, expected.Properties, versioned.Properties)
+		})
+	}
+}
+
+func TestKubernetesVolumeConvertDefaults(t *testing.T) {
+	r := &VolumeResource{
+		Properties: &PersistentVolumeClaimVolumeProperties{
+			Kind:        to.Ptr(datamodel.PersistentVolumeClaimVolume),
+			Application: to.Ptr("/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/applications/app0"),
+			Size:        to.Ptr("1Gi"),
+		},
+	}
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	pvc := dm.(*datamodel.VolumeResource).Properties.PersistentVolumeClaim
+	require.Equal(t, datamodel.PersistentVolumeAccessModeReadWriteOnce, pvc.AccessMode)
+	require.Equal(t, datamodel.VolumeRetentionPolicyDelete, pvc.RetentionPolicy)
+	require.Empty(t, pvc.StorageClassName)
+}
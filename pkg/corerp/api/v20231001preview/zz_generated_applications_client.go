@@ -308,6 +308,129 @@ func (client *ApplicationsClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// ListRevisions - Lists the revisions of the application.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - applicationName - The application name
+//   - body - The content of the action request
+//   - options - ApplicationsClientListRevisionsOptions contains the optional parameters for the ApplicationsClient.ListRevisions
+//     method.
+func (client *ApplicationsClient) ListRevisions(ctx context.Context, applicationName string, body map[string]any, options *ApplicationsClientListRevisionsOptions) (ApplicationsClientListRevisionsResponse, error) {
+	var err error
+	req, err := client.listRevisionsCreateRequest(ctx, applicationName, body, options)
+	if err != nil {
+		return ApplicationsClientListRevisionsResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ApplicationsClientListRevisionsResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ApplicationsClientListRevisionsResponse{}, err
+	}
+	resp, err := client.listRevisionsHandleResponse(httpResp)
+	return resp, err
+}
+
+// listRevisionsCreateRequest creates the ListRevisions request.
+func (client *ApplicationsClient) listRevisionsCreateRequest(ctx context.Context, applicationName string, body map[string]any, options *ApplicationsClientListRevisionsOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/applications/{applicationName}/listRevisions"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if applicationName == "" {
+		return nil, errors.New("parameter applicationName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{applicationName}", url.PathEscape(applicationName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// listRevisionsHandleResponse handles the ListRevisions response.
+func (client *ApplicationsClient) listRevisionsHandleResponse(resp *http.Response) (ApplicationsClientListRevisionsResponse, error) {
+	result := ApplicationsClientListRevisionsResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.ApplicationRevisionList); err != nil {
+		return ApplicationsClientListRevisionsResponse{}, err
+	}
+	return result, nil
+}
+
+// BeginRollback - Rolls back the application to a previous revision.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - applicationName - The application name
+//   - body - The content of the action request
+//   - options - ApplicationsClientBeginRollbackOptions contains the optional parameters for the ApplicationsClient.BeginRollback
+//     method.
+func (client *ApplicationsClient) BeginRollback(ctx context.Context, applicationName string, body ApplicationRollbackRequest, options *ApplicationsClientBeginRollbackOptions) (*runtime.Poller[ApplicationsClientRollbackResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.rollback(ctx, applicationName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[ApplicationsClientRollbackResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[ApplicationsClientRollbackResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// Rollback - Rolls back the application to a previous revision.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *ApplicationsClient) rollback(ctx context.Context, applicationName string, body ApplicationRollbackRequest, options *ApplicationsClientBeginRollbackOptions) (*http.Response, error) {
+	var err error
+	req, err := client.rollbackCreateRequest(ctx, applicationName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// rollbackCreateRequest creates the Rollback request.
+func (client *ApplicationsClient) rollbackCreateRequest(ctx context.Context, applicationName string, body ApplicationRollbackRequest, options *ApplicationsClientBeginRollbackOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/applications/{applicationName}/rollback"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if applicationName == "" {
+		return nil, errors.New("parameter applicationName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{applicationName}", url.PathEscape(applicationName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // Update - Update a ApplicationResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
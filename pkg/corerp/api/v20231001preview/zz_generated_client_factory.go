@@ -68,6 +68,11 @@ func (c *ClientFactory) NewHTTPRoutesClient() *HTTPRoutesClient {
 	return subClient
 }
 
+func (c *ClientFactory) NewJobsClient() *JobsClient {
+	subClient, _ := NewJobsClient(c.rootScope, c.credential, c.options)
+	return subClient
+}
+
 func (c *ClientFactory) NewOperationsClient() *OperationsClient {
 	subClient, _ := NewOperationsClient(c.credential, c.options)
 	return subClient
@@ -26,6 +26,24 @@ func PossibleActionTypeValues() []ActionType {
 	}
 }
 
+// ApplicationRevisionReason - The reason an application revision was created.
+type ApplicationRevisionReason string
+
+const (
+	// ApplicationRevisionReasonDeployment - The revision was created after the application was deployed.
+	ApplicationRevisionReasonDeployment ApplicationRevisionReason = "Deployment"
+	// ApplicationRevisionReasonRollback - The revision was created by rolling back to a previous revision.
+	ApplicationRevisionReasonRollback ApplicationRevisionReason = "Rollback"
+)
+
+// PossibleApplicationRevisionReasonValues returns the possible values for the ApplicationRevisionReason const type.
+func PossibleApplicationRevisionReasonValues() []ApplicationRevisionReason {
+	return []ApplicationRevisionReason{	
+		ApplicationRevisionReasonDeployment,
+		ApplicationRevisionReasonRollback,
+	}
+}
+
 // CertificateFormats - Represents certificate formats
 type CertificateFormats string
 
@@ -142,6 +160,43 @@ func PossibleDirectionValues() []Direction {
 	}
 }
 
+// EnvironmentGatewayKind - The gateway implementation kind
+type EnvironmentGatewayKind string
+
+const (
+	// EnvironmentGatewayKindContour - Gateways are rendered as Contour HTTPProxy resources
+	EnvironmentGatewayKindContour EnvironmentGatewayKind = "contour"
+	// EnvironmentGatewayKindGatewayAPI - Gateways are rendered as Kubernetes Gateway API Gateway and HTTPRoute resources
+	EnvironmentGatewayKindGatewayAPI EnvironmentGatewayKind = "gatewayAPI"
+)
+
+// PossibleEnvironmentGatewayKindValues returns the possible values for the EnvironmentGatewayKind const type.
+func PossibleEnvironmentGatewayKindValues() []EnvironmentGatewayKind {
+	return []EnvironmentGatewayKind{	
+		EnvironmentGatewayKindContour,
+		EnvironmentGatewayKindGatewayAPI,
+	}
+}
+
+// GatewayCertificateIssuerKind - The kind of issuer that issues the certificate of a Gateway.
+type GatewayCertificateIssuerKind string
+
+const (
+	// GatewayCertificateIssuerKindAcme - Certificates are issued by an ACME server such as Let's Encrypt.
+	GatewayCertificateIssuerKindAcme GatewayCertificateIssuerKind = "acme"
+	// GatewayCertificateIssuerKindSelfSigned - Certificates are issued by an internal certificate authority. Intended for development
+// environments.
+	GatewayCertificateIssuerKindSelfSigned GatewayCertificateIssuerKind = "selfSigned"
+)
+
+// PossibleGatewayCertificateIssuerKindValues returns the possible values for the GatewayCertificateIssuerKind const type.
+func PossibleGatewayCertificateIssuerKindValues() []GatewayCertificateIssuerKind {
+	return []GatewayCertificateIssuerKind{	
+		GatewayCertificateIssuerKindAcme,
+		GatewayCertificateIssuerKindSelfSigned,
+	}
+}
+
 // IAMKind - The kind of IAM provider to configure
 type IAMKind string
 
@@ -196,6 +251,45 @@ func PossibleImagePullPolicyValues() []ImagePullPolicy {
 	}
 }
 
+// JobConcurrencyPolicy - Concurrency policy for a scheduled job
+type JobConcurrencyPolicy string
+
+const (
+	// JobConcurrencyPolicyAllow - Allow concurrent executions
+	JobConcurrencyPolicyAllow JobConcurrencyPolicy = "Allow"
+	// JobConcurrencyPolicyForbid - Skip the new execution if the previous one hasn't finished yet
+	JobConcurrencyPolicyForbid JobConcurrencyPolicy = "Forbid"
+	// JobConcurrencyPolicyReplace - Cancel the currently running execution and replace it with a new one
+	JobConcurrencyPolicyReplace JobConcurrencyPolicy = "Replace"
+)
+
+// PossibleJobConcurrencyPolicyValues returns the possible values for the JobConcurrencyPolicy const type.
+func PossibleJobConcurrencyPolicyValues() []JobConcurrencyPolicy {
+	return []JobConcurrencyPolicy{	
+		JobConcurrencyPolicyAllow,
+		JobConcurrencyPolicyForbid,
+		JobConcurrencyPolicyReplace,
+	}
+}
+
+// JobRestartPolicy - Restart policy for the job's container
+type JobRestartPolicy string
+
+const (
+	// JobRestartPolicyNever - Never
+	JobRestartPolicyNever JobRestartPolicy = "Never"
+	// JobRestartPolicyOnFailure - OnFailure
+	JobRestartPolicyOnFailure JobRestartPolicy = "OnFailure"
+)
+
+// PossibleJobRestartPolicyValues returns the possible values for the JobRestartPolicy const type.
+func PossibleJobRestartPolicyValues() []JobRestartPolicy {
+	return []JobRestartPolicy{	
+		JobRestartPolicyNever,
+		JobRestartPolicyOnFailure,
+	}
+}
+
 // ManagedStore - The managed store for the ephemeral volume
 type ManagedStore string
 
@@ -233,6 +327,30 @@ func PossibleOriginValues() []Origin {
 	}
 }
 
+// PersistentVolumeAccessMode - Represents the access mode of a persistent volume claim
+type PersistentVolumeAccessMode string
+
+const (
+	// PersistentVolumeAccessModeReadOnlyMany - The volume can be mounted as read-only by many nodes
+	PersistentVolumeAccessModeReadOnlyMany PersistentVolumeAccessMode = "ReadOnlyMany"
+	// PersistentVolumeAccessModeReadWriteMany - The volume can be mounted as read-write by many nodes
+	PersistentVolumeAccessModeReadWriteMany PersistentVolumeAccessMode = "ReadWriteMany"
+	// PersistentVolumeAccessModeReadWriteOnce - The volume can be mounted as read-write by a single node
+	PersistentVolumeAccessModeReadWriteOnce PersistentVolumeAccessMode = "ReadWriteOnce"
+	// PersistentVolumeAccessModeReadWriteOncePod - The volume can be mounted as read-write by a single pod
+	PersistentVolumeAccessModeReadWriteOncePod PersistentVolumeAccessMode = "ReadWriteOncePod"
+)
+
+// PossiblePersistentVolumeAccessModeValues returns the possible values for the PersistentVolumeAccessMode const type.
+func PossiblePersistentVolumeAccessModeValues() []PersistentVolumeAccessMode {
+	return []PersistentVolumeAccessMode{	
+		PersistentVolumeAccessModeReadOnlyMany,
+		PersistentVolumeAccessModeReadWriteMany,
+		PersistentVolumeAccessModeReadWriteOnce,
+		PersistentVolumeAccessModeReadWriteOncePod,
+	}
+}
+
 // PortProtocol - The protocol in use by the port
 type PortProtocol string
 
@@ -412,6 +530,24 @@ func PossibleVolumePermissionValues() []VolumePermission {
 	}
 }
 
+// VolumeRetentionPolicy - Represents what happens to the underlying storage when the volume resource is deleted
+type VolumeRetentionPolicy string
+
+const (
+	// VolumeRetentionPolicyDelete - The storage is deleted with the volume resource
+	VolumeRetentionPolicyDelete VolumeRetentionPolicy = "Delete"
+	// VolumeRetentionPolicyRetain - The storage is kept after the volume resource is deleted
+	VolumeRetentionPolicyRetain VolumeRetentionPolicy = "Retain"
+)
+
+// PossibleVolumeRetentionPolicyValues returns the possible values for the VolumeRetentionPolicy const type.
+func PossibleVolumeRetentionPolicyValues() []VolumeRetentionPolicy {
+	return []VolumeRetentionPolicy{	
+		VolumeRetentionPolicyDelete,
+		VolumeRetentionPolicyRetain,
+	}
+}
+
 // VolumeSecretEncodings - Represents secret encodings
 type VolumeSecretEncodings string
 
@@ -73,7 +73,7 @@ type VolumeClassification interface {
 // VolumePropertiesClassification provides polymorphic access to related types.
 // Call the interface's GetVolumeProperties() method to access the common type.
 // Use a type switch to determine the concrete type.  The possible types are:
-// - *AzureKeyVaultVolumeProperties, *VolumeProperties
+// - *AzureKeyVaultVolumeProperties, *ConfigMapVolumeProperties, *EmptyDirVolumeProperties, *PersistentVolumeClaimVolumeProperties, *VolumeProperties
 type VolumePropertiesClassification interface {
 	// GetVolumeProperties returns the VolumeProperties content of the underlying type.
 	GetVolumeProperties() *VolumeProperties
@@ -133,6 +133,60 @@ type ApplicationResourceUpdateProperties struct {
 	Simulated *bool
 }
 
+// ApplicationRevision - Describes a revision of an application: a snapshot of the resources of the application.
+type ApplicationRevision struct {
+	// REQUIRED; The time the revision was created.
+	CreatedAt *time.Time
+
+	// REQUIRED; The reason the revision was created.
+	Reason *ApplicationRevisionReason
+
+	// REQUIRED; The resources of the application in this revision.
+	Resources []*ApplicationRevisionResource
+
+	// REQUIRED; The revision number. Revision numbers increase by one for every revision of the application.
+	Revision *int32
+
+	// The revision that was re-applied. Only set for revisions created by a rollback.
+	RolledBackFrom *int32
+
+	// READ-ONLY; The state of the revision. Revisions created by a rollback are 'Accepted' until the rollback completes.
+	ProvisioningState *ProvisioningState
+}
+
+// ApplicationRevisionList - The list of revisions of an application, newest first.
+type ApplicationRevisionList struct {
+	// REQUIRED; The revisions of the application.
+	Value []*ApplicationRevision
+}
+
+// ApplicationRevisionResource - Describes a resource in an application revision.
+type ApplicationRevisionResource struct {
+	// REQUIRED; The resource ID.
+	ID *string
+
+	// REQUIRED; The resource name.
+	Name *string
+
+	// REQUIRED; The properties of the resource.
+	Properties map[string]any
+
+	// REQUIRED; The resource type.
+	Type *string
+
+	// The recipe that provisioned the resource, if the resource was provisioned by a recipe.
+	Recipe *RecipeStatus
+}
+
+// ApplicationRollbackRequest - The request to roll back an application to a previous revision.
+type ApplicationRollbackRequest struct {
+	// REQUIRED; The revision to roll back to.
+	Revision *int32
+
+	// Delete the resources of the application that are not part of the revision. They are kept by default.
+	DeleteResources *bool
+}
+
 // AzureKeyVaultVolumeProperties - Represents Azure Key Vault Volume properties
 type AzureKeyVaultVolumeProperties struct {
 	// REQUIRED; Fully qualified resource ID for the application that the portable resource is consumed by
@@ -237,6 +291,38 @@ type CertificateObjectProperties struct {
 	Version *string
 }
 
+// ConfigMapVolumeProperties - Represents Kubernetes config map volume properties
+type ConfigMapVolumeProperties struct {
+	// REQUIRED; Fully qualified resource ID for the application that the portable resource is consumed by
+	Application *string
+
+	// REQUIRED; The files that this volume exposes, keyed by file name
+	Data map[string]*string
+
+	// REQUIRED; Discriminator property for VolumeProperties.
+	Kind *string
+
+	// Fully qualified resource ID for the environment that the portable resource is linked to (if applicable)
+	Environment *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; Status of a resource.
+	Status *ResourceStatus
+}
+
+// GetVolumeProperties implements the VolumePropertiesClassification interface for type ConfigMapVolumeProperties.
+func (c *ConfigMapVolumeProperties) GetVolumeProperties() *VolumeProperties {
+	return &VolumeProperties{
+		Application: c.Application,
+		Environment: c.Environment,
+		Kind: c.Kind,
+		ProvisioningState: c.ProvisioningState,
+		Status: c.Status,
+	}
+}
+
 // ConnectionProperties - Connection Properties
 type ConnectionProperties struct {
 	// REQUIRED; The source of the connection
@@ -504,6 +590,41 @@ func (d *DaprSidecarExtension) GetExtension() *Extension {
 	}
 }
 
+// EmptyDirVolumeProperties - Represents Kubernetes empty directory volume properties
+type EmptyDirVolumeProperties struct {
+	// REQUIRED; Fully qualified resource ID for the application that the portable resource is consumed by
+	Application *string
+
+	// REQUIRED; Discriminator property for VolumeProperties.
+	Kind *string
+
+	// Fully qualified resource ID for the environment that the portable resource is linked to (if applicable)
+	Environment *string
+
+	// The managed store for the volume. Default disk
+	ManagedStore *ManagedStore
+
+	// The maximum size of the volume, for example 1Gi
+	SizeLimit *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; Status of a resource.
+	Status *ResourceStatus
+}
+
+// GetVolumeProperties implements the VolumePropertiesClassification interface for type EmptyDirVolumeProperties.
+func (e *EmptyDirVolumeProperties) GetVolumeProperties() *VolumeProperties {
+	return &VolumeProperties{
+		Application: e.Application,
+		Environment: e.Environment,
+		Kind: e.Kind,
+		ProvisioningState: e.ProvisioningState,
+		Status: e.Status,
+	}
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -534,6 +655,24 @@ type EnvironmentComputeUpdate struct {
 // GetEnvironmentComputeUpdate implements the EnvironmentComputeUpdateClassification interface for type EnvironmentComputeUpdate.
 func (e *EnvironmentComputeUpdate) GetEnvironmentComputeUpdate() *EnvironmentComputeUpdate { return e }
 
+// EnvironmentGateway - The gateway implementation configuration for the environment
+type EnvironmentGateway struct {
+	// The name of the Kubernetes Gateway API GatewayClass used for gateways. Required when kind is 'gatewayAPI'.
+	GatewayClassName *string
+
+	// The gateway implementation used to render gateways. Defaults to 'contour'.
+	Kind *EnvironmentGatewayKind
+}
+
+// EnvironmentGatewayUpdate - The gateway implementation configuration for the environment
+type EnvironmentGatewayUpdate struct {
+	// The name of the Kubernetes Gateway API GatewayClass used for gateways. Required when kind is 'gatewayAPI'.
+	GatewayClassName *string
+
+	// The gateway implementation used to render gateways. Defaults to 'contour'.
+	Kind *EnvironmentGatewayKind
+}
+
 // EnvironmentProperties - Environment properties
 type EnvironmentProperties struct {
 	// REQUIRED; The compute resource used by application environment.
@@ -542,6 +681,9 @@ type EnvironmentProperties struct {
 	// The environment extension.
 	Extensions []ExtensionClassification
 
+	// The gateway implementation used to expose gateways deployed to the environment.
+	Gateway *EnvironmentGateway
+
 	// Cloud providers configuration for the environment.
 	Providers *Providers
 
@@ -605,6 +747,9 @@ type EnvironmentResourceUpdateProperties struct {
 	// The environment extension.
 	Extensions []ExtensionClassification
 
+	// The gateway implementation used to expose gateways deployed to the environment.
+	Gateway *EnvironmentGatewayUpdate
+
 	// Cloud providers configuration for the environment.
 	Providers *ProvidersUpdate
 
@@ -797,6 +942,21 @@ type Extension struct {
 // GetExtension implements the ExtensionClassification interface for type Extension.
 func (e *Extension) GetExtension() *Extension { return e }
 
+// GatewayCertificateIssuer - Automatic certificate issuance configuration for a Gateway.
+type GatewayCertificateIssuer struct {
+	// REQUIRED; The kind of issuer that issues the certificate.
+	Kind *GatewayCertificateIssuerKind
+
+	// The directory URL of the ACME server. Defaults to Let's Encrypt.
+	AcmeDirectoryURL *string
+
+	// The email address of the ACME account.
+	Email *string
+
+	// How long before it expires the certificate is renewed. Ex - 720h. Defaults to 720h.
+	RenewBefore *string
+}
+
 // GatewayHostname - Declare hostname information for the Gateway. Leaving the hostname empty auto-assigns one: mygateway.myapp.PUBLICHOSTNAMEORIP.nip.io.
 type GatewayHostname struct {
 	// Specify a fully-qualified domain name: myapp.mydomain.com. Mutually exclusive with 'prefix' and will take priority if both
@@ -906,12 +1066,108 @@ type GatewayRoute struct {
 	// The HttpRoute to route to. Ex - myserviceroute.id.
 	Destination *string
 
+	// Weighted destinations to split traffic between. Cannot be specified together with destination.
+	Destinations []*GatewayRouteDestination
+
+	// Request headers that must match for the route to be selected.
+	Headers []*GatewayRouteHeaderMatch
+
+	// HTTP methods to match. Ex - GET, POST.
+	Methods []*string
+
 	// The path to match the incoming request path on. Ex - /myservice.
 	Path *string
 
+	// Query parameters that must match for the route to be selected.
+	QueryParameters []*GatewayRouteQueryParameterMatch
+
 	// Optionally update the prefix when sending the request to the service. Ex - replacePrefix: '/' and path: '/myservice' will
 // transform '/myservice/myroute' to '/myroute'
 	ReplacePrefix *string
+
+	// Headers to set or remove on the request before it is sent to the destination.
+	RequestHeaders *GatewayRouteHeaderPolicy
+
+	// Headers to set or remove on the response before it is returned to the client.
+	ResponseHeaders *GatewayRouteHeaderPolicy
+
+	// Retry policy for requests sent through the route.
+	RetryPolicy *GatewayRouteRetryPolicy
+
+	// Timeout policy for requests sent through the route.
+	TimeoutPolicy *GatewayRouteTimeoutPolicy
+}
+
+// GatewayRouteDestination - Weighted destination of a Gateway route
+type GatewayRouteDestination struct {
+	// REQUIRED; The HttpRoute to route to. Ex - myserviceroute.id.
+	Destination *string
+
+	// REQUIRED; The proportion of traffic sent to the destination, relative to the other destinations of the route.
+	Weight *int32
+}
+
+// GatewayRouteHeaderMatch - Request header match for a Gateway route. Exactly one of exact, contains and present must
+// be specified.
+type GatewayRouteHeaderMatch struct {
+	// REQUIRED; The name of the header.
+	Name *string
+
+	// Matches if the header value contains the given value.
+	Contains *string
+
+	// Matches if the header value is equal to the given value.
+	Exact *string
+
+	// Matches if the header is present, regardless of its value.
+	Present *bool
+}
+
+// GatewayRouteHeaderPolicy - Header rewriting policy for a Gateway route
+type GatewayRouteHeaderPolicy struct {
+	// Names of the headers to remove.
+	Remove []*string
+
+	// Headers to set, replacing any existing values.
+	Set map[string]*string
+}
+
+// GatewayRouteQueryParameterMatch - Query parameter match for a Gateway route. Exactly one of exact, prefix and present
+// must be specified.
+type GatewayRouteQueryParameterMatch struct {
+	// REQUIRED; The name of the query parameter.
+	Name *string
+
+	// Matches if the query parameter value is equal to the given value.
+	Exact *string
+
+	// Matches if the query parameter value starts with the given value.
+	Prefix *string
+
+	// Matches if the query parameter is present, regardless of its value.
+	Present *bool
+}
+
+// GatewayRouteRetryPolicy - Retry policy for a Gateway route
+type GatewayRouteRetryPolicy struct {
+	// REQUIRED; The maximum number of retries.
+	Count *int32
+
+	// The timeout of each retry attempt. Ex - 2s.
+	PerTryTimeout *string
+
+	// The conditions on which to retry a request. Ex - 5xx, gateway-error, reset, connect-failure.
+	RetryOn []*string
+}
+
+// GatewayRouteTimeoutPolicy - Timeout policy for a Gateway route. Timeouts are durations such as 30s or 1m, or 'infinity'
+// to disable the timeout.
+type GatewayRouteTimeoutPolicy struct {
+	// The time a connection may stay idle before it is closed.
+	Idle *string
+
+	// The time to wait for the destination to respond to a request.
+	Response *string
 }
 
 // GatewayTLS - TLS configuration definition for Gateway resource.
@@ -919,6 +1175,10 @@ type GatewayTLS struct {
 	// The resource id for the secret containing the TLS certificate and key for the gateway.
 	CertificateFrom *string
 
+	// Issue and renew the TLS certificate of the gateway automatically. Cannot be specified together with certificateFrom or
+// sslPassthrough.
+	CertificateIssuer *GatewayCertificateIssuer
+
 	// TLS minimum protocol version (defaults to 1.2).
 	MinimumProtocolVersion *TLSMinVersion
 
@@ -1111,6 +1371,133 @@ type IdentitySettingsUpdate struct {
 	Resource *string
 }
 
+// JobProperties - Job properties
+type JobProperties struct {
+	// REQUIRED; Fully qualified resource ID for the application that the portable resource is consumed by
+	Application *string
+
+	// REQUIRED; Definition of the container that runs the job.
+	Container *Container
+
+	// The duration in seconds relative to the start time that the job may be active before it is terminated
+	ActiveDeadlineSeconds *int32
+
+	// The number of retries before marking the job as failed
+	BackoffLimit *int32
+
+	// Specifies how to treat concurrent executions of a scheduled job
+	ConcurrencyPolicy *JobConcurrencyPolicy
+
+	// Specifies a connection to another resource.
+	Connections map[string]*ConnectionProperties
+
+	// Fully qualified resource ID for the environment that the portable resource is linked to (if applicable)
+	Environment *string
+
+	// Extensions spec of the resource
+	Extensions []ExtensionClassification
+
+	// Configuration for supported external identity providers
+	Identity *IdentitySettings
+
+	// The restart policy for the job's container
+	RestartPolicy *JobRestartPolicy
+
+	// Specifies Runtime-specific functionality
+	Runtimes *RuntimesProperties
+
+	// The schedule in cron format. When specified, the job runs on the schedule; otherwise it runs once.
+	Schedule *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; Status of a resource.
+	Status *ResourceStatus
+}
+
+// JobResource - Concrete tracked resource types can be created by aliasing this type using a specific property type.
+type JobResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// The resource-specific properties for this resource.
+	Properties *JobProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex -
+// /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// JobResourceListResult - The response of a JobResource list operation.
+type JobResourceListResult struct {
+	// REQUIRED; The JobResource items on this page
+	Value []*JobResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// JobResourceUpdate - The type used for update operations of the JobResource.
+type JobResourceUpdate struct {
+	// The updatable properties of the JobResource.
+	Properties *JobResourceUpdateProperties
+
+	// Resource tags.
+	Tags map[string]*string
+}
+
+// JobResourceUpdateProperties - The updatable properties of the JobResource.
+type JobResourceUpdateProperties struct {
+	// The duration in seconds relative to the start time that the job may be active before it is terminated
+	ActiveDeadlineSeconds *int32
+
+	// Fully qualified resource ID for the application that the portable resource is consumed by
+	Application *string
+
+	// The number of retries before marking the job as failed
+	BackoffLimit *int32
+
+	// Specifies how to treat concurrent executions of a scheduled job
+	ConcurrencyPolicy *JobConcurrencyPolicy
+
+	// Specifies a connection to another resource.
+	Connections map[string]*ConnectionPropertiesUpdate
+
+	// Definition of the container that runs the job.
+	Container *ContainerUpdate
+
+	// Fully qualified resource ID for the environment that the portable resource is linked to (if applicable)
+	Environment *string
+
+	// Extensions spec of the resource
+	Extensions []ExtensionClassification
+
+	// Configuration for supported external identity providers
+	Identity *IdentitySettingsUpdate
+
+	// The restart policy for the job's container
+	RestartPolicy *JobRestartPolicy
+
+	// Specifies Runtime-specific functionality
+	Runtimes *RuntimesProperties
+
+	// The schedule in cron format. When specified, the job runs on the schedule; otherwise it runs once.
+	Schedule *string
+}
+
 // KeyObjectProperties - Represents key object properties
 type KeyObjectProperties struct {
 	// REQUIRED; The name of the key
@@ -1318,6 +1705,47 @@ func (p *PersistentVolume) GetVolume() *Volume {
 	}
 }
 
+// PersistentVolumeClaimVolumeProperties - Represents Kubernetes persistent volume claim volume properties
+type PersistentVolumeClaimVolumeProperties struct {
+	// REQUIRED; Fully qualified resource ID for the application that the portable resource is consumed by
+	Application *string
+
+	// REQUIRED; Discriminator property for VolumeProperties.
+	Kind *string
+
+	// REQUIRED; The requested storage size of the volume, for example 10Gi
+	Size *string
+
+	// The access mode of the volume. Default ReadWriteOnce
+	AccessMode *PersistentVolumeAccessMode
+
+	// Fully qualified resource ID for the environment that the portable resource is linked to (if applicable)
+	Environment *string
+
+	// What happens to the persistent volume claim when the volume resource is deleted. Default Delete
+	RetentionPolicy *VolumeRetentionPolicy
+
+	// The name of the Kubernetes storage class. The cluster default storage class is used if not specified
+	StorageClassName *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; Status of a resource.
+	Status *ResourceStatus
+}
+
+// GetVolumeProperties implements the VolumePropertiesClassification interface for type PersistentVolumeClaimVolumeProperties.
+func (p *PersistentVolumeClaimVolumeProperties) GetVolumeProperties() *VolumeProperties {
+	return &VolumeProperties{
+		Application: p.Application,
+		Environment: p.Environment,
+		Kind: p.Kind,
+		ProvisioningState: p.ProvisioningState,
+		Status: p.Status,
+	}
+}
+
 // Providers - The Cloud providers configuration
 type Providers struct {
 	// The AWS cloud provider configuration
@@ -360,6 +360,154 @@ func (a *ApplicationResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ApplicationRevision.
+func (a ApplicationRevision) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "createdAt", a.CreatedAt)
+	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "reason", a.Reason)
+	populate(objectMap, "resources", a.Resources)
+	populate(objectMap, "revision", a.Revision)
+	populate(objectMap, "rolledBackFrom", a.RolledBackFrom)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ApplicationRevision.
+func (a *ApplicationRevision) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "createdAt":
+				err = unpopulateTimeRFC3339(val, "CreatedAt", &a.CreatedAt)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
+			delete(rawMsg, key)
+		case "reason":
+				err = unpopulate(val, "Reason", &a.Reason)
+			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &a.Resources)
+			delete(rawMsg, key)
+		case "revision":
+				err = unpopulate(val, "Revision", &a.Revision)
+			delete(rawMsg, key)
+		case "rolledBackFrom":
+				err = unpopulate(val, "RolledBackFrom", &a.RolledBackFrom)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ApplicationRevisionList.
+func (a ApplicationRevisionList) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "value", a.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ApplicationRevisionList.
+func (a *ApplicationRevisionList) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "value":
+				err = unpopulate(val, "Value", &a.Value)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ApplicationRevisionResource.
+func (a ApplicationRevisionResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "id", a.ID)
+	populate(objectMap, "name", a.Name)
+	populate(objectMap, "properties", a.Properties)
+	populate(objectMap, "recipe", a.Recipe)
+	populate(objectMap, "type", a.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ApplicationRevisionResource.
+func (a *ApplicationRevisionResource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "id":
+				err = unpopulate(val, "ID", &a.ID)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &a.Name)
+			delete(rawMsg, key)
+		case "properties":
+				err = unpopulate(val, "Properties", &a.Properties)
+			delete(rawMsg, key)
+		case "recipe":
+				err = unpopulate(val, "Recipe", &a.Recipe)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &a.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ApplicationRollbackRequest.
+func (a ApplicationRollbackRequest) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "deleteResources", a.DeleteResources)
+	populate(objectMap, "revision", a.Revision)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ApplicationRollbackRequest.
+func (a *ApplicationRollbackRequest) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "deleteResources":
+				err = unpopulate(val, "DeleteResources", &a.DeleteResources)
+			delete(rawMsg, key)
+		case "revision":
+				err = unpopulate(val, "Revision", &a.Revision)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AzureKeyVaultVolumeProperties.
 func (a AzureKeyVaultVolumeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -536,6 +684,53 @@ func (c *CertificateObjectProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ConfigMapVolumeProperties.
+func (c ConfigMapVolumeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "application", c.Application)
+	populate(objectMap, "data", c.Data)
+	populate(objectMap, "environment", c.Environment)
+	objectMap["kind"] = "kubernetes.configMap"
+	populate(objectMap, "provisioningState", c.ProvisioningState)
+	populate(objectMap, "status", c.Status)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ConfigMapVolumeProperties.
+func (c *ConfigMapVolumeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "application":
+				err = unpopulate(val, "Application", &c.Application)
+			delete(rawMsg, key)
+		case "data":
+				err = unpopulate(val, "Data", &c.Data)
+			delete(rawMsg, key)
+		case "environment":
+				err = unpopulate(val, "Environment", &c.Environment)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &c.Kind)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &c.ProvisioningState)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &c.Status)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ConnectionProperties.
 func (c ConnectionProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1108,6 +1303,57 @@ func (d *DaprSidecarExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type EmptyDirVolumeProperties.
+func (e EmptyDirVolumeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "application", e.Application)
+	populate(objectMap, "environment", e.Environment)
+	objectMap["kind"] = "kubernetes.emptyDir"
+	populate(objectMap, "managedStore", e.ManagedStore)
+	populate(objectMap, "provisioningState", e.ProvisioningState)
+	populate(objectMap, "sizeLimit", e.SizeLimit)
+	populate(objectMap, "status", e.Status)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EmptyDirVolumeProperties.
+func (e *EmptyDirVolumeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "application":
+				err = unpopulate(val, "Application", &e.Application)
+			delete(rawMsg, key)
+		case "environment":
+				err = unpopulate(val, "Environment", &e.Environment)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &e.Kind)
+			delete(rawMsg, key)
+		case "managedStore":
+				err = unpopulate(val, "ManagedStore", &e.ManagedStore)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &e.ProvisioningState)
+			delete(rawMsg, key)
+		case "sizeLimit":
+				err = unpopulate(val, "SizeLimit", &e.SizeLimit)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &e.Status)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type EnvironmentCompute.
 func (e EnvironmentCompute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1178,11 +1424,74 @@ func (e *EnvironmentComputeUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type EnvironmentGateway.
+func (e EnvironmentGateway) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "gatewayClassName", e.GatewayClassName)
+	populate(objectMap, "kind", e.Kind)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentGateway.
+func (e *EnvironmentGateway) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "gatewayClassName":
+				err = unpopulate(val, "GatewayClassName", &e.GatewayClassName)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &e.Kind)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type EnvironmentGatewayUpdate.
+func (e EnvironmentGatewayUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "gatewayClassName", e.GatewayClassName)
+	populate(objectMap, "kind", e.Kind)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentGatewayUpdate.
+func (e *EnvironmentGatewayUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "gatewayClassName":
+				err = unpopulate(val, "GatewayClassName", &e.GatewayClassName)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &e.Kind)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type EnvironmentProperties.
 func (e EnvironmentProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
 	populate(objectMap, "extensions", e.Extensions)
+	populate(objectMap, "gateway", e.Gateway)
 	populate(objectMap, "providers", e.Providers)
 	populate(objectMap, "provisioningState", e.ProvisioningState)
 	populate(objectMap, "recipes", e.Recipes)
@@ -1205,6 +1514,9 @@ func (e *EnvironmentProperties) UnmarshalJSON(data []byte) error {
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
+		case "gateway":
+				err = unpopulate(val, "Gateway", &e.Gateway)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &e.Providers)
 			delete(rawMsg, key)
@@ -1354,6 +1666,7 @@ func (e EnvironmentResourceUpdateProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
 	populate(objectMap, "extensions", e.Extensions)
+	populate(objectMap, "gateway", e.Gateway)
 	populate(objectMap, "providers", e.Providers)
 	populate(objectMap, "recipes", e.Recipes)
 	populate(objectMap, "simulated", e.Simulated)
@@ -1375,6 +1688,9 @@ func (e *EnvironmentResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
+		case "gateway":
+				err = unpopulate(val, "Gateway", &e.Gateway)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &e.Providers)
 			delete(rawMsg, key)
@@ -1835,16 +2151,18 @@ func (e *Extension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type GatewayHostname.
-func (g GatewayHostname) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type GatewayCertificateIssuer.
+func (g GatewayCertificateIssuer) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "fullyQualifiedHostname", g.FullyQualifiedHostname)
-	populate(objectMap, "prefix", g.Prefix)
+	populate(objectMap, "acmeDirectoryUrl", g.AcmeDirectoryURL)
+	populate(objectMap, "email", g.Email)
+	populate(objectMap, "kind", g.Kind)
+	populate(objectMap, "renewBefore", g.RenewBefore)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayHostname.
-func (g *GatewayHostname) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayCertificateIssuer.
+func (g *GatewayCertificateIssuer) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", g, err)
@@ -1852,11 +2170,48 @@ func (g *GatewayHostname) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "fullyQualifiedHostname":
-				err = unpopulate(val, "FullyQualifiedHostname", &g.FullyQualifiedHostname)
+		case "acmeDirectoryUrl":
+				err = unpopulate(val, "AcmeDirectoryURL", &g.AcmeDirectoryURL)
 			delete(rawMsg, key)
-		case "prefix":
-				err = unpopulate(val, "Prefix", &g.Prefix)
+		case "email":
+				err = unpopulate(val, "Email", &g.Email)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &g.Kind)
+			delete(rawMsg, key)
+		case "renewBefore":
+				err = unpopulate(val, "RenewBefore", &g.RenewBefore)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayHostname.
+func (g GatewayHostname) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "fullyQualifiedHostname", g.FullyQualifiedHostname)
+	populate(objectMap, "prefix", g.Prefix)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayHostname.
+func (g *GatewayHostname) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "fullyQualifiedHostname":
+				err = unpopulate(val, "FullyQualifiedHostname", &g.FullyQualifiedHostname)
+			delete(rawMsg, key)
+		case "prefix":
+				err = unpopulate(val, "Prefix", &g.Prefix)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -2089,8 +2444,16 @@ func (g *GatewayResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 func (g GatewayRoute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "destinations", g.Destinations)
+	populate(objectMap, "headers", g.Headers)
+	populate(objectMap, "methods", g.Methods)
 	populate(objectMap, "path", g.Path)
+	populate(objectMap, "queryParameters", g.QueryParameters)
 	populate(objectMap, "replacePrefix", g.ReplacePrefix)
+	populate(objectMap, "requestHeaders", g.RequestHeaders)
+	populate(objectMap, "responseHeaders", g.ResponseHeaders)
+	populate(objectMap, "retryPolicy", g.RetryPolicy)
+	populate(objectMap, "timeoutPolicy", g.TimeoutPolicy)
 	return json.Marshal(objectMap)
 }
 
@@ -2106,12 +2469,242 @@ func (g *GatewayRoute) UnmarshalJSON(data []byte) error {
 		case "destination":
 				err = unpopulate(val, "Destination", &g.Destination)
 			delete(rawMsg, key)
+		case "destinations":
+				err = unpopulate(val, "Destinations", &g.Destinations)
+			delete(rawMsg, key)
+		case "headers":
+				err = unpopulate(val, "Headers", &g.Headers)
+			delete(rawMsg, key)
+		case "methods":
+				err = unpopulate(val, "Methods", &g.Methods)
+			delete(rawMsg, key)
 		case "path":
 				err = unpopulate(val, "Path", &g.Path)
 			delete(rawMsg, key)
+		case "queryParameters":
+				err = unpopulate(val, "QueryParameters", &g.QueryParameters)
+			delete(rawMsg, key)
 		case "replacePrefix":
 				err = unpopulate(val, "ReplacePrefix", &g.ReplacePrefix)
 			delete(rawMsg, key)
+		case "requestHeaders":
+				err = unpopulate(val, "RequestHeaders", &g.RequestHeaders)
+			delete(rawMsg, key)
+		case "responseHeaders":
+				err = unpopulate(val, "ResponseHeaders", &g.ResponseHeaders)
+			delete(rawMsg, key)
+		case "retryPolicy":
+				err = unpopulate(val, "RetryPolicy", &g.RetryPolicy)
+			delete(rawMsg, key)
+		case "timeoutPolicy":
+				err = unpopulate(val, "TimeoutPolicy", &g.TimeoutPolicy)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteDestination.
+func (g GatewayRouteDestination) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "weight", g.Weight)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteDestination.
+func (g *GatewayRouteDestination) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "destination":
+				err = unpopulate(val, "Destination", &g.Destination)
+			delete(rawMsg, key)
+		case "weight":
+				err = unpopulate(val, "Weight", &g.Weight)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteHeaderMatch.
+func (g GatewayRouteHeaderMatch) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "contains", g.Contains)
+	populate(objectMap, "exact", g.Exact)
+	populate(objectMap, "name", g.Name)
+	populate(objectMap, "present", g.Present)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteHeaderMatch.
+func (g *GatewayRouteHeaderMatch) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "contains":
+				err = unpopulate(val, "Contains", &g.Contains)
+			delete(rawMsg, key)
+		case "exact":
+				err = unpopulate(val, "Exact", &g.Exact)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &g.Name)
+			delete(rawMsg, key)
+		case "present":
+				err = unpopulate(val, "Present", &g.Present)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteHeaderPolicy.
+func (g GatewayRouteHeaderPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "remove", g.Remove)
+	populate(objectMap, "set", g.Set)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteHeaderPolicy.
+func (g *GatewayRouteHeaderPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "remove":
+				err = unpopulate(val, "Remove", &g.Remove)
+			delete(rawMsg, key)
+		case "set":
+				err = unpopulate(val, "Set", &g.Set)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteQueryParameterMatch.
+func (g GatewayRouteQueryParameterMatch) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "exact", g.Exact)
+	populate(objectMap, "name", g.Name)
+	populate(objectMap, "prefix", g.Prefix)
+	populate(objectMap, "present", g.Present)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteQueryParameterMatch.
+func (g *GatewayRouteQueryParameterMatch) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "exact":
+				err = unpopulate(val, "Exact", &g.Exact)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &g.Name)
+			delete(rawMsg, key)
+		case "prefix":
+				err = unpopulate(val, "Prefix", &g.Prefix)
+			delete(rawMsg, key)
+		case "present":
+				err = unpopulate(val, "Present", &g.Present)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteRetryPolicy.
+func (g GatewayRouteRetryPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "count", g.Count)
+	populate(objectMap, "perTryTimeout", g.PerTryTimeout)
+	populate(objectMap, "retryOn", g.RetryOn)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteRetryPolicy.
+func (g *GatewayRouteRetryPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "count":
+				err = unpopulate(val, "Count", &g.Count)
+			delete(rawMsg, key)
+		case "perTryTimeout":
+				err = unpopulate(val, "PerTryTimeout", &g.PerTryTimeout)
+			delete(rawMsg, key)
+		case "retryOn":
+				err = unpopulate(val, "RetryOn", &g.RetryOn)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteTimeoutPolicy.
+func (g GatewayRouteTimeoutPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "idle", g.Idle)
+	populate(objectMap, "response", g.Response)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteTimeoutPolicy.
+func (g *GatewayRouteTimeoutPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "idle":
+				err = unpopulate(val, "Idle", &g.Idle)
+			delete(rawMsg, key)
+		case "response":
+				err = unpopulate(val, "Response", &g.Response)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", g, err)
@@ -2124,6 +2717,7 @@ func (g *GatewayRoute) UnmarshalJSON(data []byte) error {
 func (g GatewayTLS) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "certificateFrom", g.CertificateFrom)
+	populate(objectMap, "certificateIssuer", g.CertificateIssuer)
 	populate(objectMap, "minimumProtocolVersion", g.MinimumProtocolVersion)
 	populate(objectMap, "sslPassthrough", g.SSLPassthrough)
 	return json.Marshal(objectMap)
@@ -2141,6 +2735,9 @@ func (g *GatewayTLS) UnmarshalJSON(data []byte) error {
 		case "certificateFrom":
 				err = unpopulate(val, "CertificateFrom", &g.CertificateFrom)
 			delete(rawMsg, key)
+		case "certificateIssuer":
+				err = unpopulate(val, "CertificateIssuer", &g.CertificateIssuer)
+			delete(rawMsg, key)
 		case "minimumProtocolVersion":
 				err = unpopulate(val, "MinimumProtocolVersion", &g.MinimumProtocolVersion)
 			delete(rawMsg, key)
@@ -2592,6 +3189,269 @@ func (i *IdentitySettingsUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type JobProperties.
+func (j JobProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "activeDeadlineSeconds", j.ActiveDeadlineSeconds)
+	populate(objectMap, "application", j.Application)
+	populate(objectMap, "backoffLimit", j.BackoffLimit)
+	populate(objectMap, "concurrencyPolicy", j.ConcurrencyPolicy)
+	populate(objectMap, "connections", j.Connections)
+	populate(objectMap, "container", j.Container)
+	populate(objectMap, "environment", j.Environment)
+	populate(objectMap, "extensions", j.Extensions)
+	populate(objectMap, "identity", j.Identity)
+	populate(objectMap, "provisioningState", j.ProvisioningState)
+	populate(objectMap, "restartPolicy", j.RestartPolicy)
+	populate(objectMap, "runtimes", j.Runtimes)
+	populate(objectMap, "schedule", j.Schedule)
+	populate(objectMap, "status", j.Status)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type JobProperties.
+func (j *JobProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", j, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "activeDeadlineSeconds":
+				err = unpopulate(val, "ActiveDeadlineSeconds", &j.ActiveDeadlineSeconds)
+			delete(rawMsg, key)
+		case "application":
+				err = unpopulate(val, "Application", &j.Application)
+			delete(rawMsg, key)
+		case "backoffLimit":
+				err = unpopulate(val, "BackoffLimit", &j.BackoffLimit)
+			delete(rawMsg, key)
+		case "concurrencyPolicy":
+				err = unpopulate(val, "ConcurrencyPolicy", &j.ConcurrencyPolicy)
+			delete(rawMsg, key)
+		case "connections":
+				err = unpopulate(val, "Connections", &j.Connections)
+			delete(rawMsg, key)
+		case "container":
+				err = unpopulate(val, "Container", &j.Container)
+			delete(rawMsg, key)
+		case "environment":
+				err = unpopulate(val, "Environment", &j.Environment)
+			delete(rawMsg, key)
+		case "extensions":
+			j.Extensions, err = unmarshalExtensionClassificationArray(val)
+			delete(rawMsg, key)
+		case "identity":
+				err = unpopulate(val, "Identity", &j.Identity)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &j.ProvisioningState)
+			delete(rawMsg, key)
+		case "restartPolicy":
+				err = unpopulate(val, "RestartPolicy", &j.RestartPolicy)
+			delete(rawMsg, key)
+		case "runtimes":
+				err = unpopulate(val, "Runtimes", &j.Runtimes)
+			delete(rawMsg, key)
+		case "schedule":
+				err = unpopulate(val, "Schedule", &j.Schedule)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &j.Status)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", j, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type JobResource.
+func (j JobResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "id", j.ID)
+	populate(objectMap, "location", j.Location)
+	populate(objectMap, "name", j.Name)
+	populate(objectMap, "properties", j.Properties)
+	populate(objectMap, "systemData", j.SystemData)
+	populate(objectMap, "tags", j.Tags)
+	populate(objectMap, "type", j.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type JobResource.
+func (j *JobResource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", j, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "id":
+				err = unpopulate(val, "ID", &j.ID)
+			delete(rawMsg, key)
+		case "location":
+				err = unpopulate(val, "Location", &j.Location)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &j.Name)
+			delete(rawMsg, key)
+		case "properties":
+				err = unpopulate(val, "Properties", &j.Properties)
+			delete(rawMsg, key)
+		case "systemData":
+				err = unpopulate(val, "SystemData", &j.SystemData)
+			delete(rawMsg, key)
+		case "tags":
+				err = unpopulate(val, "Tags", &j.Tags)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &j.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", j, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type JobResourceListResult.
+func (j JobResourceListResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "nextLink", j.NextLink)
+	populate(objectMap, "value", j.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type JobResourceListResult.
+func (j *JobResourceListResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", j, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "nextLink":
+				err = unpopulate(val, "NextLink", &j.NextLink)
+			delete(rawMsg, key)
+		case "value":
+				err = unpopulate(val, "Value", &j.Value)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", j, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type JobResourceUpdate.
+func (j JobResourceUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "properties", j.Properties)
+	populate(objectMap, "tags", j.Tags)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type JobResourceUpdate.
+func (j *JobResourceUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", j, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "properties":
+				err = unpopulate(val, "Properties", &j.Properties)
+			delete(rawMsg, key)
+		case "tags":
+				err = unpopulate(val, "Tags", &j.Tags)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", j, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type JobResourceUpdateProperties.
+func (j JobResourceUpdateProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "activeDeadlineSeconds", j.ActiveDeadlineSeconds)
+	populate(objectMap, "application", j.Application)
+	populate(objectMap, "backoffLimit", j.BackoffLimit)
+	populate(objectMap, "concurrencyPolicy", j.ConcurrencyPolicy)
+	populate(objectMap, "connections", j.Connections)
+	populate(objectMap, "container", j.Container)
+	populate(objectMap, "environment", j.Environment)
+	populate(objectMap, "extensions", j.Extensions)
+	populate(objectMap, "identity", j.Identity)
+	populate(objectMap, "restartPolicy", j.RestartPolicy)
+	populate(objectMap, "runtimes", j.Runtimes)
+	populate(objectMap, "schedule", j.Schedule)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type JobResourceUpdateProperties.
+func (j *JobResourceUpdateProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", j, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "activeDeadlineSeconds":
+				err = unpopulate(val, "ActiveDeadlineSeconds", &j.ActiveDeadlineSeconds)
+			delete(rawMsg, key)
+		case "application":
+				err = unpopulate(val, "Application", &j.Application)
+			delete(rawMsg, key)
+		case "backoffLimit":
+				err = unpopulate(val, "BackoffLimit", &j.BackoffLimit)
+			delete(rawMsg, key)
+		case "concurrencyPolicy":
+				err = unpopulate(val, "ConcurrencyPolicy", &j.ConcurrencyPolicy)
+			delete(rawMsg, key)
+		case "connections":
+				err = unpopulate(val, "Connections", &j.Connections)
+			delete(rawMsg, key)
+		case "container":
+				err = unpopulate(val, "Container", &j.Container)
+			delete(rawMsg, key)
+		case "environment":
+				err = unpopulate(val, "Environment", &j.Environment)
+			delete(rawMsg, key)
+		case "extensions":
+			j.Extensions, err = unmarshalExtensionClassificationArray(val)
+			delete(rawMsg, key)
+		case "identity":
+				err = unpopulate(val, "Identity", &j.Identity)
+			delete(rawMsg, key)
+		case "restartPolicy":
+				err = unpopulate(val, "RestartPolicy", &j.RestartPolicy)
+			delete(rawMsg, key)
+		case "runtimes":
+				err = unpopulate(val, "Runtimes", &j.Runtimes)
+			delete(rawMsg, key)
+		case "schedule":
+				err = unpopulate(val, "Schedule", &j.Schedule)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", j, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type KeyObjectProperties.
 func (k KeyObjectProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3020,6 +3880,65 @@ func (p *PersistentVolume) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type PersistentVolumeClaimVolumeProperties.
+func (p PersistentVolumeClaimVolumeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "accessMode", p.AccessMode)
+	populate(objectMap, "application", p.Application)
+	populate(objectMap, "environment", p.Environment)
+	objectMap["kind"] = "kubernetes.persistentVolumeClaim"
+	populate(objectMap, "provisioningState", p.ProvisioningState)
+	populate(objectMap, "retentionPolicy", p.RetentionPolicy)
+	populate(objectMap, "size", p.Size)
+	populate(objectMap, "status", p.Status)
+	populate(objectMap, "storageClassName", p.StorageClassName)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type PersistentVolumeClaimVolumeProperties.
+func (p *PersistentVolumeClaimVolumeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", p, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "accessMode":
+				err = unpopulate(val, "AccessMode", &p.AccessMode)
+			delete(rawMsg, key)
+		case "application":
+				err = unpopulate(val, "Application", &p.Application)
+			delete(rawMsg, key)
+		case "environment":
+				err = unpopulate(val, "Environment", &p.Environment)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &p.Kind)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &p.ProvisioningState)
+			delete(rawMsg, key)
+		case "retentionPolicy":
+				err = unpopulate(val, "RetentionPolicy", &p.RetentionPolicy)
+			delete(rawMsg, key)
+		case "size":
+				err = unpopulate(val, "Size", &p.Size)
+			delete(rawMsg, key)
+		case "status":
+				err = unpopulate(val, "Status", &p.Status)
+			delete(rawMsg, key)
+		case "storageClassName":
+				err = unpopulate(val, "StorageClassName", &p.StorageClassName)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", p, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type Providers.
 func (p Providers) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
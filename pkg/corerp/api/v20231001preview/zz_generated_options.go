@@ -7,6 +7,12 @@
 
 package v20231001preview
 
+// ApplicationsClientBeginRollbackOptions contains the optional parameters for the ApplicationsClient.BeginRollback method.
+type ApplicationsClientBeginRollbackOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // ApplicationsClientCreateOrUpdateOptions contains the optional parameters for the ApplicationsClient.CreateOrUpdate method.
 type ApplicationsClientCreateOrUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -32,6 +38,11 @@ type ApplicationsClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ApplicationsClientListRevisionsOptions contains the optional parameters for the ApplicationsClient.ListRevisions method.
+type ApplicationsClientListRevisionsOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ApplicationsClientUpdateOptions contains the optional parameters for the ApplicationsClient.Update method.
 type ApplicationsClientUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -187,6 +198,34 @@ type HTTPRoutesClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// JobsClientBeginCreateOrUpdateOptions contains the optional parameters for the JobsClient.BeginCreateOrUpdate method.
+type JobsClientBeginCreateOrUpdateOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// JobsClientBeginDeleteOptions contains the optional parameters for the JobsClient.BeginDelete method.
+type JobsClientBeginDeleteOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// JobsClientBeginUpdateOptions contains the optional parameters for the JobsClient.BeginUpdate method.
+type JobsClientBeginUpdateOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// JobsClientGetOptions contains the optional parameters for the JobsClient.Get method.
+type JobsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// JobsClientListByScopeOptions contains the optional parameters for the JobsClient.NewListByScopePager method.
+type JobsClientListByScopeOptions struct {
+	// placeholder for future optional parameters
+}
+
 // OperationsClientListOptions contains the optional parameters for the OperationsClient.NewListPager method.
 type OperationsClientListOptions struct {
 	// placeholder for future optional parameters
@@ -260,6 +260,12 @@ func unmarshalVolumePropertiesClassification(rawMsg json.RawMessage) (VolumeProp
 	switch m["kind"] {
 	case "azure.com.keyvault":
 		b = &AzureKeyVaultVolumeProperties{}
+	case "kubernetes.configMap":
+		b = &ConfigMapVolumeProperties{}
+	case "kubernetes.emptyDir":
+		b = &EmptyDirVolumeProperties{}
+	case "kubernetes.persistentVolumeClaim":
+		b = &PersistentVolumeClaimVolumeProperties{}
 	default:
 		b = &VolumeProperties{}
 	}
@@ -36,6 +36,17 @@ type ApplicationsClientListByScopeResponse struct {
 	ApplicationResourceListResult
 }
 
+// ApplicationsClientListRevisionsResponse contains the response from method ApplicationsClient.ListRevisions.
+type ApplicationsClientListRevisionsResponse struct {
+	// The list of revisions of an application, newest first.
+	ApplicationRevisionList
+}
+
+// ApplicationsClientRollbackResponse contains the response from method ApplicationsClient.BeginRollback.
+type ApplicationsClientRollbackResponse struct {
+	// placeholder for future response values
+}
+
 // ApplicationsClientUpdateResponse contains the response from method ApplicationsClient.Update.
 type ApplicationsClientUpdateResponse struct {
 	// Radius Application resource
@@ -199,6 +210,35 @@ type HTTPRoutesClientUpdateResponse struct {
 	HTTPRouteResource
 }
 
+// JobsClientCreateOrUpdateResponse contains the response from method JobsClient.BeginCreateOrUpdate.
+type JobsClientCreateOrUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	JobResource
+}
+
+// JobsClientDeleteResponse contains the response from method JobsClient.BeginDelete.
+type JobsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// JobsClientGetResponse contains the response from method JobsClient.Get.
+type JobsClientGetResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	JobResource
+}
+
+// JobsClientListByScopeResponse contains the response from method JobsClient.NewListByScopePager.
+type JobsClientListByScopeResponse struct {
+	// The response of a JobResource list operation.
+	JobResourceListResult
+}
+
+// JobsClientUpdateResponse contains the response from method JobsClient.BeginUpdate.
+type JobsClientUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	JobResource
+}
+
 // OperationsClientListResponse contains the response from method OperationsClient.NewListPager.
 type OperationsClientListResponse struct {
 	// A list of REST API operations supported by an Azure Resource Provider. It contains an URL link to get the next set of results.
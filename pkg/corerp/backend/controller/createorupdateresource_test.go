@@ -33,6 +33,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers/container"
 	"github.com/radius-project/radius/pkg/corerp/renderers/gateway"
 	"github.com/radius-project/radius/pkg/corerp/renderers/httproute"
+	"github.com/radius-project/radius/pkg/corerp/renderers/job"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -100,6 +101,18 @@ func TestCreateOrUpdateResourceRun_20231001Preview(t *testing.T) {
 			nil,
 			errors.New("error getting object"),
 		},
+		{
+			"job-put-success",
+			job.ResourceType,
+			"APPLICATIONS.CORE/JOBS|PUT",
+			fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/jobs/%s", uuid.NewString()),
+			nil,
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+		},
 		{
 			"http-route-put-success",
 			httproute.ResourceType,
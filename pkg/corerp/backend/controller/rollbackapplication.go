@@ -0,0 +1,299 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	app_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/applications"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// rollbackPollFrequency is the polling interval for the resource operations of a rollback.
+	rollbackPollFrequency = time.Second * 5
+)
+
+var _ ctrl.Controller = (*RollbackApplication)(nil)
+
+// RollbackApplication is the async operation controller to roll back an Applications.Core/applications resource
+// to a previous revision.
+type RollbackApplication struct {
+	ctrl.BaseController
+	connection sdk.Connection
+}
+
+// NewRollbackApplication creates a new RollbackApplication controller.
+func NewRollbackApplication(opts ctrl.Options, connection sdk.Connection) (ctrl.Controller, error) {
+	return &RollbackApplication{ctrl.NewBaseAsyncController(opts), connection}, nil
+}
+
+// Run re-applies the resources recorded in the revision created by the rollback request. The resources of the
+// application that are not part of the revision are deleted if the rollback request asked for it, otherwise they are
+// kept and added to the revision. The revision is marked Succeeded or Failed once the rollback completes.
+//
+// Recipes are run with the recipes currently registered in the environment. The recipes recorded in the revision
+// are kept to show which recipe provisioned each resource. Revisions don't record secrets, so the current secrets of
+// the resources are applied again.
+func (c *RollbackApplication) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	applicationID, err := resources.ParseResource(request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	revision, err := app_ctrl.FindRevisionByOperation(ctx, c.StorageClient(), applicationID, request.OperationID.String())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if revision == nil {
+		return ctrl.NewFailedResult(v1.ErrorDetails{Message: fmt.Sprintf("the revision for operation %q was not found", request.OperationID.String())}), nil
+	}
+
+	err = c.rollback(ctx, applicationID, revision)
+	if err != nil {
+		revision.ProvisioningState = v1.ProvisioningStateFailed
+		if saveErr := app_ctrl.SaveRevision(ctx, c.StorageClient(), revision); saveErr != nil {
+			return ctrl.Result{}, saveErr
+		}
+		return ctrl.NewFailedResult(v1.ErrorDetails{Message: err.Error()}), nil
+	}
+
+	revision.ProvisioningState = v1.ProvisioningStateSucceeded
+	if err := app_ctrl.SaveRevision(ctx, c.StorageClient(), revision); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (c *RollbackApplication) rollback(ctx context.Context, applicationID resources.ID, revision *datamodel.ApplicationRevision) error {
+	obj, err := c.StorageClient().Get(ctx, applicationID.String())
+	if err != nil {
+		return err
+	}
+	application := &datamodel.Application{}
+	if err := obj.As(application); err != nil {
+		return err
+	}
+
+	clientOptions := sdk.NewClientOptions(c.connection)
+	current, err := app_ctrl.ListAllResourcesByApplication(ctx, applicationID, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	currentByID := map[string]generated.GenericResource{}
+	for _, resource := range current {
+		currentByID[strings.ToLower(to.String(resource.ID))] = resource
+	}
+
+	// The application resource itself is not part of the rollback. The frontend controller rejects the rollback
+	// if its properties changed.
+	desired := []datamodel.ApplicationRevisionResource{}
+	desiredIDs := map[string]bool{}
+	for _, resource := range revision.Resources {
+		if strings.EqualFold(resource.ID, applicationID.String()) {
+			continue
+		}
+		desired = append(desired, resource)
+		desiredIDs[strings.ToLower(resource.ID)] = true
+	}
+
+	for _, resource := range orderRevisionResources(desired) {
+		location := application.Location
+		if existing, ok := currentByID[strings.ToLower(resource.ID)]; ok {
+			if app_ctrl.SameRevisionResource(app_ctrl.SnapshotResource(existing), resource) {
+				continue
+			}
+			location = to.String(existing.Location)
+		}
+
+		if err := putResource(ctx, resource, location, clientOptions); err != nil {
+			return fmt.Errorf("failed to apply resource %q: %w", resource.ID, err)
+		}
+	}
+
+	removed := []datamodel.ApplicationRevisionResource{}
+	for _, resource := range current {
+		if !desiredIDs[strings.ToLower(to.String(resource.ID))] {
+			removed = append(removed, app_ctrl.SnapshotResource(resource))
+		}
+	}
+
+	if !revision.DeleteResources {
+		revision.Resources = append(revision.Resources, removed...)
+		return nil
+	}
+
+	// Resources are deleted in the reverse order so that a resource is deleted before the resources it depends on.
+	ordered := orderRevisionResources(removed)
+	for i := len(ordered) - 1; i >= 0; i-- {
+		if err := deleteResource(ctx, ordered[i], clientOptions); err != nil {
+			return fmt.Errorf("failed to delete resource %q: %w", ordered[i].ID, err)
+		}
+	}
+
+	return nil
+}
+
+func putResource(ctx context.Context, resource datamodel.ApplicationRevisionResource, location string, clientOptions *policy.ClientOptions) error {
+	id, err := resources.ParseResource(resource.ID)
+	if err != nil {
+		return err
+	}
+
+	// Revisions don't record the secrets of the resources, so their current values are applied again.
+	properties, err := app_ctrl.WithSecrets(ctx, resource, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	client, err := generated.NewGenericResourcesClient(id.RootScope(), id.Type(), &aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	poller, err := client.BeginCreateOrUpdate(ctx, id.Name(), generated.GenericResource{Location: to.Ptr(location), Properties: properties}, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: rollbackPollFrequency})
+	return err
+}
+
+func deleteResource(ctx context.Context, resource datamodel.ApplicationRevisionResource, clientOptions *policy.ClientOptions) error {
+	id, err := resources.ParseResource(resource.ID)
+	if err != nil {
+		return err
+	}
+
+	client, err := generated.NewGenericResourcesClient(id.RootScope(), id.Type(), &aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	poller, err := client.BeginDelete(ctx, id.Name(), nil)
+	if err != nil {
+		if clients.Is404Error(err) {
+			// If the resource that we want to delete doesn't exist, we don't need to delete it.
+			return nil
+		}
+		return err
+	}
+
+	_, err = poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: rollbackPollFrequency})
+	if err != nil && !clients.Is404Error(err) {
+		return err
+	}
+
+	return nil
+}
+
+// orderRevisionResources orders the resources so that each resource comes after the resources it references.
+// Resources that are part of a cycle keep their original relative order.
+func orderRevisionResources(input []datamodel.ApplicationRevisionResource) []datamodel.ApplicationRevisionResource {
+	sorted := make([]datamodel.ApplicationRevisionResource, len(input))
+	copy(sorted, input)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i].ID) < strings.ToLower(sorted[j].ID)
+	})
+
+	ids := map[string]bool{}
+	for _, resource := range sorted {
+		ids[strings.ToLower(resource.ID)] = true
+	}
+
+	dependencies := map[string]map[string]bool{}
+	for _, resource := range sorted {
+		id := strings.ToLower(resource.ID)
+		dependencies[id] = map[string]bool{}
+		collectReferences(resource.Properties, func(value string) {
+			value = strings.ToLower(value)
+			if value != id && ids[value] {
+				dependencies[id][value] = true
+			}
+		})
+	}
+
+	result := []datamodel.ApplicationRevisionResource{}
+	done := map[string]bool{}
+	for len(result) < len(sorted) {
+		progress := false
+		for _, resource := range sorted {
+			id := strings.ToLower(resource.ID)
+			if done[id] || !allDone(dependencies[id], done) {
+				continue
+			}
+			result = append(result, resource)
+			done[id] = true
+			progress = true
+		}
+
+		if !progress {
+			// There is a cycle: add the remaining resources in their original order.
+			for _, resource := range sorted {
+				if id := strings.ToLower(resource.ID); !done[id] {
+					result = append(result, resource)
+					done[id] = true
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func allDone(dependencies map[string]bool, done map[string]bool) bool {
+	for dependency := range dependencies {
+		if !done[dependency] {
+			return false
+		}
+	}
+	return true
+}
+
+// collectReferences calls fn for every string value in v.
+func collectReferences(v any, fn func(string)) {
+	switch value := v.(type) {
+	case string:
+		fn(value)
+	case map[string]any:
+		for _, item := range value {
+			collectReferences(item, fn)
+		}
+	case []any:
+		for _, item := range value {
+			collectReferences(item, fn)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRollbackApplicationID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/myapp"
+	testRollbackScope         = "/planes/radius/local/resourceGroups/test-group"
+)
+
+// fakeUCP lists the current resources of the application and their secrets, and records the resources that are
+// applied or deleted.
+type fakeUCP struct {
+	mutex     sync.Mutex
+	resources []map[string]any
+	secrets   map[string]map[string]any
+	applied   []string
+	bodies    map[string]map[string]any
+	deleted   []string
+}
+
+func (f *fakeUCP) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.Method {
+	case http.MethodGet:
+		value := []map[string]any{}
+		for _, resource := range f.resources {
+			if strings.HasSuffix(strings.ToLower(r.URL.Path), strings.ToLower("/providers/"+resource["type"].(string))) {
+				value = append(value, resource)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"value": value})
+	case http.MethodPost:
+		secrets := f.secrets[strings.TrimSuffix(r.URL.Path, "/listSecrets")]
+		if secrets == nil {
+			secrets = map[string]any{}
+		}
+		_ = json.NewEncoder(w).Encode(secrets)
+	case http.MethodPut:
+		f.applied = append(f.applied, r.URL.Path)
+		body := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if f.bodies == nil {
+			f.bodies = map[string]map[string]any{}
+		}
+		f.bodies[r.URL.Path] = body
+		_ = json.NewEncoder(w).Encode(body)
+	case http.MethodDelete:
+		f.deleted = append(f.deleted, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func testRevisionResource(resourceType string, name string, properties map[string]any) datamodel.ApplicationRevisionResource {
+	properties["application"] = testRollbackApplicationID
+	return datamodel.ApplicationRevisionResource{
+		ID:         testRollbackScope + "/providers/" + resourceType + "/" + name,
+		Type:       resourceType,
+		Name:       name,
+		Properties: properties,
+	}
+}
+
+func testCurrentResource(resource datamodel.ApplicationRevisionResource) map[string]any {
+	properties := map[string]any{"provisioningState": "Succeeded"}
+	for k, v := range resource.Properties {
+		properties[k] = v
+	}
+	return map[string]any{
+		"id":         resource.ID,
+		"type":       resource.Type,
+		"name":       resource.Name,
+		"location":   "global",
+		"properties": properties,
+	}
+}
+
+func TestRollbackApplicationRun(t *testing.T) {
+	redis := testRevisionResource("Applications.Datastores/redisCaches", "redis", map[string]any{"resourceProvisioning": "manual", "host": "redis"})
+	frontend := testRevisionResource("Applications.Core/containers", "frontend", map[string]any{
+		"container":   map[string]any{"image": "frontend:1.0"},
+		"connections": map[string]any{"redis": map[string]any{"source": redis.ID}},
+	})
+	frontendV2 := testRevisionResource("Applications.Core/containers", "frontend", map[string]any{
+		"container": map[string]any{"image": "frontend:2.0"},
+	})
+	backend := testRevisionResource("Applications.Core/containers", "backend", map[string]any{
+		"container": map[string]any{"image": "backend:1.0"},
+	})
+
+	setup := func(t *testing.T, revision *datamodel.ApplicationRevision, current ...datamodel.ApplicationRevisionResource) (*fakeUCP, *store.MockStorageClient, ctrl.Controller, *ctrl.Request) {
+		mctrl := gomock.NewController(t)
+		msc := store.NewMockStorageClient(mctrl)
+
+		ucp := &fakeUCP{}
+		for _, resource := range current {
+			ucp.resources = append(ucp.resources, testCurrentResource(resource))
+		}
+		server := httptest.NewServer(ucp)
+		t.Cleanup(server.Close)
+
+		conn, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c, err := NewRollbackApplication(ctrl.Options{StorageClient: msc}, conn)
+		require.NoError(t, err)
+
+		req := &ctrl.Request{
+			OperationID:      uuid.New(),
+			OperationType:    "APPLICATIONS.CORE/APPLICATIONS|ACTIONROLLBACK",
+			ResourceID:       testRollbackApplicationID,
+			OperationTimeout: &ctrl.DefaultAsyncOperationTimeout,
+		}
+
+		items := []store.Object{}
+		if revision != nil {
+			revision.OperationID = req.OperationID.String()
+			items = append(items, store.Object{Data: revision})
+		}
+		msc.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query store.Query, _ ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+				require.Equal(t, []store.QueryFilter{{Field: "operationId", Value: req.OperationID.String()}}, query.Filters)
+				return &store.ObjectQueryResult{Items: items}, nil
+			})
+
+		return ucp, msc, c, req
+	}
+
+	expectApplicationAndRevisionSave := func(msc *store.MockStorageClient, state v1.ProvisioningState) *datamodel.ApplicationRevision {
+		saved := &datamodel.ApplicationRevision{}
+		msc.EXPECT().
+			Get(gomock.Any(), testRollbackApplicationID).
+			Return(&store.Object{Data: &datamodel.Application{}}, nil)
+		msc.EXPECT().
+			Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+				*saved = *obj.Data.(*datamodel.ApplicationRevision)
+				require.Equal(t, state, saved.ProvisioningState)
+				return nil
+			})
+		return saved
+	}
+
+	t.Run("revision not found", func(t *testing.T) {
+		_, _, c, req := setup(t, nil)
+
+		result, err := c.Run(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+	})
+
+	t.Run("applies changed resources and deletes removed resources", func(t *testing.T) {
+		revision := &datamodel.ApplicationRevision{
+			Revision:          3,
+			ProvisioningState: v1.ProvisioningStateAccepted,
+			DeleteResources:   true,
+			Resources: []datamodel.ApplicationRevisionResource{
+				{ID: testRollbackApplicationID, Type: "Applications.Core/applications", Name: "myapp", Properties: map[string]any{}},
+				frontend,
+				redis,
+			},
+		}
+		ucp, msc, c, req := setup(t, revision, frontendV2, redis, backend)
+		expectApplicationAndRevisionSave(msc, v1.ProvisioningStateSucceeded)
+
+		result, err := c.Run(context.Background(), req)
+		require.NoError(t, err)
+		require.Nil(t, result.Error)
+
+		// redis didn't change, so only the frontend container is applied.
+		require.Equal(t, []string{testRollbackScope + "/providers/Applications.Core/containers/frontend"}, ucp.applied)
+		require.Equal(t, []string{testRollbackScope + "/providers/Applications.Core/containers/backend"}, ucp.deleted)
+	})
+
+	t.Run("keeps removed resources by default", func(t *testing.T) {
+		revision := &datamodel.ApplicationRevision{
+			Revision:          3,
+			ProvisioningState: v1.ProvisioningStateAccepted,
+			Resources:         []datamodel.ApplicationRevisionResource{frontend, redis},
+		}
+		ucp, msc, c, req := setup(t, revision, frontendV2, redis, backend)
+		saved := expectApplicationAndRevisionSave(msc, v1.ProvisioningStateSucceeded)
+
+		result, err := c.Run(context.Background(), req)
+		require.NoError(t, err)
+		require.Nil(t, result.Error)
+
+		require.Equal(t, []string{testRollbackScope + "/providers/Applications.Core/containers/frontend"}, ucp.applied)
+		require.Empty(t, ucp.deleted)
+
+		// The kept resource is added to the revision.
+		require.Len(t, saved.Resources, 3)
+		require.Equal(t, backend.ID, saved.Resources[2].ID)
+	})
+
+	t.Run("applies the current secrets of the resources", func(t *testing.T) {
+		redisV1 := testRevisionResource("Applications.Datastores/redisCaches", "redis", map[string]any{
+			"resourceProvisioning": "manual",
+			"host":                 "redis-v1",
+		})
+		revision := &datamodel.ApplicationRevision{
+			Revision:          2,
+			ProvisioningState: v1.ProvisioningStateAccepted,
+			Resources:         []datamodel.ApplicationRevisionResource{redisV1},
+		}
+		ucp, msc, c, req := setup(t, revision, redis)
+		ucp.secrets = map[string]map[string]any{redis.ID: {"password": "current"}}
+		expectApplicationAndRevisionSave(msc, v1.ProvisioningStateSucceeded)
+
+		result, err := c.Run(context.Background(), req)
+		require.NoError(t, err)
+		require.Nil(t, result.Error)
+
+		require.Equal(t, []string{redis.ID}, ucp.applied)
+		properties := ucp.bodies[redis.ID]["properties"].(map[string]any)
+		require.Equal(t, "redis-v1", properties["host"])
+		require.Equal(t, map[string]any{"password": "current"}, properties["secrets"])
+
+		// The secrets are not recorded in the revision.
+		require.NotContains(t, revision.Resources[0].Properties, "secrets")
+	})
+
+	t.Run("does not apply resources that did not change", func(t *testing.T) {
+		revision := &datamodel.ApplicationRevision{
+			Revision:          2,
+			ProvisioningState: v1.ProvisioningStateAccepted,
+			Resources:         []datamodel.ApplicationRevisionResource{redis},
+		}
+		ucp, msc, c, req := setup(t, revision, redis)
+		ucp.secrets = map[string]map[string]any{redis.ID: {"password": "current"}}
+		expectApplicationAndRevisionSave(msc, v1.ProvisioningStateSucceeded)
+
+		result, err := c.Run(context.Background(), req)
+		require.NoError(t, err)
+		require.Nil(t, result.Error)
+		require.Empty(t, ucp.applied)
+	})
+
+	t.Run("recreates deleted resources in dependency order", func(t *testing.T) {
+		revision := &datamodel.ApplicationRevision{
+			Revision:          2,
+			ProvisioningState: v1.ProvisioningStateAccepted,
+			Resources:         []datamodel.ApplicationRevisionResource{frontend, redis},
+		}
+		ucp, msc, c, req := setup(t, revision)
+		expectApplicationAndRevisionSave(msc, v1.ProvisioningStateSucceeded)
+
+		result, err := c.Run(context.Background(), req)
+		require.NoError(t, err)
+		require.Nil(t, result.Error)
+
+		require.Equal(t, []string{
+			testRollbackScope + "/providers/Applications.Datastores/redisCaches/redis",
+			testRollbackScope + "/providers/Applications.Core/containers/frontend",
+		}, ucp.applied)
+		require.Empty(t, ucp.deleted)
+	})
+}
+
+func Test_orderRevisionResources(t *testing.T) {
+	a := datamodel.ApplicationRevisionResource{ID: "/planes/radius/local/providers/Test.Resources/a/a", Properties: map[string]any{"b": "/planes/radius/local/providers/Test.Resources/b/B"}}
+	b := datamodel.ApplicationRevisionResource{ID: "/planes/radius/local/providers/Test.Resources/b/b", Properties: map[string]any{"list": []any{"/planes/radius/local/providers/Test.Resources/c/c"}}}
+	c := datamodel.ApplicationRevisionResource{ID: "/planes/radius/local/providers/Test.Resources/c/c", Properties: map[string]any{}}
+
+	t.Run("dependencies first", func(t *testing.T) {
+		ordered := orderRevisionResources([]datamodel.ApplicationRevisionResource{a, b, c})
+		require.Equal(t, []string{c.ID, b.ID, a.ID}, []string{ordered[0].ID, ordered[1].ID, ordered[2].ID})
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		cyclic := c
+		cyclic.Properties = map[string]any{"a": a.ID}
+		ordered := orderRevisionResources([]datamodel.ApplicationRevisionResource{cyclic, b, a})
+		require.Len(t, ordered, 3)
+		require.Equal(t, []string{a.ID, b.ID, c.ID}, []string{ordered[0].ID, ordered[1].ID, ordered[2].ID})
+	})
+}
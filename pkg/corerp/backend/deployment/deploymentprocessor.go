@@ -296,8 +296,9 @@ func (dp *deploymentProcessor) Deploy(ctx context.Context, id resources.ID, rend
 
 		// Build database resource - copy updated properties to Resource field
 		outputResource := rpv1.OutputResource{
-			LocalID: outputResource.LocalID,
-			ID:      outputResource.ID,
+			LocalID:       outputResource.LocalID,
+			ID:            outputResource.ID,
+			RadiusManaged: outputResource.RadiusManaged,
 		}
 		deployedOutputResources = append(deployedOutputResources, outputResource)
 	}
@@ -332,6 +333,14 @@ func (dp *deploymentProcessor) Delete(ctx context.Context, id resources.ID, depl
 	// Loop over each output resource and delete in reverse dependency order - resource deployed last should be deleted first
 	for i := len(deployedOutputResources) - 1; i >= 0; i-- {
 		outputResource := deployedOutputResources[i]
+
+		// Output resources which are explicitly not managed by Radius (e.g. volumes with the Retain policy) outlive
+		// the Radius resource, so they are left in place.
+		if outputResource.RadiusManaged != nil && !*outputResource.RadiusManaged {
+			logger.Info(fmt.Sprintf("Skipping deletion of output resource not managed by Radius: LocalID: %s", outputResource.LocalID))
+			continue
+		}
+
 		resourceType := outputResource.GetResourceType()
 		outputResourceModel, err := dp.appmodel.LookupOutputResourceModel(resourceType)
 		if err != nil {
@@ -417,6 +426,13 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		envOpts.KubernetesMetadata = envExt.KubernetesMetadata
 	}
 
+	// Extract the gateway implementation. Contour is used unless the environment opts into the Gateway API.
+	envOpts.Gateway.Kind = corerp_dm.EnvironmentGatewayKindContour
+	if env.Properties.Gateway != nil && env.Properties.Gateway.Kind != "" {
+		envOpts.Gateway.Kind = env.Properties.Gateway.Kind
+		envOpts.Gateway.GatewayClassName = env.Properties.Gateway.GatewayClassName
+	}
+
 	if publicEndpointOverride != "" {
 		// Check if publicEndpointOverride contains a scheme,
 		// and if so, throw an error to the user
@@ -432,16 +448,16 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 			port = ""
 		}
 
-		envOpts.Gateway = renderers.GatewayOptions{
-			PublicEndpointOverride: true,
-			Hostname:               hostname,
-			Port:                   port,
-		}
+		envOpts.Gateway.PublicEndpointOverride = true
+		envOpts.Gateway.Hostname = hostname
+		envOpts.Gateway.Port = port
 
 		return envOpts, nil
 	}
 
-	if dp.k8sClient != nil {
+	// The public endpoint of a Gateway API gateway is only known once its Gateway has been programmed,
+	// so there is no cluster-wide endpoint to look up.
+	if dp.k8sClient != nil && envOpts.Gateway.Kind != corerp_dm.EnvironmentGatewayKindGatewayAPI {
 		// Find the public endpoint of the cluster (External IP or hostname of the contour-envoy service)
 		var services corev1.ServiceList
 		err := dp.k8sClient.List(ctx, &services, &controller_runtime.ListOptions{Namespace: "radius-system"})
@@ -452,11 +468,8 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		for _, service := range services.Items {
 			if service.Name == "contour-envoy" {
 				for _, in := range service.Status.LoadBalancer.Ingress {
-					envOpts.Gateway = renderers.GatewayOptions{
-						PublicEndpointOverride: false,
-						Hostname:               in.Hostname,
-						ExternalIP:             in.IP,
-					}
+					envOpts.Gateway.Hostname = in.Hostname
+					envOpts.Gateway.ExternalIP = in.IP
 					return envOpts, nil
 				}
 			}
@@ -502,6 +515,12 @@ func (dp *deploymentProcessor) getResourceDataByID(ctx context.Context, resource
 			return ResourceData{}, fmt.Errorf(errMsg, resourceID.String(), err)
 		}
 		return dp.buildResourceDependency(resourceID, obj.Properties.Application, obj, obj.Properties.Status.OutputResources, obj.ComputedValues, obj.SecretValues, portableresources.RecipeData{})
+	case strings.ToLower(corerp_dm.JobResourceType):
+		obj := &corerp_dm.JobResource{}
+		if err = resource.As(obj); err != nil {
+			return ResourceData{}, fmt.Errorf(errMsg, resourceID.String(), err)
+		}
+		return dp.buildResourceDependency(resourceID, obj.Properties.Application, obj, obj.Properties.Status.OutputResources, obj.ComputedValues, obj.SecretValues, portableresources.RecipeData{})
 	case strings.ToLower(corerp_dm.GatewayResourceType):
 		obj := &corerp_dm.Gateway{}
 		if err = resource.As(obj); err != nil {
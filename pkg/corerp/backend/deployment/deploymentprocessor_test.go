@@ -1097,6 +1097,21 @@ func Test_Delete(t *testing.T) {
 		err := dp.Delete(ctx, resourceID, testResource.Properties.Status.OutputResources)
 		require.NoError(t, err)
 	})
+
+	t.Run("Verify delete skips output resources not managed by Radius", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		mocks := setup(t)
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+
+		testResource := getTestResource()
+		resourceID := getTestResourceID(testResource.ID)
+		testResource.Properties.Status.OutputResources[0].RadiusManaged = to.Ptr(false)
+
+		mocks.resourceHandler.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(0).Return(nil)
+
+		err := dp.Delete(ctx, resourceID, testResource.Properties.Status.OutputResources)
+		require.NoError(t, err)
+	})
 }
 
 func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package revisions records the revisions of applications as their resources change.
+package revisions
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	app_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/applications"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ worker.OperationObserver = (*Recorder)(nil)
+
+// Recorder records a revision of an application when an asynchronous operation that creates, updates, or deletes one
+// of its resources completes.
+//
+// The revision is recorded from the state saved in the data store, so it doesn't depend on which worker runs the
+// operation. A deployment of an application is made of many independent resource operations: no revision is recorded
+// while the application or one of its resources is still being provisioned, because the operation that completes last
+// records the revision. If the application and its resources are the same as in the latest revision, no revision is
+// recorded.
+//
+// Changes made by synchronous operations, such as updating the application resource itself, are recorded with the next
+// asynchronous change of the application.
+type Recorder struct {
+	storageProvider dataprovider.DataStorageProvider
+	listResources   func(ctx context.Context, applicationID resources.ID) ([]generated.GenericResource, error)
+}
+
+// NewRecorder creates a Recorder that reads applications and revisions from the storage provider and lists the
+// resources of applications through the connection.
+func NewRecorder(storageProvider dataprovider.DataStorageProvider, connection sdk.Connection) *Recorder {
+	return &Recorder{
+		storageProvider: storageProvider,
+		listResources: func(ctx context.Context, applicationID resources.ID) ([]generated.GenericResource, error) {
+			return app_ctrl.ListAllResourcesByApplication(ctx, applicationID, sdk.NewClientOptions(connection))
+		},
+	}
+}
+
+// OperationCompleted implements worker.OperationObserver. It records a revision of the application of the resource
+// when an operation that creates, updates, or deletes the resource succeeds.
+func (r *Recorder) OperationCompleted(ctx context.Context, request *ctrl.Request, state v1.ProvisioningState) {
+	if state != v1.ProvisioningStateSucceeded {
+		return
+	}
+
+	operationType, ok := v1.ParseOperationType(request.OperationType)
+	if !ok || !changesResource(operationType.Method) {
+		return
+	}
+
+	id, err := resources.ParseResource(request.ResourceID)
+	if err != nil || strings.EqualFold(id.Type(), datamodel.ApplicationResourceType) {
+		return
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	applicationIDs, err := r.applicationsOf(ctx, id, operationType.Method)
+	if err != nil {
+		logger.Error(err, "failed to find the application of the resource", "resourceID", request.ResourceID)
+		return
+	}
+
+	for _, applicationID := range applicationIDs {
+		if err := r.record(ctx, applicationID); err != nil {
+			logger.Error(err, "failed to record application revision", "applicationID", applicationID)
+		}
+	}
+}
+
+// record records a revision of the application. No revision is recorded if the application, one of its resources, or
+// its latest revision is still being provisioned.
+func (r *Recorder) record(ctx context.Context, applicationID string) error {
+	id, err := resources.ParseResource(applicationID)
+	if err != nil {
+		return err
+	}
+
+	storageClient, err := r.storageProvider.GetStorageClient(ctx, datamodel.ApplicationResourceType)
+	if err != nil {
+		return err
+	}
+
+	application, err := store.GetResource[datamodel.Application](ctx, storageClient, id.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !application.ProvisioningState().IsTerminal() {
+		return nil
+	}
+
+	applicationResources, err := r.listResources(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, resource := range applicationResources {
+		state, _ := resource.Properties["provisioningState"].(string)
+		if !v1.ProvisioningState(state).IsTerminal() {
+			return nil
+		}
+	}
+
+	// A rollback in progress records its own revision.
+	existing, err := app_ctrl.ListApplicationRevisions(ctx, storageClient, id)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 && !existing[0].ProvisioningState.IsTerminal() {
+		return nil
+	}
+
+	revision, err := app_ctrl.RecordRevision(ctx, storageClient, application, applicationResources)
+	if err != nil {
+		return err
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info("recorded application revision", "applicationID", applicationID, "revision", revision.Revision)
+	return nil
+}
+
+// applicationsOf returns the ids of the applications whose revisions change with the resource.
+//
+// A deleted resource no longer records its application, so the applications are the ones whose latest revision
+// includes the resource. Revisions are stored in the resource group of their application, so only the applications in
+// the resource group of the resource are found.
+func (r *Recorder) applicationsOf(ctx context.Context, id resources.ID, method v1.OperationMethod) ([]string, error) {
+	if method != v1.OperationDelete {
+		storageClient, err := r.storageProvider.GetStorageClient(ctx, id.Type())
+		if err != nil {
+			return nil, err
+		}
+
+		resource, err := store.GetResource[struct {
+			Properties struct {
+				Application string `json:"application"`
+			} `json:"properties"`
+		}](ctx, storageClient, id.String())
+		if errors.Is(err, &store.ErrNotFound{}) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if resource.Properties.Application == "" {
+			return nil, nil
+		}
+		return []string{resource.Properties.Application}, nil
+	}
+
+	storageClient, err := r.storageProvider.GetStorageClient(ctx, datamodel.ApplicationResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := storageClient.Query(ctx, store.Query{RootScope: id.RootScope(), ResourceType: datamodel.ApplicationRevisionResourceType})
+	if err != nil {
+		return nil, err
+	}
+
+	latest := map[string]*datamodel.ApplicationRevision{}
+	for _, item := range result.Items {
+		revision := &datamodel.ApplicationRevision{}
+		if err := item.As(revision); err != nil {
+			return nil, err
+		}
+		if current, ok := latest[revision.Application]; !ok || revision.Revision > current.Revision {
+			latest[revision.Application] = revision
+		}
+	}
+
+	applicationIDs := []string{}
+	for applicationID, revision := range latest {
+		for _, resource := range revision.Resources {
+			if strings.EqualFold(resource.ID, id.String()) {
+				applicationIDs = append(applicationIDs, applicationID)
+				break
+			}
+		}
+	}
+
+	return applicationIDs, nil
+}
+
+func changesResource(method v1.OperationMethod) bool {
+	return method == v1.OperationPut || method == v1.OperationPatch || method == v1.OperationDelete
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revisions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testApplicationID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/myapp"
+	testContainerID   = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend"
+	testGatewayID     = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/gateway"
+)
+
+// setupRecorder returns a Recorder backed by a mock store that holds the application, a container of the application,
+// and the given revisions. The revisions saved by the Recorder are returned by the saved function.
+func setupRecorder(t *testing.T, revisions []*datamodel.ApplicationRevision, applicationResources ...generated.GenericResource) (*Recorder, func() []*datamodel.ApplicationRevision) {
+	mctrl := gomock.NewController(t)
+
+	application := &datamodel.Application{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   testApplicationID,
+				Name: "myapp",
+				Type: datamodel.ApplicationResourceType,
+			},
+			InternalMetadata: v1.InternalMetadata{
+				AsyncProvisioningState: v1.ProvisioningStateSucceeded,
+			},
+		},
+	}
+
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	mStorageClient.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			if strings.EqualFold(id, testApplicationID) {
+				return &store.Object{Metadata: store.Metadata{ID: id}, Data: application}, nil
+			}
+			return &store.Object{
+				Metadata: store.Metadata{ID: id},
+				Data:     map[string]any{"properties": map[string]any{"application": testApplicationID}},
+			}, nil
+		}).
+		AnyTimes()
+
+	items := []store.Object{}
+	for _, revision := range revisions {
+		items = append(items, store.Object{Metadata: store.Metadata{ID: revision.ID}, Data: revision})
+	}
+	mStorageClient.EXPECT().
+		Query(gomock.Any(), gomock.Any()).
+		Return(&store.ObjectQueryResult{Items: items}, nil).
+		AnyTimes()
+
+	saved := []*datamodel.ApplicationRevision{}
+	mStorageClient.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+			saved = append(saved, obj.Data.(*datamodel.ApplicationRevision))
+			return nil
+		}).
+		AnyTimes()
+
+	mStorageProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+	mStorageProvider.EXPECT().
+		GetStorageClient(gomock.Any(), gomock.Any()).
+		Return(mStorageClient, nil).
+		AnyTimes()
+
+	recorder := NewRecorder(mStorageProvider, nil)
+	recorder.listResources = func(ctx context.Context, applicationID resources.ID) ([]generated.GenericResource, error) {
+		require.True(t, strings.EqualFold(testApplicationID, applicationID.String()))
+		return applicationResources, nil
+	}
+	return recorder, func() []*datamodel.ApplicationRevision { return saved }
+}
+
+func container(state v1.ProvisioningState) generated.GenericResource {
+	return generated.GenericResource{
+		ID:   to.Ptr(testContainerID),
+		Name: to.Ptr("frontend"),
+		Type: to.Ptr("Applications.Core/containers"),
+		Properties: map[string]any{
+			"application":       testApplicationID,
+			"container":         map[string]any{"image": "nginx"},
+			"provisioningState": string(state),
+		},
+	}
+}
+
+func request(operationType string, resourceID string) *ctrl.Request {
+	return &ctrl.Request{OperationType: operationType, ResourceID: resourceID}
+}
+
+func Test_Recorder_RecordsRevision(t *testing.T) {
+	recorder, saved := setupRecorder(t, nil, container(v1.ProvisioningStateSucceeded))
+
+	recorder.OperationCompleted(context.Background(), request("APPLICATIONS.CORE/CONTAINERS|PUT", testContainerID), v1.ProvisioningStateSucceeded)
+
+	require.Len(t, saved(), 1)
+	revision := saved()[0]
+	require.Equal(t, int32(1), revision.Revision)
+	require.Equal(t, datamodel.ApplicationRevisionReasonDeployment, revision.Reason)
+	require.Len(t, revision.Resources, 2)
+	require.Equal(t, "myapp", revision.Resources[0].Name)
+	require.Equal(t, "frontend", revision.Resources[1].Name)
+}
+
+func Test_Recorder_WaitsForProvisioning(t *testing.T) {
+	// The operation of another resource of the application is still running. It records the revision when it completes.
+	recorder, saved := setupRecorder(t, nil, container(v1.ProvisioningStateUpdating))
+
+	recorder.OperationCompleted(context.Background(), request("APPLICATIONS.CORE/GATEWAYS|PUT", testGatewayID), v1.ProvisioningStateSucceeded)
+
+	require.Empty(t, saved())
+}
+
+func Test_Recorder_RecordsDelete(t *testing.T) {
+	latest := &datamodel.ApplicationRevision{
+		ID:                testApplicationID + "/revisions/1",
+		Application:       strings.ToLower(testApplicationID),
+		Revision:          1,
+		ProvisioningState: v1.ProvisioningStateSucceeded,
+		Resources: []datamodel.ApplicationRevisionResource{
+			{ID: testApplicationID, Type: datamodel.ApplicationResourceType, Name: "myapp", Properties: map[string]any{}},
+			{ID: testContainerID, Type: "Applications.Core/containers", Name: "frontend", Properties: map[string]any{"container": map[string]any{"image": "nginx"}}},
+		},
+	}
+	recorder, saved := setupRecorder(t, []*datamodel.ApplicationRevision{latest})
+
+	recorder.OperationCompleted(context.Background(), request("APPLICATIONS.CORE/CONTAINERS|DELETE", testContainerID), v1.ProvisioningStateSucceeded)
+
+	require.Len(t, saved(), 1)
+	revision := saved()[0]
+	require.Equal(t, int32(2), revision.Revision)
+	require.Len(t, revision.Resources, 1)
+	require.Equal(t, "myapp", revision.Resources[0].Name)
+}
+
+func Test_Recorder_IgnoresOperations(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *ctrl.Request
+		state   v1.ProvisioningState
+	}{
+		{
+			name:    "failed operation",
+			request: request("APPLICATIONS.CORE/CONTAINERS|PUT", testContainerID),
+			state:   v1.ProvisioningStateFailed,
+		},
+		{
+			name:    "rollback of the application",
+			request: request("APPLICATIONS.CORE/APPLICATIONS|ACTIONROLLBACK", testApplicationID),
+			state:   v1.ProvisioningStateSucceeded,
+		},
+		{
+			name:    "action on a resource",
+			request: request("APPLICATIONS.CORE/CONTAINERS|ACTIONLISTSECRETS", testContainerID),
+			state:   v1.ProvisioningStateSucceeded,
+		},
+		{
+			name:    "delete of a resource that is not in a revision",
+			request: request("APPLICATIONS.CORE/CONTAINERS|DELETE", testContainerID),
+			state:   v1.ProvisioningStateSucceeded,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder, saved := setupRecorder(t, nil, container(v1.ProvisioningStateSucceeded))
+
+			recorder.OperationCompleted(context.Background(), tc.request, tc.state)
+
+			require.Empty(t, saved())
+		})
+	}
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	// ChallengeTypeHTTP01 is the ACME HTTP-01 challenge type.
+	ChallengeTypeHTTP01 = "http-01"
+	// ChallengeTypeDNS01 is the ACME DNS-01 challenge type.
+	ChallengeTypeDNS01 = "dns-01"
+
+	// HTTP01ChallengePathPrefix is the path that the ACME server requests to validate an HTTP-01 challenge.
+	HTTP01ChallengePathPrefix = "/.well-known/acme-challenge/"
+
+	// ChallengeServiceNamespace is the namespace of the Service that serves HTTP-01 challenges.
+	ChallengeServiceNamespace = "radius-system"
+	// ChallengeServiceName is the name of the Service that serves HTTP-01 challenges.
+	ChallengeServiceName = "applications-rp"
+	// ChallengeServicePort is the port of the Service that serves HTTP-01 challenges.
+	ChallengeServicePort = 8080
+)
+
+// ChallengeSolver proves the control of a domain to an ACME server.
+type ChallengeSolver interface {
+	// ChallengeType returns the type of challenge that the solver fulfils, e.g. "http-01".
+	ChallengeType() string
+	// Present makes the key authorization of the challenge available to the ACME server.
+	Present(ctx context.Context, domain string, token string, keyAuth string) error
+	// CleanUp removes the key authorization of the challenge once it is validated.
+	CleanUp(ctx context.Context, domain string, token string) error
+}
+
+// HTTP01Solver solves HTTP-01 challenges by serving the key authorizations of pending challenges. The Gateway routes
+// /.well-known/acme-challenge/ to the ChallengeServicePort of the ChallengeServiceName Service, which serves the
+// handler, for the ACME server to validate the challenges.
+type HTTP01Solver struct {
+	mu       sync.RWMutex
+	keyAuths map[string]string
+}
+
+var _ ChallengeSolver = (*HTTP01Solver)(nil)
+var _ http.Handler = (*HTTP01Solver)(nil)
+
+// NewHTTP01Solver creates a new HTTP01Solver.
+func NewHTTP01Solver() *HTTP01Solver {
+	return &HTTP01Solver{keyAuths: map[string]string{}}
+}
+
+// ChallengeType returns the HTTP-01 challenge type.
+func (s *HTTP01Solver) ChallengeType() string {
+	return ChallengeTypeHTTP01
+}
+
+// Present serves the key authorization for the challenge token.
+func (s *HTTP01Solver) Present(ctx context.Context, domain string, token string, keyAuth string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keyAuths[token] = keyAuth
+	return nil
+}
+
+// CleanUp stops serving the key authorization for the challenge token.
+func (s *HTTP01Solver) CleanUp(ctx context.Context, domain string, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keyAuths, token)
+	return nil
+}
+
+// ServeHTTP serves the key authorizations of pending HTTP-01 challenges.
+func (s *HTTP01Solver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutPrefix(r.URL.Path, HTTP01ChallengePathPrefix)
+	if !ok || r.Method != http.MethodGet {
+		http.NotFound(w, r)
+		return
+	}
+
+	s.mu.RLock()
+	keyAuth, ok := s.keyAuths[token]
+	s.mu.RUnlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte(keyAuth))
+}
+
+// ACMEIssuer issues certificates from an ACME (RFC 8555) server such as Let's Encrypt.
+type ACMEIssuer struct {
+	// DirectoryURL is the directory URL of the ACME server.
+	DirectoryURL string
+	// Email is the email address of the ACME account. Optional.
+	Email string
+	// Solver solves the challenges of the ACME server.
+	Solver ChallengeSolver
+	// HTTPClient is the HTTP client used to talk to the ACME server. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	mu     sync.Mutex
+	client *acme.Client
+}
+
+var _ Issuer = (*ACMEIssuer)(nil)
+
+// Issue orders a certificate for the given DNS names from the ACME server. The account of the issuer is registered
+// with the ACME server the first time a certificate is issued.
+func (i *ACMEIssuer) Issue(ctx context.Context, dnsNames []string) (*Certificate, error) {
+	if len(dnsNames) == 0 {
+		return nil, errors.New("at least one DNS name is required to issue a certificate")
+	}
+
+	client, err := i.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(dnsNames...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ACME order: %w", err)
+	}
+
+	for _, authzURL := range order.AuthzURLs {
+		err = i.authorize(ctx, client, authzURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for ACME order: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: dnsNames[0]},
+		DNSNames: dnsNames,
+	}, key)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize ACME order: %w", err)
+	}
+
+	keyPEM, err := encodePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	certificatePEM := []byte{}
+	for _, der := range chain {
+		certificatePEM = append(certificatePEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+
+	return &Certificate{CertificatePEM: certificatePEM, PrivateKeyPEM: keyPEM}, nil
+}
+
+// getClient returns the ACME client of the issuer, registering a new account the first time it is called.
+func (i *ACMEIssuer) getClient(ctx context.Context) (*acme.Client, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.client != nil {
+		return i.client, nil
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &acme.Client{
+		Key:          key,
+		DirectoryURL: i.DirectoryURL,
+		HTTPClient:   i.HTTPClient,
+		UserAgent:    "radius",
+	}
+
+	account := &acme.Account{}
+	if i.Email != "" {
+		account.Contact = []string{"mailto:" + i.Email}
+	}
+
+	_, err = client.Register(ctx, account, acme.AcceptTOS)
+	if err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, fmt.Errorf("failed to register ACME account with %q: %w", i.DirectoryURL, err)
+	}
+
+	i.client = client
+	return client, nil
+}
+
+// authorize fulfils the challenge of a pending authorization and waits for the ACME server to validate it.
+func (i *ACMEIssuer) authorize(ctx context.Context, client *acme.Client, authzURL string) error {
+	authz, err := client.GetAuthorization(ctx, authzURL)
+	if err != nil {
+		return fmt.Errorf("failed to get ACME authorization: %w", err)
+	}
+
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == i.Solver.ChallengeType() {
+			challenge = c
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("ACME server does not offer a %s challenge for %q", i.Solver.ChallengeType(), authz.Identifier.Value)
+	}
+
+	var keyAuth string
+	switch challenge.Type {
+	case ChallengeTypeHTTP01:
+		keyAuth, err = client.HTTP01ChallengeResponse(challenge.Token)
+	case ChallengeTypeDNS01:
+		keyAuth, err = client.DNS01ChallengeRecord(challenge.Token)
+	default:
+		err = fmt.Errorf("unsupported ACME challenge type %q", challenge.Type)
+	}
+	if err != nil {
+		return err
+	}
+
+	domain := authz.Identifier.Value
+	err = i.Solver.Present(ctx, domain, challenge.Token, keyAuth)
+	if err != nil {
+		return fmt.Errorf("failed to present %s challenge for %q: %w", challenge.Type, domain, err)
+	}
+	defer func() {
+		_ = i.Solver.CleanUp(ctx, domain, challenge.Token)
+	}()
+
+	_, err = client.Accept(ctx, challenge)
+	if err != nil {
+		return fmt.Errorf("failed to accept %s challenge for %q: %w", challenge.Type, domain, err)
+	}
+
+	_, err = client.WaitAuthorization(ctx, authz.URI)
+	if err != nil {
+		return fmt.Errorf("failed to authorize %q: %w", domain, err)
+	}
+
+	return nil
+}
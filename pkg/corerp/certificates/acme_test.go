@@ -0,0 +1,473 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HTTP01Solver(t *testing.T) {
+	ctx := context.Background()
+	solver := NewHTTP01Solver()
+	require.Equal(t, ChallengeTypeHTTP01, solver.ChallengeType())
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		solver.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	require.Equal(t, http.StatusNotFound, serve("/.well-known/acme-challenge/token").Code)
+
+	err := solver.Present(ctx, "example.com", "token", "token.thumbprint")
+	require.NoError(t, err)
+
+	w := serve("/.well-known/acme-challenge/token")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "token.thumbprint", w.Body.String())
+	require.Equal(t, http.StatusNotFound, serve("/token").Code)
+
+	err = solver.CleanUp(ctx, "example.com", "token")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, serve("/.well-known/acme-challenge/token").Code)
+}
+
+func Test_ACMEIssuer_Issue(t *testing.T) {
+	server := newFakeACMEServer(t)
+	solver := NewHTTP01Solver()
+	server.solver = solver
+
+	issuer := &ACMEIssuer{
+		DirectoryURL: server.URL() + "/directory",
+		Email:        "admin@example.com",
+		Solver:       solver,
+	}
+
+	dnsNames := []string{"example.com", "www.example.com"}
+	certificate, err := issuer.Issue(context.Background(), dnsNames)
+	require.NoError(t, err)
+	require.Empty(t, certificate.CACertificatePEM)
+
+	leaf := verifyCertificate(t, certificate, server.ca)
+	require.Equal(t, dnsNames, leaf.DNSNames)
+
+	// The account is registered once and reused for later orders.
+	_, err = issuer.Issue(context.Background(), []string{"example.com"})
+	require.NoError(t, err)
+	require.Equal(t, 1, server.accounts)
+	require.Equal(t, []string{"mailto:admin@example.com"}, server.contact)
+
+	// Challenges are cleaned up once validated.
+	require.Empty(t, solver.keyAuths)
+}
+
+func Test_ACMEIssuer_Issue_ChallengeFails(t *testing.T) {
+	server := newFakeACMEServer(t)
+	solver := NewHTTP01Solver()
+	server.solver = solver
+
+	issuer := &ACMEIssuer{
+		DirectoryURL: server.URL() + "/directory",
+		Solver:       &wrongKeyAuthSolver{HTTP01Solver: solver},
+	}
+
+	_, err := issuer.Issue(context.Background(), []string{"example.com"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to authorize \"example.com\"")
+}
+
+func Test_ACMEIssuer_Issue_UnsupportedChallengeType(t *testing.T) {
+	server := newFakeACMEServer(t)
+
+	issuer := &ACMEIssuer{
+		DirectoryURL: server.URL() + "/directory",
+		Solver:       &dns01Solver{},
+	}
+
+	_, err := issuer.Issue(context.Background(), []string{"example.com"})
+	require.Error(t, err)
+	require.Equal(t, "ACME server does not offer a dns-01 challenge for \"example.com\"", err.Error())
+}
+
+func Test_ACMEIssuer_Issue_NoDNSNames(t *testing.T) {
+	issuer := &ACMEIssuer{Solver: NewHTTP01Solver()}
+
+	_, err := issuer.Issue(context.Background(), nil)
+	require.Error(t, err)
+}
+
+// wrongKeyAuthSolver presents an invalid key authorization so that the challenge fails.
+type wrongKeyAuthSolver struct {
+	*HTTP01Solver
+}
+
+func (s *wrongKeyAuthSolver) Present(ctx context.Context, domain string, token string, keyAuth string) error {
+	return s.HTTP01Solver.Present(ctx, domain, token, token+".invalid")
+}
+
+// dns01Solver is a solver for a challenge type that the fake ACME server does not offer.
+type dns01Solver struct {
+}
+
+func (s *dns01Solver) ChallengeType() string {
+	return ChallengeTypeDNS01
+}
+
+func (s *dns01Solver) Present(ctx context.Context, domain string, token string, keyAuth string) error {
+	return nil
+}
+
+func (s *dns01Solver) CleanUp(ctx context.Context, domain string, token string) error {
+	return nil
+}
+
+// fakeACMEServer is an in-process stand-in for an ACME (RFC 8555) server such as pebble. It trusts the JWS
+// signatures of requests and validates HTTP-01 challenges by calling the solver handler directly.
+type fakeACMEServer struct {
+	t      *testing.T
+	server *httptest.Server
+	ca     *CertificateAuthority
+	solver http.Handler
+
+	mu         sync.Mutex
+	nextID     int
+	accounts   int
+	contact    []string
+	thumbprint string
+	orders     map[string]*fakeOrder
+	authzs     map[string]*fakeAuthz
+}
+
+type fakeOrder struct {
+	status      string
+	identifiers []string
+	authzIDs    []string
+	certificate []byte
+}
+
+type fakeAuthz struct {
+	orderID string
+	domain  string
+	token   string
+	status  string
+}
+
+type fakeJWS struct {
+	Protected string `json:"protected"`
+	Payload   string `json:"payload"`
+	Signature string `json:"signature"`
+}
+
+type fakeJWK struct {
+	Crv string `json:"crv"`
+	Kty string `json:"kty"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func newFakeACMEServer(t *testing.T) *fakeACMEServer {
+	ca, err := NewCertificateAuthority("Fake ACME CA", time.Now())
+	require.NoError(t, err)
+
+	s := &fakeACMEServer{
+		t:      t,
+		ca:     ca,
+		orders: map[string]*fakeOrder{},
+		authzs: map[string]*fakeAuthz{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/directory", s.directory)
+	mux.HandleFunc("/new-nonce", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/new-account", s.newAccount)
+	mux.HandleFunc("/new-order", s.newOrder)
+	mux.HandleFunc("/order/", s.order)
+	mux.HandleFunc("/authz/", s.authz)
+	mux.HandleFunc("/challenge/", s.challenge)
+	mux.HandleFunc("/finalize/", s.finalize)
+	mux.HandleFunc("/certificate/", s.certificate)
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Replay-Nonce", s.newID())
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+func (s *fakeACMEServer) URL() string {
+	return s.server.URL
+}
+
+func (s *fakeACMEServer) newID() string {
+	b := make([]byte, 8)
+	_, err := rand.Read(b)
+	require.NoError(s.t, err)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (s *fakeACMEServer) directory(w http.ResponseWriter, r *http.Request) {
+	s.writeJSON(w, http.StatusOK, map[string]any{
+		"newNonce":   s.URL() + "/new-nonce",
+		"newAccount": s.URL() + "/new-account",
+		"newOrder":   s.URL() + "/new-order",
+		"revokeCert": s.URL() + "/revoke-cert",
+		"keyChange":  s.URL() + "/key-change",
+	})
+}
+
+func (s *fakeACMEServer) newAccount(w http.ResponseWriter, r *http.Request) {
+	var header struct {
+		JWK fakeJWK `json:"jwk"`
+	}
+	var payload struct {
+		Contact []string `json:"contact"`
+	}
+	s.readJWS(r, &header, &payload)
+
+	jwk, err := json.Marshal(header.JWK)
+	require.NoError(s.t, err)
+	thumbprint := sha256.Sum256(jwk)
+
+	status := http.StatusOK
+	if s.thumbprint == "" {
+		s.thumbprint = base64.RawURLEncoding.EncodeToString(thumbprint[:])
+		s.contact = payload.Contact
+		s.accounts++
+		status = http.StatusCreated
+	}
+
+	w.Header().Set("Location", s.URL()+"/account/1")
+	s.writeJSON(w, status, map[string]any{"status": "valid", "contact": s.contact})
+}
+
+func (s *fakeACMEServer) newOrder(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Identifiers []struct {
+			Type  string `json:"type"`
+			Value string `json:"value"`
+		} `json:"identifiers"`
+	}
+	s.readJWS(r, nil, &payload)
+
+	orderID := s.newID()
+	order := &fakeOrder{status: "pending"}
+	for _, identifier := range payload.Identifiers {
+		require.Equal(s.t, "dns", identifier.Type)
+		authzID := s.newID()
+		s.authzs[authzID] = &fakeAuthz{orderID: orderID, domain: identifier.Value, token: s.newID(), status: "pending"}
+		order.identifiers = append(order.identifiers, identifier.Value)
+		order.authzIDs = append(order.authzIDs, authzID)
+	}
+	s.orders[orderID] = order
+
+	s.writeOrder(w, http.StatusCreated, orderID)
+}
+
+func (s *fakeACMEServer) order(w http.ResponseWriter, r *http.Request) {
+	s.readJWS(r, nil, nil)
+	s.writeOrder(w, http.StatusOK, strings.TrimPrefix(r.URL.Path, "/order/"))
+}
+
+func (s *fakeACMEServer) authz(w http.ResponseWriter, r *http.Request) {
+	s.readJWS(r, nil, nil)
+	s.writeAuthz(w, strings.TrimPrefix(r.URL.Path, "/authz/"))
+}
+
+func (s *fakeACMEServer) challenge(w http.ResponseWriter, r *http.Request) {
+	s.readJWS(r, nil, nil)
+	authzID := strings.TrimPrefix(r.URL.Path, "/challenge/")
+	authz := s.authzs[authzID]
+
+	authz.status = "invalid"
+	if s.solver != nil {
+		rec := httptest.NewRecorder()
+		s.solver.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "http://"+authz.domain+HTTP01ChallengePathPrefix+authz.token, nil))
+		if rec.Code == http.StatusOK && rec.Body.String() == authz.token+"."+s.thumbprint {
+			authz.status = "valid"
+		}
+	}
+
+	order := s.orders[authz.orderID]
+	order.status = "ready"
+	for _, id := range order.authzIDs {
+		switch s.authzs[id].status {
+		case "invalid":
+			order.status = "invalid"
+		case "pending":
+			if order.status != "invalid" {
+				order.status = "pending"
+			}
+		}
+	}
+
+	s.writeJSON(w, http.StatusOK, s.challengeJSON(authzID))
+}
+
+func (s *fakeACMEServer) finalize(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		CSR string `json:"csr"`
+	}
+	s.readJWS(r, nil, &payload)
+
+	orderID := strings.TrimPrefix(r.URL.Path, "/finalize/")
+	order := s.orders[orderID]
+	require.Equal(s.t, "ready", order.status)
+
+	der, err := base64.RawURLEncoding.DecodeString(payload.CSR)
+	require.NoError(s.t, err)
+	csr, err := x509.ParseCertificateRequest(der)
+	require.NoError(s.t, err)
+	require.ElementsMatch(s.t, order.identifiers, csr.DNSNames)
+
+	serialNumber, err := newSerialNumber()
+	require.NoError(s.t, err)
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		DNSNames:     csr.DNSNames,
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	leaf, err := x509.CreateCertificate(rand.Reader, template, s.ca.Certificate, csr.PublicKey, s.ca.Key)
+	require.NoError(s.t, err)
+
+	order.certificate = append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf}), s.ca.CertificatePEM...)
+	order.status = "valid"
+	s.writeOrder(w, http.StatusOK, orderID)
+}
+
+func (s *fakeACMEServer) certificate(w http.ResponseWriter, r *http.Request) {
+	s.readJWS(r, nil, nil)
+	order := s.orders[strings.TrimPrefix(r.URL.Path, "/certificate/")]
+
+	w.Header().Set("Content-Type", "application/pem-certificate-chain")
+	_, _ = w.Write(order.certificate)
+}
+
+func (s *fakeACMEServer) readJWS(r *http.Request, header any, payload any) {
+	require.Equal(s.t, http.MethodPost, r.Method)
+
+	body, err := io.ReadAll(r.Body)
+	require.NoError(s.t, err)
+
+	jws := fakeJWS{}
+	require.NoError(s.t, json.Unmarshal(body, &jws))
+
+	if header != nil {
+		b, err := base64.RawURLEncoding.DecodeString(jws.Protected)
+		require.NoError(s.t, err)
+		require.NoError(s.t, json.Unmarshal(b, header))
+	}
+
+	if payload != nil {
+		b, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+		require.NoError(s.t, err)
+		require.NoError(s.t, json.Unmarshal(b, payload))
+	}
+}
+
+func (s *fakeACMEServer) writeOrder(w http.ResponseWriter, status int, orderID string) {
+	order := s.orders[orderID]
+	authorizations := []string{}
+	for _, id := range order.authzIDs {
+		authorizations = append(authorizations, s.URL()+"/authz/"+id)
+	}
+
+	identifiers := []map[string]string{}
+	for _, identifier := range order.identifiers {
+		identifiers = append(identifiers, map[string]string{"type": "dns", "value": identifier})
+	}
+
+	response := map[string]any{
+		"status":         order.status,
+		"identifiers":    identifiers,
+		"authorizations": authorizations,
+		"finalize":       s.URL() + "/finalize/" + orderID,
+	}
+	if order.certificate != nil {
+		response["certificate"] = s.URL() + "/certificate/" + orderID
+	}
+
+	w.Header().Set("Location", s.URL()+"/order/"+orderID)
+	s.writeJSON(w, status, response)
+}
+
+func (s *fakeACMEServer) writeAuthz(w http.ResponseWriter, authzID string) {
+	authz := s.authzs[authzID]
+	s.writeJSON(w, http.StatusOK, map[string]any{
+		"status":     authz.status,
+		"identifier": map[string]string{"type": "dns", "value": authz.domain},
+		"challenges": []any{s.challengeJSON(authzID)},
+	})
+}
+
+func (s *fakeACMEServer) challengeJSON(authzID string) map[string]any {
+	authz := s.authzs[authzID]
+	return map[string]any{
+		"type":   ChallengeTypeHTTP01,
+		"url":    s.URL() + "/challenge/" + authzID,
+		"token":  authz.token,
+		"status": authz.status,
+	}
+}
+
+func (s *fakeACMEServer) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(s.t, json.NewEncoder(w).Encode(v))
+}
+
+// verifyCertificate verifies that the certificate chains to the certificate authority and that its private key
+// matches the leaf certificate, and returns the leaf certificate.
+func verifyCertificate(t *testing.T, certificate *Certificate, ca *CertificateAuthority) *x509.Certificate {
+	leaf, err := parseLeafCertificate(certificate.CertificatePEM)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Certificate)
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: roots, DNSName: leaf.DNSNames[0]})
+	require.NoError(t, err)
+
+	key, err := parsePrivateKey(certificate.PrivateKeyPEM)
+	require.NoError(t, err)
+	public, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool })
+	require.True(t, ok)
+	require.True(t, public.Equal(leaf.PublicKey))
+
+	return leaf
+}
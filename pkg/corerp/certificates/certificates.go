@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certificates issues and renews the TLS certificates of gateways that use automatic certificate issuance.
+//
+// The gateway renderer produces a Kubernetes Secret that describes the certificate it needs through annotations. The
+// Manager fills in the certificate before the Secret is deployed and the renewal service of the service package
+// periodically re-issues certificates that are about to expire. Certificates of ACME servers start as temporary
+// certificates of the internal certificate authority, the renewal service issues them once the gateway that routes
+// the ACME challenges is deployed.
+package certificates
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// LabelManagedCertificate marks a Secret whose certificate is issued and renewed by Radius.
+	LabelManagedCertificate = "radapp.io/managed-certificate"
+	// LabelPendingCertificate marks a managed Secret that holds a temporary certificate until the renewal service
+	// issues its certificate.
+	LabelPendingCertificate = "radapp.io/certificate-pending"
+	// AnnotationIssuer is the kind of issuer that issues the certificate.
+	AnnotationIssuer = "radapp.io/certificate-issuer"
+	// AnnotationDNSNames is the comma separated list of DNS names that the certificate is issued for.
+	AnnotationDNSNames = "radapp.io/certificate-dns-names"
+	// AnnotationRenewBefore is how long before it expires the certificate is renewed.
+	AnnotationRenewBefore = "radapp.io/certificate-renew-before"
+	// AnnotationACMEDirectoryURL is the directory URL of the ACME server.
+	AnnotationACMEDirectoryURL = "radapp.io/acme-directory-url"
+	// AnnotationACMEEmail is the email address of the ACME account.
+	AnnotationACMEEmail = "radapp.io/acme-email"
+
+	// IssuerACME issues certificates from an ACME server.
+	IssuerACME = "acme"
+	// IssuerSelfSigned issues certificates from the internal certificate authority.
+	IssuerSelfSigned = "selfSigned"
+
+	// DefaultRenewBefore is how long before it expires a certificate is renewed when not specified.
+	DefaultRenewBefore = 30 * 24 * time.Hour
+
+	// SecretKeyCACertificate is the key of the certificate authority chain in a managed Secret, when it is known.
+	SecretKeyCACertificate = "ca.crt"
+)
+
+// Spec describes the certificate of a managed Secret.
+type Spec struct {
+	// Issuer is the kind of issuer that issues the certificate.
+	Issuer string
+	// DNSNames are the DNS names that the certificate is issued for.
+	DNSNames []string
+	// RenewBefore is how long before it expires the certificate is renewed.
+	RenewBefore time.Duration
+	// ACMEDirectoryURL is the directory URL of the ACME server.
+	ACMEDirectoryURL string
+	// ACMEEmail is the email address of the ACME account.
+	ACMEEmail string
+}
+
+// Annotations returns the annotations that describe the certificate on a managed Secret.
+func (s Spec) Annotations() map[string]string {
+	annotations := map[string]string{
+		AnnotationIssuer:   s.Issuer,
+		AnnotationDNSNames: strings.Join(s.DNSNames, ","),
+	}
+	if s.RenewBefore != 0 {
+		annotations[AnnotationRenewBefore] = s.RenewBefore.String()
+	}
+	if s.ACMEDirectoryURL != "" {
+		annotations[AnnotationACMEDirectoryURL] = s.ACMEDirectoryURL
+	}
+	if s.ACMEEmail != "" {
+		annotations[AnnotationACMEEmail] = s.ACMEEmail
+	}
+
+	return annotations
+}
+
+// SpecFromSecret reads the certificate spec from the annotations of a Secret. It returns false if the Secret is not
+// a managed certificate.
+func SpecFromSecret(secret *corev1.Secret) (Spec, bool, error) {
+	issuer, ok := secret.Annotations[AnnotationIssuer]
+	if !ok {
+		return Spec{}, false, nil
+	}
+
+	spec := Spec{
+		Issuer:           issuer,
+		RenewBefore:      DefaultRenewBefore,
+		ACMEDirectoryURL: secret.Annotations[AnnotationACMEDirectoryURL],
+		ACMEEmail:        secret.Annotations[AnnotationACMEEmail],
+	}
+
+	for _, name := range strings.Split(secret.Annotations[AnnotationDNSNames], ",") {
+		if name != "" {
+			spec.DNSNames = append(spec.DNSNames, name)
+		}
+	}
+	if len(spec.DNSNames) == 0 {
+		return Spec{}, true, fmt.Errorf("secret %s/%s does not specify the DNS names of its certificate", secret.Namespace, secret.Name)
+	}
+
+	if renewBefore, ok := secret.Annotations[AnnotationRenewBefore]; ok {
+		d, err := time.ParseDuration(renewBefore)
+		if err != nil {
+			return Spec{}, true, fmt.Errorf("secret %s/%s has invalid renew before duration %q: %w", secret.Namespace, secret.Name, renewBefore, err)
+		}
+		spec.RenewBefore = d
+	}
+
+	return spec, true, nil
+}
+
+// Certificate is an issued certificate and its private key.
+type Certificate struct {
+	// CertificatePEM is the PEM encoded certificate chain, leaf first.
+	CertificatePEM []byte
+	// PrivateKeyPEM is the PEM encoded private key of the leaf certificate.
+	PrivateKeyPEM []byte
+	// CACertificatePEM is the PEM encoded certificate of the issuing certificate authority, when it is known.
+	CACertificatePEM []byte
+}
+
+// SecretData returns the data of a kubernetes.io/tls Secret that holds the certificate.
+func (c *Certificate) SecretData() map[string][]byte {
+	data := map[string][]byte{
+		corev1.TLSCertKey:       c.CertificatePEM,
+		corev1.TLSPrivateKeyKey: c.PrivateKeyPEM,
+	}
+	if len(c.CACertificatePEM) > 0 {
+		data[SecretKeyCACertificate] = c.CACertificatePEM
+	}
+
+	return data
+}
+
+// Issuer issues certificates.
+type Issuer interface {
+	// Issue issues a certificate for the given DNS names.
+	Issue(ctx context.Context, dnsNames []string) (*Certificate, error)
+}
+
+// NeedsRenewal returns true if the certificate in the data of a Secret is missing, does not cover the DNS names of
+// the spec or expires within the renewal window of the spec.
+func NeedsRenewal(data map[string][]byte, spec Spec, now time.Time) bool {
+	if len(data[corev1.TLSCertKey]) == 0 || len(data[corev1.TLSPrivateKeyKey]) == 0 {
+		return true
+	}
+
+	leaf, err := parseLeafCertificate(data[corev1.TLSCertKey])
+	if err != nil {
+		return true
+	}
+
+	for _, name := range spec.DNSNames {
+		if leaf.VerifyHostname(name) != nil {
+			return true
+		}
+	}
+
+	return !now.Add(spec.RenewBefore).Before(leaf.NotAfter)
+}
+
+// parseLeafCertificate parses the first certificate of a PEM encoded certificate chain.
+func parseLeafCertificate(certificatePEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certificatePEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no certificate found in PEM data")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
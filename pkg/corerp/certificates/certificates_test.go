@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_SpecFromSecret(t *testing.T) {
+	spec := Spec{
+		Issuer:           IssuerACME,
+		DNSNames:         []string{"example.com", "www.example.com"},
+		RenewBefore:      48 * time.Hour,
+		ACMEDirectoryURL: "https://acme.example.com/directory",
+		ACMEEmail:        "admin@example.com",
+	}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: spec.Annotations()}}
+
+	actual, ok, err := SpecFromSecret(secret)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, spec, actual)
+}
+
+func Test_SpecFromSecret_Defaults(t *testing.T) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: Spec{
+		Issuer:   IssuerSelfSigned,
+		DNSNames: []string{"example.com"},
+	}.Annotations()}}
+
+	actual, ok, err := SpecFromSecret(secret)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, DefaultRenewBefore, actual.RenewBefore)
+}
+
+func Test_SpecFromSecret_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		managed     bool
+		err         string
+	}{
+		{
+			name:        "not managed",
+			annotations: map[string]string{"foo": "bar"},
+		},
+		{
+			name:        "missing dns names",
+			annotations: map[string]string{AnnotationIssuer: IssuerSelfSigned},
+			managed:     true,
+			err:         "secret default/test does not specify the DNS names of its certificate",
+		},
+		{
+			name: "invalid renew before",
+			annotations: map[string]string{
+				AnnotationIssuer:      IssuerSelfSigned,
+				AnnotationDNSNames:    "example.com",
+				AnnotationRenewBefore: "soon",
+			},
+			managed: true,
+			err:     "secret default/test has invalid renew before duration \"soon\": time: invalid duration \"soon\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test", Annotations: tt.annotations}}
+
+			_, ok, err := SpecFromSecret(secret)
+			require.Equal(t, tt.managed, ok)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func Test_NeedsRenewal(t *testing.T) {
+	now := time.Now()
+	ca, err := NewCertificateAuthority("Test CA", now)
+	require.NoError(t, err)
+
+	issuer := &SelfSignedIssuer{CA: ca, Validity: 90 * 24 * time.Hour, Now: func() time.Time { return now }}
+	certificate, err := issuer.Issue(context.Background(), []string{"example.com"})
+	require.NoError(t, err)
+
+	spec := Spec{Issuer: IssuerSelfSigned, DNSNames: []string{"example.com"}, RenewBefore: DefaultRenewBefore}
+
+	tests := []struct {
+		name     string
+		data     map[string][]byte
+		spec     Spec
+		now      time.Time
+		expected bool
+	}{
+		{
+			name:     "valid",
+			data:     certificate.SecretData(),
+			spec:     spec,
+			now:      now,
+			expected: false,
+		},
+		{
+			name:     "missing",
+			data:     nil,
+			spec:     spec,
+			now:      now,
+			expected: true,
+		},
+		{
+			name:     "invalid",
+			data:     map[string][]byte{corev1.TLSCertKey: []byte("invalid"), corev1.TLSPrivateKeyKey: []byte("invalid")},
+			spec:     spec,
+			now:      now,
+			expected: true,
+		},
+		{
+			name:     "different dns name",
+			data:     certificate.SecretData(),
+			spec:     Spec{Issuer: IssuerSelfSigned, DNSNames: []string{"example.org"}, RenewBefore: DefaultRenewBefore},
+			now:      now,
+			expected: true,
+		},
+		{
+			name:     "within renewal window",
+			data:     certificate.SecretData(),
+			spec:     spec,
+			now:      now.Add(61 * 24 * time.Hour),
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, NeedsRenewal(tt.data, tt.spec, tt.now))
+		})
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultCANamespace is the namespace of the Secret that holds the internal certificate authority.
+	DefaultCANamespace = "radius-system"
+	// CASecretName is the name of the Secret that holds the internal certificate authority.
+	CASecretName = "radius-gateway-ca"
+)
+
+// DefaultHTTP01Solver is the HTTP-01 challenge solver shared by the Manager used during deployment and the renewal
+// Service that serves the challenges.
+var DefaultHTTP01Solver = NewHTTP01Solver()
+
+// Manager issues the certificates of managed Secrets.
+type Manager struct {
+	// Client is the Kubernetes client used to read managed Secrets and the internal certificate authority.
+	Client client.Client
+	// CANamespace is the namespace of the Secret that holds the internal certificate authority.
+	CANamespace string
+	// Solver solves the challenges of ACME servers.
+	Solver ChallengeSolver
+	// HTTPClient is the HTTP client used to talk to ACME servers. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	mu          sync.Mutex
+	acmeIssuers map[string]*ACMEIssuer
+	selfSigned  *SelfSignedIssuer
+}
+
+// NewManager creates a new Manager that stores the internal certificate authority in the radius-system namespace
+// and solves ACME challenges with the DefaultHTTP01Solver.
+func NewManager(k8sClient client.Client) *Manager {
+	return &Manager{
+		Client:      k8sClient,
+		CANamespace: DefaultCANamespace,
+		Solver:      DefaultHTTP01Solver,
+	}
+}
+
+// TransformSecret fills in the certificate of a managed Secret before it is deployed. Secrets that are not managed
+// certificates are left unchanged.
+//
+// Certificates of ACME servers are not issued here: the ACME server can only validate the challenges once the
+// Gateway that routes them is deployed. Instead the Secret gets a temporary certificate of the internal certificate
+// authority and is marked as pending, and the renewal service issues the certificate once the Gateway is ready.
+func (m *Manager) TransformSecret(ctx context.Context, options *handlers.PutOptions) error {
+	secret, ok := options.Resource.CreateResource.Data.(*corev1.Secret)
+	if !ok {
+		return errors.New("cannot transform secret")
+	}
+
+	return m.ensure(ctx, secret, true)
+}
+
+// Ensure makes sure that a managed Secret holds a valid certificate. The certificate already deployed to the cluster
+// is reused when it is still valid, otherwise a new certificate is issued. Secrets that are not managed certificates
+// are left unchanged.
+func (m *Manager) Ensure(ctx context.Context, secret *corev1.Secret) error {
+	return m.ensure(ctx, secret, false)
+}
+
+// ensure makes sure that a managed Secret holds a valid certificate. When deferACME is true, certificates of ACME
+// servers are replaced by a temporary certificate and the Secret is marked as pending.
+func (m *Manager) ensure(ctx context.Context, secret *corev1.Secret, deferACME bool) error {
+	spec, ok, err := SpecFromSecret(secret)
+	if err != nil || !ok {
+		return err
+	}
+
+	now := m.now()
+	current := secret
+	if NeedsRenewal(secret.Data, spec, now) {
+		existing := &corev1.Secret{}
+		err = m.Client.Get(ctx, client.ObjectKey{Namespace: secret.Namespace, Name: secret.Name}, existing)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		} else if err == nil {
+			current = existing
+		}
+	}
+
+	pending := isPending(current)
+	if !NeedsRenewal(current.Data, spec, now) && (!pending || deferACME) {
+		setCertificateData(secret, current.Data)
+		setPending(secret, pending)
+		return nil
+	}
+
+	var issuer Issuer
+	if spec.Issuer == IssuerACME && deferACME {
+		issuer, err = m.selfSignedIssuer(ctx)
+	} else {
+		issuer, err = m.issuer(ctx, spec)
+	}
+	if err != nil {
+		return err
+	}
+
+	certificate, err := issuer.Issue(ctx, spec.DNSNames)
+	if err != nil {
+		return fmt.Errorf("failed to issue certificate for secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
+	setCertificateData(secret, certificate.SecretData())
+	setPending(secret, spec.Issuer == IssuerACME && deferACME)
+	return nil
+}
+
+// issuer returns the issuer of the certificate described by the spec.
+func (m *Manager) issuer(ctx context.Context, spec Spec) (Issuer, error) {
+	switch spec.Issuer {
+	case IssuerSelfSigned:
+		return m.selfSignedIssuer(ctx)
+	case IssuerACME:
+		return m.acmeIssuer(spec), nil
+	default:
+		return nil, fmt.Errorf("unsupported certificate issuer %q", spec.Issuer)
+	}
+}
+
+// selfSignedIssuer returns the issuer of the internal certificate authority, creating the certificate authority the
+// first time it is used.
+func (m *Manager) selfSignedIssuer(ctx context.Context) (*SelfSignedIssuer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.selfSigned != nil {
+		return m.selfSigned, nil
+	}
+
+	ca, err := m.loadOrCreateCertificateAuthority(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the internal certificate authority: %w", err)
+	}
+
+	m.selfSigned = &SelfSignedIssuer{CA: ca, Now: m.Now}
+	return m.selfSigned, nil
+}
+
+// loadOrCreateCertificateAuthority loads the internal certificate authority from its Secret, or creates the Secret
+// when it does not exist yet.
+func (m *Manager) loadOrCreateCertificateAuthority(ctx context.Context) (*CertificateAuthority, error) {
+	key := client.ObjectKey{Namespace: m.CANamespace, Name: CASecretName}
+	secret := &corev1.Secret{}
+	err := m.Client.Get(ctx, key, secret)
+	if err == nil {
+		return LoadCertificateAuthority(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	ca, err := NewCertificateAuthority("Radius Gateway CA", m.now())
+	if err != nil {
+		return nil, err
+	}
+
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: key.Namespace,
+			Name:      key.Name,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       ca.CertificatePEM,
+			corev1.TLSPrivateKeyKey: ca.PrivateKeyPEM,
+		},
+	}
+	err = m.Client.Create(ctx, secret)
+	if apierrors.IsAlreadyExists(err) {
+		// Another instance created the certificate authority concurrently, use theirs.
+		err = m.Client.Get(ctx, key, secret)
+		if err != nil {
+			return nil, err
+		}
+		return LoadCertificateAuthority(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ca, nil
+}
+
+// acmeIssuer returns the issuer of the ACME server described by the spec. Issuers are cached so that the account of
+// each ACME server is only registered once.
+func (m *Manager) acmeIssuer(spec Spec) *ACMEIssuer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.acmeIssuers == nil {
+		m.acmeIssuers = map[string]*ACMEIssuer{}
+	}
+
+	key := spec.ACMEDirectoryURL + "|" + spec.ACMEEmail
+	issuer, ok := m.acmeIssuers[key]
+	if !ok {
+		issuer = &ACMEIssuer{
+			DirectoryURL: spec.ACMEDirectoryURL,
+			Email:        spec.ACMEEmail,
+			Solver:       m.Solver,
+			HTTPClient:   m.HTTPClient,
+		}
+		m.acmeIssuers[key] = issuer
+	}
+
+	return issuer
+}
+
+func (m *Manager) now() time.Time {
+	if m.Now != nil {
+		return m.Now()
+	}
+
+	return time.Now()
+}
+
+// setCertificateData replaces the certificate of a Secret with the given data.
+func setCertificateData(secret *corev1.Secret, data map[string][]byte) {
+	secret.Type = corev1.SecretTypeTLS
+	secret.Data = map[string][]byte{}
+	for _, k := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey, SecretKeyCACertificate} {
+		if v, ok := data[k]; ok {
+			secret.Data[k] = v
+		}
+	}
+}
+
+// isPending returns true if the Secret holds a temporary certificate.
+func isPending(secret *corev1.Secret) bool {
+	return secret.Labels[LabelPendingCertificate] == "true"
+}
+
+// setPending marks the Secret as holding a temporary certificate, or removes the mark.
+func setPending(secret *corev1.Secret, pending bool) {
+	if !pending {
+		delete(secret.Labels, LabelPendingCertificate)
+		return
+	}
+
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+	secret.Labels[LabelPendingCertificate] = "true"
+}
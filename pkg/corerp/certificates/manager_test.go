@@ -0,0 +1,249 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_Manager_Ensure_SelfSigned(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := fake.NewClientBuilder().Build()
+	manager := NewManager(k8sClient)
+
+	secret := makeManagedSecret(Spec{Issuer: IssuerSelfSigned, DNSNames: []string{"example.com"}})
+	err := manager.Ensure(ctx, secret)
+	require.NoError(t, err)
+	require.Equal(t, corev1.SecretTypeTLS, secret.Type)
+
+	// The internal certificate authority is stored in the cluster and signs the certificate.
+	caSecret := &corev1.Secret{}
+	err = k8sClient.Get(ctx, client.ObjectKey{Namespace: DefaultCANamespace, Name: CASecretName}, caSecret)
+	require.NoError(t, err)
+
+	ca, err := LoadCertificateAuthority(caSecret.Data[corev1.TLSCertKey], caSecret.Data[corev1.TLSPrivateKeyKey])
+	require.NoError(t, err)
+	require.Equal(t, ca.CertificatePEM, secret.Data[SecretKeyCACertificate])
+	verifyCertificate(t, &Certificate{CertificatePEM: secret.Data[corev1.TLSCertKey], PrivateKeyPEM: secret.Data[corev1.TLSPrivateKeyKey]}, ca)
+
+	// Another manager reuses the certificate authority stored in the cluster.
+	other := makeManagedSecret(Spec{Issuer: IssuerSelfSigned, DNSNames: []string{"example.org"}})
+	other.Name = "other"
+	err = NewManager(k8sClient).Ensure(ctx, other)
+	require.NoError(t, err)
+	require.Equal(t, ca.CertificatePEM, other.Data[SecretKeyCACertificate])
+}
+
+func Test_Manager_Ensure_ReusesDeployedCertificate(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := fake.NewClientBuilder().Build()
+	manager := NewManager(k8sClient)
+
+	spec := Spec{Issuer: IssuerSelfSigned, DNSNames: []string{"example.com"}, RenewBefore: DefaultRenewBefore}
+	deployed := makeManagedSecret(spec)
+	err := manager.Ensure(ctx, deployed)
+	require.NoError(t, err)
+	err = k8sClient.Create(ctx, deployed)
+	require.NoError(t, err)
+
+	// A re-deployment renders the Secret without data, the certificate that is already deployed is kept.
+	secret := makeManagedSecret(spec)
+	err = manager.Ensure(ctx, secret)
+	require.NoError(t, err)
+	require.Equal(t, deployed.Data, secret.Data)
+
+	// Changing the DNS names issues a new certificate.
+	changed := makeManagedSecret(Spec{Issuer: IssuerSelfSigned, DNSNames: []string{"www.example.com"}, RenewBefore: DefaultRenewBefore})
+	err = manager.Ensure(ctx, changed)
+	require.NoError(t, err)
+	require.NotEqual(t, deployed.Data[corev1.TLSCertKey], changed.Data[corev1.TLSCertKey])
+}
+
+func Test_Manager_Ensure_RenewsExpiringCertificate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	manager := NewManager(fake.NewClientBuilder().Build())
+	manager.Now = func() time.Time { return now }
+
+	secret := makeManagedSecret(Spec{Issuer: IssuerSelfSigned, DNSNames: []string{"example.com"}})
+	err := manager.Ensure(ctx, secret)
+	require.NoError(t, err)
+	issued := secret.Data[corev1.TLSCertKey]
+
+	// Still valid, nothing changes.
+	err = manager.Ensure(ctx, secret)
+	require.NoError(t, err)
+	require.Equal(t, issued, secret.Data[corev1.TLSCertKey])
+
+	// Within the renewal window, a new certificate is issued.
+	now = now.Add(DefaultSelfSignedValidity - DefaultRenewBefore)
+	err = manager.Ensure(ctx, secret)
+	require.NoError(t, err)
+	require.NotEqual(t, issued, secret.Data[corev1.TLSCertKey])
+	require.False(t, NeedsRenewal(secret.Data, Spec{DNSNames: []string{"example.com"}, RenewBefore: DefaultRenewBefore}, now))
+}
+
+func Test_Manager_Ensure_ACME(t *testing.T) {
+	server := newFakeACMEServer(t)
+	solver := NewHTTP01Solver()
+	server.solver = solver
+
+	manager := NewManager(fake.NewClientBuilder().Build())
+	manager.Solver = solver
+
+	secret := makeManagedSecret(Spec{
+		Issuer:           IssuerACME,
+		DNSNames:         []string{"example.com"},
+		ACMEDirectoryURL: server.URL() + "/directory",
+		ACMEEmail:        "admin@example.com",
+	})
+	err := manager.Ensure(context.Background(), secret)
+	require.NoError(t, err)
+	require.NotContains(t, secret.Data, SecretKeyCACertificate)
+	verifyCertificate(t, &Certificate{CertificatePEM: secret.Data[corev1.TLSCertKey], PrivateKeyPEM: secret.Data[corev1.TLSPrivateKeyKey]}, server.ca)
+
+	// The account of the ACME server is reused.
+	other := makeManagedSecret(Spec{
+		Issuer:           IssuerACME,
+		DNSNames:         []string{"example.org"},
+		ACMEDirectoryURL: server.URL() + "/directory",
+		ACMEEmail:        "admin@example.com",
+	})
+	other.Name = "other"
+	err = manager.Ensure(context.Background(), other)
+	require.NoError(t, err)
+	require.Equal(t, 1, server.accounts)
+}
+
+func Test_Manager_Ensure_Unmanaged(t *testing.T) {
+	manager := NewManager(fake.NewClientBuilder().Build())
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+		Data:       map[string][]byte{"foo": []byte("bar")},
+	}
+	err := manager.Ensure(context.Background(), secret)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"foo": []byte("bar")}, secret.Data)
+}
+
+func Test_Manager_Ensure_UnsupportedIssuer(t *testing.T) {
+	manager := NewManager(fake.NewClientBuilder().Build())
+
+	secret := makeManagedSecret(Spec{Issuer: "vault", DNSNames: []string{"example.com"}})
+	err := manager.Ensure(context.Background(), secret)
+	require.EqualError(t, err, "unsupported certificate issuer \"vault\"")
+}
+
+func Test_Manager_TransformSecret(t *testing.T) {
+	manager := NewManager(fake.NewClientBuilder().Build())
+
+	secret := makeManagedSecret(Spec{Issuer: IssuerSelfSigned, DNSNames: []string{"example.com"}})
+	options := &handlers.PutOptions{
+		Resource: &rpv1.OutputResource{
+			CreateResource: &rpv1.Resource{Data: secret},
+		},
+	}
+	err := manager.TransformSecret(context.Background(), options)
+	require.NoError(t, err)
+	require.NotEmpty(t, secret.Data[corev1.TLSCertKey])
+
+	options.Resource.CreateResource.Data = &corev1.ConfigMap{}
+	err = manager.TransformSecret(context.Background(), options)
+	require.EqualError(t, err, "cannot transform secret")
+}
+
+func Test_Manager_TransformSecret_DefersACME(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeACMEServer(t)
+	solver := NewHTTP01Solver()
+	server.solver = solver
+
+	k8sClient := fake.NewClientBuilder().Build()
+	manager := NewManager(k8sClient)
+	manager.Solver = solver
+
+	spec := Spec{
+		Issuer:           IssuerACME,
+		DNSNames:         []string{"example.com"},
+		RenewBefore:      DefaultRenewBefore,
+		ACMEDirectoryURL: server.URL() + "/directory",
+	}
+	secret := makeManagedSecret(spec)
+	options := &handlers.PutOptions{
+		Resource: &rpv1.OutputResource{
+			CreateResource: &rpv1.Resource{Data: secret},
+		},
+	}
+
+	// The Gateway is not deployed yet, so the Secret gets a temporary certificate of the internal certificate
+	// authority and the ACME server is not contacted.
+	err := manager.TransformSecret(ctx, options)
+	require.NoError(t, err)
+	require.Equal(t, "true", secret.Labels[LabelPendingCertificate])
+	require.NotEmpty(t, secret.Data[SecretKeyCACertificate])
+	require.Equal(t, 0, server.accounts)
+	err = k8sClient.Create(ctx, secret)
+	require.NoError(t, err)
+
+	// A re-deployment keeps the temporary certificate while it is pending.
+	redeployed := makeManagedSecret(spec)
+	options.Resource.CreateResource.Data = redeployed
+	err = manager.TransformSecret(ctx, options)
+	require.NoError(t, err)
+	require.Equal(t, secret.Data, redeployed.Data)
+	require.Equal(t, "true", redeployed.Labels[LabelPendingCertificate])
+
+	// The renewal service issues the certificate of the ACME server.
+	err = manager.Ensure(ctx, secret)
+	require.NoError(t, err)
+	require.NotContains(t, secret.Labels, LabelPendingCertificate)
+	require.NotContains(t, secret.Data, SecretKeyCACertificate)
+	verifyCertificate(t, &Certificate{CertificatePEM: secret.Data[corev1.TLSCertKey], PrivateKeyPEM: secret.Data[corev1.TLSPrivateKeyKey]}, server.ca)
+	err = k8sClient.Update(ctx, secret)
+	require.NoError(t, err)
+
+	// Later deployments reuse the issued certificate.
+	redeployed = makeManagedSecret(spec)
+	options.Resource.CreateResource.Data = redeployed
+	err = manager.TransformSecret(ctx, options)
+	require.NoError(t, err)
+	require.Equal(t, secret.Data, redeployed.Data)
+	require.NotContains(t, redeployed.Labels, LabelPendingCertificate)
+}
+
+func makeManagedSecret(spec Spec) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "test-tls",
+			Labels:      map[string]string{LabelManagedCertificate: "true"},
+			Annotations: spec.Annotations(),
+		},
+		Type: corev1.SecretTypeTLS,
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+const (
+	// DefaultSelfSignedValidity is how long certificates issued by the internal certificate authority are valid.
+	DefaultSelfSignedValidity = 90 * 24 * time.Hour
+
+	// certificateAuthorityValidity is how long the internal certificate authority is valid.
+	certificateAuthorityValidity = 10 * 365 * 24 * time.Hour
+)
+
+// CertificateAuthority is a certificate authority that signs certificates issued by the SelfSignedIssuer.
+type CertificateAuthority struct {
+	// Certificate is the certificate of the certificate authority.
+	Certificate *x509.Certificate
+	// Key is the private key of the certificate authority.
+	Key crypto.Signer
+	// CertificatePEM is the PEM encoded certificate of the certificate authority.
+	CertificatePEM []byte
+	// PrivateKeyPEM is the PEM encoded private key of the certificate authority.
+	PrivateKeyPEM []byte
+}
+
+// NewCertificateAuthority generates a new self-signed certificate authority with the given common name.
+func NewCertificateAuthority(commonName string, now time.Time) (*CertificateAuthority, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: commonName, Organization: []string{"Radius"}},
+		NotBefore:             now.Add(-5 * time.Minute),
+		NotAfter:              now.Add(certificateAuthorityValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPEM, err := encodePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return LoadCertificateAuthority(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), keyPEM)
+}
+
+// LoadCertificateAuthority loads a certificate authority from its PEM encoded certificate and private key.
+func LoadCertificateAuthority(certificatePEM []byte, privateKeyPEM []byte) (*CertificateAuthority, error) {
+	certificate, err := parseLeafCertificate(certificatePEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate authority: %w", err)
+	}
+
+	if !certificate.IsCA {
+		return nil, errors.New("certificate is not a certificate authority")
+	}
+
+	key, err := parsePrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate authority private key: %w", err)
+	}
+
+	return &CertificateAuthority{
+		Certificate:    certificate,
+		Key:            key,
+		CertificatePEM: certificatePEM,
+		PrivateKeyPEM:  privateKeyPEM,
+	}, nil
+}
+
+// SelfSignedIssuer issues certificates signed by an internal certificate authority. Clients don't trust these
+// certificates unless they trust the certificate authority, so the issuer is intended for development environments.
+type SelfSignedIssuer struct {
+	// CA is the certificate authority that signs the certificates.
+	CA *CertificateAuthority
+	// Validity is how long issued certificates are valid. Defaults to DefaultSelfSignedValidity.
+	Validity time.Duration
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+var _ Issuer = (*SelfSignedIssuer)(nil)
+
+// Issue generates a new key and a certificate for the given DNS names signed by the certificate authority.
+func (i *SelfSignedIssuer) Issue(ctx context.Context, dnsNames []string) (*Certificate, error) {
+	if len(dnsNames) == 0 {
+		return nil, errors.New("at least one DNS name is required to issue a certificate")
+	}
+
+	now := time.Now()
+	if i.Now != nil {
+		now = i.Now()
+	}
+
+	validity := i.Validity
+	if validity == 0 {
+		validity = DefaultSelfSignedValidity
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    now.Add(-5 * time.Minute),
+		NotAfter:     now.Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, i.CA.Certificate, key.Public(), i.CA.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPEM, err := encodePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	certificatePEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return &Certificate{
+		CertificatePEM:   append(certificatePEM, i.CA.CertificatePEM...),
+		PrivateKeyPEM:    keyPEM,
+		CACertificatePEM: i.CA.CertificatePEM,
+	}, nil
+}
+
+func newSerialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+func encodePrivateKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+func parsePrivateKey(privateKeyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, errors.New("no private key found in PEM data")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key cannot sign")
+	}
+
+	return signer, nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_NewCertificateAuthority(t *testing.T) {
+	ca, err := NewCertificateAuthority("Test CA", time.Now())
+	require.NoError(t, err)
+	require.True(t, ca.Certificate.IsCA)
+	require.Equal(t, "Test CA", ca.Certificate.Subject.CommonName)
+
+	loaded, err := LoadCertificateAuthority(ca.CertificatePEM, ca.PrivateKeyPEM)
+	require.NoError(t, err)
+	require.Equal(t, ca.Certificate.SerialNumber, loaded.Certificate.SerialNumber)
+}
+
+func Test_LoadCertificateAuthority_Invalid(t *testing.T) {
+	ca, err := NewCertificateAuthority("Test CA", time.Now())
+	require.NoError(t, err)
+
+	issuer := &SelfSignedIssuer{CA: ca}
+	certificate, err := issuer.Issue(context.Background(), []string{"example.com"})
+	require.NoError(t, err)
+
+	_, err = LoadCertificateAuthority([]byte("invalid"), ca.PrivateKeyPEM)
+	require.Error(t, err)
+
+	_, err = LoadCertificateAuthority(ca.CertificatePEM, []byte("invalid"))
+	require.Error(t, err)
+
+	_, err = LoadCertificateAuthority(certificate.CertificatePEM, certificate.PrivateKeyPEM)
+	require.EqualError(t, err, "certificate is not a certificate authority")
+}
+
+func Test_SelfSignedIssuer_Issue(t *testing.T) {
+	now := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	ca, err := NewCertificateAuthority("Test CA", now)
+	require.NoError(t, err)
+
+	issuer := &SelfSignedIssuer{CA: ca, Now: func() time.Time { return now }}
+	dnsNames := []string{"example.com", "www.example.com"}
+	certificate, err := issuer.Issue(context.Background(), dnsNames)
+	require.NoError(t, err)
+	require.Equal(t, ca.CertificatePEM, certificate.CACertificatePEM)
+	require.Equal(t, ca.CertificatePEM, certificate.SecretData()[SecretKeyCACertificate])
+	require.NotEmpty(t, certificate.SecretData()[corev1.TLSCertKey])
+
+	leaf, err := parseLeafCertificate(certificate.CertificatePEM)
+	require.NoError(t, err)
+	require.Equal(t, dnsNames, leaf.DNSNames)
+	require.Equal(t, now.Add(DefaultSelfSignedValidity), leaf.NotAfter)
+	require.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, leaf.ExtKeyUsage)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Certificate)
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: roots, DNSName: "www.example.com", CurrentTime: now})
+	require.NoError(t, err)
+}
+
+func Test_SelfSignedIssuer_Issue_NoDNSNames(t *testing.T) {
+	ca, err := NewCertificateAuthority("Test CA", time.Now())
+	require.NoError(t, err)
+
+	issuer := &SelfSignedIssuer{CA: ca}
+	_, err = issuer.Issue(context.Background(), nil)
+	require.EqualError(t, err, "at least one DNS name is required to issue a certificate")
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificateservice
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/corerp/certificates"
+	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultRenewalInterval is how often managed certificates are checked for renewal when not configured.
+	DefaultRenewalInterval = 12 * time.Hour
+	// PendingInterval is how often the certificates of pending Secrets are issued until they succeed.
+	PendingInterval = time.Minute
+)
+
+// Service is a hosting service that periodically renews the managed certificates that are about to expire, issues the
+// certificates of pending Secrets once their Gateway is deployed, and serves the ACME HTTP-01 challenges of the
+// certificates.DefaultHTTP01Solver when a challenge port is configured.
+type Service struct {
+	// Options is the configuration of the service.
+	Options hostoptions.CertificateOptions
+	// Client is the Kubernetes client used to read and update managed Secrets.
+	Client client.Client
+	// Manager issues the certificates.
+	Manager *certificates.Manager
+}
+
+// NewService creates a new certificate renewal Service from the host options.
+func NewService(options hostoptions.HostOptions) (*Service, error) {
+	if options.K8sConfig == nil {
+		return nil, errors.New("kubernetes configuration is required for certificate renewal")
+	}
+
+	k8sClient, err := kubeutil.NewRuntimeClient(options.K8sConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		Options: options.Config.Certificates,
+		Client:  k8sClient,
+		Manager: certificates.NewManager(k8sClient),
+	}, nil
+}
+
+// Name returns the name of the certificate renewal service.
+func (s *Service) Name() string {
+	return "certificate renewal"
+}
+
+// Run renews the managed certificates every renewal interval, and issues the certificates of pending Secrets every
+// PendingInterval, until the context is cancelled.
+func (s *Service) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	interval := DefaultRenewalInterval
+	if s.Options.RenewalInterval != "" {
+		d, err := time.ParseDuration(s.Options.RenewalInterval)
+		if err != nil {
+			return fmt.Errorf("invalid certificate renewal interval %q: %w", s.Options.RenewalInterval, err)
+		}
+		interval = d
+	}
+
+	if s.Options.ACMEChallengePort != 0 {
+		go s.serveChallenges(ctx)
+	}
+
+	ticker := time.NewTicker(min(interval, PendingInterval))
+	defer ticker.Stop()
+
+	var nextRenewal time.Time
+	for {
+		var err error
+		if now := time.Now(); !now.Before(nextRenewal) {
+			nextRenewal = now.Add(interval)
+			err = s.Renew(ctx)
+		} else {
+			err = s.RenewPending(ctx)
+		}
+		if err != nil {
+			logger.Error(err, "Failed to renew certificates")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Renew issues new certificates for the managed Secrets whose certificates are about to expire or are pending. It
+// continues with the remaining Secrets when a certificate fails to renew, and returns the errors of all failed
+// renewals.
+func (s *Service) Renew(ctx context.Context) error {
+	return s.renew(ctx, client.MatchingLabels{certificates.LabelManagedCertificate: "true"})
+}
+
+// RenewPending issues the certificates of the pending Secrets, which hold a temporary certificate until the ACME
+// server validates the challenges routed by their Gateway.
+func (s *Service) RenewPending(ctx context.Context) error {
+	return s.renew(ctx, client.MatchingLabels{certificates.LabelManagedCertificate: "true", certificates.LabelPendingCertificate: "true"})
+}
+
+func (s *Service) renew(ctx context.Context, labels client.MatchingLabels) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	secrets := &corev1.SecretList{}
+	err := s.Client.List(ctx, secrets, labels)
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	for i := range secrets.Items {
+		secret := secrets.Items[i].DeepCopy()
+		err = s.Manager.Ensure(ctx, secret)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if bytes.Equal(secret.Data[corev1.TLSCertKey], secrets.Items[i].Data[corev1.TLSCertKey]) {
+			continue
+		}
+
+		logger.Info(fmt.Sprintf("Renewing certificate of secret %s/%s", secret.Namespace, secret.Name))
+		err = s.Client.Update(ctx, secret)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// serveChallenges serves the HTTP-01 challenges of the certificates.DefaultHTTP01Solver until the context is cancelled.
+func (s *Service) serveChallenges(ctx context.Context) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	mux := http.NewServeMux()
+	mux.Handle(certificates.HTTP01ChallengePathPrefix, certificates.DefaultHTTP01Solver)
+	server := &http.Server{
+		Addr:              ":" + strconv.Itoa(s.Options.ACMEChallengePort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext: func(ln net.Listener) context.Context {
+			return ctx
+		},
+	}
+
+	// Handle shutdown based on the context
+	go func() {
+		<-ctx.Done()
+		// We don't care about shutdown errors
+		_ = server.Shutdown(context.Background())
+	}()
+
+	logger.Info(fmt.Sprintf("ACME challenge server listening on port: '%d'...", s.Options.ACMEChallengePort))
+	err := server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		logger.Error(err, "ACME challenge server failed")
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificateservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/corerp/certificates"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_Service_Renew(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	spec := certificates.Spec{Issuer: certificates.IssuerSelfSigned, DNSNames: []string{"example.com"}}
+	k8sClient := fake.NewClientBuilder().WithObjects(
+		makeSecret("missing", map[string]string{certificates.LabelManagedCertificate: "true"}, spec.Annotations()),
+		makeSecret("unmanaged", nil, spec.Annotations()),
+	).Build()
+
+	manager := certificates.NewManager(k8sClient)
+	manager.Now = func() time.Time { return now }
+	svc := &Service{Client: k8sClient, Manager: manager}
+
+	err := svc.Renew(ctx)
+	require.NoError(t, err)
+
+	missing := getSecret(t, k8sClient, "missing")
+	require.NotEmpty(t, missing.Data[corev1.TLSCertKey])
+	require.Empty(t, getSecret(t, k8sClient, "unmanaged").Data)
+
+	// Valid certificates are left unchanged.
+	err = svc.Renew(ctx)
+	require.NoError(t, err)
+	require.Equal(t, missing.Data, getSecret(t, k8sClient, "missing").Data)
+
+	// Certificates that are about to expire are renewed.
+	now = now.Add(certificates.DefaultSelfSignedValidity)
+	err = svc.Renew(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, missing.Data[corev1.TLSCertKey], getSecret(t, k8sClient, "missing").Data[corev1.TLSCertKey])
+}
+
+func Test_Service_Renew_ContinuesOnError(t *testing.T) {
+	ctx := context.Background()
+	labels := map[string]string{certificates.LabelManagedCertificate: "true"}
+	k8sClient := fake.NewClientBuilder().WithObjects(
+		makeSecret("invalid", labels, certificates.Spec{Issuer: "vault", DNSNames: []string{"example.com"}}.Annotations()),
+		makeSecret("valid", labels, certificates.Spec{Issuer: certificates.IssuerSelfSigned, DNSNames: []string{"example.com"}}.Annotations()),
+	).Build()
+
+	svc := &Service{Client: k8sClient, Manager: certificates.NewManager(k8sClient)}
+
+	err := svc.Renew(ctx)
+	require.ErrorContains(t, err, "unsupported certificate issuer \"vault\"")
+	require.NotEmpty(t, getSecret(t, k8sClient, "valid").Data[corev1.TLSCertKey])
+}
+
+func Test_Service_RenewPending(t *testing.T) {
+	ctx := context.Background()
+	spec := certificates.Spec{Issuer: certificates.IssuerSelfSigned, DNSNames: []string{"example.com"}}
+	k8sClient := fake.NewClientBuilder().WithObjects(
+		makeSecret("pending", map[string]string{certificates.LabelManagedCertificate: "true", certificates.LabelPendingCertificate: "true"}, spec.Annotations()),
+		makeSecret("missing", map[string]string{certificates.LabelManagedCertificate: "true"}, spec.Annotations()),
+	).Build()
+
+	svc := &Service{Client: k8sClient, Manager: certificates.NewManager(k8sClient)}
+
+	err := svc.RenewPending(ctx)
+	require.NoError(t, err)
+
+	pending := getSecret(t, k8sClient, "pending")
+	require.NotEmpty(t, pending.Data[corev1.TLSCertKey])
+	require.NotContains(t, pending.Labels, certificates.LabelPendingCertificate)
+
+	// Only pending Secrets are issued.
+	require.Empty(t, getSecret(t, k8sClient, "missing").Data)
+}
+
+func Test_Service_Run(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().Build()
+	svc := &Service{
+		Options: hostoptions.CertificateOptions{RenewalInterval: "1h"},
+		Client:  k8sClient,
+		Manager: certificates.NewManager(k8sClient),
+	}
+	require.Equal(t, "certificate renewal", svc.Name())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := svc.Run(ctx)
+	require.NoError(t, err)
+
+	svc.Options.RenewalInterval = "soon"
+	err = svc.Run(context.Background())
+	require.EqualError(t, err, "invalid certificate renewal interval \"soon\": time: invalid duration \"soon\"")
+}
+
+func makeSecret(name string, labels map[string]string, annotations map[string]string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Type: corev1.SecretTypeTLS,
+	}
+}
+
+func getSecret(t *testing.T, k8sClient client.Client, name string) *corev1.Secret {
+	secret := &corev1.Secret{}
+	err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: name}, secret)
+	require.NoError(t, err)
+	return secret
+}
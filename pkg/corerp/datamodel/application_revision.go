@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+const ApplicationRevisionResourceType = "Applications.Core/applications/revisions"
+
+// ApplicationRevisionReason is the reason an application revision was created.
+type ApplicationRevisionReason string
+
+const (
+	// ApplicationRevisionReasonDeployment means the revision was recorded after the resources of the application changed.
+	ApplicationRevisionReasonDeployment ApplicationRevisionReason = "Deployment"
+	// ApplicationRevisionReasonRollback means the revision was created by rolling back to a previous revision.
+	ApplicationRevisionReasonRollback ApplicationRevisionReason = "Rollback"
+)
+
+var _ v1.DataModelInterface = (*ApplicationRevision)(nil)
+
+// ApplicationRevision is a snapshot of the resources of an application. Revisions are stored as nested
+// resources of the application: <application id>/revisions/<revision>.
+type ApplicationRevision struct {
+	// ID is the resource id of the revision.
+	ID string `json:"id"`
+
+	// Application is the lowercased resource id of the application. It is used to query the revisions of an application.
+	Application string `json:"application"`
+
+	// Revision is the revision number.
+	Revision int32 `json:"revision"`
+
+	// CreatedAt is the time the revision was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Reason is the reason the revision was created.
+	Reason ApplicationRevisionReason `json:"reason"`
+
+	// RolledBackFrom is the revision that was re-applied by a rollback.
+	RolledBackFrom int32 `json:"rolledBackFrom,omitempty"`
+
+	// ProvisioningState is the state of the revision. Revisions created by a rollback are Accepted until the rollback completes.
+	ProvisioningState v1.ProvisioningState `json:"provisioningState,omitempty"`
+
+	// OperationID is the id of the rollback operation that created the revision.
+	OperationID string `json:"operationId,omitempty"`
+
+	// DeleteResources is true if the rollback that created the revision deletes the resources of the application
+	// that are not part of the revision. Otherwise they are kept and added to the revision once the rollback completes.
+	DeleteResources bool `json:"deleteResources,omitempty"`
+
+	// Resources is the list of resources in the revision.
+	Resources []ApplicationRevisionResource `json:"resources"`
+}
+
+// ResourceTypeName returns the resource type of the ApplicationRevision.
+func (r *ApplicationRevision) ResourceTypeName() string {
+	return ApplicationRevisionResourceType
+}
+
+// ApplicationRevisionResource is a resource recorded in an application revision.
+type ApplicationRevisionResource struct {
+	// ID is the resource id.
+	ID string `json:"id"`
+
+	// Type is the resource type.
+	Type string `json:"type"`
+
+	// Name is the resource name.
+	Name string `json:"name"`
+
+	// Properties is the user-specified properties of the resource.
+	Properties map[string]any `json:"properties"`
+
+	// Recipe is the recipe that provisioned the resource, if any.
+	Recipe *rpv1.RecipeStatus `json:"recipe,omitempty"`
+}
+
+// ApplicationRollbackRequest represents the input of the application rollback action.
+type ApplicationRollbackRequest struct {
+	// Revision is the revision to roll back to.
+	Revision int32 `json:"revision"`
+
+	// DeleteResources is true if the resources of the application that are not part of the revision are deleted.
+	DeleteResources bool `json:"deleteResources,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the ApplicationRollbackRequest.
+func (r *ApplicationRollbackRequest) ResourceTypeName() string {
+	return ApplicationResourceType
+}
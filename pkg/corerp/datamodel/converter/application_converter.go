@@ -17,38 +17,22 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
+// applicationConverter converts applications between the datamodel and the versioned model of each API version.
+var applicationConverter = apiversions.NewConverter[datamodel.Application](datamodel.ApplicationResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.ApplicationResource{} })
+
 // ApplicationDataModelToVersioned converts version agnostic application datamodel to versioned model.
 func ApplicationDataModelToVersioned(model *datamodel.Application, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.ApplicationResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return applicationConverter.ToVersioned(model, version)
 }
 
 // ApplicationDataModelFromVersioned converts versioned application model to datamodel.
 func ApplicationDataModelFromVersioned(content []byte, version string) (*datamodel.Application, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.ApplicationResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		return dm.(*datamodel.Application), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return applicationConverter.FromVersioned(content, version)
 }
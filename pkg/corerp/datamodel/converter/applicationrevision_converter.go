@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"encoding/json"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+// ApplicationRevisionDataModelToVersioned converts version agnostic ApplicationRevision datamodel to versioned model.
+func ApplicationRevisionDataModelToVersioned(model *datamodel.ApplicationRevision, version string) (v1.VersionedModelInterface, error) {
+	switch version {
+	case v20231001preview.Version:
+		versioned := &v20231001preview.ApplicationRevision{}
+		err := versioned.ConvertFrom(model)
+		return versioned, err
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
+
+// ApplicationRevisionListDataModelToVersioned converts a list of version agnostic ApplicationRevision datamodels to
+// the versioned list model.
+func ApplicationRevisionListDataModelToVersioned(models []*datamodel.ApplicationRevision, version string) (any, error) {
+	switch version {
+	case v20231001preview.Version:
+		list := &v20231001preview.ApplicationRevisionList{Value: []*v20231001preview.ApplicationRevision{}}
+		for _, model := range models {
+			versioned := &v20231001preview.ApplicationRevision{}
+			if err := versioned.ConvertFrom(model); err != nil {
+				return nil, err
+			}
+			list.Value = append(list.Value, versioned)
+		}
+		return list, nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
+
+// ApplicationRollbackRequestDataModelFromVersioned converts the versioned ApplicationRollbackRequest to datamodel.
+func ApplicationRollbackRequestDataModelFromVersioned(content []byte, version string) (*datamodel.ApplicationRollbackRequest, error) {
+	switch version {
+	case v20231001preview.Version:
+		am := &v20231001preview.ApplicationRollbackRequest{}
+		if err := json.Unmarshal(content, am); err != nil {
+			return nil, err
+		}
+		dm, err := am.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		return dm.(*datamodel.ApplicationRollbackRequest), nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
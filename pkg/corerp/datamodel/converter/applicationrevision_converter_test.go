@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+// NOTE: this test is to validate the type conversion between versioned model and data model.
+// Converted content must be tested in ConvertFrom and ConvertTo tests in api models under /pkg/api/[api-version].
+
+func TestApplicationRevisionDataModelToVersioned(t *testing.T) {
+	dm := &datamodel.ApplicationRevision{Revision: 1, Reason: datamodel.ApplicationRevisionReasonDeployment}
+
+	am, err := ApplicationRevisionDataModelToVersioned(dm, "2023-10-01-preview")
+	require.NoError(t, err)
+	require.IsType(t, &v20231001preview.ApplicationRevision{}, am)
+
+	_, err = ApplicationRevisionDataModelToVersioned(dm, "unsupported")
+	require.ErrorIs(t, err, v1.ErrUnsupportedAPIVersion)
+}
+
+func TestApplicationRevisionListDataModelToVersioned(t *testing.T) {
+	dms := []*datamodel.ApplicationRevision{
+		{Revision: 2, Reason: datamodel.ApplicationRevisionReasonRollback, RolledBackFrom: 1},
+		{Revision: 1, Reason: datamodel.ApplicationRevisionReasonDeployment},
+	}
+
+	list, err := ApplicationRevisionListDataModelToVersioned(dms, "2023-10-01-preview")
+	require.NoError(t, err)
+	require.IsType(t, &v20231001preview.ApplicationRevisionList{}, list)
+	require.Len(t, list.(*v20231001preview.ApplicationRevisionList).Value, 2)
+
+	_, err = ApplicationRevisionListDataModelToVersioned(dms, "unsupported")
+	require.ErrorIs(t, err, v1.ErrUnsupportedAPIVersion)
+}
+
+func TestApplicationRollbackRequestDataModelFromVersioned(t *testing.T) {
+	dm, err := ApplicationRollbackRequestDataModelFromVersioned([]byte(`{"revision": 3}`), "2023-10-01-preview")
+	require.NoError(t, err)
+	require.Equal(t, int32(3), dm.Revision)
+
+	_, err = ApplicationRollbackRequestDataModelFromVersioned([]byte(`{"revision": "three"}`), "2023-10-01-preview")
+	require.Error(t, err)
+
+	_, err = ApplicationRollbackRequestDataModelFromVersioned([]byte(`{"revision": 3}`), "unsupported")
+	require.ErrorIs(t, err, v1.ErrUnsupportedAPIVersion)
+}
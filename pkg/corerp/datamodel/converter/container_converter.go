@@ -17,38 +17,22 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
+// containerConverter converts containers between the datamodel and the versioned model of each API version.
+var containerConverter = apiversions.NewConverter[datamodel.ContainerResource](datamodel.ContainerResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.ContainerResource{} })
+
 // ContainerDataModelToVersioned converts version agnostic Container datamodel to versioned model.
 func ContainerDataModelToVersioned(model *datamodel.ContainerResource, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.ContainerResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return containerConverter.ToVersioned(model, version)
 }
 
 // ContainerDataModelFromVersioned converts versioned Container model to datamodel.
 func ContainerDataModelFromVersioned(content []byte, version string) (*datamodel.ContainerResource, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.ContainerResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		return dm.(*datamodel.ContainerResource), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return containerConverter.FromVersioned(content, version)
 }
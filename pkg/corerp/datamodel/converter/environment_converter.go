@@ -17,43 +17,22 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
+// environmentConverter converts environments between the datamodel and the versioned model of each API version.
+var environmentConverter = apiversions.NewConverter[datamodel.Environment](datamodel.EnvironmentResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.EnvironmentResource{} })
+
 // EnvironmentDataModelToVersioned converts version agnostic environment datamodel to versioned model.
 func EnvironmentDataModelToVersioned(model *datamodel.Environment, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.EnvironmentResource{}
-		if err := versioned.ConvertFrom(model); err != nil {
-			return nil, err
-		}
-		return versioned, nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return environmentConverter.ToVersioned(model, version)
 }
 
 // EnvironmentDataModelFromVersioned converts versioned environment model to datamodel.
 func EnvironmentDataModelFromVersioned(content []byte, version string) (*datamodel.Environment, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.EnvironmentResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-		return dm.(*datamodel.Environment), nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return environmentConverter.FromVersioned(content, version)
 }
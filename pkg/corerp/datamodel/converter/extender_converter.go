@@ -17,47 +17,24 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
+// extenderConverter converts extenders between the datamodel and the versioned model of each API version.
+var extenderConverter = apiversions.NewConverter[datamodel.Extender](datamodel.ExtenderResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.ExtenderResource{} })
+
 // ExtenderDataModelToVersioned converts a datamodel.Extender to a versioned model interface based on the given version
 // string, returning an error if the conversion fails.
 func ExtenderDataModelToVersioned(model *datamodel.Extender, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.ExtenderResource{}
-		err := versioned.ConvertFrom(model)
-		if err != nil {
-			return nil, err
-		}
-
-		return versioned, nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return extenderConverter.ToVersioned(model, version)
 }
 
 // ExtenderDataModelFromVersioned unmarshals a JSON byte slice into a version-specific ExtenderResource struct, then
 // converts it to a datamodel.Extender struct and returns it, or returns an error if the unmarshal or conversion fails.
 func ExtenderDataModelFromVersioned(content []byte, version string) (*datamodel.Extender, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.ExtenderResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-		return dm.(*datamodel.Extender), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return extenderConverter.FromVersioned(content, version)
 }
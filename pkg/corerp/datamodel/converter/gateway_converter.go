@@ -17,38 +17,22 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
+// gatewayConverter converts gateways between the datamodel and the versioned model of each API version.
+var gatewayConverter = apiversions.NewConverter[datamodel.Gateway](datamodel.GatewayResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.GatewayResource{} })
+
 // GatewayDataModelToVersioned converts version agnostic Gateway datamodel to versioned model.
 func GatewayDataModelToVersioned(model *datamodel.Gateway, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.GatewayResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return gatewayConverter.ToVersioned(model, version)
 }
 
 // GatewayDataModelFromVersioned converts versioned Gateway model to datamodel.
 func GatewayDataModelFromVersioned(content []byte, version string) (*datamodel.Gateway, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.GatewayResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		return dm.(*datamodel.Gateway), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return gatewayConverter.FromVersioned(content, version)
 }
@@ -17,38 +17,22 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
+// httpRouteConverter converts HTTP routes between the datamodel and the versioned model of each API version.
+var httpRouteConverter = apiversions.NewConverter[datamodel.HTTPRoute](datamodel.HTTPRouteResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.HTTPRouteResource{} })
+
 // HTTPRouteDataModelToVersioned converts version agnostic HTTPRoute datamodel to versioned model.
 func HTTPRouteDataModelToVersioned(model *datamodel.HTTPRoute, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.HTTPRouteResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return httpRouteConverter.ToVersioned(model, version)
 }
 
 // HTTPRouteDataModelFromVersioned converts versioned HTTPRoute model to datamodel.
 func HTTPRouteDataModelFromVersioned(content []byte, version string) (*datamodel.HTTPRoute, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.HTTPRouteResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		return dm.(*datamodel.HTTPRoute), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return httpRouteConverter.FromVersioned(content, version)
 }
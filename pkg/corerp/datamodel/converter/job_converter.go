@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
+	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+// jobConverter converts jobs between the datamodel and the versioned model of each API version.
+var jobConverter = apiversions.NewConverter[datamodel.JobResource](datamodel.JobResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.JobResource{} })
+
+// JobDataModelToVersioned converts version agnostic Job datamodel to versioned model.
+func JobDataModelToVersioned(model *datamodel.JobResource, version string) (v1.VersionedModelInterface, error) {
+	return jobConverter.ToVersioned(model, version)
+}
+
+// JobDataModelFromVersioned converts versioned Job model to datamodel.
+func JobDataModelFromVersioned(content []byte, version string) (*datamodel.JobResource, error) {
+	return jobConverter.FromVersioned(content, version)
+}
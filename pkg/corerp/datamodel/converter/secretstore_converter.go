@@ -17,24 +17,19 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
+// secretStoreConverter converts secret stores between the datamodel and the versioned model of each API version.
+var secretStoreConverter = apiversions.NewConverter[datamodel.SecretStore](datamodel.SecretStoreResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.SecretStoreResource{} })
+
 // SecretStoreModelToVersioned converts version agnostic SecretStore datamodel to versioned model.
 func SecretStoreModelToVersioned(model *datamodel.SecretStore, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.SecretStoreResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return secretStoreConverter.ToVersioned(model, version)
 }
 
 // ListSecretsToVersioned converts version agnostic SecretStoreListSecrets datamodel to versioned model.
@@ -52,16 +47,5 @@ func ListSecretsToVersioned(model *datamodel.SecretStoreListSecrets, version str
 
 // SecretStoreModelFromVersioned converts versioned SecretStore model to datamodel.
 func SecretStoreModelFromVersioned(content []byte, version string) (*datamodel.SecretStore, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.SecretStoreResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		return dm.(*datamodel.SecretStore), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return secretStoreConverter.FromVersioned(content, version)
 }
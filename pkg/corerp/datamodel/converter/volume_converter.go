@@ -17,38 +17,22 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
+// volumeConverter converts volumes between the datamodel and the versioned model of each API version.
+var volumeConverter = apiversions.NewConverter[datamodel.VolumeResource](datamodel.VolumeResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.VolumeResource{} })
+
 // VolumeResourceModelToVersioned converts version agnostic Volume datamodel to versioned model.
 func VolumeResourceModelToVersioned(model *datamodel.VolumeResource, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.VolumeResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return volumeConverter.ToVersioned(model, version)
 }
 
 // VolumeResourceModelFromVersioned converts versioned Volume model to datamodel.
 func VolumeResourceModelFromVersioned(content []byte, version string) (*datamodel.VolumeResource, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.VolumeResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		return dm.(*datamodel.VolumeResource), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return volumeConverter.FromVersioned(content, version)
 }
@@ -43,6 +43,25 @@ type EnvironmentProperties struct {
 	Providers  Providers                                         `json:"providers,omitempty"`
 	Extensions []Extension                                       `json:"extensions,omitempty"`
 	Simulated  bool                                              `json:"simulated,omitempty"`
+	Gateway    *EnvironmentGateway                               `json:"gateway,omitempty"`
+}
+
+// EnvironmentGatewayKind represents the gateway implementation used by an environment.
+type EnvironmentGatewayKind string
+
+const (
+	// EnvironmentGatewayKindContour renders gateways as Contour HTTPProxy resources. This is the default.
+	EnvironmentGatewayKindContour EnvironmentGatewayKind = "contour"
+	// EnvironmentGatewayKindGatewayAPI renders gateways as Kubernetes Gateway API Gateway and HTTPRoute resources.
+	EnvironmentGatewayKindGatewayAPI EnvironmentGatewayKind = "gatewayAPI"
+)
+
+// EnvironmentGateway represents the gateway implementation configuration of the environment.
+type EnvironmentGateway struct {
+	// Kind is the gateway implementation used to render gateways.
+	Kind EnvironmentGatewayKind `json:"kind,omitempty"`
+	// GatewayClassName is the name of the Gateway API GatewayClass used when Kind is gatewayAPI.
+	GatewayClassName string `json:"gatewayClassName,omitempty"`
 }
 
 // EnvironmentRecipeProperties represents the properties of environment's recipe.
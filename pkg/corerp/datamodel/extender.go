@@ -57,6 +57,15 @@ func (r *Extender) ResourceTypeName() string {
 	return ExtenderResourceType
 }
 
+// WriteOnlyProperties returns the secrets of the extender, which are not returned by the API. Secrets of resources
+// provisioned by a recipe are outputs of the recipe, so they are not returned.
+func (r *Extender) WriteOnlyProperties() map[string]any {
+	if r.Properties.ResourceProvisioning != portableresources.ResourceProvisioningManual || len(r.Properties.Secrets) == 0 {
+		return nil
+	}
+	return map[string]any{"secrets": r.Properties.Secrets}
+}
+
 // Recipe returns the ResourceRecipe associated with the Extender if the ResourceProvisioning is not set to Manual,
 // otherwise it returns nil.
 func (r *Extender) Recipe() *portableresources.ResourceRecipe {
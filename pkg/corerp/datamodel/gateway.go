@@ -72,9 +72,75 @@ type GatewayProperties struct {
 
 // GatewayRoute represents the route attached to Gateway.
 type GatewayRoute struct {
-	Destination   string `json:"destination,omitempty"`
-	Path          string `json:"path,omitempty"`
-	ReplacePrefix string `json:"replacePrefix,omitempty"`
+	Destination     string                            `json:"destination,omitempty"`
+	Destinations    []GatewayRouteDestination         `json:"destinations,omitempty"`
+	Path            string                            `json:"path,omitempty"`
+	ReplacePrefix   string                            `json:"replacePrefix,omitempty"`
+	Headers         []GatewayRouteHeaderMatch         `json:"headers,omitempty"`
+	QueryParameters []GatewayRouteQueryParameterMatch `json:"queryParameters,omitempty"`
+	Methods         []string                          `json:"methods,omitempty"`
+	TimeoutPolicy   *GatewayRouteTimeoutPolicy        `json:"timeoutPolicy,omitempty"`
+	RetryPolicy     *GatewayRouteRetryPolicy          `json:"retryPolicy,omitempty"`
+	RequestHeaders  *GatewayRouteHeaderPolicy         `json:"requestHeaders,omitempty"`
+	ResponseHeaders *GatewayRouteHeaderPolicy         `json:"responseHeaders,omitempty"`
+}
+
+// GetDestinations returns the weighted destinations of the route. A route with a single destination is returned
+// as one destination without a weight.
+func (r *GatewayRoute) GetDestinations() []GatewayRouteDestination {
+	if len(r.Destinations) > 0 {
+		return r.Destinations
+	}
+
+	return []GatewayRouteDestination{{Destination: r.Destination}}
+}
+
+// HasRoutingRules returns true if the route splits traffic between weighted destinations, matches requests on anything
+// other than the path, or configures timeouts, retries or header rewriting.
+func (r *GatewayRoute) HasRoutingRules() bool {
+	return len(r.Destinations) > 0 || len(r.Headers) > 0 || len(r.QueryParameters) > 0 || len(r.Methods) > 0 ||
+		r.TimeoutPolicy != nil || r.RetryPolicy != nil || r.RequestHeaders != nil || r.ResponseHeaders != nil
+}
+
+// GatewayRouteDestination represents a weighted destination of a Gateway route.
+type GatewayRouteDestination struct {
+	Destination string `json:"destination,omitempty"`
+	Weight      int32  `json:"weight,omitempty"`
+}
+
+// GatewayRouteHeaderMatch represents a request header match of a Gateway route.
+type GatewayRouteHeaderMatch struct {
+	Name     string `json:"name,omitempty"`
+	Exact    string `json:"exact,omitempty"`
+	Contains string `json:"contains,omitempty"`
+	Present  bool   `json:"present,omitempty"`
+}
+
+// GatewayRouteQueryParameterMatch represents a query parameter match of a Gateway route.
+type GatewayRouteQueryParameterMatch struct {
+	Name    string `json:"name,omitempty"`
+	Exact   string `json:"exact,omitempty"`
+	Prefix  string `json:"prefix,omitempty"`
+	Present bool   `json:"present,omitempty"`
+}
+
+// GatewayRouteTimeoutPolicy represents the timeouts of a Gateway route.
+type GatewayRouteTimeoutPolicy struct {
+	Response string `json:"response,omitempty"`
+	Idle     string `json:"idle,omitempty"`
+}
+
+// GatewayRouteRetryPolicy represents the retry policy of a Gateway route.
+type GatewayRouteRetryPolicy struct {
+	Count         int32    `json:"count,omitempty"`
+	PerTryTimeout string   `json:"perTryTimeout,omitempty"`
+	RetryOn       []string `json:"retryOn,omitempty"`
+}
+
+// GatewayRouteHeaderPolicy represents the headers that a Gateway route sets or removes.
+type GatewayRouteHeaderPolicy struct {
+	Set    map[string]string `json:"set,omitempty"`
+	Remove []string          `json:"remove,omitempty"`
 }
 
 // GatewayPropertiesHostname - Declare hostname information for the Gateway.
@@ -88,6 +154,31 @@ type GatewayPropertiesTLS struct {
 	SSLPassthrough         bool                      `json:"sslPassthrough,omitempty"`
 	MinimumProtocolVersion MinimumTLSProtocolVersion `json:"minimumProtocolVersion,omitempty"`
 	CertificateFrom        string                    `json:"certificateFrom,omitempty"`
+	CertificateIssuer      *GatewayCertificateIssuer `json:"certificateIssuer,omitempty"`
+}
+
+// IsTerminated returns true if TLS is terminated at the Gateway, using either a certificate from a secret store or an
+// automatically issued certificate.
+func (t *GatewayPropertiesTLS) IsTerminated() bool {
+	return t != nil && (t.CertificateFrom != "" || t.CertificateIssuer != nil)
+}
+
+// GatewayCertificateIssuerKind represents the kind of issuer that issues the certificate of a Gateway.
+type GatewayCertificateIssuerKind string
+
+const (
+	// GatewayCertificateIssuerKindACME issues certificates from an ACME server such as Let's Encrypt.
+	GatewayCertificateIssuerKindACME GatewayCertificateIssuerKind = "acme"
+	// GatewayCertificateIssuerKindSelfSigned issues certificates from an internal certificate authority.
+	GatewayCertificateIssuerKindSelfSigned GatewayCertificateIssuerKind = "selfSigned"
+)
+
+// GatewayCertificateIssuer - Declare how the certificate of the Gateway is issued automatically.
+type GatewayCertificateIssuer struct {
+	Kind             GatewayCertificateIssuerKind `json:"kind,omitempty"`
+	ACMEDirectoryURL string                       `json:"acmeDirectoryUrl,omitempty"`
+	Email            string                       `json:"email,omitempty"`
+	RenewBefore      string                       `json:"renewBefore,omitempty"`
 }
 
 // IsValid checks if the given MinimumTLSProtocolVersion is valid.
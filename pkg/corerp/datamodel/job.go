@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+const JobResourceType = "Applications.Core/jobs"
+
+// JobResource represents Job resource.
+type JobResource struct {
+	v1.BaseResource
+
+	// PortableResourceMetadata stores the computed and secret values of the job's container, which is rendered by the
+	// container renderer, so that the deployment processor can read them like it does for containers.
+	PortableResourceMetadata
+
+	// Properties is the properties of the resource.
+	Properties JobProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the qualified name of the resource.
+func (j JobResource) ResourceTypeName() string {
+	return JobResourceType
+}
+
+// ApplyDeploymentOutput updates the JobResource's Properties, ComputedValues and SecretValues with
+// the DeploymentOutput's DeployedOutputResources, ComputedValues and SecretValues respectively and returns no error.
+func (j *JobResource) ApplyDeploymentOutput(do rpv1.DeploymentOutput) error {
+	j.Properties.Status.OutputResources = do.DeployedOutputResources
+	j.ComputedValues = do.ComputedValues
+	j.SecretValues = do.SecretValues
+	return nil
+}
+
+// OutputResources returns the OutputResources from the JobResource's Properties Status.
+func (j *JobResource) OutputResources() []rpv1.OutputResource {
+	return j.Properties.Status.OutputResources
+}
+
+// ResourceMetadata returns the BasicResourceProperties of the JobResource instance.
+func (j *JobResource) ResourceMetadata() *rpv1.BasicResourceProperties {
+	return &j.Properties.BasicResourceProperties
+}
+
+// IsScheduled returns true if the job runs on a cron schedule rather than once.
+func (j *JobResource) IsScheduled() bool {
+	return j.Properties.Schedule != ""
+}
+
+// JobProperties represents the properties of Job.
+type JobProperties struct {
+	rpv1.BasicResourceProperties
+	Connections map[string]ConnectionProperties `json:"connections,omitempty"`
+	Container   Container                       `json:"container,omitempty"`
+	Extensions  []Extension                     `json:"extensions,omitempty"`
+	Identity    *rpv1.IdentitySettings          `json:"identity,omitempty"`
+	Runtimes    *RuntimeProperties              `json:"runtimes,omitempty"`
+
+	// Schedule is the cron schedule of the job. An empty schedule means that the job runs once.
+	Schedule string `json:"schedule,omitempty"`
+
+	// RestartPolicy is the restart policy of the job's pod. Either "OnFailure" or "Never".
+	RestartPolicy string `json:"restartPolicy,omitempty"`
+
+	// BackoffLimit is the number of retries before the job is marked as failed.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// ActiveDeadlineSeconds is the duration in seconds that the job may be active before it is terminated.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// ConcurrencyPolicy specifies how to treat concurrent executions of a scheduled job.
+	ConcurrencyPolicy JobConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+}
+
+// JobConcurrencyPolicy specifies how to treat concurrent executions of a scheduled job.
+type JobConcurrencyPolicy string
+
+const (
+	// JobConcurrencyPolicyAllow allows concurrent executions of the scheduled job.
+	JobConcurrencyPolicyAllow JobConcurrencyPolicy = "Allow"
+
+	// JobConcurrencyPolicyForbid skips the new execution if the previous one hasn't finished yet.
+	JobConcurrencyPolicyForbid JobConcurrencyPolicy = "Forbid"
+
+	// JobConcurrencyPolicyReplace cancels the running execution and replaces it with the new one.
+	JobConcurrencyPolicyReplace JobConcurrencyPolicy = "Replace"
+)
@@ -26,6 +26,12 @@ const VolumeResourceType = "Applications.Core/volumes"
 const (
 	// AzureKeyVaultVolume represents the resource of azure keyvault volume.
 	AzureKeyVaultVolume string = "azure.com.keyvault"
+	// PersistentVolumeClaimVolume represents the resource of Kubernetes persistent volume claim volume.
+	PersistentVolumeClaimVolume string = "kubernetes.persistentVolumeClaim"
+	// ConfigMapVolume represents the resource of Kubernetes config map volume.
+	ConfigMapVolume string = "kubernetes.configMap"
+	// EmptyDirVolume represents the resource of Kubernetes empty directory volume.
+	EmptyDirVolume string = "kubernetes.emptyDir"
 )
 
 // VolumeResource represents VolumeResource resource.
@@ -70,6 +76,12 @@ type VolumeResourceProperties struct {
 	Kind string `json:"kind,omitempty"`
 	// AzureKeyVault represents Azure Keyvault volume properties
 	AzureKeyVault *AzureKeyVaultVolumeProperties `json:"azureKeyVault,omitempty"`
+	// PersistentVolumeClaim represents Kubernetes persistent volume claim volume properties
+	PersistentVolumeClaim *PersistentVolumeClaimVolumeProperties `json:"persistentVolumeClaim,omitempty"`
+	// ConfigMap represents Kubernetes config map volume properties
+	ConfigMap *ConfigMapVolumeProperties `json:"configMap,omitempty"`
+	// EmptyDir represents Kubernetes empty directory volume properties
+	EmptyDir *EmptyDirVolumeProperties `json:"emptyDir,omitempty"`
 }
 
 // AzureKeyVaultVolumeProperties represents the volume for Azure Keyvault.
@@ -84,6 +96,50 @@ type AzureKeyVaultVolumeProperties struct {
 	Secrets map[string]SecretObjectProperties `json:"secrets,omitempty"`
 }
 
+// PersistentVolumeClaimVolumeProperties represents the volume for Kubernetes persistent volume claim.
+type PersistentVolumeClaimVolumeProperties struct {
+	// The requested storage size of the volume, for example 10Gi
+	Size string `json:"size"`
+	// The name of the Kubernetes storage class. The cluster default storage class is used if empty.
+	StorageClassName string `json:"storageClassName,omitempty"`
+	// The access mode of the volume. Default ReadWriteOnce
+	AccessMode PersistentVolumeAccessMode `json:"accessMode,omitempty"`
+	// What happens to the persistent volume claim when the volume resource is deleted. Default Delete
+	RetentionPolicy VolumeRetentionPolicy `json:"retentionPolicy,omitempty"`
+}
+
+// PersistentVolumeAccessMode is the access mode of a persistent volume claim.
+type PersistentVolumeAccessMode string
+
+const (
+	PersistentVolumeAccessModeReadWriteOnce    PersistentVolumeAccessMode = "ReadWriteOnce"
+	PersistentVolumeAccessModeReadOnlyMany     PersistentVolumeAccessMode = "ReadOnlyMany"
+	PersistentVolumeAccessModeReadWriteMany    PersistentVolumeAccessMode = "ReadWriteMany"
+	PersistentVolumeAccessModeReadWriteOncePod PersistentVolumeAccessMode = "ReadWriteOncePod"
+)
+
+// VolumeRetentionPolicy determines what happens to the underlying storage when the volume resource is deleted.
+type VolumeRetentionPolicy string
+
+const (
+	VolumeRetentionPolicyDelete VolumeRetentionPolicy = "Delete"
+	VolumeRetentionPolicyRetain VolumeRetentionPolicy = "Retain"
+)
+
+// ConfigMapVolumeProperties represents the volume for Kubernetes config map.
+type ConfigMapVolumeProperties struct {
+	// The files that this volume exposes, keyed by file name
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// EmptyDirVolumeProperties represents the volume for Kubernetes empty directory.
+type EmptyDirVolumeProperties struct {
+	// The managed store for the volume. Default disk
+	ManagedStore ManagedStore `json:"managedStore,omitempty"`
+	// The maximum size of the volume, for example 1Gi
+	SizeLimit string `json:"sizeLimit,omitempty"`
+}
+
 // CertificateObjectProperties represents the certificate for Volume.
 type CertificateObjectProperties struct {
 	// The name of the certificate
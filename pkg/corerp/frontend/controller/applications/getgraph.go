@@ -72,7 +72,7 @@ func (ctrl *GetGraph) Run(ctx context.Context, w http.ResponseWriter, req *http.
 
 	clientOptions := sdk.NewClientOptions(ctrl.connection)
 
-	applicationResources, err := listAllResourcesByApplication(ctx, applicationID, clientOptions)
+	applicationResources, err := ListAllResourcesByApplication(ctx, applicationID, clientOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -37,9 +37,9 @@ const (
 	portsPath       = "/properties/container/ports"
 )
 
-// listAllResourcesByApplication takes a context, applicationID and clientOptions
+// ListAllResourcesByApplication takes a context, applicationID and clientOptions
 // and returns a slice of GenericResources in the application and also an error if one occurs.
-func listAllResourcesByApplication(ctx context.Context, applicationID resources.ID, clientOptions *policy.ClientOptions) ([]generated.GenericResource, error) {
+func ListAllResourcesByApplication(ctx context.Context, applicationID resources.ID, clientOptions *policy.ClientOptions) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
 	for _, resourceType := range resourceTypesList {
 		resourceList, err := listAllResourcesOfTypeInApplication(ctx, applicationID, resourceType, clientOptions)
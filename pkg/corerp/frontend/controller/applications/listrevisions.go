@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+var _ ctrl.Controller = (*ListRevisions)(nil)
+
+// ListRevisions is the controller implementation to list the revisions of an application.
+type ListRevisions struct {
+	ctrl.Operation[*datamodel.Application, datamodel.Application]
+}
+
+// NewListRevisions creates a new instance of the ListRevisions controller.
+func NewListRevisions(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListRevisions{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Application]{
+				RequestConverter:  converter.ApplicationDataModelFromVersioned,
+				ResponseConverter: converter.ApplicationDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run returns the revisions of the application, newest first.
+func (c *ListRevisions) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	sCtx := v1.ARMRequestContextFromContext(ctx)
+
+	application, _, err := c.GetResource(ctx, sCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if application == nil {
+		return rest.NewNotFoundResponse(sCtx.ResourceID), nil
+	}
+
+	revisions, err := ListApplicationRevisions(ctx, c.StorageClient(), sCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	versioned, err := converter.ApplicationRevisionListDataModelToVersioned(revisions, sCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(versioned), nil
+}
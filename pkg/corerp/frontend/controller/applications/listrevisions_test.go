@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRevisionsRun_20231001Preview(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	req, err := rpctest.NewHTTPRequestWithContent(
+		context.Background(),
+		v1.OperationPost.HTTPMethod(),
+		"http://localhost:8080/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/applications/app0/listRevisions?api-version=2023-10-01-preview", nil)
+	require.NoError(t, err)
+
+	t.Run("application not found", func(t *testing.T) {
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(nil, &store.ErrNotFound{})
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewListRevisions(ctrl.Options{StorageClient: mStorageClient})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("lists revisions", func(t *testing.T) {
+		appdm := testutil.MustGetTestData[datamodel.Application]("application20231001preview_datamodel.json")
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(&store.Object{Metadata: store.Metadata{ID: appdm.ID}, Data: appdm}, nil)
+		mStorageClient.
+			EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&store.ObjectQueryResult{
+				Items: []store.Object{
+					{Data: &datamodel.ApplicationRevision{Revision: 1, Reason: datamodel.ApplicationRevisionReasonDeployment}},
+					{Data: &datamodel.ApplicationRevision{Revision: 2, Reason: datamodel.ApplicationRevisionReasonRollback, RolledBackFrom: 1}},
+				},
+			}, nil)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewListRevisions(ctrl.Options{StorageClient: mStorageClient})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := &v20231001preview.ApplicationRevisionList{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Len(t, actual.Value, 2)
+		require.Equal(t, int32(2), *actual.Value[0].Revision)
+		require.Equal(t, int32(1), *actual.Value[0].RolledBackFrom)
+		require.Equal(t, int32(1), *actual.Value[1].Revision)
+	})
+}
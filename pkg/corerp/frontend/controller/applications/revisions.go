@@ -0,0 +1,483 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/corerp/frontend/controller/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// readOnlyRevisionProperties are set by the server. They are not recorded in revisions because they can't be
+// re-applied. The properties listed for the empty type apply to every resource type.
+var readOnlyRevisionProperties = map[string][]string{
+	"":                                {"provisioningState", "status"},
+	"applications.core/gateways":      {"url"},
+	"applications.core/httproutes":    {"scheme", "url"},
+	"applications.dapr/pubsubbrokers": {"componentName"},
+	"applications.dapr/secretstores":  {"componentName"},
+	"applications.dapr/statestores":   {"componentName"},
+}
+
+// recipeRevisionProperties are the properties recorded for a resource provisioned by a recipe. The other
+// properties of these resources are outputs of the recipe and are computed again when the recipe runs.
+var recipeRevisionProperties = []string{"application", "environment", "recipe", "resourceProvisioning"}
+
+// secretRevisionProperties are the resource types with write-only properties, such as secrets. Their values are not
+// recorded in revisions so that they are only stored by the resource. When a revision is re-applied, the current values
+// are read with the listSecrets action of the resource and merged into the recorded properties. Otherwise rolling back
+// to a revision would remove them.
+var secretRevisionProperties = map[string]secretProperties{
+	"applications.core/extenders":            secretsProperty{},
+	"applications.core/secretstores":         secretStoreData{},
+	"applications.datastores/mongodatabases": secretsProperty{},
+	"applications.datastores/rediscaches":    secretsProperty{},
+	"applications.datastores/sqldatabases":   secretsProperty{},
+	"applications.messaging/rabbitmqqueues":  secretsProperty{},
+}
+
+// secretProperties reads the write-only properties of a resource and removes them from the properties recorded in
+// revisions.
+type secretProperties interface {
+	// addSecrets reads the current secrets of the resource and adds them to its properties. Nested values of the
+	// properties are replaced rather than modified.
+	addSecrets(ctx context.Context, id resources.ID, properties map[string]any, clientOptions *arm.ClientOptions) error
+	// redact returns a copy of the properties without the secrets.
+	redact(properties map[string]any) map[string]any
+}
+
+// secretsProperty handles the resource types whose "secrets" property is returned by listSecrets.
+type secretsProperty struct{}
+
+func (secretsProperty) addSecrets(ctx context.Context, id resources.ID, properties map[string]any, clientOptions *arm.ClientOptions) error {
+	client, err := generated.NewGenericResourcesClient(id.RootScope(), id.Type(), &aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	response, err := client.ListSecrets(ctx, id.Name(), nil)
+	if err != nil {
+		return err
+	}
+
+	secrets := map[string]any{}
+	for key, value := range response.Value {
+		if value != nil {
+			secrets[key] = *value
+		}
+	}
+	if len(secrets) > 0 {
+		properties["secrets"] = secrets
+	}
+
+	return nil
+}
+
+func (secretsProperty) redact(properties map[string]any) map[string]any {
+	result := map[string]any{}
+	for key, value := range properties {
+		if key != "secrets" {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// secretStoreData handles secret stores, whose values are set in the "data" property.
+type secretStoreData struct{}
+
+func (secretStoreData) addSecrets(ctx context.Context, id resources.ID, properties map[string]any, clientOptions *arm.ClientOptions) error {
+	client, err := v20231001preview.NewSecretStoresClient(id.RootScope(), &aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	response, err := client.ListSecrets(ctx, id.Name(), map[string]any{}, nil)
+	if err != nil {
+		return err
+	}
+
+	data, ok := properties["data"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	result := map[string]any{}
+	for key, entry := range data {
+		result[key] = entry
+
+		secret, ok := response.Data[key]
+		if !ok || secret == nil || secret.Value == nil {
+			continue
+		}
+
+		merged := map[string]any{}
+		if e, ok := entry.(map[string]any); ok {
+			for k, v := range e {
+				merged[k] = v
+			}
+		}
+		merged["value"] = *secret.Value
+		result[key] = merged
+	}
+	properties["data"] = result
+
+	return nil
+}
+
+func (secretStoreData) redact(properties map[string]any) map[string]any {
+	result := map[string]any{}
+	for key, value := range properties {
+		result[key] = value
+	}
+
+	data, ok := properties["data"].(map[string]any)
+	if !ok {
+		return result
+	}
+
+	redacted := map[string]any{}
+	for key, entry := range data {
+		e, ok := entry.(map[string]any)
+		if !ok {
+			redacted[key] = entry
+			continue
+		}
+
+		r := map[string]any{}
+		for k, v := range e {
+			if k != "value" {
+				r[k] = v
+			}
+		}
+		redacted[key] = r
+	}
+	result["data"] = redacted
+	return result
+}
+
+// RevisionID returns the storage id of an application revision.
+func RevisionID(applicationID resources.ID, revision int32) string {
+	return fmt.Sprintf("%s/revisions/%d", applicationID.String(), revision)
+}
+
+// ListApplicationRevisions returns the revisions of the application, newest first.
+func ListApplicationRevisions(ctx context.Context, storageClient store.StorageClient, applicationID resources.ID) ([]*datamodel.ApplicationRevision, error) {
+	result, err := util.FindResources(ctx, applicationID.RootScope(), datamodel.ApplicationRevisionResourceType, "application", strings.ToLower(applicationID.String()), storageClient)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := []*datamodel.ApplicationRevision{}
+	for _, item := range result.Items {
+		revision := &datamodel.ApplicationRevision{}
+		if err := item.As(revision); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision > revisions[j].Revision
+	})
+
+	return revisions, nil
+}
+
+// FindRevisionByOperation returns the revision created by the given async operation, or nil if it doesn't exist.
+func FindRevisionByOperation(ctx context.Context, storageClient store.StorageClient, applicationID resources.ID, operationID string) (*datamodel.ApplicationRevision, error) {
+	result, err := util.FindResources(ctx, applicationID.RootScope(), datamodel.ApplicationRevisionResourceType, "operationId", operationID, storageClient)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Items) == 0 {
+		return nil, nil
+	}
+
+	revision := &datamodel.ApplicationRevision{}
+	if err := result.Items[0].As(revision); err != nil {
+		return nil, err
+	}
+
+	return revision, nil
+}
+
+// SaveRevision saves the revision of the application.
+func SaveRevision(ctx context.Context, storageClient store.StorageClient, revision *datamodel.ApplicationRevision) error {
+	obj := &store.Object{
+		Metadata: store.Metadata{
+			ID: revision.ID,
+		},
+		Data: revision,
+	}
+	return storageClient.Save(ctx, obj)
+}
+
+// addRevision saves a new revision of the application and removes the oldest revisions so that at most
+// MaxRevisions are kept. existing is the list of revisions before the new one was added, newest first.
+func addRevision(ctx context.Context, storageClient store.StorageClient, revision *datamodel.ApplicationRevision, existing []*datamodel.ApplicationRevision) error {
+	if err := SaveRevision(ctx, storageClient, revision); err != nil {
+		return err
+	}
+
+	for i := MaxRevisions - 1; i < len(existing); i++ {
+		if err := storageClient.Delete(ctx, existing[i].ID); err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RecordRevision records the application and its resources as a new revision of the application. If they have not
+// changed since the latest revision, the latest revision is returned instead.
+func RecordRevision(ctx context.Context, storageClient store.StorageClient, application *datamodel.Application, applicationResources []generated.GenericResource) (*datamodel.ApplicationRevision, error) {
+	applicationID, err := resources.ParseResource(application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := snapshotApplication(application)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := []datamodel.ApplicationRevisionResource{snapshot}
+	for _, resource := range applicationResources {
+		snapshots = append(snapshots, SnapshotResource(resource))
+	}
+
+	existing, err := ListApplicationRevisions(ctx, storageClient, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(existing) > 0 && SameRevisionResources(existing[0].Resources, snapshots) {
+		return existing[0], nil
+	}
+
+	revision := newRevision(applicationID, existing, datamodel.ApplicationRevisionReasonDeployment, snapshots)
+	revision.CreatedAt = time.Now().UTC()
+	revision.ProvisioningState = v1.ProvisioningStateSucceeded
+
+	if err := addRevision(ctx, storageClient, revision, existing); err != nil {
+		return nil, err
+	}
+
+	return revision, nil
+}
+
+// newRevision creates the next revision of the application.
+func newRevision(applicationID resources.ID, existing []*datamodel.ApplicationRevision, reason datamodel.ApplicationRevisionReason, resources []datamodel.ApplicationRevisionResource) *datamodel.ApplicationRevision {
+	number := int32(1)
+	if len(existing) > 0 {
+		number = existing[0].Revision + 1
+	}
+
+	return &datamodel.ApplicationRevision{
+		ID:          RevisionID(applicationID, number),
+		Application: strings.ToLower(applicationID.String()),
+		Revision:    number,
+		Reason:      reason,
+		Resources:   resources,
+	}
+}
+
+// WithSecrets returns the properties recorded for the resource with the current values of its write-only properties,
+// read with the listSecrets action of the resource. The recorded properties are not modified. The properties are
+// returned without secrets if the resource no longer exists, and unchanged if it is provisioned by a recipe, because
+// its secrets are outputs of the recipe.
+func WithSecrets(ctx context.Context, resource datamodel.ApplicationRevisionResource, clientOptions *arm.ClientOptions) (map[string]any, error) {
+	handler, ok := secretRevisionProperties[strings.ToLower(resource.Type)]
+	if !ok || isRecipeProvisioned(resource.Properties) {
+		return resource.Properties, nil
+	}
+
+	id, err := resources.ParseResource(resource.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := map[string]any{}
+	for key, value := range resource.Properties {
+		properties[key] = value
+	}
+
+	err = handler.addSecrets(ctx, id, properties, clientOptions)
+	if err != nil && !clients.Is404Error(err) {
+		return nil, fmt.Errorf("failed to list the secrets of resource %q: %w", id.String(), err)
+	}
+
+	return properties, nil
+}
+
+// SnapshotResource records the user-specified properties of a resource for an application revision.
+func SnapshotResource(resource generated.GenericResource) datamodel.ApplicationRevisionResource {
+	var id, resourceType, name string
+	if resource.ID != nil {
+		id = *resource.ID
+	}
+	if resource.Type != nil {
+		resourceType = *resource.Type
+	}
+	if resource.Name != nil {
+		name = *resource.Name
+	}
+
+	return datamodel.ApplicationRevisionResource{
+		ID:         id,
+		Type:       resourceType,
+		Name:       name,
+		Properties: revisionProperties(resourceType, resource.Properties),
+		Recipe:     recipeStatus(resource.Properties),
+	}
+}
+
+// snapshotApplication records the properties of the application resource in the same form as the
+// other resources of the application.
+func snapshotApplication(application *datamodel.Application) (datamodel.ApplicationRevisionResource, error) {
+	versioned, err := converter.ApplicationDataModelToVersioned(application, v20231001preview.Version)
+	if err != nil {
+		return datamodel.ApplicationRevisionResource{}, err
+	}
+
+	b, err := json.Marshal(versioned)
+	if err != nil {
+		return datamodel.ApplicationRevisionResource{}, err
+	}
+
+	resource := generated.GenericResource{}
+	if err := json.Unmarshal(b, &resource); err != nil {
+		return datamodel.ApplicationRevisionResource{}, err
+	}
+
+	return SnapshotResource(resource), nil
+}
+
+// SameRevisionResources returns true if both lists record the same resources with the same properties.
+func SameRevisionResources(a []datamodel.ApplicationRevisionResource, b []datamodel.ApplicationRevisionResource) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	byID := map[string]datamodel.ApplicationRevisionResource{}
+	for _, resource := range a {
+		byID[strings.ToLower(resource.ID)] = resource
+	}
+
+	for _, resource := range b {
+		other, ok := byID[strings.ToLower(resource.ID)]
+		if !ok || !SameRevisionResource(resource, other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SameRevisionResource returns true if both resources have the same properties.
+func SameRevisionResource(a datamodel.ApplicationRevisionResource, b datamodel.ApplicationRevisionResource) bool {
+	// Properties may come from different sources (the store or the API) so they are compared in
+	// their JSON form to ignore differences in the Go types of the values.
+	return strings.EqualFold(a.ID, b.ID) && reflect.DeepEqual(normalize(a.Properties), normalize(b.Properties))
+}
+
+func revisionProperties(resourceType string, properties map[string]any) map[string]any {
+	recipe := isRecipeProvisioned(properties)
+
+	result := map[string]any{}
+	for key, value := range properties {
+		if isReadOnlyRevisionProperty(resourceType, key) || (recipe && !containsFold(recipeRevisionProperties, key)) {
+			continue
+		}
+		result[key] = value
+	}
+
+	if handler, ok := secretRevisionProperties[strings.ToLower(resourceType)]; ok {
+		return handler.redact(result)
+	}
+	return result
+}
+
+// recipeStatus returns the recipe recorded in the status of the resource, if any.
+func recipeStatus(properties map[string]any) *rpv1.RecipeStatus {
+	status, ok := properties["status"].(map[string]any)
+	if !ok || status["recipe"] == nil {
+		return nil
+	}
+
+	recipe := &rpv1.RecipeStatus{}
+	if err := toStronglyTypedData(status["recipe"], recipe); err != nil || recipe.TemplatePath == "" {
+		return nil
+	}
+
+	return recipe
+}
+
+// isRecipeProvisioned returns true if the resource is provisioned by a recipe.
+func isRecipeProvisioned(properties map[string]any) bool {
+	if _, ok := properties["recipe"]; !ok {
+		return false
+	}
+
+	provisioning, _ := properties["resourceProvisioning"].(string)
+	return !strings.EqualFold(provisioning, "manual")
+}
+
+func isReadOnlyRevisionProperty(resourceType string, key string) bool {
+	return containsFold(readOnlyRevisionProperties[""], key) || containsFold(readOnlyRevisionProperties[strings.ToLower(resourceType)], key)
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalize(properties map[string]any) any {
+	b, err := json.Marshal(properties)
+	if err != nil {
+		return properties
+	}
+
+	var result any
+	if err := json.Unmarshal(b, &result); err != nil {
+		return properties
+	}
+	return result
+}
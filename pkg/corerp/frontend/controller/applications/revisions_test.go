@@ -0,0 +1,364 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testApplicationID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/myapp"
+	testEnvironmentID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/myenv"
+)
+
+func Test_SnapshotResource(t *testing.T) {
+	t.Run("removes read-only properties", func(t *testing.T) {
+		resource := generated.GenericResource{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/gateway"),
+			Type: to.Ptr("Applications.Core/gateways"),
+			Name: to.Ptr("gateway"),
+			Properties: map[string]any{
+				"application":       testApplicationID,
+				"provisioningState": "Succeeded",
+				"status":            map[string]any{"outputResources": []any{}},
+				"url":               "http://localhost",
+				"routes":            []any{map[string]any{"path": "/"}},
+			},
+		}
+
+		snapshot := SnapshotResource(resource)
+		require.Equal(t, datamodel.ApplicationRevisionResource{
+			ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/gateway",
+			Type: "Applications.Core/gateways",
+			Name: "gateway",
+			Properties: map[string]any{
+				"application": testApplicationID,
+				"routes":      []any{map[string]any{"path": "/"}},
+			},
+		}, snapshot)
+	})
+
+	t.Run("records the recipe", func(t *testing.T) {
+		resource := generated.GenericResource{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"),
+			Type: to.Ptr("Applications.Datastores/redisCaches"),
+			Name: to.Ptr("redis"),
+			Properties: map[string]any{
+				"application": testApplicationID,
+				"environment": testEnvironmentID,
+				"recipe":      map[string]any{"name": "default"},
+				"host":        "redis.svc",
+				"port":        float64(6379),
+				"status": map[string]any{
+					"recipe": map[string]any{
+						"templateKind":    "bicep",
+						"templatePath":    "ghcr.io/radius-project/recipes/redis:1.1",
+						"templateVersion": "1.1",
+					},
+				},
+			},
+		}
+
+		snapshot := SnapshotResource(resource)
+		require.Equal(t, map[string]any{
+			"application": testApplicationID,
+			"environment": testEnvironmentID,
+			"recipe":      map[string]any{"name": "default"},
+		}, snapshot.Properties)
+		require.Equal(t, &rpv1.RecipeStatus{
+			TemplateKind:    "bicep",
+			TemplatePath:    "ghcr.io/radius-project/recipes/redis:1.1",
+			TemplateVersion: "1.1",
+		}, snapshot.Recipe)
+	})
+
+	t.Run("keeps the properties of manually provisioned resources", func(t *testing.T) {
+		resource := generated.GenericResource{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"),
+			Type: to.Ptr("Applications.Datastores/redisCaches"),
+			Name: to.Ptr("redis"),
+			Properties: map[string]any{
+				"resourceProvisioning": "manual",
+				"recipe":               map[string]any{"name": "default"},
+				"host":                 "redis.svc",
+			},
+		}
+
+		snapshot := SnapshotResource(resource)
+		require.Equal(t, "redis.svc", snapshot.Properties["host"])
+		require.Nil(t, snapshot.Recipe)
+	})
+
+	t.Run("does not record secrets", func(t *testing.T) {
+		redis := generated.GenericResource{
+			ID:         to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"),
+			Type:       to.Ptr("Applications.Datastores/redisCaches"),
+			Properties: map[string]any{"resourceProvisioning": "manual", "host": "redis", "secrets": map[string]any{"password": "secret"}},
+		}
+		require.Equal(t, map[string]any{"resourceProvisioning": "manual", "host": "redis"}, SnapshotResource(redis).Properties)
+
+		secretStore := generated.GenericResource{
+			ID:         to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/secrets"),
+			Type:       to.Ptr("Applications.Core/secretStores"),
+			Properties: map[string]any{"data": map[string]any{"key": map[string]any{"encoding": "raw", "value": "value"}}},
+		}
+		require.Equal(t, map[string]any{"data": map[string]any{"key": map[string]any{"encoding": "raw"}}}, SnapshotResource(secretStore).Properties)
+	})
+}
+
+func Test_SameRevisionResources(t *testing.T) {
+	a := []datamodel.ApplicationRevisionResource{
+		{ID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/a", Properties: map[string]any{"port": 80}},
+		{ID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/b", Properties: map[string]any{}},
+	}
+
+	t.Run("same resources in a different order", func(t *testing.T) {
+		b := []datamodel.ApplicationRevisionResource{
+			{ID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/B", Properties: map[string]any{}},
+			{ID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/a", Properties: map[string]any{"port": float64(80)}},
+		}
+		require.True(t, SameRevisionResources(a, b))
+	})
+
+	t.Run("changed property", func(t *testing.T) {
+		b := []datamodel.ApplicationRevisionResource{
+			{ID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/a", Properties: map[string]any{"port": 8080}},
+			{ID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/b", Properties: map[string]any{}},
+		}
+		require.False(t, SameRevisionResources(a, b))
+	})
+
+	t.Run("removed resource", func(t *testing.T) {
+		require.False(t, SameRevisionResources(a, a[:1]))
+	})
+}
+
+func Test_RecordRevision(t *testing.T) {
+	application := testutil.MustGetTestData[datamodel.Application]("application20231001preview_datamodel.json")
+	container := generated.GenericResource{
+		ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/containers/frontend"),
+		Name: to.Ptr("frontend"),
+		Type: to.Ptr("Applications.Core/containers"),
+		Properties: map[string]any{
+			"application":       application.ID,
+			"container":         map[string]any{"image": "nginx"},
+			"provisioningState": "Succeeded",
+		},
+	}
+
+	t.Run("creates the first revision", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mStorageClient := store.NewMockStorageClient(mctrl)
+		mStorageClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&store.ObjectQueryResult{}, nil)
+		mStorageClient.EXPECT().
+			Save(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		revision, err := RecordRevision(context.Background(), mStorageClient, application, []generated.GenericResource{container})
+		require.NoError(t, err)
+		require.Equal(t, int32(1), revision.Revision)
+		require.Equal(t, datamodel.ApplicationRevisionReasonDeployment, revision.Reason)
+		require.Len(t, revision.Resources, 2)
+		require.Equal(t, "app0", revision.Resources[0].Name)
+		require.NotContains(t, revision.Resources[0].Properties, "status")
+		require.Equal(t, "frontend", revision.Resources[1].Name)
+		require.NotContains(t, revision.Resources[1].Properties, "provisioningState")
+	})
+
+	t.Run("returns the latest revision if nothing changed", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		mStorageClient := store.NewMockStorageClient(mctrl)
+		mStorageClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&store.ObjectQueryResult{}, nil)
+		mStorageClient.EXPECT().
+			Save(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		latest, err := RecordRevision(context.Background(), mStorageClient, application, []generated.GenericResource{container})
+		require.NoError(t, err)
+
+		mStorageClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&store.ObjectQueryResult{Items: []store.Object{{Data: latest}}}, nil)
+
+		revision, err := RecordRevision(context.Background(), mStorageClient, application, []generated.GenericResource{container})
+		require.NoError(t, err)
+		require.Equal(t, int32(1), revision.Revision)
+	})
+}
+
+func Test_WithSecrets(t *testing.T) {
+	redisID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"
+	secretStoreID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/secrets"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case redisID + "/listSecrets":
+			_ = json.NewEncoder(w).Encode(map[string]any{"password": "secret"})
+		case secretStoreID + "/listSecrets":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"type": "generic",
+				"data": map[string]any{"key": map[string]any{"value": "value"}},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conn, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+	clientOptions := sdk.NewClientOptions(conn)
+
+	t.Run("adds the secrets of a resource", func(t *testing.T) {
+		resource := datamodel.ApplicationRevisionResource{
+			ID:         redisID,
+			Type:       "Applications.Datastores/redisCaches",
+			Properties: map[string]any{"resourceProvisioning": "manual", "host": "redis"},
+		}
+
+		properties, err := WithSecrets(context.Background(), resource, clientOptions)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"resourceProvisioning": "manual", "host": "redis", "secrets": map[string]any{"password": "secret"}}, properties)
+
+		// The recorded properties are not modified.
+		require.NotContains(t, resource.Properties, "secrets")
+	})
+
+	t.Run("adds the values of a secret store", func(t *testing.T) {
+		resource := datamodel.ApplicationRevisionResource{
+			ID:         secretStoreID,
+			Type:       "Applications.Core/secretStores",
+			Properties: map[string]any{"data": map[string]any{"key": map[string]any{"encoding": "raw"}}},
+		}
+
+		properties, err := WithSecrets(context.Background(), resource, clientOptions)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"key": map[string]any{"encoding": "raw", "value": "value"}}, properties["data"])
+		require.Equal(t, map[string]any{"key": map[string]any{"encoding": "raw"}}, resource.Properties["data"])
+	})
+
+	t.Run("skips resources without secrets", func(t *testing.T) {
+		for _, resource := range []datamodel.ApplicationRevisionResource{
+			{
+				ID:         "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/recipe",
+				Type:       "Applications.Datastores/redisCaches",
+				Properties: map[string]any{"recipe": map[string]any{"name": "default"}},
+			},
+			{
+				ID:         "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/deleted",
+				Type:       "Applications.Datastores/redisCaches",
+				Properties: map[string]any{"resourceProvisioning": "manual"},
+			},
+			{
+				ID:         "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend",
+				Type:       "Applications.Core/containers",
+				Properties: map[string]any{"container": map[string]any{"image": "nginx"}},
+			},
+		} {
+			properties, err := WithSecrets(context.Background(), resource, clientOptions)
+			require.NoError(t, err)
+			require.Equal(t, resource.Properties, properties)
+		}
+	})
+}
+
+func Test_newRevision(t *testing.T) {
+	applicationID := resources.MustParse(testApplicationID)
+
+	first := newRevision(applicationID, nil, datamodel.ApplicationRevisionReasonDeployment, nil)
+	require.Equal(t, int32(1), first.Revision)
+	require.Equal(t, testApplicationID+"/revisions/1", first.ID)
+	require.Equal(t, "/planes/radius/local/resourcegroups/test-group/providers/applications.core/applications/myapp", first.Application)
+
+	next := newRevision(applicationID, []*datamodel.ApplicationRevision{{Revision: 7}, {Revision: 6}}, datamodel.ApplicationRevisionReasonRollback, nil)
+	require.Equal(t, int32(8), next.Revision)
+	require.Equal(t, datamodel.ApplicationRevisionReasonRollback, next.Reason)
+}
+
+func Test_addRevision(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	applicationID := resources.MustParse(testApplicationID)
+	existing := []*datamodel.ApplicationRevision{}
+	for i := MaxRevisions; i > 0; i-- {
+		existing = append(existing, &datamodel.ApplicationRevision{ID: RevisionID(applicationID, int32(i)), Revision: int32(i)})
+	}
+
+	revision := newRevision(applicationID, existing, datamodel.ApplicationRevisionReasonDeployment, nil)
+
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	mStorageClient.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+			require.Equal(t, RevisionID(applicationID, MaxRevisions+1), obj.ID)
+			return nil
+		})
+	mStorageClient.EXPECT().
+		Delete(gomock.Any(), RevisionID(applicationID, 1)).
+		Return(nil)
+
+	err := addRevision(context.Background(), mStorageClient, revision, existing)
+	require.NoError(t, err)
+}
+
+func Test_ListApplicationRevisions(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	applicationID := resources.MustParse(testApplicationID)
+
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	mStorageClient.EXPECT().
+		Query(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, _ ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			require.Equal(t, datamodel.ApplicationRevisionResourceType, query.ResourceType)
+			require.Equal(t, []store.QueryFilter{{Field: "application", Value: "/planes/radius/local/resourcegroups/test-group/providers/applications.core/applications/myapp"}}, query.Filters)
+			return &store.ObjectQueryResult{
+				Items: []store.Object{
+					{Data: map[string]any{"revision": 1}},
+					{Data: map[string]any{"revision": 3}},
+					{Data: map[string]any{"revision": 2}},
+				},
+			}, nil
+		})
+
+	revisions, err := ListApplicationRevisions(context.Background(), mStorageClient, applicationID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	require.Equal(t, []int32{3, 2, 1}, []int32{revisions[0].Revision, revisions[1].Revision, revisions[2].Revision})
+}
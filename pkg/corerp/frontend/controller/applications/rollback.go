@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+var _ ctrl.Controller = (*Rollback)(nil)
+
+// Rollback is the controller implementation to roll back an application to a previous revision.
+type Rollback struct {
+	ctrl.Operation[*datamodel.Application, datamodel.Application]
+}
+
+// NewRollback creates a new instance of the Rollback controller.
+func NewRollback(opts ctrl.Options) (ctrl.Controller, error) {
+	return &Rollback{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Application]{
+				RequestConverter:  converter.ApplicationDataModelFromVersioned,
+				ResponseConverter: converter.ApplicationDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run creates a new revision of the application with the resources of the requested revision and queues
+// the async operation that re-applies them. The new revision is Accepted until the operation completes.
+func (c *Rollback) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	sCtx := v1.ARMRequestContextFromContext(ctx)
+
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	request, err := converter.ApplicationRollbackRequestDataModelFromVersioned(content, sCtx.APIVersion)
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+	if request.Revision < 1 {
+		return rest.NewBadRequestResponse("revision must be a positive number"), nil
+	}
+
+	application, etag, err := c.GetResource(ctx, sCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if application == nil {
+		return rest.NewNotFoundResponse(sCtx.ResourceID), nil
+	}
+
+	if r, err := c.PrepareResource(ctx, req, nil, application, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	existing, err := ListApplicationRevisions(ctx, c.StorageClient(), sCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *datamodel.ApplicationRevision
+	for _, revision := range existing {
+		if revision.Revision == request.Revision {
+			target = revision
+			break
+		}
+	}
+	if target == nil {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("the revision %d of application %q was not found", request.Revision, sCtx.ResourceID.String())), nil
+	}
+	if !target.ProvisioningState.IsTerminal() {
+		return rest.NewConflictResponse(fmt.Sprintf("the revision %d of application %q is still being applied", request.Revision, sCtx.ResourceID.String())), nil
+	}
+
+	// The application resource is updated synchronously and it is locked by the async operation while the
+	// rollback runs, so the rollback only restores the other resources of the application.
+	snapshot, err := snapshotApplication(application)
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range target.Resources {
+		if strings.EqualFold(resource.ID, snapshot.ID) && !SameRevisionResource(resource, snapshot) {
+			return rest.NewConflictResponse(fmt.Sprintf("the properties of application %q changed after revision %d. Deploy the application to change its properties", sCtx.ResourceID.String(), request.Revision)), nil
+		}
+	}
+
+	revision := newRevision(sCtx.ResourceID, existing, datamodel.ApplicationRevisionReasonRollback, target.Resources)
+	revision.CreatedAt = time.Now().UTC()
+	revision.RolledBackFrom = target.Revision
+	revision.OperationID = sCtx.OperationID.String()
+	revision.DeleteResources = request.DeleteResources
+	revision.ProvisioningState = v1.ProvisioningStateAccepted
+
+	if err := addRevision(ctx, c.StorageClient(), revision, existing); err != nil {
+		return nil, err
+	}
+
+	if r, err := c.PrepareAsyncOperation(ctx, application, v1.ProvisioningStateAccepted, AsyncRollbackApplicationTimeout, &etag); r != nil || err != nil {
+		if err != nil {
+			revision.ProvisioningState = v1.ProvisioningStateFailed
+			_ = SaveRevision(ctx, c.StorageClient(), revision)
+		}
+		return r, err
+	}
+
+	versioned, err := converter.ApplicationRevisionDataModelToVersioned(revision, sCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewAsyncOperationResponse(versioned, sCtx.Location, http.StatusAccepted, sCtx.ResourceID, sCtx.OperationID, sCtx.APIVersion, "", ""), nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const testRollbackURL = "http://localhost:8080/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/applications/app0/rollback?api-version=2023-10-01-preview"
+
+func TestRollbackRun_20231001Preview(t *testing.T) {
+	appdm := testutil.MustGetTestData[datamodel.Application]("application20231001preview_datamodel.json")
+	appSnapshot, err := snapshotApplication(appdm)
+	require.NoError(t, err)
+
+	container := datamodel.ApplicationRevisionResource{
+		ID:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/containers/frontend",
+		Type:       "Applications.Core/containers",
+		Name:       "frontend",
+		Properties: map[string]any{"container": map[string]any{"image": "nginx:1.0"}},
+	}
+
+	revisions := func(appResource datamodel.ApplicationRevisionResource) *store.ObjectQueryResult {
+		return &store.ObjectQueryResult{
+			Items: []store.Object{
+				{Data: &datamodel.ApplicationRevision{ID: appdm.ID + "/revisions/1", Revision: 1, ProvisioningState: v1.ProvisioningStateSucceeded, Resources: []datamodel.ApplicationRevisionResource{appResource, container}}},
+				{Data: &datamodel.ApplicationRevision{ID: appdm.ID + "/revisions/2", Revision: 2, ProvisioningState: v1.ProvisioningStateSucceeded, Resources: []datamodel.ApplicationRevisionResource{appResource}}},
+			},
+		}
+	}
+
+	setup := func(t *testing.T) (*store.MockStorageClient, *statusmanager.MockStatusManager, ctrl.Controller) {
+		mctrl := gomock.NewController(t)
+		mStorageClient := store.NewMockStorageClient(mctrl)
+		msm := statusmanager.NewMockStatusManager(mctrl)
+
+		ctl, err := NewRollback(ctrl.Options{StorageClient: mStorageClient, StatusManager: msm})
+		require.NoError(t, err)
+		return mStorageClient, msm, ctl
+	}
+
+	run := func(t *testing.T, ctl ctrl.Controller, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, testRollbackURL, bytes.NewBufferString(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		ctx := rpctest.NewARMRequestContext(req)
+
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		err = resp.Apply(ctx, w, req)
+		require.NoError(t, err)
+		return w
+	}
+
+	expectApplication := func(mStorageClient *store.MockStorageClient) {
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(&store.Object{Metadata: store.Metadata{ID: appdm.ID, ETag: "etag"}, Data: appdm}, nil)
+	}
+
+	t.Run("invalid revision", func(t *testing.T) {
+		_, _, ctl := setup(t)
+		w := run(t, ctl, `{"revision": 0}`)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
+
+	t.Run("application not found", func(t *testing.T) {
+		mStorageClient, _, ctl := setup(t)
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(nil, &store.ErrNotFound{})
+
+		w := run(t, ctl, `{"revision": 1}`)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		mStorageClient, _, ctl := setup(t)
+		expectApplication(mStorageClient)
+		mStorageClient.
+			EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(revisions(appSnapshot), nil)
+
+		w := run(t, ctl, `{"revision": 5}`)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("application properties changed", func(t *testing.T) {
+		mStorageClient, _, ctl := setup(t)
+		expectApplication(mStorageClient)
+
+		changed := appSnapshot
+		changed.Properties = map[string]any{"environment": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/other"}
+		mStorageClient.
+			EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(revisions(changed), nil)
+
+		w := run(t, ctl, `{"revision": 1}`)
+		require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+	})
+
+	t.Run("queues the rollback", func(t *testing.T) {
+		mStorageClient, msm, ctl := setup(t)
+		expectApplication(mStorageClient)
+		mStorageClient.
+			EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(revisions(appSnapshot), nil)
+
+		var revision *datamodel.ApplicationRevision
+		mStorageClient.
+			EXPECT().
+			Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+				revision = obj.Data.(*datamodel.ApplicationRevision)
+				return nil
+			})
+		mStorageClient.
+			EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+				require.Equal(t, v1.ProvisioningStateAccepted, obj.Data.(*datamodel.Application).ProvisioningState())
+				return nil
+			})
+		msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		w := run(t, ctl, `{"revision": 1, "deleteResources": true}`)
+		require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+		require.NotEmpty(t, w.Header().Get("Azure-AsyncOperation"))
+
+		require.NotNil(t, revision)
+		require.Equal(t, int32(3), revision.Revision)
+		require.Equal(t, int32(1), revision.RolledBackFrom)
+		require.Equal(t, datamodel.ApplicationRevisionReasonRollback, revision.Reason)
+		require.Equal(t, v1.ProvisioningStateAccepted, revision.ProvisioningState)
+		require.NotEmpty(t, revision.OperationID)
+		require.True(t, revision.DeleteResources)
+		require.Len(t, revision.Resources, 2)
+
+		actual := &v20231001preview.ApplicationRevision{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Equal(t, int32(3), *actual.Revision)
+	})
+}
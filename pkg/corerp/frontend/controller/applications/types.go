@@ -17,10 +17,13 @@ limitations under the License.
 package applications
 
 import (
+	"time"
+
 	cntr_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/containers"
 	ext_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/extenders"
 	gtwy_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
 	hrt_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/httproutes"
+	job_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/jobs"
 	sstr_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
@@ -29,6 +32,12 @@ import (
 
 const (
 	ResourceTypeName = "Applications.Core/applications"
+
+	// MaxRevisions is the number of revisions kept for each application. The oldest revisions are removed first.
+	MaxRevisions = 10
+
+	// AsyncRollbackApplicationTimeout is the timeout for the async rollback operation of an application.
+	AsyncRollbackApplicationTimeout = time.Duration(60) * time.Minute
 )
 
 var (
@@ -44,6 +53,7 @@ var (
 		gtwy_ctrl.ResourceTypeName,
 		hrt_ctrl.ResourceTypeName,
 		cntr_ctrl.ResourceTypeName,
+		job_ctrl.ResourceTypeName,
 		sstr_ctrl.ResourceTypeName,
 	}
 )
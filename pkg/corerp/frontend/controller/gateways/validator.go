@@ -18,15 +18,36 @@ package gateways
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/exp/slices"
 
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
-// ValidateAndMutateRequest checks if the TLS configuration is valid and sets the TLS protocol version to 1.2 if it is not
-// specified. It returns a BadRequestResponse error if SSL Passthrough and TLS termination are both configured or if TLS
-// protocol version is set but certificateFrom is not.
+var (
+	// validMethods is the set of HTTP methods that a route can match on.
+	validMethods = []string{
+		http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace,
+	}
+
+	// validRetryOn is the set of conditions that a route can retry requests on.
+	validRetryOn = []string{
+		"5xx", "gateway-error", "reset", "connect-failure", "retriable-4xx", "refused-stream",
+		"retriable-status-codes", "retriable-headers",
+	}
+)
+
+// ValidateAndMutateRequest checks if the TLS configuration and the routing rules of each route are valid and sets the
+// TLS protocol version to 1.2 if it is not specified. It returns a BadRequestResponse error if SSL Passthrough and TLS
+// termination are both configured, if the certificate issuer is invalid, if TLS protocol version is set but TLS is not
+// terminated at the gateway, or if a route is invalid.
 func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *datamodel.Gateway, options *controller.Options) (rest.Response, error) {
 	if newResource.Properties.TLS != nil {
 		// If SSL Passthrough and TLS termination are both configured, then report an error
@@ -34,8 +55,12 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 			return rest.NewBadRequestResponse("Only one of $.properties.tls.certificateFrom and $.properties.tls.sslPassthrough can be specified at a time."), nil
 		}
 
-		// If TLS protocol version is set, then certificateFrom must be set
-		if newResource.Properties.TLS.MinimumProtocolVersion != "" && newResource.Properties.TLS.CertificateFrom == "" {
+		if msg := validateCertificateIssuer(newResource.Properties.TLS); msg != "" {
+			return rest.NewBadRequestResponse(msg), nil
+		}
+
+		// If TLS protocol version is set, then TLS must be terminated at the gateway
+		if newResource.Properties.TLS.MinimumProtocolVersion != "" && !newResource.Properties.TLS.IsTerminated() {
 			return rest.NewBadRequestResponse("Field $.properties.tls.certificateFrom is required when $.properties.tls.minimumProtocolVersion is set."), nil
 		}
 
@@ -45,5 +70,170 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		}
 	}
 
+	for i, route := range newResource.Properties.Routes {
+		if msg := validateRoute(fmt.Sprintf("$.properties.routes[%d]", i), &route); msg != "" {
+			return rest.NewBadRequestResponse(msg), nil
+		}
+
+		sslPassthrough := newResource.Properties.TLS != nil && newResource.Properties.TLS.SSLPassthrough
+		if sslPassthrough && route.HasRoutingRules() {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.routes[%d] cannot specify routing rules when $.properties.tls.sslPassthrough is set to true.", i)), nil
+		}
+	}
+
 	return nil, nil
 }
+
+// validateCertificateIssuer validates the automatic certificate issuance configuration of the gateway. It returns a
+// message describing the first problem found, or an empty string if the configuration is valid.
+func validateCertificateIssuer(tls *datamodel.GatewayPropertiesTLS) string {
+	issuer := tls.CertificateIssuer
+	if issuer == nil {
+		return ""
+	}
+
+	if tls.CertificateFrom != "" {
+		return "Only one of $.properties.tls.certificateFrom and $.properties.tls.certificateIssuer can be specified at a time."
+	}
+
+	if tls.SSLPassthrough {
+		return "Only one of $.properties.tls.certificateIssuer and $.properties.tls.sslPassthrough can be specified at a time."
+	}
+
+	if issuer.Kind != datamodel.GatewayCertificateIssuerKindACME && (issuer.ACMEDirectoryURL != "" || issuer.Email != "") {
+		return "Fields $.properties.tls.certificateIssuer.acmeDirectoryUrl and $.properties.tls.certificateIssuer.email can only be specified when $.properties.tls.certificateIssuer.kind is 'acme'."
+	}
+
+	if issuer.ACMEDirectoryURL != "" {
+		u, err := url.Parse(issuer.ACMEDirectoryURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Sprintf("Field $.properties.tls.certificateIssuer.acmeDirectoryUrl has invalid URL %q.", issuer.ACMEDirectoryURL)
+		}
+	}
+
+	if issuer.RenewBefore != "" {
+		d, err := time.ParseDuration(issuer.RenewBefore)
+		if err != nil || d <= 0 {
+			return fmt.Sprintf("Field $.properties.tls.certificateIssuer.renewBefore has invalid duration %q.", issuer.RenewBefore)
+		}
+	}
+
+	return ""
+}
+
+// validateRoute validates the destinations, matches and policies of a route. It returns a message describing the
+// first problem found, or an empty string if the route is valid.
+func validateRoute(path string, route *datamodel.GatewayRoute) string {
+	if route.Destination != "" && len(route.Destinations) > 0 {
+		return fmt.Sprintf("Only one of %[1]s.destination and %[1]s.destinations can be specified at a time.", path)
+	}
+
+	totalWeight := int32(0)
+	for i, destination := range route.Destinations {
+		if destination.Destination == "" {
+			return fmt.Sprintf("Field %s.destinations[%d].destination is required.", path, i)
+		}
+		if destination.Weight < 0 {
+			return fmt.Sprintf("Field %s.destinations[%d].weight must not be negative.", path, i)
+		}
+		totalWeight += destination.Weight
+	}
+	if len(route.Destinations) > 0 && totalWeight == 0 {
+		return fmt.Sprintf("At least one of %s.destinations must have a positive weight.", path)
+	}
+
+	for i, header := range route.Headers {
+		if header.Name == "" {
+			return fmt.Sprintf("Field %s.headers[%d].name is required.", path, i)
+		}
+		if countMatchers(header.Exact != "", header.Contains != "", header.Present) != 1 {
+			return fmt.Sprintf("Exactly one of exact, contains and present must be specified for %s.headers[%d].", path, i)
+		}
+	}
+
+	for i, parameter := range route.QueryParameters {
+		if parameter.Name == "" {
+			return fmt.Sprintf("Field %s.queryParameters[%d].name is required.", path, i)
+		}
+		if countMatchers(parameter.Exact != "", parameter.Prefix != "", parameter.Present) != 1 {
+			return fmt.Sprintf("Exactly one of exact, prefix and present must be specified for %s.queryParameters[%d].", path, i)
+		}
+	}
+
+	for i, method := range route.Methods {
+		if !slices.Contains(validMethods, method) {
+			return fmt.Sprintf("Field %s.methods[%d] has invalid HTTP method %q.", path, i, method)
+		}
+	}
+
+	if route.TimeoutPolicy != nil {
+		if !isValidTimeout(route.TimeoutPolicy.Response) {
+			return fmt.Sprintf("Field %s.timeoutPolicy.response has invalid duration %q.", path, route.TimeoutPolicy.Response)
+		}
+		if !isValidTimeout(route.TimeoutPolicy.Idle) {
+			return fmt.Sprintf("Field %s.timeoutPolicy.idle has invalid duration %q.", path, route.TimeoutPolicy.Idle)
+		}
+	}
+
+	if route.RetryPolicy != nil {
+		if route.RetryPolicy.Count < 0 {
+			return fmt.Sprintf("Field %s.retryPolicy.count must not be negative.", path)
+		}
+		if !isValidTimeout(route.RetryPolicy.PerTryTimeout) {
+			return fmt.Sprintf("Field %s.retryPolicy.perTryTimeout has invalid duration %q.", path, route.RetryPolicy.PerTryTimeout)
+		}
+		for i, condition := range route.RetryPolicy.RetryOn {
+			if !slices.Contains(validRetryOn, condition) {
+				return fmt.Sprintf("Field %s.retryPolicy.retryOn[%d] has invalid retry condition %q.", path, i, condition)
+			}
+		}
+	}
+
+	if msg := validateHeaderPolicy(path+".requestHeaders", route.RequestHeaders); msg != "" {
+		return msg
+	}
+	if msg := validateHeaderPolicy(path+".responseHeaders", route.ResponseHeaders); msg != "" {
+		return msg
+	}
+
+	return ""
+}
+
+func validateHeaderPolicy(path string, policy *datamodel.GatewayRouteHeaderPolicy) string {
+	if policy == nil {
+		return ""
+	}
+
+	for name := range policy.Set {
+		if name == "" {
+			return fmt.Sprintf("Field %s.set must not contain an empty header name.", path)
+		}
+	}
+	for _, name := range policy.Remove {
+		if name == "" {
+			return fmt.Sprintf("Field %s.remove must not contain an empty header name.", path)
+		}
+	}
+
+	return ""
+}
+
+func countMatchers(matchers ...bool) int {
+	count := 0
+	for _, m := range matchers {
+		if m {
+			count++
+		}
+	}
+	return count
+}
+
+// isValidTimeout returns true if the timeout is empty, a duration such as 30s or infinity to disable the timeout.
+func isValidTimeout(timeout string) bool {
+	if timeout == "" || timeout == "infinity" {
+		return true
+	}
+
+	d, err := time.ParseDuration(timeout)
+	return err == nil && d >= 0
+}
@@ -108,6 +108,296 @@ func TestValidateAndMutateRequest_Gateway(t *testing.T) {
 			},
 			resp: nil,
 		},
+		{
+			desc: "certificate issuer defaults TLS protocol version to 1.2",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+							Kind:             datamodel.GatewayCertificateIssuerKindACME,
+							ACMEDirectoryURL: "https://acme.example.com/directory",
+							RenewBefore:      "240h",
+						},
+					},
+				},
+			},
+			oldResource: nil,
+			mutatedResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+							Kind:             datamodel.GatewayCertificateIssuerKindACME,
+							ACMEDirectoryURL: "https://acme.example.com/directory",
+							RenewBefore:      "240h",
+						},
+						MinimumProtocolVersion: "1.2",
+					},
+				},
+			},
+			resp: nil,
+		},
+		{
+			desc: "cannot specify both certificateFrom and certificateIssuer",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						CertificateFrom:   "secretname",
+						CertificateIssuer: &datamodel.GatewayCertificateIssuer{Kind: datamodel.GatewayCertificateIssuerKindSelfSigned},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Only one of $.properties.tls.certificateFrom and $.properties.tls.certificateIssuer can be specified at a time."),
+		},
+		{
+			desc: "cannot specify both certificateIssuer and SSL Passthrough",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						SSLPassthrough:    true,
+						CertificateIssuer: &datamodel.GatewayCertificateIssuer{Kind: datamodel.GatewayCertificateIssuerKindSelfSigned},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Only one of $.properties.tls.certificateIssuer and $.properties.tls.sslPassthrough can be specified at a time."),
+		},
+		{
+			desc: "ACME fields require the acme issuer",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+							Kind:  datamodel.GatewayCertificateIssuerKindSelfSigned,
+							Email: "admin@example.com",
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Fields $.properties.tls.certificateIssuer.acmeDirectoryUrl and $.properties.tls.certificateIssuer.email can only be specified when $.properties.tls.certificateIssuer.kind is 'acme'."),
+		},
+		{
+			desc: "invalid ACME directory URL",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+							Kind:             datamodel.GatewayCertificateIssuerKindACME,
+							ACMEDirectoryURL: "acme.example.com",
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.tls.certificateIssuer.acmeDirectoryUrl has invalid URL \"acme.example.com\"."),
+		},
+		{
+			desc: "invalid renewBefore",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+							Kind:        datamodel.GatewayCertificateIssuerKindSelfSigned,
+							RenewBefore: "30d",
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.tls.certificateIssuer.renewBefore has invalid duration \"30d\"."),
+		},
+		{
+			desc: "valid routing rules",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Path: "/api",
+							Destinations: []datamodel.GatewayRouteDestination{
+								{Destination: "stable", Weight: 90},
+								{Destination: "canary", Weight: 10},
+							},
+							Headers: []datamodel.GatewayRouteHeaderMatch{
+								{Name: "x-canary", Exact: "true"},
+							},
+							QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{
+								{Name: "debug", Present: true},
+							},
+							Methods:       []string{"GET", "POST"},
+							TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Response: "30s", Idle: "infinity"},
+							RetryPolicy:   &datamodel.GatewayRouteRetryPolicy{Count: 3, PerTryTimeout: "500ms", RetryOn: []string{"5xx"}},
+							RequestHeaders: &datamodel.GatewayRouteHeaderPolicy{
+								Set: map[string]string{"x-forwarded-by": "radius"},
+							},
+						},
+					},
+				},
+			},
+			oldResource: nil,
+			mutatedResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Path: "/api",
+							Destinations: []datamodel.GatewayRouteDestination{
+								{Destination: "stable", Weight: 90},
+								{Destination: "canary", Weight: 10},
+							},
+							Headers: []datamodel.GatewayRouteHeaderMatch{
+								{Name: "x-canary", Exact: "true"},
+							},
+							QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{
+								{Name: "debug", Present: true},
+							},
+							Methods:       []string{"GET", "POST"},
+							TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Response: "30s", Idle: "infinity"},
+							RetryPolicy:   &datamodel.GatewayRouteRetryPolicy{Count: 3, PerTryTimeout: "500ms", RetryOn: []string{"5xx"}},
+							RequestHeaders: &datamodel.GatewayRouteHeaderPolicy{
+								Set: map[string]string{"x-forwarded-by": "radius"},
+							},
+						},
+					},
+				},
+			},
+			resp: nil,
+		},
+		{
+			desc: "cannot specify both destination and destinations",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination:  "stable",
+							Destinations: []datamodel.GatewayRouteDestination{{Destination: "canary", Weight: 10}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Only one of $.properties.routes[0].destination and $.properties.routes[0].destinations can be specified at a time."),
+		},
+		{
+			desc: "destination weight cannot be negative",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destinations: []datamodel.GatewayRouteDestination{{Destination: "stable", Weight: 10}, {Destination: "canary", Weight: -1}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].destinations[1].weight must not be negative."),
+		},
+		{
+			desc: "destinations must have a positive weight",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destinations: []datamodel.GatewayRouteDestination{{Destination: "stable"}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("At least one of $.properties.routes[0].destinations must have a positive weight."),
+		},
+		{
+			desc: "header match requires exactly one matcher",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "stable",
+							Headers:     []datamodel.GatewayRouteHeaderMatch{{Name: "x-canary", Exact: "true", Present: true}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Exactly one of exact, contains and present must be specified for $.properties.routes[0].headers[0]."),
+		},
+		{
+			desc: "query parameter match requires a name",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination:     "stable",
+							QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{{Exact: "v2"}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].queryParameters[0].name is required."),
+		},
+		{
+			desc: "invalid HTTP method",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "stable",
+							Methods:     []string{"GET", "FETCH"},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].methods[1] has invalid HTTP method \"FETCH\"."),
+		},
+		{
+			desc: "invalid timeout",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination:   "stable",
+							TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Response: "ten seconds"},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].timeoutPolicy.response has invalid duration \"ten seconds\"."),
+		},
+		{
+			desc: "invalid retry condition",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "stable",
+							RetryPolicy: &datamodel.GatewayRouteRetryPolicy{Count: 2, RetryOn: []string{"always"}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].retryPolicy.retryOn[0] has invalid retry condition \"always\"."),
+		},
+		{
+			desc: "empty header name in header policy",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination:     "stable",
+							ResponseHeaders: &datamodel.GatewayRouteHeaderPolicy{Remove: []string{""}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].responseHeaders.remove must not contain an empty header name."),
+		},
+		{
+			desc: "cannot specify routing rules with SSL Passthrough",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						SSLPassthrough: true,
+					},
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "stable",
+							Methods:     []string{"GET"},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0] cannot specify routing rules when $.properties.tls.sslPassthrough is set to true."),
+		},
 	}
 
 	for _, tc := range requestTests {
@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+const (
+	manifestTargetProperty          = "$.properties.runtimes.kubernetes.base"
+	podTargetProperty               = "$.properties.runtimes.kubernetes.pod"
+	portsTargetProperty             = "$.properties.container.ports"
+	scheduleTargetProperty          = "$.properties.schedule"
+	restartPolicyTargetProperty     = "$.properties.restartPolicy"
+	concurrencyPolicyTargetProperty = "$.properties.concurrencyPolicy"
+	extensionsTargetProperty        = "$.properties.extensions"
+)
+
+// cronMacros is the set of predefined schedules supported by Kubernetes CronJobs.
+var cronMacros = map[string]bool{
+	"@yearly":   true,
+	"@annually": true,
+	"@monthly":  true,
+	"@weekly":   true,
+	"@daily":    true,
+	"@midnight": true,
+	"@hourly":   true,
+}
+
+// cronFields describes the allowed range and names of each field in a standard 5-field cron expression.
+var cronFields = []struct {
+	name     string
+	min, max int
+	names    []string
+}{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 6, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// ValidateAndMutateRequest checks if the newResource has a user-defined identity and if so, returns a bad request
+// response, otherwise it sets the identity of the newResource to the identity of the oldResource if it exists. It also
+// validates the job specific properties such as the schedule, restart policy and concurrency policy.
+func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *datamodel.JobResource, options *controller.Options) (rest.Response, error) {
+	if newResource.Properties.Identity != nil {
+		return rest.NewBadRequestResponse("User-defined identity in Applications.Core/jobs is not supported."), nil
+	}
+
+	if oldResource != nil {
+		// Identity property is populated during deployment.
+		// This will populate the existing identity to new resource to keep the identity info.
+		newResource.Properties.Identity = oldResource.Properties.Identity
+	}
+
+	errDetails := []v1.ErrorDetails{}
+
+	if len(newResource.Properties.Container.Ports) > 0 {
+		errDetails = append(errDetails, v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  portsTargetProperty,
+			Message: "ports are not supported for jobs.",
+		})
+	}
+
+	for _, ext := range newResource.Properties.Extensions {
+		// Replicas and Dapr sidecars don't apply to pods which run to completion.
+		if ext.Kind != datamodel.KubernetesMetadata {
+			errDetails = append(errDetails, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  extensionsTargetProperty,
+				Message: fmt.Sprintf("extension %s is not supported for jobs.", ext.Kind),
+			})
+		}
+	}
+
+	switch newResource.Properties.RestartPolicy {
+	case "", string(corev1.RestartPolicyOnFailure), string(corev1.RestartPolicyNever):
+	default:
+		errDetails = append(errDetails, v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  restartPolicyTargetProperty,
+			Message: fmt.Sprintf("restart policy %q is not supported for jobs. Supported values are OnFailure and Never.", newResource.Properties.RestartPolicy),
+		})
+	}
+
+	if newResource.IsScheduled() {
+		if err := validateSchedule(newResource.Properties.Schedule); err != nil {
+			errDetails = append(errDetails, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  scheduleTargetProperty,
+				Message: fmt.Sprintf("Invalid schedule %q: %s.", newResource.Properties.Schedule, err.Error()),
+			})
+		}
+	} else if newResource.Properties.ConcurrencyPolicy != "" {
+		errDetails = append(errDetails, v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  concurrencyPolicyTargetProperty,
+			Message: "concurrency policy can only be set for scheduled jobs.",
+		})
+	}
+
+	runtimes := newResource.Properties.Runtimes
+	if runtimes != nil && runtimes.Kubernetes != nil {
+		if runtimes.Kubernetes.Base != "" {
+			errDetails = append(errDetails, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  manifestTargetProperty,
+				Message: "base manifest is not supported for jobs.",
+			})
+		}
+
+		if runtimes.Kubernetes.Pod != "" {
+			if err := validatePodSpec([]byte(runtimes.Kubernetes.Pod)); err != nil {
+				errDetails = append(errDetails, err.(v1.ErrorDetails))
+			}
+		}
+	}
+
+	if len(errDetails) == 1 {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: errDetails[0]}), nil
+	} else if len(errDetails) > 1 {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Message: "The job definition is invalid.",
+				Details: errDetails,
+			},
+		}), nil
+	}
+
+	return nil, nil
+}
+
+// validatePodSpec is doing only syntactic validation for PodSpec by deserialzing the given JSON patch
+// to PodSpec object at this time.
+func validatePodSpec(patch []byte) error {
+	podSpec := &corev1.PodSpec{}
+	err := json.Unmarshal(patch, podSpec)
+	if err != nil {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  podTargetProperty,
+			Message: fmt.Sprintf("Invalid PodSpec for patching: %s.", err.Error()),
+		}
+	}
+	return nil
+}
+
+// validateSchedule validates the syntax of a cron schedule in the standard 5-field format, or one of the
+// predefined macros such as @hourly.
+func validateSchedule(schedule string) error {
+	if strings.HasPrefix(schedule, "@") {
+		if !cronMacros[schedule] {
+			return fmt.Errorf("unsupported macro %s", schedule)
+		}
+		return nil
+	}
+
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected %d fields but found %d", len(cronFields), len(fields))
+	}
+
+	for i, field := range fields {
+		spec := cronFields[i]
+		for _, item := range strings.Split(field, ",") {
+			if err := validateScheduleItem(item, spec.min, spec.max, spec.names); err != nil {
+				return fmt.Errorf("invalid %s field %q: %w", spec.name, field, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateScheduleItem validates a single item of a cron field, which is one of "*", "value", "start-end",
+// optionally followed by "/step".
+func validateScheduleItem(item string, min, max int, names []string) error {
+	rangePart, stepPart, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		step, err := strconv.Atoi(stepPart)
+		if err != nil || step <= 0 {
+			return fmt.Errorf("invalid step %q", stepPart)
+		}
+	}
+
+	if rangePart == "*" || rangePart == "?" {
+		return nil
+	}
+
+	startPart, endPart, isRange := strings.Cut(rangePart, "-")
+	start, err := parseScheduleValue(startPart, min, max, names)
+	if err != nil {
+		return err
+	}
+
+	if isRange {
+		end, err := parseScheduleValue(endPart, min, max, names)
+		if err != nil {
+			return err
+		}
+		if end < start {
+			return fmt.Errorf("range %q is out of order", rangePart)
+		}
+	}
+
+	return nil
+}
+
+func parseScheduleValue(value string, min, max int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(value, name) {
+			return i + min, nil
+		}
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("value %d is out of range [%d-%d]", v, min, max)
+	}
+	return v, nil
+}
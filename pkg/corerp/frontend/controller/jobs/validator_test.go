@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAndMutateRequest_IdentityProperty(t *testing.T) {
+	requestTests := []struct {
+		desc            string
+		newResource     *datamodel.JobResource
+		oldResource     *datamodel.JobResource
+		mutatedResource *datamodel.JobResource
+		resp            rest.Response
+	}{
+		{
+			desc: "nil identity",
+			newResource: &datamodel.JobResource{
+				Properties: datamodel.JobProperties{},
+			},
+			oldResource: &datamodel.JobResource{
+				Properties: datamodel.JobProperties{},
+			},
+			mutatedResource: &datamodel.JobResource{
+				Properties: datamodel.JobProperties{},
+			},
+			resp: nil,
+		},
+		{
+			desc: "user defined identity not supported",
+			newResource: &datamodel.JobResource{
+				Properties: datamodel.JobProperties{
+					Identity: &rpv1.IdentitySettings{
+						Kind:       rpv1.AzureIdentityWorkload,
+						OIDCIssuer: "https://issuer",
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("User-defined identity in Applications.Core/jobs is not supported."),
+		},
+		{
+			desc: "valid identity",
+			newResource: &datamodel.JobResource{
+				Properties: datamodel.JobProperties{},
+			},
+			oldResource: &datamodel.JobResource{
+				Properties: datamodel.JobProperties{
+					Identity: &rpv1.IdentitySettings{
+						Kind:       rpv1.AzureIdentityWorkload,
+						OIDCIssuer: "https://oidcurl/id",
+						Resource:   "identity-resource-id",
+					},
+				},
+			},
+			mutatedResource: &datamodel.JobResource{
+				Properties: datamodel.JobProperties{
+					Identity: &rpv1.IdentitySettings{
+						Kind:       rpv1.AzureIdentityWorkload,
+						OIDCIssuer: "https://oidcurl/id",
+						Resource:   "identity-resource-id",
+					},
+				},
+			},
+			resp: nil,
+		},
+	}
+
+	for _, tc := range requestTests {
+		t.Run(tc.desc, func(t *testing.T) {
+			r, err := ValidateAndMutateRequest(context.Background(), tc.newResource, tc.oldResource, nil)
+
+			require.NoError(t, err)
+			if tc.resp != nil {
+				require.Equal(t, tc.resp, r)
+			} else {
+				require.Nil(t, r)
+				require.Equal(t, tc.mutatedResource, tc.newResource)
+			}
+		})
+	}
+}
+
+func TestValidateAndMutateRequest_JobProperties(t *testing.T) {
+	requestTests := []struct {
+		desc       string
+		properties datamodel.JobProperties
+		target     string
+	}{
+		{
+			desc: "valid one-shot job",
+			properties: datamodel.JobProperties{
+				RestartPolicy: "Never",
+			},
+		},
+		{
+			desc: "valid scheduled job",
+			properties: datamodel.JobProperties{
+				Schedule:          "*/15 0-6 * JAN-MAR mon,wed",
+				RestartPolicy:     "OnFailure",
+				ConcurrencyPolicy: datamodel.JobConcurrencyPolicyForbid,
+			},
+		},
+		{
+			desc: "valid schedule macro",
+			properties: datamodel.JobProperties{
+				Schedule: "@hourly",
+			},
+		},
+		{
+			desc: "kubernetes metadata extension",
+			properties: datamodel.JobProperties{
+				Extensions: []datamodel.Extension{
+					{Kind: datamodel.KubernetesMetadata, KubernetesMetadata: &datamodel.KubeMetadataExtension{}},
+				},
+			},
+		},
+		{
+			desc: "manual scaling extension is not supported",
+			properties: datamodel.JobProperties{
+				Extensions: []datamodel.Extension{
+					{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{}},
+				},
+			},
+			target: extensionsTargetProperty,
+		},
+		{
+			desc: "restart policy Always is not supported",
+			properties: datamodel.JobProperties{
+				RestartPolicy: "Always",
+			},
+			target: restartPolicyTargetProperty,
+		},
+		{
+			desc: "ports are not supported",
+			properties: datamodel.JobProperties{
+				Container: datamodel.Container{
+					Ports: map[string]datamodel.ContainerPort{
+						"web": {ContainerPort: 80},
+					},
+				},
+			},
+			target: portsTargetProperty,
+		},
+		{
+			desc: "invalid number of schedule fields",
+			properties: datamodel.JobProperties{
+				Schedule: "* * *",
+			},
+			target: scheduleTargetProperty,
+		},
+		{
+			desc: "schedule value out of range",
+			properties: datamodel.JobProperties{
+				Schedule: "60 * * * *",
+			},
+			target: scheduleTargetProperty,
+		},
+		{
+			desc: "invalid schedule macro",
+			properties: datamodel.JobProperties{
+				Schedule: "@every-other-day",
+			},
+			target: scheduleTargetProperty,
+		},
+		{
+			desc: "concurrency policy without schedule",
+			properties: datamodel.JobProperties{
+				ConcurrencyPolicy: datamodel.JobConcurrencyPolicyReplace,
+			},
+			target: concurrencyPolicyTargetProperty,
+		},
+		{
+			desc: "base manifest is not supported",
+			properties: datamodel.JobProperties{
+				Runtimes: &datamodel.RuntimeProperties{
+					Kubernetes: &datamodel.KubernetesRuntime{
+						Base: "apiVersion: v1\nkind: ConfigMap",
+					},
+				},
+			},
+			target: manifestTargetProperty,
+		},
+		{
+			desc: "invalid pod patch",
+			properties: datamodel.JobProperties{
+				Runtimes: &datamodel.RuntimeProperties{
+					Kubernetes: &datamodel.KubernetesRuntime{
+						Pod: `{"containers": "invalid"}`,
+					},
+				},
+			},
+			target: podTargetProperty,
+		},
+	}
+
+	for _, tc := range requestTests {
+		t.Run(tc.desc, func(t *testing.T) {
+			newResource := &datamodel.JobResource{Properties: tc.properties}
+			r, err := ValidateAndMutateRequest(context.Background(), newResource, nil, nil)
+			require.NoError(t, err)
+
+			if tc.target == "" {
+				require.Nil(t, r)
+				return
+			}
+
+			resp, ok := r.(*rest.BadRequestResponse)
+			require.True(t, ok)
+			require.Equal(t, v1.CodeInvalidRequestContent, resp.Body.Error.Code)
+			require.Equal(t, tc.target, resp.Body.Error.Target)
+		})
+	}
+}
+
+func TestValidateAndMutateRequest_MultipleErrors(t *testing.T) {
+	newResource := &datamodel.JobResource{
+		Properties: datamodel.JobProperties{
+			RestartPolicy:     "Always",
+			ConcurrencyPolicy: datamodel.JobConcurrencyPolicyAllow,
+		},
+	}
+
+	r, err := ValidateAndMutateRequest(context.Background(), newResource, nil, nil)
+	require.NoError(t, err)
+
+	resp, ok := r.(*rest.BadRequestResponse)
+	require.True(t, ok)
+	require.Equal(t, "The job definition is invalid.", resp.Body.Error.Message)
+	require.Len(t, resp.Body.Error.Details, 2)
+}
@@ -19,6 +19,7 @@ package volumes
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
@@ -26,6 +27,8 @@ import (
 
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -33,14 +36,26 @@ const (
 	secretProviderClassesCRD = "secretproviderclasses.secrets-store.csi.x-k8s.io"
 )
 
-// ValidateRequest checks if the new resource kind is valid and if a Secret CSI driver is installed for the resource kind.
-// If not, it returns a BadRequestResponse.
+// ValidateRequest checks if the new resource kind is valid, if a Secret CSI driver is installed for the resource kind
+// and if the Kubernetes volume properties are valid. If not, it returns a BadRequestResponse.
 func ValidateRequest(ctx context.Context, newResource *datamodel.VolumeResource, oldResource *datamodel.VolumeResource, options *controller.Options) (rest.Response, error) {
 	csiCRDValidationRequired := false
 
 	switch newResource.Properties.Kind {
 	case datamodel.AzureKeyVaultVolume:
 		csiCRDValidationRequired = true
+	case datamodel.PersistentVolumeClaimVolume:
+		if msg := validatePersistentVolumeClaim(newResource, oldResource); msg != "" {
+			return rest.NewBadRequestResponse(msg), nil
+		}
+	case datamodel.ConfigMapVolume:
+		if msg := validateConfigMap(newResource); msg != "" {
+			return rest.NewBadRequestResponse(msg), nil
+		}
+	case datamodel.EmptyDirVolume:
+		if msg := validateEmptyDir(newResource); msg != "" {
+			return rest.NewBadRequestResponse(msg), nil
+		}
 	default:
 		return rest.NewBadRequestResponse(fmt.Sprintf("invalid resource kind: %s", newResource.Properties.Kind)), nil
 	}
@@ -59,3 +74,64 @@ func ValidateRequest(ctx context.Context, newResource *datamodel.VolumeResource,
 
 	return nil, nil
 }
+
+// validatePersistentVolumeClaim validates the persistent volume claim properties. Kubernetes does not allow the
+// storage class or access mode of a bound claim to change, and a claim can only grow, so these are rejected up front.
+func validatePersistentVolumeClaim(newResource *datamodel.VolumeResource, oldResource *datamodel.VolumeResource) string {
+	pvc := newResource.Properties.PersistentVolumeClaim
+	if pvc == nil {
+		return "persistentVolumeClaim properties are required"
+	}
+
+	size, err := resource.ParseQuantity(pvc.Size)
+	if err != nil {
+		return fmt.Sprintf("invalid size %q: %s", pvc.Size, err.Error())
+	}
+
+	if oldResource == nil || oldResource.Properties.PersistentVolumeClaim == nil {
+		return ""
+	}
+
+	old := oldResource.Properties.PersistentVolumeClaim
+	if old.StorageClassName != pvc.StorageClassName {
+		return fmt.Sprintf("storageClassName cannot be changed from %q to %q", old.StorageClassName, pvc.StorageClassName)
+	}
+	if old.AccessMode != pvc.AccessMode {
+		return fmt.Sprintf("accessMode cannot be changed from %q to %q", old.AccessMode, pvc.AccessMode)
+	}
+	if oldSize, err := resource.ParseQuantity(old.Size); err == nil && size.Cmp(oldSize) < 0 {
+		return fmt.Sprintf("size cannot be reduced from %s to %s", old.Size, pvc.Size)
+	}
+
+	return ""
+}
+
+// validateConfigMap validates that the config map volume file names are valid config map keys.
+func validateConfigMap(newResource *datamodel.VolumeResource) string {
+	cm := newResource.Properties.ConfigMap
+	if cm == nil || len(cm.Data) == 0 {
+		return "configMap volume must define at least one file in data"
+	}
+
+	for key := range cm.Data {
+		if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+			return fmt.Sprintf("invalid file name %q: %s", key, strings.Join(errs, ", "))
+		}
+	}
+
+	return ""
+}
+
+// validateEmptyDir validates the empty directory volume properties.
+func validateEmptyDir(newResource *datamodel.VolumeResource) string {
+	emptyDir := newResource.Properties.EmptyDir
+	if emptyDir == nil || emptyDir.SizeLimit == "" {
+		return ""
+	}
+
+	if _, err := resource.ParseQuantity(emptyDir.SizeLimit); err != nil {
+		return fmt.Sprintf("invalid sizeLimit %q: %s", emptyDir.SizeLimit, err.Error())
+	}
+
+	return ""
+}
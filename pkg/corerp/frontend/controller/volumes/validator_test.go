@@ -151,6 +151,219 @@ func TestValidateRequest(t *testing.T) {
 			want:    nil,
 			wantErr: nil,
 		},
+		{
+			name: "pvc-valid",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:                  datamodel.PersistentVolumeClaimVolume,
+						PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{Size: "10Gi", StorageClassName: "managed-csi", AccessMode: datamodel.PersistentVolumeAccessModeReadWriteOnce},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		{
+			name: "pvc-invalid-size",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:                  datamodel.PersistentVolumeClaimVolume,
+						PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{Size: "ten", StorageClassName: "managed-csi", AccessMode: datamodel.PersistentVolumeAccessModeReadWriteOnce},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse(`invalid size "ten": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`),
+			wantErr: nil,
+		},
+		{
+			name: "pvc-grow",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:                  datamodel.PersistentVolumeClaimVolume,
+						PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{Size: "20Gi", StorageClassName: "managed-csi", AccessMode: datamodel.PersistentVolumeAccessModeReadWriteOnce},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:                  datamodel.PersistentVolumeClaimVolume,
+						PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{Size: "10Gi", StorageClassName: "managed-csi", AccessMode: datamodel.PersistentVolumeAccessModeReadWriteOnce},
+					},
+				},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		{
+			name: "pvc-shrink",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:                  datamodel.PersistentVolumeClaimVolume,
+						PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{Size: "5Gi", StorageClassName: "managed-csi", AccessMode: datamodel.PersistentVolumeAccessModeReadWriteOnce},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:                  datamodel.PersistentVolumeClaimVolume,
+						PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{Size: "10Gi", StorageClassName: "managed-csi", AccessMode: datamodel.PersistentVolumeAccessModeReadWriteOnce},
+					},
+				},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse("size cannot be reduced from 10Gi to 5Gi"),
+			wantErr: nil,
+		},
+		{
+			name: "pvc-storage-class-changed",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:                  datamodel.PersistentVolumeClaimVolume,
+						PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{Size: "10Gi", StorageClassName: "premium", AccessMode: datamodel.PersistentVolumeAccessModeReadWriteOnce},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:                  datamodel.PersistentVolumeClaimVolume,
+						PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{Size: "10Gi", StorageClassName: "managed-csi", AccessMode: datamodel.PersistentVolumeAccessModeReadWriteOnce},
+					},
+				},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse(`storageClassName cannot be changed from "managed-csi" to "premium"`),
+			wantErr: nil,
+		},
+		{
+			name: "configmap-valid",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:      datamodel.ConfigMapVolume,
+						ConfigMap: &datamodel.ConfigMapVolumeProperties{Data: map[string]string{"app.conf": "key=value"}},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		{
+			name: "configmap-invalid-key",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:      datamodel.ConfigMapVolume,
+						ConfigMap: &datamodel.ConfigMapVolumeProperties{Data: map[string]string{"conf/app.conf": "key=value"}},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse(`invalid file name "conf/app.conf": a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')`),
+			wantErr: nil,
+		},
+		{
+			name: "configmap-empty",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:      datamodel.ConfigMapVolume,
+						ConfigMap: &datamodel.ConfigMapVolumeProperties{},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse("configMap volume must define at least one file in data"),
+			wantErr: nil,
+		},
+		{
+			name: "emptydir-invalid-size-limit",
+			args: args{
+				ctx: v1.WithARMRequestContext(
+					context.Background(), &v1.ARMRequestContext{
+						ResourceID: mustParseResourceID(resourceID),
+						HTTPMethod: http.MethodPut,
+					}),
+				newResource: &datamodel.VolumeResource{
+					Properties: datamodel.VolumeResourceProperties{
+						Kind:     datamodel.EmptyDirVolume,
+						EmptyDir: &datamodel.EmptyDirVolumeProperties{SizeLimit: "lots"},
+					},
+				},
+				oldResource: &datamodel.VolumeResource{},
+				options: &controller.Options{
+					KubeClient: defaultFakeClient,
+				},
+			},
+			want:    rest.NewBadRequestResponse(`invalid sizeLimit "lots": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`),
+			wantErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
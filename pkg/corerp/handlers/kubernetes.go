@@ -42,6 +42,7 @@ import (
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 const (
@@ -49,7 +50,7 @@ const (
 	DefaultCacheResyncInterval = time.Second * time.Duration(30)
 )
 
-// Create an interface for deployment waiter and http proxy waiter
+// Create an interface for deployment waiter, job waiter, persistent volume claim waiter, http proxy waiter and gateway waiter
 type ResourceWaiter interface {
 	addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error)
 	addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error)
@@ -62,7 +63,10 @@ func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discove
 		client:             client,
 		k8sDiscoveryClient: discoveryClient,
 		httpProxyWaiter:    NewHTTPProxyWaiter(dynamicClientSet),
+		gatewayWaiter:      NewGatewayWaiter(dynamicClientSet),
 		deploymentWaiter:   NewDeploymentWaiter(clientSet),
+		jobWaiter:          NewJobWaiter(clientSet),
+		pvcWaiter:          NewPersistentVolumeClaimWaiter(clientSet),
 	}
 }
 
@@ -71,11 +75,16 @@ type kubernetesHandler struct {
 	// k8sDiscoveryClient is the Kubernetes client to used for API version lookups on Kubernetes resources. Override this for testing.
 	k8sDiscoveryClient discovery.ServerResourcesInterface
 	httpProxyWaiter    ResourceWaiter
+	gatewayWaiter      ResourceWaiter
 	deploymentWaiter   ResourceWaiter
+	jobWaiter          ResourceWaiter
+	pvcWaiter          ResourceWaiter
 }
 
 // Put stores the Kubernetes resource in the cluster and returns the properties of the resource. If the resource is a
-// deployment, it also waits until the deployment is ready.
+// deployment, it also waits until the deployment is ready. If the resource is a job, it waits until the job completes
+// and returns an error if the job fails. If the resource is a persistent volume claim, it waits until the claim is bound.
+// If the resource is a Gateway API Gateway, it waits until the gateway is programmed and returns its public endpoint.
 func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions) (map[string]string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -130,6 +139,22 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		}
 		logger.Info(fmt.Sprintf("Deployment %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
 		return properties, nil
+	case "job":
+		// Monitor the job until it runs to completion.
+		err = handler.jobWaiter.waitUntilReady(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("Job %s in namespace %s has completed", item.GetName(), item.GetNamespace()))
+		return properties, nil
+	case "persistentvolumeclaim":
+		// Monitor the persistent volume claim until it is bound.
+		err = handler.pvcWaiter.waitUntilReady(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("Persistent volume claim %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
+		return properties, nil
 	case "httpproxy":
 		err = handler.httpProxyWaiter.waitUntilReady(ctx, &item)
 		if err != nil {
@@ -137,6 +162,35 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		}
 		logger.Info(fmt.Sprintf("HTTP Proxy %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
 		return properties, nil
+	case "gateway":
+		if groupVersion.Group != gatewayv1beta1.GroupName {
+			return properties, nil
+		}
+
+		err = handler.gatewayWaiter.waitUntilReady(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("Gateway %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
+
+		// The address of the gateway is only known once it has been programmed, so read back the latest status.
+		latest := unstructured.Unstructured{}
+		latest.SetGroupVersionKind(item.GroupVersionKind())
+		err = handler.client.Get(ctx, client.ObjectKeyFromObject(&item), &latest)
+		if err != nil {
+			return nil, err
+		}
+
+		gateway := gatewayv1beta1.Gateway{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(latest.Object, &gateway)
+		if err != nil {
+			return nil, err
+		}
+
+		if endpoint := getGatewayPublicEndpoint(&gateway); endpoint != "" {
+			properties[GatewayPublicEndpointKey] = endpoint
+		}
+		return properties, nil
 	default:
 		// We do not monitor the other resource types.
 		return properties, nil
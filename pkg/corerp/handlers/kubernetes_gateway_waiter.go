@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	MaxGatewayDeploymentTimeout = time.Minute * time.Duration(10)
+
+	// GatewayPublicEndpointKey is the property key for the public endpoint of a Gateway API Gateway, derived
+	// from the address assigned to the Gateway once it has been programmed.
+	GatewayPublicEndpointKey = "gatewaypublicendpoint"
+)
+
+// GatewayGVR is the GroupVersionResource of Gateway API Gateways.
+var GatewayGVR = gatewayv1beta1.SchemeGroupVersion.WithResource("gateways")
+
+type gatewayWaiter struct {
+	dynamicClientSet         dynamic.Interface
+	gatewayDeploymentTimeout time.Duration
+	cacheResyncInterval      time.Duration
+}
+
+// NewGatewayWaiter returns a new instance of GatewayWaiter
+func NewGatewayWaiter(dynamicClientSet dynamic.Interface) *gatewayWaiter {
+	return &gatewayWaiter{
+		dynamicClientSet:         dynamicClientSet,
+		gatewayDeploymentTimeout: MaxGatewayDeploymentTimeout,
+		cacheResyncInterval:      DefaultCacheResyncInterval,
+	}
+}
+
+func (handler *gatewayWaiter) addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			handler.checkGatewayStatus(ctx, informerFactory, item, doneCh)
+		},
+		UpdateFunc: func(_, newObj any) {
+			handler.checkGatewayStatus(ctx, informerFactory, item, doneCh)
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "failed to add event handler")
+	}
+}
+
+// addEventHandler is not implemented for GatewayWaiter
+func (handler *gatewayWaiter) addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+}
+
+func (handler *gatewayWaiter) waitUntilReady(ctx context.Context, obj client.Object) error {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("gatewayName", obj.GetName(), "namespace", obj.GetNamespace())
+
+	doneCh := make(chan error, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, handler.gatewayDeploymentTimeout)
+	// This ensures that the informer is stopped when this function is returned.
+	defer cancel()
+
+	// Create dynamic informer for Gateway
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(handler.dynamicClientSet, handler.cacheResyncInterval, obj.GetNamespace(), nil)
+	gatewayInformer := dynamicInformerFactory.ForResource(GatewayGVR)
+	// Add event handlers to the gateway informer
+	handler.addDynamicEventHandler(ctx, dynamicInformerFactory, gatewayInformer.Informer(), obj, doneCh)
+
+	// Start the informers
+	dynamicInformerFactory.Start(ctx.Done())
+
+	// Wait for the cache to be synced.
+	dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	select {
+	case <-ctx.Done():
+		// Get the final status
+		gateway, err := gatewayInformer.Lister().ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+		if err != nil {
+			return fmt.Errorf("gateway deployment timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		g := gatewayv1beta1.Gateway{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(gateway.(*unstructured.Unstructured).Object, &g)
+		if err != nil {
+			return fmt.Errorf("gateway deployment timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		status := metav1.Condition{}
+		if c := meta.FindStatusCondition(g.Status.Conditions, string(gatewayv1beta1.GatewayConditionProgrammed)); c != nil {
+			status = *c
+		}
+		return fmt.Errorf("gateway deployment timed out, name: %s, namespace %s, status: %s, reason: %s", obj.GetName(), obj.GetNamespace(), status.Message, status.Reason)
+	case err := <-doneCh:
+		if err == nil {
+			logger.Info(fmt.Sprintf("Marking gateway deployment %s in namespace %s as complete", obj.GetName(), obj.GetNamespace()))
+		}
+		return err
+	}
+}
+
+func (handler *gatewayWaiter) checkGatewayStatus(ctx context.Context, dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory, obj client.Object, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("gatewayName", obj.GetName(), "namespace", obj.GetNamespace())
+
+	gateway, err := dynamicInformerFactory.ForResource(GatewayGVR).Lister().ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+	if err != nil {
+		logger.Info(fmt.Sprintf("Unable to get gateway: %s", err.Error()))
+		return false
+	}
+
+	g := gatewayv1beta1.Gateway{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(gateway.(*unstructured.Unstructured).Object, &g)
+	if err != nil {
+		logger.Info(fmt.Sprintf("Unable to convert gateway: %s", err.Error()))
+		return false
+	}
+
+	for _, c := range g.Status.Conditions {
+		// Skip conditions that were reported for a previous version of the gateway
+		if c.ObservedGeneration != g.Generation {
+			continue
+		}
+
+		if c.Type == string(gatewayv1beta1.GatewayConditionAccepted) && c.Status == metav1.ConditionFalse {
+			doneCh <- fmt.Errorf("gateway %s in namespace %s was not accepted. Reason: %s, Message: %s", g.Name, g.Namespace, c.Reason, c.Message)
+			return false
+		}
+
+		if c.Type == string(gatewayv1beta1.GatewayConditionProgrammed) && c.Status == metav1.ConditionTrue {
+			// The Gateway is ready
+			doneCh <- nil
+			return true
+		}
+	}
+
+	return false
+}
+
+// getGatewayPublicEndpoint returns the URL where the given Gateway can be reached, based on the hostname of its
+// listener or the first address assigned to it. An empty string is returned when no address has been assigned.
+func getGatewayPublicEndpoint(gateway *gatewayv1beta1.Gateway) string {
+	if len(gateway.Spec.Listeners) == 0 {
+		return ""
+	}
+
+	listener := gateway.Spec.Listeners[0]
+	host := ""
+	if listener.Hostname != nil {
+		host = string(*listener.Hostname)
+	} else if len(gateway.Status.Addresses) > 0 {
+		host = gateway.Status.Addresses[0].Value
+	}
+
+	if host == "" {
+		return ""
+	}
+
+	scheme, defaultPort := "http", 80
+	if listener.Protocol == gatewayv1beta1.HTTPSProtocolType || listener.Protocol == gatewayv1beta1.TLSProtocolType {
+		scheme, defaultPort = "https", 443
+	}
+
+	authority := host
+	if int(listener.Port) != defaultPort {
+		authority = net.JoinHostPort(host, strconv.Itoa(int(listener.Port)))
+	} else if net.ParseIP(host) != nil && net.ParseIP(host).To4() == nil {
+		// IPv6 addresses must be enclosed in brackets
+		authority = "[" + host + "]"
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, authority)
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func newTestGateway(conditions ...metav1.Condition) *gatewayv1beta1.Gateway {
+	return &gatewayv1beta1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Gateway",
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Name:       "test-gateway",
+			Generation: 2,
+		},
+		Status: gatewayv1beta1.GatewayStatus{
+			Conditions: conditions,
+		},
+	}
+}
+
+func newTestGatewayInformerFactory(t *testing.T, gateway *gatewayv1beta1.Gateway) (dynamic.Interface, dynamicinformer.DynamicSharedInformerFactory) {
+	// create fake dynamic clientset
+	s := runtime.NewScheme()
+	err := gatewayv1beta1.AddToScheme(s)
+	require.NoError(t, err)
+	fakeClient := fakedynamic.NewSimpleDynamicClient(s)
+
+	// create a fake dynamic informer factory and add the gateway to the informer cache
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(fakeClient, 0, "default", nil)
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(gateway)
+	require.NoError(t, err)
+	err = dynamicInformerFactory.ForResource(GatewayGVR).Informer().GetIndexer().Add(&unstructured.Unstructured{Object: obj})
+	require.NoError(t, err, "Could not add test gateway to informer cache")
+
+	return fakeClient, dynamicInformerFactory
+}
+
+func checkTestGatewayStatus(t *testing.T, gateway *gatewayv1beta1.Gateway) (bool, error) {
+	ctx := context.Background()
+	fakeClient, dynamicInformerFactory := newTestGatewayInformerFactory(t, gateway)
+
+	obj := &gatewayv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "test-gateway",
+		},
+	}
+
+	gatewayWaiter := &gatewayWaiter{
+		dynamicClientSet: fakeClient,
+	}
+
+	doneCh := make(chan error, 1)
+	ready := gatewayWaiter.checkGatewayStatus(ctx, dynamicInformerFactory, obj, doneCh)
+	if len(doneCh) == 0 {
+		return ready, nil
+	}
+
+	return ready, <-doneCh
+}
+
+func TestCheckGatewayStatus_Programmed(t *testing.T) {
+	gateway := newTestGateway(
+		metav1.Condition{
+			Type:               string(gatewayv1beta1.GatewayConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 2,
+		},
+		metav1.Condition{
+			Type:               string(gatewayv1beta1.GatewayConditionProgrammed),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 2,
+		},
+	)
+
+	ready, err := checkTestGatewayStatus(t, gateway)
+	require.NoError(t, err)
+	require.True(t, ready)
+}
+
+func TestCheckGatewayStatus_NotAccepted(t *testing.T) {
+	gateway := newTestGateway(
+		metav1.Condition{
+			Type:               string(gatewayv1beta1.GatewayConditionAccepted),
+			Status:             metav1.ConditionFalse,
+			Reason:             string(gatewayv1beta1.GatewayReasonListenersNotValid),
+			Message:            "listener is invalid",
+			ObservedGeneration: 2,
+		},
+	)
+
+	ready, err := checkTestGatewayStatus(t, gateway)
+	require.False(t, ready)
+	require.Error(t, err)
+	require.Equal(t, "gateway test-gateway in namespace default was not accepted. Reason: ListenersNotValid, Message: listener is invalid", err.Error())
+}
+
+func TestCheckGatewayStatus_StaleCondition(t *testing.T) {
+	// The Programmed condition was reported for a previous generation, so the gateway is not ready yet
+	gateway := newTestGateway(
+		metav1.Condition{
+			Type:               string(gatewayv1beta1.GatewayConditionProgrammed),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+		},
+	)
+
+	ready, err := checkTestGatewayStatus(t, gateway)
+	require.NoError(t, err)
+	require.False(t, ready)
+}
+
+func TestGetGatewayPublicEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		listener gatewayv1beta1.Listener
+		address  string
+		expected string
+	}{
+		{
+			name: "listener hostname",
+			listener: gatewayv1beta1.Listener{
+				Hostname: to.Ptr(gatewayv1beta1.Hostname("example.com")),
+				Port:     80,
+				Protocol: gatewayv1beta1.HTTPProtocolType,
+			},
+			address:  "10.0.0.1",
+			expected: "http://example.com",
+		},
+		{
+			name: "assigned address",
+			listener: gatewayv1beta1.Listener{
+				Port:     80,
+				Protocol: gatewayv1beta1.HTTPProtocolType,
+			},
+			address:  "10.0.0.1",
+			expected: "http://10.0.0.1",
+		},
+		{
+			name: "https with non-default port",
+			listener: gatewayv1beta1.Listener{
+				Port:     8443,
+				Protocol: gatewayv1beta1.HTTPSProtocolType,
+			},
+			address:  "10.0.0.1",
+			expected: "https://10.0.0.1:8443",
+		},
+		{
+			name: "ipv6 address",
+			listener: gatewayv1beta1.Listener{
+				Port:     443,
+				Protocol: gatewayv1beta1.TLSProtocolType,
+			},
+			address:  "fd00::1",
+			expected: "https://[fd00::1]",
+		},
+		{
+			name: "no address assigned",
+			listener: gatewayv1beta1.Listener{
+				Port:     80,
+				Protocol: gatewayv1beta1.HTTPProtocolType,
+			},
+			expected: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := newTestGateway()
+			gateway.Spec.Listeners = []gatewayv1beta1.Listener{tc.listener}
+			if tc.address != "" {
+				gateway.Status.Addresses = []gatewayv1beta1.GatewayAddress{
+					{
+						Value: tc.address,
+					},
+				}
+			}
+
+			require.Equal(t, tc.expected, getGatewayPublicEndpoint(gateway))
+		})
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MaxJobTimeout is the max timeout for waiting for a job to run to completion.
+	// Job duration should not reach to this timeout since async operation worker will time out context before MaxJobTimeout.
+	MaxJobTimeout = time.Minute * time.Duration(10)
+)
+
+type jobWaiter struct {
+	clientSet           k8s.Interface
+	jobTimeOut          time.Duration
+	cacheResyncInterval time.Duration
+}
+
+// NewJobWaiter creates a ResourceWaiter which waits until a Kubernetes Job has either completed or failed.
+func NewJobWaiter(clientSet k8s.Interface) *jobWaiter {
+	return &jobWaiter{
+		clientSet:           clientSet,
+		jobTimeOut:          MaxJobTimeout,
+		cacheResyncInterval: DefaultCacheResyncInterval,
+	}
+}
+
+func (handler *jobWaiter) addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			handler.checkJobStatus(ctx, informerFactory, item, doneCh)
+		},
+		UpdateFunc: func(_, newObj any) {
+			handler.checkJobStatus(ctx, informerFactory, item, doneCh)
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "failed to add event handler")
+	}
+}
+
+// addDynamicEventHandler is not implemented for jobWaiter
+func (handler *jobWaiter) addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+}
+
+func (handler *jobWaiter) waitUntilReady(ctx context.Context, item client.Object) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// When the job completes, an error nil will be sent
+	// In case of a failure, the error will be sent
+	doneCh := make(chan error, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, handler.jobTimeOut)
+	// This ensures that the informer is stopped when this function is returned.
+	defer cancel()
+
+	handler.startInformers(ctx, item, doneCh)
+
+	select {
+	case <-ctx.Done():
+		// Get the final job status
+		job, err := handler.clientSet.BatchV1().Jobs(item.GetNamespace()).Get(ctx, item.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("job timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", item.GetName(), item.GetNamespace(), err)
+		}
+
+		return fmt.Errorf("job timed out, name: %s, namespace %s, active: %d, succeeded: %d, failed: %d", item.GetName(), item.GetNamespace(), job.Status.Active, job.Status.Succeeded, job.Status.Failed)
+
+	case err := <-doneCh:
+		if err == nil {
+			logger.Info(fmt.Sprintf("Marking job %s in namespace %s as complete", item.GetName(), item.GetNamespace()))
+		}
+		return err
+	}
+}
+
+// checkJobStatus checks whether the job has completed or failed. It returns true when the outcome of the job is known.
+func (handler *jobWaiter) checkJobStatus(ctx context.Context, informerFactory informers.SharedInformerFactory, item client.Object, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("jobName", item.GetName(), "namespace", item.GetNamespace())
+
+	job, err := informerFactory.Batch().V1().Jobs().Lister().Jobs(item.GetNamespace()).Get(item.GetName())
+	if err != nil {
+		logger.Info("Unable to find job")
+		return false
+	}
+
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch c.Type {
+		case batchv1.JobComplete:
+			logger.Info(fmt.Sprintf("Job completed. Succeeded: %d", job.Status.Succeeded))
+			notifyDone(doneCh, nil)
+			return true
+		case batchv1.JobFailed:
+			logger.Info(fmt.Sprintf("Job failed. Reason: %s, Message: %s", c.Reason, c.Message))
+			notifyDone(doneCh, fmt.Errorf("job %s in namespace %s failed, reason: %s, message: %s", job.Name, job.Namespace, c.Reason, c.Message))
+			return true
+		}
+	}
+
+	// A job whose pods can't be started will never complete or fail by itself, so we report those errors early.
+	err = handler.checkPodsStartable(ctx, informerFactory, job)
+	if err != nil {
+		notifyDone(doneCh, err)
+		return true
+	}
+
+	logger.Info(fmt.Sprintf("Job is still running. Active: %d, Succeeded: %d, Failed: %d", job.Status.Active, job.Status.Succeeded, job.Status.Failed))
+	return false
+}
+
+// checkPodsStartable returns an error if any of the pods of the job can't start due to image pull errors.
+func (handler *jobWaiter) checkPodsStartable(ctx context.Context, informerFactory informers.SharedInformerFactory, job *batchv1.Job) error {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("jobName", job.Name, "namespace", job.Namespace)
+
+	if job.Spec.Selector == nil {
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		logger.Info(fmt.Sprintf("Invalid selector for job: %s", err.Error()))
+		return nil
+	}
+
+	pl, err := informerFactory.Core().V1().Pods().Lister().Pods(job.Namespace).List(selector)
+	if err != nil {
+		logger.Info(fmt.Sprintf("Unable to find pods for job: %s", err.Error()))
+		return nil
+	}
+
+	for _, p := range pl {
+		if !metav1.IsControlledBy(p, job) {
+			continue
+		}
+
+		for _, cs := range p.Status.ContainerStatuses {
+			if cs.State.Waiting == nil {
+				continue
+			}
+
+			if cs.State.Waiting.Reason == "ErrImagePull" || cs.State.Waiting.Reason == "ImagePullBackOff" {
+				return fmt.Errorf("Container state is 'Waiting' Reason: %s, Message: %s", cs.State.Waiting.Reason, cs.State.Waiting.Message)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (handler *jobWaiter) startInformers(ctx context.Context, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(handler.clientSet, handler.cacheResyncInterval, informers.WithNamespace(item.GetNamespace()))
+	// Add event handlers to the pod informer
+	handler.addEventHandler(ctx, informerFactory, informerFactory.Core().V1().Pods().Informer(), item, doneCh)
+
+	// Add event handlers to the job informer
+	handler.addEventHandler(ctx, informerFactory, informerFactory.Batch().V1().Jobs().Informer(), item, doneCh)
+
+	// Start the informers
+	informerFactory.Start(ctx.Done())
+
+	// Wait for the job and pod informer's cache to be synced.
+	informerFactory.WaitForCacheSync(ctx.Done())
+
+	logger.Info(fmt.Sprintf("Informers started and caches synced for job: %s in namespace: %s", item.GetName(), item.GetNamespace()))
+}
+
+// notifyDone sends the outcome of a wait to doneCh without blocking. Several informers (e.g. the job and the pod
+// informers) may observe the outcome, but only the first result is consumed by waitUntilReady.
+func notifyDone(doneCh chan<- error, err error) {
+	select {
+	case doneCh <- err:
+	default:
+	}
+}
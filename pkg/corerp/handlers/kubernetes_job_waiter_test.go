@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var testJob = &batchv1.Job{
+	TypeMeta: metav1.TypeMeta{
+		Kind:       "Job",
+		APIVersion: "batch/v1",
+	},
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "test-job",
+		Namespace: "test-namespace",
+		UID:       "test-job-uid",
+	},
+	Spec: batchv1.JobSpec{
+		Selector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app": "test",
+			},
+		},
+	},
+	Status: batchv1.JobStatus{
+		Succeeded: 1,
+		Conditions: []batchv1.JobCondition{
+			{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			},
+		},
+	},
+}
+
+func newTestJob(conditions ...batchv1.JobCondition) *batchv1.Job {
+	job := testJob.DeepCopy()
+	job.Status = batchv1.JobStatus{Conditions: conditions}
+	return job
+}
+
+func startJobInformers(ctx context.Context, clientSet *fake.Clientset) informers.SharedInformerFactory {
+	informerFactory := informers.NewSharedInformerFactory(clientSet, 0)
+
+	// Add informers
+	informerFactory.Batch().V1().Jobs().Informer()
+	informerFactory.Core().V1().Pods().Informer()
+
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+	return informerFactory
+}
+
+func TestJobWaitUntilReady_Complete(t *testing.T) {
+	ctx := context.Background()
+	job := newTestJob(batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue})
+
+	waiter := &jobWaiter{
+		clientSet:           fake.NewSimpleClientset(job),
+		jobTimeOut:          time.Duration(50) * time.Second,
+		cacheResyncInterval: time.Duration(10) * time.Second,
+	}
+
+	err := waiter.waitUntilReady(ctx, job)
+	require.NoError(t, err)
+}
+
+func TestJobWaitUntilReady_Failed(t *testing.T) {
+	ctx := context.Background()
+	job := newTestJob(batchv1.JobCondition{
+		Type:    batchv1.JobFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  "BackoffLimitExceeded",
+		Message: "Job has reached the specified backoff limit",
+	})
+
+	waiter := &jobWaiter{
+		clientSet:           fake.NewSimpleClientset(job),
+		jobTimeOut:          time.Duration(50) * time.Second,
+		cacheResyncInterval: time.Duration(10) * time.Second,
+	}
+
+	err := waiter.waitUntilReady(ctx, job)
+	require.Error(t, err)
+	require.Equal(t, "job test-job in namespace test-namespace failed, reason: BackoffLimitExceeded, message: Job has reached the specified backoff limit", err.Error())
+}
+
+func TestJobWaitUntilReady_Timeout(t *testing.T) {
+	ctx := context.Background()
+	job := newTestJob()
+	job.Status.Active = 1
+
+	waiter := &jobWaiter{
+		clientSet:           fake.NewSimpleClientset(job),
+		jobTimeOut:          time.Duration(1) * time.Second,
+		cacheResyncInterval: time.Duration(10) * time.Second,
+	}
+
+	err := waiter.waitUntilReady(ctx, job)
+	require.Error(t, err)
+	require.Equal(t, "job timed out, name: test-job, namespace test-namespace, active: 1, succeeded: 0, failed: 0", err.Error())
+}
+
+func TestCheckJobStatus(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	job := newTestJob()
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-job-pod",
+			Namespace: job.Namespace,
+			Labels:    job.Spec.Selector.MatchLabels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(job, batchv1.SchemeGroupVersion.WithKind("Job")),
+			},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{
+							Reason: "ContainerCreating",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	clientSet := fake.NewSimpleClientset(job, pod)
+	informerFactory := startJobInformers(ctx, clientSet)
+	waiter := NewJobWaiter(clientSet)
+
+	// The job is still running.
+	doneCh := make(chan error, 1)
+	done := waiter.checkJobStatus(ctx, informerFactory, job, doneCh)
+	require.False(t, done)
+	require.Len(t, doneCh, 0)
+
+	// The pod can't pull its image.
+	pod.Status.ContainerStatuses[0].State.Waiting = &corev1.ContainerStateWaiting{
+		Reason:  "ImagePullBackOff",
+		Message: "Back-off pulling image",
+	}
+	err := informerFactory.Core().V1().Pods().Informer().GetIndexer().Update(pod)
+	require.NoError(t, err)
+
+	done = waiter.checkJobStatus(ctx, informerFactory, job, doneCh)
+	require.True(t, done)
+	err = <-doneCh
+	require.EqualError(t, err, "Container state is 'Waiting' Reason: ImagePullBackOff, Message: Back-off pulling image")
+}
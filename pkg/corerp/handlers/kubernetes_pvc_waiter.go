@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MaxPersistentVolumeClaimTimeout is the max timeout for waiting for a persistent volume claim to be bound.
+	MaxPersistentVolumeClaimTimeout = time.Minute * time.Duration(5)
+
+	// defaultStorageClassAnnotation is the annotation which marks the default storage class of the cluster.
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+)
+
+type pvcWaiter struct {
+	clientSet           k8s.Interface
+	pvcTimeOut          time.Duration
+	cacheResyncInterval time.Duration
+}
+
+// NewPersistentVolumeClaimWaiter creates a ResourceWaiter which waits until a Kubernetes PersistentVolumeClaim is bound.
+func NewPersistentVolumeClaimWaiter(clientSet k8s.Interface) *pvcWaiter {
+	return &pvcWaiter{
+		clientSet:           clientSet,
+		pvcTimeOut:          MaxPersistentVolumeClaimTimeout,
+		cacheResyncInterval: DefaultCacheResyncInterval,
+	}
+}
+
+func (handler *pvcWaiter) addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			handler.checkPersistentVolumeClaimStatus(ctx, informerFactory, item, doneCh)
+		},
+		UpdateFunc: func(_, newObj any) {
+			handler.checkPersistentVolumeClaimStatus(ctx, informerFactory, item, doneCh)
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "failed to add event handler")
+	}
+}
+
+// addDynamicEventHandler is not implemented for pvcWaiter
+func (handler *pvcWaiter) addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+}
+
+func (handler *pvcWaiter) waitUntilReady(ctx context.Context, item client.Object) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// A claim whose storage class delays binding until a pod uses it stays Pending until the container that
+	// mounts it is deployed, so there is nothing to wait for here.
+	waitForConsumer, err := handler.isWaitForFirstConsumer(ctx, item)
+	if err != nil {
+		return err
+	}
+	if waitForConsumer {
+		logger.Info(fmt.Sprintf("Persistent volume claim %s in namespace %s will be bound when it is first used", item.GetName(), item.GetNamespace()))
+		return nil
+	}
+
+	// When the claim is bound, an error nil will be sent
+	// In case of a failure, the error will be sent
+	doneCh := make(chan error, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, handler.pvcTimeOut)
+	// This ensures that the informer is stopped when this function is returned.
+	defer cancel()
+
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(handler.clientSet, handler.cacheResyncInterval, informers.WithNamespace(item.GetNamespace()))
+	handler.addEventHandler(ctx, informerFactory, informerFactory.Core().V1().PersistentVolumeClaims().Informer(), item, doneCh)
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+
+	select {
+	case <-ctx.Done():
+		// Get the final claim status
+		pvc, err := handler.clientSet.CoreV1().PersistentVolumeClaims(item.GetNamespace()).Get(ctx, item.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("persistent volume claim timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", item.GetName(), item.GetNamespace(), err)
+		}
+
+		return fmt.Errorf("persistent volume claim timed out, name: %s, namespace %s, phase: %s", item.GetName(), item.GetNamespace(), pvc.Status.Phase)
+
+	case err := <-doneCh:
+		if err == nil {
+			logger.Info(fmt.Sprintf("Marking persistent volume claim %s in namespace %s as bound", item.GetName(), item.GetNamespace()))
+		}
+		return err
+	}
+}
+
+// isWaitForFirstConsumer returns true if the storage class of the claim binds volumes only when a pod using the claim is scheduled.
+func (handler *pvcWaiter) isWaitForFirstConsumer(ctx context.Context, item client.Object) (bool, error) {
+	pvc, err := handler.clientSet.CoreV1().PersistentVolumeClaims(item.GetNamespace()).Get(ctx, item.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	var sc *storagev1.StorageClass
+	if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+		sc, err = handler.clientSet.StorageV1().StorageClasses().Get(ctx, *pvc.Spec.StorageClassName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+	} else {
+		scl, err := handler.clientSet.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+		for i := range scl.Items {
+			if scl.Items[i].Annotations[defaultStorageClassAnnotation] == "true" {
+				sc = &scl.Items[i]
+				break
+			}
+		}
+	}
+
+	return sc != nil && sc.VolumeBindingMode != nil && *sc.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer, nil
+}
+
+// checkPersistentVolumeClaimStatus checks whether the claim has been bound or lost. It returns true when the outcome is known.
+func (handler *pvcWaiter) checkPersistentVolumeClaimStatus(ctx context.Context, informerFactory informers.SharedInformerFactory, item client.Object, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("persistentVolumeClaimName", item.GetName(), "namespace", item.GetNamespace())
+
+	pvc, err := informerFactory.Core().V1().PersistentVolumeClaims().Lister().PersistentVolumeClaims(item.GetNamespace()).Get(item.GetName())
+	if err != nil {
+		logger.Info("Unable to find persistent volume claim")
+		return false
+	}
+
+	switch pvc.Status.Phase {
+	case corev1.ClaimBound:
+		logger.Info(fmt.Sprintf("Persistent volume claim is bound to volume %s", pvc.Spec.VolumeName))
+		notifyDone(doneCh, nil)
+		return true
+	case corev1.ClaimLost:
+		notifyDone(doneCh, fmt.Errorf("persistent volume claim %s in namespace %s lost its underlying volume %s", pvc.Name, pvc.Namespace, pvc.Spec.VolumeName))
+		return true
+	}
+
+	logger.Info(fmt.Sprintf("Persistent volume claim is not bound yet. Phase: %s", pvc.Status.Phase))
+	return false
+}
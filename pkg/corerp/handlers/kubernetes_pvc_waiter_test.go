@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestPersistentVolumeClaim(storageClassName string, phase corev1.PersistentVolumeClaimPhase) *corev1.PersistentVolumeClaim {
+	pvc := &corev1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pvc",
+			Namespace: "test-namespace",
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			VolumeName: "pv-1",
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Phase: phase,
+		},
+	}
+	if storageClassName != "" {
+		pvc.Spec.StorageClassName = to.Ptr(storageClassName)
+	}
+	return pvc
+}
+
+func newTestStorageClass(name string, isDefault bool, mode storagev1.VolumeBindingMode) *storagev1.StorageClass {
+	sc := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{},
+		},
+		VolumeBindingMode: &mode,
+	}
+	if isDefault {
+		sc.Annotations[defaultStorageClassAnnotation] = "true"
+	}
+	return sc
+}
+
+func TestPersistentVolumeClaimWaitUntilReady(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+		wantErr string
+	}{
+		{
+			name: "bound",
+			objects: []runtime.Object{
+				newTestPersistentVolumeClaim("standard", corev1.ClaimBound),
+				newTestStorageClass("standard", false, storagev1.VolumeBindingImmediate),
+			},
+		},
+		{
+			name: "lost",
+			objects: []runtime.Object{
+				newTestPersistentVolumeClaim("standard", corev1.ClaimLost),
+				newTestStorageClass("standard", false, storagev1.VolumeBindingImmediate),
+			},
+			wantErr: "persistent volume claim test-pvc in namespace test-namespace lost its underlying volume pv-1",
+		},
+		{
+			name: "pending-timeout",
+			objects: []runtime.Object{
+				newTestPersistentVolumeClaim("standard", corev1.ClaimPending),
+				newTestStorageClass("standard", false, storagev1.VolumeBindingImmediate),
+			},
+			wantErr: "persistent volume claim timed out, name: test-pvc, namespace test-namespace, phase: Pending",
+		},
+		{
+			name: "pending-wait-for-first-consumer",
+			objects: []runtime.Object{
+				newTestPersistentVolumeClaim("local", corev1.ClaimPending),
+				newTestStorageClass("local", false, storagev1.VolumeBindingWaitForFirstConsumer),
+			},
+		},
+		{
+			name: "pending-default-storage-class-wait-for-first-consumer",
+			objects: []runtime.Object{
+				newTestPersistentVolumeClaim("", corev1.ClaimPending),
+				newTestStorageClass("standard", false, storagev1.VolumeBindingImmediate),
+				newTestStorageClass("local", true, storagev1.VolumeBindingWaitForFirstConsumer),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			waiter := &pvcWaiter{
+				clientSet:           fake.NewSimpleClientset(tc.objects...),
+				pvcTimeOut:          time.Duration(1) * time.Second,
+				cacheResyncInterval: time.Duration(10) * time.Second,
+			}
+
+			err := waiter.waitUntilReady(context.Background(), newTestPersistentVolumeClaim("", ""))
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -90,6 +90,46 @@ func TestPut(t *testing.T) {
 				"resourcename":         "test-deployment",
 			},
 		},
+		{
+			name: "job resource",
+			in: &PutOptions{
+				Resource: &rpv1.OutputResource{
+					CreateResource: &rpv1.Resource{
+						ResourceType: resourcemodel.ResourceType{
+							Provider: resourcemodel.ProviderKubernetes,
+							Type:     "batch/Job",
+						},
+						Data: testJob,
+					},
+				},
+			},
+			out: map[string]string{
+				"kubernetesapiversion": "batch/v1",
+				"kuberneteskind":       "Job",
+				"kubernetesnamespace":  "test-namespace",
+				"resourcename":         "test-job",
+			},
+		},
+		{
+			name: "persistent volume claim resource",
+			in: &PutOptions{
+				Resource: &rpv1.OutputResource{
+					CreateResource: &rpv1.Resource{
+						ResourceType: resourcemodel.ResourceType{
+							Provider: resourcemodel.ProviderKubernetes,
+							Type:     "core/PersistentVolumeClaim",
+						},
+						Data: newTestPersistentVolumeClaim("", corev1.ClaimBound),
+					},
+				},
+			},
+			out: map[string]string{
+				"kubernetesapiversion": "v1",
+				"kuberneteskind":       "PersistentVolumeClaim",
+				"kubernetesnamespace":  "test-namespace",
+				"resourcename":         "test-pvc",
+			},
+		},
 	}
 
 	for _, tc := range putTests {
@@ -104,6 +144,16 @@ func TestPut(t *testing.T) {
 					deploymentTimeOut:   time.Duration(50) * time.Second,
 					cacheResyncInterval: time.Duration(1) * time.Second,
 				},
+				jobWaiter: &jobWaiter{
+					clientSet:           clientSet,
+					jobTimeOut:          time.Duration(50) * time.Second,
+					cacheResyncInterval: time.Duration(1) * time.Second,
+				},
+				pvcWaiter: &pvcWaiter{
+					clientSet:           clientSet,
+					pvcTimeOut:          time.Duration(50) * time.Second,
+					cacheResyncInterval: time.Duration(1) * time.Second,
+				},
 			}
 
 			// If the resource is a deployment, we need to add a replica set to it
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/radius-project/radius/pkg/azure/armauth"
+	"github.com/radius-project/radius/pkg/corerp/certificates"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/handlers"
 	"github.com/radius-project/radius/pkg/corerp/renderers/container"
@@ -27,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers/daprextension"
 	"github.com/radius-project/radius/pkg/corerp/renderers/gateway"
 	"github.com/radius-project/radius/pkg/corerp/renderers/httproute"
+	"github.com/radius-project/radius/pkg/corerp/renderers/job"
 	"github.com/radius-project/radius/pkg/corerp/renderers/kubernetesmetadata"
 	"github.com/radius-project/radius/pkg/corerp/renderers/manualscale"
 	"github.com/radius-project/radius/pkg/corerp/renderers/volume"
@@ -97,6 +99,16 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 				},
 			},
 		},
+		{
+			ResourceType: job.ResourceType,
+			Renderer: &job.Renderer{
+				Inner: &kubernetesmetadata.Renderer{
+					Inner: &container.Renderer{
+						RoleAssignmentMap: roleAssignmentMap,
+					},
+				},
+			},
+		},
 		{
 			ResourceType: httproute.ResourceType,
 			Renderer:     &httproute.Renderer{},
@@ -135,6 +147,14 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 			ResourceTransformer: azcontainer.TransformFederatedIdentitySA,
 			ResourceHandler:     handlers.NewKubernetesHandler(k8sClient, k8sClientSet, discoveryClient, k8sDynamicClientSet),
 		},
+		{
+			ResourceType: resourcemodel.ResourceType{
+				Type:     resources_kubernetes.ResourceTypeSecret,
+				Provider: resourcemodel.ProviderKubernetes,
+			},
+			ResourceTransformer: certificates.NewManager(k8sClient).TransformSecret,
+			ResourceHandler:     handlers.NewKubernetesHandler(k8sClient, k8sClientSet, discoveryClient, k8sDynamicClientSet),
+		},
 	}
 
 	azureOutputResourceModel := []OutputResourceModel{
@@ -63,7 +63,7 @@ const (
 // GetSupportedKinds returns a list of supported volume kinds.
 func GetSupportedKinds() []string {
 	keys := []string{}
-	keys = append(keys, datamodel.AzureKeyVaultVolume)
+	keys = append(keys, datamodel.AzureKeyVaultVolume, datamodel.PersistentVolumeClaimVolume, datamodel.ConfigMapVolume, datamodel.EmptyDirVolume)
 	return keys
 }
 
@@ -507,6 +507,23 @@ func (r Renderer) makeDeployment(
 				if err != nil {
 					return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create secretstore volume spec for volume: %s - %w", volumeName, err)
 				}
+			case datamodel.PersistentVolumeClaimVolume:
+				pvcID, ok := properties.OutputResources[rpv1.LocalIDPersistentVolumeClaim]
+				if !ok {
+					return []rpv1.OutputResource{}, nil, fmt.Errorf("persistent volume claim for volume: %s has not been deployed", volumeName)
+				}
+				volumeSpec, volumeMountSpec = makePersistentVolumeClaimVolume(volumeName, volumeProperties.Persistent, pvcID.Name())
+			case datamodel.ConfigMapVolume:
+				cmID, ok := properties.OutputResources[rpv1.LocalIDConfigMap]
+				if !ok {
+					return []rpv1.OutputResource{}, nil, fmt.Errorf("config map for volume: %s has not been deployed", volumeName)
+				}
+				volumeSpec, volumeMountSpec = makeConfigMapVolume(volumeName, volumeProperties.Persistent, cmID.Name())
+			case datamodel.EmptyDirVolume:
+				volumeSpec, volumeMountSpec, err = makeEmptyDirVolume(volumeName, volumeProperties.Persistent, vol.Properties.EmptyDir)
+				if err != nil {
+					return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create empty directory volume spec for volume: %s - %w", volumeName, err)
+				}
 			default:
 				return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Unsupported volume kind: %s for volume: %s. Supported kinds are: %v", vol.Properties.Kind, volumeName, GetSupportedKinds()))
 			}
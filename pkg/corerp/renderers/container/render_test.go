@@ -41,6 +41,7 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -1251,6 +1252,183 @@ func Test_Render_PersistentAzureKeyVaultVolumes(t *testing.T) {
 	require.Equal(t, true, volumeMounts[0].ReadOnly)
 }
 
+func Test_Render_PersistentKubernetesVolumes(t *testing.T) {
+	testVolName := "test-volume"
+	sizeLimit := resource.MustParse("1Gi")
+	tests := []struct {
+		name            string
+		permission      datamodel.VolumePermission
+		volume          datamodel.VolumeResourceProperties
+		outputResources map[string]resources.ID
+		expectedVolume  corev1.VolumeSource
+		readOnly        bool
+	}{
+		{
+			name: "persistent volume claim",
+			volume: datamodel.VolumeResourceProperties{
+				Kind: datamodel.PersistentVolumeClaimVolume,
+				PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{
+					Size: "10Gi",
+				},
+			},
+			outputResources: map[string]resources.ID{
+				rpv1.LocalIDPersistentVolumeClaim: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "PersistentVolumeClaim", "test-ns", testVolName),
+			},
+			expectedVolume: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: testVolName},
+			},
+		},
+		{
+			name:       "read-only persistent volume claim",
+			permission: datamodel.VolumePermissionRead,
+			volume: datamodel.VolumeResourceProperties{
+				Kind: datamodel.PersistentVolumeClaimVolume,
+				PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{
+					Size: "10Gi",
+				},
+			},
+			outputResources: map[string]resources.ID{
+				rpv1.LocalIDPersistentVolumeClaim: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "PersistentVolumeClaim", "test-ns", testVolName),
+			},
+			expectedVolume: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: testVolName, ReadOnly: true},
+			},
+			readOnly: true,
+		},
+		{
+			name: "config map",
+			volume: datamodel.VolumeResourceProperties{
+				Kind: datamodel.ConfigMapVolume,
+				ConfigMap: &datamodel.ConfigMapVolumeProperties{
+					Data: map[string]string{"app.conf": "key=value"},
+				},
+			},
+			outputResources: map[string]resources.ID{
+				rpv1.LocalIDConfigMap: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "ConfigMap", "test-ns", testVolName),
+			},
+			expectedVolume: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: testVolName},
+				},
+			},
+			readOnly: true,
+		},
+		{
+			name: "empty directory",
+			volume: datamodel.VolumeResourceProperties{
+				Kind: datamodel.EmptyDirVolume,
+				EmptyDir: &datamodel.EmptyDirVolumeProperties{
+					ManagedStore: datamodel.ManagedStoreMemory,
+					SizeLimit:    "1Gi",
+				},
+			},
+			expectedVolume: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory, SizeLimit: &sizeLimit},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			properties := datamodel.ContainerProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: applicationResourceID,
+				},
+				Container: datamodel.Container{
+					Image: "someimage:latest",
+					Volumes: map[string]datamodel.VolumeProperties{
+						tempVolName: {
+							Kind: datamodel.Persistent,
+							Persistent: &datamodel.PersistentVolume{
+								VolumeBase: datamodel.VolumeBase{
+									MountPath: tempVolMountPath,
+								},
+								Source:     testResourceID,
+								Permission: tc.permission,
+							},
+						},
+					},
+				},
+			}
+			resource := makeResource(t, properties)
+			resourceID, _ := resources.ParseResource(testResourceID)
+			tc.volume.Application = applicationResourceID
+			dependencies := map[string]renderers.RendererDependency{
+				testResourceID: {
+					ResourceID: resourceID,
+					Resource: &datamodel.VolumeResource{
+						BaseResource: apiv1.BaseResource{
+							TrackedResource: apiv1.TrackedResource{
+								Name: testVolName,
+							},
+						},
+						Properties: tc.volume,
+					},
+					ComputedValues:  map[string]any{},
+					OutputResources: tc.outputResources,
+				},
+			}
+
+			ctx := testcontext.New(t)
+			renderer := Renderer{}
+			output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: testEnvironmentOptions})
+			require.NoError(t, err)
+
+			deployment, _ := kubernetes.FindDeployment(output.Resources)
+			require.NotNil(t, deployment)
+
+			expectedVolumes := []corev1.Volume{{Name: tempVolName, VolumeSource: tc.expectedVolume}}
+			require.Equal(t, expectedVolumes, deployment.Spec.Template.Spec.Volumes)
+
+			expectedVolumeMounts := []corev1.VolumeMount{{Name: tempVolName, MountPath: tempVolMountPath, ReadOnly: tc.readOnly}}
+			require.Equal(t, expectedVolumeMounts, deployment.Spec.Template.Spec.Containers[0].VolumeMounts)
+		})
+	}
+}
+
+func Test_Render_PersistentKubernetesVolumes_NotDeployed(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Volumes: map[string]datamodel.VolumeProperties{
+				tempVolName: {
+					Kind: datamodel.Persistent,
+					Persistent: &datamodel.PersistentVolume{
+						VolumeBase: datamodel.VolumeBase{
+							MountPath: tempVolMountPath,
+						},
+						Source: testResourceID,
+					},
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	resourceID, _ := resources.ParseResource(testResourceID)
+	dependencies := map[string]renderers.RendererDependency{
+		testResourceID: {
+			ResourceID: resourceID,
+			Resource: &datamodel.VolumeResource{
+				Properties: datamodel.VolumeResourceProperties{
+					Kind: datamodel.PersistentVolumeClaimVolume,
+					PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{
+						Size: "10Gi",
+					},
+				},
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	_, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: testEnvironmentOptions})
+	require.Error(t, err)
+	require.Equal(t, "persistent volume claim for volume: "+tempVolName+" has not been deployed", err.Error())
+}
+
 func outputResourcesToResourceTypeMap(resources []rpv1.OutputResource) map[string][]rpv1.OutputResource {
 	results := map[string][]rpv1.OutputResource{}
 	for _, resource := range resources {
@@ -20,6 +20,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Create the volume specs for Pod.
@@ -41,3 +42,80 @@ func makeEphemeralVolume(volumeName string, volume *datamodel.EphemeralVolume) (
 
 	return volumeSpec, volumeMountSpec, nil
 }
+
+// makePersistentVolumeClaimVolume creates the volume specs for Pod which mount the given persistent volume claim.
+func makePersistentVolumeClaimVolume(volumeName string, volume *datamodel.PersistentVolume, claimName string) (corev1.Volume, corev1.VolumeMount) {
+	readOnly := volume.Permission == datamodel.VolumePermissionRead
+	volumeSpec := corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+				ReadOnly:  readOnly,
+			},
+		},
+	}
+
+	volumeMountSpec := corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: volume.MountPath,
+		ReadOnly:  readOnly,
+	}
+
+	return volumeSpec, volumeMountSpec
+}
+
+// makeConfigMapVolume creates the volume specs for Pod which expose the given config map as files. Config map
+// volumes are always mounted as read-only.
+func makeConfigMapVolume(volumeName string, volume *datamodel.PersistentVolume, configMapName string) (corev1.Volume, corev1.VolumeMount) {
+	volumeSpec := corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: configMapName,
+				},
+			},
+		},
+	}
+
+	volumeMountSpec := corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: volume.MountPath,
+		ReadOnly:  true,
+	}
+
+	return volumeSpec, volumeMountSpec
+}
+
+// makeEmptyDirVolume creates the volume specs for Pod which mount an empty directory backed by the given volume resource.
+func makeEmptyDirVolume(volumeName string, volume *datamodel.PersistentVolume, properties *datamodel.EmptyDirVolumeProperties) (corev1.Volume, corev1.VolumeMount, error) {
+	emptyDir := &corev1.EmptyDirVolumeSource{
+		Medium: corev1.StorageMediumDefault,
+	}
+	if properties != nil && properties.ManagedStore == datamodel.ManagedStoreMemory {
+		emptyDir.Medium = corev1.StorageMediumMemory
+	}
+	if properties != nil && properties.SizeLimit != "" {
+		sizeLimit, err := resource.ParseQuantity(properties.SizeLimit)
+		if err != nil {
+			return corev1.Volume{}, corev1.VolumeMount{}, err
+		}
+		emptyDir.SizeLimit = &sizeLimit
+	}
+
+	volumeSpec := corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: emptyDir,
+		},
+	}
+
+	volumeMountSpec := corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: volume.MountPath,
+		ReadOnly:  volume.Permission == datamodel.VolumePermissionRead,
+	}
+
+	return volumeSpec, volumeMountSpec, nil
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net"
+	"time"
+
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/certificates"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// MakeCertificateSecret creates the Kubernetes Secret that holds the automatically issued certificate of the Gateway.
+// The Secret only describes the certificate through annotations, the certificate itself is issued when the Secret is
+// deployed and renewed before it expires.
+func MakeCertificateSecret(options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string, hostname string) (rpv1.OutputResource, error) {
+	issuer := gateway.Properties.TLS.CertificateIssuer
+	if hostname == "" || net.ParseIP(hostname) != nil {
+		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("a DNS hostname is required to issue a certificate for the Gateway, configure the hostname of the Gateway or the public endpoint of the environment")
+	}
+
+	spec := certificates.Spec{
+		DNSNames:         []string{hostname},
+		ACMEDirectoryURL: issuer.ACMEDirectoryURL,
+		ACMEEmail:        issuer.Email,
+	}
+
+	switch issuer.Kind {
+	case datamodel.GatewayCertificateIssuerKindACME:
+		spec.Issuer = certificates.IssuerACME
+	case datamodel.GatewayCertificateIssuerKindSelfSigned:
+		spec.Issuer = certificates.IssuerSelfSigned
+	default:
+		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("unsupported certificate issuer kind %q", issuer.Kind))
+	}
+
+	if issuer.RenewBefore != "" {
+		renewBefore, err := time.ParseDuration(issuer.RenewBefore)
+		if err != nil {
+			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid certificate renewBefore duration %q", issuer.RenewBefore))
+		}
+		spec.RenewBefore = renewBefore
+	}
+
+	labels := renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName())
+	labels[certificates.LabelManagedCertificate] = "true"
+
+	annotations := renderers.GetAnnotations(options)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	for k, v := range spec.Annotations() {
+		annotations[k] = v
+	}
+
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        getCertificateSecretName(gateway.Name),
+			Namespace:   options.Environment.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Type: corev1.SecretTypeTLS,
+	}
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDGatewayCertificate, secret, secret.ObjectMeta), nil
+}
+
+// getCertificateSecretName returns the name of the Secret that holds the automatically issued certificate of the Gateway.
+func getCertificateSecretName(gatewayName string) string {
+	return kubernetes.NormalizeResourceName(gatewayName) + "-tls"
+}
+
+// withCertificateSecret adds the certificate Secret to the output of the renderer, and makes the Gateway depend on it
+// so that the certificate is issued before the Gateway references it.
+func withCertificateSecret(output renderers.RendererOutput, certificateSecret *rpv1.OutputResource) renderers.RendererOutput {
+	if certificateSecret == nil {
+		return output
+	}
+
+	for i := range output.Resources {
+		if output.Resources[i].LocalID == rpv1.LocalIDGateway {
+			output.Resources[i].CreateResource.Dependencies = append(output.Resources[i].CreateResource.Dependencies, rpv1.LocalIDGatewayCertificate)
+		}
+	}
+
+	output.Resources = append([]rpv1.OutputResource{*certificateSecret}, output.Resources...)
+	return output
+}
+
+// usesACME returns true if the certificate of the Gateway is issued by an ACME server, which validates the HTTP-01
+// challenges that the Gateway routes to the challenge Service.
+func usesACME(gateway *datamodel.Gateway) bool {
+	return gateway.Properties.TLS != nil && gateway.Properties.TLS.CertificateIssuer != nil &&
+		gateway.Properties.TLS.CertificateIssuer.Kind == datamodel.GatewayCertificateIssuerKindACME
+}
+
+// getACMEChallengeResourceName returns the name of the resources that route the HTTP-01 challenges of the Gateway.
+// The Gateways of all environments share the namespace of the challenge Service, so the name is derived from both the
+// namespace and the name of the Gateway.
+func getACMEChallengeResourceName(namespace string, gatewayName string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace + "/" + gatewayName))
+	return fmt.Sprintf("acme-challenge-%08x", h.Sum32())
+}
+
+// makeACMEChallengeInclude creates the include of the root HTTPProxy that sends the HTTP-01 challenges to the
+// HTTPProxy created by MakeACMEChallengeHTTPProxy.
+func makeACMEChallengeInclude(options renderers.RenderOptions, gateway *datamodel.Gateway) contourv1.Include {
+	return contourv1.Include{
+		Name:      getACMEChallengeResourceName(options.Environment.Namespace, gateway.Name),
+		Namespace: certificates.ChallengeServiceNamespace,
+		Conditions: []contourv1.MatchCondition{
+			{
+				Prefix: certificates.HTTP01ChallengePathPrefix,
+			},
+		},
+	}
+}
+
+// MakeACMEChallengeHTTPProxy creates the Contour HTTPProxy that routes the HTTP-01 challenges of the Gateway to the
+// challenge Service. An HTTPProxy can only route to the Services of its own namespace, so it is created in the
+// namespace of the challenge Service and included by the root HTTPProxy of the Gateway. Challenges are served over
+// plain HTTP since the ACME server validates them before the certificate is issued.
+func MakeACMEChallengeHTTPProxy(options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string) rpv1.OutputResource {
+	httpProxy := &contourv1.HTTPProxy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HTTPProxy",
+			APIVersion: contourv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        getACMEChallengeResourceName(options.Environment.Namespace, gateway.Name),
+			Namespace:   certificates.ChallengeServiceNamespace,
+			Labels:      renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: contourv1.HTTPProxySpec{
+			Routes: []contourv1.Route{
+				{
+					Services: []contourv1.Service{
+						{
+							Name: certificates.ChallengeServiceName,
+							Port: certificates.ChallengeServicePort,
+						},
+					},
+					PermitInsecure: true,
+				},
+			},
+		},
+	}
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDGatewayACMEChallenge, httpProxy, httpProxy.ObjectMeta)
+}
+
+// MakeACMEChallengeHTTPRoute creates the Gateway API HTTPRoute that routes the HTTP-01 challenges received by the plain
+// HTTP listener of the Gateway to the challenge Service, along with the ReferenceGrant that allows the HTTPRoute to
+// reference the Service of another namespace.
+func MakeACMEChallengeHTTPRoute(options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string) []rpv1.OutputResource {
+	name := getACMEChallengeResourceName(options.Environment.Namespace, gateway.Name)
+	labels := renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName())
+
+	referenceGrant := &gatewayv1beta1.ReferenceGrant{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ReferenceGrant",
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   certificates.ChallengeServiceNamespace,
+			Labels:      labels,
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{
+				{
+					Group:     gatewayv1beta1.GroupName,
+					Kind:      "HTTPRoute",
+					Namespace: gatewayv1beta1.Namespace(options.Environment.Namespace),
+				},
+			},
+			To: []gatewayv1beta1.ReferenceGrantTo{
+				{
+					Group: "",
+					Kind:  "Service",
+					Name:  to.Ptr(gatewayv1beta1.ObjectName(certificates.ChallengeServiceName)),
+				},
+			},
+		},
+	}
+
+	httpRoute := &gatewayv1beta1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HTTPRoute",
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   options.Environment.Namespace,
+			Labels:      labels,
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: gatewayv1beta1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1beta1.CommonRouteSpec{
+				ParentRefs: []gatewayv1beta1.ParentReference{
+					{
+						Name:        gatewayv1beta1.ObjectName(kubernetes.NormalizeResourceName(gateway.Name)),
+						SectionName: to.Ptr(gatewayv1beta1.SectionName(gatewayAPIHTTPListenerName)),
+					},
+				},
+			},
+			Rules: []gatewayv1beta1.HTTPRouteRule{
+				{
+					Matches: []gatewayv1beta1.HTTPRouteMatch{
+						{
+							Path: &gatewayv1beta1.HTTPPathMatch{
+								Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+								Value: to.Ptr(certificates.HTTP01ChallengePathPrefix),
+							},
+						},
+					},
+					BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+						{
+							BackendRef: gatewayv1beta1.BackendRef{
+								BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+									Name:      gatewayv1beta1.ObjectName(certificates.ChallengeServiceName),
+									Namespace: to.Ptr(gatewayv1beta1.Namespace(certificates.ChallengeServiceNamespace)),
+									Port:      to.Ptr(gatewayv1beta1.PortNumber(certificates.ChallengeServicePort)),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	grantResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDGatewayACMEChallengeGrant, referenceGrant, referenceGrant.ObjectMeta)
+	routeResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDGatewayACMEChallenge, httpRoute, httpRoute.ObjectMeta)
+	routeResource.CreateResource.Dependencies = []string{rpv1.LocalIDGateway, rpv1.LocalIDGatewayACMEChallengeGrant}
+	return []rpv1.OutputResource{grantResource, routeResource}
+}
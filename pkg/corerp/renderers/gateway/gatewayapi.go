@@ -0,0 +1,425 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// gatewayAPIHTTPListenerName is the name of the listener used for plain HTTP traffic.
+	gatewayAPIHTTPListenerName = "http"
+	// gatewayAPIHTTPSListenerName is the name of the listener used when TLS is terminated at the Gateway.
+	gatewayAPIHTTPSListenerName = "https"
+	// gatewayAPITLSListenerName is the name of the listener used for SSL passthrough.
+	gatewayAPITLSListenerName = "tls"
+)
+
+// renderGatewayAPI renders the Gateway as a Kubernetes Gateway API Gateway along with one HTTPRoute per destination,
+// or a single TLSRoute when SSL passthrough is enabled. An empty hostname means that the public endpoint of the
+// Gateway is not known until the Gateway has been programmed, in which case the URL is reported by the handler.
+func renderGatewayAPI(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string, hostname string, publicEndpoint string) (renderers.RendererOutput, error) {
+	gatewayObject, err := MakeGatewayAPIGateway(ctx, options, gateway, gateway.Name, applicationName, hostname)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	routeObjects, err := MakeGatewayAPIRoutes(ctx, options, *gateway, &gateway.Properties, kubernetes.NormalizeResourceName(gateway.Name), applicationName)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	if usesACME(gateway) {
+		routeObjects = append(routeObjects, MakeACMEChallengeHTTPRoute(options, gateway, applicationName)...)
+	}
+
+	url := rpv1.ComputedValueReference{
+		Value: publicEndpoint,
+	}
+	if hostname == "" {
+		url = rpv1.ComputedValueReference{
+			LocalID:           rpv1.LocalIDGateway,
+			PropertyReference: handlers.GatewayPublicEndpointKey,
+		}
+	}
+
+	return renderers.RendererOutput{
+		Resources: append([]rpv1.OutputResource{gatewayObject}, routeObjects...),
+		ComputedValues: map[string]rpv1.ComputedValueReference{
+			"url": url,
+		},
+	}, nil
+}
+
+// MakeGatewayAPIGateway validates the Gateway resource and its dependencies, and creates a Gateway API Gateway
+// with a single listener for the public endpoint.
+func MakeGatewayAPIGateway(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, resourceName string, applicationName string, hostname string) (rpv1.OutputResource, error) {
+	if options.Environment.Gateway.GatewayClassName == "" {
+		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("the environment must specify a gatewayClassName to use the Gateway API")
+	}
+
+	if len(gateway.Properties.Routes) < 1 {
+		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
+	}
+
+	listener := gatewayv1beta1.Listener{
+		Name:     gatewayAPIHTTPListenerName,
+		Port:     gatewayv1beta1.PortNumber(80),
+		Protocol: gatewayv1beta1.HTTPProtocolType,
+	}
+
+	// Listener hostnames must be DNS names, so IP address overrides leave the listener matching any host.
+	if hostname != "" && net.ParseIP(hostname) == nil {
+		listener.Hostname = to.Ptr(gatewayv1beta1.Hostname(hostname))
+	}
+
+	if gateway.Properties.TLS != nil {
+		if gateway.Properties.TLS.SSLPassthrough {
+			if len(gateway.Properties.Routes) > 1 {
+				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support multiple routes with sslPassthrough set to true")
+			}
+
+			route := gateway.Properties.Routes[0]
+			if route.Path != "" || route.ReplacePrefix != "" {
+				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
+			}
+
+			listener.Name = gatewayAPITLSListenerName
+			listener.Port = gatewayv1beta1.PortNumber(443)
+			listener.Protocol = gatewayv1beta1.TLSProtocolType
+			listener.TLS = &gatewayv1beta1.GatewayTLSConfig{
+				Mode: to.Ptr(gatewayv1beta1.TLSModePassthrough),
+			}
+		} else if gateway.Properties.TLS.IsTerminated() {
+			secretNamespace, secretName, err := getCertificateSecret(options, gateway)
+			if err != nil {
+				return rpv1.OutputResource{}, err
+			}
+
+			certificateRef := gatewayv1beta1.SecretObjectReference{
+				Name: gatewayv1beta1.ObjectName(secretName),
+			}
+			if secretNamespace != options.Environment.Namespace {
+				certificateRef.Namespace = to.Ptr(gatewayv1beta1.Namespace(secretNamespace))
+			}
+
+			// The Gateway API has no portable equivalent of the minimum TLS protocol version, so it is
+			// left to the implementation behind the GatewayClass.
+			listener.Name = gatewayAPIHTTPSListenerName
+			listener.Port = gatewayv1beta1.PortNumber(443)
+			listener.Protocol = gatewayv1beta1.HTTPSProtocolType
+			listener.TLS = &gatewayv1beta1.GatewayTLSConfig{
+				Mode:            to.Ptr(gatewayv1beta1.TLSModeTerminate),
+				CertificateRefs: []gatewayv1beta1.SecretObjectReference{certificateRef},
+			}
+		}
+	}
+
+	listeners := []gatewayv1beta1.Listener{listener}
+
+	// The ACME server validates the HTTP-01 challenges of the certificate over plain HTTP.
+	if usesACME(gateway) {
+		listeners = append(listeners, gatewayv1beta1.Listener{
+			Name:     gatewayAPIHTTPListenerName,
+			Port:     gatewayv1beta1.PortNumber(80),
+			Protocol: gatewayv1beta1.HTTPProtocolType,
+			Hostname: listener.Hostname,
+		})
+	}
+
+	gatewayObject := &gatewayv1beta1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Gateway",
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        kubernetes.NormalizeResourceName(resourceName),
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(options, applicationName, resourceName, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: gatewayv1beta1.GatewaySpec{
+			GatewayClassName: gatewayv1beta1.ObjectName(options.Environment.Gateway.GatewayClassName),
+			Listeners:        listeners,
+		},
+	}
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDGateway, gatewayObject, gatewayObject.ObjectMeta), nil
+}
+
+// MakeGatewayAPIRoutes creates an HTTPRoute for each destination of the gateway, or a TLSRoute when SSL passthrough
+// is enabled, and returns them as OutputResources. Routes that share a destination are rendered as rules of the
+// same HTTPRoute.
+func MakeGatewayAPIRoutes(ctx context.Context, options renderers.RenderOptions, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, applicationName string) ([]rpv1.OutputResource, error) {
+	parentRefs := []gatewayv1beta1.ParentReference{
+		{
+			Name: gatewayv1beta1.ObjectName(gatewayName),
+		},
+	}
+
+	// Only the HTTP-01 challenges of the ACME server are served by the plain HTTP listener.
+	if usesACME(&resource) {
+		parentRefs[0].SectionName = to.Ptr(gatewayv1beta1.SectionName(gatewayAPIHTTPSListenerName))
+	}
+
+	if gateway.TLS != nil && gateway.TLS.SSLPassthrough {
+		route := gateway.Routes[0]
+		routeName, err := getRouteName(&route)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
+		}
+
+		port := renderers.DefaultSecurePort
+		routePort, ok := options.Dependencies[route.Destination].ComputedValues["port"].(float64)
+		if ok {
+			port = int32(routePort)
+		}
+
+		routeResourceName := kubernetes.NormalizeResourceName(routeName)
+		tlsRoute := &gatewayv1alpha2.TLSRoute{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "TLSRoute",
+				APIVersion: gatewayv1alpha2.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        routeResourceName,
+				Namespace:   options.Environment.Namespace,
+				Labels:      renderers.GetLabels(options, applicationName, routeName, resource.ResourceTypeName()),
+				Annotations: renderers.GetAnnotations(options),
+			},
+			Spec: gatewayv1alpha2.TLSRouteSpec{
+				CommonRouteSpec: gatewayv1alpha2.CommonRouteSpec{
+					ParentRefs: parentRefs,
+				},
+				Rules: []gatewayv1alpha2.TLSRouteRule{
+					{
+						BackendRefs: []gatewayv1alpha2.BackendRef{
+							{
+								BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+									Name: gatewayv1beta1.ObjectName(routeResourceName),
+									Port: to.Ptr(gatewayv1beta1.PortNumber(port)),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		localID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeName)
+		outputResource := rpv1.NewKubernetesOutputResource(localID, tlsRoute, tlsRoute.ObjectMeta)
+		outputResource.CreateResource.Dependencies = []string{rpv1.LocalIDGateway}
+		return []rpv1.OutputResource{outputResource}, nil
+	}
+
+	localIDs := []string{}
+	objects := make(map[string]*gatewayv1beta1.HTTPRoute)
+	for _, route := range gateway.Routes {
+		routeName, err := getRouteName(&route)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
+		}
+
+		rule, err := makeGatewayAPIRouteRule(options, &route)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
+		}
+
+		// Create unique localID for dependency graph
+		localID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeName)
+		routeResourceName := kubernetes.NormalizeResourceName(routeName)
+
+		// If this route already exists, add the rule to it
+		if object, exists := objects[localID]; exists {
+			object.Spec.Rules = append(object.Spec.Rules, rule)
+			continue
+		}
+
+		objects[localID] = &gatewayv1beta1.HTTPRoute{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "HTTPRoute",
+				APIVersion: gatewayv1beta1.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        routeResourceName,
+				Namespace:   options.Environment.Namespace,
+				Labels:      renderers.GetLabels(options, applicationName, routeName, resource.ResourceTypeName()),
+				Annotations: renderers.GetAnnotations(options),
+			},
+			Spec: gatewayv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1beta1.CommonRouteSpec{
+					ParentRefs: parentRefs,
+				},
+				Rules: []gatewayv1beta1.HTTPRouteRule{rule},
+			},
+		}
+		localIDs = append(localIDs, localID)
+	}
+
+	var outputResources []rpv1.OutputResource
+	for _, localID := range localIDs {
+		object := objects[localID]
+		outputResource := rpv1.NewKubernetesOutputResource(localID, object, object.ObjectMeta)
+
+		// Routes attach to the Gateway through their parentRefs, so the Gateway is created first
+		outputResource.CreateResource.Dependencies = []string{rpv1.LocalIDGateway}
+		outputResources = append(outputResources, outputResource)
+	}
+
+	return outputResources, nil
+}
+
+// makeGatewayAPIRouteRule creates the HTTPRoute rule for a Gateway route. The Gateway API ORs the matches of a rule, so a route
+// that matches several HTTP methods is rendered as one match per method. Routing rules that the Gateway API cannot
+// express are reported as errors rather than silently dropped.
+func makeGatewayAPIRouteRule(options renderers.RenderOptions, route *datamodel.GatewayRoute) (gatewayv1beta1.HTTPRouteRule, error) {
+	if route.TimeoutPolicy != nil {
+		return gatewayv1beta1.HTTPRouteRule{}, v1.NewClientErrInvalidRequest("timeoutPolicy is not supported when the environment uses the Gateway API")
+	}
+
+	if route.RetryPolicy != nil {
+		return gatewayv1beta1.HTTPRouteRule{}, v1.NewClientErrInvalidRequest("retryPolicy is not supported when the environment uses the Gateway API")
+	}
+
+	path := route.Path
+	if path == "" {
+		path = "/"
+	}
+
+	match := gatewayv1beta1.HTTPRouteMatch{
+		Path: &gatewayv1beta1.HTTPPathMatch{
+			Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+			Value: to.Ptr(path),
+		},
+	}
+
+	for _, header := range route.Headers {
+		if header.Exact == "" {
+			return gatewayv1beta1.HTTPRouteRule{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("header %s: only exact matches are supported when the environment uses the Gateway API", header.Name))
+		}
+
+		match.Headers = append(match.Headers, gatewayv1beta1.HTTPHeaderMatch{
+			Type:  to.Ptr(gatewayv1beta1.HeaderMatchExact),
+			Name:  gatewayv1beta1.HTTPHeaderName(header.Name),
+			Value: header.Exact,
+		})
+	}
+
+	for _, parameter := range route.QueryParameters {
+		if parameter.Exact == "" {
+			return gatewayv1beta1.HTTPRouteRule{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("query parameter %s: only exact matches are supported when the environment uses the Gateway API", parameter.Name))
+		}
+
+		match.QueryParams = append(match.QueryParams, gatewayv1beta1.HTTPQueryParamMatch{
+			Type:  to.Ptr(gatewayv1beta1.QueryParamMatchExact),
+			Name:  gatewayv1beta1.HTTPHeaderName(parameter.Name),
+			Value: parameter.Exact,
+		})
+	}
+
+	rule := gatewayv1beta1.HTTPRouteRule{}
+	if len(route.Methods) == 0 {
+		rule.Matches = []gatewayv1beta1.HTTPRouteMatch{match}
+	}
+	for _, method := range route.Methods {
+		methodMatch := match
+		methodMatch.Method = to.Ptr(gatewayv1beta1.HTTPMethod(method))
+		rule.Matches = append(rule.Matches, methodMatch)
+	}
+
+	for _, destination := range route.GetDestinations() {
+		port, err := getDestinationPort(options, destination.Destination)
+		if err != nil {
+			return gatewayv1beta1.HTTPRouteRule{}, err
+		}
+
+		name, err := getDestinationName(destination.Destination)
+		if err != nil {
+			return gatewayv1beta1.HTTPRouteRule{}, err
+		}
+
+		backendRef := gatewayv1beta1.HTTPBackendRef{
+			BackendRef: gatewayv1beta1.BackendRef{
+				BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+					Name: gatewayv1beta1.ObjectName(kubernetes.NormalizeResourceName(name)),
+					Port: to.Ptr(gatewayv1beta1.PortNumber(port)),
+				},
+			},
+		}
+		if len(route.Destinations) > 0 {
+			backendRef.Weight = to.Ptr(destination.Weight)
+		}
+		rule.BackendRefs = append(rule.BackendRefs, backendRef)
+	}
+
+	if route.ReplacePrefix != "" {
+		rule.Filters = append(rule.Filters, gatewayv1beta1.HTTPRouteFilter{
+			Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+			URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+				Path: &gatewayv1beta1.HTTPPathModifier{
+					Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+					ReplacePrefixMatch: to.Ptr(route.ReplacePrefix),
+				},
+			},
+		})
+	}
+
+	if route.RequestHeaders != nil {
+		rule.Filters = append(rule.Filters, gatewayv1beta1.HTTPRouteFilter{
+			Type:                  gatewayv1beta1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: makeHTTPHeaderFilter(route.RequestHeaders),
+		})
+	}
+
+	if route.ResponseHeaders != nil {
+		rule.Filters = append(rule.Filters, gatewayv1beta1.HTTPRouteFilter{
+			Type:                   gatewayv1beta1.HTTPRouteFilterResponseHeaderModifier,
+			ResponseHeaderModifier: makeHTTPHeaderFilter(route.ResponseHeaders),
+		})
+	}
+
+	return rule, nil
+}
+
+// makeHTTPHeaderFilter converts a header rewriting policy to a Gateway API header filter.
+func makeHTTPHeaderFilter(policy *datamodel.GatewayRouteHeaderPolicy) *gatewayv1beta1.HTTPHeaderFilter {
+	filter := &gatewayv1beta1.HTTPHeaderFilter{
+		Remove: policy.Remove,
+	}
+	for _, name := range sortedHeaderNames(policy) {
+		filter.Set = append(filter.Set, gatewayv1beta1.HTTPHeader{
+			Name:  gatewayv1beta1.HTTPHeaderName(name),
+			Value: policy.Set[name],
+		})
+	}
+
+	return filter
+}
@@ -0,0 +1,613 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/certificates"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/stretchr/testify/require"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const testGatewayClassName = "test-gateway-class"
+
+func Test_Render_GatewayAPI_Routes(t *testing.T) {
+	r := &Renderer{}
+
+	routeAName := "routeaname"
+	routeBName := "routebname"
+	routes := []datamodel.GatewayRoute{
+		{
+			Destination: makeRouteResourceID(routeAName),
+			Path:        "/routea",
+		},
+		{
+			Destination:   makeRouteResourceID(routeBName),
+			Path:          "/routeb",
+			ReplacePrefix: "/rewrite",
+		},
+		{
+			Destination: makeRouteResourceID(routeBName),
+			Path:        "/routeb2",
+		},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: routes,
+	}
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+	require.Empty(t, output.SecretValues)
+	require.Equal(t, "http://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	expectedListener := gatewayv1beta1.Listener{
+		Name:     gatewayAPIHTTPListenerName,
+		Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+		Port:     80,
+		Protocol: gatewayv1beta1.HTTPProtocolType,
+	}
+	validateGatewayAPIGateway(t, output.Resources, expectedListener)
+
+	routeA := validateGatewayAPIHTTPRoute(t, output.Resources, routeAName)
+	require.Equal(t, []gatewayv1beta1.HTTPRouteRule{makeHTTPRouteRule(routeAName, "/routea", "")}, routeA.Spec.Rules)
+
+	routeB := validateGatewayAPIHTTPRoute(t, output.Resources, routeBName)
+	expectedRules := []gatewayv1beta1.HTTPRouteRule{
+		makeHTTPRouteRule(routeBName, "/routeb", "/rewrite"),
+		makeHTTPRouteRule(routeBName, "/routeb2", ""),
+	}
+	require.Equal(t, expectedRules, routeB.Spec.Rules)
+}
+
+func Test_Render_GatewayAPI_RoutingRules(t *testing.T) {
+	r := &Renderer{}
+
+	stableName := "stable"
+	canaryName := "canary"
+	routes := []datamodel.GatewayRoute{
+		{
+			Path: "/api",
+			Destinations: []datamodel.GatewayRouteDestination{
+				{Destination: makeRouteResourceID(stableName), Weight: 90},
+				{Destination: makeRouteResourceID(canaryName), Weight: 10},
+			},
+			Headers: []datamodel.GatewayRouteHeaderMatch{
+				{Name: "x-canary", Exact: "true"},
+			},
+			QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{
+				{Name: "version", Exact: "v2"},
+			},
+			Methods: []string{"GET", "POST"},
+			RequestHeaders: &datamodel.GatewayRouteHeaderPolicy{
+				Set: map[string]string{"x-forwarded-by": "radius"},
+			},
+			ResponseHeaders: &datamodel.GatewayRouteHeaderPolicy{
+				Remove: []string{"server"},
+			},
+		},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: routes,
+	}
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	expectedMatch := func(method gatewayv1beta1.HTTPMethod) gatewayv1beta1.HTTPRouteMatch {
+		return gatewayv1beta1.HTTPRouteMatch{
+			Path: &gatewayv1beta1.HTTPPathMatch{
+				Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+				Value: to.Ptr("/api"),
+			},
+			Headers: []gatewayv1beta1.HTTPHeaderMatch{
+				{Type: to.Ptr(gatewayv1beta1.HeaderMatchExact), Name: "x-canary", Value: "true"},
+			},
+			QueryParams: []gatewayv1beta1.HTTPQueryParamMatch{
+				{Type: to.Ptr(gatewayv1beta1.QueryParamMatchExact), Name: "version", Value: "v2"},
+			},
+			Method: to.Ptr(method),
+		}
+	}
+	expectedBackendRef := func(name string, weight int32) gatewayv1beta1.HTTPBackendRef {
+		return gatewayv1beta1.HTTPBackendRef{
+			BackendRef: gatewayv1beta1.BackendRef{
+				BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+					Name: gatewayv1beta1.ObjectName(name),
+					Port: to.Ptr(gatewayv1beta1.PortNumber(80)),
+				},
+				Weight: to.Ptr(weight),
+			},
+		}
+	}
+	expectedRule := gatewayv1beta1.HTTPRouteRule{
+		Matches: []gatewayv1beta1.HTTPRouteMatch{
+			expectedMatch(gatewayv1beta1.HTTPMethodGet),
+			expectedMatch(gatewayv1beta1.HTTPMethodPost),
+		},
+		Filters: []gatewayv1beta1.HTTPRouteFilter{
+			{
+				Type: gatewayv1beta1.HTTPRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: &gatewayv1beta1.HTTPHeaderFilter{
+					Set: []gatewayv1beta1.HTTPHeader{{Name: "x-forwarded-by", Value: "radius"}},
+				},
+			},
+			{
+				Type: gatewayv1beta1.HTTPRouteFilterResponseHeaderModifier,
+				ResponseHeaderModifier: &gatewayv1beta1.HTTPHeaderFilter{
+					Remove: []string{"server"},
+				},
+			},
+		},
+		BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+			expectedBackendRef(stableName, 90),
+			expectedBackendRef(canaryName, 10),
+		},
+	}
+
+	route := validateGatewayAPIHTTPRoute(t, output.Resources, stableName)
+	require.Equal(t, []gatewayv1beta1.HTTPRouteRule{expectedRule}, route.Spec.Rules)
+}
+
+func Test_Render_GatewayAPI_Fails_WithUnsupportedRoutingRules(t *testing.T) {
+	tests := []struct {
+		desc     string
+		route    datamodel.GatewayRoute
+		expected string
+	}{
+		{
+			desc: "header contains match",
+			route: datamodel.GatewayRoute{
+				Destination: makeRouteResourceID("routename"),
+				Headers:     []datamodel.GatewayRouteHeaderMatch{{Name: "user-agent", Contains: "Mobile"}},
+			},
+			expected: "header user-agent: only exact matches are supported when the environment uses the Gateway API",
+		},
+		{
+			desc: "retry policy",
+			route: datamodel.GatewayRoute{
+				Destination: makeRouteResourceID("routename"),
+				RetryPolicy: &datamodel.GatewayRouteRetryPolicy{Count: 3},
+			},
+			expected: "retryPolicy is not supported when the environment uses the Gateway API",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			r := &Renderer{}
+			properties := datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+				},
+				Routes: []datamodel.GatewayRoute{tc.route},
+			}
+			resource := makeResource(t, properties)
+			environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+			_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+			require.Error(t, err)
+			require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+			require.Equal(t, tc.expected, err.(*v1.ErrClientRP).Message)
+		})
+	}
+}
+
+func Test_Render_GatewayAPI_TLSTermination(t *testing.T) {
+	r := &Renderer{}
+
+	secretName := "myapp-tls-secret"
+	secretNamespace := "secret-namespace"
+	secretStoreResourceId := makeSecretStoreResourceID(secretName)
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			MinimumProtocolVersion: "1.2",
+			CertificateFrom:        secretStoreResourceId,
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+	dependencies := map[string]renderers.RendererDependency{
+		(makeResourceID(t, secretStoreResourceId).String()): {
+			ResourceID: makeResourceID(t, secretStoreResourceId),
+			Resource: &datamodel.SecretStore{
+				Properties: &datamodel.SecretStoreProperties{
+					Type: "certificate",
+					Data: map[string]*datamodel.SecretStoreDataValue{
+						"tls.crt": {
+							Value: to.Ptr("test-crt"),
+						},
+						"tls.key": {
+							Value: to.Ptr("test-crt"),
+						},
+					},
+				},
+			},
+			OutputResources: map[string]resources.ID{
+				"Secret": resources_kubernetes.IDFromParts(
+					resources_kubernetes.PlaneNameTODO,
+					"",
+					"Secret",
+					secretNamespace,
+					secretName),
+			},
+		},
+	}
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	expectedListener := gatewayv1beta1.Listener{
+		Name:     gatewayAPIHTTPSListenerName,
+		Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+		Port:     443,
+		Protocol: gatewayv1beta1.HTTPSProtocolType,
+		TLS: &gatewayv1beta1.GatewayTLSConfig{
+			Mode: to.Ptr(gatewayv1beta1.TLSModeTerminate),
+			CertificateRefs: []gatewayv1beta1.SecretObjectReference{
+				{
+					Name:      gatewayv1beta1.ObjectName(secretName),
+					Namespace: to.Ptr(gatewayv1beta1.Namespace(secretNamespace)),
+				},
+			},
+		},
+	}
+	validateGatewayAPIGateway(t, output.Resources, expectedListener)
+}
+
+func Test_Render_GatewayAPI_CertificateIssuer(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+				Kind: datamodel.GatewayCertificateIssuerKindSelfSigned,
+			},
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+	require.Equal(t, rpv1.LocalIDGatewayCertificate, output.Resources[0].LocalID)
+	require.Equal(t, resources_kubernetes.ResourceTypeSecret, output.Resources[0].GetResourceType().Type)
+	require.Equal(t, []string{rpv1.LocalIDGatewayCertificate}, output.Resources[1].CreateResource.Dependencies)
+
+	expectedListener := gatewayv1beta1.Listener{
+		Name:     gatewayAPIHTTPSListenerName,
+		Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+		Port:     443,
+		Protocol: gatewayv1beta1.HTTPSProtocolType,
+		TLS: &gatewayv1beta1.GatewayTLSConfig{
+			Mode: to.Ptr(gatewayv1beta1.TLSModeTerminate),
+			CertificateRefs: []gatewayv1beta1.SecretObjectReference{
+				{
+					Name: gatewayv1beta1.ObjectName(resourceName + "-tls"),
+				},
+			},
+		},
+	}
+	validateGatewayAPIGateway(t, output.Resources, expectedListener)
+}
+
+func Test_Render_GatewayAPI_CertificateIssuer_ACME(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+				Kind:             datamodel.GatewayCertificateIssuerKindACME,
+				ACMEDirectoryURL: "https://acme.example.com/directory",
+			},
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 5)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	validateGatewayAPIGateway(t, output.Resources, gatewayv1beta1.Listener{
+		Name:     gatewayAPIHTTPSListenerName,
+		Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+		Port:     443,
+		Protocol: gatewayv1beta1.HTTPSProtocolType,
+		TLS: &gatewayv1beta1.GatewayTLSConfig{
+			Mode: to.Ptr(gatewayv1beta1.TLSModeTerminate),
+			CertificateRefs: []gatewayv1beta1.SecretObjectReference{
+				{
+					Name: gatewayv1beta1.ObjectName(resourceName + "-tls"),
+				},
+			},
+		},
+	}, gatewayv1beta1.Listener{
+		Name:     gatewayAPIHTTPListenerName,
+		Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+		Port:     80,
+		Protocol: gatewayv1beta1.HTTPProtocolType,
+	})
+
+	// The routes of the application are only served over HTTPS.
+	for _, r := range output.Resources {
+		if route, ok := r.CreateResource.Data.(*gatewayv1beta1.HTTPRoute); ok && r.LocalID != rpv1.LocalIDGatewayACMEChallenge {
+			require.Equal(t, to.Ptr(gatewayv1beta1.SectionName(gatewayAPIHTTPSListenerName)), route.Spec.ParentRefs[0].SectionName)
+		}
+	}
+
+	// The HTTP-01 challenges are routed to the challenge Service over plain HTTP.
+	name := getACMEChallengeResourceName(environmentOptions.Namespace, resourceName)
+	var challengeRoute *gatewayv1beta1.HTTPRoute
+	var referenceGrant *gatewayv1beta1.ReferenceGrant
+	for _, r := range output.Resources {
+		switch r.LocalID {
+		case rpv1.LocalIDGatewayACMEChallenge:
+			challengeRoute = r.CreateResource.Data.(*gatewayv1beta1.HTTPRoute)
+			require.Equal(t, []string{rpv1.LocalIDGateway, rpv1.LocalIDGatewayACMEChallengeGrant}, r.CreateResource.Dependencies)
+		case rpv1.LocalIDGatewayACMEChallengeGrant:
+			referenceGrant = r.CreateResource.Data.(*gatewayv1beta1.ReferenceGrant)
+		}
+	}
+
+	require.NotNil(t, challengeRoute)
+	require.Equal(t, name, challengeRoute.Name)
+	require.Equal(t, environmentOptions.Namespace, challengeRoute.Namespace)
+	require.Equal(t, to.Ptr(gatewayv1beta1.SectionName(gatewayAPIHTTPListenerName)), challengeRoute.Spec.ParentRefs[0].SectionName)
+	require.Equal(t, certificates.HTTP01ChallengePathPrefix, *challengeRoute.Spec.Rules[0].Matches[0].Path.Value)
+	require.Equal(t, gatewayv1beta1.BackendObjectReference{
+		Name:      certificates.ChallengeServiceName,
+		Namespace: to.Ptr(gatewayv1beta1.Namespace(certificates.ChallengeServiceNamespace)),
+		Port:      to.Ptr(gatewayv1beta1.PortNumber(certificates.ChallengeServicePort)),
+	}, challengeRoute.Spec.Rules[0].BackendRefs[0].BackendObjectReference)
+
+	require.NotNil(t, referenceGrant)
+	require.Equal(t, name, referenceGrant.Name)
+	require.Equal(t, certificates.ChallengeServiceNamespace, referenceGrant.Namespace)
+	require.Equal(t, gatewayv1beta1.Namespace(environmentOptions.Namespace), referenceGrant.Spec.From[0].Namespace)
+	require.Equal(t, to.Ptr(gatewayv1beta1.ObjectName(certificates.ChallengeServiceName)), referenceGrant.Spec.To[0].Name)
+}
+
+func Test_Render_GatewayAPI_SSLPassthrough(t *testing.T) {
+	r := &Renderer{}
+
+	routeName := "routename"
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: makeRouteResourceID(routeName),
+			},
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			SSLPassthrough: true,
+		},
+	}
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	expectedListener := gatewayv1beta1.Listener{
+		Name:     gatewayAPITLSListenerName,
+		Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+		Port:     443,
+		Protocol: gatewayv1beta1.TLSProtocolType,
+		TLS: &gatewayv1beta1.GatewayTLSConfig{
+			Mode: to.Ptr(gatewayv1beta1.TLSModePassthrough),
+		},
+	}
+	validateGatewayAPIGateway(t, output.Resources, expectedListener)
+
+	localID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeName)
+	var tlsRoute *gatewayv1alpha2.TLSRoute
+	for _, r := range output.Resources {
+		if r.LocalID == localID {
+			tlsRoute = r.CreateResource.Data.(*gatewayv1alpha2.TLSRoute)
+			require.Equal(t, []string{rpv1.LocalIDGateway}, r.CreateResource.Dependencies)
+		}
+	}
+	require.NotNil(t, tlsRoute)
+	require.Equal(t, []gatewayv1beta1.ParentReference{{Name: gatewayv1beta1.ObjectName(resourceName)}}, tlsRoute.Spec.ParentRefs)
+	require.Len(t, tlsRoute.Spec.Rules, 1)
+	require.Equal(t, gatewayv1beta1.ObjectName(routeName), tlsRoute.Spec.Rules[0].BackendRefs[0].Name)
+	require.Equal(t, gatewayv1beta1.PortNumber(443), *tlsRoute.Spec.Rules[0].BackendRefs[0].Port)
+}
+
+func Test_Render_GatewayAPI_NoPublicEndpoint(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", "")
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	// The URL is reported by the handler once the gateway has been assigned an address
+	expectedURL := rpv1.ComputedValueReference{
+		LocalID:           rpv1.LocalIDGateway,
+		PropertyReference: handlers.GatewayPublicEndpointKey,
+	}
+	require.Equal(t, expectedURL, output.ComputedValues["url"])
+
+	expectedListener := gatewayv1beta1.Listener{
+		Name:     gatewayAPIHTTPListenerName,
+		Port:     80,
+		Protocol: gatewayv1beta1.HTTPProtocolType,
+	}
+	validateGatewayAPIGateway(t, output.Resources, expectedListener)
+}
+
+func Test_Render_GatewayAPI_Fails_WithoutGatewayClassName(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+	environmentOptions.Gateway.GatewayClassName = ""
+
+	_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.Error(t, err)
+	require.Equal(t, err.(*v1.ErrClientRP).Code, v1.CodeInvalid)
+	require.Equal(t, err.(*v1.ErrClientRP).Message, "the environment must specify a gatewayClassName to use the Gateway API")
+}
+
+func getGatewayAPIEnvironmentOptions(hostname, externalIP string) renderers.EnvironmentOptions {
+	environmentOptions := getEnvironmentOptions(hostname, externalIP, "", false, false)
+	environmentOptions.Gateway.Kind = datamodel.EnvironmentGatewayKindGatewayAPI
+	environmentOptions.Gateway.GatewayClassName = testGatewayClassName
+
+	return environmentOptions
+}
+
+func makeHTTPRouteRule(routeName string, path string, replacePrefix string) gatewayv1beta1.HTTPRouteRule {
+	rule := gatewayv1beta1.HTTPRouteRule{
+		Matches: []gatewayv1beta1.HTTPRouteMatch{
+			{
+				Path: &gatewayv1beta1.HTTPPathMatch{
+					Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+					Value: to.Ptr(path),
+				},
+			},
+		},
+		BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+			{
+				BackendRef: gatewayv1beta1.BackendRef{
+					BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+						Name: gatewayv1beta1.ObjectName(kubernetes.NormalizeResourceName(routeName)),
+						Port: to.Ptr(gatewayv1beta1.PortNumber(80)),
+					},
+				},
+			},
+		},
+	}
+
+	if replacePrefix != "" {
+		rule.Filters = []gatewayv1beta1.HTTPRouteFilter{
+			{
+				Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+				URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+					Path: &gatewayv1beta1.HTTPPathModifier{
+						Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+						ReplacePrefixMatch: to.Ptr(replacePrefix),
+					},
+				},
+			},
+		}
+	}
+
+	return rule
+}
+
+func validateGatewayAPIGateway(t *testing.T, outputResources []rpv1.OutputResource, expectedListeners ...gatewayv1beta1.Listener) {
+	for _, r := range outputResources {
+		if r.LocalID != rpv1.LocalIDGateway {
+			continue
+		}
+
+		gateway, ok := r.CreateResource.Data.(*gatewayv1beta1.Gateway)
+		require.True(t, ok)
+		require.Equal(t, kubernetes.NormalizeResourceName(resourceName), gateway.Name)
+		require.Equal(t, applicationName, gateway.Namespace)
+		require.Equal(t, gatewayv1beta1.ObjectName(testGatewayClassName), gateway.Spec.GatewayClassName)
+		require.Equal(t, expectedListeners, gateway.Spec.Listeners)
+		require.Equal(t, resources_kubernetes.ResourceTypeGatewayAPIGateway, r.GetResourceType().Type)
+		return
+	}
+
+	require.Fail(t, "gateway output resource not found")
+}
+
+func validateGatewayAPIHTTPRoute(t *testing.T, outputResources []rpv1.OutputResource, routeName string) *gatewayv1beta1.HTTPRoute {
+	localID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeName)
+	for _, r := range outputResources {
+		if r.LocalID != localID {
+			continue
+		}
+
+		route, ok := r.CreateResource.Data.(*gatewayv1beta1.HTTPRoute)
+		require.True(t, ok)
+		require.Equal(t, kubernetes.NormalizeResourceName(routeName), route.Name)
+		require.Equal(t, applicationName, route.Namespace)
+		require.Equal(t, []gatewayv1beta1.ParentReference{{Name: gatewayv1beta1.ObjectName(resourceName)}}, route.Spec.ParentRefs)
+		require.Equal(t, []string{rpv1.LocalIDGateway}, r.CreateResource.Dependencies)
+		return route
+	}
+
+	require.Fail(t, "http route output resource not found")
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -37,6 +38,9 @@ import (
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 )
 
+// methodHeader is the pseudo-header that Contour matches the HTTP method of a request on.
+const methodHeader = ":method"
+
 type Renderer struct {
 }
 
@@ -51,17 +55,19 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 
 	// Get all httpRoutes that are used by this gateway
 	for _, route := range gtwyProperties.Routes {
-		// Skip if destination is a URL. DNS-SD will resolve the route.
-		if isURL(route.Destination) {
-			continue
-		}
+		for _, destination := range route.GetDestinations() {
+			// Skip if destination is a URL. DNS-SD will resolve the route.
+			if isURL(destination.Destination) {
+				continue
+			}
 
-		resourceID, err := resources.ParseResource(route.Destination)
-		if err != nil {
-			return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
-		}
+			resourceID, err := resources.ParseResource(destination.Destination)
+			if err != nil {
+				return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
+			}
 
-		radiusResourceIDs = append(radiusResourceIDs, resourceID)
+			radiusResourceIDs = append(radiusResourceIDs, resourceID)
+		}
 	}
 
 	// Get secretStore resource ID from certificateFrom property
@@ -78,7 +84,8 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 }
 
 // Render creates a gateway object and http route objects based on the given parameters, and returns them along
-// with a computed value for the gateway's public endpoint.
+// with a computed value for the gateway's public endpoint. Contour HTTPProxy objects are rendered unless the
+// environment uses the Kubernetes Gateway API.
 func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
 	outputResources := []rpv1.OutputResource{}
 	gateway, ok := dm.(*datamodel.Gateway)
@@ -94,15 +101,44 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	hostname, err := getHostname(*gateway, &gateway.Properties, applicationName, options.Environment.Gateway)
 
 	var publicEndpoint string
-	if errors.Is(err, &ErrNoPublicEndpoint{}) {
+	noPublicEndpoint := errors.Is(err, &ErrNoPublicEndpoint{})
+	if noPublicEndpoint {
 		publicEndpoint = "unknown"
 	} else if err != nil {
 		return renderers.RendererOutput{}, fmt.Errorf("getting hostname failed with error: %s", err)
 	} else {
-		isHttps := gateway.Properties.TLS != nil && (gateway.Properties.TLS.SSLPassthrough || gateway.Properties.TLS.CertificateFrom != "")
+		isHttps := gateway.Properties.TLS != nil && (gateway.Properties.TLS.SSLPassthrough || gateway.Properties.TLS.IsTerminated())
 		publicEndpoint = getPublicEndpoint(hostname, options.Environment.Gateway.Port, isHttps)
 	}
 
+	var certificateSecret *rpv1.OutputResource
+	if gateway.Properties.TLS != nil && gateway.Properties.TLS.CertificateIssuer != nil {
+		certificateHostname := hostname
+		if noPublicEndpoint {
+			certificateHostname = ""
+		}
+
+		secret, err := MakeCertificateSecret(options, gateway, applicationName, certificateHostname)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+		certificateSecret = &secret
+	}
+
+	if options.Environment.Gateway.Kind == datamodel.EnvironmentGatewayKindGatewayAPI {
+		// Without a known public endpoint the Gateway listens on any hostname and reports its own address.
+		if noPublicEndpoint {
+			hostname = ""
+		}
+
+		output, err := renderGatewayAPI(ctx, options, gateway, applicationName, hostname, publicEndpoint)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+
+		return withCertificateSecret(output, certificateSecret), nil
+	}
+
 	gatewayObject, err := MakeRootHTTPProxy(ctx, options, gateway, gateway.Name, applicationName, hostname)
 	if err != nil {
 		return renderers.RendererOutput{}, err
@@ -122,17 +158,20 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	}
 	outputResources = append(outputResources, httpRouteObjects...)
 
-	return renderers.RendererOutput{
+	if usesACME(gateway) {
+		outputResources = append(outputResources, MakeACMEChallengeHTTPProxy(options, gateway, applicationName))
+	}
+
+	return withCertificateSecret(renderers.RendererOutput{
 		Resources:      outputResources,
 		ComputedValues: computedValues,
-	}, nil
+	}, certificateSecret), nil
 }
 
 // MakeRootHTTPProxy validates the Gateway resource and its dependencies, and creates a Contour HTTPProxy resource
 // to act as the Gateway.
 func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, resourceName string, applicationName string, hostname string) (rpv1.OutputResource, error) {
 	includes := []contourv1.Include{}
-	dependencies := options.Dependencies
 
 	if len(gateway.Properties.Routes) < 1 {
 		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
@@ -145,50 +184,10 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 	if gateway.Properties.TLS != nil {
 		sslPassthrough = gateway.Properties.TLS.SSLPassthrough
 
-		if gateway.Properties.TLS.CertificateFrom != "" {
-			secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
-			secretStoreResource, ok := dependencies[secretStoreResourceId]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			referencedResource := dependencies[secretStoreResourceId].Resource
-			if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
-			}
-
-			// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
-			secretStore, ok := referencedResource.(*datamodel.SecretStore)
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
-			}
-
-			if secretStore.Properties.Type != datamodel.SecretTypeCert {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with type certificate")
-			}
-
-			if secretStore.Properties.Data["tls.crt"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.crt")
-			}
-
-			if secretStore.Properties.Data["tls.key"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.key")
-			}
-
-			// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
-			if secretStoreResource.OutputResources == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			secretName := secretResourceID.Name()
-			secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
-			if secretNamespace == "" {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+		if gateway.Properties.TLS.IsTerminated() {
+			secretNamespace, secretName, err := getCertificateSecret(options, gateway)
+			if err != nil {
+				return rpv1.OutputResource{}, err
 			}
 
 			contourTLSConfig = &contourv1.TLS{
@@ -204,11 +203,12 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 	}
 
 	var route datamodel.GatewayRoute //route will hold the one sslPassthrough route, if sslPassthrough is true
-	for _, route = range gateway.Properties.Routes {
+	for i := range gateway.Properties.Routes {
+		route = gateway.Properties.Routes[i]
 		if sslPassthrough && (route.Path != "" || route.ReplacePrefix != "") {
 			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
 		}
-		routeName, err := getRouteName(&route)
+		routeName, err := getRouteProxyName(resourceName, i, &route)
 		if err != nil {
 			return rpv1.OutputResource{}, err
 		}
@@ -228,6 +228,11 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		})
 	}
 
+	// The ACME server validates the HTTP-01 challenges of the certificate through the Gateway.
+	if usesACME(gateway) {
+		includes = append(includes, makeACMEChallengeInclude(options, gateway))
+	}
+
 	virtualHostname := hostname
 	if hostname == "" {
 		// If the given hostname is empty, use the application name
@@ -292,32 +297,75 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDGateway, rootHTTPProxy, rootHTTPProxy.ObjectMeta), nil
 }
 
-// MakeRoutesHTTPProxies creates HTTPProxy objects for each route in the gateway and returns them as OutputResources. It returns
-// an error if it fails to get the route name.
-func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, gatewayOutPutResource rpv1.OutputResource, applicationName string) ([]rpv1.OutputResource, error) {
+// getCertificateSecret validates the secretStore referenced by the Gateway's certificateFrom property and returns
+// the namespace and name of the Kubernetes secret that holds the certificate. Automatically issued certificates are
+// held by the Secret rendered alongside the Gateway.
+func getCertificateSecret(options renderers.RenderOptions, gateway *datamodel.Gateway) (string, string, error) {
+	if gateway.Properties.TLS.CertificateIssuer != nil {
+		return options.Environment.Namespace, getCertificateSecretName(gateway.Name), nil
+	}
+
 	dependencies := options.Dependencies
-	objects := make(map[string]*contourv1.HTTPProxy)
+	secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
+	secretStoreResource, ok := dependencies[secretStoreResourceId]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
 
-	for _, route := range gateway.Routes {
-		port := renderers.DefaultPort
+	referencedResource := dependencies[secretStoreResourceId].Resource
+	if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
+	}
 
-		if isURL(route.Destination) {
-			_, _, urlPort, err := parseURL(route.Destination)
-			if err != nil {
-				return []rpv1.OutputResource{}, err
-			}
-			port = urlPort
+	// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
+	secretStore, ok := referencedResource.(*datamodel.SecretStore)
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
+	}
 
-		} else {
-			routeProperties := dependencies[route.Destination]
-			routePort, ok := routeProperties.ComputedValues["port"].(float64)
-			if ok {
-				port = int32(routePort)
-			}
+	if secretStore.Properties.Type != datamodel.SecretTypeCert {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with type certificate")
+	}
 
+	if secretStore.Properties.Data["tls.crt"] == nil {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.crt")
+	}
+
+	if secretStore.Properties.Data["tls.key"] == nil {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.key")
+	}
+
+	// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
+	if secretStoreResource.OutputResources == nil {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	secretName := secretResourceID.Name()
+	secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
+	if secretNamespace == "" {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	return secretNamespace, secretName, nil
+}
+
+// MakeRoutesHTTPProxies creates HTTPProxy objects for each route in the gateway and returns them as OutputResources. It returns
+// an error if it fails to get the route name.
+func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, gatewayOutPutResource rpv1.OutputResource, applicationName string) ([]rpv1.OutputResource, error) {
+	objects := make(map[string]*contourv1.HTTPProxy)
+
+	for i, route := range gateway.Routes {
+		routeName, err := getRouteProxyName(resource.Name, i, &route)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
 		}
 
-		routeName, err := getRouteName(&route)
+		services, err := makeRouteServices(options, &route)
 		if err != nil {
 			return []rpv1.OutputResource{}, err
 		}
@@ -372,17 +420,7 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 				Annotations: renderers.GetAnnotations(options),
 			},
 			Spec: contourv1.HTTPProxySpec{
-				Routes: []contourv1.Route{
-					{
-						Services: []contourv1.Service{
-							{
-								Name: routeResourceName,
-								Port: int(port),
-							},
-						},
-						PathRewritePolicy: pathRewritePolicy,
-					},
-				},
+				Routes: makeContourRoutes(&route, services, pathRewritePolicy),
 			},
 		}
 
@@ -400,10 +438,178 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 	return outputResources, nil
 }
 
+// getRouteProxyName returns the name of the object that serves the route. Routes without routing rules share the
+// object of their destination, while routes with routing rules get their own so that their conditions and policies
+// don't apply to other routes to the same destination.
+func getRouteProxyName(gatewayName string, index int, route *datamodel.GatewayRoute) (string, error) {
+	if route.HasRoutingRules() {
+		return fmt.Sprintf("%s-route-%d", gatewayName, index), nil
+	}
+
+	return getRouteName(route)
+}
+
+// makeRouteServices returns the Contour services for each destination of the route.
+func makeRouteServices(options renderers.RenderOptions, route *datamodel.GatewayRoute) ([]contourv1.Service, error) {
+	services := []contourv1.Service{}
+	for _, destination := range route.GetDestinations() {
+		port, err := getDestinationPort(options, destination.Destination)
+		if err != nil {
+			return nil, err
+		}
+
+		name, err := getDestinationName(destination.Destination)
+		if err != nil {
+			return nil, err
+		}
+
+		services = append(services, contourv1.Service{
+			Name:   kubernetes.NormalizeResourceName(name),
+			Port:   int(port),
+			Weight: int64(destination.Weight),
+		})
+	}
+
+	return services, nil
+}
+
+// makeContourRoutes creates the Contour routes for a Gateway route. Contour ANDs the conditions of a route, so a route
+// that matches several HTTP methods is rendered as one Contour route per method.
+func makeContourRoutes(route *datamodel.GatewayRoute, services []contourv1.Service, pathRewritePolicy *contourv1.PathRewritePolicy) []contourv1.Route {
+	conditions := []contourv1.MatchCondition{}
+	for _, header := range route.Headers {
+		conditions = append(conditions, contourv1.MatchCondition{
+			Header: &contourv1.HeaderMatchCondition{
+				Name:     header.Name,
+				Exact:    header.Exact,
+				Contains: header.Contains,
+				Present:  header.Present,
+			},
+		})
+	}
+
+	for _, parameter := range route.QueryParameters {
+		conditions = append(conditions, contourv1.MatchCondition{
+			QueryParameter: &contourv1.QueryParameterMatchCondition{
+				Name:    parameter.Name,
+				Exact:   parameter.Exact,
+				Prefix:  parameter.Prefix,
+				Present: parameter.Present,
+			},
+		})
+	}
+
+	contourRoute := contourv1.Route{
+		Services:              services,
+		PathRewritePolicy:     pathRewritePolicy,
+		RequestHeadersPolicy:  makeContourHeadersPolicy(route.RequestHeaders),
+		ResponseHeadersPolicy: makeContourHeadersPolicy(route.ResponseHeaders),
+	}
+
+	if route.TimeoutPolicy != nil {
+		contourRoute.TimeoutPolicy = &contourv1.TimeoutPolicy{
+			Response: route.TimeoutPolicy.Response,
+			Idle:     route.TimeoutPolicy.Idle,
+		}
+	}
+
+	if route.RetryPolicy != nil {
+		contourRoute.RetryPolicy = &contourv1.RetryPolicy{
+			NumRetries:    int64(route.RetryPolicy.Count),
+			PerTryTimeout: route.RetryPolicy.PerTryTimeout,
+		}
+		for _, retryOn := range route.RetryPolicy.RetryOn {
+			contourRoute.RetryPolicy.RetryOn = append(contourRoute.RetryPolicy.RetryOn, contourv1.RetryOn(retryOn))
+		}
+	}
+
+	if len(route.Methods) == 0 {
+		if len(conditions) > 0 {
+			contourRoute.Conditions = conditions
+		}
+
+		return []contourv1.Route{contourRoute}
+	}
+
+	routes := []contourv1.Route{}
+	for _, method := range route.Methods {
+		methodRoute := contourRoute
+		methodRoute.Conditions = append([]contourv1.MatchCondition{
+			{
+				Header: &contourv1.HeaderMatchCondition{
+					Name:  methodHeader,
+					Exact: method,
+				},
+			},
+		}, conditions...)
+		routes = append(routes, methodRoute)
+	}
+
+	return routes
+}
+
+// makeContourHeadersPolicy converts a header rewriting policy to a Contour headers policy.
+func makeContourHeadersPolicy(policy *datamodel.GatewayRouteHeaderPolicy) *contourv1.HeadersPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	headersPolicy := &contourv1.HeadersPolicy{
+		Remove: policy.Remove,
+	}
+	for _, name := range sortedHeaderNames(policy) {
+		headersPolicy.Set = append(headersPolicy.Set, contourv1.HeaderValue{
+			Name:  name,
+			Value: policy.Set[name],
+		})
+	}
+
+	return headersPolicy
+}
+
+// sortedHeaderNames returns the names of the headers set by the policy in order, so that rendered objects are
+// deterministic.
+func sortedHeaderNames(policy *datamodel.GatewayRouteHeaderPolicy) []string {
+	names := make([]string, 0, len(policy.Set))
+	for name := range policy.Set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// getDestinationPort returns the port of a route destination. The port is parsed from the destination when it is a URL,
+// and is otherwise taken from the computed values of the referenced httpRoute.
+func getDestinationPort(options renderers.RenderOptions, destination string) (int32, error) {
+	if isURL(destination) {
+		_, _, port, err := parseURL(destination)
+		if err != nil {
+			return 0, err
+		}
+
+		return port, nil
+	}
+
+	port := renderers.DefaultPort
+	routeProperties := options.Dependencies[destination]
+	routePort, ok := routeProperties.ComputedValues["port"].(float64)
+	if ok {
+		port = int32(routePort)
+	}
+
+	return port, nil
+}
+
+// getRouteName returns the name of the route's first destination.
 func getRouteName(route *datamodel.GatewayRoute) (string, error) {
+	return getDestinationName(route.GetDestinations()[0].Destination)
+}
+
+func getDestinationName(destination string) (string, error) {
 	// if isURL, then name is hostname (DNS-SD case)
-	if isURL(route.Destination) {
-		u, err := url.Parse(route.Destination)
+	if isURL(destination) {
+		u, err := url.Parse(destination)
 		if err != nil {
 			return "", v1.NewClientErrInvalidRequest(err.Error())
 		}
@@ -412,7 +618,7 @@ func getRouteName(route *datamodel.GatewayRoute) (string, error) {
 	}
 
 	// if not URL, then name is the resourceID (HTTProute case)
-	resourceID, err := resources.ParseResource(route.Destination)
+	resourceID, err := resources.ParseResource(destination)
 	if err != nil {
 		return "", v1.NewClientErrInvalidRequest(err.Error())
 	}
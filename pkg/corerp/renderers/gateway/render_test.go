@@ -24,6 +24,7 @@ import (
 
 	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/certificates"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/renderers"
 	"github.com/radius-project/radius/pkg/corerp/renderers/httproute"
@@ -35,6 +36,7 @@ import (
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
 )
 
 const (
@@ -123,6 +125,34 @@ func Test_GetDependencyIDs_Success(t *testing.T) {
 	require.ElementsMatch(t, expectedAzureResourceIDs, resourceIDs)
 }
 
+func Test_GetDependencyIDs_WeightedDestinations(t *testing.T) {
+	stableResourceID := makeRouteResourceID("stable")
+	canaryResourceID := makeRouteResourceID("canary")
+	properties := datamodel.GatewayProperties{
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destinations: []datamodel.GatewayRouteDestination{
+					{Destination: stableResourceID, Weight: 90},
+					{Destination: canaryResourceID, Weight: 10},
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	radiusResourceIDs, resourceIDs, err := renderer.GetDependencyIDs(ctx, resource)
+	require.NoError(t, err)
+	require.Len(t, resourceIDs, 0)
+
+	expectedRadiusResourceIDs := []resources.ID{
+		makeResourceID(t, stableResourceID),
+		makeResourceID(t, canaryResourceID),
+	}
+	require.ElementsMatch(t, expectedRadiusResourceIDs, radiusResourceIDs)
+}
+
 func Test_Render_WithIPAndNoHostname(t *testing.T) {
 	r := &Renderer{}
 
@@ -1055,6 +1085,112 @@ func Test_Render_Route_WithMultiplePrefixRewrite(t *testing.T) {
 	validateHttpRoute(t, output.Resources, routeBName, 80, expectedPathRewritePolicy, "")
 }
 
+func Test_Render_Route_WithRoutingRules(t *testing.T) {
+	r := &Renderer{}
+
+	stableName := "stable"
+	canaryName := "canary"
+	routes := []datamodel.GatewayRoute{
+		{
+			Destination: makeRouteResourceID(stableName),
+			Path:        "/",
+		},
+		{
+			Path:          "/api",
+			ReplacePrefix: "/",
+			Destinations: []datamodel.GatewayRouteDestination{
+				{Destination: makeRouteResourceID(stableName), Weight: 90},
+				{Destination: makeRouteResourceID(canaryName), Weight: 10},
+			},
+			Headers: []datamodel.GatewayRouteHeaderMatch{
+				{Name: "x-canary", Exact: "true"},
+			},
+			QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{
+				{Name: "debug", Present: true},
+			},
+			Methods:       []string{"GET", "POST"},
+			TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Response: "30s", Idle: "1m"},
+			RetryPolicy:   &datamodel.GatewayRouteRetryPolicy{Count: 3, PerTryTimeout: "2s", RetryOn: []string{"5xx", "reset"}},
+			RequestHeaders: &datamodel.GatewayRouteHeaderPolicy{
+				Set:    map[string]string{"x-version": "v2", "x-forwarded-by": "radius"},
+				Remove: []string{"x-debug"},
+			},
+			ResponseHeaders: &datamodel.GatewayRouteHeaderPolicy{
+				Remove: []string{"server"},
+			},
+		},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: routes,
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	// The route with routing rules gets its own HTTPProxy so that its conditions don't apply to the other route.
+	rulesRouteName := fmt.Sprintf("%s-route-1", resourceName)
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: expectedHostname,
+		},
+		Includes: []contourv1.Include{
+			{
+				Name:       kubernetes.NormalizeResourceName(stableName),
+				Conditions: []contourv1.MatchCondition{{Prefix: "/"}},
+			},
+			{
+				Name:       kubernetes.NormalizeResourceName(rulesRouteName),
+				Conditions: []contourv1.MatchCondition{{Prefix: "/api"}},
+			},
+		},
+	}
+
+	validateHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+	validateHttpRoute(t, output.Resources, stableName, 80, nil, "")
+
+	httpRoute, _ := kubernetes.FindContourHTTPProxyByLocalID(output.Resources, fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, rulesRouteName))
+	require.NotNil(t, httpRoute)
+	require.Equal(t, kubernetes.NormalizeResourceName(rulesRouteName), httpRoute.Name)
+
+	expectedRoute := func(method string) contourv1.Route {
+		return contourv1.Route{
+			Conditions: []contourv1.MatchCondition{
+				{Header: &contourv1.HeaderMatchCondition{Name: ":method", Exact: method}},
+				{Header: &contourv1.HeaderMatchCondition{Name: "x-canary", Exact: "true"}},
+				{QueryParameter: &contourv1.QueryParameterMatchCondition{Name: "debug", Present: true}},
+			},
+			Services: []contourv1.Service{
+				{Name: stableName, Port: 80, Weight: 90},
+				{Name: canaryName, Port: 80, Weight: 10},
+			},
+			PathRewritePolicy: &contourv1.PathRewritePolicy{
+				ReplacePrefix: []contourv1.ReplacePrefix{{Prefix: "/api", Replacement: "/"}},
+			},
+			TimeoutPolicy: &contourv1.TimeoutPolicy{Response: "30s", Idle: "1m"},
+			RetryPolicy:   &contourv1.RetryPolicy{NumRetries: 3, PerTryTimeout: "2s", RetryOn: []contourv1.RetryOn{"5xx", "reset"}},
+			RequestHeadersPolicy: &contourv1.HeadersPolicy{
+				Set: []contourv1.HeaderValue{
+					{Name: "x-forwarded-by", Value: "radius"},
+					{Name: "x-version", Value: "v2"},
+				},
+				Remove: []string{"x-debug"},
+			},
+			ResponseHeadersPolicy: &contourv1.HeadersPolicy{
+				Remove: []string{"server"},
+			},
+		}
+	}
+	require.Equal(t, []contourv1.Route{expectedRoute("GET"), expectedRoute("POST")}, httpRoute.Spec.Routes)
+}
+
 func Test_Render_WithDependencies(t *testing.T) {
 	r := &Renderer{}
 
@@ -1401,6 +1537,138 @@ func Test_Render_With_TLSTermination(t *testing.T) {
 	validateHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
 }
 
+func Test_Render_With_CertificateIssuer(t *testing.T) {
+	r := &Renderer{}
+
+	properties, expectedIncludes := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			MinimumProtocolVersion: "1.2",
+			CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+				Kind:             datamodel.GatewayCertificateIssuerKindACME,
+				ACMEDirectoryURL: "https://acme.example.com/directory",
+				Email:            "admin@example.com",
+				RenewBefore:      "720h",
+			},
+		},
+	})
+	resource := makeResource(t, properties)
+
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 4)
+	require.Empty(t, output.SecretValues)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	require.Equal(t, rpv1.LocalIDGatewayCertificate, output.Resources[0].LocalID)
+	secret, ok := output.Resources[0].CreateResource.Data.(*corev1.Secret)
+	require.True(t, ok)
+	require.Equal(t, resourceName+"-tls", secret.Name)
+	require.Equal(t, environmentOptions.Namespace, secret.Namespace)
+	require.Equal(t, corev1.SecretTypeTLS, secret.Type)
+	require.Empty(t, secret.Data)
+	require.Equal(t, "true", secret.Labels[certificates.LabelManagedCertificate])
+
+	expectedAnnotations := map[string]string{
+		certificates.AnnotationIssuer:           certificates.IssuerACME,
+		certificates.AnnotationDNSNames:         expectedHostname,
+		certificates.AnnotationRenewBefore:      "720h0m0s",
+		certificates.AnnotationACMEDirectoryURL: "https://acme.example.com/directory",
+		certificates.AnnotationACMEEmail:        "admin@example.com",
+	}
+	require.Equal(t, expectedAnnotations, secret.Annotations)
+
+	httpProxy, httpProxyOutputResource := kubernetes.FindContourHTTPProxy(output.Resources)
+	require.Contains(t, httpProxyOutputResource.CreateResource.Dependencies, rpv1.LocalIDGatewayCertificate)
+
+	expectedGatewaySpec := contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: expectedHostname,
+			TLS: &contourv1.TLS{
+				MinimumProtocolVersion: "1.2",
+				SecretName:             environmentOptions.Namespace + "/" + resourceName + "-tls",
+			},
+		},
+		Includes: append(expectedIncludes, contourv1.Include{
+			Name:      getACMEChallengeResourceName(environmentOptions.Namespace, resourceName),
+			Namespace: certificates.ChallengeServiceNamespace,
+			Conditions: []contourv1.MatchCondition{
+				{
+					Prefix: certificates.HTTP01ChallengePathPrefix,
+				},
+			},
+		}),
+	}
+	require.Equal(t, expectedGatewaySpec, httpProxy.Spec)
+
+	// The HTTP-01 challenges are routed to the challenge Service over plain HTTP.
+	challengeOutputResource := output.Resources[len(output.Resources)-1]
+	require.Equal(t, rpv1.LocalIDGatewayACMEChallenge, challengeOutputResource.LocalID)
+	challengeProxy, ok := challengeOutputResource.CreateResource.Data.(*contourv1.HTTPProxy)
+	require.True(t, ok)
+	require.Equal(t, getACMEChallengeResourceName(environmentOptions.Namespace, resourceName), challengeProxy.Name)
+	require.Equal(t, certificates.ChallengeServiceNamespace, challengeProxy.Namespace)
+	require.Nil(t, challengeProxy.Spec.VirtualHost)
+	require.Equal(t, []contourv1.Route{
+		{
+			Services: []contourv1.Service{
+				{
+					Name: certificates.ChallengeServiceName,
+					Port: certificates.ChallengeServicePort,
+				},
+			},
+			PermitInsecure: true,
+		},
+	}, challengeProxy.Spec.Routes)
+}
+
+func Test_Render_Fails_CertificateIssuerWithoutHostname(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			MinimumProtocolVersion: "1.2",
+			CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+				Kind: datamodel.GatewayCertificateIssuerKindSelfSigned,
+			},
+		},
+	})
+	resource := makeResource(t, properties)
+
+	tests := []struct {
+		name               string
+		environmentOptions renderers.EnvironmentOptions
+	}{
+		{
+			name:               "no public endpoint",
+			environmentOptions: getEnvironmentOptions("", "", "", false, false),
+		},
+		{
+			name:               "ip address override",
+			environmentOptions: getEnvironmentOptions("127.0.0.1", "", "", true, false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: tt.environmentOptions})
+			require.Error(t, err)
+			require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+			require.Equal(t, "a DNS hostname is required to issue a certificate for the Gateway, configure the hostname of the Gateway or the public endpoint of the environment", err.(*v1.ErrClientRP).Message)
+			require.Empty(t, output.Resources)
+		})
+	}
+}
+
 func Test_ParseURL(t *testing.T) {
 	const valid_url = "http://examplehost:80"
 	const invalid_url = "http://abc:def"
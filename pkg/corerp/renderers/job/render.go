@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/corerp/renderers/container"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	ResourceType = "Applications.Core/jobs"
+
+	// maxJobNamePrefixLength is the maximum length of the job name before the template hash is appended. Kubernetes
+	// adds the job name as a label to the pods of the job, so the full name must fit in the 63 character label limit.
+	maxJobNamePrefixLength = 54
+
+	// maxCronJobNameLength is the maximum length of the name of a CronJob. The CronJob controller appends an 11
+	// character suffix to the name of each Job it creates, which must fit in the 63 character label limit.
+	maxCronJobNameLength = 52
+)
+
+// Renderer is the renderers.Renderer implementation for Applications.Core/jobs.
+//
+// A job shares the container definition, connections, and environment of Applications.Core/containers, so the
+// rendering of the pod is delegated to the Inner container renderer. The resulting Deployment is then replaced
+// with a Kubernetes Job, or a CronJob when the job has a schedule.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs returns the Radius and Azure resource IDs that the job depends on.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	resource, ok := dm.(*datamodel.JobResource)
+	if !ok {
+		return nil, nil, v1.ErrInvalidModelConversion
+	}
+
+	return r.Inner.GetDependencyIDs(ctx, toContainerResource(resource))
+}
+
+// Render renders the pod of the job with the Inner renderer and converts the rendered Deployment to a Kubernetes Job,
+// or a CronJob if the job is scheduled.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	resource, ok := dm.(*datamodel.JobResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	output, err := r.Inner.Render(ctx, toContainerResource(resource), options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	for i, ores := range output.Resources {
+		if ores.CreateResource == nil || ores.GetResourceType().Provider != resourcemodel.ProviderKubernetes {
+			continue
+		}
+
+		if obj, ok := ores.CreateResource.Data.(metav1.Object); ok {
+			obj.SetLabels(replaceResourceTypeLabel(obj.GetLabels()))
+		}
+
+		deployment, ok := ores.CreateResource.Data.(*appsv1.Deployment)
+		if !ok {
+			continue
+		}
+		deployment.Spec.Template.Labels = replaceResourceTypeLabel(deployment.Spec.Template.Labels)
+
+		var workload rpv1.OutputResource
+		if resource.IsScheduled() {
+			cronJob := makeCronJob(resource, deployment)
+			workload = rpv1.NewKubernetesOutputResource(rpv1.LocalIDCronJob, cronJob, cronJob.ObjectMeta)
+		} else {
+			job, err := makeJob(resource, deployment)
+			if err != nil {
+				return renderers.RendererOutput{}, err
+			}
+			workload = rpv1.NewKubernetesOutputResource(rpv1.LocalIDJob, job, job.ObjectMeta)
+		}
+		workload.CreateResource.Dependencies = ores.CreateResource.Dependencies
+		output.Resources[i] = workload
+	}
+
+	for key, value := range output.ComputedValues {
+		if value.Transformer != nil {
+			value.Transformer = wrapTransformer(value.Transformer)
+			output.ComputedValues[key] = value
+		}
+	}
+
+	return output, nil
+}
+
+// toContainerResource creates a container resource with the same container definition as the job so that the pod
+// can be rendered by the container renderer.
+func toContainerResource(resource *datamodel.JobResource) *datamodel.ContainerResource {
+	return &datamodel.ContainerResource{
+		BaseResource:             resource.BaseResource,
+		PortableResourceMetadata: resource.PortableResourceMetadata,
+		Properties: datamodel.ContainerProperties{
+			BasicResourceProperties: resource.Properties.BasicResourceProperties,
+			Connections:             resource.Properties.Connections,
+			Container:               resource.Properties.Container,
+			Extensions:              resource.Properties.Extensions,
+			Identity:                resource.Properties.Identity,
+			Runtimes:                resource.Properties.Runtimes,
+		},
+	}
+}
+
+// wrapTransformer adapts a computed value transformer of the container renderer so that it applies to the job resource.
+func wrapTransformer(transformer func(v1.DataModelInterface, map[string]any) error) func(v1.DataModelInterface, map[string]any) error {
+	return func(r v1.DataModelInterface, cv map[string]any) error {
+		res, ok := r.(*datamodel.JobResource)
+		if !ok {
+			return errors.New("resource must be JobResource")
+		}
+
+		ctr := toContainerResource(res)
+		if err := transformer(ctr, cv); err != nil {
+			return err
+		}
+
+		res.Properties.Identity = ctr.Properties.Identity
+		return nil
+	}
+}
+
+// replaceResourceTypeLabel replaces the resource type label set by the container renderer with the job resource type.
+func replaceResourceTypeLabel(labels map[string]string) map[string]string {
+	if labels[kubernetes.LabelRadiusResourceType] == strings.ToLower(kubernetes.ConvertResourceTypeToLabelValue(container.ResourceType)) {
+		labels[kubernetes.LabelRadiusResourceType] = strings.ToLower(kubernetes.ConvertResourceTypeToLabelValue(ResourceType))
+	}
+	return labels
+}
+
+func makeJobSpec(resource *datamodel.JobResource, deployment *appsv1.Deployment) batchv1.JobSpec {
+	template := *deployment.Spec.Template.DeepCopy()
+
+	// Pods of a job can't use the "Always" restart policy, OnFailure is used by default.
+	template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+	if resource.Properties.RestartPolicy != "" {
+		template.Spec.RestartPolicy = corev1.RestartPolicy(resource.Properties.RestartPolicy)
+	}
+
+	return batchv1.JobSpec{
+		Template:              template,
+		BackoffLimit:          resource.Properties.BackoffLimit,
+		ActiveDeadlineSeconds: resource.Properties.ActiveDeadlineSeconds,
+	}
+}
+
+// makeJob creates a Kubernetes Job from the rendered deployment.
+//
+// The pod template of a Job is immutable, so the name of the Job includes a hash of its spec. A change to the
+// job definition results in a new Job which runs again, and the previous Job is garbage collected. Deploying an
+// unchanged job doesn't run it again.
+func makeJob(resource *datamodel.JobResource, deployment *appsv1.Deployment) (*batchv1.Job, error) {
+	spec := makeJobSpec(resource, deployment)
+
+	hash, err := hashJobSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	name := deployment.Name
+	if len(name) > maxJobNamePrefixLength {
+		name = name[:maxJobNamePrefixLength]
+	}
+
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%s", name, hash),
+			Namespace:   deployment.Namespace,
+			Labels:      deployment.Labels,
+			Annotations: deployment.Annotations,
+		},
+		Spec: spec,
+	}, nil
+}
+
+// makeCronJob creates a Kubernetes CronJob from the rendered deployment.
+func makeCronJob(resource *datamodel.JobResource, deployment *appsv1.Deployment) *batchv1.CronJob {
+	cronJob := &batchv1.CronJob{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CronJob",
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cronJobName(deployment.Name),
+			Namespace:   deployment.Namespace,
+			Labels:      deployment.Labels,
+			Annotations: deployment.Annotations,
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: resource.Properties.Schedule,
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: deployment.Spec.Template.Labels,
+				},
+				Spec: makeJobSpec(resource, deployment),
+			},
+		},
+	}
+
+	if resource.Properties.ConcurrencyPolicy != "" {
+		cronJob.Spec.ConcurrencyPolicy = batchv1.ConcurrencyPolicy(resource.Properties.ConcurrencyPolicy)
+	}
+
+	return cronJob
+}
+
+// cronJobName returns the name of the CronJob for the deployment name. A name that is too long is truncated and
+// suffixed with a hash of the full name, so that the names of different jobs remain unique.
+func cronJobName(name string) string {
+	if len(name) <= maxCronJobNameLength {
+		return name
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(name))
+	suffix := fmt.Sprintf("%08x", hash.Sum32())
+	return fmt.Sprintf("%s-%s", name[:maxCronJobNameLength-len(suffix)-1], suffix)
+}
+
+func hashJobSpec(spec batchv1.JobSpec) (string, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write(b)
+	return fmt.Sprintf("%08x", hash.Sum32()), nil
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/corerp/renderers/container"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	applicationName       = "test-app"
+	applicationResourceID = "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+	resourceName          = "test-job"
+)
+
+func makeResource(properties datamodel.JobProperties) *datamodel.JobResource {
+	properties.Application = applicationResourceID
+	return &datamodel.JobResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/jobs/" + resourceName,
+				Name: resourceName,
+				Type: ResourceType,
+			},
+		},
+		Properties: properties,
+	}
+}
+
+func findResource[T any](t *testing.T, output renderers.RendererOutput, localID string) (T, rpv1.OutputResource) {
+	for _, r := range output.Resources {
+		if r.LocalID == localID {
+			obj, ok := r.CreateResource.Data.(T)
+			require.True(t, ok)
+			return obj, r
+		}
+	}
+
+	var empty T
+	require.Failf(t, "output resource not found", "local id: %s", localID)
+	return empty, rpv1.OutputResource{}
+}
+
+func Test_Render_Job(t *testing.T) {
+	resource := makeResource(datamodel.JobProperties{
+		Container: datamodel.Container{
+			Image:   "migrations:latest",
+			Command: []string{"./migrate.sh"},
+			Env: map[string]string{
+				"MODE": "migrate",
+			},
+		},
+		RestartPolicy:         "Never",
+		BackoffLimit:          to.Ptr(int32(2)),
+		ActiveDeadlineSeconds: to.Ptr(int64(300)),
+	})
+
+	renderer := &Renderer{Inner: &container.Renderer{}}
+	output, err := renderer.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+
+	for _, r := range output.Resources {
+		_, ok := r.CreateResource.Data.(*appsv1.Deployment)
+		require.False(t, ok, "deployment should be replaced with a job")
+	}
+
+	job, outputResource := findResource[*batchv1.Job](t, output, rpv1.LocalIDJob)
+	require.Equal(t, []string{rpv1.LocalIDServiceAccount, rpv1.LocalIDKubernetesRole, rpv1.LocalIDKubernetesRoleBinding}, outputResource.CreateResource.Dependencies)
+	require.Equal(t, "Job", job.Kind)
+	require.Equal(t, "batch/v1", job.APIVersion)
+	require.True(t, strings.HasPrefix(job.Name, resourceName+"-"))
+	require.Equal(t, "batch/Job", outputResource.GetResourceType().Type)
+
+	labels := kubernetes.MakeDescriptiveLabels(applicationName, resourceName, ResourceType)
+	require.Equal(t, labels, job.Labels)
+	require.Equal(t, labels, job.Spec.Template.Labels)
+
+	require.Equal(t, to.Ptr(int32(2)), job.Spec.BackoffLimit)
+	require.Equal(t, to.Ptr(int64(300)), job.Spec.ActiveDeadlineSeconds)
+	require.Equal(t, corev1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+
+	require.Len(t, job.Spec.Template.Spec.Containers, 1)
+	c := job.Spec.Template.Spec.Containers[0]
+	require.Equal(t, "migrations:latest", c.Image)
+	require.Equal(t, []string{"./migrate.sh"}, c.Command)
+	require.Equal(t, []corev1.EnvVar{{Name: "MODE", Value: "migrate"}}, c.Env)
+
+	// The other Kubernetes resources are labeled with the job resource type.
+	sa, _ := findResource[*corev1.ServiceAccount](t, output, rpv1.LocalIDServiceAccount)
+	require.Equal(t, strings.ToLower(kubernetes.ConvertResourceTypeToLabelValue(ResourceType)), sa.Labels[kubernetes.LabelRadiusResourceType])
+}
+
+func Test_Render_Job_DefaultRestartPolicy(t *testing.T) {
+	resource := makeResource(datamodel.JobProperties{
+		Container: datamodel.Container{
+			Image: "migrations:latest",
+		},
+	})
+
+	renderer := &Renderer{Inner: &container.Renderer{}}
+	output, err := renderer.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+
+	job, _ := findResource[*batchv1.Job](t, output, rpv1.LocalIDJob)
+	require.Equal(t, corev1.RestartPolicyOnFailure, job.Spec.Template.Spec.RestartPolicy)
+	require.Nil(t, job.Spec.BackoffLimit)
+}
+
+func Test_Render_Job_NameChangesWithDefinition(t *testing.T) {
+	render := func(image string) string {
+		resource := makeResource(datamodel.JobProperties{
+			Container: datamodel.Container{
+				Image: image,
+			},
+		})
+
+		renderer := &Renderer{Inner: &container.Renderer{}}
+		output, err := renderer.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+		require.NoError(t, err)
+
+		job, _ := findResource[*batchv1.Job](t, output, rpv1.LocalIDJob)
+		return job.Name
+	}
+
+	require.Equal(t, render("migrations:v1"), render("migrations:v1"))
+	require.NotEqual(t, render("migrations:v1"), render("migrations:v2"))
+}
+
+func Test_Render_CronJob(t *testing.T) {
+	resource := makeResource(datamodel.JobProperties{
+		Container: datamodel.Container{
+			Image: "cleanup:latest",
+		},
+		Schedule:          "0 * * * *",
+		ConcurrencyPolicy: datamodel.JobConcurrencyPolicyForbid,
+		BackoffLimit:      to.Ptr(int32(1)),
+	})
+
+	renderer := &Renderer{Inner: &container.Renderer{}}
+	output, err := renderer.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+
+	cronJob, _ := findResource[*batchv1.CronJob](t, output, rpv1.LocalIDCronJob)
+	require.Equal(t, "CronJob", cronJob.Kind)
+	require.Equal(t, resourceName, cronJob.Name)
+	require.Equal(t, "0 * * * *", cronJob.Spec.Schedule)
+	require.Equal(t, batchv1.ForbidConcurrent, cronJob.Spec.ConcurrencyPolicy)
+	require.Equal(t, to.Ptr(int32(1)), cronJob.Spec.JobTemplate.Spec.BackoffLimit)
+	require.Equal(t, corev1.RestartPolicyOnFailure, cronJob.Spec.JobTemplate.Spec.Template.Spec.RestartPolicy)
+	require.Equal(t, "cleanup:latest", cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Image)
+	require.Equal(t, strings.ToLower(kubernetes.ConvertResourceTypeToLabelValue(ResourceType)), cronJob.Spec.JobTemplate.Labels[kubernetes.LabelRadiusResourceType])
+}
+
+func Test_Render_CronJob_LongName(t *testing.T) {
+	render := func(name string) string {
+		resource := makeResource(datamodel.JobProperties{
+			Container: datamodel.Container{
+				Image: "cleanup:latest",
+			},
+			Schedule: "0 * * * *",
+		})
+		resource.ID = "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/jobs/" + name
+		resource.Name = name
+
+		renderer := &Renderer{Inner: &container.Renderer{}}
+		output, err := renderer.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+		require.NoError(t, err)
+
+		cronJob, _ := findResource[*batchv1.CronJob](t, output, rpv1.LocalIDCronJob)
+		return cronJob.Name
+	}
+
+	first := render(strings.Repeat("a", 47) + "-first")
+	second := render(strings.Repeat("a", 47) + "-second")
+
+	require.Len(t, first, maxCronJobNameLength)
+	require.Len(t, second, maxCronJobNameLength)
+	require.True(t, strings.HasPrefix(first, strings.Repeat("a", 43)+"-"))
+	require.NotEqual(t, first, second)
+}
+
+func Test_Render_TransformerAppliesToJob(t *testing.T) {
+	ctr := &datamodel.ContainerResource{}
+	transformer := wrapTransformer(func(r v1.DataModelInterface, cv map[string]any) error {
+		res := r.(*datamodel.ContainerResource)
+		res.Properties.Identity = &rpv1.IdentitySettings{Resource: cv["id"].(string)}
+		return nil
+	})
+
+	err := transformer(ctr, map[string]any{"id": "identity-id"})
+	require.ErrorContains(t, err, "resource must be JobResource")
+
+	resource := makeResource(datamodel.JobProperties{})
+	err = transformer(resource, map[string]any{"id": "identity-id"})
+	require.NoError(t, err)
+	require.Equal(t, "identity-id", resource.Properties.Identity.Resource)
+}
+
+func Test_Render_InvalidModel(t *testing.T) {
+	renderer := &Renderer{Inner: &container.Renderer{}}
+	_, err := renderer.Render(testcontext.New(t), &datamodel.ContainerResource{}, renderers.RenderOptions{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+
+	_, _, err = renderer.GetDependencyIDs(testcontext.New(t), &datamodel.ContainerResource{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
@@ -83,6 +83,11 @@ type ApplicationOptions struct {
 }
 
 type GatewayOptions struct {
+	// Kind is the gateway implementation used by the environment.
+	Kind datamodel.EnvironmentGatewayKind
+	// GatewayClassName is the Gateway API GatewayClass used when Kind is gatewayAPI.
+	GatewayClassName string
+
 	PublicEndpointOverride bool
 	Hostname               string
 	Port                   string
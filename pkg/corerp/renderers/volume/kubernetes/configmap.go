@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConfigMapRenderer is a renderer for Kubernetes config map volume.
+type ConfigMapRenderer struct {
+}
+
+// Render creates a ConfigMap holding the files of the VolumeResource.
+func (r *ConfigMapRenderer) Render(ctx context.Context, dm v1.DataModelInterface, options *renderers.RenderOptions) (*renderers.RendererOutput, error) {
+	volume, ok := dm.(*datamodel.VolumeResource)
+	if !ok {
+		return nil, v1.ErrInvalidModelConversion
+	}
+
+	properties := volume.Properties.ConfigMap
+	if properties == nil {
+		return nil, v1.NewClientErrInvalidRequest("configMap properties are required")
+	}
+
+	appID, err := resources.ParseResource(volume.Properties.Application)
+	if err != nil {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid application id: %s. id: %s", err.Error(), volume.Properties.Application))
+	}
+
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        k8slabels.NormalizeResourceName(volume.Name),
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(*options, appID.Name(), volume.Name, volume.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(*options),
+		},
+		Data: properties.Data,
+	}
+
+	return &renderers.RendererOutput{
+		Resources:      []rpv1.OutputResource{rpv1.NewKubernetesOutputResource(rpv1.LocalIDConfigMap, cm, cm.ObjectMeta)},
+		ComputedValues: map[string]rpv1.ComputedValueReference{},
+		SecretValues:   map[string]rpv1.SecretValueReference{},
+	}, nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestConfigMapRender(t *testing.T) {
+	vol := newTestVolume("config", datamodel.VolumeResourceProperties{
+		Kind: datamodel.ConfigMapVolume,
+		ConfigMap: &datamodel.ConfigMapVolumeProperties{
+			Data: map[string]string{"app.conf": "key=value"},
+		},
+	})
+
+	r := &ConfigMapRenderer{}
+	output, err := r.Render(context.Background(), vol, &renderers.RenderOptions{
+		Environment: renderers.EnvironmentOptions{Namespace: "default-app0"},
+	})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+	require.Equal(t, rpv1.LocalIDConfigMap, output.Resources[0].LocalID)
+
+	cm := output.Resources[0].CreateResource.Data.(*corev1.ConfigMap)
+	require.Equal(t, "config", cm.Name)
+	require.Equal(t, "default-app0", cm.Namespace)
+	require.Equal(t, map[string]string{"app.conf": "key=value"}, cm.Data)
+}
+
+func TestEmptyDirRender(t *testing.T) {
+	vol := newTestVolume("scratch", datamodel.VolumeResourceProperties{
+		Kind:     datamodel.EmptyDirVolume,
+		EmptyDir: &datamodel.EmptyDirVolumeProperties{},
+	})
+
+	r := &EmptyDirRenderer{}
+	output, err := r.Render(context.Background(), vol, &renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Empty(t, output.Resources)
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// EmptyDirRenderer is a renderer for Kubernetes empty directory volume.
+type EmptyDirRenderer struct {
+}
+
+// Render returns an empty RendererOutput. An empty directory only exists for the lifetime of the pod that mounts it,
+// so it is created by the container renderer.
+func (r *EmptyDirRenderer) Render(ctx context.Context, dm v1.DataModelInterface, options *renderers.RenderOptions) (*renderers.RendererOutput, error) {
+	if _, ok := dm.(*datamodel.VolumeResource); !ok {
+		return nil, v1.ErrInvalidModelConversion
+	}
+
+	return &renderers.RendererOutput{
+		Resources:      []rpv1.OutputResource{},
+		ComputedValues: map[string]rpv1.ComputedValueReference{},
+		SecretValues:   map[string]rpv1.SecretValueReference{},
+	}, nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PersistentVolumeClaimRenderer is a renderer for Kubernetes persistent volume claim volume.
+type PersistentVolumeClaimRenderer struct {
+}
+
+// Render creates a PersistentVolumeClaim from the VolumeResource. If the retention policy of the volume is Retain,
+// the claim is not managed by Radius so that it is kept when the volume resource is deleted.
+func (r *PersistentVolumeClaimRenderer) Render(ctx context.Context, dm v1.DataModelInterface, options *renderers.RenderOptions) (*renderers.RendererOutput, error) {
+	volume, ok := dm.(*datamodel.VolumeResource)
+	if !ok {
+		return nil, v1.ErrInvalidModelConversion
+	}
+
+	properties := volume.Properties.PersistentVolumeClaim
+	if properties == nil {
+		return nil, v1.NewClientErrInvalidRequest("persistentVolumeClaim properties are required")
+	}
+
+	size, err := resource.ParseQuantity(properties.Size)
+	if err != nil {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid size %q: %s", properties.Size, err.Error()))
+	}
+
+	appID, err := resources.ParseResource(volume.Properties.Application)
+	if err != nil {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid application id: %s. id: %s", err.Error(), volume.Properties.Application))
+	}
+
+	accessMode := corev1.ReadWriteOnce
+	if properties.AccessMode != "" {
+		accessMode = corev1.PersistentVolumeAccessMode(properties.AccessMode)
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        k8slabels.NormalizeResourceName(volume.Name),
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(*options, appID.Name(), volume.Name, volume.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(*options),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{accessMode},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: size,
+				},
+			},
+		},
+	}
+
+	if properties.StorageClassName != "" {
+		pvc.Spec.StorageClassName = to.Ptr(properties.StorageClassName)
+	}
+
+	or := rpv1.NewKubernetesOutputResource(rpv1.LocalIDPersistentVolumeClaim, pvc, pvc.ObjectMeta)
+	if properties.RetentionPolicy == datamodel.VolumeRetentionPolicyRetain {
+		or.RadiusManaged = to.Ptr(false)
+	}
+
+	return &renderers.RendererOutput{
+		Resources:      []rpv1.OutputResource{or},
+		ComputedValues: map[string]rpv1.ComputedValueReference{},
+		SecretValues:   map[string]rpv1.SecretValueReference{},
+	}, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	applicationID = "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/applications/app0"
+)
+
+func newTestVolume(name string, properties datamodel.VolumeResourceProperties) *datamodel.VolumeResource {
+	properties.Application = applicationID
+	return &datamodel.VolumeResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/volumes/" + name,
+				Name: name,
+				Type: datamodel.VolumeResourceType,
+			},
+		},
+		Properties: properties,
+	}
+}
+
+func TestPersistentVolumeClaimRender(t *testing.T) {
+	tests := []struct {
+		name             string
+		properties       *datamodel.PersistentVolumeClaimVolumeProperties
+		storageClassName *string
+		accessMode       corev1.PersistentVolumeAccessMode
+		radiusManaged    *bool
+	}{
+		{
+			name: "defaults",
+			properties: &datamodel.PersistentVolumeClaimVolumeProperties{
+				Size: "10Gi",
+			},
+			accessMode: corev1.ReadWriteOnce,
+		},
+		{
+			name: "custom",
+			properties: &datamodel.PersistentVolumeClaimVolumeProperties{
+				Size:             "10Gi",
+				StorageClassName: "managed-csi",
+				AccessMode:       datamodel.PersistentVolumeAccessModeReadWriteMany,
+				RetentionPolicy:  datamodel.VolumeRetentionPolicyDelete,
+			},
+			storageClassName: to.Ptr("managed-csi"),
+			accessMode:       corev1.ReadWriteMany,
+		},
+		{
+			name: "retain",
+			properties: &datamodel.PersistentVolumeClaimVolumeProperties{
+				Size:            "10Gi",
+				RetentionPolicy: datamodel.VolumeRetentionPolicyRetain,
+			},
+			accessMode:    corev1.ReadWriteOnce,
+			radiusManaged: to.Ptr(false),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vol := newTestVolume("data", datamodel.VolumeResourceProperties{
+				Kind:                  datamodel.PersistentVolumeClaimVolume,
+				PersistentVolumeClaim: tc.properties,
+			})
+
+			r := &PersistentVolumeClaimRenderer{}
+			output, err := r.Render(context.Background(), vol, &renderers.RenderOptions{
+				Environment: renderers.EnvironmentOptions{Namespace: "default-app0"},
+			})
+			require.NoError(t, err)
+			require.Len(t, output.Resources, 1)
+
+			or := output.Resources[0]
+			require.Equal(t, rpv1.LocalIDPersistentVolumeClaim, or.LocalID)
+			require.Equal(t, tc.radiusManaged, or.RadiusManaged)
+
+			pvc := or.CreateResource.Data.(*corev1.PersistentVolumeClaim)
+			require.Equal(t, "data", pvc.Name)
+			require.Equal(t, "default-app0", pvc.Namespace)
+			require.Equal(t, kubernetes.MakeDescriptiveLabels("app0", "data", datamodel.VolumeResourceType), pvc.Labels)
+			require.Equal(t, tc.storageClassName, pvc.Spec.StorageClassName)
+			require.Equal(t, []corev1.PersistentVolumeAccessMode{tc.accessMode}, pvc.Spec.AccessModes)
+			require.Equal(t, resource.MustParse("10Gi"), pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+		})
+	}
+}
+
+func TestPersistentVolumeClaimRender_InvalidSize(t *testing.T) {
+	vol := newTestVolume("data", datamodel.VolumeResourceProperties{
+		Kind: datamodel.PersistentVolumeClaimVolume,
+		PersistentVolumeClaim: &datamodel.PersistentVolumeClaimVolumeProperties{
+			Size: "lots",
+		},
+	})
+
+	r := &PersistentVolumeClaimRenderer{}
+	_, err := r.Render(context.Background(), vol, &renderers.RenderOptions{})
+	require.Error(t, err)
+	require.ErrorIs(t, err, &v1.ErrClientRP{})
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/renderers"
 	azvolrenderer "github.com/radius-project/radius/pkg/corerp/renderers/volume/azure"
+	k8svolrenderer "github.com/radius-project/radius/pkg/corerp/renderers/volume/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -39,7 +40,10 @@ type Renderer struct {
 func NewRenderer(armConfig *armauth.ArmConfig) renderers.Renderer {
 	return &Renderer{
 		VolumeRenderers: map[string]VolumeRenderer{
-			datamodel.AzureKeyVaultVolume: &azvolrenderer.KeyVaultRenderer{},
+			datamodel.AzureKeyVaultVolume:         &azvolrenderer.KeyVaultRenderer{},
+			datamodel.PersistentVolumeClaimVolume: &k8svolrenderer.PersistentVolumeClaimRenderer{},
+			datamodel.ConfigMapVolume:             &k8svolrenderer.ConfigMapRenderer{},
+			datamodel.EmptyDirVolume:              &k8svolrenderer.EmptyDirRenderer{},
 		},
 	}
 }
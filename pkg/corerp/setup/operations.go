@@ -159,6 +159,26 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Core/applications/listRevisions/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "applications",
+			Operation:   "List application revisions",
+			Description: "List the revisions of an application.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/applications/rollback/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "applications",
+			Operation:   "Roll back application",
+			Description: "Roll back an application to a previous revision.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Core/gateways/read",
 		Display: &v1.OperationDisplayProperties{
@@ -219,6 +239,36 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Core/jobs/read",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "jobs",
+			Operation:   "List jobs",
+			Description: "Get the list of jobs.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/jobs/write",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "jobs",
+			Operation:   "Create/Update job",
+			Description: "Create or update a job.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/jobs/delete",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "jobs",
+			Operation:   "Delete job",
+			Description: "Delete a job.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Core/extenders/read",
 		Display: &v1.OperationDisplayProperties{
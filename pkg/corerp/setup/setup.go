@@ -32,6 +32,7 @@ import (
 	env_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/environments"
 	ext_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/extenders"
 	gw_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
+	job_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/jobs"
 	secret_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
 	vol_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/volumes"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
@@ -90,6 +91,17 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 					return app_ctrl.NewGetGraph(opt, *recipeControllerConfig.UCPConnection)
 				},
 			},
+			"listRevisions": {
+				APIController: app_ctrl.NewListRevisions,
+			},
+			"rollback": {
+				APIController: app_ctrl.NewRollback,
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return backend_ctrl.NewRollbackApplication(options, *recipeControllerConfig.UCPConnection)
+				},
+				AsyncOperationTimeout:    app_ctrl.AsyncRollbackApplicationTimeout,
+				AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+			},
 		},
 	})
 
@@ -137,6 +149,32 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		},
 	})
 
+	_ = ns.AddResource("jobs", &builder.ResourceOption[*datamodel.JobResource, datamodel.JobResource]{
+		RequestConverter:  converter.JobDataModelFromVersioned,
+		ResponseConverter: converter.JobDataModelToVersioned,
+
+		Put: builder.Operation[datamodel.JobResource]{
+			UpdateFilters: []apictrl.UpdateFilter[datamodel.JobResource]{
+				rp_frontend.PrepareRadiusResource[*datamodel.JobResource],
+				job_ctrl.ValidateAndMutateRequest,
+			},
+			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
+			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+		},
+		Patch: builder.Operation[datamodel.JobResource]{
+			UpdateFilters: []apictrl.UpdateFilter[datamodel.JobResource]{
+				rp_frontend.PrepareRadiusResource[*datamodel.JobResource],
+				job_ctrl.ValidateAndMutateRequest,
+			},
+			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
+			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+		},
+		Delete: builder.Operation[datamodel.JobResource]{
+			AsyncJobController:       backend_ctrl.NewDeleteResource,
+			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+		},
+	})
+
 	_ = ns.AddResource("gateways", &builder.ResourceOption[*datamodel.Gateway, datamodel.Gateway]{
 		RequestConverter:  converter.GatewayDataModelFromVersioned,
 		ResponseConverter: converter.GatewayDataModelToVersioned,
@@ -33,12 +33,14 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/swagger"
 
 	app_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/applications"
 	ctr_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/containers"
 	env_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/environments"
 	gtwy_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
 	hrt_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/httproutes"
+	job_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/jobs"
 	secret_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
 	vol_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/volumes"
 )
@@ -92,6 +94,30 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ctr_ctrl.ResourceTypeName, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.core/containers/ctr0",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
+		Path:          "/providers/applications.core/jobs",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationList},
+		Path:          "/resourcegroups/testrg/providers/applications.core/jobs",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationGet},
+		Path:          "/resourcegroups/testrg/providers/applications.core/jobs/job0",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationPut},
+		Path:          "/resourcegroups/testrg/providers/applications.core/jobs/job0",
+		Method:        http.MethodPut,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationPatch},
+		Path:          "/resourcegroups/testrg/providers/applications.core/jobs/job0",
+		Method:        http.MethodPatch,
+	}, {
+		OperationType: v1.OperationType{Type: job_ctrl.ResourceTypeName, Method: v1.OperationDelete},
+		Path:          "/resourcegroups/testrg/providers/applications.core/jobs/job0",
+		Method:        http.MethodDelete,
 	}, {
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/environments",
@@ -232,6 +258,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: app_ctrl.ResourceTypeName, Method: "ACTIONGETGRAPH"},
 		Path:          "/resourcegroups/testrg/providers/applications.core/applications/app0/getgraph",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: app_ctrl.ResourceTypeName, Method: "ACTIONLISTREVISIONS"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/applications/app0/listrevisions",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: app_ctrl.ResourceTypeName, Method: "ACTIONROLLBACK"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/applications/app0/rollback",
+		Method:        http.MethodPost,
 	},
 }
 
@@ -260,3 +294,13 @@ func TestRouter(t *testing.T) {
 		return r, nsBuilder.ApplyAPIHandlers(ctx, r, apictrl.Options{PathBase: "/api.ucp.dev", DataProvider: mockSP}, validator)
 	})
 }
+
+func TestOpenAPIDocument(t *testing.T) {
+	ns := SetupNamespace(&controllerconfig.RecipeControllerConfig{})
+	nsBuilder := ns.GenerateBuilder()
+
+	doc, err := nsBuilder.OpenAPIDocument()
+	require.NoError(t, err)
+
+	rpctest.AssertOpenAPIDocument(t, doc, swagger.SpecFiles, "specification/applications/resource-manager/Applications.Core/preview/2023-10-01-preview/openapi.json")
+}
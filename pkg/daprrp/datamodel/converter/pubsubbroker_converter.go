@@ -17,44 +17,25 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/daprrp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 )
 
+// pubSubBrokerConverter converts Dapr pub sub brokers between the datamodel and the versioned model of each API version.
+var pubSubBrokerConverter = apiversions.NewConverter[datamodel.DaprPubSubBroker](dapr_ctrl.DaprPubSubBrokersResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.DaprPubSubBrokerResource{} })
+
 // PubSubBrokerDataModelToVersioned converts a version-agnostic datamodel.DaprPubSubBroker to a versioned model based on the version
 // string, returning an error if the version is not supported.
 func PubSubBrokerDataModelToVersioned(model *datamodel.DaprPubSubBroker, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.DaprPubSubBrokerResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return pubSubBrokerConverter.ToVersioned(model, version)
 }
 
 // PubSubBrokerDataModelFromVersioned unmarshals a JSON byte slice into a versioned PubSubBroker resource and converts it
 // to a version-agnostic datamodel PubSubBroker, returning an error if either operation fails.
 func PubSubBrokerDataModelFromVersioned(content []byte, version string) (*datamodel.DaprPubSubBroker, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.DaprPubSubBrokerResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-
-		return dm.(*datamodel.DaprPubSubBroker), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return pubSubBrokerConverter.FromVersioned(content, version)
 }
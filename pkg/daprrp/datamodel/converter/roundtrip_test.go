@@ -0,0 +1,32 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+)
+
+func TestRoundTrip(t *testing.T) {
+	rpctest.RunRoundTripTests(t, "../../api", map[string][]string{
+		dapr_ctrl.DaprPubSubBrokersResourceType: {"pubsubbroker_manual_resource.json", "pubsubbroker_recipe_resource.json"},
+		dapr_ctrl.DaprSecretStoresResourceType:  {"secretstore_manual_resource.json", "secretstore_recipe_resource.json"},
+		dapr_ctrl.DaprStateStoresResourceType:   {"statestore_values_resource.json", "statestore_recipe_resource.json"},
+	})
+}
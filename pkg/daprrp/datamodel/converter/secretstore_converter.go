@@ -17,43 +17,25 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/daprrp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 )
 
+// secretStoreConverter converts Dapr secret stores between the datamodel and the versioned model of each API version.
+var secretStoreConverter = apiversions.NewConverter[datamodel.DaprSecretStore](dapr_ctrl.DaprSecretStoresResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.DaprSecretStoreResource{} })
+
 // SecretStoreDataModelToVersioned converts a version-agnostic datamodel.DaprSecretStore to a versioned model based on the version
 // string, returning an error if the version is not supported.
 func SecretStoreDataModelToVersioned(model *datamodel.DaprSecretStore, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.DaprSecretStoreResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return secretStoreConverter.ToVersioned(model, version)
 }
 
 // SecretStoreDataModelFromVersioned unmarshals a JSON content into a versionined DaprSecretStoreResource object and then converts
 // it to a version-agnostic DaprSecretStore object, returning an error if either of these steps fail.
 func SecretStoreDataModelFromVersioned(content []byte, version string) (*datamodel.DaprSecretStore, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.DaprSecretStoreResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-		return dm.(*datamodel.DaprSecretStore), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return secretStoreConverter.FromVersioned(content, version)
 }
@@ -17,44 +17,25 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/daprrp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 )
 
+// stateStoreConverter converts Dapr state stores between the datamodel and the versioned model of each API version.
+var stateStoreConverter = apiversions.NewConverter[datamodel.DaprStateStore](dapr_ctrl.DaprStateStoresResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.DaprStateStoreResource{} })
+
 // StateStoreDataModelToVersioned converts a version-agnostic datamodel.DaprStateStore to a versioned model interface based on the
 // version string provided, or returns an error if the version is not supported.
 func StateStoreDataModelToVersioned(model *datamodel.DaprStateStore, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.DaprStateStoreResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return stateStoreConverter.ToVersioned(model, version)
 }
 
 // StateStoreDataModelFromVersioned unmarshals a JSON byte slice into a DaprStateStoreResource struct, then converts it to
 // a version-agnostic DaprStateStore struct and returns it, or an error if the version is unsupported.
 func StateStoreDataModelFromVersioned(content []byte, version string) (*datamodel.DaprStateStore, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.DaprStateStoreResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-
-		return dm.(*datamodel.DaprStateStore), nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return stateStoreConverter.FromVersioned(content, version)
 }
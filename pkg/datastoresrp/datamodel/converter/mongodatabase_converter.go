@@ -17,44 +17,27 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/datastoresrp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 )
 
+// mongoDatabaseConverter converts Mongo databases between the datamodel and the versioned model of each API version.
+var mongoDatabaseConverter = apiversions.NewConverter[datamodel.MongoDatabase](ds_ctrl.MongoDatabasesResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.MongoDatabaseResource{} })
+
 // MongoDatabaseDataModelToVersioned converts a Mongo database data model to a versioned model interface based on the
 // specified version, and returns an error if the version is not supported.
 func MongoDatabaseDataModelToVersioned(model *datamodel.MongoDatabase, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.MongoDatabaseResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return mongoDatabaseConverter.ToVersioned(model, version)
 }
 
 // MongoDatabaseDataModelFromVersioned takes in a byte slice and a version string and returns a Mongo database instance and
 // an error if the version is unsupported.
 func MongoDatabaseDataModelFromVersioned(content []byte, version string) (*datamodel.MongoDatabase, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.MongoDatabaseResource{}
-		if err := json.Unmarshal(content, versioned); err != nil {
-			return nil, err
-		}
-		dm, err := versioned.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-		return dm.(*datamodel.MongoDatabase), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return mongoDatabaseConverter.FromVersioned(content, version)
 }
 
 // MongoDatabaseSecretsDataModelFromVersioned converts version agnostic MongoDatabaseSecrets datamodel to versioned model.
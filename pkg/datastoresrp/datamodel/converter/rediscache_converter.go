@@ -17,49 +17,27 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/datastoresrp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 )
 
+// redisCacheConverter converts Redis caches between the datamodel and the versioned model of each API version.
+var redisCacheConverter = apiversions.NewConverter[datamodel.RedisCache](ds_ctrl.RedisCachesResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.RedisCacheResource{} })
+
 // RedisCacheDataModelToVersioned converts a Redis cache data model to a versioned model interface and returns an error if
 // the conversion fails.
 func RedisCacheDataModelToVersioned(model *datamodel.RedisCache, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.RedisCacheResource{}
-		err := versioned.ConvertFrom(model)
-		if err != nil {
-			return nil, err
-		}
-
-		return versioned, nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return redisCacheConverter.ToVersioned(model, version)
 }
 
 // RedisCacheDataModelFromVersioned converts a versioned Redis cache resource to a datamodel.RedisCache and returns an error
 // if the conversion fails.
 func RedisCacheDataModelFromVersioned(content []byte, version string) (*datamodel.RedisCache, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.RedisCacheResource{}
-		if err := json.Unmarshal(content, versioned); err != nil {
-			return nil, err
-		}
-		dm, err := versioned.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-		return dm.(*datamodel.RedisCache), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return redisCacheConverter.FromVersioned(content, version)
 }
 
 // RedisCacheSecretsDataModelToVersioned takes in a pointer to a RedisCacheSecrets datamodel and a version string, and
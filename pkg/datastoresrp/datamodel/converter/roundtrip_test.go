@@ -0,0 +1,32 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+)
+
+func TestRoundTrip(t *testing.T) {
+	rpctest.RunRoundTripTests(t, "../../api", map[string][]string{
+		ds_ctrl.MongoDatabasesResourceType: {"mongodatabaseresource.json", "mongodatabaseresource_recipe.json"},
+		ds_ctrl.RedisCachesResourceType:    {"rediscacheresource_manual.json", "rediscacheresource_recipe_named.json"},
+		ds_ctrl.SqlDatabasesResourceType:   {"sqldatabase_manual_resource.json", "sqldatabase_recipe_resource.json"},
+	})
+}
@@ -17,45 +17,27 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/datastoresrp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 )
 
+// sqlDatabaseConverter converts SQL databases between the datamodel and the versioned model of each API version.
+var sqlDatabaseConverter = apiversions.NewConverter[datamodel.SqlDatabase](ds_ctrl.SqlDatabasesResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.SQLDatabaseResource{} })
+
 // SqlDatabaseDataModelToVersioned converts a SqlDatabase data model to a VersionedModelInterface based on the specified
 // version, returning an error if the version is unsupported.
 func SqlDatabaseDataModelToVersioned(model *datamodel.SqlDatabase, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.SQLDatabaseResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return sqlDatabaseConverter.ToVersioned(model, version)
 }
 
 // SqlDatabaseDataModelFromVersioned takes in a byte slice and a version string and returns a SqlDatabase object and an
 // error if one occurs.
 func SqlDatabaseDataModelFromVersioned(content []byte, version string) (*datamodel.SqlDatabase, error) {
-	switch version {
-	case v20231001preview.Version:
-		am := &v20231001preview.SQLDatabaseResource{}
-		if err := json.Unmarshal(content, am); err != nil {
-			return nil, err
-		}
-		dm, err := am.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-		return dm.(*datamodel.SqlDatabase), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return sqlDatabaseConverter.FromVersioned(content, version)
 }
 
 // This function converts a SqlDatabaseSecretsDataModel to a VersionedModelInterface based on the version provided, and
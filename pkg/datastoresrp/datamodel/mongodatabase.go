@@ -134,3 +134,12 @@ func (mongoSecrets *MongoDatabaseSecrets) ResourceTypeName() string {
 func (r *MongoDatabase) ResourceTypeName() string {
 	return ds_ctrl.MongoDatabasesResourceType
 }
+
+// WriteOnlyProperties returns the secrets of the Mongo database, which are not returned by the API. Secrets of resources
+// provisioned by a recipe are outputs of the recipe, so they are not returned.
+func (r *MongoDatabase) WriteOnlyProperties() map[string]any {
+	if r.Properties.ResourceProvisioning != portableresources.ResourceProvisioningManual || r.Properties.Secrets.IsEmpty() {
+		return nil
+	}
+	return map[string]any{"secrets": r.Properties.Secrets}
+}
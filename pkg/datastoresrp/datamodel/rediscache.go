@@ -59,6 +59,15 @@ func (r *RedisCache) ResourceTypeName() string {
 	return ds_ctrl.RedisCachesResourceType
 }
 
+// WriteOnlyProperties returns the secrets of the Redis cache, which are not returned by the API. Secrets of resources
+// provisioned by a recipe are outputs of the recipe, so they are not returned.
+func (r *RedisCache) WriteOnlyProperties() map[string]any {
+	if r.Properties.ResourceProvisioning != portableresources.ResourceProvisioningManual || r.Properties.Secrets.IsEmpty() {
+		return nil
+	}
+	return map[string]any{"secrets": r.Properties.Secrets}
+}
+
 // Recipe returns the ResourceRecipe from the Redis cache Properties if ResourceProvisioning is not set to Manual,
 // otherwise it returns nil.
 func (r *RedisCache) Recipe() *portableresources.ResourceRecipe {
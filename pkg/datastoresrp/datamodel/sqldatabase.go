@@ -68,6 +68,15 @@ func (r *SqlDatabase) ResourceTypeName() string {
 	return ds_ctrl.SqlDatabasesResourceType
 }
 
+// WriteOnlyProperties returns the secrets of the SQL database, which are not returned by the API. Secrets of resources
+// provisioned by a recipe are outputs of the recipe, so they are not returned.
+func (r *SqlDatabase) WriteOnlyProperties() map[string]any {
+	if r.Properties.ResourceProvisioning != portableresources.ResourceProvisioningManual || r.Properties.Secrets.IsEmpty() {
+		return nil
+	}
+	return map[string]any{"secrets": r.Properties.Secrets}
+}
+
 // SqlDatabaseProperties represents the properties of SQL database resource.
 type SqlDatabaseProperties struct {
 	rpv1.BasicResourceProperties
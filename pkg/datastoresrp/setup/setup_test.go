@@ -33,6 +33,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/swagger"
 )
 
 var handlerTests = []rpctest.HandlerTestSpec{
@@ -144,3 +145,13 @@ func TestRouter(t *testing.T) {
 		return r, nsBuilder.ApplyAPIHandlers(ctx, r, apictrl.Options{PathBase: "/api.ucp.dev", DataProvider: mockSP}, validator)
 	})
 }
+
+func TestOpenAPIDocument(t *testing.T) {
+	ns := SetupNamespace(&controllerconfig.RecipeControllerConfig{})
+	nsBuilder := ns.GenerateBuilder()
+
+	doc, err := nsBuilder.OpenAPIDocument()
+	require.NoError(t, err)
+
+	rpctest.AssertOpenAPIDocument(t, doc, swagger.SpecFiles, "specification/applications/resource-manager/Applications.Datastores/preview/2023-10-01-preview/openapi.json")
+}
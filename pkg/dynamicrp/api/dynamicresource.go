@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// DynamicResource is the versioned model of a resource of a user-defined resource type. Every API version of a
+// user-defined resource type has the same shape and differs only in the schema of its properties, which is validated by
+// the frontend, so this model serves all the registered API versions.
+type DynamicResource struct {
+	// ID is the fully qualified resource ID of the resource.
+	ID *string `json:"id,omitempty"`
+
+	// Name is the name of the resource.
+	Name *string `json:"name,omitempty"`
+
+	// Type is the type of the resource.
+	Type *string `json:"type,omitempty"`
+
+	// Location is the geo-location where the resource lives.
+	Location *string `json:"location,omitempty"`
+
+	// Tags are the resource tags.
+	Tags map[string]*string `json:"tags,omitempty"`
+
+	// SystemData is the metadata of the creation and last modification of the resource.
+	SystemData *v1.SystemData `json:"systemData,omitempty"`
+
+	// Properties are the properties of the resource. The properties defined by the schema of the resource type are set
+	// alongside the common properties such as environment, application and recipe.
+	Properties map[string]any `json:"properties,omitempty"`
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	propertyEnvironment       = "environment"
+	propertyApplication       = "application"
+	propertyRecipe            = "recipe"
+	propertyStatus            = "status"
+	propertyProvisioningState = "provisioningState"
+)
+
+// CommonProperties are the properties which every resource of a user-defined resource type has in addition to the
+// properties defined by the schema of its resource type.
+var CommonProperties = []string{propertyEnvironment, propertyApplication, propertyRecipe, propertyStatus, propertyProvisioningState}
+
+// ConvertTo converts from the versioned resource to version-agnostic datamodel and returns it, or an error if the
+// conversion fails. The read-only properties are ignored.
+func (src *DynamicResource) ConvertTo() (v1.DataModelInterface, error) {
+	converted := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     to.String(src.Type),
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				AsyncProvisioningState: v1.ProvisioningStateAccepted,
+			},
+		},
+	}
+
+	environment, err := stringProperty(src.Properties, propertyEnvironment)
+	if err != nil {
+		return nil, err
+	}
+	if environment == "" {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.environment", ValidValue: "a non-empty string"}
+	}
+
+	application, err := stringProperty(src.Properties, propertyApplication)
+	if err != nil {
+		return nil, err
+	}
+
+	recipe, err := toRecipeDataModel(src.Properties[propertyRecipe])
+	if err != nil {
+		return nil, err
+	}
+
+	converted.Properties = datamodel.DynamicResourceProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Environment: environment,
+			Application: application,
+		},
+		ResourceRecipe: recipe,
+		Values:         UserProperties(src.Properties),
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned resource and returns an error if the conversion
+// fails. The outputs of the recipe are returned as read-only properties and the secrets are omitted.
+func (dst *DynamicResource) ConvertFrom(src v1.DataModelInterface) error {
+	resource, ok := src.(*datamodel.DynamicResource)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(resource.ID)
+	dst.Name = to.Ptr(resource.Name)
+	dst.Type = to.Ptr(resource.Type)
+	dst.SystemData = &resource.SystemData
+	dst.Location = to.Ptr(resource.Location)
+	dst.Tags = *to.StringMapPtr(resource.Tags)
+
+	properties := map[string]any{}
+	for k, v := range resource.Properties.Values {
+		properties[k] = v
+	}
+	for k, v := range resource.ComputedValues {
+		properties[k] = v
+	}
+
+	properties[propertyEnvironment] = resource.Properties.Environment
+	if resource.Properties.Application != "" {
+		properties[propertyApplication] = resource.Properties.Application
+	}
+	properties[propertyRecipe] = fromRecipeDataModel(resource.Properties.ResourceRecipe)
+	properties[propertyProvisioningState] = string(resource.InternalMetadata.AsyncProvisioningState)
+	properties[propertyStatus] = fromResourceStatusDataModel(resource.Properties.Status)
+	dst.Properties = properties
+
+	return nil
+}
+
+// UserProperties returns the properties which are defined by the schema of the resource type, i.e. all the properties
+// except the common properties of the resource.
+func UserProperties(properties map[string]any) map[string]any {
+	values := map[string]any{}
+	for k, v := range properties {
+		common := false
+		for _, name := range CommonProperties {
+			if k == name {
+				common = true
+				break
+			}
+		}
+		if !common {
+			values[k] = v
+		}
+	}
+
+	return values
+}
+
+func stringProperty(properties map[string]any, name string) (string, error) {
+	value, ok := properties[name]
+	if !ok || value == nil {
+		return "", nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", &v1.ErrModelConversion{PropertyName: "$.properties." + name, ValidValue: "a string"}
+	}
+
+	return s, nil
+}
+
+func toRecipeDataModel(value any) (portableresources.ResourceRecipe, error) {
+	recipe := portableresources.ResourceRecipe{
+		Name: portableresources.DefaultRecipeName,
+	}
+	if value == nil {
+		return recipe, nil
+	}
+
+	r, ok := value.(map[string]any)
+	if !ok {
+		return recipe, &v1.ErrModelConversion{PropertyName: "$.properties.recipe", ValidValue: "an object"}
+	}
+
+	name, err := stringProperty(r, "name")
+	if err != nil {
+		return recipe, &v1.ErrModelConversion{PropertyName: "$.properties.recipe.name", ValidValue: "a string"}
+	}
+	if name != "" {
+		recipe.Name = name
+	}
+
+	if parameters, ok := r["parameters"]; ok && parameters != nil {
+		recipe.Parameters, ok = parameters.(map[string]any)
+		if !ok {
+			return recipe, &v1.ErrModelConversion{PropertyName: "$.properties.recipe.parameters", ValidValue: "an object"}
+		}
+	}
+
+	return recipe, nil
+}
+
+func fromRecipeDataModel(r portableresources.ResourceRecipe) map[string]any {
+	recipe := map[string]any{
+		"name": r.Name,
+	}
+	if r.Parameters != nil {
+		recipe["parameters"] = r.Parameters
+	}
+
+	return recipe
+}
+
+func fromResourceStatusDataModel(status rpv1.ResourceStatus) map[string]any {
+	outputResources := []any{}
+	for _, or := range status.OutputResources {
+		r := map[string]any{
+			"id": or.ID.String(),
+		}
+
+		// We will not serialize the following fields if they are empty or nil.
+		if or.LocalID != "" {
+			r["localId"] = or.LocalID
+		}
+		if or.RadiusManaged != nil {
+			r["radiusManaged"] = *or.RadiusManaged
+		}
+
+		outputResources = append(outputResources, r)
+	}
+
+	converted := map[string]any{
+		"outputResources": outputResources,
+	}
+
+	if status.Recipe != nil {
+		recipe := map[string]any{
+			"templateKind": status.Recipe.TemplateKind,
+			"templatePath": status.Recipe.TemplatePath,
+		}
+		if status.Recipe.TemplateVersion != "" {
+			recipe["templateVersion"] = status.Recipe.TemplateVersion
+		}
+		converted["recipe"] = recipe
+	}
+
+	return converted
+}
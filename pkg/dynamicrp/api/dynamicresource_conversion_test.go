@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	testResourceID  = "/planes/radius/local/resourceGroups/testrg/providers/MyCompany.Data/kafkaTopics/topic0"
+	testEnvironment = "/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/environments/env0"
+	testApplication = "/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/applications/app0"
+)
+
+func TestDynamicResource_ConvertVersionedToDataModel(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]any
+		expected   datamodel.DynamicResourceProperties
+		err        error
+	}{
+		{
+			name: "default recipe",
+			properties: map[string]any{
+				"environment":       testEnvironment,
+				"application":       testApplication,
+				"partitions":        float64(3),
+				"provisioningState": "Succeeded",
+				"status":            map[string]any{},
+			},
+			expected: datamodel.DynamicResourceProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Environment: testEnvironment,
+					Application: testApplication,
+				},
+				ResourceRecipe: portableresources.ResourceRecipe{Name: portableresources.DefaultRecipeName},
+				Values:         map[string]any{"partitions": float64(3)},
+			},
+		},
+		{
+			name: "named recipe with parameters",
+			properties: map[string]any{
+				"environment": testEnvironment,
+				"recipe": map[string]any{
+					"name":       "kafka",
+					"parameters": map[string]any{"replicas": float64(2)},
+				},
+			},
+			expected: datamodel.DynamicResourceProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Environment: testEnvironment,
+				},
+				ResourceRecipe: portableresources.ResourceRecipe{
+					Name:       "kafka",
+					Parameters: map[string]any{"replicas": float64(2)},
+				},
+				Values: map[string]any{},
+			},
+		},
+		{
+			name:       "missing environment",
+			properties: map[string]any{"partitions": float64(3)},
+			err:        &v1.ErrModelConversion{PropertyName: "$.properties.environment", ValidValue: "a non-empty string"},
+		},
+		{
+			name:       "invalid application",
+			properties: map[string]any{"environment": testEnvironment, "application": true},
+			err:        &v1.ErrModelConversion{PropertyName: "$.properties.application", ValidValue: "a string"},
+		},
+		{
+			name:       "invalid recipe",
+			properties: map[string]any{"environment": testEnvironment, "recipe": "kafka"},
+			err:        &v1.ErrModelConversion{PropertyName: "$.properties.recipe", ValidValue: "an object"},
+		},
+		{
+			name: "invalid recipe parameters",
+			properties: map[string]any{
+				"environment": testEnvironment,
+				"recipe":      map[string]any{"parameters": "replicas"},
+			},
+			err: &v1.ErrModelConversion{PropertyName: "$.properties.recipe.parameters", ValidValue: "an object"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versioned := &DynamicResource{
+				ID:         to.Ptr(testResourceID),
+				Name:       to.Ptr("topic0"),
+				Type:       to.Ptr("MyCompany.Data/kafkaTopics"),
+				Location:   to.Ptr(v1.LocationGlobal),
+				Properties: tt.properties,
+			}
+
+			dm, err := versioned.ConvertTo()
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+
+			resource := dm.(*datamodel.DynamicResource)
+			require.Equal(t, testResourceID, resource.ID)
+			require.Equal(t, "MyCompany.Data/kafkaTopics", resource.Type)
+			require.Equal(t, v1.ProvisioningStateAccepted, resource.InternalMetadata.AsyncProvisioningState)
+			require.Equal(t, tt.expected, resource.Properties)
+		})
+	}
+}
+
+func TestDynamicResource_ConvertDataModelToVersioned(t *testing.T) {
+	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       testResourceID,
+				Name:     "topic0",
+				Type:     "MyCompany.Data/kafkaTopics",
+				Location: v1.LocationGlobal,
+			},
+			InternalMetadata: v1.InternalMetadata{
+				AsyncProvisioningState: v1.ProvisioningStateSucceeded,
+			},
+		},
+		Properties: datamodel.DynamicResourceProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Environment: testEnvironment,
+				Status: rpv1.ResourceStatus{
+					OutputResources: []rpv1.OutputResource{
+						{
+							ID:            resources.MustParse("/planes/kubernetes/local/namespaces/default/providers/core/Secret/topic0"),
+							RadiusManaged: to.Ptr(true),
+						},
+					},
+				},
+			},
+			ResourceRecipe: portableresources.ResourceRecipe{Name: portableresources.DefaultRecipeName},
+			Values:         map[string]any{"partitions": float64(3)},
+		},
+	}
+	resource.ComputedValues = map[string]any{"host": "kafka.default.svc"}
+	resource.SecretValues = map[string]rpv1.SecretValueReference{"password": {Value: "secret"}}
+
+	versioned := &DynamicResource{}
+	err := versioned.ConvertFrom(resource)
+	require.NoError(t, err)
+
+	require.Equal(t, testResourceID, *versioned.ID)
+	require.Equal(t, "MyCompany.Data/kafkaTopics", *versioned.Type)
+	require.Equal(t, map[string]any{
+		"environment":       testEnvironment,
+		"partitions":        float64(3),
+		"host":              "kafka.default.svc",
+		"recipe":            map[string]any{"name": portableresources.DefaultRecipeName},
+		"provisioningState": "Succeeded",
+		"status": map[string]any{
+			"outputResources": []any{
+				map[string]any{
+					"id":            "/planes/kubernetes/local/namespaces/default/providers/core/Secret/topic0",
+					"radiusManaged": true,
+				},
+			},
+		},
+	}, versioned.Properties)
+}
+
+func TestDynamicResource_ConvertFromInvalidModel(t *testing.T) {
+	versioned := &DynamicResource{}
+	err := versioned.ConvertFrom(&fakeResource{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
+
+type fakeResource struct{}
+
+func (f *fakeResource) ResourceTypeName() string {
+	return "FakeResource"
+}
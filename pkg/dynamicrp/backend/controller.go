@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/processors"
+	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_proc "github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+)
+
+var _ ctrl.Controller = (*DynamicResourceController)(nil)
+
+// DynamicResourceController is the async operation controller for the resources of user-defined resource types. The
+// resource types are registered at runtime, so the controller is registered as the default controller of the worker
+// and dispatches each operation to the portable resource controller for its method.
+type DynamicResourceController struct {
+	ctrl.BaseController
+
+	createOrUpdate ctrl.Controller
+	delete         ctrl.Controller
+}
+
+// NewDynamicResourceController creates a new DynamicResourceController which provisions resources with recipes and
+// processes the recipe outputs with the given processor.
+func NewDynamicResourceController(opts ctrl.Options, processor *processors.Processor, eng engine.Engine, client pr_proc.ResourceClient, configurationLoader configloader.ConfigurationLoader) (ctrl.Controller, error) {
+	createOrUpdate, err := pr_ctrl.NewCreateOrUpdateResource[*datamodel.DynamicResource, datamodel.DynamicResource](opts, processor, eng, client, configurationLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteResource, err := pr_ctrl.NewDeleteResource[*datamodel.DynamicResource, datamodel.DynamicResource](opts, processor, eng, configurationLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DynamicResourceController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		createOrUpdate: createOrUpdate,
+		delete:         deleteResource,
+	}, nil
+}
+
+// Run runs the controller for the method of the operation. It returns an error if the method is not supported.
+func (c *DynamicResourceController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	operationType, _ := v1.ParseOperationType(request.OperationType)
+	switch operationType.Method {
+	case v1.OperationPut, v1.OperationPatch:
+		return c.createOrUpdate.Run(ctx, request)
+	case v1.OperationDelete:
+		return c.delete.Run(ctx, request)
+	default:
+		return ctrl.Result{}, fmt.Errorf("unsupported operation type %q for resource %q", request.OperationType, request.ResourceID)
+	}
+}
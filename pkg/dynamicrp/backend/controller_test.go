@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+)
+
+type testController struct {
+	ctrl.BaseController
+	method string
+}
+
+func (c *testController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	return ctrl.NewFailedResult(v1.ErrorDetails{Code: c.method}), nil
+}
+
+func Test_DynamicResourceController_Run(t *testing.T) {
+	c := &DynamicResourceController{
+		createOrUpdate: &testController{method: "createOrUpdate"},
+		delete:         &testController{method: "delete"},
+	}
+
+	tests := []struct {
+		operationType string
+		expected      string
+		err           string
+	}{
+		{operationType: "MYCOMPANY.DATA/KAFKATOPICS|PUT", expected: "createOrUpdate"},
+		{operationType: "MYCOMPANY.DATA/KAFKATOPICS|PATCH", expected: "createOrUpdate"},
+		{operationType: "MYCOMPANY.DATA/KAFKATOPICS|DELETE", expected: "delete"},
+		{operationType: "MYCOMPANY.DATA/KAFKATOPICS|GET", err: `unsupported operation type "MYCOMPANY.DATA/KAFKATOPICS|GET" for resource "topic0"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.operationType, func(t *testing.T) {
+			result, err := c.Run(context.Background(), &ctrl.Request{OperationType: tt.operationType, ResourceID: "topic0"})
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"encoding/json"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/dynamicrp/api"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+)
+
+// DynamicResourceDataModelToVersioned converts a version-agnostic datamodel.DynamicResource to a versioned model
+// interface. Every registered API version of a user-defined resource type uses the same versioned model, so the version
+// is not checked here. The frontend rejects the API versions that are not registered.
+func DynamicResourceDataModelToVersioned(model *datamodel.DynamicResource, version string) (v1.VersionedModelInterface, error) {
+	versioned := &api.DynamicResource{}
+	err := versioned.ConvertFrom(model)
+	return versioned, err
+}
+
+// DynamicResourceDataModelFromVersioned takes in a byte slice and a version string and returns a version-agnostic
+// DynamicResource datamodel, or an error if the content can't be converted.
+func DynamicResourceDataModelFromVersioned(content []byte, version string) (*datamodel.DynamicResource, error) {
+	versioned := &api.DynamicResource{}
+	if err := json.Unmarshal(content, versioned); err != nil {
+		return nil, err
+	}
+
+	dm, err := versioned.ConvertTo()
+	if err != nil {
+		return nil, err
+	}
+
+	converted := dm.(*datamodel.DynamicResource)
+	converted.UpdatedAPIVersion = version
+	return converted, nil
+}
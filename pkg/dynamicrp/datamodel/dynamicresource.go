@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/portableresources"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// DynamicResource represents a resource of a user-defined resource type. The resource type is registered through UCP,
+// so the properties defined by its schema are stored generically and the resource is provisioned by a recipe.
+type DynamicResource struct {
+	v1.BaseResource
+
+	// Properties is the properties of the resource.
+	Properties DynamicResourceProperties `json:"properties"`
+
+	// PortableResourceMetadata represents internal DataModel properties common to all portable resource types.
+	// ComputedValues stores the outputs and SecretValues stores the secrets of the recipe of the resource.
+	pr_dm.PortableResourceMetadata
+}
+
+// ApplyDeploymentOutput updates the Status of Properties of the resource with the DeployedOutputResources and returns no error.
+func (r *DynamicResource) ApplyDeploymentOutput(do rpv1.DeploymentOutput) error {
+	r.Properties.Status.OutputResources = do.DeployedOutputResources
+	return nil
+}
+
+// OutputResources returns the OutputResources of the resource.
+func (r *DynamicResource) OutputResources() []rpv1.OutputResource {
+	return r.Properties.Status.OutputResources
+}
+
+// ResourceMetadata returns the BasicResourceProperties of the resource.
+func (r *DynamicResource) ResourceMetadata() *rpv1.BasicResourceProperties {
+	return &r.Properties.BasicResourceProperties
+}
+
+// ResourceTypeName returns the resource type of the resource, which is the type of its ID.
+func (r *DynamicResource) ResourceTypeName() string {
+	return r.Type
+}
+
+// Recipe returns the ResourceRecipe of the resource. Resources of user-defined resource types are always provisioned by
+// recipes.
+func (r *DynamicResource) Recipe() *portableresources.ResourceRecipe {
+	return &r.Properties.ResourceRecipe
+}
+
+// DynamicResourceProperties represents the properties of a resource of a user-defined resource type.
+type DynamicResourceProperties struct {
+	rpv1.BasicResourceProperties
+
+	// ResourceRecipe is the recipe used to provision the resource.
+	ResourceRecipe portableresources.ResourceRecipe `json:"recipe,omitempty"`
+
+	// Values are the properties of the resource defined by the schema of the resource type.
+	Values map[string]any `json:"values,omitempty"`
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+)
+
+var _ ctrl.Controller = (*ListSecrets)(nil)
+
+// ListSecrets is the controller implementation to list the secrets of a resource of a user-defined resource type.
+type ListSecrets struct {
+	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+}
+
+// NewListSecrets creates a new instance of ListSecrets.
+func NewListSecrets(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListSecrets{
+		Operation: ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.DynamicResource]{
+				RequestConverter:  converter.DynamicResourceDataModelFromVersioned,
+				ResponseConverter: converter.DynamicResourceDataModelToVersioned,
+			}),
+	}, nil
+}
+
+// Run returns the secrets of the specified resource, which are the secrets output by its recipe.
+func (ctrl *ListSecrets) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	sCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for listsecrets has name of the operation as suffix which should be removed to get the resource id.
+	// route id format: /planes/radius/<plane>/resourceGroups/<resource_group>/providers/<namespace>/<type>/<resource_name>/listsecrets
+	parsedResourceID := sCtx.ResourceID.Truncate()
+	resource, _, err := ctrl.GetResource(ctx, parsedResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource == nil {
+		return rest.NewNotFoundResponse(sCtx.ResourceID), nil
+	}
+
+	secrets := map[string]string{}
+	for key, secret := range resource.SecretValues {
+		secrets[key] = secret.Value
+	}
+
+	return rest.NewOKResponse(secrets), nil
+}
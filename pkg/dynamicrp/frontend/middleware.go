@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/registry"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+type resourceTypeKey struct{}
+
+// ResourceTypeFromContext returns the registration of the resource type of the request, or nil if the request was not
+// processed by ResolveResourceType.
+func ResourceTypeFromContext(ctx context.Context) *ucp_dm.ResourceType {
+	resourceType, _ := ctx.Value(resourceTypeKey{}).(*ucp_dm.ResourceType)
+	return resourceType
+}
+
+// WithResourceType returns a copy of ctx which carries the registration of the resource type of the request.
+func WithResourceType(ctx context.Context, resourceType *ucp_dm.ResourceType) context.Context {
+	return context.WithValue(ctx, resourceTypeKey{}, resourceType)
+}
+
+// ResolveResourceType is the middleware to look up the registration of the user-defined resource type of the request.
+// It responds with 404 Not Found if the resource type is not registered and with 400 Bad Request if the API version of
+// the request is not registered for the resource type. Otherwise, the registration is added to the request context.
+func ResolveResourceType(registry *registry.Registry) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+			resourceType, err := registry.Get(ctx, serviceCtx.ResourceID)
+			if err != nil {
+				server.HandleError(ctx, w, r, err)
+				return
+			}
+
+			var response rest.Response
+			qualifiedType := QualifiedType(serviceCtx.ResourceID)
+			if resourceType == nil {
+				namespace := serviceCtx.ResourceID.ProviderNamespace()
+				response = rest.NewNotFoundAPIVersionResponse(strings.TrimPrefix(qualifiedType, namespace+"/"), namespace, serviceCtx.APIVersion)
+			} else if _, ok := resourceType.APIVersions[serviceCtx.APIVersion]; !ok {
+				response = unsupportedAPIVersionResponse(serviceCtx.APIVersion, qualifiedType, resourceType)
+			}
+
+			if response != nil {
+				if err := response.Apply(ctx, w, r); err != nil {
+					server.HandleError(ctx, w, r, err)
+				}
+				return
+			}
+
+			h.ServeHTTP(w, r.WithContext(WithResourceType(ctx, resourceType)))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// QualifiedType returns the fully qualified type of the resource for the given resource, resource collection or
+// resource action ID, e.g. 'MyCompany.Data/kafkaTopics'.
+func QualifiedType(id resources.ID) string {
+	segments := id.TypeSegments()
+	if len(segments) == 0 {
+		return ""
+	}
+
+	return segments[0].Type
+}
+
+func unsupportedAPIVersionResponse(apiVersion, qualifiedType string, resourceType *ucp_dm.ResourceType) rest.Response {
+	versions := []string{}
+	for version := range resourceType.APIVersions {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+
+	return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+		Error: v1.ErrorDetails{
+			Code:    v1.CodeInvalidApiVersionParameter,
+			Message: fmt.Sprintf("API version '%s' for type '%s' is not supported. The supported api-versions are '%s'.", apiVersion, qualifiedType, strings.Join(versions, ", ")),
+		},
+	})
+}
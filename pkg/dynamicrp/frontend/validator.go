@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/validator"
+)
+
+// ValidateRequest validates the properties of the resource against the schema registered for the API version of the
+// request. The outputs of the resource type are read-only, so they are removed from the properties before validation.
+func ValidateRequest(ctx context.Context, newResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resourceType := ResourceTypeFromContext(ctx)
+	if resourceType == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	for _, output := range resourceType.Outputs {
+		for k := range newResource.Properties.Values {
+			if strings.EqualFold(k, output) {
+				delete(newResource.Properties.Values, k)
+			}
+		}
+	}
+
+	schemaValidator, err := validator.NewSchemaValidator(resourceType.APIVersions[serviceCtx.APIVersion].Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	values := newResource.Properties.Values
+	if values == nil {
+		values = map[string]any{}
+	}
+
+	errs := schemaValidator.Validate(values)
+	if len(errs) == 0 {
+		return nil, nil
+	}
+
+	details := []v1.ErrorDetails{}
+	for _, e := range errs {
+		details = append(details, v1.ErrorDetails{Code: e.Code, Message: e.Message})
+	}
+
+	return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+		Error: v1.ErrorDetails{
+			Code:    v1.CodeHTTPRequestPayloadAPISpecValidationFailed,
+			Target:  QualifiedType(serviceCtx.ResourceID),
+			Message: "HTTP request payload failed validation against API specification with one or more errors. Please see details for more information.",
+			Details: details,
+		},
+	}), nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+func TestValidateRequest(t *testing.T) {
+	resourceType := &ucp_dm.ResourceType{
+		Name: "kafkaTopics",
+		APIVersions: map[string]ucp_dm.ResourceTypeAPIVersion{
+			"2023-10-01-preview": {
+				Schema: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"partitions": map[string]any{"type": "integer"},
+					},
+					"required":             []any{"partitions"},
+					"additionalProperties": false,
+				},
+			},
+		},
+		Outputs: []string{"host"},
+	}
+
+	tests := []struct {
+		name         string
+		resourceType *ucp_dm.ResourceType
+		values       map[string]any
+		statusCode   int
+		expected     map[string]any
+	}{
+		{
+			name:         "valid properties",
+			resourceType: resourceType,
+			values:       map[string]any{"partitions": 3},
+			expected:     map[string]any{"partitions": 3},
+		},
+		{
+			name:         "outputs are removed",
+			resourceType: resourceType,
+			values:       map[string]any{"partitions": 3, "Host": "kafka.default.svc"},
+			expected:     map[string]any{"partitions": 3},
+		},
+		{
+			name:         "invalid properties",
+			resourceType: resourceType,
+			values:       map[string]any{"partitions": "three"},
+			statusCode:   http.StatusBadRequest,
+		},
+		{
+			name:         "missing required properties",
+			resourceType: resourceType,
+			statusCode:   http.StatusBadRequest,
+		},
+		{
+			name:       "unregistered resource type",
+			values:     map[string]any{"partitions": 3},
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			ctx = v1.WithARMRequestContext(ctx, &v1.ARMRequestContext{
+				ResourceID: resources.MustParse("/planes/radius/local/resourceGroups/testrg/providers/MyCompany.Data/kafkaTopics/topic0"),
+				APIVersion: "2023-10-01-preview",
+			})
+			if tt.resourceType != nil {
+				ctx = WithResourceType(ctx, tt.resourceType)
+			}
+
+			resource := &datamodel.DynamicResource{
+				Properties: datamodel.DynamicResourceProperties{Values: tt.values},
+			}
+
+			resp, err := ValidateRequest(ctx, resource, nil, nil)
+			require.NoError(t, err)
+
+			if tt.statusCode == 0 {
+				require.Nil(t, resp)
+				require.Equal(t, tt.expected, resource.Properties.Values)
+				return
+			}
+
+			require.NotNil(t, resp)
+			w := httptest.NewRecorder()
+			err = resp.Apply(ctx, w, httptest.NewRequest(http.MethodPut, "/", nil))
+			require.NoError(t, err)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+			if tt.statusCode == http.StatusBadRequest {
+				badRequest := resp.(*rest.BadRequestResponse)
+				require.Equal(t, v1.CodeHTTPRequestPayloadAPISpecValidationFailed, badRequest.Body.Error.Code)
+				require.NotEmpty(t, badRequest.Body.Error.Details)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package processors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/registry"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// Processor is a processor for the resources of user-defined resource types.
+type Processor struct {
+	// Registry looks up the registration of the resource type of the resource.
+	Registry *registry.Registry
+}
+
+// Process implements the processors.Processor interface for the resources of user-defined resource types. It enforces
+// the contract of the resource type: the recipe must output every value listed in the outputs of the resource type and
+// every secret listed in its secrets. Outputs are stored as computed values and secrets as secret values of the resource.
+func (p *Processor) Process(ctx context.Context, resource *datamodel.DynamicResource, options processors.Options) error {
+	id, err := resources.ParseResource(resource.ID)
+	if err != nil {
+		return err
+	}
+
+	resourceType, err := p.Registry.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if resourceType == nil {
+		return &processors.ValidationError{Message: fmt.Sprintf("the resource type %q is not registered", id.Type())}
+	}
+
+	validator := processors.NewValidator(&resource.ComputedValues, &resource.SecretValues, &resource.Properties.Status.OutputResources, resource.Properties.Status.Recipe)
+	if err := validator.SetAndValidate(options.RecipeOutput); err != nil {
+		return err
+	}
+
+	values, secrets := map[string]any{}, map[string]any{}
+	if options.RecipeOutput != nil {
+		values, secrets = options.RecipeOutput.Values, options.RecipeOutput.Secrets
+	}
+
+	msgs := []string{}
+	for _, name := range resourceType.Outputs {
+		value, ok := values[name]
+		if !ok {
+			msgs = append(msgs, fmt.Sprintf("the output %q should be provided by the recipe", name))
+			continue
+		}
+		resource.ComputedValues[name] = value
+	}
+
+	for _, name := range resourceType.Secrets {
+		value, ok := secrets[name]
+		if !ok {
+			msgs = append(msgs, fmt.Sprintf("the secret %q should be provided by the recipe", name))
+			continue
+		}
+		secret, ok := value.(string)
+		if !ok {
+			msgs = append(msgs, fmt.Sprintf("the secret %q provided by the recipe is expected to be a string, got %T", name, value))
+			continue
+		}
+		resource.SecretValues[name] = rpv1.SecretValueReference{Value: secret}
+	}
+
+	if len(msgs) == 1 {
+		return &processors.ValidationError{Message: msgs[0]}
+	}
+
+	if len(msgs) > 0 {
+		return &processors.ValidationError{Message: fmt.Sprintf("validation returned multiple errors:\n\n%v", strings.Join(msgs, "\n"))}
+	}
+
+	return nil
+}
+
+// Delete implements the processors.Processor interface for the resources of user-defined resource types. The resources
+// created by the recipe are deleted by the recipe engine.
+func (p *Processor) Delete(ctx context.Context, resource *datamodel.DynamicResource, options processors.Options) error {
+	return nil
+}
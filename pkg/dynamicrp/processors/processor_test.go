@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package processors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/registry"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	resourceID = "/planes/radius/local/resourceGroups/test-rg/providers/MyCompany.Data/kafkaTopics/topic0"
+	providerID = "/planes/radius/local/providers/System.Resources/resourceProviders/MyCompany.Data"
+	azureID    = "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.EventHub/namespaces/test-ns"
+)
+
+func newTestProcessor(t *testing.T, provider *ucp_dm.ResourceProvider) *Processor {
+	mctrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(mctrl)
+	if provider == nil {
+		storageClient.EXPECT().Get(gomock.Any(), providerID).Return(nil, &store.ErrNotFound{ID: providerID})
+	} else {
+		storageClient.EXPECT().Get(gomock.Any(), providerID).Return(&store.Object{Data: provider}, nil)
+	}
+
+	return &Processor{Registry: registry.NewRegistry(storageClient)}
+}
+
+func newTestResource() *datamodel.DynamicResource {
+	return &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   resourceID,
+				Type: "MyCompany.Data/kafkaTopics",
+			},
+		},
+		Properties: datamodel.DynamicResourceProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Status: rpv1.ResourceStatus{Recipe: &rpv1.RecipeStatus{}},
+			},
+		},
+	}
+}
+
+func Test_Process(t *testing.T) {
+	provider := &ucp_dm.ResourceProvider{
+		Properties: ucp_dm.ResourceProviderProperties{
+			ResourceTypes: []ucp_dm.ResourceType{
+				{
+					Name:    "kafkaTopics",
+					Outputs: []string{"topic", "partitions"},
+					Secrets: []string{"connectionString"},
+				},
+			},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		processor := newTestProcessor(t, provider)
+		resource := newTestResource()
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Resources: []string{azureID},
+				Values: map[string]any{
+					"topic":      "topic0",
+					"partitions": float64(3),
+					"unlisted":   "ignored",
+				},
+				Secrets: map[string]any{
+					"connectionString": "Endpoint=sb://test-ns",
+				},
+				Status: &rpv1.RecipeStatus{TemplateKind: recipes.TemplateKindBicep, TemplatePath: "registry/kafka:1.0"},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]any{"topic": "topic0", "partitions": float64(3)}, resource.ComputedValues)
+		require.Equal(t, map[string]rpv1.SecretValueReference{"connectionString": {Value: "Endpoint=sb://test-ns"}}, resource.SecretValues)
+
+		expectedOutputResources, err := processors.GetOutputResourcesFromRecipe(options.RecipeOutput)
+		require.NoError(t, err)
+		require.Equal(t, expectedOutputResources, resource.Properties.Status.OutputResources)
+		require.Equal(t, options.RecipeOutput.Status, resource.Properties.Status.Recipe)
+	})
+
+	t.Run("missing outputs and secrets", func(t *testing.T) {
+		processor := newTestProcessor(t, provider)
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Values: map[string]any{
+					"topic": "topic0",
+				},
+				Secrets: map[string]any{
+					"connectionString": 123,
+				},
+			},
+		}
+
+		err := processor.Process(context.Background(), newTestResource(), options)
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, `validation returned multiple errors:
+
+the output "partitions" should be provided by the recipe
+the secret "connectionString" provided by the recipe is expected to be a string, got int`, err.Error())
+	})
+
+	t.Run("unregistered resource type", func(t *testing.T) {
+		processor := newTestProcessor(t, nil)
+
+		err := processor.Process(context.Background(), newTestResource(), processors.Options{RecipeOutput: &recipes.RecipeOutput{}})
+		require.Error(t, err)
+		require.Equal(t, `the resource type "MyCompany.Data/kafkaTopics" is not registered`, err.Error())
+	})
+}
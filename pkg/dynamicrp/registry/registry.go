@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// Registry looks up the user-defined resource types registered through UCP. The registrations are stored as resource
+// provider resources in the storage shared with UCP.
+type Registry struct {
+	storageClient store.StorageClient
+}
+
+// NewRegistry creates a new Registry which reads the registrations using the given storage client.
+func NewRegistry(storageClient store.StorageClient) *Registry {
+	return &Registry{storageClient: storageClient}
+}
+
+// Get returns the registration of the resource type of the given resource or resource collection ID. It returns nil
+// if the resource type is not registered.
+func (r *Registry) Get(ctx context.Context, id resources.ID) (*ucp_dm.ResourceType, error) {
+	segments := id.TypeSegments()
+	if len(segments) == 0 {
+		return nil, nil
+	}
+
+	_, typeName, found := strings.Cut(segments[0].Type, "/")
+	if !found {
+		return nil, nil
+	}
+
+	obj, err := r.storageClient.Get(ctx, ucp_dm.ResourceProviderID(id))
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	provider := &ucp_dm.ResourceProvider{}
+	if err := obj.As(provider); err != nil {
+		return nil, err
+	}
+
+	return provider.ResourceType(typeName), nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const providerID = "/planes/radius/local/providers/System.Resources/resourceProviders/MyCompany.Data"
+
+func Test_Registry_Get(t *testing.T) {
+	provider := &ucp_dm.ResourceProvider{
+		Properties: ucp_dm.ResourceProviderProperties{
+			ResourceTypes: []ucp_dm.ResourceType{
+				{
+					Name: "kafkaTopics",
+					APIVersions: map[string]ucp_dm.ResourceTypeAPIVersion{
+						"2023-10-01-preview": {Schema: map[string]any{"type": "object"}},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		id       string
+		expected string
+	}{
+		{
+			name:     "resource",
+			id:       "/planes/radius/local/resourceGroups/test-rg/providers/MyCompany.Data/kafkaTopics/topic0",
+			expected: "kafkaTopics",
+		},
+		{
+			name:     "resource collection with different casing",
+			id:       "/planes/radius/local/resourcegroups/test-rg/providers/MyCompany.Data/KAFKATOPICS",
+			expected: "kafkaTopics",
+		},
+		{
+			name:     "plane scoped resource collection",
+			id:       "/planes/radius/local/providers/MyCompany.Data/kafkaTopics",
+			expected: "kafkaTopics",
+		},
+		{
+			name: "unregistered resource type",
+			id:   "/planes/radius/local/resourceGroups/test-rg/providers/MyCompany.Data/queues/queue0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			storageClient := store.NewMockStorageClient(mctrl)
+			storageClient.EXPECT().
+				Get(gomock.Any(), providerID).
+				Return(&store.Object{Data: provider}, nil)
+
+			resourceType, err := NewRegistry(storageClient).Get(context.Background(), resources.MustParse(tt.id))
+			require.NoError(t, err)
+			if tt.expected == "" {
+				require.Nil(t, resourceType)
+				return
+			}
+			require.Equal(t, tt.expected, resourceType.Name)
+		})
+	}
+
+	t.Run("unregistered resource provider", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		storageClient := store.NewMockStorageClient(mctrl)
+		storageClient.EXPECT().
+			Get(gomock.Any(), providerID).
+			Return(nil, &store.ErrNotFound{ID: providerID})
+
+		resourceType, err := NewRegistry(storageClient).Get(context.Background(), resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/MyCompany.Data/kafkaTopics/topic0"))
+		require.NoError(t, err)
+		require.Nil(t, resourceType)
+	})
+
+	t.Run("storage error", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		storageClient := store.NewMockStorageClient(mctrl)
+		storageClient.EXPECT().
+			Get(gomock.Any(), providerID).
+			Return(nil, errors.New("storage is unavailable"))
+
+		_, err := NewRegistry(storageClient).Get(context.Background(), resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/MyCompany.Data/kafkaTopics/topic0"))
+		require.EqualError(t, err, "storage is unavailable")
+	})
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
+	"github.com/radius-project/radius/pkg/armrpc/builder"
+	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/dynamicrp/backend"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/dynamicrp/frontend"
+	"github.com/radius-project/radius/pkg/dynamicrp/processors"
+	"github.com/radius-project/radius/pkg/dynamicrp/registry"
+	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// AsyncCreateOrUpdateTimeout is the timeout for async create or update of the resources of user-defined resource types.
+	AsyncCreateOrUpdateTimeout = time.Duration(60) * time.Minute
+
+	// AsyncDeleteTimeout is the timeout for async delete of the resources of user-defined resource types.
+	AsyncDeleteTimeout = time.Duration(30) * time.Minute
+
+	// AsyncOperationRetryAfter is polling interval for async create/update or delete resource operations.
+	AsyncOperationRetryAfter = time.Duration(5) * time.Second
+
+	resourceTypePath = "/providers/{providerNamespace}/{resourceType}"
+	resourcePath     = resourceTypePath + "/{resourceName}"
+)
+
+// Setup registers the routes and the async controller of the resource provider for the user-defined resource types.
+// Unlike the other resource providers, the resource types are not known when the server starts, so the routes match any
+// provider namespace and resource type and the registration of the resource type is looked up for each request.
+type Setup struct {
+	recipeControllerConfig *controllerconfig.RecipeControllerConfig
+}
+
+// NewSetup creates a new Setup which provisions resources with the recipe engine of the given configuration.
+func NewSetup(recipeControllerConfig *controllerconfig.RecipeControllerConfig) *Setup {
+	return &Setup{recipeControllerConfig: recipeControllerConfig}
+}
+
+type handler struct {
+	path              string
+	method            v1.OperationMethod
+	httpMethod        string
+	operationType     func(r *http.Request) string
+	controllerFactory server.ControllerFactoryFunc
+	middlewares       []func(http.Handler) http.Handler
+}
+
+// ApplyAPIHandlers registers the routes for the resources of user-defined resource types. The routes are matched after
+// the routes of the other resource providers because chi prefers static path segments over parameters.
+func (s *Setup) ApplyAPIHandlers(ctx context.Context, r chi.Router, ctrlOpts apictrl.Options) error {
+	registryClient, err := ctrlOpts.DataProvider.GetStorageClient(ctx, ucp_dm.ResourceProviderResourceType)
+	if err != nil {
+		return err
+	}
+	resolve := frontend.ResolveResourceType(registry.NewRegistry(registryClient))
+
+	// The resources of all user-defined resource types are stored using the same storage client.
+	storageClient, err := ctrlOpts.DataProvider.GetStorageClient(ctx, "")
+	if err != nil {
+		return err
+	}
+	ctrlOpts.StorageClient = storageClient
+
+	resourceOptions := apictrl.ResourceOptions[datamodel.DynamicResource]{
+		RequestConverter:  converter.DynamicResourceDataModelFromVersioned,
+		ResponseConverter: converter.DynamicResourceDataModelToVersioned,
+		UpdateFilters: []apictrl.UpdateFilter[datamodel.DynamicResource]{
+			rp_frontend.PrepareRadiusResource[*datamodel.DynamicResource],
+			frontend.ValidateRequest,
+		},
+		AsyncOperationTimeout:    AsyncCreateOrUpdateTimeout,
+		AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+	}
+
+	planeListOptions := resourceOptions
+	planeListOptions.ListRecursiveQuery = true
+
+	deleteOptions := resourceOptions
+	deleteOptions.AsyncOperationTimeout = AsyncDeleteTimeout
+
+	rootScopePath := ctrlOpts.PathBase + builder.UCPRootScopePath
+	resourceGroupPath := rootScopePath + builder.ResourceGroupPath
+	resourceType := func(r *http.Request) string {
+		return frontend.QualifiedType(v1.ARMRequestContextFromContext(r.Context()).ResourceID)
+	}
+	operationType := func(suffix string) func(r *http.Request) string {
+		return func(r *http.Request) string {
+			return v1.ARMRequestContextFromContext(r.Context()).ResourceID.ProviderNamespace() + suffix
+		}
+	}
+
+	handlers := []handler{
+		{
+			path:          rootScopePath + resourceTypePath,
+			method:        v1.OperationPlaneScopeList,
+			operationType: resourceType,
+			middlewares:   []func(http.Handler) http.Handler{resolve},
+			controllerFactory: func(opts apictrl.Options) (apictrl.Controller, error) {
+				return defaultoperation.NewListResources(opts, planeListOptions)
+			},
+		},
+		{
+			path:          resourceGroupPath + resourceTypePath,
+			method:        v1.OperationList,
+			operationType: resourceType,
+			middlewares:   []func(http.Handler) http.Handler{resolve},
+			controllerFactory: func(opts apictrl.Options) (apictrl.Controller, error) {
+				return defaultoperation.NewListResources(opts, resourceOptions)
+			},
+		},
+		{
+			path:          resourceGroupPath + resourcePath,
+			method:        v1.OperationGet,
+			operationType: resourceType,
+			middlewares:   []func(http.Handler) http.Handler{resolve},
+			controllerFactory: func(opts apictrl.Options) (apictrl.Controller, error) {
+				return defaultoperation.NewGetResource(opts, resourceOptions)
+			},
+		},
+		{
+			path:          resourceGroupPath + resourcePath,
+			method:        v1.OperationPut,
+			operationType: resourceType,
+			middlewares:   []func(http.Handler) http.Handler{resolve},
+			controllerFactory: func(opts apictrl.Options) (apictrl.Controller, error) {
+				return defaultoperation.NewDefaultAsyncPut(opts, resourceOptions)
+			},
+		},
+		{
+			path:          resourceGroupPath + resourcePath,
+			method:        v1.OperationPatch,
+			operationType: resourceType,
+			middlewares:   []func(http.Handler) http.Handler{resolve},
+			controllerFactory: func(opts apictrl.Options) (apictrl.Controller, error) {
+				return defaultoperation.NewDefaultAsyncPatch(opts, resourceOptions)
+			},
+		},
+		{
+			path:          resourceGroupPath + resourcePath,
+			method:        v1.OperationDelete,
+			operationType: resourceType,
+			middlewares:   []func(http.Handler) http.Handler{resolve},
+			controllerFactory: func(opts apictrl.Options) (apictrl.Controller, error) {
+				return defaultoperation.NewDefaultAsyncDelete(opts, deleteOptions)
+			},
+		},
+		{
+			path:              resourceGroupPath + resourcePath + "/listsecrets",
+			method:            v1.OperationMethod("ACTIONLISTSECRETS"),
+			operationType:     resourceType,
+			middlewares:       []func(http.Handler) http.Handler{resolve},
+			controllerFactory: frontend.NewListSecrets,
+		},
+		{
+			path:              rootScopePath + "/providers/{providerNamespace}/locations/{location}/operationstatuses/{operationId}",
+			method:            v1.OperationGet,
+			operationType:     operationType("/operationstatuses"),
+			controllerFactory: defaultoperation.NewGetOperationStatus,
+		},
+		{
+			path:              rootScopePath + "/providers/{providerNamespace}/locations/{location}/operationresults/{operationId}",
+			method:            v1.OperationGet,
+			operationType:     operationType("/operationresults"),
+			controllerFactory: defaultoperation.NewGetOperationResult,
+		},
+	}
+
+	for _, h := range handlers {
+		ctrl, err := h.controllerFactory(ctrlOpts)
+		if err != nil {
+			return err
+		}
+
+		r.With(h.middlewares...).MethodFunc(h.method.HTTPMethod(), h.path, handlerForController(ctrl, h.method, h.operationType))
+	}
+
+	return nil
+}
+
+// handlerForController creates a handler which runs the controller with the operation type of the request, because the
+// resource type is part of the operation type and is known only when the request is received.
+func handlerForController(ctrl apictrl.Controller, method v1.OperationMethod, operationType func(r *http.Request) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ot := v1.OperationType{Type: operationType(r), Method: method}
+		server.HandlerForController(ctrl, ot).ServeHTTP(w, r)
+	}
+}
+
+// ApplyAsyncHandler registers the async controller for the resources of user-defined resource types as the default
+// controller of the worker.
+func (s *Setup) ApplyAsyncHandler(ctx context.Context, controllers *worker.ControllerRegistry, ctrlOpts asyncctrl.Options) error {
+	registryClient, err := ctrlOpts.DataProvider.GetStorageClient(ctx, ucp_dm.ResourceProviderResourceType)
+	if err != nil {
+		return err
+	}
+	processor := &processors.Processor{Registry: registry.NewRegistry(registryClient)}
+
+	return controllers.RegisterDefault(ctx, func(opts asyncctrl.Options) (asyncctrl.Controller, error) {
+		return backend.NewDynamicResourceController(opts, processor, s.recipeControllerConfig.Engine, s.recipeControllerConfig.ResourceClient, s.recipeControllerConfig.ConfigLoader)
+	}, ctrlOpts)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	resourceTypeName = "MyCompany.Data/kafkaTopics"
+	providerID       = "/planes/radius/local/providers/System.Resources/resourceProviders/MyCompany.Data"
+)
+
+var handlerTests = []rpctest.HandlerTestSpec{
+	{
+		OperationType: v1.OperationType{Type: resourceTypeName, Method: v1.OperationPlaneScopeList},
+		Path:          "/providers/mycompany.data/kafkatopics",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: resourceTypeName, Method: v1.OperationList},
+		Path:          "/resourcegroups/testrg/providers/mycompany.data/kafkatopics",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: resourceTypeName, Method: v1.OperationGet},
+		Path:          "/resourcegroups/testrg/providers/mycompany.data/kafkatopics/topic0",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: resourceTypeName, Method: v1.OperationPut},
+		Path:          "/resourcegroups/testrg/providers/mycompany.data/kafkatopics/topic0",
+		Method:        http.MethodPut,
+	}, {
+		OperationType: v1.OperationType{Type: resourceTypeName, Method: v1.OperationPatch},
+		Path:          "/resourcegroups/testrg/providers/mycompany.data/kafkatopics/topic0",
+		Method:        http.MethodPatch,
+	}, {
+		OperationType: v1.OperationType{Type: resourceTypeName, Method: v1.OperationDelete},
+		Path:          "/resourcegroups/testrg/providers/mycompany.data/kafkatopics/topic0",
+		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: resourceTypeName, Method: "ACTIONLISTSECRETS"},
+		Path:          "/resourcegroups/testrg/providers/mycompany.data/kafkatopics/topic0/listsecrets",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: "MyCompany.Data/operationStatuses", Method: v1.OperationGet},
+		Path:          "/providers/mycompany.data/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "MyCompany.Data/operationResults", Method: v1.OperationGet},
+		Path:          "/providers/mycompany.data/locations/global/operationresults/00000000-0000-0000-0000-000000000000",
+		Method:        http.MethodGet,
+	},
+}
+
+func TestRouter(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	mockSP := dataprovider.NewMockDataStorageProvider(mctrl)
+	mockSC := store.NewMockStorageClient(mctrl)
+	mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(mockSC), nil).AnyTimes()
+
+	s := NewSetup(&controllerconfig.RecipeControllerConfig{})
+	rpctest.AssertRouters(t, handlerTests, "/api.ucp.dev", "/planes/radius/local", func(ctx context.Context) (chi.Router, error) {
+		r := chi.NewRouter()
+		return r, s.ApplyAPIHandlers(ctx, r, apictrl.Options{PathBase: "/api.ucp.dev", DataProvider: mockSP})
+	})
+}
+
+func TestRouter_ResolveResourceType(t *testing.T) {
+	provider := &ucp_dm.ResourceProvider{
+		Properties: ucp_dm.ResourceProviderProperties{
+			ResourceTypes: []ucp_dm.ResourceType{
+				{
+					Name: "kafkaTopics",
+					APIVersions: map[string]ucp_dm.ResourceTypeAPIVersion{
+						"2023-10-01-preview": {Schema: map[string]any{"type": "object"}},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		id         string
+		apiVersion string
+		provider   *ucp_dm.ResourceProvider
+		err        error
+		statusCode int
+	}{
+		{
+			name:       "unregistered resource provider",
+			id:         "/planes/radius/local/resourceGroups/testrg/providers/MyCompany.Data/kafkaTopics/topic0",
+			apiVersion: "2023-10-01-preview",
+			err:        &store.ErrNotFound{ID: providerID},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:       "unregistered resource type",
+			id:         "/planes/radius/local/resourceGroups/testrg/providers/MyCompany.Data/queues/queue0",
+			apiVersion: "2023-10-01-preview",
+			provider:   provider,
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:       "unsupported api version",
+			id:         "/planes/radius/local/resourceGroups/testrg/providers/MyCompany.Data/kafkaTopics/topic0",
+			apiVersion: "2024-01-01",
+			provider:   provider,
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "storage failure",
+			id:         "/planes/radius/local/resourceGroups/testrg/providers/MyCompany.Data/kafkaTopics/topic0",
+			apiVersion: "2023-10-01-preview",
+			err:        errors.New("storage failure"),
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+
+			mockSP := dataprovider.NewMockDataStorageProvider(mctrl)
+			mockSC := store.NewMockStorageClient(mctrl)
+			mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(mockSC), nil).AnyTimes()
+
+			var obj *store.Object
+			if tt.provider != nil {
+				obj = &store.Object{Data: tt.provider}
+			}
+			mockSC.EXPECT().Get(gomock.Any(), providerID).Return(obj, tt.err)
+
+			ctx := testcontext.New(t)
+			r := chi.NewRouter()
+			err := NewSetup(&controllerconfig.RecipeControllerConfig{}).ApplyAPIHandlers(ctx, r, apictrl.Options{DataProvider: mockSP})
+			require.NoError(t, err)
+
+			id, err := resources.ParseResource(tt.id)
+			require.NoError(t, err)
+
+			// The server lowercases the request path before routing.
+			req := httptest.NewRequest(http.MethodGet, strings.ToLower(tt.id)+"?api-version="+tt.apiVersion, nil)
+			req = req.WithContext(v1.WithARMRequestContext(ctx, &v1.ARMRequestContext{ResourceID: id, APIVersion: tt.apiVersion}))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+		})
+	}
+}
@@ -17,41 +17,27 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/messagingrp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/messagingrp/datamodel"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
 )
 
+// rabbitMQQueueConverter converts RabbitMQ queues between the datamodel and the versioned model of each API version.
+var rabbitMQQueueConverter = apiversions.NewConverter[datamodel.RabbitMQQueue](msg_ctrl.RabbitMQQueuesResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.RabbitMQQueueResource{} })
+
 // RabbitMQQueueDataModelToVersioned converts a version-agnostic datamodel.RabbitMQQueue to a versioned model interface
 // and returns an error if the version is unsupported.
 func RabbitMQQueueDataModelToVersioned(model *datamodel.RabbitMQQueue, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.RabbitMQQueueResource{}
-		err := versioned.ConvertFrom(model)
-		return versioned, err
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return rabbitMQQueueConverter.ToVersioned(model, version)
 }
 
 // RabbitMQQueueDataModelFromVersioned takes in a byte slice and a version string and returns a version-agnostic
 // RabbitMQQueue datamodel and an error if the version is unsupported.
 func RabbitMQQueueDataModelFromVersioned(content []byte, version string) (*datamodel.RabbitMQQueue, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.RabbitMQQueueResource{}
-		if err := json.Unmarshal(content, versioned); err != nil {
-			return nil, err
-		}
-		dm, err := versioned.ConvertTo()
-		return dm.(*datamodel.RabbitMQQueue), err
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return rabbitMQQueueConverter.FromVersioned(content, version)
 }
 
 // RabbitMQSecretsDataModelToVersioned converts a version-agnostic datamodel.RabbitMQSecrets to a versioned model
@@ -59,6 +59,15 @@ func (r *RabbitMQQueue) ResourceTypeName() string {
 	return msg_ctrl.RabbitMQQueuesResourceType
 }
 
+// WriteOnlyProperties returns the secrets of the RabbitMQ queue, which are not returned by the API. Secrets of resources
+// provisioned by a recipe are outputs of the recipe, so they are not returned.
+func (r *RabbitMQQueue) WriteOnlyProperties() map[string]any {
+	if r.Properties.ResourceProvisioning != portableresources.ResourceProvisioningManual || r.Properties.Secrets == (RabbitMQSecrets{}) {
+		return nil
+	}
+	return map[string]any{"secrets": r.Properties.Secrets}
+}
+
 // RabbitMQQueueProperties represents the properties of RabbitMQQueue response resource.
 type RabbitMQQueueProperties struct {
 	rpv1.BasicResourceProperties
@@ -22,6 +22,9 @@ var (
 
 	// DefaultRecipeEngineMetrics holds recipe engine metrics definitions.
 	DefaultRecipeEngineMetrics = newRecipeEngineMetrics()
+
+	// DefaultRateLimitMetrics holds rate limit metrics definitions.
+	DefaultRateLimitMetrics = newRateLimitMetrics()
 )
 
 // InitMetrics initializes metrics for Radius.
@@ -34,5 +37,9 @@ func InitMetrics() error {
 		return err
 	}
 
+	if err := DefaultRateLimitMetrics.Init(); err != nil {
+		return err
+	}
+
 	return nil
 }
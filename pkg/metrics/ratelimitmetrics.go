@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// ThrottledRequestCount is the metric name for the number of requests rejected by rate limiting.
+	ThrottledRequestCount = "ratelimit.throttled.request"
+)
+
+type rateLimitMetrics struct {
+	counters map[string]metric.Int64Counter
+}
+
+func newRateLimitMetrics() *rateLimitMetrics {
+	return &rateLimitMetrics{
+		counters: make(map[string]metric.Int64Counter),
+	}
+}
+
+// Init initializes the counters for rateLimitMetrics and returns an error if any of the initialization fails.
+func (r *rateLimitMetrics) Init() error {
+	meter := otel.GetMeterProvider().Meter("rate-limit-metrics")
+
+	var err error
+	r.counters[ThrottledRequestCount], err = meter.Int64Counter(ThrottledRequestCount)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordThrottledRequest records a request rejected by rate limiting with operation class and plane type attributes.
+// The plane type is empty unless the request is limited by the limit of its plane type.
+func (r *rateLimitMetrics) RecordThrottledRequest(ctx context.Context, operationClass string, planeType string) {
+	if r.counters[ThrottledRequestCount] != nil {
+		attrs := []attribute.KeyValue{operationClassAttrKey.String(normalizeAttrValue(operationClass))}
+		if planeType != "" {
+			attrs = append(attrs, planeTypeAttrKey.String(normalizeAttrValue(planeType)))
+		}
+		r.counters[ThrottledRequestCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
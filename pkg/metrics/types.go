@@ -33,6 +33,12 @@ const (
 	// operationErrorCodeAttrKey is the attribute name for the operation error code.
 	operationErrorCodeAttrKey = attribute.Key("operation_error_code")
 
+	// operationClassAttrKey is the attribute name for the operation class of a request, e.g. read or write.
+	operationClassAttrKey = attribute.Key("operation_class")
+
+	// planeTypeAttrKey is the attribute name for the plane type.
+	planeTypeAttrKey = attribute.Key("plane_type")
+
 	// recipeNameAttrKey is the attribute name for the recipe name.
 	recipeNameAttrKey = attribute.Key("recipe_name")
 
@@ -17,15 +17,35 @@ limitations under the License.
 package middleware
 
 import (
+	"context"
+	"net"
 	"net/http"
 )
 
-// RemoveRemoteAddr is the middleware to remove remoteaddr to avoid high cardinality in metrics.
+type remoteAddrKey struct{}
+
+// RemoveRemoteAddr is the middleware to remove remoteaddr to avoid high cardinality in metrics. The removed address is
+// kept in the request context and is returned by ClientAddress.
 // This is a temporary workaround until opentelemetry-go fixes the issue - https://github.com/open-telemetry/opentelemetry-go-contrib/issues/3765
 func RemoveRemoteAddr(next http.Handler) http.Handler {
 	fn := func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), remoteAddrKey{}, r.RemoteAddr)
 		r.RemoteAddr = ""
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(ctx))
 	}
 	return http.HandlerFunc(fn)
 }
+
+// ClientAddress returns the IP address of the client of the request, including the address removed by
+// RemoveRemoteAddr.
+func ClientAddress(r *http.Request) string {
+	addr := r.RemoteAddr
+	if addr == "" {
+		addr, _ = r.Context().Value(remoteAddrKey{}).(string)
+	}
+
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
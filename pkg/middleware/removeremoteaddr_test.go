@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientAddress(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	require.Equal(t, "10.0.0.1", ClientAddress(req))
+
+	var removed *http.Request
+	RemoveRemoteAddr(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		removed = r
+	})).ServeHTTP(httptest.NewRecorder(), req)
+	require.Empty(t, removed.RemoteAddr)
+	require.Equal(t, "10.0.0.1", ClientAddress(removed))
+
+	req.RemoteAddr = "10.0.0.2"
+	require.Equal(t, "10.0.0.2", ClientAddress(req))
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/radius-project/radius/pkg/armrpc/quotas"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
@@ -40,6 +41,9 @@ var _ Engine = (*engine)(nil)
 type Options struct {
 	ConfigurationLoader configloader.ConfigurationLoader
 	Drivers             map[string]recipedriver.Driver
+
+	// QuotaChecker limits the number of recipe executions in flight. Quotas are not enforced if it is nil.
+	QuotaChecker *quotas.Checker
 }
 
 type engine struct {
@@ -76,6 +80,10 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		return nil, nil, err
 	}
 
+	if err := e.checkQuotas(ctx, recipe); err != nil {
+		return nil, definition, err
+	}
+
 	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
 	if err != nil {
 		return nil, definition, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
@@ -96,6 +104,24 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 	return res, definition, nil
 }
 
+// checkQuotas returns an error if running the recipe exceeds the limit on recipe executions in flight of a quota of the
+// resource group or environment of the resource.
+func (e *engine) checkQuotas(ctx context.Context, recipe recipes.ResourceMetadata) error {
+	if e.options.QuotaChecker == nil {
+		return nil
+	}
+
+	violation, err := e.options.QuotaChecker.CheckRecipeExecution(ctx, recipe.ResourceID, recipe.EnvironmentID)
+	if err != nil {
+		return fmt.Errorf("failed to check the quotas of the recipe: %w", err)
+	}
+	if violation != nil {
+		return recipes.NewRecipeError(recipes.RecipeQuotaExceeded, violation.Message(recipe.ResourceID), util.RecipeSetupError, nil)
+	}
+
+	return nil
+}
+
 // Delete calls the Delete method of the driver specified in the recipe definition to delete the output resources.
 func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 	deletionStart := time.Now()
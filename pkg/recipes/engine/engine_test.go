@@ -22,12 +22,16 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/armrpc/quotas"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	recipedriver "github.com/radius-project/radius/pkg/recipes/driver"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
@@ -186,6 +190,57 @@ func Test_Engine_Execute_Failure(t *testing.T) {
 	require.Equal(t, err.Error(), "failed to execute recipe")
 }
 
+func Test_Engine_Execute_QuotaExceeded(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/mongo",
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, _ := setup(t)
+
+	mctrl := gomock.NewController(t)
+	storageProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+	storageClient := store.NewMockStorageClient(mctrl)
+	storageProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(storageClient, nil).AnyTimes()
+	engine.options.QuotaChecker = quotas.NewChecker(storageProvider)
+
+	quota := map[string]any{
+		"id":   "/planes/radius/local/resourceGroups/test-rg/providers/System.Quotas/quotas/quota0",
+		"type": ucp_datamodel.QuotaResourceType,
+		"properties": map[string]any{
+			"scope":  "/planes/radius/local/resourceGroups/test-rg",
+			"limits": map[string]any{"maxRecipeExecutions": 0},
+		},
+	}
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local", ScopeRecursive: true, ResourceType: ucp_datamodel.QuotaResourceType}, gomock.Any()).
+		Return(&store.ObjectQueryResult{Items: []store.Object{{Data: quota}}}, nil)
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local/resourceGroups/test-rg"}, gomock.Any()).
+		Return(&store.ObjectQueryResult{}, nil)
+
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+
+	result, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.Nil(t, result)
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeQuotaExceeded, recipes.GetErrorDetails(err).Code)
+}
+
 func Test_Engine_Terraform_Success(t *testing.T) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -46,4 +46,7 @@ const (
 
 	// Used for errors with recipe configuration
 	RecipeConfigurationFailure = "RecipeConfigurationFailure"
+
+	// Used for recipes that exceed the limit on recipe executions in flight of a quota.
+	RecipeQuotaExceeded = "QuotaExceeded"
 )
@@ -22,13 +22,19 @@ import (
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
 
 const ExtendersResourceType = "Applications.Core/extenders"
 
-// IsValidPortableResourceType checks if the provided resource type is a valid portable resource type.
+// IsValidPortableResourceType checks if the provided resource type is a valid portable resource type. Resource types
+// of user-defined resource providers are portable resource types because they are provisioned by recipes.
 // Returns true if the resource type is valid, false otherwise.
 func IsValidPortableResourceType(resourceType string) bool {
+	if IsUserDefinedResourceType(resourceType) {
+		return true
+	}
+
 	portableResourceTypes := []string{
 		dapr_ctrl.DaprPubSubBrokersResourceType,
 		dapr_ctrl.DaprSecretStoresResourceType,
@@ -48,3 +54,14 @@ func IsValidPortableResourceType(resourceType string) bool {
 
 	return false
 }
+
+// IsUserDefinedResourceType checks if the provided resource type can be registered by a user-defined resource provider,
+// e.g. 'MyCompany.Data/kafkaTopics'. It doesn't check whether the resource type is registered.
+func IsUserDefinedResourceType(resourceType string) bool {
+	namespace, name, found := strings.Cut(resourceType, "/")
+	if !found || name == "" || strings.Contains(name, "/") {
+		return false
+	}
+
+	return radius.IsUserDefinedNamespace(namespace)
+}
@@ -31,3 +31,11 @@ func TestInvalidPortableResourceType(t *testing.T) {
 	isValid := IsValidPortableResourceType("Applications.Dapr/pubSubBroker")
 	require.Equal(t, false, isValid)
 }
+
+func TestUserDefinedPortableResourceType(t *testing.T) {
+	require.True(t, IsValidPortableResourceType("MyCompany.Data/kafkaTopics"))
+	require.True(t, IsUserDefinedResourceType("MyCompany.Data/kafkaTopics"))
+	require.False(t, IsUserDefinedResourceType("MyCompany.Data"))
+	require.False(t, IsUserDefinedResourceType("MyCompany.Data/kafkaTopics/partitions"))
+	require.False(t, IsUserDefinedResourceType("Applications.Datastores/mongoDatabases"))
+}
@@ -52,11 +52,17 @@ const (
 	LocalIDDaprSecretStoreAzureKeyVault = "DaprSecretStoreAzureKeyVault"
 	LocalIDDaprPubSubBrokerKafka        = "DaprPubSubBrokerKafka"
 	LocalIDDeployment                   = "Deployment"
+	LocalIDJob                          = "Job"
+	LocalIDCronJob                      = "CronJob"
 	LocalIDGateway                      = "Gateway"
+	LocalIDGatewayCertificate           = "GatewayCertificate"
+	LocalIDGatewayACMEChallenge         = "GatewayACMEChallenge"
+	LocalIDGatewayACMEChallengeGrant    = "GatewayACMEChallengeGrant"
 	LocalIDHttpRoute                    = "HttpRoute"
 	LocalIDKeyVault                     = "KeyVault"
 	LocalIDSecret                       = "Secret"
 	LocalIDConfigMap                    = "ConfigMap"
+	LocalIDPersistentVolumeClaim        = "PersistentVolumeClaim"
 	LocalIDSecretProviderClass          = "SecretProviderClass"
 	LocalIDServiceAccount               = "ServiceAccount"
 	LocalIDKubernetesRole               = "KubernetesRole"
@@ -22,38 +22,70 @@ import (
 	"net/url"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"k8s.io/client-go/transport"
 )
 
 var _ Connection = (*directConnection)(nil)
 
-// directConnection represents a connection to a Radius API endpoint with no authentication
-// or intermediate systems. This is mostly used for test scenarios.
+// directConnection represents a connection to a Radius API endpoint with no intermediate systems. This is
+// mostly used for test scenarios, and by Radius components that call UCP directly.
 type directConnection struct {
 	endpoint string
+
+	// transport is the http.RoundTripper used to send requests.
+	transport http.RoundTripper
 }
 
 // NewDirectConnection parses the given endpoint string and returns a direct connection if the endpoint uses the http or
 // https scheme, otherwise it returns an error.
 func NewDirectConnection(endpoint string) (Connection, error) {
+	if err := validateEndpoint(endpoint); err != nil {
+		return nil, err
+	}
+
+	return &directConnection{
+		endpoint:  endpoint,
+		transport: http.DefaultTransport,
+	}, nil
+}
+
+// NewDirectConnectionWithTokenFile returns a direct connection that sends the token in the given file as a bearer
+// token with each request. The file is read again periodically, so that tokens that are rotated, like Kubernetes
+// service account tokens, are used once they change.
+func NewDirectConnectionWithTokenFile(endpoint string, tokenFile string) (Connection, error) {
+	if err := validateEndpoint(endpoint); err != nil {
+		return nil, err
+	}
+
+	roundTripper, err := transport.NewBearerAuthWithRefreshRoundTripper("", tokenFile, http.DefaultTransport)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token file %q: %w", tokenFile, err)
+	}
+
+	return &directConnection{
+		endpoint:  endpoint,
+		transport: roundTripper,
+	}, nil
+}
+
+func validateEndpoint(endpoint string) error {
 	parsed, err := url.Parse(endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse endpoint %q: %w", endpoint, err)
+		return fmt.Errorf("failed to parse endpoint %q: %w", endpoint, err)
 	}
 
 	if parsed.Scheme != "http" && parsed.Scheme != "https" {
-		return nil, fmt.Errorf("the endpoint must use the http or https scheme (got %q)", endpoint)
+		return fmt.Errorf("the endpoint must use the http or https scheme (got %q)", endpoint)
 	}
 
-	return &directConnection{
-		endpoint: endpoint,
-	}, nil
+	return nil
 }
 
 // Client returns an http.Client for communicating with Radius. This satisfies both the
 // autorest.Sender interface (autorest Track1 Go SDK) and policy.Transporter interface
 // (autorest Track2 Go SDK).
 func (c *directConnection) Client() *http.Client {
-	return &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+	return &http.Client{Transport: otelhttp.NewTransport(c.transport)}
 }
 
 // Endpoint returns the endpoint (aka. base URL) of the Radius API. This definitely includes
@@ -17,9 +17,14 @@ limitations under the License.
 package sdk
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
@@ -57,3 +62,30 @@ func Test_NewDirectConnection_InvalidWithoutScheme(t *testing.T) {
 	require.Contains(t, err.Error(), "the endpoint must use the http or https scheme")
 	require.Nil(t, connection)
 }
+
+func Test_NewDirectConnectionWithTokenFile(t *testing.T) {
+	authorization := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	t.Cleanup(server.Close)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("service-account-token"), 0600))
+
+	connection, err := NewDirectConnectionWithTokenFile(server.URL, tokenFile)
+	require.NoError(t, err)
+
+	// The token is sent even though the pipeline removes the Authorization header set by autorest.
+	req, err := runtime.NewRequest(context.Background(), http.MethodGet, server.URL)
+	require.NoError(t, err)
+	_, err = NewPipeline(connection).Do(req)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer service-account-token", authorization)
+}
+
+func Test_NewDirectConnectionWithTokenFile_MissingFile(t *testing.T) {
+	connection, err := NewDirectConnectionWithTokenFile("http://example.com", filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, err, "failed to read token file")
+	require.Nil(t, connection)
+}
@@ -26,6 +26,7 @@ import (
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	dynamicrp_setup "github.com/radius-project/radius/pkg/dynamicrp/setup"
 )
 
 // APIService is the restful API server for Radius Resource Provider.
@@ -33,16 +34,19 @@ type APIService struct {
 	server.Service
 
 	handlerBuilder []builder.Builder
+	dynamicRP      *dynamicrp_setup.Setup
 }
 
-// NewAPIService creates a new instance of APIService.
-func NewAPIService(options hostoptions.HostOptions, builder []builder.Builder) *APIService {
+// NewAPIService creates a new instance of APIService. The routes of the user-defined resource types are registered
+// if dynamicRP is not nil.
+func NewAPIService(options hostoptions.HostOptions, builder []builder.Builder, dynamicRP *dynamicrp_setup.Setup) *APIService {
 	return &APIService{
 		Service: server.Service{
 			ProviderName: "radius",
 			Options:      options,
 		},
 		handlerBuilder: builder,
+		dynamicRP:      dynamicRP,
 	}
 }
 
@@ -63,14 +67,14 @@ func (s *APIService) Run(ctx context.Context) error {
 		Address:  address,
 		PathBase: s.Options.Config.Server.PathBase,
 		Configure: func(r chi.Router) error {
-			for _, b := range s.handlerBuilder {
-				opts := apictrl.Options{
-					PathBase:      s.Options.Config.Server.PathBase,
-					DataProvider:  s.StorageProvider,
-					KubeClient:    s.KubeClient,
-					StatusManager: s.OperationStatusManager,
-				}
+			opts := apictrl.Options{
+				PathBase:      s.Options.Config.Server.PathBase,
+				DataProvider:  s.StorageProvider,
+				KubeClient:    s.KubeClient,
+				StatusManager: s.OperationStatusManager,
+			}
 
+			for _, b := range s.handlerBuilder {
 				validator, err := builder.NewOpenAPIValidator(ctx, opts.PathBase, b.Namespace())
 				if err != nil {
 					panic(err)
@@ -80,10 +84,24 @@ func (s *APIService) Run(ctx context.Context) error {
 					panic(err)
 				}
 			}
+
+			if s.dynamicRP != nil {
+				if err := s.dynamicRP.ApplyAPIHandlers(ctx, r, opts); err != nil {
+					panic(err)
+				}
+			}
 			return nil
 		},
 		// set the arm cert manager for managing client certificate
 		ArmCertMgr:    s.ARMCertManager,
 		EnableArmAuth: s.Options.Config.Server.EnableArmAuth, // when enabled the client cert validation will be done
+		// authenticators and authorizer are empty unless authentication and authorization are configured
+		Authenticators: s.Authenticators,
+		Authorizer:     s.Authorizer,
+		AuditSink:      s.AuditSink,
+		LockChecker:    s.LockChecker,
+		QuotaChecker:   s.QuotaChecker,
+		Publisher:      s.Publisher,
+		RateLimiter:    s.RateLimiter,
 	})
 }
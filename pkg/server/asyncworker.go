@@ -25,7 +25,9 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
+	"github.com/radius-project/radius/pkg/corerp/backend/revisions"
 	"github.com/radius-project/radius/pkg/corerp/model"
+	dynamicrp_setup "github.com/radius-project/radius/pkg/dynamicrp/setup"
 	"github.com/radius-project/radius/pkg/kubeutil"
 )
 
@@ -34,16 +36,19 @@ type AsyncWorker struct {
 	worker.Service
 
 	handlerBuilder []builder.Builder
+	dynamicRP      *dynamicrp_setup.Setup
 }
 
-// NewAsyncWorker creates new service instance to run AsyncReqeustProcessWorker.
-func NewAsyncWorker(options hostoptions.HostOptions, builder []builder.Builder) *AsyncWorker {
+// NewAsyncWorker creates new service instance to run AsyncReqeustProcessWorker. The controller of the user-defined
+// resource types is registered if dynamicRP is not nil.
+func NewAsyncWorker(options hostoptions.HostOptions, builder []builder.Builder, dynamicRP *dynamicrp_setup.Setup) *AsyncWorker {
 	return &AsyncWorker{
 		Service: worker.Service{
 			ProviderName: "radius",
 			Options:      options,
 		},
 		handlerBuilder: builder,
+		dynamicRP:      dynamicRP,
 	}
 }
 
@@ -68,22 +73,30 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize application model: %w", err)
 	}
 
-	for _, b := range w.handlerBuilder {
-		opts := ctrl.Options{
-			DataProvider: w.StorageProvider,
-			KubeClient:   k8s.RuntimeClient,
-			GetDeploymentProcessor: func() deployment.DeploymentProcessor {
-				return deployment.NewDeploymentProcessor(appModel, w.StorageProvider, k8s.RuntimeClient, k8s.ClientSet)
-			},
-		}
+	opts := ctrl.Options{
+		DataProvider: w.StorageProvider,
+		KubeClient:   k8s.RuntimeClient,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewDeploymentProcessor(appModel, w.StorageProvider, k8s.RuntimeClient, k8s.ClientSet)
+		},
+	}
 
+	for _, b := range w.handlerBuilder {
 		err := b.ApplyAsyncHandler(ctx, w.Controllers, opts)
 		if err != nil {
 			panic(err)
 		}
 	}
 
-	workerOpts := worker.Options{}
+	if w.dynamicRP != nil {
+		if err := w.dynamicRP.ApplyAsyncHandler(ctx, w.Controllers, opts); err != nil {
+			panic(err)
+		}
+	}
+
+	workerOpts := worker.Options{
+		OperationObserver: revisions.NewRecorder(w.StorageProvider, w.Options.UCPConnection),
+	}
 	if w.Options.Config.WorkerServer != nil {
 		if w.Options.Config.WorkerServer.MaxOperationConcurrency != nil {
 			workerOpts.MaxOperationConcurrency = *w.Options.Config.WorkerServer.MaxOperationConcurrency
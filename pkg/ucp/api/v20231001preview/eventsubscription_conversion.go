@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+	"net/url"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned EventSubscription resource to version-agnostic datamodel.
+func (src *EventSubscriptionResource) ConvertTo() (v1.DataModelInterface, error) {
+	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+
+	if src.Properties == nil || src.Properties.URL == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.url", ValidValue: "not nil"}
+	}
+
+	u, err := url.Parse(*src.Properties.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.url", ValidValue: "an absolute http or https URL"}
+	}
+
+	eventTypes := []datamodel.EventType{}
+	for _, eventType := range src.Properties.EventTypes {
+		if eventType == nil || !isValidEventType(*eventType) {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.eventTypes", ValidValue: fmt.Sprintf("one of %s", PossibleEventTypeValues())}
+		}
+		eventTypes = append(eventTypes, datamodel.EventType(*eventType))
+	}
+
+	converted := &datamodel.EventSubscription{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: to.String(src.Type),
+			},
+		},
+		Properties: datamodel.EventSubscriptionProperties{
+			URL:    *src.Properties.URL,
+			Secret: to.String(src.Properties.Secret),
+			Scope:  to.String(src.Properties.Scope),
+		},
+	}
+	for _, resourceType := range src.Properties.ResourceTypes {
+		if resourceType != nil && *resourceType != "" {
+			converted.Properties.ResourceTypes = append(converted.Properties.ResourceTypes, *resourceType)
+		}
+	}
+	if len(eventTypes) > 0 {
+		converted.Properties.EventTypes = eventTypes
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned EventSubscription resource. The secret is
+// write-only and is never returned.
+func (dst *EventSubscriptionResource) ConvertFrom(src v1.DataModelInterface) error {
+	subscription, ok := src.(*datamodel.EventSubscription)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(subscription.ID)
+	dst.Name = to.Ptr(subscription.Name)
+	dst.Type = to.Ptr(subscription.Type)
+
+	dst.Properties = &EventSubscriptionProperties{
+		URL:   to.Ptr(subscription.Properties.URL),
+		Scope: to.Ptr(subscription.Properties.Scope),
+	}
+	if len(subscription.Properties.ResourceTypes) > 0 {
+		dst.Properties.ResourceTypes = to.SliceOfPtrs(subscription.Properties.ResourceTypes...)
+	}
+	for _, eventType := range subscription.Properties.EventTypes {
+		dst.Properties.EventTypes = append(dst.Properties.EventTypes, to.Ptr(EventType(eventType)))
+	}
+
+	return nil
+}
+
+func isValidEventType(eventType EventType) bool {
+	for _, value := range PossibleEventTypeValues() {
+		if eventType == value {
+			return true
+		}
+	}
+
+	return false
+}
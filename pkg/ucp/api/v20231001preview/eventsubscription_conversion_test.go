@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSubscriptionConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.EventSubscription
+		err      error
+	}{
+		{
+			filename: "eventsubscriptionresource.json",
+			expected: &datamodel.EventSubscription{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/resourceGroups/test-rg/providers/System.Events/eventSubscriptions/dashboard",
+						Name: "dashboard",
+						Type: datamodel.EventSubscriptionResourceType,
+					},
+				},
+				Properties: datamodel.EventSubscriptionProperties{
+					URL:           "https://hooks.contoso.com/radius",
+					Secret:        "s3cr3t",
+					Scope:         "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+					ResourceTypes: []string{"Applications.Core/containers"},
+					EventTypes:    []datamodel.EventType{datamodel.EventTypeResourceCreated, datamodel.EventTypeOperationFailed},
+				},
+			},
+		},
+		{
+			filename: "eventsubscriptionresource-invalid-url.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.url", ValidValue: "an absolute http or https URL"},
+		},
+		{
+			filename: "eventsubscriptionresource-missing-url.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.url", ValidValue: "not nil"},
+		},
+		{
+			filename: "eventsubscriptionresource-invalid-eventtype.json",
+			err: &v1.ErrModelConversion{
+				PropertyName: "$.properties.eventTypes",
+				ValidValue:   "one of [io.radapp.operation.canceled io.radapp.operation.failed io.radapp.operation.succeeded io.radapp.resource.created io.radapp.resource.deleted io.radapp.resource.updated]",
+			},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &EventSubscriptionResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			// act
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm.(*datamodel.EventSubscription))
+			}
+		})
+	}
+}
+
+func TestEventSubscriptionConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("eventsubscriptionresourcedatamodel.json")
+	r := &datamodel.EventSubscription{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &EventSubscriptionResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "/planes/radius/local/resourceGroups/test-rg/providers/System.Events/eventSubscriptions/chatbot", *versioned.ID)
+	require.Equal(t, "chatbot", *versioned.Name)
+	require.Equal(t, "http://chatbot.default.svc.cluster.local/events", *versioned.Properties.URL)
+	require.Equal(t, "/planes/radius/local/resourceGroups/test-rg", *versioned.Properties.Scope)
+	require.Equal(t, []*EventType{to.Ptr(EventTypeIoRadappOperationFailed)}, versioned.Properties.EventTypes)
+	require.Nil(t, versioned.Properties.ResourceTypes)
+
+	// The secret is write-only.
+	require.Nil(t, versioned.Properties.Secret)
+}
+
+func TestEventSubscriptionConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &EventSubscriptionResource{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorIs(t, err, tc.err)
+	}
+}
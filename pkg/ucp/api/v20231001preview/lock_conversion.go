@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned Lock resource to version-agnostic datamodel.
+func (src *LockResource) ConvertTo() (v1.DataModelInterface, error) {
+	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+
+	if src.Properties == nil || src.Properties.Level == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.level", ValidValue: "not nil"}
+	}
+
+	var found bool
+	for _, l := range PossibleLockLevelValues() {
+		if *src.Properties.Level == l {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.level", ValidValue: fmt.Sprintf("one of %s", PossibleLockLevelValues())}
+	}
+
+	converted := &datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: to.String(src.Type),
+			},
+		},
+		Properties: datamodel.LockProperties{
+			Level: datamodel.LockLevel(*src.Properties.Level),
+			Scope: to.String(src.Properties.Scope),
+			Notes: to.String(src.Properties.Notes),
+		},
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Lock resource.
+func (dst *LockResource) ConvertFrom(src v1.DataModelInterface) error {
+	lock, ok := src.(*datamodel.Lock)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(lock.ID)
+	dst.Name = to.Ptr(lock.Name)
+	dst.Type = to.Ptr(lock.Type)
+
+	dst.Properties = &LockProperties{
+		Level: to.Ptr(LockLevel(lock.Properties.Level)),
+		Scope: to.Ptr(lock.Properties.Scope),
+	}
+	if lock.Properties.Notes != "" {
+		dst.Properties.Notes = to.Ptr(lock.Properties.Notes)
+	}
+
+	return nil
+}
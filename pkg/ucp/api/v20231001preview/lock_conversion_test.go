@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.Lock
+		err      error
+	}{
+		{
+			filename: "lockresource.json",
+			expected: &datamodel.Lock{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/resourceGroups/test-rg/providers/System.Locks/locks/protect-db",
+						Name: "protect-db",
+						Type: datamodel.LockResourceType,
+					},
+				},
+				Properties: datamodel.LockProperties{
+					Level: datamodel.LockLevelCanNotDelete,
+					Scope: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/db",
+					Notes: "Production cache",
+				},
+			},
+		},
+		{
+			filename: "lockresource-invalid-level.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.level", ValidValue: "one of [CanNotDelete ReadOnly]"},
+		},
+		{
+			filename: "lockresource-missing-level.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.level", ValidValue: "not nil"},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &LockResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			// act
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm.(*datamodel.Lock))
+			}
+		})
+	}
+}
+
+func TestLockConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("lockresourcedatamodel.json")
+	r := &datamodel.Lock{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &LockResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "/planes/radius/local/resourceGroups/test-rg/providers/System.Locks/locks/protect-rg", *versioned.ID)
+	require.Equal(t, "protect-rg", *versioned.Name)
+	require.Equal(t, LockLevelReadOnly, *versioned.Properties.Level)
+	require.Equal(t, "/planes/radius/local/resourceGroups/test-rg", *versioned.Properties.Scope)
+	require.Nil(t, versioned.Properties.Notes)
+}
+
+func TestLockConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &LockResource{Properties: &LockProperties{Level: to.Ptr(LockLevelReadOnly)}}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorIs(t, err, tc.err)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned Quota resource to version-agnostic datamodel.
+func (src *QuotaResource) ConvertTo() (v1.DataModelInterface, error) {
+	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+
+	if src.Properties == nil || src.Properties.Limits == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.limits", ValidValue: "not nil"}
+	}
+
+	converted := &datamodel.Quota{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: to.String(src.Type),
+			},
+		},
+		Properties: datamodel.QuotaProperties{
+			Scope: to.String(src.Properties.Scope),
+			Limits: datamodel.QuotaLimits{
+				MaxRecipeExecutions: src.Properties.Limits.MaxRecipeExecutions,
+				MaxContainers:       src.Properties.Limits.MaxContainers,
+				MaxReplicas:         src.Properties.Limits.MaxReplicas,
+			},
+		},
+	}
+
+	if src.Properties.Limits.ResourceCounts != nil {
+		converted.Properties.Limits.ResourceCounts = map[string]int32{}
+		for resourceType, count := range src.Properties.Limits.ResourceCounts {
+			if count == nil {
+				return nil, &v1.ErrModelConversion{PropertyName: "$.properties.limits.resourceCounts['" + resourceType + "']", ValidValue: "not nil"}
+			}
+			converted.Properties.Limits.ResourceCounts[resourceType] = *count
+		}
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Quota resource.
+func (dst *QuotaResource) ConvertFrom(src v1.DataModelInterface) error {
+	quota, ok := src.(*datamodel.Quota)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(quota.ID)
+	dst.Name = to.Ptr(quota.Name)
+	dst.Type = to.Ptr(quota.Type)
+
+	dst.Properties = &QuotaProperties{
+		Scope: to.Ptr(quota.Properties.Scope),
+		Limits: &QuotaLimits{
+			MaxRecipeExecutions: quota.Properties.Limits.MaxRecipeExecutions,
+			MaxContainers:       quota.Properties.Limits.MaxContainers,
+			MaxReplicas:         quota.Properties.Limits.MaxReplicas,
+		},
+	}
+
+	if quota.Properties.Limits.ResourceCounts != nil {
+		dst.Properties.Limits.ResourceCounts = map[string]*int32{}
+		for resourceType, count := range quota.Properties.Limits.ResourceCounts {
+			dst.Properties.Limits.ResourceCounts[resourceType] = to.Ptr(count)
+		}
+	}
+
+	return nil
+}
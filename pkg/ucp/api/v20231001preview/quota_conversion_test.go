@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.Quota
+		err      error
+	}{
+		{
+			filename: "quotaresource.json",
+			expected: &datamodel.Quota{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/resourceGroups/test-rg/providers/System.Quotas/quotas/team-a",
+						Name: "team-a",
+						Type: datamodel.QuotaResourceType,
+					},
+				},
+				Properties: datamodel.QuotaProperties{
+					Scope: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0",
+					Limits: datamodel.QuotaLimits{
+						ResourceCounts:      map[string]int32{"Applications.Datastores/redisCaches": 5},
+						MaxRecipeExecutions: to.Ptr(int32(2)),
+						MaxContainers:       to.Ptr(int32(10)),
+						MaxReplicas:         to.Ptr(int32(20)),
+					},
+				},
+			},
+		},
+		{
+			filename: "quotaresource-missing-limits.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.limits", ValidValue: "not nil"},
+		},
+		{
+			filename: "quotaresource-invalid-resourcecount.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.limits.resourceCounts['Applications.Datastores/redisCaches']", ValidValue: "not nil"},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &QuotaResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			// act
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm.(*datamodel.Quota))
+			}
+		})
+	}
+}
+
+func TestQuotaConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("quotaresourcedatamodel.json")
+	r := &datamodel.Quota{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &QuotaResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "/planes/radius/local/resourceGroups/test-rg/providers/System.Quotas/quotas/team-b", *versioned.ID)
+	require.Equal(t, "team-b", *versioned.Name)
+	require.Equal(t, "/planes/radius/local/resourceGroups/test-rg", *versioned.Properties.Scope)
+	require.Equal(t, map[string]*int32{"Applications.Core/containers": to.Ptr(int32(3))}, versioned.Properties.Limits.ResourceCounts)
+	require.Equal(t, int32(6), *versioned.Properties.Limits.MaxReplicas)
+	require.Nil(t, versioned.Properties.Limits.MaxContainers)
+	require.Nil(t, versioned.Properties.Limits.MaxRecipeExecutions)
+}
+
+func TestQuotaConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &QuotaResource{Properties: &QuotaProperties{Limits: &QuotaLimits{}}}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorIs(t, err, tc.err)
+	}
+}
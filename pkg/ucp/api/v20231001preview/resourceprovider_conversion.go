@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned ResourceProvider resource to version-agnostic datamodel.
+func (src *ResourceProviderResource) ConvertTo() (v1.DataModelInterface, error) {
+	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+
+	if src.Properties == nil || len(src.Properties.ResourceTypes) == 0 {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.resourceTypes", ValidValue: "at least one provided"}
+	}
+
+	converted := &datamodel.ResourceProvider{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: to.String(src.Type),
+			},
+		},
+		Properties: datamodel.ResourceProviderProperties{
+			ResourceTypes: []datamodel.ResourceType{},
+		},
+	}
+
+	for i, resourceType := range src.Properties.ResourceTypes {
+		path := fmt.Sprintf("$.properties.resourceTypes[%d]", i)
+		if resourceType == nil || resourceType.Name == nil || *resourceType.Name == "" {
+			return nil, &v1.ErrModelConversion{PropertyName: path + ".name", ValidValue: "not empty"}
+		}
+		if len(resourceType.APIVersions) == 0 {
+			return nil, &v1.ErrModelConversion{PropertyName: path + ".apiVersions", ValidValue: "at least one provided"}
+		}
+
+		apiVersions := map[string]datamodel.ResourceTypeAPIVersion{}
+		for version, apiVersion := range resourceType.APIVersions {
+			if apiVersion == nil || apiVersion.Schema == nil {
+				return nil, &v1.ErrModelConversion{PropertyName: fmt.Sprintf("%s.apiVersions['%s'].schema", path, version), ValidValue: "not nil"}
+			}
+			apiVersions[version] = datamodel.ResourceTypeAPIVersion{Schema: apiVersion.Schema}
+		}
+
+		converted.Properties.ResourceTypes = append(converted.Properties.ResourceTypes, datamodel.ResourceType{
+			Name:        *resourceType.Name,
+			APIVersions: apiVersions,
+			Outputs:     stringSlice(resourceType.Outputs),
+			Secrets:     stringSlice(resourceType.Secrets),
+		})
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned ResourceProvider resource.
+func (dst *ResourceProviderResource) ConvertFrom(src v1.DataModelInterface) error {
+	provider, ok := src.(*datamodel.ResourceProvider)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(provider.ID)
+	dst.Name = to.Ptr(provider.Name)
+	dst.Type = to.Ptr(provider.Type)
+
+	dst.Properties = &ResourceProviderProperties{
+		ResourceTypes: []*ResourceTypeDefinition{},
+	}
+	for _, resourceType := range provider.Properties.ResourceTypes {
+		converted := &ResourceTypeDefinition{
+			Name:        to.Ptr(resourceType.Name),
+			APIVersions: map[string]*ResourceTypeAPIVersion{},
+		}
+		for version, apiVersion := range resourceType.APIVersions {
+			converted.APIVersions[version] = &ResourceTypeAPIVersion{Schema: apiVersion.Schema}
+		}
+		if len(resourceType.Outputs) > 0 {
+			converted.Outputs = to.SliceOfPtrs(resourceType.Outputs...)
+		}
+		if len(resourceType.Secrets) > 0 {
+			converted.Secrets = to.SliceOfPtrs(resourceType.Secrets...)
+		}
+		dst.Properties.ResourceTypes = append(dst.Properties.ResourceTypes, converted)
+	}
+
+	return nil
+}
+
+func stringSlice(s []*string) []string {
+	if s == nil {
+		return nil
+	}
+	var r []string
+	for _, v := range s {
+		if v != nil {
+			r = append(r, *v)
+		}
+	}
+	return r
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceProviderConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.ResourceProvider
+		err      error
+	}{
+		{
+			filename: "resourceproviderresource.json",
+			expected: &datamodel.ResourceProvider{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/MyCompany.Data",
+						Name: "MyCompany.Data",
+						Type: datamodel.ResourceProviderResourceType,
+					},
+				},
+				Properties: datamodel.ResourceProviderProperties{
+					ResourceTypes: []datamodel.ResourceType{
+						{
+							Name: "kafkaTopics",
+							APIVersions: map[string]datamodel.ResourceTypeAPIVersion{
+								"2024-01-01-preview": {
+									Schema: map[string]any{
+										"type": "object",
+										"properties": map[string]any{
+											"partitions": map[string]any{"type": "integer"},
+										},
+										"required": []any{"partitions"},
+									},
+								},
+							},
+							Outputs: []string{"host", "port"},
+							Secrets: []string{"password"},
+						},
+					},
+				},
+			},
+		},
+		{
+			filename: "resourceproviderresource-missing-resourcetypes.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.resourceTypes", ValidValue: "at least one provided"},
+		},
+		{
+			filename: "resourceproviderresource-missing-apiversions.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.resourceTypes[0].apiVersions", ValidValue: "at least one provided"},
+		},
+		{
+			filename: "resourceproviderresource-missing-schema.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.resourceTypes[0].apiVersions['2024-01-01-preview'].schema", ValidValue: "not nil"},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &ResourceProviderResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			// act
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm.(*datamodel.ResourceProvider))
+			}
+		})
+	}
+}
+
+func TestResourceProviderConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("resourceproviderresourcedatamodel.json")
+	r := &datamodel.ResourceProvider{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &ResourceProviderResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "/planes/radius/local/providers/System.Resources/resourceProviders/MyCompany.Messaging", *versioned.ID)
+	require.Equal(t, "MyCompany.Messaging", *versioned.Name)
+	require.Equal(t, "System.Resources/resourceProviders", *versioned.Type)
+	require.Len(t, versioned.Properties.ResourceTypes, 1)
+
+	resourceType := versioned.Properties.ResourceTypes[0]
+	require.Equal(t, "queues", *resourceType.Name)
+	require.Equal(t, map[string]*ResourceTypeAPIVersion{
+		"2024-01-01-preview": {Schema: map[string]any{"type": "object"}},
+	}, resourceType.APIVersions)
+	require.Equal(t, []*string{to.Ptr("queueUrl")}, resourceType.Outputs)
+	require.Nil(t, resourceType.Secrets)
+}
+
+func TestResourceProviderConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &ResourceProviderResource{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorIs(t, err, tc.err)
+	}
+}
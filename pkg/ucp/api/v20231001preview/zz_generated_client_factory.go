@@ -44,16 +44,36 @@ func (c *ClientFactory) NewAzureCredentialsClient() *AzureCredentialsClient {
 	return subClient
 }
 
+func (c *ClientFactory) NewEventSubscriptionsClient(rootScope string) *EventSubscriptionsClient {
+	subClient, _ := NewEventSubscriptionsClient(rootScope, c.credential, c.options)
+	return subClient
+}
+
+func (c *ClientFactory) NewLocksClient(rootScope string) *LocksClient {
+	subClient, _ := NewLocksClient(rootScope, c.credential, c.options)
+	return subClient
+}
+
 func (c *ClientFactory) NewPlanesClient() *PlanesClient {
 	subClient, _ := NewPlanesClient(c.credential, c.options)
 	return subClient
 }
 
+func (c *ClientFactory) NewQuotasClient(rootScope string) *QuotasClient {
+	subClient, _ := NewQuotasClient(rootScope, c.credential, c.options)
+	return subClient
+}
+
 func (c *ClientFactory) NewResourceGroupsClient() *ResourceGroupsClient {
 	subClient, _ := NewResourceGroupsClient(c.credential, c.options)
 	return subClient
 }
 
+func (c *ClientFactory) NewResourceProvidersClient() *ResourceProvidersClient {
+	subClient, _ := NewResourceProvidersClient(c.credential, c.options)
+	return subClient
+}
+
 func (c *ClientFactory) NewResourcesClient() *ResourcesClient {
 	subClient, _ := NewResourcesClient(c.credential, c.options)
 	return subClient
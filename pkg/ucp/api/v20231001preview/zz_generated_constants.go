@@ -77,6 +77,54 @@ func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	}
 }
 
+// EventType - The type of a change notification.
+type EventType string
+
+const (
+	// EventTypeIoRadappOperationCanceled - An asynchronous operation was canceled.
+	EventTypeIoRadappOperationCanceled EventType = "io.radapp.operation.canceled"
+	// EventTypeIoRadappOperationFailed - An asynchronous operation failed.
+	EventTypeIoRadappOperationFailed EventType = "io.radapp.operation.failed"
+	// EventTypeIoRadappOperationSucceeded - An asynchronous operation succeeded.
+	EventTypeIoRadappOperationSucceeded EventType = "io.radapp.operation.succeeded"
+	// EventTypeIoRadappResourceCreated - A create request for a resource was accepted.
+	EventTypeIoRadappResourceCreated EventType = "io.radapp.resource.created"
+	// EventTypeIoRadappResourceDeleted - A resource was deleted.
+	EventTypeIoRadappResourceDeleted EventType = "io.radapp.resource.deleted"
+	// EventTypeIoRadappResourceUpdated - An update request for a resource was accepted.
+	EventTypeIoRadappResourceUpdated EventType = "io.radapp.resource.updated"
+)
+
+// PossibleEventTypeValues returns the possible values for the EventType const type.
+func PossibleEventTypeValues() []EventType {
+	return []EventType{	
+		EventTypeIoRadappOperationCanceled,
+		EventTypeIoRadappOperationFailed,
+		EventTypeIoRadappOperationSucceeded,
+		EventTypeIoRadappResourceCreated,
+		EventTypeIoRadappResourceDeleted,
+		EventTypeIoRadappResourceUpdated,
+	}
+}
+
+// LockLevel - The kind of operations that a lock prevents.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete - The locked scope can be read and modified but not deleted.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+	// LockLevelReadOnly - The locked scope can be read but not modified or deleted.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// PossibleLockLevelValues returns the possible values for the LockLevel const type.
+func PossibleLockLevelValues() []LockLevel {
+	return []LockLevel{	
+		LockLevelCanNotDelete,
+		LockLevelReadOnly,
+	}
+}
+
 // PlaneKind - Plane kinds supported.
 type PlaneKind string
 
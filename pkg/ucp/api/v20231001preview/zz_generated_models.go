@@ -229,6 +229,51 @@ type ErrorResponse struct {
 	Error *ErrorDetail
 }
 
+// EventSubscriptionProperties - The event subscription properties
+type EventSubscriptionProperties struct {
+	// REQUIRED; The HTTP or HTTPS endpoint that CloudEvents are delivered to.
+	URL *string
+
+	// The event types that are delivered. Events of all types are delivered when empty.
+	EventTypes []*EventType
+
+	// The resource types whose events are delivered. Events of all resource types are delivered when empty.
+	ResourceTypes []*string
+
+	// The plane, resource group, application or resource whose events are delivered. Defaults to the subscription scope.
+	Scope *string
+
+	// The key that signs each delivery with HMAC-SHA256. It is never returned and is kept when an update omits it.
+	Secret *string
+}
+
+// EventSubscriptionResource - The event subscription resource
+type EventSubscriptionResource struct {
+	// The resource-specific properties for this resource.
+	Properties *EventSubscriptionProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// EventSubscriptionResourceListResult - The response of a EventSubscriptionResource list operation.
+type EventSubscriptionResourceListResult struct {
+	// REQUIRED; The EventSubscriptionResource items on this page
+	Value []*EventSubscriptionResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // GenericResource - Represents resource data.
 type GenericResource struct {
 	// The resource-specific properties for this resource.
@@ -272,6 +317,45 @@ func (i *InternalCredentialStorageProperties) GetCredentialStorageProperties() *
 	}
 }
 
+// LockProperties - The lock properties
+type LockProperties struct {
+	// REQUIRED; The kind of operations that the lock prevents.
+	Level *LockLevel
+
+	// The notes about the lock, such as the reason for the lock.
+	Notes *string
+
+	// The plane, resource group, application or resource that is locked. Defaults to the scope of the lock.
+	Scope *string
+}
+
+// LockResource - The lock resource
+type LockResource struct {
+	// The resource-specific properties for this resource.
+	Properties *LockProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// LockResourceListResult - The response of a LockResource list operation.
+type LockResourceListResult struct {
+	// REQUIRED; The LockResource items on this page
+	Value []*LockResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // PlaneResource - The plane resource
 type PlaneResource struct {
 	// REQUIRED; The geo-location where the resource lives
@@ -342,6 +426,72 @@ type ProxyResource struct {
 	Type *string
 }
 
+// QuotaLimits - The limits of a quota. A limit that is not set is not enforced.
+type QuotaLimits struct {
+	// The maximum number of containers in the scope.
+	MaxContainers *int32
+
+	// The maximum number of recipe executions in flight in the scope.
+	MaxRecipeExecutions *int32
+
+	// The maximum number of replicas of all the containers in the scope.
+	MaxReplicas *int32
+
+	// The maximum number of resources of each resource type in the scope, keyed by the fully-qualified resource type.
+	ResourceCounts map[string]*int32
+}
+
+// QuotaProperties - The quota properties
+type QuotaProperties struct {
+	// REQUIRED; The limits of the quota.
+	Limits *QuotaLimits
+
+	// The resource group or environment that the quota applies to. Defaults to the resource group of the quota.
+	Scope *string
+}
+
+// QuotaResource - The quota resource
+type QuotaResource struct {
+	// The resource-specific properties for this resource.
+	Properties *QuotaProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// QuotaResourceListResult - The response of a QuotaResource list operation.
+type QuotaResourceListResult struct {
+	// REQUIRED; The QuotaResource items on this page
+	Value []*QuotaResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// QuotaUsage - The current usage of the limits of a quota.
+type QuotaUsage struct {
+	// REQUIRED; The number of containers in the scope.
+	Containers *int32
+
+	// REQUIRED; The number of recipe executions in flight in the scope.
+	RecipeExecutions *int32
+
+	// REQUIRED; The number of replicas of all the containers in the scope.
+	Replicas *int32
+
+	// REQUIRED; The number of resources of each resource type that is limited by the quota.
+	ResourceCounts map[string]*int32
+}
+
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
 type Resource struct {
 	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
@@ -402,6 +552,60 @@ type ResourceGroupResourceTagsUpdate struct {
 	Tags map[string]*string
 }
 
+// ResourceProviderProperties - The resource provider properties
+type ResourceProviderProperties struct {
+	// REQUIRED; The resource types of the resource provider.
+	ResourceTypes []*ResourceTypeDefinition
+}
+
+// ResourceProviderResource - The resource provider resource
+type ResourceProviderResource struct {
+	// The resource-specific properties for this resource.
+	Properties *ResourceProviderProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// ResourceProviderResourceListResult - The response of a ResourceProviderResource list operation.
+type ResourceProviderResourceListResult struct {
+	// REQUIRED; The ResourceProviderResource items on this page
+	Value []*ResourceProviderResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// ResourceTypeAPIVersion - An API version of a resource type
+type ResourceTypeAPIVersion struct {
+	// REQUIRED; The JSON schema of the properties of a resource that are set by users.
+	Schema map[string]any
+}
+
+// ResourceTypeDefinition - A resource type of a resource provider
+type ResourceTypeDefinition struct {
+	// REQUIRED; The API versions of the resource type, keyed by version.
+	APIVersions map[string]*ResourceTypeAPIVersion
+
+	// REQUIRED; The name of the resource type within its resource provider.
+	Name *string
+
+	// The names of the values that the recipe of a resource must output. They are returned as read-only properties.
+	Outputs []*string
+
+	// The names of the secrets that the recipe of a resource must output. They are returned by the listSecrets action.
+	Secrets []*string
+}
+
 // SystemData - Metadata pertaining to creation and last modification of the resource.
 type SystemData struct {
 	// The timestamp of resource creation (UTC).
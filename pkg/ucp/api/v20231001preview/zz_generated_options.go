@@ -59,6 +59,48 @@ type AzureCredentialsClientUpdateOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EventSubscriptionsClientCreateOrUpdateOptions contains the optional parameters for the EventSubscriptionsClient.CreateOrUpdate
+// method.
+type EventSubscriptionsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// EventSubscriptionsClientDeleteOptions contains the optional parameters for the EventSubscriptionsClient.Delete method.
+type EventSubscriptionsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// EventSubscriptionsClientGetOptions contains the optional parameters for the EventSubscriptionsClient.Get method.
+type EventSubscriptionsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// EventSubscriptionsClientListByScopeOptions contains the optional parameters for the EventSubscriptionsClient.NewListByScopePager
+// method.
+type EventSubscriptionsClientListByScopeOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientCreateOrUpdateOptions contains the optional parameters for the LocksClient.CreateOrUpdate method.
+type LocksClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientDeleteOptions contains the optional parameters for the LocksClient.Delete method.
+type LocksClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientGetOptions contains the optional parameters for the LocksClient.Get method.
+type LocksClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientListByScopeOptions contains the optional parameters for the LocksClient.NewListByScopePager method.
+type LocksClientListByScopeOptions struct {
+	// placeholder for future optional parameters
+}
+
 // PlanesClientBeginCreateOrUpdateOptions contains the optional parameters for the PlanesClient.BeginCreateOrUpdate method.
 type PlanesClientBeginCreateOrUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -92,6 +134,31 @@ type PlanesClientListPlanesOptions struct {
 	// placeholder for future optional parameters
 }
 
+// QuotasClientCreateOrUpdateOptions contains the optional parameters for the QuotasClient.CreateOrUpdate method.
+type QuotasClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// QuotasClientDeleteOptions contains the optional parameters for the QuotasClient.Delete method.
+type QuotasClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// QuotasClientGetOptions contains the optional parameters for the QuotasClient.Get method.
+type QuotasClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// QuotasClientGetUsageOptions contains the optional parameters for the QuotasClient.GetUsage method.
+type QuotasClientGetUsageOptions struct {
+	// placeholder for future optional parameters
+}
+
+// QuotasClientListByScopeOptions contains the optional parameters for the QuotasClient.NewListByScopePager method.
+type QuotasClientListByScopeOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ResourceGroupsClientCreateOrUpdateOptions contains the optional parameters for the ResourceGroupsClient.CreateOrUpdate
 // method.
 type ResourceGroupsClientCreateOrUpdateOptions struct {
@@ -118,6 +185,27 @@ type ResourceGroupsClientUpdateOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ResourceProvidersClientCreateOrUpdateOptions contains the optional parameters for the ResourceProvidersClient.CreateOrUpdate
+// method.
+type ResourceProvidersClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// ResourceProvidersClientDeleteOptions contains the optional parameters for the ResourceProvidersClient.Delete method.
+type ResourceProvidersClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// ResourceProvidersClientGetOptions contains the optional parameters for the ResourceProvidersClient.Get method.
+type ResourceProvidersClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// ResourceProvidersClientListOptions contains the optional parameters for the ResourceProvidersClient.NewListPager method.
+type ResourceProvidersClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ResourcesClientListOptions contains the optional parameters for the ResourcesClient.NewListPager method.
 type ResourcesClientListOptions struct {
 	// placeholder for future optional parameters
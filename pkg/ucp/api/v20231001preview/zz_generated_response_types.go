@@ -65,6 +65,54 @@ type AzureCredentialsClientUpdateResponse struct {
 	AzureCredentialResource
 }
 
+// EventSubscriptionsClientCreateOrUpdateResponse contains the response from method
+// EventSubscriptionsClient.CreateOrUpdate.
+type EventSubscriptionsClientCreateOrUpdateResponse struct {
+	// The event subscription resource
+	EventSubscriptionResource
+}
+
+// EventSubscriptionsClientDeleteResponse contains the response from method EventSubscriptionsClient.Delete.
+type EventSubscriptionsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// EventSubscriptionsClientGetResponse contains the response from method EventSubscriptionsClient.Get.
+type EventSubscriptionsClientGetResponse struct {
+	// The event subscription resource
+	EventSubscriptionResource
+}
+
+// EventSubscriptionsClientListByScopeResponse contains the response from method
+// EventSubscriptionsClient.NewListByScopePager.
+type EventSubscriptionsClientListByScopeResponse struct {
+	// The response of a EventSubscriptionResource list operation.
+	EventSubscriptionResourceListResult
+}
+
+// LocksClientCreateOrUpdateResponse contains the response from method LocksClient.CreateOrUpdate.
+type LocksClientCreateOrUpdateResponse struct {
+	// The lock resource
+	LockResource
+}
+
+// LocksClientDeleteResponse contains the response from method LocksClient.Delete.
+type LocksClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// LocksClientGetResponse contains the response from method LocksClient.Get.
+type LocksClientGetResponse struct {
+	// The lock resource
+	LockResource
+}
+
+// LocksClientListByScopeResponse contains the response from method LocksClient.NewListByScopePager.
+type LocksClientListByScopeResponse struct {
+	// The response of a LockResource list operation.
+	LockResourceListResult
+}
+
 // PlanesClientCreateOrUpdateResponse contains the response from method PlanesClient.BeginCreateOrUpdate.
 type PlanesClientCreateOrUpdateResponse struct {
 	// The plane resource
@@ -100,6 +148,35 @@ type PlanesClientUpdateResponse struct {
 	PlaneResource
 }
 
+// QuotasClientCreateOrUpdateResponse contains the response from method QuotasClient.CreateOrUpdate.
+type QuotasClientCreateOrUpdateResponse struct {
+	// The quota resource
+	QuotaResource
+}
+
+// QuotasClientDeleteResponse contains the response from method QuotasClient.Delete.
+type QuotasClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// QuotasClientGetResponse contains the response from method QuotasClient.Get.
+type QuotasClientGetResponse struct {
+	// The quota resource
+	QuotaResource
+}
+
+// QuotasClientGetUsageResponse contains the response from method QuotasClient.GetUsage.
+type QuotasClientGetUsageResponse struct {
+	// The current usage of the limits of a quota.
+	QuotaUsage
+}
+
+// QuotasClientListByScopeResponse contains the response from method QuotasClient.NewListByScopePager.
+type QuotasClientListByScopeResponse struct {
+	// The response of a QuotaResource list operation.
+	QuotaResourceListResult
+}
+
 // ResourceGroupsClientCreateOrUpdateResponse contains the response from method ResourceGroupsClient.CreateOrUpdate.
 type ResourceGroupsClientCreateOrUpdateResponse struct {
 	// The resource group resource
@@ -129,6 +206,29 @@ type ResourceGroupsClientUpdateResponse struct {
 	ResourceGroupResource
 }
 
+// ResourceProvidersClientCreateOrUpdateResponse contains the response from method ResourceProvidersClient.CreateOrUpdate.
+type ResourceProvidersClientCreateOrUpdateResponse struct {
+	// The resource provider resource
+	ResourceProviderResource
+}
+
+// ResourceProvidersClientDeleteResponse contains the response from method ResourceProvidersClient.Delete.
+type ResourceProvidersClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// ResourceProvidersClientGetResponse contains the response from method ResourceProvidersClient.Get.
+type ResourceProvidersClientGetResponse struct {
+	// The resource provider resource
+	ResourceProviderResource
+}
+
+// ResourceProvidersClientListResponse contains the response from method ResourceProvidersClient.NewListPager.
+type ResourceProvidersClientListResponse struct {
+	// The response of a ResourceProviderResource list operation.
+	ResourceProviderResourceListResult
+}
+
 // ResourcesClientListResponse contains the response from method ResourcesClient.NewListPager.
 type ResourcesClientListResponse struct {
 	// The response of a GenericResource list operation.
@@ -48,6 +48,10 @@ type UCPOptions struct {
 type UCPDirectConnectionOptions struct {
 	// Endpoint is the URL endpoint for the connection.
 	Endpoint string `yaml:"endpoint"`
+
+	// TokenFile is the path of a file that contains a bearer token to send with each request, such as a
+	// Kubernetes service account token. No credentials are sent when it is empty.
+	TokenFile string `yaml:"tokenFile,omitempty"`
 }
 
 // NewConnectionFromUCPConfig creates a Connection for UCP endpoint. It checks if the connection kind is direct and if so,
@@ -58,6 +62,9 @@ func NewConnectionFromUCPConfig(option *UCPOptions, k8sConfig *rest.Config) (sdk
 		if option.Direct == nil || option.Direct.Endpoint == "" {
 			return nil, errors.New("the property .ucp.direct.endpoint is required when using a direct connection")
 		}
+		if option.Direct.TokenFile != "" {
+			return sdk.NewDirectConnectionWithTokenFile(option.Direct.Endpoint, option.Direct.TokenFile)
+		}
 		return sdk.NewDirectConnection(option.Direct.Endpoint)
 	}
 	return sdk.NewKubernetesConnectionFromConfig(k8sConfig)
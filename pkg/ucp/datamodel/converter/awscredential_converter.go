@@ -17,43 +17,22 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
+// awsCredentialConverter converts AWS credentials between the datamodel and the versioned model of each API version.
+var awsCredentialConverter = apiversions.NewConverter[datamodel.AWSCredential](v20231001preview.AWSCredentialType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.AwsCredentialResource{} })
+
 // AWSCredentialDataModelToVersioned converts version agnostic AWS credential datamodel to versioned model.
 func AWSCredentialDataModelToVersioned(model *datamodel.AWSCredential, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.AwsCredentialResource{}
-		if err := versioned.ConvertFrom(model); err != nil {
-			return nil, err
-		}
-		return versioned, nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return awsCredentialConverter.ToVersioned(model, version)
 }
 
 // AWSCredentialDataModelFromVersioned converts AWS versioned credential model to datamodel.
 func AWSCredentialDataModelFromVersioned(content []byte, version string) (*datamodel.AWSCredential, error) {
-	switch version {
-	case v20231001preview.Version:
-		vm := &v20231001preview.AwsCredentialResource{}
-		if err := json.Unmarshal(content, vm); err != nil {
-			return nil, err
-		}
-		dm, err := vm.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-		return dm.(*datamodel.AWSCredential), nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return awsCredentialConverter.FromVersioned(content, version)
 }
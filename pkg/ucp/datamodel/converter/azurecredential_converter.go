@@ -17,43 +17,22 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
+// azureCredentialConverter converts Azure credentials between the datamodel and the versioned model of each API version.
+var azureCredentialConverter = apiversions.NewConverter[datamodel.AzureCredential](v20231001preview.AzureCredentialType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.AzureCredentialResource{} })
+
 // AzureCredentialDataModelToVersioned converts version agnostic Azure credential datamodel to versioned model.
 func AzureCredentialDataModelToVersioned(model *datamodel.AzureCredential, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.AzureCredentialResource{}
-		if err := versioned.ConvertFrom(model); err != nil {
-			return nil, err
-		}
-		return versioned, nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return azureCredentialConverter.ToVersioned(model, version)
 }
 
 // AzureCredentialDataModelFromVersioned converts versioned Azure credential model to datamodel.
 func AzureCredentialDataModelFromVersioned(content []byte, version string) (*datamodel.AzureCredential, error) {
-	switch version {
-	case v20231001preview.Version:
-		vm := &v20231001preview.AzureCredentialResource{}
-		if err := json.Unmarshal(content, vm); err != nil {
-			return nil, err
-		}
-		dm, err := vm.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-		return dm.(*datamodel.AzureCredential), nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return azureCredentialConverter.FromVersioned(content, version)
 }
@@ -17,43 +17,23 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
+// planeConverter converts planes between the datamodel and the versioned model of each API version.
+var planeConverter = apiversions.NewConverter[datamodel.Plane](resources.PlaneTypePrefix).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.PlaneResource{} })
+
 // PlaneDataModelToVersioned converts version agnostic plane datamodel to versioned model.
 func PlaneDataModelToVersioned(model *datamodel.Plane, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.PlaneResource{}
-		if err := versioned.ConvertFrom(model); err != nil {
-			return nil, err
-		}
-		return versioned, nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return planeConverter.ToVersioned(model, version)
 }
 
 // PlaneDataModelFromVersioned converts versioned plane model to datamodel.
 func PlaneDataModelFromVersioned(content []byte, version string) (*datamodel.Plane, error) {
-	switch version {
-	case v20231001preview.Version:
-		vm := &v20231001preview.PlaneResource{}
-		if err := json.Unmarshal(content, vm); err != nil {
-			return nil, err
-		}
-		dm, err := vm.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-		return dm.(*datamodel.Plane), nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return planeConverter.FromVersioned(content, version)
 }
@@ -17,45 +17,25 @@ limitations under the License.
 package converter
 
 import (
-	"encoding/json"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
+// resourceGroupConverter converts resource groups between the datamodel and the versioned model of each API version.
+var resourceGroupConverter = apiversions.NewConverter[datamodel.ResourceGroup](resources.ResourceGroupType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.ResourceGroupResource{} })
+
 // ResourceGroupDataModelToVersioned converts version agnostic environment datamodel to versioned model.
 // It returns an error if the conversion fails.
 func ResourceGroupDataModelToVersioned(model *datamodel.ResourceGroup, version string) (v1.VersionedModelInterface, error) {
-	switch version {
-	case v20231001preview.Version:
-		versioned := &v20231001preview.ResourceGroupResource{}
-		if err := versioned.ConvertFrom(model); err != nil {
-			return nil, err
-		}
-		return versioned, nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return resourceGroupConverter.ToVersioned(model, version)
 }
 
 // ResourceGroupDataModelFromVersioned converts versioned environment model to datamodel.
 // It returns an error if the conversion fails.
 func ResourceGroupDataModelFromVersioned(content []byte, version string) (*datamodel.ResourceGroup, error) {
-	switch version {
-	case v20231001preview.Version:
-		vm := &v20231001preview.ResourceGroupResource{}
-		if err := json.Unmarshal(content, vm); err != nil {
-			return nil, err
-		}
-		dm, err := vm.ConvertTo()
-		if err != nil {
-			return nil, err
-		}
-		return dm.(*datamodel.ResourceGroup), nil
-
-	default:
-		return nil, v1.ErrUnsupportedAPIVersion
-	}
+	return resourceGroupConverter.FromVersioned(content, version)
 }
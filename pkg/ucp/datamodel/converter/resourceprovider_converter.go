@@ -0,0 +1,40 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
+	v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// resourceProviderConverter converts resource providers between the datamodel and the versioned model of each API version.
+var resourceProviderConverter = apiversions.NewConverter[datamodel.ResourceProvider](datamodel.ResourceProviderResourceType).
+	Register(v20231001preview.Version, func() v1.VersionedModelInterface { return &v20231001preview.ResourceProviderResource{} })
+
+// ResourceProviderDataModelToVersioned converts version agnostic resource provider datamodel to versioned model.
+// It returns an error if the conversion fails.
+func ResourceProviderDataModelToVersioned(model *datamodel.ResourceProvider, version string) (v1.VersionedModelInterface, error) {
+	return resourceProviderConverter.ToVersioned(model, version)
+}
+
+// ResourceProviderDataModelFromVersioned converts versioned resource provider model to datamodel.
+// It returns an error if the conversion fails.
+func ResourceProviderDataModelFromVersioned(content []byte, version string) (*datamodel.ResourceProvider, error) {
+	return resourceProviderConverter.FromVersioned(content, version)
+}
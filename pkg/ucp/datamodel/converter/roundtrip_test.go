@@ -0,0 +1,39 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+func TestRoundTrip(t *testing.T) {
+	rpctest.RunRoundTripTests(t, "../../api", map[string][]string{
+		v20231001preview.AWSCredentialType:      {"credentialresource-aws.json"},
+		v20231001preview.AzureCredentialType:    {"credentialresource-azure.json"},
+		datamodel.EventSubscriptionResourceType: {"eventsubscriptionresource.json"},
+		datamodel.LockResourceType:              {"lockresource.json"},
+		resources.PlaneTypePrefix:               {"planeresource.json"},
+		datamodel.QuotaResourceType:             {"quotaresource.json"},
+		resources.ResourceGroupType:             {"resourcegroup.json"},
+		datamodel.ResourceProviderResourceType:  {"resourceproviderresource.json"},
+	})
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// EventSubscriptionResourceType is the resource type of an event subscription.
+	EventSubscriptionResourceType = "System.Events/eventSubscriptions"
+)
+
+// EventType is the type of a change notification. Event types follow the reverse-DNS naming recommended for the
+// CloudEvents type attribute.
+type EventType string
+
+const (
+	// EventTypeResourceCreated is sent when a create request for a resource is accepted.
+	EventTypeResourceCreated EventType = "io.radapp.resource.created"
+
+	// EventTypeResourceUpdated is sent when an update request for an existing resource is accepted.
+	EventTypeResourceUpdated EventType = "io.radapp.resource.updated"
+
+	// EventTypeResourceDeleted is sent when a resource is deleted.
+	EventTypeResourceDeleted EventType = "io.radapp.resource.deleted"
+
+	// EventTypeOperationSucceeded is sent when an asynchronous operation succeeds.
+	EventTypeOperationSucceeded EventType = "io.radapp.operation.succeeded"
+
+	// EventTypeOperationFailed is sent when an asynchronous operation fails.
+	EventTypeOperationFailed EventType = "io.radapp.operation.failed"
+
+	// EventTypeOperationCanceled is sent when an asynchronous operation is canceled.
+	EventTypeOperationCanceled EventType = "io.radapp.operation.canceled"
+)
+
+// PossibleEventTypeValues returns the event types that can be subscribed to.
+func PossibleEventTypeValues() []EventType {
+	return []EventType{
+		EventTypeResourceCreated,
+		EventTypeResourceUpdated,
+		EventTypeResourceDeleted,
+		EventTypeOperationSucceeded,
+		EventTypeOperationFailed,
+		EventTypeOperationCanceled,
+	}
+}
+
+// EventSubscriptionProperties represents the properties of an event subscription.
+type EventSubscriptionProperties struct {
+	// URL is the HTTP or HTTPS endpoint that events are delivered to.
+	URL string `json:"url"`
+
+	// Secret is the key used to sign the timestamp and body of each delivery with HMAC-SHA256. Deliveries are not
+	// signed when empty.
+	Secret string `json:"secret,omitempty"`
+
+	// Scope is the plane, resource group, application or resource whose events are delivered.
+	Scope string `json:"scope"`
+
+	// ResourceTypes limits the events to resources of these types. Events of all resource types are delivered when empty.
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+
+	// EventTypes limits the events to these types. Events of all types are delivered when empty.
+	EventTypes []EventType `json:"eventTypes,omitempty"`
+}
+
+// EventSubscription represents a subscription that delivers change notifications to a webhook.
+type EventSubscription struct {
+	v1.BaseResource
+
+	// Properties is the properties of the resource.
+	Properties EventSubscriptionProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the resource type name of the EventSubscription.
+func (e EventSubscription) ResourceTypeName() string {
+	return EventSubscriptionResourceType
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// LockResourceType is the resource type of a management lock.
+	LockResourceType = "System.Locks/locks"
+)
+
+// LockLevel is the kind of operations that a lock prevents.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete prevents deleting the locked scope. The locked scope can still be read and modified.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+
+	// LockLevelReadOnly prevents modifying and deleting the locked scope. The locked scope can still be read.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// LockProperties represents the properties of a management lock.
+type LockProperties struct {
+	// Level is the kind of operations that the lock prevents.
+	Level LockLevel `json:"level"`
+
+	// Scope is the plane, resource group, application or resource that is locked.
+	Scope string `json:"scope"`
+
+	// Notes are the notes about the lock, such as the reason for the lock.
+	Notes string `json:"notes,omitempty"`
+}
+
+// Lock represents a management lock that protects a scope from deletion or change.
+type Lock struct {
+	v1.BaseResource
+
+	// Properties is the properties of the resource.
+	Properties LockProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the resource type name of the Lock.
+func (l Lock) ResourceTypeName() string {
+	return LockResourceType
+}
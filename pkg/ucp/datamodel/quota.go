@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// QuotaResourceType is the resource type of a quota.
+	QuotaResourceType = "System.Quotas/quotas"
+)
+
+// QuotaLimits represents the limits of a quota. A limit that is not set is not enforced.
+type QuotaLimits struct {
+	// ResourceCounts is the maximum number of resources of each resource type in the scope, keyed by the fully-qualified
+	// resource type.
+	ResourceCounts map[string]int32 `json:"resourceCounts,omitempty"`
+
+	// MaxRecipeExecutions is the maximum number of recipe executions in flight in the scope.
+	MaxRecipeExecutions *int32 `json:"maxRecipeExecutions,omitempty"`
+
+	// MaxContainers is the maximum number of containers in the scope.
+	MaxContainers *int32 `json:"maxContainers,omitempty"`
+
+	// MaxReplicas is the maximum number of replicas of all the containers in the scope.
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
+
+// QuotaProperties represents the properties of a quota.
+type QuotaProperties struct {
+	// Scope is the resource group or environment that the quota applies to.
+	Scope string `json:"scope"`
+
+	// Limits is the limits of the quota.
+	Limits QuotaLimits `json:"limits"`
+}
+
+// Quota represents a quota that limits the resources in a resource group or environment.
+type Quota struct {
+	v1.BaseResource
+
+	// Properties is the properties of the resource.
+	Properties QuotaProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the resource type name of the Quota.
+func (q Quota) ResourceTypeName() string {
+	return QuotaResourceType
+}
+
+// QuotaUsage represents the current usage of the limits of a quota.
+type QuotaUsage struct {
+	// ResourceCounts is the number of resources of each resource type that is limited by the quota.
+	ResourceCounts map[string]int32 `json:"resourceCounts"`
+
+	// RecipeExecutions is the number of recipe executions in flight in the scope.
+	RecipeExecutions int32 `json:"recipeExecutions"`
+
+	// Containers is the number of containers in the scope.
+	Containers int32 `json:"containers"`
+
+	// Replicas is the number of replicas of all the containers in the scope.
+	Replicas int32 `json:"replicas"`
+}
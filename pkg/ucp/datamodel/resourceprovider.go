@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// ResourceProviderNamespace is the namespace of the resource provider registrations. The plane configures the URL
+	// of the resource provider which serves all user-defined resource types under this namespace.
+	ResourceProviderNamespace = "System.Resources"
+
+	// ResourceProviderResourceType is the resource type of a resource provider registered by users.
+	ResourceProviderResourceType = ResourceProviderNamespace + "/resourceProviders"
+)
+
+// ResourceProviderProperties represents the properties of a resource provider registered by users.
+type ResourceProviderProperties struct {
+	// ResourceTypes are the resource types of the resource provider.
+	ResourceTypes []ResourceType `json:"resourceTypes"`
+}
+
+// ResourceType is a resource type registered by users. Resources of the type are stored generically and provisioned
+// by recipes.
+type ResourceType struct {
+	// Name is the name of the resource type within its resource provider, e.g. 'kafkaTopics'.
+	Name string `json:"name"`
+
+	// APIVersions are the API versions of the resource type, keyed by version.
+	APIVersions map[string]ResourceTypeAPIVersion `json:"apiVersions"`
+
+	// Outputs are the names of the values that the recipe of a resource must output. They are returned as read-only
+	// properties of the resource.
+	Outputs []string `json:"outputs,omitempty"`
+
+	// Secrets are the names of the secrets that the recipe of a resource must output. They are returned by the
+	// listSecrets action of the resource.
+	Secrets []string `json:"secrets,omitempty"`
+}
+
+// ResourceTypeAPIVersion represents an API version of a resource type.
+type ResourceTypeAPIVersion struct {
+	// Schema is the JSON schema of the properties of a resource that are set by users.
+	Schema map[string]any `json:"schema"`
+}
+
+// ResourceProvider represents a resource provider registered by users, which defines the resource types of a
+// provider namespace, e.g. 'MyCompany.Data'.
+type ResourceProvider struct {
+	v1.BaseResource
+
+	// Properties is the properties of the resource provider.
+	Properties ResourceProviderProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the type of the resource provider resource.
+func (p *ResourceProvider) ResourceTypeName() string {
+	return ResourceProviderResourceType
+}
+
+// ResourceType returns the resource type with the given name, or nil if the resource provider does not define it.
+// Resource type names are compared case-insensitively.
+func (p *ResourceProvider) ResourceType(name string) *ResourceType {
+	for i := range p.Properties.ResourceTypes {
+		if strings.EqualFold(p.Properties.ResourceTypes[i].Name, name) {
+			return &p.Properties.ResourceTypes[i]
+		}
+	}
+
+	return nil
+}
+
+// ResourceProviderID returns the ID of the resource provider registration for the provider namespace of the
+// resource, e.g. '/planes/radius/local/providers/System.Resources/resourceProviders/MyCompany.Data'.
+func ResourceProviderID(id resources.ID) string {
+	return id.PlaneScope() + "/providers/" + ResourceProviderResourceType + "/" + id.ProviderNamespace()
+}
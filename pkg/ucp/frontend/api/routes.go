@@ -27,10 +27,18 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/armrpc/notifications"
+	"github.com/radius-project/radius/pkg/armrpc/openapi"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	audit_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/audit"
+	discovery_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/discovery"
+	eventsubscriptions_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/eventsubscriptions"
 	kubernetes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/kubernetes"
+	locks_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/locks"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
+	quotas_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/quotas"
+	resourceproviders_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourceproviders"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"github.com/radius-project/radius/pkg/validator"
@@ -39,6 +47,21 @@ import (
 const (
 	planeCollectionPath       = "/planes"
 	planeCollectionByTypePath = "/planes/{planeType}"
+	auditEventsPath           = "/providers/system.audit/events"
+	lockCollectionPath        = "/providers/system.locks/locks"
+	lockResourcePath          = "/providers/system.locks/locks/{lockName}"
+	quotaCollectionPath       = "/providers/system.quotas/quotas"
+	quotaResourcePath         = "/providers/system.quotas/quotas/{quotaName}"
+	quotaGetUsagePath         = "/providers/system.quotas/quotas/{quotaName}/getusage"
+
+	eventSubscriptionCollectionPath = "/providers/system.events/eventsubscriptions"
+	eventSubscriptionResourcePath   = "/providers/system.events/eventsubscriptions/{eventSubscriptionName}"
+	eventSubscriptionDeliveriesPath = "/providers/system.events/eventsubscriptions/{eventSubscriptionName}/deliveries"
+
+	resourceProviderCollectionPath = "/planes/radius/{planeName}/providers/system.resources/resourceproviders"
+	resourceProviderResourcePath   = "/planes/radius/{planeName}/providers/system.resources/resourceproviders/{resourceProviderName}"
+
+	openAPIDocumentPath = "/openapi"
 
 	// OperationTypeKubernetesOpenAPIV2Doc is the operation type for the required OpenAPI v2 discovery document.
 	//
@@ -58,6 +81,13 @@ const (
 
 	// OperationTypePlanes is the operation type for the planes (specific type) endpoints
 	OperationTypePlanesByType = "PLANESBYTYPE"
+
+	// OperationTypeAuditEvents is the operation type for querying the audit log.
+	OperationTypeAuditEvents = "SYSTEM.AUDIT/EVENTS"
+
+	// OperationTypeOpenAPIDocument is the operation type for the OpenAPI document of the resource providers served
+	// through UCP.
+	OperationTypeOpenAPIDocument = "OPENAPIDOCUMENT"
 )
 
 func initModules(ctx context.Context, modules []modules.Initializer) (map[string]http.Handler, []string, error) {
@@ -191,6 +221,248 @@ func Register(ctx context.Context, router chi.Router, planeModules []modules.Ini
 		},
 	}...)
 
+	// The audit log is not a resource type of any plane so its routes are registered without API validation.
+	for _, scope := range []string{"/planes/{planeType}/{planeName}", "/planes/{planeType}/{planeName}/resourcegroups/{resourceGroupName}"} {
+		handlerOptions = append(handlerOptions, server.HandlerOptions{
+			ParentRouter:      router,
+			Path:              options.PathBase + scope + auditEventsPath,
+			Method:            v1.OperationList,
+			OperationType:     &v1.OperationType{Type: OperationTypeAuditEvents, Method: v1.OperationList},
+			ControllerFactory: audit_ctrl.NewListEvents,
+		})
+	}
+
+	// Locks are not a resource type of any plane so their routes are registered without API validation. Locks can be
+	// stored at plane scope or resource group scope.
+	for _, scope := range []string{"/planes/{planeType}/{planeName}", "/planes/{planeType}/{planeName}/resourcegroups/{resourceGroupName}"} {
+		lockOptions := controller.ResourceOptions[datamodel.Lock]{
+			RequestConverter:   converter.LockDataModelFromVersioned,
+			ResponseConverter:  converter.LockDataModelToVersioned,
+			ListRecursiveQuery: true,
+		}
+
+		handlerOptions = append(handlerOptions, []server.HandlerOptions{
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + lockCollectionPath,
+				ResourceType: datamodel.LockResourceType,
+				Method:       v1.OperationList,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewListResources(opt, lockOptions)
+				},
+			},
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + lockResourcePath,
+				ResourceType: datamodel.LockResourceType,
+				Method:       v1.OperationGet,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewGetResource(opt, lockOptions)
+				},
+			},
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + lockResourcePath,
+				ResourceType: datamodel.LockResourceType,
+				Method:       v1.OperationPut,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					putOptions := lockOptions
+					putOptions.UpdateFilters = []controller.UpdateFilter[datamodel.Lock]{locks_ctrl.ValidateRequest}
+					return defaultoperation.NewDefaultSyncPut(opt, putOptions)
+				},
+			},
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + lockResourcePath,
+				ResourceType: datamodel.LockResourceType,
+				Method:       v1.OperationDelete,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewDefaultSyncDelete(opt, lockOptions)
+				},
+			},
+		}...)
+	}
+
+	// Quotas are not a resource type of any plane so their routes are registered without API validation. Quotas can be
+	// stored at plane scope or resource group scope and are enforced by the resource providers.
+	for _, scope := range []string{"/planes/{planeType}/{planeName}", "/planes/{planeType}/{planeName}/resourcegroups/{resourceGroupName}"} {
+		quotaOptions := controller.ResourceOptions[datamodel.Quota]{
+			RequestConverter:   converter.QuotaDataModelFromVersioned,
+			ResponseConverter:  converter.QuotaDataModelToVersioned,
+			ListRecursiveQuery: true,
+		}
+
+		handlerOptions = append(handlerOptions, []server.HandlerOptions{
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + quotaCollectionPath,
+				ResourceType: datamodel.QuotaResourceType,
+				Method:       v1.OperationList,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewListResources(opt, quotaOptions)
+				},
+			},
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + quotaResourcePath,
+				ResourceType: datamodel.QuotaResourceType,
+				Method:       v1.OperationGet,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewGetResource(opt, quotaOptions)
+				},
+			},
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + quotaResourcePath,
+				ResourceType: datamodel.QuotaResourceType,
+				Method:       v1.OperationPut,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					putOptions := quotaOptions
+					putOptions.UpdateFilters = []controller.UpdateFilter[datamodel.Quota]{quotas_ctrl.ValidateRequest}
+					return defaultoperation.NewDefaultSyncPut(opt, putOptions)
+				},
+			},
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + quotaResourcePath,
+				ResourceType: datamodel.QuotaResourceType,
+				Method:       v1.OperationDelete,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewDefaultSyncDelete(opt, quotaOptions)
+				},
+			},
+			{
+				ParentRouter:      router,
+				Path:              options.PathBase + scope + quotaGetUsagePath,
+				ResourceType:      datamodel.QuotaResourceType,
+				Method:            "ACTIONGETUSAGE",
+				ControllerFactory: quotas_ctrl.NewGetUsage,
+			},
+		}...)
+	}
+
+	// Event subscriptions are not a resource type of any plane so their routes are registered without API validation.
+	// Event subscriptions can be stored at plane scope or resource group scope.
+	for _, scope := range []string{"/planes/{planeType}/{planeName}", "/planes/{planeType}/{planeName}/resourcegroups/{resourceGroupName}"} {
+		eventSubscriptionOptions := controller.ResourceOptions[datamodel.EventSubscription]{
+			RequestConverter:   converter.EventSubscriptionDataModelFromVersioned,
+			ResponseConverter:  converter.EventSubscriptionDataModelToVersioned,
+			ListRecursiveQuery: true,
+		}
+
+		handlerOptions = append(handlerOptions, []server.HandlerOptions{
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + eventSubscriptionCollectionPath,
+				ResourceType: datamodel.EventSubscriptionResourceType,
+				Method:       v1.OperationList,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewListResources(opt, eventSubscriptionOptions)
+				},
+			},
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + eventSubscriptionResourcePath,
+				ResourceType: datamodel.EventSubscriptionResourceType,
+				Method:       v1.OperationGet,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewGetResource(opt, eventSubscriptionOptions)
+				},
+			},
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + eventSubscriptionResourcePath,
+				ResourceType: datamodel.EventSubscriptionResourceType,
+				Method:       v1.OperationPut,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					putOptions := eventSubscriptionOptions
+					putOptions.UpdateFilters = []controller.UpdateFilter[datamodel.EventSubscription]{eventsubscriptions_ctrl.ValidateRequest}
+					return defaultoperation.NewDefaultSyncPut(opt, putOptions)
+				},
+			},
+			{
+				ParentRouter: router,
+				Path:         options.PathBase + scope + eventSubscriptionResourcePath,
+				ResourceType: datamodel.EventSubscriptionResourceType,
+				Method:       v1.OperationDelete,
+				ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewDefaultSyncDelete(opt, eventSubscriptionOptions)
+				},
+			},
+			{
+				ParentRouter:      router,
+				Path:              options.PathBase + scope + eventSubscriptionDeliveriesPath,
+				ResourceType:      notifications.DeliveryResourceType,
+				Method:            v1.OperationList,
+				ControllerFactory: eventsubscriptions_ctrl.NewListDeliveries,
+			},
+		}...)
+	}
+
+	// Resource providers register user-defined resource types of the Radius plane. Requests for the resource types
+	// are forwarded to the resource provider configured for System.Resources.
+	resourceProviderOptions := controller.ResourceOptions[datamodel.ResourceProvider]{
+		RequestConverter:  converter.ResourceProviderDataModelFromVersioned,
+		ResponseConverter: converter.ResourceProviderDataModelToVersioned,
+	}
+
+	handlerOptions = append(handlerOptions, []server.HandlerOptions{
+		{
+			ParentRouter: router,
+			Path:         options.PathBase + resourceProviderCollectionPath,
+			ResourceType: datamodel.ResourceProviderResourceType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources(opt, resourceProviderOptions)
+			},
+		},
+		{
+			ParentRouter: router,
+			Path:         options.PathBase + resourceProviderResourcePath,
+			ResourceType: datamodel.ResourceProviderResourceType,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opt, resourceProviderOptions)
+			},
+		},
+		{
+			ParentRouter: router,
+			Path:         options.PathBase + resourceProviderResourcePath,
+			ResourceType: datamodel.ResourceProviderResourceType,
+			Method:       v1.OperationPut,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				putOptions := resourceProviderOptions
+				putOptions.UpdateFilters = []controller.UpdateFilter[datamodel.ResourceProvider]{resourceproviders_ctrl.ValidateRequest}
+				return defaultoperation.NewDefaultSyncPut(opt, putOptions)
+			},
+		},
+		{
+			ParentRouter: router,
+			Path:         options.PathBase + resourceProviderResourcePath,
+			ResourceType: datamodel.ResourceProviderResourceType,
+			Method:       v1.OperationDelete,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncDelete(opt, resourceProviderOptions)
+			},
+		},
+	}...)
+
+	// The OpenAPI document describes the resource providers served through UCP, including the resource types
+	// registered by users, so that tools can discover resource types dynamically.
+	openAPIDocument := options.OpenAPIDocument
+	if openAPIDocument == nil {
+		openAPIDocument = openapi.NewDocument("Radius APIService", "v1alpha3")
+	}
+	handlerOptions = append(handlerOptions, server.HandlerOptions{
+		ParentRouter:  router,
+		Path:          options.PathBase + openAPIDocumentPath,
+		Method:        v1.OperationGet,
+		OperationType: &v1.OperationType{Type: OperationTypeOpenAPIDocument, Method: v1.OperationGet},
+		ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+			return discovery_ctrl.NewGetOpenAPIDocument(opt, openAPIDocument)
+		},
+	})
+
 	ctrlOptions := controller.Options{
 		Address:      options.Address,
 		PathBase:     options.PathBase,
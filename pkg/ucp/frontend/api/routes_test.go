@@ -24,7 +24,9 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/golang/mock/gomock"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/notifications"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 )
@@ -74,8 +76,121 @@ func Test_Routes(t *testing.T) {
 			Method:        http.MethodDelete,
 			Path:          "/planes/someType/someName",
 		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeAuditEvents, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/someType/someName/providers/system.audit/events",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeAuditEvents, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/someType/someName/resourcegroups/someGroup/providers/system.audit/events",
+		},
+		{
+			OperationType: v1.OperationType{Type: OperationTypeOpenAPIDocument, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/openapi",
+		},
 	}
 
+	for _, scope := range []string{"/planes/someType/someName", "/planes/someType/someName/resourcegroups/someGroup"} {
+		tests = append(tests, []rpctest.HandlerTestSpec{
+			{
+				OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationList},
+				Method:        http.MethodGet,
+				Path:          scope + "/providers/system.locks/locks",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationGet},
+				Method:        http.MethodGet,
+				Path:          scope + "/providers/system.locks/locks/lock0",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationPut},
+				Method:        http.MethodPut,
+				Path:          scope + "/providers/system.locks/locks/lock0",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationDelete},
+				Method:        http.MethodDelete,
+				Path:          scope + "/providers/system.locks/locks/lock0",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.QuotaResourceType, Method: v1.OperationList},
+				Method:        http.MethodGet,
+				Path:          scope + "/providers/system.quotas/quotas",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.QuotaResourceType, Method: v1.OperationGet},
+				Method:        http.MethodGet,
+				Path:          scope + "/providers/system.quotas/quotas/quota0",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.QuotaResourceType, Method: v1.OperationPut},
+				Method:        http.MethodPut,
+				Path:          scope + "/providers/system.quotas/quotas/quota0",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.QuotaResourceType, Method: v1.OperationDelete},
+				Method:        http.MethodDelete,
+				Path:          scope + "/providers/system.quotas/quotas/quota0",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.QuotaResourceType, Method: "ACTIONGETUSAGE"},
+				Method:        http.MethodPost,
+				Path:          scope + "/providers/system.quotas/quotas/quota0/getusage",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.EventSubscriptionResourceType, Method: v1.OperationList},
+				Method:        http.MethodGet,
+				Path:          scope + "/providers/system.events/eventsubscriptions",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.EventSubscriptionResourceType, Method: v1.OperationGet},
+				Method:        http.MethodGet,
+				Path:          scope + "/providers/system.events/eventsubscriptions/subscription0",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.EventSubscriptionResourceType, Method: v1.OperationPut},
+				Method:        http.MethodPut,
+				Path:          scope + "/providers/system.events/eventsubscriptions/subscription0",
+			},
+			{
+				OperationType: v1.OperationType{Type: datamodel.EventSubscriptionResourceType, Method: v1.OperationDelete},
+				Method:        http.MethodDelete,
+				Path:          scope + "/providers/system.events/eventsubscriptions/subscription0",
+			},
+			{
+				OperationType: v1.OperationType{Type: notifications.DeliveryResourceType, Method: v1.OperationList},
+				Method:        http.MethodGet,
+				Path:          scope + "/providers/system.events/eventsubscriptions/subscription0/deliveries",
+			},
+		}...)
+	}
+
+	tests = append(tests, []rpctest.HandlerTestSpec{
+		{
+			OperationType: v1.OperationType{Type: datamodel.ResourceProviderResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/system.resources/resourceproviders",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.ResourceProviderResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/system.resources/resourceproviders/MyCompany.Data",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.ResourceProviderResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/providers/system.resources/resourceproviders/MyCompany.Data",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.ResourceProviderResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/providers/system.resources/resourceproviders/MyCompany.Data",
+		},
+	}...)
+
 	ctrl := gomock.NewController(t)
 	dataProvider := dataprovider.NewMockDataStorageProvider(ctrl)
 	dataProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
@@ -19,14 +19,24 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/armrpc/builder"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/armrpc/locks"
+	"github.com/radius-project/radius/pkg/armrpc/notifications"
+	"github.com/radius-project/radius/pkg/armrpc/openapi"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/sdk"
@@ -50,6 +60,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
+	kube_rest "k8s.io/client-go/rest"
 )
 
 const (
@@ -74,8 +85,15 @@ type ServiceOptions struct {
 	UCPConnection           sdk.Connection
 	Location                string
 
+	// KubeConfig is the Kubernetes configuration used to review tokens when Kubernetes authentication is configured.
+	KubeConfig *kube_rest.Config
+
 	// Modules is a list of modules that will be registered with the router.
 	Modules []modules.Initializer
+
+	// Builders are the builders of the built-in resource providers served through UCP. They are used to generate the
+	// OpenAPI document served by UCP.
+	Builders []builder.Builder
 }
 
 // Service implements the hosting.Service interface for the UCP frontend API.
@@ -125,16 +143,22 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		return nil, err
 	}
 
+	openAPIDocument, err := newOpenAPIDocument(s.options.Builders)
+	if err != nil {
+		return nil, err
+	}
+
 	moduleOptions := modules.Options{
-		Address:        s.options.Address,
-		PathBase:       s.options.PathBase,
-		Config:         s.options.Config,
-		Location:       s.options.Location,
-		DataProvider:   s.storageProvider,
-		QueueProvider:  s.queueProvider,
-		SecretProvider: s.secretProvider,
-		SpecLoader:     specLoader,
-		UCPConnection:  s.options.UCPConnection,
+		Address:         s.options.Address,
+		PathBase:        s.options.PathBase,
+		Config:          s.options.Config,
+		Location:        s.options.Location,
+		DataProvider:    s.storageProvider,
+		QueueProvider:   s.queueProvider,
+		SecretProvider:  s.secretProvider,
+		SpecLoader:      specLoader,
+		OpenAPIDocument: openAPIDocument,
+		UCPConnection:   s.options.UCPConnection,
 	}
 
 	modules := DefaultModules(moduleOptions)
@@ -153,6 +177,47 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 	}
 
 	app := http.Handler(r)
+	var tlsConfig *tls.Config
+	if s.options.Config != nil {
+		authenticators, authorizer, err := server.NewAccessControl(s.options.Config.Authentication, s.options.Config.Authorization, s.options.KubeConfig)
+		if err != nil {
+			return nil, err
+		}
+		rateLimiter, err := ratelimit.NewLimiter(s.options.PathBase, s.options.Config.RateLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		// Handlers are wrapped from the inside out, so requests are authenticated, then rate limited, then authorized. This
+		// matches the order of the resource provider servers: the rate limiter identifies clients by principal, and
+		// throttled requests are rejected before they are authorized.
+		if authorizer != nil {
+			app = authorization.WithAuthorizer(authorizer)(app)
+		}
+		if rateLimiter != nil {
+			app = ratelimit.WithLimiter(rateLimiter)(app)
+		}
+		if len(authenticators) > 0 {
+			app = authentication.Authenticate(authenticators)(app)
+		}
+
+		// Request client certificates during the TLS handshake so that they can be verified by the authenticator.
+		if s.options.Config.Authentication != nil && s.options.Config.Authentication.ClientCertificate != nil {
+			tlsConfig = &tls.Config{ClientAuth: tls.RequestClientCert}
+		}
+
+		auditSink, err := audit.NewSink(ctx, s.options.Config.Audit, s.storageProvider)
+		if err != nil {
+			return nil, err
+		}
+		if auditSink != nil {
+			app = audit.WithSink(auditSink)(app)
+		}
+	}
+	app = locks.WithChecker(locks.NewChecker(s.storageProvider))(app)
+	publisher := notifications.NewPublisher(s.storageProvider, notifications.Options{})
+	publisher.Start(ctx)
+	app = notifications.WithPublisher(publisher)(app)
 	app = servicecontext.ARMRequestCtx(s.options.PathBase, "global")(app)
 	app = middleware.WithLogger(app)
 
@@ -172,7 +237,8 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		// AWS SDK is case sensitive. Therefore, cannot use lowercase middleware. Therefore, introducing a new middleware that translates
 		// the path for only these segments and preserves the case for the other parts of the path.
 		// TODO: https://github.com/radius-project/radius/issues/5921
-		Handler: app,
+		Handler:   app,
+		TLSConfig: tlsConfig,
 		BaseContext: func(ln net.Listener) context.Context {
 			return ctx
 		},
@@ -264,3 +330,19 @@ func (s *Service) Run(ctx context.Context) error {
 	logger.Info("Server stopped...")
 	return nil
 }
+
+// newOpenAPIDocument merges the OpenAPI documents of the resource providers built by the builders.
+func newOpenAPIDocument(builders []builder.Builder) (*openapi.Document, error) {
+	doc := openapi.NewDocument("Radius APIService", "v1alpha3")
+	for i := range builders {
+		providerDoc, err := builders[i].OpenAPIDocument()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := doc.Merge(providerDoc); err != nil {
+			return nil, fmt.Errorf("failed to merge the OpenAPI document of %s: %w", builders[i].Namespace(), err)
+		}
+	}
+	return doc, nil
+}
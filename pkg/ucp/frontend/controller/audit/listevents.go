@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+const (
+	// SinceParam is the query parameter that limits the results to events recorded at or after a time.
+	SinceParam = "since"
+
+	// UntilParam is the query parameter that limits the results to events recorded before a time.
+	UntilParam = "until"
+
+	// ResourceIDParam is the query parameter that limits the results to events for a resource and its child resources.
+	ResourceIDParam = "resourceId"
+)
+
+var _ armrpc_controller.Controller = (*ListEvents)(nil)
+
+// EventList is the response body of the audit events collection.
+type EventList struct {
+	Value []audit.Event `json:"value"`
+}
+
+// ListEvents is the controller implementation to query the audit log saved in the data store.
+type ListEvents struct {
+	armrpc_controller.BaseController
+}
+
+// NewListEvents creates a new controller for listing audit events.
+func NewListEvents(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &ListEvents{
+		BaseController: armrpc_controller.NewBaseController(opts),
+	}, nil
+}
+
+// Run returns the audit events recorded under the plane or resource group scope of the request, filtered by the
+// since, until and resourceId query parameters.
+func (e *ListEvents) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	filter := audit.Filter{
+		Scope:      serviceCtx.ResourceID.RootScope(),
+		ResourceID: req.URL.Query().Get(ResourceIDParam),
+	}
+
+	var err error
+	if filter.Since, err = parseTime(req, SinceParam); err != nil {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+	if filter.Until, err = parseTime(req, UntilParam); err != nil {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	events, err := audit.NewStoreSink(e.StorageClient()).Query(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewOKResponse(&EventList{Value: events}), nil
+}
+
+func parseTime(req *http.Request, param string) (time.Time, error) {
+	value := req.URL.Query().Get(param)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("the %s parameter must be an RFC 3339 timestamp: %q", param, value)
+	}
+	return t, nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListEvents(t *testing.T) {
+	recorded := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	resourceID := "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/app"
+
+	t.Run("success", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockStorageClient := store.NewMockStorageClient(mockCtrl)
+
+		ctrl, err := NewListEvents(armrpc_controller.Options{StorageClient: mockStorageClient})
+		require.NoError(t, err)
+
+		query := store.Query{
+			RootScope:      "/planes/radius/local/resourceGroups/rg",
+			ScopeRecursive: true,
+			ResourceType:   audit.ResourceType,
+		}
+		mockStorageClient.EXPECT().Query(gomock.Any(), query, gomock.Any()).Return(&store.ObjectQueryResult{
+			Items: []store.Object{
+				{Data: &audit.Event{ID: "1", Time: recorded.Add(-time.Hour), Kind: audit.KindRequest, ResourceID: resourceID}},
+				{Data: &audit.Event{ID: "2", Time: recorded, Kind: audit.KindRequest, ResourceID: resourceID}},
+				{Data: &audit.Event{ID: "3", Time: recorded, Kind: audit.KindRequest, ResourceID: resourceID + "2"}},
+			},
+		}, nil)
+
+		url := "/planes/radius/local/resourceGroups/rg/providers/System.Audit/events?api-version=2023-10-01-preview&since=2024-01-02T03:00:00Z&resourceId=" + resourceID
+		request, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+
+		actualResponse, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		expected := armrpc_rest.NewOKResponse(&EventList{
+			Value: []audit.Event{{ID: "2", Time: recorded, Kind: audit.KindRequest, ResourceID: resourceID}},
+		})
+		require.Equal(t, expected, actualResponse)
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		ctrl, err := NewListEvents(armrpc_controller.Options{StorageClient: store.NewMockStorageClient(mockCtrl)})
+		require.NoError(t, err)
+
+		url := "/planes/radius/local/providers/System.Audit/events?api-version=2023-10-01-preview&until=yesterday"
+		request, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+
+		actualResponse, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, actualResponse)
+	})
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	http "net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/openapi"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const radiusPlaneScope = "/planes/radius"
+
+var _ armrpc_controller.Controller = (*GetOpenAPIDocument)(nil)
+
+// GetOpenAPIDocument is the controller implementation to get the OpenAPI v3 document of the resource providers served
+// through UCP.
+type GetOpenAPIDocument struct {
+	armrpc_controller.BaseController
+
+	document *openapi.Document
+}
+
+// NewGetOpenAPIDocument creates a new GetOpenAPIDocument controller which serves the given document of the built-in
+// resource providers merged with the resource types registered by users.
+func NewGetOpenAPIDocument(opts armrpc_controller.Options, document *openapi.Document) (armrpc_controller.Controller, error) {
+	return &GetOpenAPIDocument{
+		BaseController: armrpc_controller.NewBaseController(opts),
+		document:       document,
+	}, nil
+}
+
+// Run merges the document of the built-in resource providers with the resource types of the resource providers
+// registered in the radius planes and returns it. The registered resource types are read on every request so that
+// the document is always up to date.
+func (c *GetOpenAPIDocument) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	doc := openapi.NewDocument(c.document.Info.Title, c.document.Info.Version)
+	if err := doc.Merge(c.document); err != nil {
+		return nil, err
+	}
+
+	result, err := c.StorageClient().Query(ctx, store.Query{
+		RootScope:      radiusPlaneScope,
+		ScopeRecursive: true,
+		ResourceType:   datamodel.ResourceProviderResourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The same resource provider may be registered in more than one radius plane.
+	namespaces := map[string]bool{}
+	for _, item := range result.Items {
+		provider := &datamodel.ResourceProvider{}
+		if err := item.As(provider); err != nil {
+			return nil, err
+		}
+
+		if namespaces[strings.ToLower(provider.Name)] {
+			continue
+		}
+		namespaces[strings.ToLower(provider.Name)] = true
+
+		for _, resourceType := range provider.Properties.ResourceTypes {
+			if err := addResourceType(doc, provider.Name, resourceType); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return armrpc_rest.NewOKResponse(doc), nil
+}
+
+// addResourceType adds the operations of a resource type registered by users, which are served by the dynamic
+// resource provider.
+func addResourceType(doc *openapi.Document, namespace string, resourceType datamodel.ResourceType) error {
+	versions := []string{}
+	for version := range resourceType.APIVersions {
+		versions = append(versions, version)
+	}
+	apiversions.SortNewestFirst(versions)
+
+	var schema *openapi.Schema
+	if len(versions) > 0 {
+		properties := &openapi.Schema{}
+		b, err := json.Marshal(resourceType.APIVersions[versions[0]].Schema)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(b, properties); err != nil {
+			return err
+		}
+		schema = openapi.ResourceSchema(properties)
+	}
+
+	qualifiedType := namespace + "/" + resourceType.Name
+	collectionPath := "/providers/" + qualifiedType
+	resourcePath := collectionPath + "/{resourceName}"
+
+	operations := []struct {
+		path   string
+		method v1.OperationMethod
+	}{
+		{collectionPath, v1.OperationList},
+		{resourcePath, v1.OperationGet},
+		{resourcePath, v1.OperationPut},
+		{resourcePath, v1.OperationPatch},
+		{resourcePath, v1.OperationDelete},
+		{resourcePath + "/listSecrets", v1.OperationMethod("ACTIONLISTSECRETS")},
+	}
+
+	for _, op := range operations {
+		err := doc.AddResourceOperation(openapi.ResourceOperation{
+			ResourceType: qualifiedType,
+			Path:         op.path,
+			Method:       op.method,
+			APIVersions:  versions,
+			Schema:       schema,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
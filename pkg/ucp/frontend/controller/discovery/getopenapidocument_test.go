@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/openapi"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+)
+
+func Test_GetOpenAPIDocument(t *testing.T) {
+	static := openapi.NewDocument("Radius APIService", "v1alpha3")
+	require.NoError(t, static.AddResourceOperation(openapi.ResourceOperation{
+		ResourceType: "Applications.Core/environments",
+		Path:         "/providers/Applications.Core/environments/{environmentName}",
+		Method:       v1.OperationGet,
+	}))
+
+	provider := &datamodel.ResourceProvider{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{
+			ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/MyCompany.Data",
+			Name: "MyCompany.Data",
+			Type: datamodel.ResourceProviderResourceType,
+		}},
+		Properties: datamodel.ResourceProviderProperties{
+			ResourceTypes: []datamodel.ResourceType{
+				{
+					Name: "kafkaTopics",
+					APIVersions: map[string]datamodel.ResourceTypeAPIVersion{
+						"2023-10-01-preview": {Schema: map[string]any{"type": "object"}},
+						"2024-01-01":         {Schema: map[string]any{"type": "object", "properties": map[string]any{"partitions": map[string]any{"type": "integer"}}}},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		storage, ctrl := setupGetOpenAPIDocument(t, static)
+
+		// The same resource provider registered in two planes is only added once.
+		storage.EXPECT().
+			Query(gomock.Any(), store.Query{RootScope: "/planes/radius", ScopeRecursive: true, ResourceType: datamodel.ResourceProviderResourceType}, gomock.Any()).
+			Return(&store.ObjectQueryResult{Items: []store.Object{*testutil.MustGetStoreObject(t, provider), *testutil.MustGetStoreObject(t, provider)}}, nil).
+			Times(1)
+
+		request, err := http.NewRequest(http.MethodGet, "/apis/api.ucp.dev/v1alpha3/openapi", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		ok, isOK := response.(*armrpc_rest.OKResponse)
+		require.True(t, isOK)
+		doc := ok.Body.(*openapi.Document)
+
+		require.Equal(t, map[string][]string{
+			"/{rootScope}/providers/Applications.Core/environments/{environmentName}":      {"get"},
+			"/{rootScope}/providers/MyCompany.Data/kafkaTopics":                            {"get"},
+			"/{rootScope}/providers/MyCompany.Data/kafkaTopics/{resourceName}":             {"delete", "get", "patch", "put"},
+			"/{rootScope}/providers/MyCompany.Data/kafkaTopics/{resourceName}/listSecrets": {"post"},
+		}, doc.Operations())
+
+		put := doc.Paths["/{rootScope}/providers/MyCompany.Data/kafkaTopics/{resourceName}"].Put
+		require.Equal(t, "KafkaTopics_CreateOrUpdate", put.OperationID)
+		properties := put.RequestBody.Content["application/json"].Schema.Properties["properties"]
+		require.Equal(t, &openapi.Schema{Type: "integer"}, properties.Properties["partitions"])
+		require.Equal(t, []any{"2024-01-01", "2023-10-01-preview"}, put.Parameters[len(put.Parameters)-1].Schema.Enum)
+
+		// The static document is not modified.
+		require.Len(t, static.Paths, 1)
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		storage, ctrl := setupGetOpenAPIDocument(t, static)
+
+		storage.EXPECT().
+			Query(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, &store.ErrInvalid{Message: "invalid query"}).
+			Times(1)
+
+		request, err := http.NewRequest(http.MethodGet, "/apis/api.ucp.dev/v1alpha3/openapi", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		_, err = ctrl.Run(ctx, nil, request)
+		require.Error(t, err)
+	})
+}
+
+func setupGetOpenAPIDocument(t *testing.T, document *openapi.Document) (*store.MockStorageClient, armrpc_controller.Controller) {
+	ctrl := gomock.NewController(t)
+	storage := store.NewMockStorageClient(ctrl)
+
+	c, err := NewGetOpenAPIDocument(armrpc_controller.Options{StorageClient: storage}, document)
+	require.NoError(t, err)
+
+	return storage, c
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsubscriptions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/notifications"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ armrpc_controller.Controller = (*ListDeliveries)(nil)
+
+// DeliveryList is the response body of the deliveries collection of an event subscription.
+type DeliveryList struct {
+	Value []notifications.Delivery `json:"value"`
+}
+
+// ListDeliveries is the controller implementation to list the delivery log of an event subscription.
+type ListDeliveries struct {
+	armrpc_controller.BaseController
+}
+
+// NewListDeliveries creates a new controller for listing the delivery attempts of an event subscription.
+func NewListDeliveries(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &ListDeliveries{
+		BaseController: armrpc_controller.NewBaseController(opts),
+	}, nil
+}
+
+// Run returns the delivery attempts of the event subscription in the request path, oldest first.
+func (l *ListDeliveries) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The request ID refers to the deliveries collection, e.g. .../eventSubscriptions/{name}/deliveries.
+	subscriptionID, err := resources.Parse(strings.TrimSuffix(serviceCtx.ResourceID.String(), "/deliveries"))
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	// First check if the event subscription exists.
+	_, err = l.StorageClient().Get(ctx, subscriptionID.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := notifications.NewDeliveryLog(l.StorageClient()).List(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewOKResponse(&DeliveryList{Value: deliveries}), nil
+}
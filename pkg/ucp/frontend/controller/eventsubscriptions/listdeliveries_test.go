@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsubscriptions
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/notifications"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func Test_ListDeliveries(t *testing.T) {
+	subscriptionID := "/planes/radius/local/resourceGroups/test-rg/providers/System.Events/eventSubscriptions/sub0"
+	id := subscriptionID + "/deliveries"
+
+	t.Run("success", func(t *testing.T) {
+		storage, ctrl := setupListDeliveries(t)
+
+		delivery := notifications.Delivery{
+			ID:             "d1",
+			SubscriptionID: subscriptionID,
+			EventID:        "e1",
+			EventType:      "io.radapp.resource.created",
+			Time:           time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Attempt:        1,
+			StatusCode:     http.StatusOK,
+			Succeeded:      true,
+		}
+		// Objects read from the store are decoded from JSON.
+		b, err := json.Marshal(delivery)
+		require.NoError(t, err)
+		data := map[string]any{}
+		require.NoError(t, json.Unmarshal(b, &data))
+
+		storage.EXPECT().
+			Get(gomock.Any(), subscriptionID).
+			Return(&store.Object{}, nil).
+			Times(1)
+
+		expectedQuery := store.Query{RootScope: "/planes/radius/local/resourceGroups/test-rg", ResourceType: notifications.DeliveryResourceType}
+		storage.EXPECT().
+			Query(gomock.Any(), expectedQuery, gomock.Any()).
+			Return(&store.ObjectQueryResult{Items: []store.Object{{Data: data}}}, nil).
+			Times(1)
+
+		expected := armrpc_rest.NewOKResponse(&DeliveryList{Value: []notifications.Delivery{delivery}})
+
+		request, err := http.NewRequest(http.MethodGet, id+"?api-version=2023-10-01-preview", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
+	t.Run("event subscription not found", func(t *testing.T) {
+		storage, ctrl := setupListDeliveries(t)
+
+		storage.EXPECT().
+			Get(gomock.Any(), subscriptionID).
+			Return(nil, &store.ErrNotFound{ID: subscriptionID}).
+			Times(1)
+
+		expected := armrpc_rest.NewNotFoundResponse(resources.MustParse(id))
+
+		request, err := http.NewRequest(http.MethodGet, id+"?api-version=2023-10-01-preview", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+}
+
+func setupListDeliveries(t *testing.T) (*store.MockStorageClient, armrpc_controller.Controller) {
+	ctrl := gomock.NewController(t)
+	storage := store.NewMockStorageClient(ctrl)
+
+	c, err := NewListDeliveries(armrpc_controller.Options{StorageClient: storage})
+	require.NoError(t, err)
+
+	return storage, c
+}
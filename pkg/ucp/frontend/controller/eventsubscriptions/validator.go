@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsubscriptions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateRequest sets the scope of the event subscription to the scope that it is stored in if it is not specified
+// and checks that the scope is a valid ID within the scope that the subscription is stored in. The secret is write-only,
+// so the secret of the existing subscription is kept when the request omits it. The type of the subscription is set to
+// its canonical casing because event subscription routes are matched in lowercase.
+func ValidateRequest(ctx context.Context, newResource, oldResource *datamodel.EventSubscription, options *controller.Options) (rest.Response, error) {
+	rootScope := v1.ARMRequestContextFromContext(ctx).ResourceID.RootScope()
+	newResource.Type = datamodel.EventSubscriptionResourceType
+	if newResource.Properties.Scope == "" {
+		newResource.Properties.Scope = rootScope
+	}
+	if newResource.Properties.Secret == "" && oldResource != nil {
+		newResource.Properties.Secret = oldResource.Properties.Secret
+	}
+
+	scope, err := resources.Parse(newResource.Properties.Scope)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.scope must be a valid resource or scope ID: %s", err.Error())), nil
+	}
+	if scope.IsEmpty() || scope.IsScopeCollection() || scope.IsResourceCollection() {
+		return rest.NewBadRequestResponse("Field $.properties.scope must refer to a plane, resource group or resource."), nil
+	}
+
+	id := strings.ToLower(scope.String())
+	root := strings.ToLower(rootScope)
+	if id != root && !strings.HasPrefix(id, root+"/") {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.scope must be '%s' or a resource within it.", rootScope)), nil
+	}
+
+	// Keep the casing of the scope segments consistent with the ID of the event subscription.
+	if id == root {
+		newResource.Properties.Scope = rootScope
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsubscriptions
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateRequest(t *testing.T) {
+	const rgSubscriptionID = "/planes/radius/local/resourceGroups/test-rg/providers/System.Events/eventSubscriptions/sub0"
+
+	tests := []struct {
+		name           string
+		subscriptionID string
+		scope          string
+		expected       string
+		message        string
+	}{
+		{
+			name:           "default scope of resource group subscription",
+			subscriptionID: rgSubscriptionID,
+			expected:       "/planes/radius/local/resourceGroups/test-rg",
+		},
+		{
+			name:           "default scope of plane subscription",
+			subscriptionID: "/planes/radius/local/providers/System.Events/eventSubscriptions/sub0",
+			expected:       "/planes/radius/local",
+		},
+		{
+			name:           "resource scope",
+			subscriptionID: rgSubscriptionID,
+			scope:          "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+			expected:       "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+		},
+		{
+			name:           "resource group scope with different casing",
+			subscriptionID: rgSubscriptionID,
+			scope:          "/planes/radius/local/resourcegroups/TEST-RG",
+			expected:       "/planes/radius/local/resourceGroups/test-rg",
+		},
+		{
+			name:           "invalid scope",
+			subscriptionID: rgSubscriptionID,
+			scope:          "not-an-id",
+			message:        "Field $.properties.scope must be a valid resource or scope ID",
+		},
+		{
+			name:           "collection scope",
+			subscriptionID: rgSubscriptionID,
+			scope:          "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications",
+			message:        "Field $.properties.scope must refer to a plane, resource group or resource.",
+		},
+		{
+			name:           "scope outside resource group",
+			subscriptionID: rgSubscriptionID,
+			scope:          "/planes/radius/local/resourceGroups/other-rg",
+			message:        "Field $.properties.scope must be '/planes/radius/local/resourceGroups/test-rg' or a resource within it.",
+		},
+		{
+			name:           "parent scope",
+			subscriptionID: rgSubscriptionID,
+			scope:          "/planes/radius/local",
+			message:        "Field $.properties.scope must be '/planes/radius/local/resourceGroups/test-rg' or a resource within it.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPut, tt.subscriptionID+"?api-version=2023-10-01-preview", nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			subscription := &datamodel.EventSubscription{Properties: datamodel.EventSubscriptionProperties{URL: "https://example.com/events", Scope: tt.scope}}
+			resp, err := ValidateRequest(ctx, subscription, nil, nil)
+			require.NoError(t, err)
+
+			if tt.message == "" {
+				require.Nil(t, resp)
+				require.Equal(t, tt.expected, subscription.Properties.Scope)
+				require.Equal(t, datamodel.EventSubscriptionResourceType, subscription.Type)
+				return
+			}
+
+			badRequest, ok := resp.(*rest.BadRequestResponse)
+			require.True(t, ok)
+			require.Contains(t, badRequest.Body.Error.Message, tt.message)
+		})
+	}
+}
+
+func Test_ValidateRequest_Secret(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPut, "/planes/radius/local/providers/System.Events/eventSubscriptions/sub0?api-version=2023-10-01-preview", nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	old := &datamodel.EventSubscription{Properties: datamodel.EventSubscriptionProperties{Secret: "old"}}
+
+	t.Run("kept when omitted", func(t *testing.T) {
+		subscription := &datamodel.EventSubscription{}
+		resp, err := ValidateRequest(ctx, subscription, old, nil)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+		require.Equal(t, "old", subscription.Properties.Secret)
+	})
+
+	t.Run("replaced", func(t *testing.T) {
+		subscription := &datamodel.EventSubscription{Properties: datamodel.EventSubscriptionProperties{Secret: "new"}}
+		resp, err := ValidateRequest(ctx, subscription, old, nil)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+		require.Equal(t, "new", subscription.Properties.Secret)
+	})
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/locks"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateRequest sets the scope of the lock to the scope that the lock is stored in if it is not specified and checks
+// that the scope is a valid ID within the scope that the lock is stored in. The type of the lock is set to its canonical
+// casing because lock routes are matched in lowercase. A lock stored in a resource group can lock
+// the resource group or the applications and resources in the resource group.
+func ValidateRequest(ctx context.Context, newResource, oldResource *datamodel.Lock, options *controller.Options) (rest.Response, error) {
+	rootScope := v1.ARMRequestContextFromContext(ctx).ResourceID.RootScope()
+	newResource.Type = datamodel.LockResourceType
+	if newResource.Properties.Scope == "" {
+		newResource.Properties.Scope = rootScope
+	}
+
+	scope, err := resources.Parse(newResource.Properties.Scope)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.scope must be a valid resource or scope ID: %s", err.Error())), nil
+	}
+	if scope.IsEmpty() || scope.IsScopeCollection() || scope.IsResourceCollection() {
+		return rest.NewBadRequestResponse("Field $.properties.scope must refer to a plane, resource group or resource."), nil
+	}
+	if locks.IsLock(scope.Type()) {
+		return rest.NewBadRequestResponse("Field $.properties.scope must not refer to a lock."), nil
+	}
+	if !locks.Contains(rootScope, scope.String()) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.scope must be '%s' or a resource within it.", rootScope)), nil
+	}
+
+	// Keep the casing of the scope segments consistent with the ID of the lock.
+	if strings.EqualFold(scope.String(), rootScope) {
+		newResource.Properties.Scope = rootScope
+	}
+
+	return nil, nil
+}
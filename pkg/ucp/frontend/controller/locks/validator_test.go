@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateRequest(t *testing.T) {
+	const rgLockID = "/planes/radius/local/resourceGroups/test-rg/providers/System.Locks/locks/lock0"
+
+	tests := []struct {
+		name     string
+		lockID   string
+		scope    string
+		expected string
+		message  string
+	}{
+		{
+			name:     "default scope of resource group lock",
+			lockID:   rgLockID,
+			expected: "/planes/radius/local/resourceGroups/test-rg",
+		},
+		{
+			name:     "default scope of plane lock",
+			lockID:   "/planes/radius/local/providers/System.Locks/locks/lock0",
+			expected: "/planes/radius/local",
+		},
+		{
+			name:     "resource scope",
+			lockID:   rgLockID,
+			scope:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+			expected: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+		},
+		{
+			name:     "resource group scope with different casing",
+			lockID:   rgLockID,
+			scope:    "/planes/radius/local/resourcegroups/TEST-RG",
+			expected: "/planes/radius/local/resourceGroups/test-rg",
+		},
+		{
+			name:    "invalid scope",
+			lockID:  rgLockID,
+			scope:   "not-an-id",
+			message: "Field $.properties.scope must be a valid resource or scope ID",
+		},
+		{
+			name:    "collection scope",
+			lockID:  rgLockID,
+			scope:   "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications",
+			message: "Field $.properties.scope must refer to a plane, resource group or resource.",
+		},
+		{
+			name:    "lock scope",
+			lockID:  rgLockID,
+			scope:   "/planes/radius/local/resourceGroups/test-rg/providers/System.Locks/locks/other",
+			message: "Field $.properties.scope must not refer to a lock.",
+		},
+		{
+			name:    "scope outside resource group",
+			lockID:  rgLockID,
+			scope:   "/planes/radius/local/resourceGroups/other-rg/providers/Applications.Core/applications/app",
+			message: "Field $.properties.scope must be '/planes/radius/local/resourceGroups/test-rg' or a resource within it.",
+		},
+		{
+			name:    "parent scope",
+			lockID:  rgLockID,
+			scope:   "/planes/radius/local",
+			message: "Field $.properties.scope must be '/planes/radius/local/resourceGroups/test-rg' or a resource within it.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPut, tt.lockID+"?api-version=2023-10-01-preview", nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			lock := &datamodel.Lock{Properties: datamodel.LockProperties{Level: datamodel.LockLevelCanNotDelete, Scope: tt.scope}}
+			resp, err := ValidateRequest(ctx, lock, nil, nil)
+			require.NoError(t, err)
+
+			if tt.message == "" {
+				require.Nil(t, resp)
+				require.Equal(t, tt.expected, lock.Properties.Scope)
+				require.Equal(t, datamodel.LockResourceType, lock.Type)
+				return
+			}
+
+			badRequest, ok := resp.(*rest.BadRequestResponse)
+			require.True(t, ok)
+			require.Contains(t, badRequest.Body.Error.Message, tt.message)
+		})
+	}
+}
@@ -17,6 +17,7 @@ package planes
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	http "net/http"
 	"net/url"
@@ -29,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/proxy"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/rest"
+	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
@@ -131,6 +133,12 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 	var proxyURL string
 	if plane.Properties.Kind == rest.PlaneKindUCPNative {
 		proxyURL = plane.LookupResourceProvider(resourceID.ProviderNamespace())
+		if proxyURL == "" {
+			proxyURL, err = p.lookupUserDefinedResourceProvider(ctx, plane, resourceID)
+			if err != nil {
+				return nil, err
+			}
+		}
 		if proxyURL == "" {
 			err = fmt.Errorf("provider %s not configured", resourceID.ProviderNamespace())
 			return nil, err
@@ -189,6 +197,19 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 	return nil, nil
 }
 
+// lookupUserDefinedResourceProvider returns the URL of the resource provider which serves the user-defined resource types
+// if the provider namespace of the resource is registered as a resource provider. It returns an empty string otherwise.
+func (p *ProxyController) lookupUserDefinedResourceProvider(ctx context.Context, plane *datamodel.Plane, id resources.ID) (string, error) {
+	_, err := p.StorageClient().Get(ctx, datamodel.ResourceProviderID(id))
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return plane.LookupResourceProvider(datamodel.ResourceProviderNamespace), nil
+}
+
 // trimPlanesPrefix trims the planes prefix from the request URL path.
 func trimPlanesPrefix(r *http.Request) {
 	_, _, remainder, err := resources.ExtractPlanesPrefixFromURLPath(r.URL.Path)
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotas
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/quotas"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ armrpc_controller.Controller = (*GetUsage)(nil)
+
+// GetUsage is the controller implementation to get the current usage of the limits of a quota.
+type GetUsage struct {
+	armrpc_controller.BaseController
+	checker *quotas.Checker
+}
+
+// NewGetUsage creates a new controller for getting the usage of a quota.
+func NewGetUsage(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &GetUsage{
+		BaseController: armrpc_controller.NewBaseController(opts),
+		checker:        quotas.NewChecker(opts.DataProvider),
+	}, nil
+}
+
+// Run returns the usage of the limits of the quota in the request path.
+func (g *GetUsage) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The request ID refers to the quota of the action, e.g. .../quotas/{name} for .../quotas/{name}/getUsage.
+	quotaID := serviceCtx.ResourceID
+
+	obj, err := g.StorageClient().Get(ctx, quotaID.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return armrpc_rest.NewNotFoundResponse(quotaID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	quota := &datamodel.Quota{}
+	if err := obj.As(quota); err != nil {
+		return nil, err
+	}
+
+	usage, err := g.checker.Usage(ctx, quota)
+	if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewOKResponse(usage), nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotas
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+)
+
+func Test_GetUsage(t *testing.T) {
+	const rgID = "/planes/radius/local/resourceGroups/test-rg"
+	quotaID := rgID + "/providers/System.Quotas/quotas/quota0"
+	id := quotaID + "/getUsage"
+
+	t.Run("success", func(t *testing.T) {
+		storage, ctrl := setupGetUsage(t)
+
+		quota := &datamodel.Quota{Properties: datamodel.QuotaProperties{
+			Scope:  rgID,
+			Limits: datamodel.QuotaLimits{MaxContainers: to.Ptr(int32(5))},
+		}}
+		storage.EXPECT().
+			Get(gomock.Any(), quotaID).
+			Return(testutil.MustGetStoreObject(t, quota), nil).
+			Times(1)
+
+		container := map[string]any{
+			"id":   rgID + "/providers/Applications.Core/containers/c0",
+			"type": "Applications.Core/containers",
+		}
+		storage.EXPECT().
+			Query(gomock.Any(), store.Query{RootScope: rgID}, gomock.Any()).
+			Return(&store.ObjectQueryResult{Items: []store.Object{*testutil.MustGetStoreObject(t, container)}}, nil).
+			Times(1)
+
+		expected := armrpc_rest.NewOKResponse(&datamodel.QuotaUsage{ResourceCounts: map[string]int32{}, Containers: 1, Replicas: 1})
+
+		request, err := http.NewRequest(http.MethodPost, id+"?api-version=2023-10-01-preview", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
+	t.Run("quota not found", func(t *testing.T) {
+		storage, ctrl := setupGetUsage(t)
+
+		storage.EXPECT().
+			Get(gomock.Any(), quotaID).
+			Return(nil, &store.ErrNotFound{ID: quotaID}).
+			Times(1)
+
+		expected := armrpc_rest.NewNotFoundResponse(resources.MustParse(quotaID))
+
+		request, err := http.NewRequest(http.MethodPost, id+"?api-version=2023-10-01-preview", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+}
+
+func setupGetUsage(t *testing.T) (*store.MockStorageClient, armrpc_controller.Controller) {
+	ctrl := gomock.NewController(t)
+	storage := store.NewMockStorageClient(ctrl)
+	provider := dataprovider.NewMockDataStorageProvider(ctrl)
+	provider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(storage, nil).AnyTimes()
+
+	c, err := NewGetUsage(armrpc_controller.Options{StorageClient: storage, DataProvider: provider})
+	require.NoError(t, err)
+
+	return storage, c
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotas
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/locks"
+	"github.com/radius-project/radius/pkg/armrpc/quotas"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateRequest sets the scope of the quota to the resource group that the quota is stored in if it is not specified
+// and checks that the scope is a resource group or an environment within the scope that the quota is stored in. The
+// type of the quota is set to its canonical casing because quota routes are matched in lowercase.
+func ValidateRequest(ctx context.Context, newResource, oldResource *datamodel.Quota, options *controller.Options) (rest.Response, error) {
+	rootScope := v1.ARMRequestContextFromContext(ctx).ResourceID.RootScope()
+	newResource.Type = datamodel.QuotaResourceType
+	if newResource.Properties.Scope == "" {
+		if !quotas.IsResourceGroup(resources.MustParse(rootScope)) {
+			return rest.NewBadRequestResponse("Field $.properties.scope must be specified for a quota stored in a plane."), nil
+		}
+		newResource.Properties.Scope = rootScope
+	}
+
+	scope, err := resources.Parse(newResource.Properties.Scope)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.scope must be a valid resource or scope ID: %s", err.Error())), nil
+	}
+	if !quotas.IsResourceGroup(scope) && !quotas.IsEnvironment(scope) {
+		return rest.NewBadRequestResponse("Field $.properties.scope must refer to a resource group or an environment."), nil
+	}
+	if !locks.Contains(rootScope, scope.String()) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.scope must be '%s' or a resource within it.", rootScope)), nil
+	}
+
+	// Keep the casing of the scope segments consistent with the ID of the quota.
+	if strings.EqualFold(scope.String(), rootScope) {
+		newResource.Properties.Scope = rootScope
+	}
+
+	return validateLimits(&newResource.Properties.Limits), nil
+}
+
+func validateLimits(limits *datamodel.QuotaLimits) rest.Response {
+	if len(limits.ResourceCounts) == 0 && limits.MaxRecipeExecutions == nil && limits.MaxContainers == nil && limits.MaxReplicas == nil {
+		return rest.NewBadRequestResponse("Field $.properties.limits must specify at least one limit.")
+	}
+
+	// Sort the resource types so that the first invalid resource type is reported consistently.
+	resourceTypes := []string{}
+	for resourceType := range limits.ResourceCounts {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		namespace, name, found := strings.Cut(resourceType, "/")
+		if !found || namespace == "" || name == "" || strings.Contains(name, "/") {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.limits.resourceCounts['%s'] must be a resource type in the form 'Namespace/type'.", resourceType))
+		}
+		if limits.ResourceCounts[resourceType] < 0 {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.limits.resourceCounts['%s'] must not be negative.", resourceType))
+		}
+	}
+
+	named := []struct {
+		name  string
+		value *int32
+	}{
+		{quotas.LimitMaxRecipeExecutions, limits.MaxRecipeExecutions},
+		{quotas.LimitMaxContainers, limits.MaxContainers},
+		{quotas.LimitMaxReplicas, limits.MaxReplicas},
+	}
+	for _, limit := range named {
+		if limit.value != nil && *limit.value < 0 {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.limits.%s must not be negative.", limit.name))
+		}
+	}
+
+	return nil
+}
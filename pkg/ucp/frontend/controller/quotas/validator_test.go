@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotas
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateRequest(t *testing.T) {
+	const rgQuotaID = "/planes/radius/local/resourceGroups/test-rg/providers/System.Quotas/quotas/quota0"
+	const planeQuotaID = "/planes/radius/local/providers/System.Quotas/quotas/quota0"
+	validLimits := datamodel.QuotaLimits{MaxContainers: to.Ptr(int32(10))}
+
+	tests := []struct {
+		name     string
+		quotaID  string
+		scope    string
+		limits   datamodel.QuotaLimits
+		expected string
+		message  string
+	}{
+		{
+			name:     "default scope of resource group quota",
+			quotaID:  rgQuotaID,
+			limits:   validLimits,
+			expected: "/planes/radius/local/resourceGroups/test-rg",
+		},
+		{
+			name:     "resource group scope with different casing",
+			quotaID:  rgQuotaID,
+			scope:    "/planes/radius/local/resourcegroups/TEST-RG",
+			limits:   validLimits,
+			expected: "/planes/radius/local/resourceGroups/test-rg",
+		},
+		{
+			name:     "environment scope",
+			quotaID:  rgQuotaID,
+			scope:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env",
+			limits:   datamodel.QuotaLimits{ResourceCounts: map[string]int32{"Applications.Datastores/redisCaches": 5}},
+			expected: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env",
+		},
+		{
+			name:     "plane quota of a resource group",
+			quotaID:  planeQuotaID,
+			scope:    "/planes/radius/local/resourceGroups/test-rg",
+			limits:   datamodel.QuotaLimits{MaxRecipeExecutions: to.Ptr(int32(0))},
+			expected: "/planes/radius/local/resourceGroups/test-rg",
+		},
+		{
+			name:    "missing scope of plane quota",
+			quotaID: planeQuotaID,
+			limits:  validLimits,
+			message: "Field $.properties.scope must be specified for a quota stored in a plane.",
+		},
+		{
+			name:    "invalid scope",
+			quotaID: rgQuotaID,
+			scope:   "not-an-id",
+			limits:  validLimits,
+			message: "Field $.properties.scope must be a valid resource or scope ID",
+		},
+		{
+			name:    "application scope",
+			quotaID: rgQuotaID,
+			scope:   "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+			limits:  validLimits,
+			message: "Field $.properties.scope must refer to a resource group or an environment.",
+		},
+		{
+			name:    "scope outside resource group",
+			quotaID: rgQuotaID,
+			scope:   "/planes/radius/local/resourceGroups/other-rg",
+			limits:  validLimits,
+			message: "Field $.properties.scope must be '/planes/radius/local/resourceGroups/test-rg' or a resource within it.",
+		},
+		{
+			name:    "no limits",
+			quotaID: rgQuotaID,
+			message: "Field $.properties.limits must specify at least one limit.",
+		},
+		{
+			name:    "invalid resource type",
+			quotaID: rgQuotaID,
+			limits:  datamodel.QuotaLimits{ResourceCounts: map[string]int32{"redisCaches": 5}},
+			message: "Field $.properties.limits.resourceCounts['redisCaches'] must be a resource type in the form 'Namespace/type'.",
+		},
+		{
+			name:    "negative resource count",
+			quotaID: rgQuotaID,
+			limits:  datamodel.QuotaLimits{ResourceCounts: map[string]int32{"Applications.Datastores/redisCaches": -1}},
+			message: "Field $.properties.limits.resourceCounts['Applications.Datastores/redisCaches'] must not be negative.",
+		},
+		{
+			name:    "negative replicas",
+			quotaID: rgQuotaID,
+			limits:  datamodel.QuotaLimits{MaxReplicas: to.Ptr(int32(-1))},
+			message: "Field $.properties.limits.maxReplicas must not be negative.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPut, tt.quotaID+"?api-version=2023-10-01-preview", nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			quota := &datamodel.Quota{Properties: datamodel.QuotaProperties{Scope: tt.scope, Limits: tt.limits}}
+			resp, err := ValidateRequest(ctx, quota, nil, nil)
+			require.NoError(t, err)
+
+			if tt.message == "" {
+				require.Nil(t, resp)
+				require.Equal(t, tt.expected, quota.Properties.Scope)
+				require.Equal(t, datamodel.QuotaResourceType, quota.Type)
+				return
+			}
+
+			badRequest, ok := resp.(*rest.BadRequestResponse)
+			require.True(t, ok)
+			require.Contains(t, badRequest.Body.Error.Message, tt.message)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/validator"
+)
+
+var (
+	// resourceTypeRegex matches the names of resource types, e.g. 'kafkaTopics'.
+	resourceTypeRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
+	// apiVersionRegex matches the API versions of resource types, e.g. '2023-10-01-preview'.
+	apiVersionRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-preview)?$`)
+
+	// reservedProperties are the properties which every user-defined resource has, so outputs and secrets of
+	// resource types must not use them.
+	reservedProperties = []string{"environment", "application", "recipe", "status", "provisioningState"}
+)
+
+// ValidateRequest validates the resource types registered by the resource provider. The name of the resource provider
+// is the provider namespace of its resource types, so it must not be a namespace reserved by Radius. Every API version
+// of a resource type must have a valid schema and the outputs and secrets of a resource type must be unique. The type
+// of the resource provider is set to its canonical casing because resource provider routes are matched in lowercase.
+func ValidateRequest(ctx context.Context, newResource, oldResource *datamodel.ResourceProvider, options *controller.Options) (rest.Response, error) {
+	newResource.Type = datamodel.ResourceProviderResourceType
+
+	namespace := v1.ARMRequestContextFromContext(ctx).ResourceID.Name()
+	if !radius.IsUserDefinedNamespace(namespace) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Resource provider name '%s' must be a provider namespace such as 'MyCompany.Data' which is not reserved by Radius.", namespace)), nil
+	}
+
+	names := map[string]bool{}
+	for i, resourceType := range newResource.Properties.ResourceTypes {
+		field := fmt.Sprintf("$.properties.resourceTypes[%d]", i)
+		if !resourceTypeRegex.MatchString(resourceType.Name) {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.name must contain only letters and digits and start with a letter.", field)), nil
+		}
+		if names[strings.ToLower(resourceType.Name)] {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.name must be unique, but '%s' is defined more than once.", field, resourceType.Name)), nil
+		}
+		names[strings.ToLower(resourceType.Name)] = true
+
+		for version, apiVersion := range resourceType.APIVersions {
+			if !apiVersionRegex.MatchString(version) {
+				return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.apiVersions has an invalid API version '%s'. API versions must be formatted as 'YYYY-MM-DD' or 'YYYY-MM-DD-preview'.", field, version)), nil
+			}
+			if _, err := validator.NewSchemaValidator(apiVersion.Schema); err != nil {
+				return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.apiVersions['%s'].schema is invalid: %s", field, version, err.Error())), nil
+			}
+		}
+
+		values := map[string]string{}
+		for _, contract := range []struct {
+			field string
+			names []string
+		}{{"outputs", resourceType.Outputs}, {"secrets", resourceType.Secrets}} {
+			for _, name := range contract.names {
+				if name == "" {
+					return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.%s must not contain empty names.", field, contract.field)), nil
+				}
+				for _, reserved := range reservedProperties {
+					if strings.EqualFold(name, reserved) {
+						return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.%s must not contain the reserved property '%s'.", field, contract.field, name)), nil
+					}
+				}
+				if _, ok := values[strings.ToLower(name)]; ok {
+					return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.%s has '%s' which is already defined in %s.", field, contract.field, name, values[strings.ToLower(name)])), nil
+				}
+				values[strings.ToLower(name)] = contract.field
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateRequest(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"partitions": map[string]any{"type": "integer"},
+		},
+	}
+
+	validType := func() datamodel.ResourceType {
+		return datamodel.ResourceType{
+			Name: "kafkaTopics",
+			APIVersions: map[string]datamodel.ResourceTypeAPIVersion{
+				"2023-10-01-preview": {Schema: schema},
+			},
+			Outputs: []string{"topic"},
+			Secrets: []string{"connectionString"},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		modify    func(resourceType *datamodel.ResourceType)
+		extra     []datamodel.ResourceType
+		message   string
+	}{
+		{
+			name:      "valid",
+			namespace: "MyCompany.Data",
+		},
+		{
+			name:      "invalid namespace",
+			namespace: "MyCompany",
+			message:   "Resource provider name 'MyCompany' must be a provider namespace such as 'MyCompany.Data' which is not reserved by Radius.",
+		},
+		{
+			name:      "reserved namespace",
+			namespace: "applications.Data",
+			message:   "Resource provider name 'applications.Data' must be a provider namespace such as 'MyCompany.Data' which is not reserved by Radius.",
+		},
+		{
+			name:      "invalid resource type name",
+			namespace: "MyCompany.Data",
+			modify:    func(resourceType *datamodel.ResourceType) { resourceType.Name = "kafka/topics" },
+			message:   "Field $.properties.resourceTypes[0].name must contain only letters and digits and start with a letter.",
+		},
+		{
+			name:      "duplicate resource type",
+			namespace: "MyCompany.Data",
+			extra:     []datamodel.ResourceType{{Name: "KafkaTopics"}},
+			message:   "Field $.properties.resourceTypes[1].name must be unique, but 'KafkaTopics' is defined more than once.",
+		},
+		{
+			name:      "invalid api version",
+			namespace: "MyCompany.Data",
+			modify: func(resourceType *datamodel.ResourceType) {
+				resourceType.APIVersions = map[string]datamodel.ResourceTypeAPIVersion{"v1": {Schema: schema}}
+			},
+			message: "Field $.properties.resourceTypes[0].apiVersions has an invalid API version 'v1'.",
+		},
+		{
+			name:      "invalid schema",
+			namespace: "MyCompany.Data",
+			modify: func(resourceType *datamodel.ResourceType) {
+				resourceType.APIVersions["2023-10-01-preview"] = datamodel.ResourceTypeAPIVersion{Schema: map[string]any{"type": "string"}}
+			},
+			message: "Field $.properties.resourceTypes[0].apiVersions['2023-10-01-preview'].schema is invalid: invalid schema: the type must be 'object', but got 'string'",
+		},
+		{
+			name:      "empty output",
+			namespace: "MyCompany.Data",
+			modify:    func(resourceType *datamodel.ResourceType) { resourceType.Outputs = []string{""} },
+			message:   "Field $.properties.resourceTypes[0].outputs must not contain empty names.",
+		},
+		{
+			name:      "reserved output",
+			namespace: "MyCompany.Data",
+			modify:    func(resourceType *datamodel.ResourceType) { resourceType.Outputs = []string{"Environment"} },
+			message:   "Field $.properties.resourceTypes[0].outputs must not contain the reserved property 'Environment'.",
+		},
+		{
+			name:      "secret defined as output",
+			namespace: "MyCompany.Data",
+			modify:    func(resourceType *datamodel.ResourceType) { resourceType.Secrets = []string{"topic"} },
+			message:   "Field $.properties.resourceTypes[0].secrets has 'topic' which is already defined in outputs.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPut, "/planes/radius/local/providers/System.Resources/resourceProviders/"+tt.namespace+"?api-version=2023-10-01-preview", nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			resourceType := validType()
+			if tt.modify != nil {
+				tt.modify(&resourceType)
+			}
+			provider := &datamodel.ResourceProvider{
+				Properties: datamodel.ResourceProviderProperties{
+					ResourceTypes: append([]datamodel.ResourceType{resourceType}, tt.extra...),
+				},
+			}
+
+			resp, err := ValidateRequest(ctx, provider, nil, nil)
+			require.NoError(t, err)
+
+			if tt.message == "" {
+				require.Nil(t, resp)
+				require.Equal(t, datamodel.ResourceProviderResourceType, provider.Type)
+				return
+			}
+
+			badRequest, ok := resp.(*rest.BadRequestResponse)
+			require.True(t, ok)
+			require.Contains(t, badRequest.Body.Error.Message, tt.message)
+		})
+	}
+}
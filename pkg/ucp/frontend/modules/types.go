@@ -20,6 +20,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/radius-project/radius/pkg/armrpc/openapi"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/hostoptions"
@@ -71,6 +72,9 @@ type Options struct {
 	// SpecLoader is the OpenAPI spec loader containing specs for the UCP APIs.
 	SpecLoader *validator.Loader
 
+	// OpenAPIDocument is the OpenAPI document of the built-in resource providers served through UCP.
+	OpenAPIDocument *openapi.Document
+
 	// UCPConnection is the connection used to communicate with UCP APIs.
 	UCPConnection sdk.Connection
 }
@@ -17,6 +17,10 @@ limitations under the License.
 package hostoptions
 
 import (
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
+	"github.com/radius-project/radius/pkg/armrpc/ratelimit"
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
 	profilerprovider "github.com/radius-project/radius/pkg/profiler/provider"
 	"github.com/radius-project/radius/pkg/trace"
@@ -41,6 +45,20 @@ type UCPConfig struct {
 	Identity         Identity                                 `yaml:"identity,omitempty"`
 	UCP              config.UCPOptions                        `yaml:"ucp"`
 	Location         string                                   `yaml:"location"`
+
+	// Authentication configures how callers of the UCP API are authenticated. Requests are not authenticated when unset.
+	Authentication *authentication.Options `yaml:"authentication,omitempty"`
+
+	// Authorization configures role-based access control for the UCP API. Requires Authentication. Requests are not
+	// authorized when unset.
+	Authorization *authorization.Options `yaml:"authorization,omitempty"`
+
+	// Audit configures the audit log of mutating operations. Operations are not audited when unset.
+	Audit *audit.Options `yaml:"audit,omitempty"`
+
+	// RateLimit configures the rate limiting of the requests of each client, including the requests proxied to the
+	// Azure and AWS planes. Requests are not limited when unset.
+	RateLimit *ratelimit.Options `yaml:"rateLimit,omitempty"`
 }
 
 const (
@@ -134,7 +134,7 @@ func AsyncResource(t *testing.T, ts *testserver.TestServer, rootScope string, pu
 			ResourceType: resourceType,
 			Method:       v1.OperationPatch,
 			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut(opt, resourceOptions)
+				return defaultoperation.NewDefaultAsyncPatch(opt, resourceOptions)
 			},
 		},
 		{
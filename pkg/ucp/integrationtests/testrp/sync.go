@@ -88,7 +88,7 @@ func SyncResource(t *testing.T, ts *testserver.TestServer, rootScope string) fun
 			ResourceType: resourceType,
 			Method:       v1.OperationPatch,
 			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
-				return defaultoperation.NewDefaultSyncPut(opt, resourceOptions)
+				return defaultoperation.NewDefaultSyncPatch(opt, resourceOptions)
 			},
 		},
 		{
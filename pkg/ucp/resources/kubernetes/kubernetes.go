@@ -49,6 +49,9 @@ var providerLookup map[string]string = map[string]string{
 	strings.ToLower(KindRoleBinding):         ResourceTypeRoleBinding,
 	strings.ToLower(KindSecretProviderClass): ResourceTypeSecretProviderClass,
 	strings.ToLower(KindContourHTTPProxy):    ResourceTypeContourHTTPProxy,
+	strings.ToLower(KindGatewayAPIGateway):   ResourceTypeGatewayAPIGateway,
+	strings.ToLower(KindGatewayAPIHTTPRoute): ResourceTypeGatewayAPIHTTPRoute,
+	strings.ToLower(KindGatewayAPITLSRoute):  ResourceTypeGatewayAPITLSRoute,
 }
 
 // ToParts returns the component parts of the given UCP resource ID.
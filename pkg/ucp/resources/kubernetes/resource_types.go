@@ -53,6 +53,19 @@ const (
 	// ResourceTypeContourHTTPProxy is the resource type of a Contour HTTPProxy.
 	ResourceTypeContourHTTPProxy = "projectcontour.io/HTTPProxy"
 
+	// KindGatewayAPIGateway is the kind of a Kubernetes Gateway API Gateway.
+	KindGatewayAPIGateway = "Gateway"
+	// ResourceTypeGatewayAPIGateway is the resource type of a Kubernetes Gateway API Gateway.
+	ResourceTypeGatewayAPIGateway = "gateway.networking.k8s.io/Gateway"
+	// KindGatewayAPIHTTPRoute is the kind of a Kubernetes Gateway API HTTPRoute.
+	KindGatewayAPIHTTPRoute = "HTTPRoute"
+	// ResourceTypeGatewayAPIHTTPRoute is the resource type of a Kubernetes Gateway API HTTPRoute.
+	ResourceTypeGatewayAPIHTTPRoute = "gateway.networking.k8s.io/HTTPRoute"
+	// KindGatewayAPITLSRoute is the kind of a Kubernetes Gateway API TLSRoute.
+	KindGatewayAPITLSRoute = "TLSRoute"
+	// ResourceTypeGatewayAPITLSRoute is the resource type of a Kubernetes Gateway API TLSRoute.
+	ResourceTypeGatewayAPITLSRoute = "gateway.networking.k8s.io/TLSRoute"
+
 	// ResourceTypeDaprComponent is the resource type of a Dapr component.
 	ResourceTypeDaprComponent = "dapr.io/Component"
 )
@@ -16,7 +16,12 @@ limitations under the License.
 
 package radius
 
-import "github.com/radius-project/radius/pkg/ucp/resources"
+import (
+	"regexp"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
 
 const (
 	// PlaneTypeRadius defines the type name of the Radius plane.
@@ -38,6 +43,33 @@ const (
 	NamespaceApplicationsMessaging = "Applications.Messaging"
 )
 
+var (
+	// userDefinedNamespaceRegex matches the namespaces of user-defined resource providers, e.g. 'MyCompany.Data'.
+	userDefinedNamespaceRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(\.[A-Za-z][A-Za-z0-9]*)+$`)
+
+	// reservedNamespacePrefixes are the prefixes of the namespaces of the resource providers implemented by Radius
+	// or by clouds.
+	reservedNamespacePrefixes = []string{"Applications", "System", "Radius", "Microsoft", "AWS"}
+)
+
+// IsUserDefinedNamespace checks if the given namespace can be used by a resource provider registered by users. The
+// namespace must have at least two segments, e.g. 'MyCompany.Data', and must not start with a reserved prefix such as
+// 'Applications'.
+func IsUserDefinedNamespace(namespace string) bool {
+	if !userDefinedNamespaceRegex.MatchString(namespace) {
+		return false
+	}
+
+	prefix := strings.Split(namespace, ".")[0]
+	for _, reserved := range reservedNamespacePrefixes {
+		if strings.EqualFold(prefix, reserved) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // IsRadiusResource checks if the given ID represents a resource type, and is defined in the Radius plane.
 func IsRadiusResource(id resources.ID) bool {
 	return id.FindScope("radius") != "" && id.IsResource()
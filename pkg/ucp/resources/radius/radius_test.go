@@ -54,3 +54,27 @@ func Test_IsRadiusResource(t *testing.T) {
 		})
 	}
 }
+
+func Test_IsUserDefinedNamespace(t *testing.T) {
+	values := []struct {
+		namespace string
+		expected  bool
+	}{
+		{namespace: "MyCompany.Data", expected: true},
+		{namespace: "MyCompany.Data.Kafka", expected: true},
+		{namespace: "MyCompany", expected: false},
+		{namespace: "MyCompany.", expected: false},
+		{namespace: "My-Company.Data", expected: false},
+		{namespace: "Applications.Core", expected: false},
+		{namespace: "applications.Custom", expected: false},
+		{namespace: "System.Resources", expected: false},
+		{namespace: "Microsoft.Resources", expected: false},
+		{namespace: "AWS.S3", expected: false},
+	}
+
+	for _, v := range values {
+		t.Run(v.namespace, func(t *testing.T) {
+			require.Equal(t, v.expected, IsUserDefinedNamespace(v.namespace))
+		})
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/radius-project/radius/pkg/armrpc/audit"
+	"github.com/radius-project/radius/pkg/armrpc/builder"
 	hostOpts "github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
@@ -67,6 +69,11 @@ type Options struct {
 	Identity                hostoptions.Identity
 	UCPConnection           sdk.Connection
 	Location                string
+	KubeConfig              *kube_rest.Config
+
+	// Builders are the builders of the built-in resource providers served through UCP. They are used to generate the
+	// OpenAPI document served by UCP.
+	Builders []builder.Builder
 }
 
 const UCPProviderName = "ucp"
@@ -111,7 +118,8 @@ func NewServerOptionsFromEnvironment() (Options, error) {
 	}
 
 	var cfg *kube_rest.Config
-	if opts.Config.UCP.Kind == config.UCPConnectionKindKubernetes {
+	authn := opts.Config.Authentication
+	if opts.Config.UCP.Kind == config.UCPConnectionKindKubernetes || (authn != nil && authn.Kubernetes != nil) {
 		cfg, err = kubeutil.NewClientConfig(&kubeutil.ConfigOptions{
 			// TODO: Allow to use custom context via configuration. - https://github.com/radius-project/radius/issues/5433
 			ContextName: "",
@@ -144,6 +152,7 @@ func NewServerOptionsFromEnvironment() (Options, error) {
 		Identity:                identity,
 		UCPConnection:           ucpConn,
 		Location:                location,
+		KubeConfig:              cfg,
 	}, nil
 }
 
@@ -164,6 +173,8 @@ func NewServer(options *Options) (*hosting.Host, error) {
 			InitialPlanes:          options.InitialPlanes,
 			Identity:               options.Identity,
 			UCPConnection:          options.UCPConnection,
+			KubeConfig:             options.KubeConfig,
+			Builders:               options.Builders,
 		}),
 	}
 
@@ -187,6 +198,11 @@ func NewServer(options *Options) (*hosting.Host, error) {
 		hostingServices = append(hostingServices, profilerservice.NewService(profilerOptions))
 	}
 
+	var auditOptions *audit.Options
+	if options.Config != nil {
+		auditOptions = options.Config.Audit
+	}
+
 	backendServiceOptions := hostOpts.HostOptions{
 		Config: &hostOpts.ProviderConfig{
 			StorageProvider:  options.StorageProviderOptions,
@@ -195,6 +211,7 @@ func NewServer(options *Options) (*hosting.Host, error) {
 			MetricsProvider:  options.MetricsProviderOptions,
 			TracerProvider:   options.TracerProviderOptions,
 			ProfilerProvider: options.ProfilerProviderOptions,
+			Audit:            auditOptions,
 		},
 	}
 	hostingServices = append(hostingServices, backend.NewService(backendServiceOptions))
@@ -96,6 +96,10 @@ func APIValidator(options Options) func(h http.Handler) http.Handler {
 				return
 			}
 
+			if newest, ok := options.SpecLoader.DeprecatedVersion(resourceType, apiVersion); ok {
+				w.Header().Set(v1.WarningHeader, deprecatedAPIVersionWarning(apiVersion, resourceType, newest))
+			}
+
 			errs := v.ValidateRequest(r)
 			if errs != nil {
 				resp := validationFailedResponse(resourceType, errs)
@@ -148,6 +152,10 @@ func unsupportedAPIVersionResponse(apiVersion, resourceType string, supportedAPI
 	})
 }
 
+func deprecatedAPIVersionWarning(apiVersion, resourceType, newest string) string {
+	return fmt.Sprintf("299 - \"API version '%s' for type '%s' is deprecated. Use api-version '%s' instead.\"", apiVersion, resourceType, newest)
+}
+
 func validationFailedResponse(qualifiedName string, valErrs []ValidationError) rest.Response {
 	errDetails := []v1.ErrorDetails{}
 
@@ -522,3 +522,47 @@ func runTest(t *testing.T, resourceIDUrl, targetScope, planeRootScope string, pr
 		})
 	}
 }
+
+func Test_APIValidator_DeprecatedVersion(t *testing.T) {
+	l, err := LoadSpec(context.Background(), "applications.core", multiVersionSpecFiles(t), []string{"/planes/{planeType}/{planeName}/resourceGroups/{resourceGroupName}"}, "rootScope")
+	require.NoError(t, err)
+
+	versionTests := []struct {
+		apiVersion string
+		warning    string
+	}{
+		{
+			apiVersion: "2023-10-01-preview",
+			warning:    "299 - \"API version '2023-10-01-preview' for type 'applications.core/environments' is deprecated. Use api-version '2024-01-01' instead.\"",
+		},
+		{
+			apiVersion: "2024-01-01",
+			warning:    "",
+		},
+	}
+
+	for _, tc := range versionTests {
+		t.Run(tc.apiVersion, func(t *testing.T) {
+			r := chi.NewRouter()
+			r.Route("/planes/{planeType}/{planeName}/resourceGroups/{resourceGroupName}"+environmentResourceRoute, func(r chi.Router) {
+				r.Use(APIValidator(Options{
+					SpecLoader:         l,
+					ResourceTypeGetter: RadiusResourceTypeGetter,
+				}))
+				r.MethodFunc(http.MethodPut, "/", func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusAccepted)
+				})
+			})
+
+			body := testutil.ReadFixture("put-environments-valid.json")
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, ucpResourceGroupScopedResourceURL+"?api-version="+tc.apiVersion, bytes.NewBuffer(body))
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusAccepted, w.Result().StatusCode, "%s", w.Body.String())
+			require.Equal(t, tc.warning, w.Result().Header.Get(v1.WarningHeader))
+		})
+	}
+}
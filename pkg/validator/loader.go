@@ -28,6 +28,7 @@ import (
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/spec"
+	"github.com/radius-project/radius/pkg/armrpc/apiversions"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
@@ -50,9 +51,11 @@ func (l *Loader) Name() string {
 	return l.providerName
 }
 
-// // SupportedVersions returns a list of supported versions for the given resource type, or an empty list if the resource
-// type is not supported.
+// SupportedVersions returns a list of supported versions for the given resource type, newest first, or an empty list if
+// the resource type is not supported.
 func (l *Loader) SupportedVersions(resourceType string) []string {
+	// ARM types are compared case-insensitively
+	resourceType = strings.ToLower(resourceType)
 	if versions, ok := l.supportedVersions[resourceType]; ok {
 		return versions
 	}
@@ -64,6 +67,12 @@ func (l *Loader) SupportedVersions(resourceType string) []string {
 	return []string{}
 }
 
+// DeprecatedVersion returns the newest supported version and true if the given version of the resource type is a
+// preview version that has been superseded by a newer version.
+func (l *Loader) DeprecatedVersion(resourceType, version string) (string, bool) {
+	return apiversions.Deprecated(version, l.SupportedVersions(resourceType))
+}
+
 // GetValidator returns the cached validator.
 func (l *Loader) GetValidator(resourceType, version string) (Validator, bool) {
 	// ARM types are compared case-insensitively
@@ -167,6 +176,10 @@ func LoadSpec(ctx context.Context, providerName string, specs fs.FS, rootScopePr
 		return nil, ErrSpecDocumentNotFound
 	}
 
+	for _, versions := range l.supportedVersions {
+		apiversions.SortNewestFirst(versions)
+	}
+
 	return l, nil
 }
 
@@ -18,7 +18,10 @@ package validator
 
 import (
 	"context"
+	"io/fs"
+	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/radius-project/radius/swagger"
 	"github.com/stretchr/testify/require"
@@ -79,3 +82,51 @@ func Test_Loader(t *testing.T) {
 	require.True(t, ok)
 	require.NotNil(t, v)
 }
+
+func Test_Loader_Versions(t *testing.T) {
+	l, err := LoadSpec(context.Background(), "applications.core", multiVersionSpecFiles(t), []string{"{rootScope:.*}"}, "rootScope")
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"2024-01-01", "2023-10-01-preview"}, l.SupportedVersions("Applications.Core/environments"))
+
+	v, ok := l.GetValidator("applications.core/environments", "2024-01-01")
+	require.True(t, ok)
+	require.NotNil(t, v)
+
+	newest, ok := l.DeprecatedVersion("Applications.Core/environments", "2023-10-01-preview")
+	require.True(t, ok)
+	require.Equal(t, "2024-01-01", newest)
+
+	_, ok = l.DeprecatedVersion("Applications.Core/environments", "2024-01-01")
+	require.False(t, ok)
+}
+
+// multiVersionSpecFiles returns the spec files with a copy of the Applications.Core preview spec as stable version
+// 2024-01-01.
+func multiVersionSpecFiles(t *testing.T) fs.FS {
+	const (
+		previewDir = "specification/applications/resource-manager/Applications.Core/preview/2023-10-01-preview/"
+		stableDir  = "specification/applications/resource-manager/Applications.Core/stable/2024-01-01/"
+	)
+
+	files := fstest.MapFS{}
+	err := fs.WalkDir(swagger.SpecFiles, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		data, err := fs.ReadFile(swagger.SpecFiles, path)
+		if err != nil {
+			return err
+		}
+
+		files[path] = &fstest.MapFile{Data: data}
+		if strings.HasPrefix(path, previewDir) {
+			files[stableDir+strings.TrimPrefix(path, previewDir)] = &fstest.MapFile{Data: data}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	return files
+}
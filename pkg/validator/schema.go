@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	oai_errors "github.com/go-openapi/errors"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// schemaRoot is the path of the properties validated by SchemaValidator, used as a prefix of error messages.
+	schemaRoot = "$.properties"
+)
+
+// SchemaValidator validates the properties of a resource against an OpenAPI schema which is not defined in the swagger
+// files of the resource provider, such as the schema of a user-defined resource type.
+type SchemaValidator struct {
+	schema *spec.Schema
+}
+
+// NewSchemaValidator compiles the given OpenAPI schema for the properties of a resource. It returns an error if the schema
+// is not a valid schema of an object, or it references a schema outside of itself.
+func NewSchemaValidator(schema map[string]any) (*SchemaValidator, error) {
+	if schema == nil {
+		return nil, errors.New("schema must not be nil")
+	}
+
+	if err := validateRefs(schema, "#"); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	s := &spec.Schema{}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	if len(s.Type) > 0 && !s.Type.Contains("object") {
+		return nil, fmt.Errorf("invalid schema: the type must be 'object', but got '%s'", strings.Join(s.Type, ","))
+	}
+
+	if err := spec.ExpandSchema(s, s, nil); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	return &SchemaValidator{schema: s}, nil
+}
+
+// Validate validates the properties of a resource and returns all the errors. The value must be the JSON decoded
+// properties of the resource.
+func (v *SchemaValidator) Validate(value any) []ValidationError {
+	result := validate.NewSchemaValidator(v.schema, nil, schemaRoot, strfmt.Default).Validate(value)
+	if result == nil || result.IsValid() {
+		return nil
+	}
+
+	errs := []ValidationError{}
+	for _, e := range flattenResult(result.Errors) {
+		errs = append(errs, ValidationError{
+			Code:    v1.CodeInvalidProperties,
+			Message: e.Error(),
+		})
+	}
+
+	// The order of errors depends on the iteration order of maps, so sort them to return stable results.
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Message < errs[j].Message })
+	return errs
+}
+
+func flattenResult(errs []error) []error {
+	res := []error{}
+	for _, e := range errs {
+		if composite, ok := e.(*oai_errors.CompositeError); ok {
+			res = append(res, flattenComposite(composite).Errors...)
+			continue
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+// validateRefs returns an error if the schema contains a reference which does not point to the schema itself.
+// Resolving remote references would make the validation depend on the network.
+func validateRefs(value any, path string) error {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, child := range v {
+			if k == "$ref" {
+				ref, ok := child.(string)
+				if !ok || !strings.HasPrefix(ref, "#/") {
+					return fmt.Errorf("invalid schema: %s/$ref must be a local reference starting with '#/'", path)
+				}
+				continue
+			}
+			if err := validateRefs(child, path+"/"+k); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, child := range v {
+			if err := validateRefs(child, fmt.Sprintf("%s/%d", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
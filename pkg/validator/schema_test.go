@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+var testSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"size":  map[string]any{"type": "string", "enum": []any{"S", "M"}},
+		"count": map[string]any{"type": "integer", "minimum": 1},
+		"tags":  map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+	},
+	"required":             []any{"size"},
+	"additionalProperties": false,
+}
+
+func Test_NewSchemaValidator(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema map[string]any
+		err    string
+	}{
+		{
+			name:   "valid",
+			schema: testSchema,
+		},
+		{
+			name:   "empty",
+			schema: map[string]any{},
+		},
+		{
+			name:   "local reference",
+			schema: map[string]any{"properties": map[string]any{"a": map[string]any{"$ref": "#/definitions/a"}}, "definitions": map[string]any{"a": map[string]any{"type": "string"}}},
+		},
+		{
+			name: "nil",
+			err:  "schema must not be nil",
+		},
+		{
+			name:   "not an object",
+			schema: map[string]any{"type": "string"},
+			err:    "invalid schema: the type must be 'object', but got 'string'",
+		},
+		{
+			name:   "remote reference",
+			schema: map[string]any{"properties": map[string]any{"a": map[string]any{"$ref": "https://example.com/schema.json"}}},
+			err:    "invalid schema: #/properties/a/$ref must be a local reference starting with '#/'",
+		},
+		{
+			name:   "unresolved reference",
+			schema: map[string]any{"properties": map[string]any{"a": map[string]any{"$ref": "#/definitions/a"}}},
+			err:    "invalid schema: object has no key \"a\"",
+		},
+		{
+			name:   "invalid type",
+			schema: map[string]any{"type": 3},
+			err:    "invalid schema: only string or array is allowed, not float64",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := NewSchemaValidator(tc.schema)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, v)
+		})
+	}
+}
+
+func Test_SchemaValidator_Validate(t *testing.T) {
+	v, err := NewSchemaValidator(testSchema)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		value map[string]any
+		errs  []ValidationError
+	}{
+		{
+			name:  "valid",
+			value: map[string]any{"size": "S", "count": float64(2), "tags": []any{"a"}},
+		},
+		{
+			name:  "invalid enum",
+			value: map[string]any{"size": "L"},
+			errs: []ValidationError{
+				{Code: v1.CodeInvalidProperties, Message: "$.properties.size in body should be one of [S M]"},
+			},
+		},
+		{
+			name:  "multiple errors",
+			value: map[string]any{"count": float64(0), "tags": []any{float64(1)}, "extra": "x"},
+			errs: []ValidationError{
+				{Code: v1.CodeInvalidProperties, Message: "$.properties.count in body should be greater than or equal to 1"},
+				{Code: v1.CodeInvalidProperties, Message: "$.properties.extra in body is a forbidden property"},
+				{Code: v1.CodeInvalidProperties, Message: "$.properties.size in body is required"},
+				{Code: v1.CodeInvalidProperties, Message: "$.properties.tags in body must be of type string: \"number\""},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := v.Validate(tc.value)
+			if tc.errs == nil {
+				require.Empty(t, errs)
+				return
+			}
+			require.Equal(t, tc.errs, errs)
+		})
+	}
+}
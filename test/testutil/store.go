@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutil
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+// MustGetStoreObject returns a store object holding value as a generic map, the same form that objects read back
+// from the data store have. The test fails if value cannot be round-tripped through JSON.
+func MustGetStoreObject(t *testing.T, value any) *store.Object {
+	b, err := json.Marshal(value)
+	require.NoError(t, err)
+
+	data := map[string]any{}
+	err = json.Unmarshal(b, &data)
+	require.NoError(t, err)
+
+	return &store.Object{Data: data}
+}